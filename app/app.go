@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"os"
@@ -19,6 +20,7 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -89,7 +91,14 @@ import (
 	"github.com/CoreumFoundation/coreum/docs"
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/pkg/denomindex"
+	"github.com/CoreumFoundation/coreum/pkg/denomledger"
+	"github.com/CoreumFoundation/coreum/pkg/nodeprofile"
+	"github.com/CoreumFoundation/coreum/pkg/events"
+	// registers the proto files not generated by the cosmos sdk, so they are served by the reflection service
+	_ "github.com/CoreumFoundation/coreum/pkg/protoset"
 	assetft "github.com/CoreumFoundation/coreum/x/asset/ft"
+	assetftclient "github.com/CoreumFoundation/coreum/x/asset/ft/client"
 	assetftkeeper "github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetftwasm "github.com/CoreumFoundation/coreum/x/asset/ft/wasm"
@@ -101,12 +110,22 @@ import (
 	customparamskeeper "github.com/CoreumFoundation/coreum/x/customparams/keeper"
 	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
 	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+	"github.com/CoreumFoundation/coreum/x/expedited"
+	expeditedkeeper "github.com/CoreumFoundation/coreum/x/expedited/keeper"
+	expeditedtypes "github.com/CoreumFoundation/coreum/x/expedited/types"
 	"github.com/CoreumFoundation/coreum/x/feemodel"
+	feemodelclient "github.com/CoreumFoundation/coreum/x/feemodel/client"
 	feemodelkeeper "github.com/CoreumFoundation/coreum/x/feemodel/keeper"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 	"github.com/CoreumFoundation/coreum/x/nft"
 	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
 	nftmodule "github.com/CoreumFoundation/coreum/x/nft/module"
+	"github.com/CoreumFoundation/coreum/x/oracle"
+	oraclekeeper "github.com/CoreumFoundation/coreum/x/oracle/keeper"
+	oracletypes "github.com/CoreumFoundation/coreum/x/oracle/types"
+	"github.com/CoreumFoundation/coreum/x/timetravel"
+	timetravelkeeper "github.com/CoreumFoundation/coreum/x/timetravel/keeper"
+	timetraveltypes "github.com/CoreumFoundation/coreum/x/timetravel/types"
 	wasmtypes "github.com/CoreumFoundation/coreum/x/wasm/types"
 	"github.com/CoreumFoundation/coreum/x/wbank"
 	wbankkeeper "github.com/CoreumFoundation/coreum/x/wbank/keeper"
@@ -141,6 +160,8 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		distrclient.ProposalHandler,
 		upgradeclient.ProposalHandler,
 		upgradeclient.CancelProposalHandler,
+		feemodelclient.ProposalHandler,
+		assetftclient.ProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -177,6 +198,9 @@ var (
 		assetft.AppModuleBasic{},
 		assetnft.AppModuleBasic{},
 		customparams.AppModuleBasic{},
+		oracle.AppModuleBasic{},
+		expedited.AppModuleBasic{},
+		timetravel.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -190,6 +214,8 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		wasm.ModuleName:                {authtypes.Burner},
 		assetfttypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
+		assetnfttypes.ModuleName:       nil,
+		feemodeltypes.ModuleName:       {authtypes.Burner},
 		nft.ModuleName:                 {}, // the line is required by the nft module to have the module account stored in the account keeper
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
@@ -221,6 +247,10 @@ type App struct {
 
 	invCheckPeriod uint
 
+	// blockHeader is the header of the block being executed, it is used to build the context the gas of the failed
+	// transactions is refunded in.
+	blockHeader tmproto.Header
+
 	// keys to access the substores
 	keys    map[string]*sdk.KVStoreKey
 	tkeys   map[string]*sdk.TransientStoreKey
@@ -248,6 +278,9 @@ type App struct {
 	BankKeeper         wbankkeeper.BaseKeeperWrapper
 	NFTKeeper          nftkeeper.Keeper
 	CustomParamsKeeper customparamskeeper.Keeper
+	OracleKeeper       oraclekeeper.Keeper
+	ExpeditedKeeper    expeditedkeeper.Keeper
+	TimeTravelKeeper   timetravelkeeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// mm is the module manager
@@ -255,6 +288,9 @@ type App struct {
 
 	// sm is the simulation manager
 	sm *module.SimulationManager
+
+	// wasmGasRegister is the wasm gas register applying the pinned instance cost discount set by the governance
+	wasmGasRegister wasmtypes.GasRegister
 }
 
 // New returns a reference to an initialized blockchain app
@@ -288,11 +324,44 @@ func New(
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
 		wasm.StoreKey, feemodeltypes.StoreKey, assetfttypes.StoreKey, assetnfttypes.StoreKey, nftkeeper.StoreKey,
+		oracletypes.StoreKey,
+		expeditedtypes.StoreKey,
+		timetraveltypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state streaming, the streamed stores are chosen in the app.toml, see docs/chain/state-streaming.md
+	if _, _, err := streaming.LoadStreamingServices(bApp, appOpts, appCodec, keys); err != nil {
+		panic(errors.Wrap(err, "failed to load state streaming services"))
+	}
+
+	// configure the optional index of the transactions involving the fungible tokens, see docs/chain/denom-index.md
+	var denomIndex *denomindex.Index
+	if cast.ToBool(appOpts.Get(denomindex.FlagEnable)) {
+		denomIndexDB, err := sdk.NewLevelDB(denomindex.DBName, filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(errors.Wrap(err, "failed to open denom index database"))
+		}
+		denomIndex = denomindex.New(denomIndexDB)
+		bApp.SetStreamingService(denomIndex)
+	}
+
+	// configure the optional ledger of the balance changes of the chosen denoms, see docs/chain/denom-ledger.md
+	var denomLedger *denomledger.Ledger
+	if cast.ToBool(appOpts.Get(denomledger.FlagEnable)) {
+		denomLedgerDB, err := sdk.NewLevelDB(denomledger.DBName, filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(errors.Wrap(err, "failed to open denom ledger database"))
+		}
+		denomLedger, err = denomledger.New(denomLedgerDB, cast.ToStringSlice(appOpts.Get(denomledger.FlagDenoms)))
+		if err != nil {
+			panic(errors.Wrap(err, "failed to create denom ledger"))
+		}
+		bApp.SetStreamingService(denomLedger)
+	}
+
 	app := &App{
 		BaseApp:           bApp,
 		cdc:               cdc,
@@ -324,11 +393,19 @@ func New(
 		keys[authz.ModuleName], appCodec, app.MsgServiceRouter(),
 	)
 
+	// the staking and wasm keepers depend on the bank keeper, so they are created later and passed to the asset keeper
+	// by reference.
+	var stakingKeeper stakingkeeper.Keeper
 	assetFTKeeper := assetftkeeper.NewKeeper(
 		appCodec,
 		keys[assetfttypes.StoreKey],
+		app.GetSubspace(assetfttypes.ModuleName),
+		app.AccountKeeper,
 		// for the asset we use the clear bank keeper without the assets integration to prevent cycling calls.
 		bankkeeper.NewBaseKeeper(appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs()),
+		&stakingKeeper,
+		&app.WASMKeeper,
+		ChosenNetwork.DeterministicGas().AssetFTSendFeatureGas(),
 	)
 
 	app.BankKeeper = wbankkeeper.NewKeeper(
@@ -337,7 +414,7 @@ func New(
 
 	app.AssetFTKeeper = assetFTKeeper
 
-	stakingKeeper := stakingkeeper.NewKeeper(
+	stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
@@ -375,20 +452,44 @@ func New(
 		app.GetSubspace(feemodeltypes.ModuleName).WithKeyTable(paramstypes.NewKeyTable().RegisterParamSet(&feemodeltypes.Params{})),
 		keys[feemodeltypes.StoreKey],
 		tkeys[feemodeltypes.TransientStoreKey],
+		app.BankKeeper,
 	)
 
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		appCodec,
+		keys[oracletypes.StoreKey],
+		app.GetSubspace(oracletypes.ModuleName),
+		app.StakingKeeper,
+	)
+	app.FeeModelKeeper.SetPriceOracle(app.OracleKeeper)
+
 	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
 
-	app.CustomParamsKeeper = customparamskeeper.NewKeeper(app.GetSubspace(customparamstypes.CustomParamsStaking))
+	app.CustomParamsKeeper = customparamskeeper.NewKeeper(
+		app.GetSubspace(customparamstypes.CustomParamsStaking),
+		app.GetSubspace(customparamstypes.CustomParamsWasm),
+		app.GetSubspace(customparamstypes.CustomParamsTx),
+	)
 
-	app.AssetNFTKeeper = assetnftkeeper.NewKeeper(appCodec, keys[assetnfttypes.StoreKey], app.NFTKeeper)
+	app.AssetNFTKeeper = assetnftkeeper.NewKeeper(
+		appCodec,
+		keys[assetnfttypes.StoreKey],
+		app.GetSubspace(assetnfttypes.ModuleName),
+		app.NFTKeeper,
+		app.BankKeeper,
+		app.AssetFTKeeper,
+	)
+	// the hooks are set after the asset nft keeper receives its copy of the nft keeper, so the transfers done by
+	// the asset nft keeper don't call them
+	app.NFTKeeper.SetHooks(app.AssetNFTKeeper.Hooks())
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(assetfttypes.RouterKey, assetft.NewProposalHandler(app.AssetFTKeeper))
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	evidenceKeeper := evidencekeeper.NewKeeper(
@@ -403,9 +504,11 @@ func New(
 		panic(errors.Wrapf(err, "error while reading wasm config"))
 	}
 
+	app.wasmGasRegister = wasmtypes.NewGasRegister(wasmkeeper.DefaultGasRegisterConfig())
 	wasmOpts := []wasm.Option{
 		wasmkeeper.WithMessageEncoders(wasmtypes.NewCustomEncoder(assetftwasm.MsgHandler)),
 		wasmkeeper.WithQueryPlugins(wasmtypes.NewCustomQuerier(assetftwasm.QueryHandler(app.AssetFTKeeper))),
+		wasmkeeper.WithGasRegister(app.wasmGasRegister),
 	}
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
@@ -440,6 +543,19 @@ func New(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
 	)
+	app.ExpeditedKeeper = expeditedkeeper.NewKeeper(
+		appCodec,
+		keys[expeditedtypes.StoreKey],
+		app.GetSubspace(expeditedtypes.ModuleName),
+		&app.GovKeeper,
+		&stakingKeeper,
+	)
+	app.GovKeeper.SetHooks(app.ExpeditedKeeper.Hooks())
+	app.TimeTravelKeeper = timetravelkeeper.NewKeeper(
+		appCodec,
+		keys[timetraveltypes.StoreKey],
+		ChosenNetwork.IsTimeTravelEnabled(),
+	)
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
 	// this line is used by starport scaffolding # ibc/app/router
@@ -453,6 +569,9 @@ func New(
 	assetFTModule := assetft.NewAppModule(appCodec, app.AssetFTKeeper, app.BankKeeper)
 	assetNFTModule := assetnft.NewAppModule(appCodec, app.AssetNFTKeeper)
 	feeModule := feemodel.NewAppModule(app.FeeModelKeeper)
+	oracleModule := oracle.NewAppModule(appCodec, app.OracleKeeper)
+	expeditedModule := expedited.NewAppModule(appCodec, app.ExpeditedKeeper)
+	timeTravelModule := timetravel.NewAppModule(appCodec, app.TimeTravelKeeper)
 
 	nftModule := nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry)
 
@@ -489,6 +608,9 @@ func New(
 		assetNFTModule,
 		nftModule,
 		customParamsModule,
+		oracleModule,
+		expeditedModule,
+		timeTravelModule,
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 
@@ -519,11 +641,17 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
+		oracletypes.ModuleName,
+		expeditedtypes.ModuleName,
+		timetraveltypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	)
 
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
+		// expedited must go before the gov to convert the expedited proposals not reaching the threshold before
+		// they are tallied by the gov
+		expeditedtypes.ModuleName,
 		govtypes.ModuleName,
 		customparamstypes.ModuleName,
 		stakingtypes.ModuleName,
@@ -541,10 +669,13 @@ func New(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		wasm.ModuleName,
+		// oracle must go before the fee model to apply the gas price floor computed from the latest exchange rates
+		oracletypes.ModuleName,
 		feemodeltypes.ModuleName,
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
+		timetraveltypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	)
 
@@ -576,6 +707,9 @@ func New(
 		nft.ModuleName,
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
+		oracletypes.ModuleName,
+		expeditedtypes.ModuleName,
+		timetraveltypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
 
@@ -583,6 +717,11 @@ func New(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.appCodec,
 		deterministicgastypes.NewDeterministicMsgServer(app.MsgServiceRouter(), ChosenNetwork.DeterministicGas()), app.GRPCQueryRouter()))
+	denomindex.RegisterQueryServer(app.GRPCQueryRouter(), denomindex.NewQueryService(denomIndex))
+	denomledger.RegisterQueryServer(app.GRPCQueryRouter(), denomledger.NewQueryService(denomLedger))
+	nodeprofile.RegisterQueryServer(app.GRPCQueryRouter(), nodeprofile.NewQueryService(appOpts))
+	deterministicgastypes.RegisterQueryServer(
+		app.GRPCQueryRouter(), deterministicgastypes.NewQueryService(app.interfaceRegistry, ChosenNetwork.DeterministicGas()))
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	app.sm = module.NewSimulationManager(
@@ -602,6 +741,8 @@ func New(
 		assetNFTModule,
 		nftModule,
 		customParamsModule,
+		oracleModule,
+		expeditedModule,
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 	app.sm.RegisterStoreDecoders()
@@ -623,6 +764,7 @@ func New(
 			SignModeHandler:              encodingConfig.TxConfig.SignModeHandler(),
 			FeegrantKeeper:               app.FeeGrantKeeper,
 			FeeModelKeeper:               app.FeeModelKeeper,
+			CustomParamsKeeper:           app.CustomParamsKeeper,
 			WasmTXCounterStoreKey:        keys[wasm.StoreKey],
 		},
 	)
@@ -667,9 +809,43 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 // GetBaseApp returns the base app of the application
 func (app *App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// BeginBlock shifts the block time of the consensus by the offset of the time travel before the block is executed,
+// so the shifted time is seen by the modules, the transactions and the queries. The offset is read from the committed
+// state, so all the validators shift the time equally. The time is never shifted on the public networks.
+func (app *App) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.TimeTravelKeeper.IsEnabled() {
+		ctx := app.BaseApp.NewUncachedContext(false, req.Header)
+		req.Header.Time = app.TimeTravelKeeper.ShiftedTime(ctx, req.Header.Time)
+	}
+	app.blockHeader = req.Header
+	return app.BaseApp.BeginBlock(req)
+}
+
+// DeliverTx executes the transaction and then refunds the fee of the gas not used by it if the transaction failed and
+// the refund is enabled by the fee model params. It is done here because the message handlers don't run for the failed
+// transaction and the gas used by it is known only once it is executed. The changes are made in the state of the block,
+// so they are committed together with the transaction.
+func (app *App) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+
+	ctx := app.BaseApp.NewContext(false, app.blockHeader)
+	if err := app.FeeModelKeeper.RefundTxGas(ctx, res.Code != 0, uint64(res.GasUsed)); err != nil {
+		// the transaction is executed already, so its result is not changed if the refund fails
+		app.Logger().Error("refunding the gas of the failed transaction failed", "err", err)
+	}
+	res.Events = append(res.Events, sdk.MarkEventsToIndex(ctx.EventManager().ABCIEvents(), nil)...)
+
+	return res
+}
+
 // BeginBlocker application updates every begin block
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
-	return app.mm.BeginBlock(ctx, req)
+	// the gas register has no access to the state, so the discount is refreshed before the transactions are executed
+	app.wasmGasRegister.SetPinnedInstanceCostDiscount(app.CustomParamsKeeper.GetWasmParams(ctx).PinnedInstanceCostDiscount)
+	res := app.mm.BeginBlock(ctx, req)
+	// the version of the event registry is reported in each block so the indexers may detect the schema changes
+	res.Events = append(res.Events, sdk.Events{events.NewRegistryVersionEvent()}.ToABCIEvents()...)
+	return res
 }
 
 // EndBlocker application updates every end block
@@ -754,7 +930,7 @@ func (app *App) GetSubspace(moduleName string) paramstypes.Subspace {
 
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
-func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, _ serverconfig.APIConfig) {
+func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, apiConfig serverconfig.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
 	rpc.RegisterRoutes(clientCtx, apiSvr.Router)
 	// Register legacy tx routes.
@@ -763,6 +939,21 @@ func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, _ serverconfig.APICo
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register denom index queries routes from grpc-gateway.
+	if err := denomindex.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, denomindex.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := denomledger.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, denomledger.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := nodeprofile.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, nodeprofile.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+	if err := deterministicgastypes.RegisterQueryHandlerClient(
+		context.Background(), apiSvr.GRPCGatewayRouter, deterministicgastypes.NewQueryClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
 
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
@@ -770,6 +961,11 @@ func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, _ serverconfig.APICo
 
 	// register app's OpenAPI routes.
 	apiSvr.Router.Handle("/static/openapi.yml", http.FileServer(http.FS(docs.Docs)))
+	// register the OpenAPI spec in JSON format, including the custom modules, if enabled in the API config.
+	if apiConfig.Swagger {
+		apiSvr.Router.HandleFunc("/swagger/openapi.json", openapi.JSONHandler(docs.Docs, "static/openapi.yml"))
+		apiSvr.Router.HandleFunc("/swagger/", openapi.Handler(Name, "/swagger/openapi.json"))
+	}
 	apiSvr.Router.HandleFunc("/", openapi.Handler(Name, "/static/openapi.yml"))
 }
 
@@ -798,6 +994,12 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(wasm.ModuleName)
 	paramsKeeper.Subspace(feemodeltypes.ModuleName)
 	paramsKeeper.Subspace(customparamstypes.CustomParamsStaking)
+	paramsKeeper.Subspace(customparamstypes.CustomParamsWasm)
+	paramsKeeper.Subspace(customparamstypes.CustomParamsTx)
+	paramsKeeper.Subspace(oracletypes.ModuleName).WithKeyTable(oracletypes.ParamKeyTable())
+	paramsKeeper.Subspace(expeditedtypes.ModuleName).WithKeyTable(expeditedtypes.ParamKeyTable())
+	paramsKeeper.Subspace(assetfttypes.ModuleName).WithKeyTable(assetfttypes.ParamKeyTable())
+	paramsKeeper.Subspace(assetnfttypes.ModuleName).WithKeyTable(assetnfttypes.ParamKeyTable())
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	return paramsKeeper
@@ -9,6 +9,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -46,6 +47,32 @@ func (app *App) ExportAppStateAndValidators(
 	}, nil
 }
 
+// ExportModuleStates exports the genesis states of the chosen modules at the last committed height.
+// The modules are exported one by one and each state is passed to the callback right after it is exported,
+// so the state of all the modules is never kept in memory at once.
+func (app *App) ExportModuleStates(modules []string, fn func(module string, state json.RawMessage) error) error {
+	for _, module := range modules {
+		if _, exists := app.mm.Modules[module]; !exists {
+			return errors.Errorf("unknown module %q", module)
+		}
+	}
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	exported := map[string]struct{}{}
+	for _, module := range modules {
+		if _, exists := exported[module]; exists {
+			continue
+		}
+		exported[module] = struct{}{}
+
+		if err := fn(module, app.mm.Modules[module].ExportGenesis(ctx, app.appCodec)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 // in favour of export at a block height
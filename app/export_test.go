@@ -0,0 +1,51 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestApp_ExportModuleStates(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	issuer, _ := testApp.GenAccount(ctx)
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		InitialAmount: sdk.NewInt(100),
+	})
+	requireT.NoError(err)
+	testApp.Commit()
+
+	var modules []string
+	states := map[string]json.RawMessage{}
+	requireT.NoError(testApp.ExportModuleStates(
+		[]string{assetfttypes.ModuleName, "bank", assetfttypes.ModuleName},
+		func(module string, state json.RawMessage) error {
+			modules = append(modules, module)
+			states[module] = state
+			return nil
+		},
+	))
+	requireT.Equal([]string{assetfttypes.ModuleName, "bank"}, modules)
+
+	var ftState assetfttypes.GenesisState
+	requireT.NoError(testApp.AppCodec().UnmarshalJSON(states[assetfttypes.ModuleName], &ftState))
+	requireT.Len(ftState.Tokens, 1)
+	requireT.Equal(denom, ftState.Tokens[0].Denom)
+
+	requireT.Error(testApp.ExportModuleStates([]string{"unknown"}, func(string, json.RawMessage) error {
+		requireT.Fail("no module should be exported")
+		return nil
+	}))
+}
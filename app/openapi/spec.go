@@ -0,0 +1,56 @@
+package openapi
+
+import (
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// JSONHandler returns an http handler that serves the OpenAPI spec stored in YAML format at specPath of specFS
+// converted to JSON. The conversion is done once, on the first request.
+func JSONHandler(specFS fs.FS, specPath string) http.HandlerFunc {
+	var (
+		once    sync.Once
+		spec    []byte
+		specErr error
+	)
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		once.Do(func() {
+			spec, specErr = yamlSpecToJSON(specFS, specPath)
+		})
+		if specErr != nil {
+			http.Error(w, specErr.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(spec); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+func yamlSpecToJSON(specFS fs.FS, specPath string) ([]byte, error) {
+	yamlSpec, err := fs.ReadFile(specFS, specPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't read OpenAPI spec %q", specPath)
+	}
+
+	var spec interface{}
+	if err := yaml.Unmarshal(yamlSpec, &spec); err != nil {
+		return nil, errors.Wrapf(err, "can't decode OpenAPI spec %q", specPath)
+	}
+
+	jsonSpec, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't encode OpenAPI spec %q to JSON", specPath)
+	}
+
+	return jsonSpec, nil
+}
@@ -0,0 +1,75 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/CoreumFoundation/coreum/app/openapi"
+	"github.com/CoreumFoundation/coreum/docs"
+)
+
+func TestJSONHandler(t *testing.T) {
+	requireT := require.New(t)
+
+	handler := openapi.JSONHandler(docs.Docs, "static/openapi.yml")
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/swagger/openapi.json", nil))
+
+	requireT.Equal(http.StatusOK, rec.Code)
+	requireT.Equal("application/json", rec.Header().Get("Content-Type"))
+
+	var spec struct {
+		Swagger string                     `json:"swagger"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	requireT.NoError(json.Unmarshal(rec.Body.Bytes(), &spec))
+	requireT.Equal("2.0", spec.Swagger)
+	requireT.Contains(spec.Paths, "/coreum/asset/ft/v1/denom/{denom}")
+	requireT.Contains(spec.Paths, "/coreum/nft/v1beta1/classes")
+	requireT.Contains(spec.Paths, "/coreum/asset/nft/v1/classes")
+
+	// missing spec
+	handler = openapi.JSONHandler(docs.Docs, "static/missing.yml")
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/swagger/openapi.json", nil))
+	requireT.Equal(http.StatusInternalServerError, rec.Code)
+}
+
+// TestSpecCoversProtoRoutes fails if a REST route is added to the proto files of the coreum modules without
+// regenerating the spec by `make proto-gen-openapi`.
+func TestSpecCoversProtoRoutes(t *testing.T) {
+	requireT := require.New(t)
+
+	specData, err := docs.Docs.ReadFile("static/openapi.yml")
+	requireT.NoError(err)
+	var spec struct {
+		Paths map[string]interface{} `yaml:"paths"`
+	}
+	requireT.NoError(yaml.Unmarshal(specData, &spec))
+
+	routeRegexp := regexp.MustCompile(`\(google\.api\.http\)\.get\s*=\s*"([^"]+)"`)
+	var routes int
+	requireT.NoError(filepath.Walk(filepath.Join("..", "..", "proto"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".proto" {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range routeRegexp.FindAllStringSubmatch(string(data), -1) {
+			routes++
+			requireT.Contains(spec.Paths, match[1], "route of %s is missing in the spec", path)
+		}
+		return nil
+	}))
+	requireT.Positive(routes)
+}
@@ -0,0 +1,58 @@
+package cosmoscmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/pkg/errorcodes"
+)
+
+// ErrorCodesCmd returns the command listing the errors returned by the coreum modules.
+func ErrorCodesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "error-codes [codespace]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "List the errors returned by the coreum modules",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the codespace, code, name and description of the errors returned by the coreum modules.
+The list is built into the binary, so the node is not queried. If the codespace is provided, only its errors are listed.
+
+Example:
+$ %s query error-codes assetft
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			descriptors, err := errorcodes.BuildDescriptors(errorcodes.Registry())
+			if err != nil {
+				return err
+			}
+			if len(args) > 0 {
+				descriptors = filterErrorCodes(descriptors, args[0])
+			}
+
+			data, err := errorcodes.MarshalDescriptors(descriptors)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(data)
+		},
+	}
+}
+
+func filterErrorCodes(descriptors []errorcodes.Descriptor, codespace string) []errorcodes.Descriptor {
+	filtered := make([]errorcodes.Descriptor, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		if descriptor.Codespace == codespace {
+			filtered = append(filtered, descriptor)
+		}
+	}
+	return filtered
+}
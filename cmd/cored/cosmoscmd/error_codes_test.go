@@ -0,0 +1,26 @@
+package cosmoscmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/errorcodes"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestFilterErrorCodes(t *testing.T) {
+	requireT := require.New(t)
+
+	descriptors, err := errorcodes.BuildDescriptors(errorcodes.Registry())
+	requireT.NoError(err)
+
+	filtered := filterErrorCodes(descriptors, assetnfttypes.ModuleName)
+	requireT.NotEmpty(filtered)
+	requireT.Less(len(filtered), len(descriptors))
+	for _, descriptor := range filtered {
+		requireT.Equal(assetnfttypes.ModuleName, descriptor.Codespace)
+	}
+
+	requireT.Empty(filterErrorCodes(descriptors, "unknown"))
+}
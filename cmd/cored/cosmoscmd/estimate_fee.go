@@ -0,0 +1,167 @@
+package cosmoscmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// secp256k1SignatureSize is the size of the signature added to the tx bytes when the tx is signed.
+const secp256k1SignatureSize = 64
+
+// feeTiers defines the multipliers applied to the minimum gas price to compute the suggested fees. The higher tiers
+// keep the tx valid if the minimum gas price grows before the tx is broadcast.
+var feeTiers = []struct {
+	Name       string
+	Multiplier sdk.Dec
+}{
+	{Name: "low", Multiplier: sdk.OneDec()},
+	{Name: "medium", Multiplier: sdk.MustNewDecFromStr("1.2")},
+	{Name: "high", Multiplier: sdk.MustNewDecFromStr("1.5")},
+}
+
+// FeeEstimation is the fee estimated for the transaction.
+type FeeEstimation struct {
+	Gas           uint64            `json:"gas"`
+	Deterministic bool              `json:"deterministic"`
+	MinGasPrice   string            `json:"min_gas_price"`
+	Surcharge     string            `json:"surcharge"`
+	MinFee        string            `json:"min_fee"`
+	SuggestedFees map[string]string `json:"suggested_fees"`
+}
+
+// EstimateFeeCmd returns the command computing the fee required by the transaction stored in the file.
+func EstimateFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [tx.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Compute the minimum fee required by the transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Compute the gas and the minimum fee required by the transaction stored in the file (use - to read from stdin).
+The gas is computed using the deterministic gas of the messages. If any of the messages doesn't have the deterministic gas,
+the gas limit declared in the transaction is used. The minimum fee is computed using the current minimum gas price and
+the surcharges of the messages. The suggested fees keep the transaction valid if the minimum gas price grows.
+
+Example:
+$ %s tx estimate-fee tx.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			authRes, err := authtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &authtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			feemodelQueryClient := feemodeltypes.NewQueryClient(clientCtx)
+			minGasPriceRes, err := feemodelQueryClient.MinGasPrice(cmd.Context(), &feemodeltypes.QueryMinGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+			feemodelParamsRes, err := feemodelQueryClient.Params(cmd.Context(), &feemodeltypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			estimation, err := estimateFee(
+				tx,
+				len(txBytes),
+				config.DefaultDeterministicGasRequirements(),
+				authRes.Params,
+				feemodelParamsRes.Params,
+				minGasPriceRes.MinGasPrice,
+			)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(estimation)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func estimateFee(
+	tx sdk.Tx,
+	txSize int,
+	deterministicGas config.DeterministicGasRequirements,
+	authParams authtypes.Params,
+	feemodelParams feemodeltypes.Params,
+	minGasPrice sdk.DecCoin,
+) (FeeEstimation, error) {
+	feeTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return FeeEstimation{}, errors.Errorf("unsupported tx type: %T", tx)
+	}
+
+	// the signatures and public keys missing in the unsigned tx are added to its size
+	signatures, err := feeTx.GetSignaturesV2()
+	if err != nil {
+		return FeeEstimation{}, errors.WithStack(err)
+	}
+	if missingSignatures := len(feeTx.GetSigners()) - len(signatures); missingSignatures > 0 {
+		txSize += missingSignatures * (secp256k1SignatureSize + secp256k1.PubKeySize)
+	}
+
+	gas, deterministic := deterministicGas.TxGas(feeTx.GetMsgs(), txSize, len(feeTx.GetSigners()), authParams)
+	if !deterministic {
+		gas = feeTx.GetGas()
+	}
+
+	surcharge := feemodelParams.CalculateSurcharge(feeTx.GetMsgs())
+	minFee := func(multiplier sdk.Dec) sdk.Coin {
+		return sdk.NewCoin(
+			minGasPrice.Denom,
+			minGasPrice.Amount.Mul(multiplier).MulInt64(int64(gas)).Ceil().TruncateInt().Add(surcharge),
+		)
+	}
+
+	suggestedFees := make(map[string]string, len(feeTiers))
+	for _, tier := range feeTiers {
+		suggestedFees[tier.Name] = minFee(tier.Multiplier).String()
+	}
+
+	return FeeEstimation{
+		Gas:           gas,
+		Deterministic: deterministic,
+		MinGasPrice:   minGasPrice.String(),
+		Surcharge:     sdk.NewCoin(minGasPrice.Denom, surcharge).String(),
+		MinFee:        minFee(sdk.OneDec()).String(),
+		SuggestedFees: suggestedFees,
+	}, nil
+}
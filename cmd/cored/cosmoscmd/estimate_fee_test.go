@@ -0,0 +1,71 @@
+package cosmoscmd
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+)
+
+func TestEstimateFee(t *testing.T) {
+	requireT := require.New(t)
+
+	txConfig := config.NewEncodingConfig(app.ModuleBasics).TxConfig
+	deterministicGas := config.DefaultDeterministicGasRequirements()
+	authParams := authtypes.DefaultParams()
+	minGasPrice := sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.0625"))
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sendMsg := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("ucore", 10)))
+	nftMsg := &nft.MsgSend{ClassId: "class", Id: "id", Sender: sender.String(), Receiver: sender.String()}
+
+	feemodelParams := feemodeltypes.DefaultParams()
+	feemodelParams.Surcharges = []feemodeltypes.MsgSurcharge{
+		{MsgTypeURL: sdk.MsgTypeURL(nftMsg), Amount: sdk.NewInt(1000)},
+	}
+
+	// the gas of the small tx is deterministic
+	txBuilder := txConfig.NewTxBuilder()
+	requireT.NoError(txBuilder.SetMsgs(sendMsg, nftMsg))
+	txBuilder.SetGasLimit(1)
+	estimation, err := estimateFee(txBuilder.GetTx(), 100, deterministicGas, authParams, feemodelParams, minGasPrice)
+	requireT.NoError(err)
+	expectedGas := deterministicGas.FixedGas + deterministicGas.BankSendPerEntry + deterministicGas.NFTSend
+	requireT.Equal(FeeEstimation{
+		Gas:           expectedGas,
+		Deterministic: true,
+		MinGasPrice:   minGasPrice.String(),
+		Surcharge:     "1000ucore",
+		MinFee:        "6750ucore",
+		SuggestedFees: map[string]string{
+			"low":    "6750ucore",
+			"medium": "7900ucore",
+			"high":   "9625ucore",
+		},
+	}, estimation)
+
+	// the bytes exceeding the free ones are charged
+	estimation, err = estimateFee(txBuilder.GetTx(), int(deterministicGas.FreeBytes)+100, deterministicGas, authParams, feemodelParams, minGasPrice)
+	requireT.NoError(err)
+	requireT.Equal(expectedGas+authParams.TxSizeCostPerByte*(100+secp256k1SignatureSize+secp256k1.PubKeySize), estimation.Gas)
+
+	// the declared gas is used if the message doesn't have deterministic gas
+	txBuilder = txConfig.NewTxBuilder()
+	requireT.NoError(txBuilder.SetMsgs(sendMsg, &wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: sender.String(), Msg: []byte("{}")}))
+	txBuilder.SetGasLimit(200000)
+	estimation, err = estimateFee(txBuilder.GetTx(), 100, deterministicGas, authParams, feemodelParams, minGasPrice)
+	requireT.NoError(err)
+	requireT.False(estimation.Deterministic)
+	requireT.EqualValues(200000, estimation.Gas)
+	requireT.Equal("0ucore", estimation.Surcharge)
+	requireT.Equal("12500ucore", estimation.MinFee)
+}
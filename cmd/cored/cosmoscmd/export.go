@@ -0,0 +1,95 @@
+package cosmoscmd
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const flagModules = "modules"
+
+// moduleState is the line of the module export output.
+type moduleState struct {
+	Module string          `json:"module"`
+	Height int64           `json:"height"`
+	State  json.RawMessage `json:"state"`
+}
+
+// addModuleExportFlags extends the export command with the option to export the states of the chosen modules only.
+// In that case, instead of the genesis file, the command writes one JSON line per module, so the output might be
+// processed as a stream.
+func addModuleExportFlags(exportCmd *cobra.Command, a appCreator) {
+	exportGenesis := exportCmd.RunE
+	exportCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		modules, err := cmd.Flags().GetStringSlice(flagModules)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if len(modules) == 0 {
+			return exportGenesis(cmd, args)
+		}
+		return a.exportModules(cmd, modules)
+	}
+
+	exportCmd.Flags().StringSlice(
+		flagModules,
+		[]string{},
+		"Comma-separated list of modules to export, e.g. assetft,assetnft. If set, the state of each module is written as a separate JSON line instead of the genesis file",
+	)
+}
+
+func (a appCreator) exportModules(cmd *cobra.Command, modules []string) error {
+	forZeroHeight, err := cmd.Flags().GetBool(server.FlagForZeroHeight)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if forZeroHeight {
+		return errors.Errorf("--%s can't be used together with --%s", server.FlagForZeroHeight, flagModules)
+	}
+	height, err := cmd.Flags().GetInt64(server.FlagHeight)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	homeDir, err := cmd.Flags().GetString(flags.FlagHome)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := sdk.NewLevelDB("application", filepath.Join(homeDir, "data"))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer db.Close()
+
+	exportableApp := a.buildApp(
+		serverCtx.Logger,
+		db,
+		nil,
+		height == -1, // -1: no height provided
+		map[int64]bool{},
+		homeDir,
+		uint(1),
+		a.encodingConfig,
+		serverCtx.Viper,
+	)
+	if height != -1 {
+		if err := exportableApp.LoadHeight(height); err != nil {
+			return err
+		}
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	return exportableApp.ExportModuleStates(modules, func(module string, state json.RawMessage) error {
+		return errors.WithStack(encoder.Encode(moduleState{
+			Module: module,
+			Height: exportableApp.LastBlockHeight(),
+			State:  state,
+		}))
+	})
+}
@@ -0,0 +1,250 @@
+package cosmoscmd
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/CoreumFoundation/coreum/pkg/shamir"
+)
+
+const (
+	// FlagShares is the flag defining the number of the recovery shares.
+	FlagShares = "shares"
+	// FlagThreshold is the flag defining the number of the shares required to recover the key.
+	FlagThreshold = "threshold"
+)
+
+// KeyWithShares is the output of the command creating the key with the recovery shares.
+type KeyWithShares struct {
+	keyring.KeyOutput
+	Threshold int      `json:"threshold,omitempty"`
+	Shares    []string `json:"shares,omitempty"`
+}
+
+// keysCommand returns the keys commands of the sdk extended with the commands managing the keys recovered from
+// the shares.
+func keysCommand(defaultNodeHome string) *cobra.Command {
+	cmd := keys.Commands(defaultNodeHome)
+	cmd.AddCommand(
+		AddKeyWithSharesCmd(),
+		RecoverKeyFromSharesCmd(),
+	)
+	return cmd
+}
+
+// AddKeyWithSharesCmd returns the command creating the key without the mnemonic and splitting it into
+// the recovery shares.
+func AddKeyWithSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-with-shares [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add the key recoverable from the threshold of the shares instead of the mnemonic",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the new secp256k1 key, store it in the keyring and split it into the recovery shares using the
+Shamir's secret sharing. Any threshold number of the shares recovers the key, while fewer shares reveal nothing about it.
+The key has no mnemonic, so the shares should be handed to different custodians and the key can't be recovered once
+more than shares-threshold of them are lost.
+
+Example:
+$ %s keys add-with-shares issuer --shares 5 --threshold 3
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sharesCount, err := cmd.Flags().GetInt(FlagShares)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			threshold, err := cmd.Flags().GetInt(FlagThreshold)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			name := args[0]
+			if _, err := clientCtx.Keyring.Key(name); err == nil {
+				return errors.Errorf("key %q exists already", name)
+			}
+
+			privKey := secp256k1.GenPrivKey()
+			shares, err := shamir.Split(privKey.Key, sharesCount, threshold)
+			if err != nil {
+				return err
+			}
+			info, err := importPrivKey(clientCtx.Keyring, name, privKey)
+			if err != nil {
+				return err
+			}
+
+			keyOutput, err := keyring.MkAccKeyOutput(info)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			out := KeyWithShares{
+				KeyOutput: keyOutput,
+				Threshold: threshold,
+			}
+			for _, share := range shares {
+				out.Shares = append(out.Shares, encodeShare(threshold, share))
+			}
+
+			return printKeyWithShares(cmd, out)
+		},
+	}
+
+	cmd.Flags().Int(FlagShares, 3, "Number of the recovery shares")
+	cmd.Flags().Int(FlagThreshold, 2, "Number of the shares required to recover the key")
+
+	return cmd
+}
+
+// RecoverKeyFromSharesCmd returns the command recovering the key from the shares.
+func RecoverKeyFromSharesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-from-shares [name]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Recover the key from the threshold of the shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Recover the key from the shares created by the add-with-shares command and store it in the keyring.
+The shares are read from the input one per line until the threshold is reached. Verify the address of the recovered key
+matches the one printed when the key was created.
+
+Example:
+$ %s keys recover-from-shares issuer
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			if _, err := clientCtx.Keyring.Key(name); err == nil {
+				return errors.Errorf("key %q exists already", name)
+			}
+
+			buf := bufio.NewReader(cmd.InOrStdin())
+			var shares [][]byte
+			threshold := 1
+			for len(shares) < threshold {
+				line, err := input.GetString(fmt.Sprintf("Enter share %d:", len(shares)+1), buf)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				shareThreshold, share, err := decodeShare(line)
+				if err != nil {
+					return err
+				}
+				if len(shares) > 0 && shareThreshold != threshold {
+					return errors.Errorf("share belongs to the key with threshold %d, expected %d", shareThreshold, threshold)
+				}
+				threshold = shareThreshold
+				shares = append(shares, share)
+			}
+
+			secret, err := shamir.Combine(shares)
+			if err != nil {
+				return err
+			}
+			if len(secret) != secp256k1.PrivKeySize {
+				return errors.Errorf("invalid length of the recovered key %d", len(secret))
+			}
+			info, err := importPrivKey(clientCtx.Keyring, name, &secp256k1.PrivKey{Key: secret})
+			if err != nil {
+				return err
+			}
+
+			keyOutput, err := keyring.MkAccKeyOutput(info)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return printKeyWithShares(cmd, KeyWithShares{KeyOutput: keyOutput})
+		},
+	}
+
+	return cmd
+}
+
+// importPrivKey stores the private key in the keyring. The keyring accepts the armored keys only, so the key is
+// armored with the ephemeral passphrase.
+func importPrivKey(kr keyring.Keyring, name string, privKey *secp256k1.PrivKey) (keyring.Info, error) {
+	const passphrase = "import"
+	if err := kr.ImportPrivKey(name, crypto.EncryptArmorPrivKey(privKey, passphrase, string(hd.Secp256k1Type)), passphrase); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	info, err := kr.Key(name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return info, nil
+}
+
+// encodeShare encodes the share together with the threshold, so the recovery knows how many shares are required.
+func encodeShare(threshold int, share []byte) string {
+	return hex.EncodeToString(append([]byte{byte(threshold)}, share...))
+}
+
+func decodeShare(encoded string) (int, []byte, error) {
+	bz, err := hex.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid share encoding")
+	}
+	if len(bz) < 3 || bz[0] < 2 {
+		return 0, nil, errors.New("invalid share")
+	}
+	return int(bz[0]), bz[1:], nil
+}
+
+func printKeyWithShares(cmd *cobra.Command, out KeyWithShares) error {
+	outputFormat, err := cmd.Flags().GetString(cli.OutputFlag)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	switch outputFormat {
+	case keys.OutputFormatText:
+		cmd.Printf("name: %s\naddress: %s\npubkey: '%s'\n", out.Name, out.Address, out.PubKey)
+		if len(out.Shares) > 0 {
+			fmt.Fprintf(cmd.ErrOrStderr(), "\n**Important** hand the shares to different custodians, any %d of them recover the key.\n", out.Threshold)
+			fmt.Fprintln(cmd.ErrOrStderr(), "The key has no mnemonic, it can't be recovered if too many shares are lost.")
+			fmt.Fprintln(cmd.ErrOrStderr(), "")
+			for i, share := range out.Shares {
+				fmt.Fprintf(cmd.ErrOrStderr(), "share %d: %s\n", i+1, share)
+			}
+		}
+	case keys.OutputFormatJSON:
+		bz, err := json.Marshal(out)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		cmd.Println(string(bz))
+	default:
+		return errors.Errorf("invalid output format %s", outputFormat)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package cosmoscmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+func TestKeysWithShares(t *testing.T) {
+	requireT := require.New(t)
+
+	run := func(home, in string, args ...string) (string, error) {
+		cmd := keysCommand(t.TempDir())
+		out := &bytes.Buffer{}
+		cmd.SetIn(strings.NewReader(in))
+		cmd.SetOut(out)
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+			fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, "test"),
+			fmt.Sprintf("--%s=%s", cli.OutputFlag, "json"),
+		))
+		err := cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &client.Context{}))
+		return out.String(), err
+	}
+
+	home := t.TempDir()
+	out, err := run(home, "", "add-with-shares", "key1", "--shares=3", "--threshold=2")
+	requireT.NoError(err)
+	var created KeyWithShares
+	requireT.NoError(json.Unmarshal([]byte(out), &created))
+	requireT.Equal("key1", created.Name)
+	requireT.Equal(2, created.Threshold)
+	requireT.Len(created.Shares, 3)
+
+	// the key can't be overwritten
+	_, err = run(home, "", "add-with-shares", "key1")
+	requireT.Error(err)
+
+	// any threshold number of the shares recovers the key on another machine
+	recoveryHome := t.TempDir()
+	out, err = run(recoveryHome, created.Shares[2]+"\n"+created.Shares[0]+"\n", "recover-from-shares", "key2")
+	requireT.NoError(err)
+	var recovered KeyWithShares
+	requireT.NoError(json.Unmarshal([]byte(out), &recovered))
+	requireT.Equal("key2", recovered.Name)
+	requireT.Equal(created.Address, recovered.Address)
+	requireT.Equal(created.PubKey, recovered.PubKey)
+	requireT.Empty(recovered.Shares)
+
+	// the same share can't be used twice
+	_, err = run(t.TempDir(), created.Shares[1]+"\n"+created.Shares[1]+"\n", "recover-from-shares", "key3")
+	requireT.Error(err)
+
+	// the invalid share is rejected
+	_, err = run(t.TempDir(), "invalid\n", "recover-from-shares", "key3")
+	requireT.Error(err)
+}
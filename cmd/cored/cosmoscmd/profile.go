@@ -0,0 +1,35 @@
+package cosmoscmd
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/nodeprofile"
+)
+
+// addProfileFlags extends the start command with the option to configure the node using one of the predefined
+// profiles. The profile is applied on top of the config.toml and app.toml, the flags set explicitly take precedence.
+func addProfileFlags(startCmd *cobra.Command) {
+	preRun := startCmd.PreRunE
+	startCmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRun != nil {
+			if err := preRun(cmd, args); err != nil {
+				return err
+			}
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		return nodeprofile.Apply(serverCtx.Viper, serverCtx.Config, app.ChosenNetwork.Denom(), func(flag string) bool {
+			return cmd.Flags().Changed(flag)
+		})
+	}
+
+	startCmd.Flags().String(
+		nodeprofile.FlagProfile,
+		"",
+		fmt.Sprintf("Node configuration profile setting pruning, state sync snapshots, indexing and minimum gas prices, one of %v", nodeprofile.Profiles()),
+	)
+}
@@ -15,7 +15,6 @@ import (
 	clientconfig "github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -39,6 +38,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/app"
 	"github.com/CoreumFoundation/coreum/pkg/config"
+	deterministicgascli "github.com/CoreumFoundation/coreum/x/deterministicgas/client/cli"
 )
 
 type (
@@ -187,6 +187,7 @@ func initRootCmd(
 		a.appExport,
 		func(cmd *cobra.Command) {
 			addModuleInitFlags(cmd)
+			addProfileFlags(cmd)
 
 			if options.startCmdCustomizer != nil {
 				options.startCmdCustomizer(cmd)
@@ -194,12 +195,18 @@ func initRootCmd(
 		},
 	)
 
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			addModuleExportFlags(cmd, a)
+		}
+	}
+
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		queryCommand(moduleBasics),
 		txCommand(moduleBasics),
-		keys.Commands(defaultNodeHome),
+		keysCommand(defaultNodeHome),
 	)
 
 	// add user given sub commands.
@@ -225,6 +232,8 @@ func queryCommand(moduleBasics module.BasicManager) *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		ErrorCodesCmd(),
+		deterministicgascli.GetQueryCmd(),
 	)
 
 	moduleBasics.AddQueryCommands(cmd)
@@ -252,6 +261,7 @@ func txCommand(moduleBasics module.BasicManager) *cobra.Command {
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
+		EstimateFeeCmd(),
 	)
 
 	moduleBasics.AddTxCommands(cmd)
@@ -403,9 +413,25 @@ func initAppConfig() (string, interface{}) {
 		MemoryCacheSize uint32
 	}
 
+	// DenomIndexConfig defines configuration for the index of the transactions involving the fungible tokens.
+	type DenomIndexConfig struct {
+		// Enable enables the index
+		Enable bool
+	}
+
+	// DenomLedgerConfig defines configuration for the ledger of the balance changes of the chosen denoms.
+	type DenomLedgerConfig struct {
+		// Enable enables the ledger
+		Enable bool
+		// Denoms are the denoms recorded by the ledger
+		Denoms []string
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config
-		WASM WASMConfig
+		WASM        WASMConfig
+		DenomIndex  DenomIndexConfig
+		DenomLedger DenomLedgerConfig
 	}
 
 	defaultWasmConfig := wasm.DefaultWasmConfig()
@@ -424,6 +450,20 @@ query_gas_limit = {{ .WASM.QueryGasLimit }}
 # This defines the memory size for Wasm modules that we can keep cached to speed-up instantiation
 # The value is in MiB not bytes
 memory_cache_size = {{ .WASM.MemoryCacheSize }}
+
+[denom-index]
+# Enables the node-level index of the transactions involving the fungible tokens, served by the
+# /coreum/denomindex/v1/denom/{denom}/txs query. The index is stored in the data/denom_index.db directory.
+# The index is built from the blocks processed after it is enabled.
+enable = {{ .DenomIndex.Enable }}
+
+[denom-ledger]
+# Enables the node-level ledger recording the balance changes of the denoms in the double-entry form, served by the
+# /coreum/denomledger/v1/denom/{denom}/entries query. The ledger is stored in the data/denom_ledger.db directory.
+# The ledger is built from the blocks processed after it is enabled.
+enable = {{ .DenomLedger.Enable }}
+# The denoms recorded by the ledger, e.g. ["ucore", "abc-devcore1..."].
+denoms = [{{ range $i, $denom := .DenomLedger.Denoms }}{{ if $i }}, {{ end }}"{{ $denom }}"{{ end }}]
 `
 
 	return customAppTemplate, customAppConfig
@@ -0,0 +1,45 @@
+// Package main contains the tool generating the list of the errors returned by the coreum modules.
+//
+// Usage:
+//
+//	error-codes --out docs/static/errors.json
+//
+// See docs/chain/errors.md for the description of the list.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/errorcodes"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	out := flag.String("out", "", "The file the list is written to, if empty the list is printed to stdout")
+	flag.Parse()
+
+	descriptors, err := errorcodes.BuildDescriptors(errorcodes.Registry())
+	if err != nil {
+		return err
+	}
+	data, err := errorcodes.MarshalDescriptors(descriptors)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(*out, data, 0o644))
+}
@@ -0,0 +1,45 @@
+// Package main contains the tool generating the schema of the typed events emitted by the coreum modules.
+//
+// Usage:
+//
+//	event-schema --out docs/static/events.json
+//
+// See docs/chain/events.md for the description of the schema.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/events"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	out := flag.String("out", "", "The file the schema is written to, if empty the schema is printed to stdout")
+	flag.Parse()
+
+	schema, err := events.BuildSchema(events.Registry())
+	if err != nil {
+		return err
+	}
+	data, err := events.MarshalSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(*out, data, 0o644))
+}
@@ -0,0 +1,60 @@
+// Package main contains the tool reporting the size and the deterministic gas of the messages.
+//
+// Usage:
+//
+//	gas-report --chain-id coreum-mainnet-1
+//
+// See docs/chain/tx-limits.md for the description of the report.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/pkg/gasreport"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	chainID := flag.String("chain-id", string(constant.ChainIDMain), "The network chain ID the deterministic gas is taken from")
+	txSizeCostPerByte := flag.Uint64("tx-size-cost-per-byte", authtypes.DefaultTxSizeCostPerByte, "The gas charged for each byte of the transaction")
+	out := flag.String("out", "", "The file the report is written to, if empty the report is printed to stdout")
+	flag.Parse()
+
+	network, err := config.NetworkByChainID(constant.ChainID(*chainID))
+	if err != nil {
+		return err
+	}
+	network.SetSDKConfig()
+
+	msgs, err := gasreport.Samples()
+	if err != nil {
+		return err
+	}
+	entries, err := gasreport.Build(network.DeterministicGas(), *txSizeCostPerByte, msgs)
+	if err != nil {
+		return err
+	}
+	data, err := gasreport.MarshalEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(*out, data, 0o644))
+}
@@ -0,0 +1,304 @@
+// Package main contains the tool combining the swagger specs generated from the proto files of the modules into the
+// single OpenAPI spec served by the node.
+//
+// Usage:
+//
+//	openapi-combine --swagger-dir build/swagger --out docs/static/openapi.yml
+//
+// The tool is run by `make proto-gen-openapi`, see docs/chain/openapi.md.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const swaggerFileSuffix = ".swagger.json"
+
+type spec struct {
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]json.RawMessage            `json:"definitions"`
+}
+
+// combinedSpec holds the operations and the definitions converted to YAML nodes, so the order of their fields
+// generated by protoc-gen-swagger is kept.
+type combinedSpec struct {
+	Paths       map[string]map[string]*yaml.Node
+	Definitions map[string]*yaml.Node
+}
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	swaggerDir := flag.String("swagger-dir", "", "The dir containing the swagger specs generated from the proto files")
+	out := flag.String("out", "", "The file the spec is written to, if empty the spec is printed to stdout")
+	flag.Parse()
+
+	if *swaggerDir == "" {
+		return errors.New("swagger dir must be set")
+	}
+
+	files, err := listSwaggerFiles(*swaggerDir)
+	if err != nil {
+		return err
+	}
+	combined, err := combine(*swaggerDir, files)
+	if err != nil {
+		return err
+	}
+	data, err := marshal(combined)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(*out, data, 0o644))
+}
+
+// listSwaggerFiles returns the swagger specs stored in the dir, relative to the dir. The layout of the dir follows
+// the proto packages, e.g. coreum/asset/ft/v1/query.swagger.json.
+func listSwaggerFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, swaggerFileSuffix) {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(name))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// combine merges the paths and the definitions of the specs. The operation IDs generated by protoc-gen-swagger are
+// unique within the proto package only, so they are prefixed by the package name derived from the path of the spec.
+func combine(dir string, files []string) (combinedSpec, error) {
+	combined := combinedSpec{
+		Paths:       map[string]map[string]*yaml.Node{},
+		Definitions: map[string]*yaml.Node{},
+	}
+	operationIDs := map[string]string{}
+	for _, file := range files {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			return combinedSpec{}, errors.WithStack(err)
+		}
+		var s spec
+		if err := json.Unmarshal(data, &s); err != nil {
+			return combinedSpec{}, errors.Wrapf(err, "decoding swagger spec %s failed", file)
+		}
+
+		prefix := operationIDPrefix(file)
+		for path, operations := range s.Paths {
+			if _, exists := combined.Paths[path]; !exists {
+				combined.Paths[path] = map[string]*yaml.Node{}
+			}
+			for method, rawOperation := range operations {
+				if _, exists := combined.Paths[path][method]; exists {
+					return combinedSpec{}, errors.Errorf("operation %s %s is defined twice, second time in %s", method, path, file)
+				}
+				operation, err := jsonToNode(rawOperation)
+				if err != nil {
+					return combinedSpec{}, errors.Wrapf(err, "decoding operation %s %s of %s failed", method, path, file)
+				}
+				// the tags group the operations by the service name, which is Query for all the modules
+				deleteKey(operation, "tags")
+				if id := mappingValue(operation, "operationId"); id != nil {
+					id.Value = prefix + id.Value
+					if previous, exists := operationIDs[id.Value]; exists {
+						return combinedSpec{}, errors.Errorf("operation ID %s is used by %s and %s", id.Value, previous, file)
+					}
+					operationIDs[id.Value] = file
+				}
+				combined.Paths[path][method] = operation
+			}
+		}
+		// the definitions are named by the full proto names, so the same name means the same message
+		for name, rawDefinition := range s.Definitions {
+			definition, err := jsonToNode(rawDefinition)
+			if err != nil {
+				return combinedSpec{}, errors.Wrapf(err, "decoding definition %s of %s failed", name, file)
+			}
+			combined.Definitions[name] = definition
+		}
+	}
+
+	return combined, nil
+}
+
+// operationIDPrefix returns the prefix of the operation IDs of the spec, e.g. CoreumAssetFtV1 for
+// coreum/asset/ft/v1/query.swagger.json.
+func operationIDPrefix(file string) string {
+	var prefix strings.Builder
+	for _, part := range strings.FieldsFunc(filepath.Dir(file), func(r rune) bool {
+		return r == '/' || r == '_'
+	}) {
+		upper := true
+		for _, r := range part {
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			prefix.WriteRune(r)
+			upper = unicode.IsDigit(r)
+		}
+	}
+	return prefix.String()
+}
+
+func marshal(s combinedSpec) ([]byte, error) {
+	root := mappingNode()
+	appendPair(root, "swagger", stringNode("2.0"))
+	info := mappingNode()
+	appendPair(info, "title", stringNode("HTTP API Console"))
+	appendPair(info, "name", stringNode(""))
+	appendPair(info, "description", stringNode(""))
+	appendPair(root, "info", info)
+
+	paths := mappingNode()
+	for _, path := range sortedKeys(s.Paths) {
+		operations := mappingNode()
+		for _, method := range sortedKeys(s.Paths[path]) {
+			appendPair(operations, method, s.Paths[path][method])
+		}
+		appendPair(paths, path, operations)
+	}
+	appendPair(root, "paths", paths)
+
+	definitions := mappingNode()
+	for _, name := range sortedKeys(s.Definitions) {
+		appendPair(definitions, name, s.Definitions[name])
+	}
+	appendPair(root, "definitions", definitions)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonToNode converts the JSON value to the YAML node keeping the order of the object keys.
+func jsonToNode(data []byte) (*yaml.Node, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decodeNode(decoder)
+}
+
+func decodeNode(decoder *json.Decoder) (*yaml.Node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		if value == '{' {
+			node := mappingNode()
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, errors.WithStack(err)
+				}
+				child, err := decodeNode(decoder)
+				if err != nil {
+					return nil, err
+				}
+				appendPair(node, key.(string), child)
+			}
+			_, err := decoder.Token()
+			return node, errors.WithStack(err)
+		}
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		for decoder.More() {
+			child, err := decodeNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+		_, err := decoder.Token()
+		return node, errors.WithStack(err)
+	case string:
+		return stringNode(value), nil
+	case json.Number:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: value.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(value)}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
+
+func mappingNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+}
+
+func stringNode(value string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+	if strings.Contains(value, "\n") {
+		node.Style = yaml.LiteralStyle
+	}
+	return node
+}
+
+func appendPair(node *yaml.Node, key string, value *yaml.Node) {
+	node.Content = append(node.Content, stringNode(key), value)
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func deleteKey(node *yaml.Node, key string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,65 @@
+// Package main contains the tool generating the FileDescriptorSet of the proto files of the coreum modules and all
+// their dependencies. The set is attached to the release, so the clients written in other languages may generate the
+// typed clients without the proto sources.
+//
+// Usage:
+//
+//	proto-descriptors --version v1.0.0 --out-dir build
+//
+// See docs/chain/proto-descriptors.md for the description of the set.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+
+	// registers the proto files of all the modules used by the chain
+	_ "github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/protoset"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	protoDir := flag.String("proto-dir", "proto", "The dir containing the proto files of the coreum modules")
+	ver := flag.String("version", version.Version, "The version of the chain the set is generated for")
+	outDir := flag.String("out-dir", "", "The dir the set is written to, if empty the set is printed to stdout")
+	flag.Parse()
+
+	if *ver == "" {
+		return errors.New("version must be set")
+	}
+
+	files, err := protoset.ListFiles(*protoDir)
+	if err != nil {
+		return err
+	}
+	set, err := protoset.Build(files)
+	if err != nil {
+		return err
+	}
+	data, err := protoset.Marshal(set)
+	if err != nil {
+		return err
+	}
+
+	if *outDir == "" {
+		_, err = os.Stdout.Write(data)
+		return errors.WithStack(err)
+	}
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		return errors.WithStack(err)
+	}
+	out := filepath.Join(*outDir, fmt.Sprintf("coreum-proto-descriptors-%s.binpb", *ver))
+	return errors.WithStack(os.WriteFile(out, data, 0o644))
+}
@@ -0,0 +1,82 @@
+// Package main contains the tool decoding the asset module state changes written by the file streaming service.
+//
+// Usage:
+//
+//	stream-decoder --chain-id coreum-mainnet-1 <write_dir>/block-100-tx-0 <write_dir>/block-100-end
+//
+// Each decoded state change is printed as a single JSON line, see docs/chain/state-streaming.md for the schema. The
+// records which can't be decoded are reported to stderr and skipped.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/pkg/streaming"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	chainID := flag.String("chain-id", string(constant.ChainIDMain), "The network chain ID used to encode addresses")
+	flag.Parse()
+
+	network, err := config.NetworkByChainID(constant.ChainID(*chainID))
+	if err != nil {
+		return err
+	}
+	network.SetSDKConfig()
+
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	decoder := streaming.NewDecoder(cdc)
+	encoder := json.NewEncoder(os.Stdout)
+	for _, path := range flag.Args() {
+		if err := decodeFile(path, cdc, decoder, encoder); err != nil {
+			return errors.Wrapf(err, "can't decode file %s", path)
+		}
+	}
+
+	return nil
+}
+
+func decodeFile(path string, cdc codec.Codec, decoder streaming.Decoder, encoder *json.Encoder) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+
+	pairs, err := streaming.ReadStoreKVPairs(cdc, f)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		change, ok, err := decoder.Decode(pair)
+		if err != nil {
+			// the malformed record is reported, so the rest of the changes are still decoded
+			fmt.Fprintln(os.Stderr, errors.Wrapf(err, "file %s", path))
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err := encoder.Encode(change); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+package integrationtests
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pkg/errors"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"go.uber.org/zap"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+)
+
+const artifactsTimeout = 10 * time.Second
+
+var (
+	// heightRegex matches the height reported by the node logs both in the console and the JSON format.
+	heightRegex = regexp.MustCompile(`"?height"?[=:]\s*"?(\d+)`)
+	// testNameRegex matches the characters of the test name which are not allowed in the directory name.
+	testNameRegex = regexp.MustCompile(`[^a-zA-Z0-9_\-.]+`)
+)
+
+// txRecord is the record of the transaction broadcast by the test.
+type txRecord struct {
+	Hash      string            `json:"hash"`
+	Height    int64             `json:"height,omitempty"`
+	Code      uint32            `json:"code"`
+	Codespace string            `json:"codespace,omitempty"`
+	GasWanted int64             `json:"gas_wanted,omitempty"`
+	GasUsed   int64             `json:"gas_used,omitempty"`
+	Log       string            `json:"log,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+
+	txBytes []byte
+}
+
+// txRecorder collects the transactions broadcast by the test.
+type txRecorder struct {
+	mu  sync.Mutex
+	txs []txRecord
+}
+
+func (r *txRecorder) add(ctx context.Context, record txRecord) {
+	logger.Get(ctx).Debug("Transaction broadcast",
+		zap.String("hash", record.Hash),
+		zap.Int64("height", record.Height),
+		zap.Uint32("code", record.Code),
+		zap.Int64("gasUsed", record.GasUsed),
+	)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.txs = append(r.txs, record)
+}
+
+func (r *txRecorder) records() []txRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]txRecord{}, r.txs...)
+}
+
+// recordingClient is the Tendermint RPC client recording the transactions broadcast through it.
+type recordingClient struct {
+	rpcclient.Client
+	recorder *txRecorder
+}
+
+// BroadcastTxCommit broadcasts the transaction and records it together with its result.
+func (c recordingClient) BroadcastTxCommit(ctx context.Context, txBytes tmtypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	res, err := c.Client.BroadcastTxCommit(ctx, txBytes)
+
+	record := newTxRecord(txBytes, err)
+	switch {
+	case res == nil:
+	case res.CheckTx.Code != 0:
+		record.Code = res.CheckTx.Code
+		record.Codespace = res.CheckTx.Codespace
+		record.Log = res.CheckTx.Log
+	default:
+		record.Height = res.Height
+		record.Code = res.DeliverTx.Code
+		record.Codespace = res.DeliverTx.Codespace
+		record.Log = res.DeliverTx.Log
+		record.GasWanted = res.DeliverTx.GasWanted
+		record.GasUsed = res.DeliverTx.GasUsed
+	}
+	c.recorder.add(ctx, record)
+
+	return res, err
+}
+
+// BroadcastTxSync broadcasts the transaction and records it together with the result of the check.
+func (c recordingClient) BroadcastTxSync(ctx context.Context, txBytes tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res, err := c.Client.BroadcastTxSync(ctx, txBytes)
+	c.recorder.add(ctx, newBroadcastTxRecord(txBytes, res, err))
+	return res, err
+}
+
+// BroadcastTxAsync broadcasts the transaction and records it.
+func (c recordingClient) BroadcastTxAsync(ctx context.Context, txBytes tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	res, err := c.Client.BroadcastTxAsync(ctx, txBytes)
+	c.recorder.add(ctx, newBroadcastTxRecord(txBytes, res, err))
+	return res, err
+}
+
+func newTxRecord(txBytes tmtypes.Tx, err error) txRecord {
+	record := txRecord{
+		Hash:    fmt.Sprintf("%X", txBytes.Hash()),
+		txBytes: txBytes,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	return record
+}
+
+func newBroadcastTxRecord(txBytes tmtypes.Tx, res *coretypes.ResultBroadcastTx, err error) txRecord {
+	record := newTxRecord(txBytes, err)
+	if res != nil {
+		record.Code = res.Code
+		record.Codespace = res.Codespace
+		record.Log = res.Log
+	}
+	return record
+}
+
+// testArtifacts stores the artifacts of the failed test.
+type testArtifacts struct {
+	recorder  *txRecorder
+	clientCtx tx.ClientContext
+}
+
+// newTestArtifacts wraps the RPC client of the client context to record the broadcast transactions and registers
+// the cleanup function dumping the artifacts if the test fails. It returns the client context to be used by the test.
+func newTestArtifacts(t *testing.T, clientCtx tx.ClientContext) tx.ClientContext {
+	recorder := &txRecorder{}
+	clientCtx = clientCtx.WithClient(recordingClient{
+		Client:   clientCtx.Client(),
+		recorder: recorder,
+	})
+
+	artifacts := testArtifacts{
+		recorder:  recorder,
+		clientCtx: clientCtx,
+	}
+	t.Cleanup(func() {
+		if !t.Failed() {
+			return
+		}
+		dir, err := artifacts.dump(t.Name())
+		if err != nil {
+			t.Logf("Storing artifacts of the failed test failed: %s", err)
+			return
+		}
+		t.Logf("Artifacts of the failed test stored in %s", dir)
+	})
+
+	return clientCtx
+}
+
+// dump stores the broadcast transactions and the node logs covering their heights in the artifacts directory
+// of the test.
+func (a testArtifacts) dump(testName string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), artifactsTimeout)
+	defer cancel()
+
+	dir := filepath.Join(cfg.ArtifactsDir, testNameRegex.ReplaceAllString(testName, "_"))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	records := a.recorder.records()
+	cdc := codec.NewProtoCodec(a.clientCtx.InterfaceRegistry())
+	var minHeight, maxHeight int64
+	for i := range records {
+		a.complete(ctx, cdc, &records[i])
+		if height := records[i].Height; height > 0 {
+			if minHeight == 0 || height < minHeight {
+				minHeight = height
+			}
+			if height > maxHeight {
+				maxHeight = height
+			}
+		}
+	}
+
+	bz, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "txs.json"), bz, 0o600); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	if cfg.NodeLogFile != "" && maxHeight > 0 {
+		if err := copyNodeLogs(cfg.NodeLogFile, filepath.Join(dir, "node.log"), minHeight, maxHeight); err != nil {
+			return "", err
+		}
+	}
+
+	return dir, nil
+}
+
+// complete decodes the messages of the transaction and, if the transaction hasn't been broadcast in the block mode,
+// queries its result.
+func (a testArtifacts) complete(ctx context.Context, cdc codec.JSONCodec, record *txRecord) {
+	if decodedTx, err := a.clientCtx.TxConfig().TxDecoder()(record.txBytes); err == nil {
+		for _, msg := range decodedTx.GetMsgs() {
+			msgJSON, err := cdc.MarshalInterfaceJSON(msg)
+			if err != nil {
+				continue
+			}
+			record.Messages = append(record.Messages, msgJSON)
+		}
+	}
+
+	if record.Height > 0 || record.Error != "" || record.Code != 0 {
+		return
+	}
+	hash, err := hex.DecodeString(record.Hash)
+	if err != nil {
+		return
+	}
+	res, err := a.clientCtx.Client().Tx(ctx, hash, false)
+	if err != nil {
+		return
+	}
+	record.Height = res.Height
+	record.Code = res.TxResult.Code
+	record.Codespace = res.TxResult.Codespace
+	record.Log = res.TxResult.Log
+	record.GasWanted = res.TxResult.GasWanted
+	record.GasUsed = res.TxResult.GasUsed
+}
+
+// copyNodeLogs copies the lines of the node logs produced between the heights. The lines not reporting the height
+// are assigned to the last reported one.
+func copyNodeLogs(srcFile, dstFile string, minHeight, maxHeight int64) error {
+	src, err := os.Open(srcFile)
+	if err != nil {
+		return errors.Wrapf(err, "can't open the node log file %s", srcFile)
+	}
+	defer src.Close()
+
+	dst, err := os.Create(dstFile)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer dst.Close()
+
+	writer := bufio.NewWriter(dst)
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var height int64
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := heightRegex.FindStringSubmatch(line); match != nil {
+			if h, err := strconv.ParseInt(match[1], 10, 64); err == nil {
+				height = h
+			}
+		}
+		if height > maxHeight {
+			break
+		}
+		if height < minHeight {
+			continue
+		}
+		if _, err := writer.WriteString(strings.TrimRight(line, "\r") + "\n"); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrapf(err, "can't read the node log file %s", srcFile)
+	}
+
+	return errors.WithStack(writer.Flush())
+}
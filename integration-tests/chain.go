@@ -1,19 +1,24 @@
 package integrationtests
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
+	"testing"
 
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/CoreumFoundation/coreum/app"
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	"github.com/CoreumFoundation/coreum/pkg/tx"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
 // ChainContext is a types used to store the components required for the test chains subcomponents.
@@ -90,6 +95,7 @@ func (c ChainContext) DeterministicGas() config.DeterministicGasRequirements {
 }
 
 // GasLimitByMsgs calculates sum of gas limits required for message types passed.
+// The gas charged for the features of the fungible tokens sent by the bank messages is included.
 // It panics if unsupported message type specified.
 func (c ChainContext) GasLimitByMsgs(msgs ...sdk.Msg) uint64 {
 	deterministicGas := c.NetworkConfig.Fee.DeterministicGas
@@ -99,7 +105,7 @@ func (c ChainContext) GasLimitByMsgs(msgs ...sdk.Msg) uint64 {
 		if !exists {
 			panic(errors.Errorf("unsuported message type for deterministic gas: %v", reflect.TypeOf(msg).String()))
 		}
-		totalGasRequired += msgGas + deterministicGas.FixedGas
+		totalGasRequired += msgGas + c.ftSendFeatureGas(msg) + deterministicGas.FixedGas
 	}
 
 	return totalGasRequired
@@ -115,12 +121,57 @@ func (c ChainContext) GasLimitByMultiSendMsgs(msgs ...sdk.Msg) uint64 {
 		if !exists {
 			panic(errors.Errorf("unsuported message type for deterministic gas: %v", reflect.TypeOf(msg).String()))
 		}
-		totalGasRequired += msgGas
+		totalGasRequired += msgGas + c.ftSendFeatureGas(msg)
 	}
 
 	return totalGasRequired + deterministicGas.FixedGas
 }
 
+// AllFTSendFeaturesGas returns the gas charged for the fungible token sent if all of its features are enabled.
+func (c ChainContext) AllFTSendFeaturesGas() uint64 {
+	var gas uint64
+	for _, featureGas := range c.NetworkConfig.Fee.DeterministicGas.AssetFTSendFeatureGas() {
+		gas += featureGas
+	}
+	return gas
+}
+
+// ftSendFeatureGas returns the gas charged for the features of the fungible tokens sent by the bank message. The
+// features of the tokens are not known without querying the chain, so all the features are assumed to be enabled.
+func (c ChainContext) ftSendFeatureGas(msg sdk.Msg) uint64 {
+	var gas uint64
+	for _, coin := range bankMsgCoins(msg) {
+		if _, _, err := assetfttypes.DeconstructDenom(coin.Denom); err == nil {
+			gas += c.AllFTSendFeaturesGas()
+		}
+	}
+	return gas
+}
+
+func isBankMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *banktypes.MsgSend, *banktypes.MsgMultiSend:
+		return true
+	default:
+		return false
+	}
+}
+
+func bankMsgCoins(msg sdk.Msg) sdk.Coins {
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		return m.Amount
+	case *banktypes.MsgMultiSend:
+		var coins sdk.Coins
+		for _, input := range m.Inputs {
+			coins = append(coins, input.Coins...)
+		}
+		return coins
+	default:
+		return nil
+	}
+}
+
 // BalancesOptions is the input type for the ComputeNeededBalanceFromOptions.
 type BalancesOptions struct {
 	Messages []sdk.Msg
@@ -144,6 +195,10 @@ func (c ChainContext) ComputeNeededBalanceFromOptions(options BalancesOptions) s
 	totalAmount := sdk.ZeroInt()
 	for _, msg := range options.Messages {
 		gas := c.GasLimitByMsgs(msg)
+		// the bank message without the coins is assumed to send the fungible token with all the features enabled
+		if isBankMsg(msg) && len(bankMsgCoins(msg)) == 0 {
+			gas += c.AllFTSendFeaturesGas()
+		}
 		// Ceil().RoundInt() is here to be compatible with the sdk's TxFactory
 		// https://github.com/cosmos/cosmos-sdk/blob/ff416ee63d32da5d520a8b2d16b00da762416146/client/tx/factory.go#L223
 		amt := options.GasPrice.Mul(sdk.NewDec(int64(gas))).Ceil().RoundInt()
@@ -153,9 +208,46 @@ func (c ChainContext) ComputeNeededBalanceFromOptions(options BalancesOptions) s
 	return totalAmount.Add(options.Amount)
 }
 
+// AssertDeterministicGas checks that the gas used by the transaction equals the deterministic gas required
+// by its messages. If it doesn't, the test fails with the report containing the gas breakdown per message.
+func (c ChainContext) AssertDeterministicGas(t *testing.T, res *sdk.TxResponse, msgs ...sdk.Msg) {
+	t.Helper()
+
+	deterministicGas := c.NetworkConfig.Fee.DeterministicGas
+	expectedGas := deterministicGas.FixedGas
+	report := &strings.Builder{}
+	fmt.Fprintf(report, "per message breakdown:\n  fixed gas: %d\n", deterministicGas.FixedGas)
+	for i, msg := range msgs {
+		msgGas, exists := deterministicGas.GasRequiredByMessage(msg)
+		if !exists {
+			t.Fatalf("message #%d %s is not deterministic", i, sdk.MsgTypeURL(msg))
+		}
+		expectedGas += msgGas
+		fmt.Fprintf(report, "  message #%d %s: %d\n", i, sdk.MsgTypeURL(msg), msgGas)
+	}
+
+	usedGas := uint64(res.GasUsed)
+	if usedGas == expectedGas {
+		return
+	}
+
+	diff := int64(usedGas) - int64(expectedGas)
+	t.Fatalf(
+		"gas used by the transaction %s deviates from the deterministic gas:\n  expected: %d\n  used: %d\n  diff: %+d (%+.2f%%)\n%s",
+		res.TxHash,
+		expectedGas,
+		usedGas,
+		diff,
+		float64(diff)*100/float64(expectedGas),
+		report.String(),
+	)
+}
+
 // ChainConfig defines the config arguments required for the test chain initialisation.
 type ChainConfig struct {
 	RPCAddress      string
+	APIAddress      string
+	GRPCAddresses   []string
 	NetworkConfig   config.NetworkConfig
 	FundingMnemonic string
 	StakerMnemonics []string
@@ -164,8 +256,10 @@ type ChainConfig struct {
 // Chain holds network and client for the blockchain
 type Chain struct {
 	ChainContext
-	Faucet     Faucet
-	Governance Governance
+	Faucet      Faucet
+	Governance  Governance
+	REST        RESTClient
+	Consistency ConsistencyChecker
 }
 
 // NewChain creates an instance of the new Chain.
@@ -189,5 +283,7 @@ func NewChain(cfg ChainConfig) Chain {
 		ChainContext: chainCtx,
 		Governance:   governance,
 		Faucet:       faucet,
+		REST:         NewRESTClient(cfg.APIAddress, clientCtx.InterfaceRegistry()),
+		Consistency:  NewConsistencyChecker(clientCtx, cfg.GRPCAddresses, clientCtx.InterfaceRegistry()),
 	}
 }
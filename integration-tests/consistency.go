@@ -0,0 +1,173 @@
+package integrationtests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// nodeSyncTimeout is the time the nodes are given to reach the height the state is compared at.
+const nodeSyncTimeout = 20 * time.Second
+
+// StateQuery queries the piece of the state from the node over the gRPC connection.
+type StateQuery func(ctx context.Context, conn grpc.ClientConnInterface) (proto.Message, error)
+
+// ConsistencyChecker queries the same state from all the nodes of the network directly over gRPC and asserts it is
+// equal at the same height. It catches the non-determinism of the keepers, like the map iteration or the usage of the
+// local time, which makes the nodes diverge without failing the transactions.
+type ConsistencyChecker struct {
+	clientCtx tx.ClientContext
+	addresses []string
+	conns     []*grpc.ClientConn
+	codec     codec.JSONCodec
+}
+
+// NewConsistencyChecker returns a new instance of the ConsistencyChecker. The client context is used to get the height
+// the state is compared at, the gRPC addresses are the nodes the state is queried from.
+func NewConsistencyChecker(
+	clientCtx tx.ClientContext,
+	grpcAddresses []string,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) ConsistencyChecker {
+	conns := make([]*grpc.ClientConn, 0, len(grpcAddresses))
+	for _, address := range grpcAddresses {
+		// the connection is established lazily, so the nodes aren't required until the state is checked
+		conn, err := grpc.Dial(address, grpc.WithInsecure())
+		if err != nil {
+			panic(errors.Wrapf(err, "can't create gRPC connection to %s", address))
+		}
+		conns = append(conns, conn)
+	}
+
+	return ConsistencyChecker{
+		clientCtx: clientCtx,
+		addresses: grpcAddresses,
+		conns:     conns,
+		codec:     codec.NewProtoCodec(interfaceRegistry),
+	}
+}
+
+// AssertConsistent runs the queries against all the nodes at the latest height of the node the transactions are
+// broadcast to and asserts the responses of all the nodes are equal. The check is skipped if less than two nodes
+// are configured.
+func (cc ConsistencyChecker) AssertConsistent(ctx context.Context, t *testing.T, queries ...StateQuery) {
+	if len(cc.conns) < 2 {
+		t.Log("State consistency check skipped, less than two gRPC nodes are configured")
+		return
+	}
+
+	requireT := require.New(t)
+	status, err := cc.clientCtx.Client().Status(ctx)
+	requireT.NoError(err)
+	height := status.SyncInfo.LatestBlockHeight
+
+	for i, conn := range cc.conns {
+		requireT.NoError(awaitHeight(ctx, conn, height), "node %s hasn't reached height %d", cc.addresses[i], height)
+	}
+
+	heightCtx := metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	for queryIndex, query := range queries {
+		var (
+			expected   proto.Message
+			expectedBz []byte
+		)
+		for i, conn := range cc.conns {
+			res, err := query(heightCtx, conn)
+			requireT.NoError(err, "query %d failed on node %s", queryIndex, cc.addresses[i])
+			bz, err := proto.Marshal(res)
+			requireT.NoError(err)
+
+			if expected == nil {
+				expected, expectedBz = res, bz
+				continue
+			}
+			requireT.Equal(
+				cc.mustMarshalJSON(expected), cc.mustMarshalJSON(res),
+				"query %d returned different state on nodes %s and %s at height %d",
+				queryIndex, cc.addresses[0], cc.addresses[i], height,
+			)
+			// the binary form is compared too, because the fields of the JSON form might be formatted the same way
+			requireT.Equal(expectedBz, bz, "query %d returned different binary state on nodes %s and %s at height %d",
+				queryIndex, cc.addresses[0], cc.addresses[i], height)
+		}
+	}
+}
+
+func (cc ConsistencyChecker) mustMarshalJSON(msg proto.Message) string {
+	bz, err := cc.codec.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+func awaitHeight(ctx context.Context, conn grpc.ClientConnInterface, height int64) error {
+	retryCtx, cancel := context.WithTimeout(ctx, nodeSyncTimeout)
+	defer cancel()
+
+	client := tmservice.NewServiceClient(conn)
+	return retry.Do(retryCtx, 200*time.Millisecond, func() error {
+		res, err := client.GetLatestBlock(retryCtx, &tmservice.GetLatestBlockRequest{})
+		if err != nil {
+			return retry.Retryable(errors.WithStack(err))
+		}
+		if res.Block.Header.Height < height {
+			return retry.Retryable(errors.Errorf("height %d hasn't been reached yet, current: %d", height, res.Block.Header.Height))
+		}
+		return nil
+	})
+}
+
+// FTStateQueries returns the queries of the state of the fungible token and the balances of the accounts holding it.
+func FTStateQueries(denom string, accounts ...sdk.AccAddress) []StateQuery {
+	queries := []StateQuery{
+		func(ctx context.Context, conn grpc.ClientConnInterface) (proto.Message, error) {
+			return assetfttypes.NewQueryClient(conn).Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+		},
+		func(ctx context.Context, conn grpc.ClientConnInterface) (proto.Message, error) {
+			return banktypes.NewQueryClient(conn).SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+		},
+	}
+	for _, account := range accounts {
+		account := account.String()
+		queries = append(queries,
+			func(ctx context.Context, conn grpc.ClientConnInterface) (proto.Message, error) {
+				return banktypes.NewQueryClient(conn).Balance(ctx, &banktypes.QueryBalanceRequest{
+					Address: account,
+					Denom:   denom,
+				})
+			},
+			func(ctx context.Context, conn grpc.ClientConnInterface) (proto.Message, error) {
+				return assetfttypes.NewQueryClient(conn).FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+					Account: account,
+					Denom:   denom,
+				})
+			},
+			func(ctx context.Context, conn grpc.ClientConnInterface) (proto.Message, error) {
+				return assetfttypes.NewQueryClient(conn).WhitelistedBalance(ctx, &assetfttypes.QueryWhitelistedBalanceRequest{
+					Account: account,
+					Denom:   denom,
+				})
+			},
+		)
+	}
+
+	return queries
+}
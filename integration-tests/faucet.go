@@ -2,11 +2,14 @@ package integrationtests
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
 	"github.com/CoreumFoundation/coreum/pkg/tx"
@@ -26,10 +29,40 @@ func NewFundedAccount(address sdk.AccAddress, amount sdk.Coin) FundedAccount {
 	}
 }
 
+// FaucetEmptyError is returned if the faucet doesn't hold enough funds to fund the accounts. The request is refused
+// before the transaction is broadcast, so the other requests funded in the same transaction are not affected.
+type FaucetEmptyError struct {
+	// Address is the address of the faucet.
+	Address sdk.AccAddress
+	// Balance is the remaining balance of the faucet not reserved by the other requests.
+	Balance sdk.Coins
+	// Required is the amount required to fund the accounts, including the fee of the funding transaction.
+	Required sdk.Coins
+}
+
+// TopUp returns the amount the faucet must be topped up with to fund the accounts.
+func (e FaucetEmptyError) TopUp() sdk.Coins {
+	topUp := sdk.NewCoins()
+	for _, coin := range e.Required {
+		if missing := coin.Amount.Sub(e.Balance.AmountOf(coin.Denom)); missing.IsPositive() {
+			topUp = topUp.Add(sdk.NewCoin(coin.Denom, missing))
+		}
+	}
+	return topUp
+}
+
+func (e FaucetEmptyError) Error() string {
+	return fmt.Sprintf(
+		"faucet %s is empty, balance: %s, required: %s, top up the faucet with at least %s",
+		e.Address, e.Balance, e.Required, e.TopUp(),
+	)
+}
+
 // Faucet is the test chain faucet.
 type Faucet struct {
 	chainCtx ChainContext
 	queue    chan fundingRequest
+	budget   *faucetBudget
 
 	// muCh is used to serve the same purpose as `sync.Mutex` to protect `fundingWallet` against being used
 	// to broadcast many transactions in parallel by different integration tests. The difference between this and `sync.Mutex`
@@ -42,6 +75,7 @@ func NewFaucet(chainCtx ChainContext) Faucet {
 	faucet := Faucet{
 		chainCtx: chainCtx,
 		queue:    make(chan fundingRequest),
+		budget:   &faucetBudget{},
 		muCh:     make(chan struct{}, 1),
 	}
 	faucet.muCh <- struct{}{}
@@ -54,7 +88,7 @@ type fundingRequest struct {
 }
 
 // FundAccounts funds the list of the received wallets.
-func (f Faucet) FundAccounts(ctx context.Context, accountsToFund ...FundedAccount) (retErr error) {
+func (f Faucet) FundAccounts(ctx context.Context, accountsToFund ...FundedAccount) error {
 	const maxAccountsPerRequest = 20
 
 	if len(accountsToFund) > maxAccountsPerRequest {
@@ -101,18 +135,77 @@ func (f Faucet) FundAccounts(ctx context.Context, accountsToFund ...FundedAccoun
 		return err
 	}
 
+	// After transaction is broadcasted we unlock `muCh` so another leader for next transaction might be selected
 	defer func() {
-		// After transaction is broadcasted we unlock `muCh` so another leader for next transaction might be selected
 		f.muCh <- struct{}{}
+	}()
 
-		// If leader got an error during broadcasting, that error is propagated to all the other participants.
+	// The requests the faucet can't afford are refused before the transaction is broadcast, so they don't fail
+	// the requests of the other participants.
+	accepted, err := f.reserveFunds(ctx, requests)
+	if err != nil {
 		for _, req := range requests {
-			req.FundedCh <- retErr
+			req.FundedCh <- err
 		}
-	}()
+		return <-req.FundedCh
+	}
+
+	// All accepted requests are collected, let's create messages and broadcast tx
+	if len(accepted) > 0 {
+		err = f.broadcastTx(ctx, f.collectMessages(accepted))
+		if err != nil {
+			// the funds spent by the failed transaction are unknown, so the balance is queried again
+			f.budget.invalidate()
+		}
+	}
+
+	// If leader got an error during broadcasting, that error is propagated to all the other participants.
+	for _, req := range accepted {
+		req.FundedCh <- err
+	}
+
+	// The leader's own request is either accepted or refused, in both cases the result is in its channel.
+	return <-req.FundedCh
+}
+
+// reserveFunds reserves the budget of the faucet for the requests and returns the accepted ones. The refused requests
+// get the FaucetEmptyError.
+func (f Faucet) reserveFunds(ctx context.Context, requests []fundingRequest) ([]fundingRequest, error) {
+	faucetAddress := f.chainCtx.ClientContext.FromAddress()
+	if err := f.budget.load(ctx, f.chainCtx.ClientContext, faucetAddress); err != nil {
+		return nil, err
+	}
+
+	accepted := make([]fundingRequest, 0, len(requests))
+	for _, req := range requests {
+		required := f.requiredFunds(req)
+		if ok, balance := f.budget.reserve(required); !ok {
+			emptyErr := FaucetEmptyError{
+				Address:  faucetAddress,
+				Balance:  balance,
+				Required: required,
+			}
+			logger.Get(ctx).Error("Funding request refused", zap.Error(emptyErr))
+			req.FundedCh <- emptyErr
+			continue
+		}
+		accepted = append(accepted, req)
+	}
+
+	return accepted, nil
+}
+
+// requiredFunds returns the funds required to fund the accounts of the request, including the fee paid for its
+// messages.
+func (f Faucet) requiredFunds(req fundingRequest) sdk.Coins {
+	gasLimit := uint64(len(req.AccountsToFund)) * f.chainCtx.GasLimitByMsgs(&banktypes.MsgSend{})
+	fee := f.chainCtx.NetworkConfig.Fee.FeeModel.Params().InitialGasPrice.MulInt64(int64(gasLimit)).Ceil().RoundInt()
 
-	// All requests are collected, let's create messages and broadcast tx
-	return f.broadcastTx(ctx, f.collectMessages(requests))
+	required := sdk.NewCoins(f.chainCtx.NewCoin(fee))
+	for _, acc := range req.AccountsToFund {
+		required = required.Add(acc.Amount)
+	}
+	return required
 }
 
 func (f Faucet) collectRequests(ctx context.Context, leaderReq fundingRequest) ([]fundingRequest, error) {
@@ -188,3 +281,84 @@ func (f Faucet) FundAccountsWithOptions(ctx context.Context, address sdk.AccAddr
 		Amount:  f.chainCtx.NewCoin(amount),
 	})
 }
+
+// TopUp sends the coins to the faucet from the account, e.g. the one funded in the bank genesis of the chain, and adds
+// them to the budget of the faucet. The account must be imported into the keyring of the chain and hold the fee.
+func (f Faucet) TopUp(ctx context.Context, from sdk.AccAddress, coins sdk.Coins) error {
+	msg := &banktypes.MsgSend{
+		FromAddress: from.String(),
+		ToAddress:   f.chainCtx.ClientContext.FromAddress().String(),
+		Amount:      coins,
+	}
+
+	return f.budget.topUp(coins, func() error {
+		_, err := tx.BroadcastTx(
+			ctx,
+			f.chainCtx.ClientContext.WithFromAddress(from),
+			f.chainCtx.TxFactory().WithGas(f.chainCtx.GasLimitByMsgs(msg)),
+			msg,
+		)
+		return err
+	})
+}
+
+// faucetBudget tracks the remaining balance of the faucet per denom. The balance is queried once and then decreased
+// by the funds reserved for the requests, so the requests the faucet can't afford are refused without broadcasting.
+type faucetBudget struct {
+	mu       sync.Mutex
+	loaded   bool
+	balances sdk.Coins
+}
+
+// load queries the balance of the faucet unless it is already known.
+func (b *faucetBudget) load(ctx context.Context, clientCtx tx.ClientContext, faucetAddress sdk.AccAddress) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.loaded {
+		return nil
+	}
+	res, err := banktypes.NewQueryClient(clientCtx).AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+		Address: faucetAddress.String(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "can't query the balance of the faucet %s", faucetAddress)
+	}
+	b.balances = res.Balances
+	b.loaded = true
+	return nil
+}
+
+// reserve deducts the required funds from the budget and returns true if the budget covers them. Otherwise, it
+// returns false together with the remaining budget.
+func (b *faucetBudget) reserve(required sdk.Coins) (bool, sdk.Coins) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.balances.IsAllGTE(required) {
+		return false, b.balances
+	}
+	b.balances = b.balances.Sub(required)
+	return true, b.balances
+}
+
+// topUp runs the transfer of the coins to the faucet and adds them to the budget once it succeeds.
+func (b *faucetBudget) topUp(coins sdk.Coins, transfer func() error) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := transfer(); err != nil {
+		return errors.Wrapf(err, "can't top up the faucet with %s", coins)
+	}
+	if b.loaded {
+		b.balances = b.balances.Add(coins...)
+	}
+	return nil
+}
+
+func (b *faucetBudget) invalidate() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.loaded = false
+}
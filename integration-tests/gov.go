@@ -203,12 +203,13 @@ func (g Governance) WaitForVotingToFinalize(ctx context.Context, proposalID uint
 		return proposal.Status, err
 	}
 
-	block, err := g.chainCtx.ClientContext.Client().Block(ctx, nil)
+	// the block time seen by the chain is used because the time of the tendermint block doesn't include the time travel
+	blockTime, err := g.chainCtx.BlockTime(ctx)
 	if err != nil {
-		return proposal.Status, errors.WithStack(err)
+		return proposal.Status, err
 	}
-	if block.Block.Time.Before(proposal.VotingEndTime) {
-		waitCtx, waitCancel := context.WithTimeout(ctx, proposal.VotingEndTime.Sub(block.Block.Time))
+	if blockTime.Before(proposal.VotingEndTime) {
+		waitCtx, waitCancel := context.WithTimeout(ctx, proposal.VotingEndTime.Sub(blockTime))
 		defer waitCancel()
 
 		<-waitCtx.Done()
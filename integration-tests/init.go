@@ -4,8 +4,12 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"go.uber.org/zap"
+
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
 	"github.com/CoreumFoundation/coreum/pkg/config"
 )
@@ -27,11 +31,15 @@ func (m *stringsFlag) Set(val string) error {
 
 type testingConfig struct {
 	RPCAddress      string
+	APIAddress      string
+	GRPCAddresses   []string
 	NetworkConfig   config.NetworkConfig
 	FundingMnemonic string
 	StakerMnemonics []string
 	LogFormat       logger.Format
 	LogVerbose      bool
+	ArtifactsDir    string
+	NodeLogFile     string
 }
 
 var (
@@ -41,14 +49,18 @@ var (
 
 func init() {
 	var (
-		fundingMnemonic, coredAddress, logFormat string
-		stakerMnemonics                          stringsFlag
+		fundingMnemonic, coredAddress, apiAddress, logFormat, artifactsDir, nodeLogFile string
+		stakerMnemonics, grpcAddresses                                                  stringsFlag
 	)
 
 	flag.StringVar(&coredAddress, "cored-address", "tcp://localhost:26657", "Address of cored node started by znet")
+	flag.StringVar(&apiAddress, "cored-api-address", "http://localhost:1317", "Address of the REST API of cored node started by znet")
+	flag.Var(&grpcAddresses, "cored-grpc-address", "gRPC address of the node of the network the state consistency is checked on, supports multiple")
 	flag.StringVar(&fundingMnemonic, "funding-mnemonic", "sad hobby filter tray ordinary gap half web cat hard call mystery describe member round trend friend beyond such clap frozen segment fan mistake", "Funding account mnemonic required by tests")
 	flag.Var(&stakerMnemonics, "staker-mnemonic", "Staker account mnemonics required by tests, supports multiple")
 	flag.StringVar(&logFormat, "log-format", string(logger.ToolDefaultConfig.Format), "Format of logs produced by tests")
+	flag.StringVar(&artifactsDir, "artifacts-dir", filepath.Join(os.TempDir(), "coreum-integration-tests"), "Directory where the artifacts of the failed tests are stored")
+	flag.StringVar(&nodeLogFile, "cored-log-file", "", "Log file of cored node started by znet, the logs covering the transactions of the failed test are attached to its artifacts")
 
 	// accept testing flags
 	testing.Init()
@@ -68,11 +80,15 @@ func init() {
 	}
 	cfg = testingConfig{
 		RPCAddress:      coredAddress,
+		APIAddress:      apiAddress,
+		GRPCAddresses:   grpcAddresses,
 		NetworkConfig:   networkConfig,
 		FundingMnemonic: fundingMnemonic,
 		StakerMnemonics: stakerMnemonics,
 		LogFormat:       logger.Format(logFormat),
 		LogVerbose:      flag.Lookup("test.v").Value.String() == "true",
+		ArtifactsDir:    artifactsDir,
+		NodeLogFile:     nodeLogFile,
 	}
 
 	// FIXME (wojtek): remove this once we have our own address encoder
@@ -80,6 +96,8 @@ func init() {
 
 	chain = NewChain(ChainConfig{
 		RPCAddress:      cfg.RPCAddress,
+		APIAddress:      cfg.APIAddress,
+		GRPCAddresses:   cfg.GRPCAddresses,
 		NetworkConfig:   cfg.NetworkConfig,
 		FundingMnemonic: cfg.FundingMnemonic,
 		StakerMnemonics: cfg.StakerMnemonics,
@@ -87,14 +105,20 @@ func init() {
 }
 
 // NewTestingContext returns the configured chain and new context for the integration tests.
+// The logs produced in the context are tagged with the name of the test. The transactions broadcast by the chain
+// are recorded and, if the test fails, stored in the artifacts directory together with the node logs covering them.
 func NewTestingContext(t *testing.T) (context.Context, Chain) {
 	loggerConfig := logger.Config{
 		Format:  cfg.LogFormat,
 		Verbose: cfg.LogVerbose,
 	}
 
-	ctx, cancel := context.WithCancel(logger.WithLogger(context.Background(), logger.New(loggerConfig)))
+	log := logger.New(loggerConfig).With(zap.String("test", t.Name()))
+	ctx, cancel := context.WithCancel(logger.WithLogger(context.Background(), log))
 	t.Cleanup(cancel)
 
-	return ctx, chain
+	testChain := chain
+	testChain.ChainContext = NewChainContext(newTestArtifacts(t, chain.ClientContext), chain.NetworkConfig)
+
+	return ctx, testChain
 }
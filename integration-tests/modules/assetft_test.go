@@ -4,14 +4,22 @@ package modules
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
 	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
 	"github.com/CoreumFoundation/coreum/pkg/tx"
 	"github.com/CoreumFoundation/coreum/testutil/event"
@@ -205,6 +213,11 @@ func TestAssetFTBurnRate(t *testing.T) {
 	tokenIssuedEvents, err := event.FindTypedEvents[*assetfttypes.EventTokenIssued](res.Events)
 	requireT.NoError(err)
 	denom := tokenIssuedEvents[0].Denom
+	definition := assetfttypes.FTDefinition{
+		Denom:    denom,
+		Issuer:   issuer.String(),
+		BurnRate: issueMsg.BurnRate,
+	}
 
 	// send from issuer to recipient1 (burn must not apply)
 	sendMsg := &banktypes.MsgSend{
@@ -232,17 +245,22 @@ func TestAssetFTBurnRate(t *testing.T) {
 		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
 	}
 
-	_, err = tx.BroadcastTx(
+	res, err = tx.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(recipient1),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
 		sendMsg,
 	)
 	requireT.NoError(err)
+	outcome := definition.CalculateSendOutcome(definition.Issuer, recipient1, recipient2, sdk.NewInt(100))
+	requireT.Equal(sdk.NewInt(10).String(), outcome.Burnt.String())
+	burntCoins, err := event.FindBurntCoins(res.Events)
+	requireT.NoError(err)
+	requireT.Equal(outcome.Burnt.String(), burntCoins.AmountOf(denom).String())
 	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
 		&issuer:     600,
-		&recipient1: 290,
-		&recipient2: 100,
+		&recipient1: 400 - outcome.Sent.Int64(),
+		&recipient2: outcome.Received.Int64(),
 	})
 
 	// send from recipient2 to issuer (burn must not apply)
@@ -266,7 +284,7 @@ func TestAssetFTBurnRate(t *testing.T) {
 	})
 
 	// multi send from recipient1 to issuer and recipient2
-	// (burn must apply to both transfers. will be fixed later to apply to one transfer)
+	// (burn must apply only to the share of the input sent to recipient2)
 	multiSendMsg := &banktypes.MsgMultiSend{
 		Inputs: []banktypes.Input{
 			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200)))},
@@ -285,18 +303,117 @@ func TestAssetFTBurnRate(t *testing.T) {
 		}),
 	)
 
-	_, err = tx.BroadcastTx(
+	res, err = tx.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(recipient1),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(multiSendMsg)),
 		multiSendMsg,
 	)
 	requireT.NoError(err)
+	burnt := definition.CalculateMultiSendBurnRateAmount(sdk.NewInt(200), sdk.NewInt(100), sdk.NewInt(200))
+	requireT.Equal(sdk.NewInt(10).String(), burnt.String())
+	burntCoins, err = event.FindBurntCoins(res.Events)
+	requireT.NoError(err)
+	requireT.Equal(burnt.String(), burntCoins.AmountOf(denom).String())
+	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
+		&issuer:     700 + 100,
+		&recipient1: 290 - 200 - burnt.Int64(),
+		&recipient2: 100,
+	})
+}
+
+// TestAssetFTSendCommissionRate tests send commission rate functionality of fungible tokens.
+func TestAssetFTSendCommissionRate(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient1 := chain.GenAccount()
+	recipient2 := chain.GenAccount()
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+			},
+		}),
+		chain.Faucet.FundAccountsWithOptions(ctx, recipient1, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+			},
+		}),
+	)
+
+	// Issue an fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:             issuer.String(),
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Description:        "ABC Description",
+		Features:           []assetfttypes.TokenFeature{},
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.20"),
+	}
+
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	tokenIssuedEvents, err := event.FindTypedEvents[*assetfttypes.EventTokenIssued](res.Events)
+	requireT.NoError(err)
+	denom := tokenIssuedEvents[0].Denom
+	requireT.Equal(issueMsg.SendCommissionRate.String(), tokenIssuedEvents[0].SendCommissionRate.String())
+
+	// send from issuer to recipient1 (commission must not apply)
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient1.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(400))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
 	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
-		&issuer:     800,
-		&recipient1: 70,
+		&issuer:     600,
+		&recipient1: 400,
+	})
+
+	// send from recipient1 to recipient2 (commission must apply)
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: recipient1.String(),
+		ToAddress:   recipient2.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient1),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+	assertCoinDistribution(ctx, chain.ClientContext, t, denom, map[*sdk.AccAddress]int64{
+		&issuer:     620,
+		&recipient1: 280,
 		&recipient2: 100,
 	})
+
+	// the rate is returned by the token query
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	tokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.SendCommissionRate.String(), tokenRes.Token.SendCommissionRate.String())
 }
 
 // TestAssetFTFreezeUnfreezable checks freeze functionality on unfreezable fungible tokens.
@@ -464,7 +581,7 @@ func TestAssetFTFreeze(t *testing.T) {
 		freezeMsg,
 	)
 	requireT.NoError(err)
-	assertT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(freezeMsg))
+	chain.AssertDeterministicGas(t, res, freezeMsg)
 
 	fungibleTokenFreezeEvts, err := event.FindTypedEvents[*assetfttypes.EventFrozenAmountChanged](res.Events)
 	requireT.NoError(err)
@@ -537,7 +654,7 @@ func TestAssetFTFreeze(t *testing.T) {
 		unfreezeMsg,
 	)
 	requireT.NoError(err)
-	assertT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(unfreezeMsg))
+	chain.AssertDeterministicGas(t, res, unfreezeMsg)
 
 	fungibleTokenFreezeEvts, err = event.FindTypedEvents[*assetfttypes.EventFrozenAmountChanged](res.Events)
 	requireT.NoError(err)
@@ -602,7 +719,7 @@ func TestAssetFTFreeze(t *testing.T) {
 		unfreezeMsg,
 	)
 	requireT.NoError(err)
-	assertT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(unfreezeMsg))
+	chain.AssertDeterministicGas(t, res, unfreezeMsg)
 
 	fungibleTokenFreezeEvts, err = event.FindTypedEvents[*assetfttypes.EventFrozenAmountChanged](res.Events)
 	requireT.NoError(err)
@@ -611,6 +728,9 @@ func TestAssetFTFreeze(t *testing.T) {
 		PreviousAmount: sdk.NewCoin(denom, sdk.NewInt(200)),
 		CurrentAmount:  sdk.NewCoin(denom, sdk.NewInt(0)),
 	}, fungibleTokenFreezeEvts[0])
+
+	// all the nodes have the same state of the token
+	chain.Consistency.AssertConsistent(ctx, t, integrationtests.FTStateQueries(denom, issuer, recipient)...)
 }
 
 // TestAssetFTGloballyFreeze checks global freeze functionality of fungible tokens.
@@ -734,13 +854,14 @@ func TestAssetFTIssueBasic(t *testing.T) {
 
 	// Issue the new fungible token
 	msg := &assetfttypes.MsgIssue{
-		Issuer:        issuer.String(),
-		Symbol:        "WBTC",
-		Subunit:       "wsatoshi",
-		Precision:     8,
-		Description:   "Wrapped BTC",
-		InitialAmount: sdk.NewInt(777),
-		BurnRate:      sdk.NewDec(0),
+		Issuer:             issuer.String(),
+		Symbol:             "WBTC",
+		Subunit:            "wsatoshi",
+		Precision:          8,
+		Description:        "Wrapped BTC",
+		InitialAmount:      sdk.NewInt(777),
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.NewDec(0),
 	}
 
 	res, err := tx.BroadcastTx(
@@ -751,20 +872,21 @@ func TestAssetFTIssueBasic(t *testing.T) {
 	)
 
 	require.NoError(t, err)
-	assert.Equal(t, chain.GasLimitByMsgs(&assetfttypes.MsgIssue{}), uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, &assetfttypes.MsgIssue{})
 	fungibleTokenIssuedEvts, err := event.FindTypedEvents[*assetfttypes.EventTokenIssued](res.Events)
 
 	require.NoError(t, err)
 	require.Equal(t, assetfttypes.EventTokenIssued{
-		Denom:         assetfttypes.BuildDenom(msg.Subunit, issuer),
-		Issuer:        msg.Issuer,
-		Symbol:        msg.Symbol,
-		Precision:     msg.Precision,
-		Subunit:       msg.Subunit,
-		Description:   msg.Description,
-		InitialAmount: msg.InitialAmount,
-		Features:      []assetfttypes.TokenFeature{},
-		BurnRate:      msg.BurnRate,
+		Denom:              assetfttypes.BuildDenom(msg.Subunit, issuer),
+		Issuer:             msg.Issuer,
+		Symbol:             msg.Symbol,
+		Precision:          msg.Precision,
+		Subunit:            msg.Subunit,
+		Description:        msg.Description,
+		InitialAmount:      msg.InitialAmount,
+		Features:           []assetfttypes.TokenFeature{},
+		BurnRate:           msg.BurnRate,
+		SendCommissionRate: msg.SendCommissionRate,
 	}, *fungibleTokenIssuedEvts[0])
 
 	denom := fungibleTokenIssuedEvts[0].Denom
@@ -776,13 +898,14 @@ func TestAssetFTIssueBasic(t *testing.T) {
 	requireT.NoError(err)
 
 	requireT.Equal(assetfttypes.FT{
-		Denom:       denom,
-		Issuer:      msg.Issuer,
-		Symbol:      msg.Symbol,
-		Subunit:     "wsatoshi",
-		Precision:   8,
-		Description: msg.Description,
-		BurnRate:    msg.BurnRate,
+		Denom:              denom,
+		Issuer:             msg.Issuer,
+		Symbol:             msg.Symbol,
+		Subunit:            "wsatoshi",
+		Precision:          8,
+		Description:        msg.Description,
+		BurnRate:           msg.BurnRate,
+		SendCommissionRate: msg.SendCommissionRate,
 	}, gotToken.Token)
 
 	// query balance
@@ -1093,7 +1216,7 @@ func TestAssetFTWhitelist(t *testing.T) {
 		whitelistMsg,
 	)
 	requireT.NoError(err)
-	assertT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(whitelistMsg))
+	chain.AssertDeterministicGas(t, res, whitelistMsg)
 
 	// query whitelisted tokens
 	whitelistedBalance, err := ftClient.WhitelistedBalance(ctx, &assetfttypes.QueryWhitelistedBalanceRequest{
@@ -1170,7 +1293,7 @@ func TestAssetFTWhitelist(t *testing.T) {
 		whitelistMsg,
 	)
 	requireT.NoError(err)
-	assertT.EqualValues(res.GasUsed, chain.GasLimitByMsgs(whitelistMsg))
+	chain.AssertDeterministicGas(t, res, whitelistMsg)
 
 	// query whitelisted tokens
 	whitelistedBalance, err = ftClient.WhitelistedBalance(ctx, &assetfttypes.QueryWhitelistedBalanceRequest{
@@ -1256,21 +1379,1803 @@ func TestAssetFTWhitelist(t *testing.T) {
 		sendMsg,
 	)
 	assertT.True(assetfttypes.ErrWhitelistedLimitExceeded.Is(err))
+
+	// all the nodes have the same state of the token
+	chain.Consistency.AssertConsistent(ctx, t, integrationtests.FTStateQueries(denom, issuer, recipient)...)
 }
 
-func assertCoinDistribution(ctx context.Context, clientCtx tx.ClientContext, t *testing.T, denom string, dist map[*sdk.AccAddress]int64) {
-	bankClient := banktypes.NewQueryClient(clientCtx)
+// TestAssetFTFrozenRate checks that the share of the balance frozen by the issuer can't be sent.
+func TestAssetFTFrozenRate(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
 	requireT := require.New(t)
+	assertT := assert.New(t)
 
-	total := int64(0)
-	for acc, expectedBalance := range dist {
-		total += expectedBalance
-		getBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: acc.String(), Denom: denom})
-		requireT.NoError(err)
-		requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(expectedBalance)).String(), getBalance.Balance.String())
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgSetFrozenRate{},
+				&assetfttypes.MsgSetFrozenRate{},
+				&banktypes.MsgSend{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, holder, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	// Issue the new fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+		},
 	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
 
-	supply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   holder.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
 	requireT.NoError(err)
-	requireT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(total)).String(), supply.Amount.String())
+
+	// freeze the quarter of the holder's balance
+	frozenRateMsg := &assetfttypes.MsgSetFrozenRate{
+		Sender:  issuer.String(),
+		Account: holder.String(),
+		Denom:   denom,
+		Rate:    sdk.MustNewDecFromStr("0.25"),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(frozenRateMsg)),
+		frozenRateMsg,
+	)
+	requireT.NoError(err)
+
+	frozenRate, err := ftClient.FrozenRate(ctx, &assetfttypes.QueryFrozenRateRequest{
+		Account: holder.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(frozenRateMsg.Rate.String(), frozenRate.Rate.String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 25).String(), frozenRate.FrozenAmount.String())
+
+	// try to send more than the unfrozen share
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: holder.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(76))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// send the unfrozen share
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(75)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// remove the relative freeze and send the rest
+	frozenRateMsg.Rate = sdk.ZeroDec()
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(frozenRateMsg)),
+		frozenRateMsg,
+	)
+	requireT.NoError(err)
+
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(25)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+}
+
+// TestAssetFTTimedFreeze checks that the amount frozen by the issuer until the unfreeze time can't be sent before that
+// time and is unfrozen automatically after it.
+func TestAssetFTTimedFreeze(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgFreezeUntil{},
+				&banktypes.MsgSend{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, holder, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	// Issue the new fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   holder.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// freeze the part of the holder's balance for a short time
+	freezeMsg := &assetfttypes.MsgFreezeUntil{
+		Sender:       issuer.String(),
+		Account:      holder.String(),
+		Coin:         sdk.NewInt64Coin(denom, 40),
+		UnfreezeTime: time.Now().UTC().Add(20 * time.Second).Truncate(time.Second),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+
+	timedFreezes, err := ftClient.TimedFreezes(ctx, &assetfttypes.QueryTimedFreezesRequest{
+		Account: holder.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal([]assetfttypes.TimedFreeze{
+		{
+			Account:      holder.String(),
+			Coin:         freezeMsg.Coin,
+			UnfreezeTime: freezeMsg.UnfreezeTime,
+		},
+	}, timedFreezes.TimedFreezes)
+
+	// try to send more than the unfrozen amount
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: holder.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(61))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// send the unfrozen amount
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(60)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// wait for the amount to be unfrozen and send the rest
+	retryCtx, retryCancel := context.WithTimeout(ctx, time.Minute)
+	defer retryCancel()
+	requireT.NoError(retry.Do(retryCtx, time.Second, func() error {
+		timedFreezes, err := ftClient.TimedFreezes(ctx, &assetfttypes.QueryTimedFreezesRequest{
+			Account: holder.String(),
+		})
+		if err != nil {
+			return err
+		}
+		if len(timedFreezes.TimedFreezes) > 0 {
+			return retry.Retryable(errors.Errorf("waiting for the amount to be unfrozen"))
+		}
+		return nil
+	}))
+
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(40)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+}
+
+// TestAssetFTSendFeatureGas tests that the gas is charged for the features of the fungible token sent.
+func TestAssetFTSendFeatureGas(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	deterministicGas := chain.DeterministicGas()
+
+	featureGasRes, err := ftClient.SendFeatureGas(ctx, &assetfttypes.QuerySendFeatureGasRequest{})
+	requireT.NoError(err)
+	requireT.Contains(featureGasRes.Features, assetfttypes.FeatureGas{
+		Feature: assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+		Gas:     deterministicGas.AssetFTSendFreezeFeature,
+	})
+
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+		},
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+
+	// the gas of the freeze feature is charged on top of the deterministic gas of the send
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(assetfttypes.BuildDenom(issueMsg.Subunit, issuer), 100)),
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(
+		deterministicGas.FixedGas+deterministicGas.BankSendPerEntry+deterministicGas.AssetFTSendFreezeFeature,
+		res.GasUsed,
+	)
+}
+
+// TestAssetFTTokenStats tests the supply and holder statistics of the fungible token.
+func TestAssetFTTokenStats(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgFreeze{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+		},
+	}
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+	}
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewInt64Coin(denom, 10),
+	}
+	msgs := []sdk.Msg{issueMsg, sendMsg, freezeMsg}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msgs...)),
+		msgs...,
+	)
+	requireT.NoError(err)
+
+	statsRes, err := ftClient.TokenStats(ctx, &assetfttypes.QueryTokenStatsRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(assetfttypes.TokenStats{
+		Supply:      sdk.NewInt64Coin(denom, 1000),
+		Holders:     2,
+		Frozen:      sdk.NewInt64Coin(denom, 10),
+		Whitelisted: sdk.NewInt64Coin(denom, 0),
+	}, statsRes.Stats)
+}
+
+// TestAssetFTFreezeAccount tests freezing of all the fungible tokens of the issuer held by the account.
+func TestAssetFTFreezeAccount(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgIssue{},
+				// two tokens are sent to the holder
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgFreezeAccount{},
+				&assetfttypes.MsgUnfreezeAccount{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, holder, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	// issue the freezable and the unfreezable tokens
+	var denoms []string
+	for _, issueMsg := range []*assetfttypes.MsgIssue{
+		{
+			Issuer:        issuer.String(),
+			Symbol:        "ABC",
+			Subunit:       "uabc",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			Features: []assetfttypes.TokenFeature{
+				assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+			},
+		},
+		{
+			Issuer:        issuer.String(),
+			Symbol:        "DEF",
+			Subunit:       "udef",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+		},
+	} {
+		_, err := tx.BroadcastTx(
+			ctx,
+			chain.ClientContext.WithFromAddress(issuer),
+			chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+			issueMsg,
+		)
+		requireT.NoError(err)
+		denoms = append(denoms, assetfttypes.BuildDenom(issueMsg.Subunit, issuer))
+	}
+	freezableDenom, unfreezableDenom := denoms[0], denoms[1]
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   holder.String(),
+		Amount: sdk.NewCoins(
+			sdk.NewCoin(freezableDenom, sdk.NewInt(100)),
+			sdk.NewCoin(unfreezableDenom, sdk.NewInt(100)),
+		),
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// freeze the holder account
+	freezeMsg := &assetfttypes.MsgFreezeAccount{
+		Sender:  issuer.String(),
+		Account: holder.String(),
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(chain.GasLimitByMsgs(freezeMsg), res.GasUsed)
+
+	frozenRes, err := ftClient.AccountFrozen(ctx, &assetfttypes.QueryAccountFrozenRequest{
+		Issuer:  issuer.String(),
+		Account: holder.String(),
+	})
+	requireT.NoError(err)
+	requireT.True(frozenRes.Frozen)
+
+	frozenAccountsRes, err := ftClient.FrozenAccounts(ctx, &assetfttypes.QueryFrozenAccountsRequest{
+		Issuer: issuer.String(),
+	})
+	requireT.NoError(err)
+	requireT.Equal([]assetfttypes.AccountFreeze{
+		{Issuer: issuer.String(), Account: holder.String()},
+	}, frozenAccountsRes.AccountFreezes)
+
+	// the freezable token can't be sent
+	sendMsg = &banktypes.MsgSend{
+		FromAddress: holder.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(freezableDenom, sdk.NewInt(10))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	assertT.True(assetfttypes.ErrAccountFrozen.Is(err))
+
+	// the unfreezable token isn't affected
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(unfreezableDenom, sdk.NewInt(10)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// unfreeze the holder account and send the freezable token
+	unfreezeMsg := &assetfttypes.MsgUnfreezeAccount{
+		Sender:  issuer.String(),
+		Account: holder.String(),
+	}
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(unfreezeMsg)),
+		unfreezeMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(chain.GasLimitByMsgs(unfreezeMsg), res.GasUsed)
+
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(freezableDenom, sdk.NewInt(10)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+}
+
+// TestAssetFTBurnFrom tests burning of the fungible tokens using the burn allowance.
+func TestAssetFTBurnFrom(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgBurnFrom{},
+				&assetfttypes.MsgBurnFrom{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, holder, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgGrantBurnAllowance{},
+			},
+		}))
+
+	// Issue the new fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_burn, //nolint:nosnakecase
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   holder.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// the holder allows the issuer to burn the part of its balance
+	grantMsg := &assetfttypes.MsgGrantBurnAllowance{
+		Sender:  holder.String(),
+		Spender: issuer.String(),
+		Coin:    sdk.NewInt64Coin(denom, 50),
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(grantMsg)),
+		grantMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(chain.GasLimitByMsgs(grantMsg), res.GasUsed)
+
+	burnMsg := &assetfttypes.MsgBurnFrom{
+		Sender:  issuer.String(),
+		Account: holder.String(),
+		Coin:    sdk.NewInt64Coin(denom, 30),
+	}
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(chain.GasLimitByMsgs(burnMsg), res.GasUsed)
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: holder.String(), Denom: denom})
+	requireT.NoError(err)
+	assertT.Equal(sdk.NewInt64Coin(denom, 70).String(), balanceRes.Balance.String())
+
+	allowanceRes, err := ftClient.BurnAllowance(ctx, &assetfttypes.QueryBurnAllowanceRequest{
+		Owner:   holder.String(),
+		Spender: issuer.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	assertT.Equal(sdk.NewInt64Coin(denom, 20).String(), allowanceRes.Allowance.String())
+
+	// the amount exceeding the remaining allowance can't be burnt
+	burnMsg.Coin = sdk.NewInt64Coin(denom, 21)
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	assertT.True(assetfttypes.ErrBurnAllowanceExceeded.Is(err))
+}
+
+// TestAssetFTVestingIssuance tests the issuance vesting the initial amount on the issuer account.
+func TestAssetFTVestingIssuance(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	// Issue the new fungible token with the part of the initial amount vested after a year
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		VestingSchedule: &assetfttypes.VestingSchedule{
+			Periods: []assetfttypes.VestingPeriod{
+				{Length: 0, Amount: sdk.NewInt(100)},
+				{Length: 365 * 24 * time.Hour, Amount: sdk.NewInt(900)},
+			},
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// the issuer account is converted to the vesting one
+	authClient := authtypes.NewQueryClient(chain.ClientContext)
+	accountRes, err := authClient.Account(ctx, &authtypes.QueryAccountRequest{Address: issuer.String()})
+	requireT.NoError(err)
+	var account authtypes.AccountI
+	requireT.NoError(chain.ClientContext.InterfaceRegistry().UnpackAny(accountRes.Account, &account))
+	vestingAccount, ok := account.(*vestingtypes.PeriodicVestingAccount)
+	requireT.True(ok)
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)), vestingAccount.OriginalVesting)
+
+	// the amount of the zero length period is spendable, the rest is locked
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	sendMsg.Amount = sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1)))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	assertT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+}
+
+// TestAssetFTWhitelistExemption checks that the account exempted by the issuer receives the tokens above the whitelisted
+// limit.
+func TestAssetFTWhitelistExemption(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	operator := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgSetWhitelistExemption{},
+				&assetfttypes.MsgSetWhitelistExemption{},
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+			},
+		}))
+
+	// Issue the new fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_whitelist, //nolint:nosnakecase
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// exempt the operator
+	exemptionMsg := &assetfttypes.MsgSetWhitelistExemption{
+		Sender:  issuer.String(),
+		Account: operator.String(),
+		Denom:   denom,
+		Exempt:  true,
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(exemptionMsg)),
+		exemptionMsg,
+	)
+	requireT.NoError(err)
+
+	exemptions, err := ftClient.WhitelistExemptions(ctx, &assetfttypes.QueryWhitelistExemptionsRequest{
+		Denom: denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal([]string{operator.String()}, exemptions.Accounts)
+
+	// send to the operator without the whitelisted limit
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   operator.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// revoke the exemption
+	exemptionMsg.Exempt = false
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(exemptionMsg)),
+		exemptionMsg,
+	)
+	requireT.NoError(err)
+
+	// try to send to the operator again
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	assertT.True(assetfttypes.ErrWhitelistedLimitExceeded.Is(err))
+}
+
+// TestAssetFTBatchFreezeAndWhitelist tests freezing and whitelisting many accounts in one message.
+func TestAssetFTBatchFreezeAndWhitelist(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	holder1 := chain.GenAccount()
+	holder2 := chain.GenAccount()
+	twoEntries := []assetfttypes.AccountCoin{{}, {}}
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgBatchSetWhitelistedLimit{Entries: twoEntries},
+				&banktypes.MsgSend{},
+				&banktypes.MsgSend{},
+				&assetfttypes.MsgBatchFreeze{Entries: twoEntries},
+				&assetfttypes.MsgBatchUnfreeze{Entries: twoEntries},
+				&assetfttypes.MsgBatchUnfreeze{Entries: twoEntries},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_freeze,    //nolint:nosnakecase
+			assetfttypes.TokenFeature_whitelist, //nolint:nosnakecase
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// whitelist both holders at once
+	whitelistMsg := &assetfttypes.MsgBatchSetWhitelistedLimit{
+		Sender: issuer.String(),
+		Entries: []assetfttypes.AccountCoin{
+			{Account: holder1.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(100))},
+			{Account: holder2.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(200))},
+		},
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(whitelistMsg)),
+		whitelistMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(chain.GasLimitByMsgs(whitelistMsg), res.GasUsed)
+
+	for _, entry := range whitelistMsg.Entries {
+		whitelistedBalance, err := ftClient.WhitelistedBalance(ctx, &assetfttypes.QueryWhitelistedBalanceRequest{
+			Account: entry.Account,
+			Denom:   denom,
+		})
+		requireT.NoError(err)
+		requireT.Equal(entry.Coin.String(), whitelistedBalance.Balance.String())
+
+		sendMsg := &banktypes.MsgSend{
+			FromAddress: issuer.String(),
+			ToAddress:   entry.Account,
+			Amount:      sdk.NewCoins(entry.Coin),
+		}
+		_, err = tx.BroadcastTx(
+			ctx,
+			chain.ClientContext.WithFromAddress(issuer),
+			chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+			sendMsg,
+		)
+		requireT.NoError(err)
+	}
+
+	// freeze both holders at once
+	freezeMsg := &assetfttypes.MsgBatchFreeze{
+		Sender: issuer.String(),
+		Entries: []assetfttypes.AccountCoin{
+			{Account: holder1.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(40))},
+			{Account: holder2.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(50))},
+		},
+	}
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+	assertT.EqualValues(chain.GasLimitByMsgs(freezeMsg), res.GasUsed)
+
+	frozenEvents, err := event.FindTypedEvents[*assetfttypes.EventFrozenAmountChanged](res.Events)
+	requireT.NoError(err)
+	requireT.Len(frozenEvents, 2)
+
+	// the unfreeze exceeding the frozen amount of the second holder fails the whole batch
+	unfreezeMsg := &assetfttypes.MsgBatchUnfreeze{
+		Sender: issuer.String(),
+		Entries: []assetfttypes.AccountCoin{
+			{Account: holder1.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(40))},
+			{Account: holder2.String(), Coin: sdk.NewCoin(denom, sdk.NewInt(51))},
+		},
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(unfreezeMsg)),
+		unfreezeMsg,
+	)
+	requireT.True(assetfttypes.ErrNotEnoughBalance.Is(err))
+
+	for _, entry := range freezeMsg.Entries {
+		frozenBalance, err := ftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+			Account: entry.Account,
+			Denom:   denom,
+		})
+		requireT.NoError(err)
+		requireT.Equal(entry.Coin.String(), frozenBalance.Balance.String())
+	}
+
+	// unfreeze both holders at once
+	unfreezeMsg.Entries[1].Coin = sdk.NewCoin(denom, sdk.NewInt(50))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(unfreezeMsg)),
+		unfreezeMsg,
+	)
+	requireT.NoError(err)
+
+	for _, entry := range unfreezeMsg.Entries {
+		frozenBalance, err := ftClient.FrozenBalance(ctx, &assetfttypes.QueryFrozenBalanceRequest{
+			Account: entry.Account,
+			Denom:   denom,
+		})
+		requireT.NoError(err)
+		requireT.True(frozenBalance.Balance.IsZero())
+	}
+}
+
+// TestAssetFTRegisterIBCDenom tests registration and resolution of the IBC voucher denoms.
+func TestAssetFTRegisterIBCDenom(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgRegisterIBCDenom{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	registerMsg := &assetfttypes.MsgRegisterIBCDenom{
+		Sender: issuer.String(),
+		Trace: assetfttypes.IBCDenomTrace{
+			Path:      "transfer/channel-0/transfer/channel-5",
+			BaseDenom: denom,
+		},
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(registerMsg)),
+		registerMsg,
+	)
+	requireT.NoError(err)
+
+	ibcDenom := registerMsg.Trace.IBCDenom()
+	resolveRes, err := ftClient.ResolveIBCDenom(ctx, &assetfttypes.QueryResolveIBCDenomRequest{
+		Hash: ibcDenom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(ibcDenom, resolveRes.IBCDenom)
+	requireT.Equal(registerMsg.Trace, resolveRes.Trace)
+	requireT.NotNil(resolveRes.Token)
+	requireT.Equal(denom, resolveRes.Token.Denom)
+	requireT.Equal(issueMsg.Symbol, resolveRes.Token.Symbol)
+	requireT.Equal(issueMsg.Precision, resolveRes.Token.Precision)
+
+	metadataRes, err := bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{
+		Denom: ibcDenom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.Symbol, metadataRes.Metadata.Display)
+}
+
+// TestAssetFTReserveCapture tests reservation of the funds and their capture by the payee.
+func TestAssetFTReserveCapture(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	payee := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgReserve{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, payee, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgCapture{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	reserveMsg := &assetfttypes.MsgReserve{
+		Payer:      issuer.String(),
+		Payee:      payee.String(),
+		Amount:     sdk.NewCoin(denom, sdk.NewInt(300)),
+		Expiration: time.Now().UTC().Add(time.Hour),
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(reserveMsg)),
+		reserveMsg,
+	)
+	requireT.NoError(err)
+	reservedEvts, err := event.FindTypedEvents[*assetfttypes.EventFundsReserved](res.Events)
+	requireT.NoError(err)
+	requireT.Len(reservedEvts, 1)
+	id := reservedEvts[0].ID
+
+	payeeReservationsRes, err := ftClient.PayeeReservations(ctx, &assetfttypes.QueryPayeeReservationsRequest{
+		Payee: payee.String(),
+	})
+	requireT.NoError(err)
+	requireT.Len(payeeReservationsRes.Reservations, 1)
+	requireT.Equal(id, payeeReservationsRes.Reservations[0].ID)
+	requireT.Equal(reserveMsg.Amount, payeeReservationsRes.Reservations[0].Amount)
+
+	captureMsg := &assetfttypes.MsgCapture{
+		Sender: payee.String(),
+		ID:     id,
+		Amount: sdk.NewCoin(denom, sdk.NewInt(200)),
+	}
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(payee),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(captureMsg)),
+		captureMsg,
+	)
+	requireT.NoError(err)
+	capturedEvts, err := event.FindTypedEvents[*assetfttypes.EventFundsCaptured](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventFundsCaptured{
+		ID:       id,
+		Payer:    issuer.String(),
+		Payee:    payee.String(),
+		Amount:   captureMsg.Amount,
+		Released: sdk.NewCoin(denom, sdk.NewInt(100)),
+	}, capturedEvts[0])
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: payee.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(200).String(), balanceRes.Balance.Amount.String())
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(800).String(), balanceRes.Balance.Amount.String())
+
+	_, err = ftClient.Reservation(ctx, &assetfttypes.QueryReservationRequest{
+		Id: id,
+	})
+	requireT.ErrorContains(err, "not found")
+}
+
+// TestAssetFTReserveAttestation checks that the issuer publishes the reserve attestations of the token.
+func TestAssetFTReserveAttestation(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	randomAccount := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgPublishReserveAttestation{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, randomAccount, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgPublishReserveAttestation{},
+			},
+		}))
+
+	// Issue the new fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// try to publish the attestation by the random account
+	attestationMsg := &assetfttypes.MsgPublishReserveAttestation{
+		Sender:               randomAccount.String(),
+		Denom:                denom,
+		Amount:               sdk.NewInt(1000),
+		AuditorSignatureHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		URI:                  "https://example.com/attestation.pdf",
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(randomAccount),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(attestationMsg)),
+		attestationMsg,
+	)
+	assertT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// publish the attestation by the issuer
+	attestationMsg.Sender = issuer.String()
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(attestationMsg)),
+		attestationMsg,
+	)
+	requireT.NoError(err)
+
+	publishedEvts, err := event.FindTypedEvents[*assetfttypes.EventReserveAttestationPublished](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventReserveAttestationPublished{
+		Denom:                denom,
+		Amount:               attestationMsg.Amount,
+		AuditorSignatureHash: attestationMsg.AuditorSignatureHash,
+		URI:                  attestationMsg.URI,
+	}, publishedEvts[0])
+
+	attestationsRes, err := ftClient.ReserveAttestations(ctx, &assetfttypes.QueryReserveAttestationsRequest{
+		Denom: denom,
+	})
+	requireT.NoError(err)
+	requireT.Len(attestationsRes.Attestations, 1)
+	attestation := attestationsRes.Attestations[0]
+	assertT.Equal(denom, attestation.Denom)
+	assertT.Equal(attestationMsg.Amount.String(), attestation.Amount.String())
+	assertT.Equal(attestationMsg.AuditorSignatureHash, attestation.AuditorSignatureHash)
+	assertT.Equal(attestationMsg.URI, attestation.URI)
+	assertT.False(attestation.Timestamp.IsZero())
+}
+
+// TestAssetFTTransferAdmin checks that the issuer privileges of the token are transferred to another account and
+// cleared.
+//
+//nolint:funlen // there are many tests
+func TestAssetFTTransferAdmin(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	assertT := assert.New(t)
+
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	issuer := chain.GenAccount()
+	admin := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgTransferAdmin{},
+				&assetfttypes.MsgTransferAdmin{},
+				&assetfttypes.MsgMint{},
+			},
+		}))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, admin, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgMint{},
+				&assetfttypes.MsgClearAdmin{},
+			},
+		}))
+
+	// Issue the new fungible token
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		Description:   "ABC Description",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []assetfttypes.TokenFeature{assetfttypes.TokenFeature_mint}, //nolint:nosnakecase
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	adminRes, err := ftClient.Admin(ctx, &assetfttypes.QueryAdminRequest{Denom: denom})
+	requireT.NoError(err)
+	assertT.Equal(issuer.String(), adminRes.Admin)
+	assertT.Nil(adminRes.PendingAdminTransfer)
+
+	// schedule the transfer with the grace period
+	transferMsg := &assetfttypes.MsgTransferAdmin{
+		Sender:      issuer.String(),
+		Denom:       denom,
+		Account:     admin.String(),
+		GracePeriod: time.Hour,
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferMsg)),
+		transferMsg,
+	)
+	requireT.NoError(err)
+
+	scheduledEvts, err := event.FindTypedEvents[*assetfttypes.EventAdminTransferScheduled](res.Events)
+	requireT.NoError(err)
+	requireT.Len(scheduledEvts, 1)
+	assertT.Equal(issuer.String(), scheduledEvts[0].Admin)
+	assertT.Equal(admin.String(), scheduledEvts[0].PendingAdmin)
+
+	adminRes, err = ftClient.Admin(ctx, &assetfttypes.QueryAdminRequest{Denom: denom})
+	requireT.NoError(err)
+	assertT.Equal(issuer.String(), adminRes.Admin)
+	requireT.NotNil(adminRes.PendingAdminTransfer)
+	assertT.Equal(admin.String(), adminRes.PendingAdminTransfer.Account)
+	assertT.Equal(scheduledEvts[0].ActivationTime, adminRes.PendingAdminTransfer.ActivationTime)
+
+	// transfer the privileges immediately, replacing the scheduled transfer
+	transferMsg.GracePeriod = 0
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferMsg)),
+		transferMsg,
+	)
+	requireT.NoError(err)
+
+	transferredEvts, err := event.FindTypedEvents[*assetfttypes.EventAdminTransferred](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: issuer.String(),
+		CurrentAdmin:  admin.String(),
+	}, transferredEvts[0])
+
+	adminRes, err = ftClient.Admin(ctx, &assetfttypes.QueryAdminRequest{Denom: denom})
+	requireT.NoError(err)
+	assertT.Equal(admin.String(), adminRes.Admin)
+	assertT.Nil(adminRes.PendingAdminTransfer)
+
+	// the issuer can't mint anymore
+	mintMsg := &assetfttypes.MsgMint{
+		Sender: issuer.String(),
+		Coin:   sdk.NewInt64Coin(denom, 100),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	assertT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// the new admin mints
+	mintMsg.Sender = admin.String()
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(admin),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
+		mintMsg,
+	)
+	requireT.NoError(err)
+
+	// clear the admin
+	clearMsg := &assetfttypes.MsgClearAdmin{
+		Sender: admin.String(),
+		Denom:  denom,
+	}
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(admin),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(clearMsg)),
+		clearMsg,
+	)
+	requireT.NoError(err)
+
+	clearedEvts, err := event.FindTypedEvents[*assetfttypes.EventAdminCleared](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: admin.String(),
+	}, clearedEvts[0])
+
+	adminRes, err = ftClient.Admin(ctx, &assetfttypes.QueryAdminRequest{Denom: denom})
+	requireT.NoError(err)
+	assertT.Empty(adminRes.Admin)
+}
+
+// TestAssetFTWrap tests wrapping of the native coin into the fungible token and unwrapping it back.
+func TestAssetFTWrap(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	account := chain.GenAccount()
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	amountToWrap := sdk.NewInt(1000)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, account, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgWrap{},
+				&assetfttypes.MsgUnwrap{},
+			},
+			Amount: amountToWrap,
+		}),
+	)
+
+	wrapMsg := &assetfttypes.MsgWrap{
+		Sender: account.String(),
+		Coin:   chain.NewCoin(amountToWrap),
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(account),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(wrapMsg)),
+		wrapMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res, wrapMsg)
+
+	wrappedDenom := assetfttypes.BuildDenom(
+		assetfttypes.BuildWrappedSubunit(chain.NetworkConfig.Denom),
+		authtypes.NewModuleAddress(assetfttypes.ModuleName),
+	)
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: account.String(),
+		Denom:   wrappedDenom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(amountToWrap.String(), balanceRes.Balance.Amount.String())
+
+	unwrapMsg := &assetfttypes.MsgUnwrap{
+		Sender: account.String(),
+		Coin:   sdk.NewCoin(wrappedDenom, amountToWrap),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(account),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(unwrapMsg)),
+		unwrapMsg,
+	)
+	requireT.NoError(err)
+
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: account.String(),
+		Denom:   wrappedDenom,
+	})
+	requireT.NoError(err)
+	requireT.True(balanceRes.Balance.Amount.IsZero())
+
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: account.String(),
+		Denom:   chain.NetworkConfig.Denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(amountToWrap.String(), balanceRes.Balance.Amount.String())
+}
+
+// TestAssetFTBridgeMintAndBurn tests minting of fungible tokens by the bridge authorized using authz
+// and burning of them to bridge them back.
+func TestAssetFTBridgeMintAndBurn(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	bridge := chain.GenAccount()
+	recipient := chain.GenAccount()
+	attester1 := secp256k1.GenPrivKey()
+	attester2 := secp256k1.GenPrivKey()
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+			},
+			Amount: sdk.NewInt(1_000_000),
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, bridge, integrationtests.BalancesOptions{
+			Amount: sdk.NewInt(1_000_000),
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, recipient, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgBridgeBurn{},
+			},
+		}),
+	)
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "WBTC",
+		Subunit:       "wsatoshi",
+		Precision:     8,
+		InitialAmount: sdk.ZeroInt(),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_mint, //nolint:nosnakecase
+			assetfttypes.TokenFeature_burn, //nolint:nosnakecase
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+
+	// grant bridge minting rights requiring two of two attestations
+	grantMsg, err := authz.NewMsgGrant(
+		issuer,
+		bridge,
+		assetfttypes.NewBridgeAuthorization(denom, []string{
+			sdk.AccAddress(attester1.PubKey().Address()).String(),
+			sdk.AccAddress(attester2.PubKey().Address()).String(),
+		}, 2),
+		time.Now().Add(time.Hour),
+	)
+	requireT.NoError(err)
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithSimulateAndExecute(true),
+		grantMsg,
+	)
+	requireT.NoError(err)
+
+	coin := sdk.NewCoin(denom, sdk.NewInt(1000))
+	transferID := "0x7e4a1c"
+	signBytes := assetfttypes.BridgeMintSignBytes(chain.ClientContext.ChainID(), transferID, recipient, coin)
+	attestations := make([]assetfttypes.BridgeAttestation, 0, 2)
+	for _, attester := range []*secp256k1.PrivKey{attester1, attester2} {
+		signature, err := attester.Sign(signBytes)
+		requireT.NoError(err)
+		attestations = append(attestations, assetfttypes.BridgeAttestation{
+			Attester:  sdk.AccAddress(attester.PubKey().Address()).String(),
+			PubKey:    attester.PubKey().Bytes(),
+			Signature: signature,
+		})
+	}
+
+	// try to mint with the attestations below the threshold
+	mintMsg := &assetfttypes.MsgBridgeMint{
+		Sender:       issuer.String(),
+		Recipient:    recipient.String(),
+		Coin:         coin,
+		TransferID:   transferID,
+		Attestations: attestations[:1],
+	}
+	execMsg := authz.NewMsgExec(bridge, []sdk.Msg{mintMsg})
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(bridge),
+		chain.TxFactory().WithGas(500_000),
+		&execMsg,
+	)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// mint with all the attestations
+	mintMsg.Attestations = attestations
+	execMsg = authz.NewMsgExec(bridge, []sdk.Msg{mintMsg})
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(bridge),
+		chain.TxFactory().WithSimulateAndExecute(true),
+		&execMsg,
+	)
+	requireT.NoError(err)
+	mintedEvts, err := event.FindTypedEvents[*assetfttypes.EventBridgeMinted](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetfttypes.EventBridgeMinted{
+		TransferID: transferID,
+		Recipient:  recipient.String(),
+		Coin:       coin,
+		Attesters:  bridgeAttesters(attestations),
+	}, mintedEvts[0])
+
+	recordRes, err := ftClient.BridgeMintRecord(ctx, &assetfttypes.QueryBridgeMintRecordRequest{
+		Denom:      denom,
+		TransferId: transferID,
+	})
+	requireT.NoError(err)
+	requireT.Equal(recipient.String(), recordRes.Record.Recipient)
+
+	// the same transfer can't be minted twice
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(bridge),
+		chain.TxFactory().WithGas(500_000),
+		&execMsg,
+	)
+	requireT.True(assetfttypes.ErrTransferAlreadyMinted.Is(err))
+
+	// burn the tokens to bridge them back
+	burnMsg := &assetfttypes.MsgBridgeBurn{
+		Sender:      recipient.String(),
+		Coin:        sdk.NewCoin(denom, sdk.NewInt(400)),
+		Destination: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+	}
+	res, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(recipient),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res, burnMsg)
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: recipient.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(600).String(), balanceRes.Balance.Amount.String())
+}
+
+func bridgeAttesters(attestations []assetfttypes.BridgeAttestation) []string {
+	attesters := make([]string, 0, len(attestations))
+	for _, attestation := range attestations {
+		attesters = append(attesters, attestation.Attester)
+	}
+	return attesters
+}
+
+func assertCoinDistribution(ctx context.Context, clientCtx tx.ClientContext, t *testing.T, denom string, dist map[*sdk.AccAddress]int64) {
+	bankClient := banktypes.NewQueryClient(clientCtx)
+	requireT := require.New(t)
+
+	total := int64(0)
+	for acc, expectedBalance := range dist {
+		total += expectedBalance
+		getBalance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: acc.String(), Denom: denom})
+		requireT.NoError(err)
+		requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(expectedBalance)).String(), getBalance.Balance.String())
+	}
+
+	supply, err := bankClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.EqualValues(sdk.NewCoin(denom, sdk.NewInt(total)).String(), supply.Amount.String())
+}
+
+// TestAssetFTEventIndexing tests that the transactions of the fungible tokens are found by the tx_search queries
+// matching the plain attributes of the index events.
+func TestAssetFTEventIndexing(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgFreeze{},
+			},
+		}))
+
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "IDX",
+		Subunit:       "uidx",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []assetfttypes.TokenFeature{
+			assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+		},
+	}
+	issueRes, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+
+	denom := assetfttypes.BuildDenom(issueMsg.Subunit, issuer)
+	freezeMsg := &assetfttypes.MsgFreeze{
+		Sender:  issuer.String(),
+		Account: recipient.String(),
+		Coin:    sdk.NewInt64Coin(denom, 100),
+	}
+	freezeRes, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+
+	assertTxsSearched(ctx, t, chain, fmt.Sprintf("%s.%s='%s'",
+		assetfttypes.EventTypeIndex, assetfttypes.AttributeKeyDenom, denom,
+	), issueRes.TxHash, freezeRes.TxHash)
+	assertTxsSearched(ctx, t, chain, fmt.Sprintf("%s.%s='%s'",
+		assetfttypes.EventTypeIndex, assetfttypes.AttributeKeyIssuer, issuer,
+	), issueRes.TxHash, freezeRes.TxHash)
+	assertTxsSearched(ctx, t, chain, fmt.Sprintf("%s.%s='%s' AND %s.%s='%s'",
+		assetfttypes.EventTypeIndex, assetfttypes.AttributeKeyDenom, denom,
+		assetfttypes.EventTypeIndex, assetfttypes.AttributeKeyAccount, recipient,
+	), freezeRes.TxHash)
+}
+
+// assertTxsSearched asserts that the tx_search query finds the transactions of the hashes, in the order of their
+// execution.
+func assertTxsSearched(
+	ctx context.Context,
+	t *testing.T,
+	chain integrationtests.Chain,
+	query string,
+	expectedHashes ...string,
+) {
+	t.Helper()
+
+	// the transactions are indexed after the block is committed
+	retryCtx, retryCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer retryCancel()
+	require.NoError(t, retry.Do(retryCtx, 500*time.Millisecond, func() error {
+		res, err := chain.ClientContext.Client().TxSearch(ctx, query, false, nil, nil, "asc")
+		if err != nil {
+			return retry.Retryable(errors.WithStack(err))
+		}
+		hashes := make([]string, 0, len(res.Txs))
+		for _, resTx := range res.Txs {
+			hashes = append(hashes, resTx.Hash.String())
+		}
+		if !assert.ObjectsAreEqual(expectedHashes, hashes) {
+			return retry.Retryable(errors.Errorf("query %q found %v, expected %v", query, hashes, expectedHashes))
+		}
+		return nil
+	}))
 }
@@ -3,9 +3,14 @@
 package modules
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
@@ -49,10 +54,11 @@ func TestAssetNFTIssueClass(t *testing.T) {
 		issueMsg,
 	)
 	requireT.NoError(err)
-	requireT.Equal(chain.GasLimitByMsgs(issueMsg), uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, issueMsg)
 	tokenIssuedEvents, err := event.FindTypedEvents[*assetnfttypes.EventClassIssued](res.Events)
 	requireT.NoError(err)
 	tokenIssuedEvent := tokenIssuedEvents[0]
+	requireT.True(tokenIssuedEvent.RoyaltyRate.IsZero())
 	requireT.Equal(&assetnfttypes.EventClassIssued{
 		ID:          assetnfttypes.BuildClassID(issueMsg.Symbol, issuer),
 		Issuer:      issuer.String(),
@@ -61,6 +67,7 @@ func TestAssetNFTIssueClass(t *testing.T) {
 		Description: issueMsg.Description,
 		URI:         issueMsg.URI,
 		URIHash:     issueMsg.URIHash,
+		RoyaltyRate: tokenIssuedEvent.RoyaltyRate,
 	}, tokenIssuedEvent)
 
 	// check that class is present in the nft module
@@ -129,7 +136,7 @@ func TestAssetNFTMint(t *testing.T) {
 		mintMsg,
 	)
 	requireT.NoError(err)
-	requireT.Equal(chain.GasLimitByMsgs(mintMsg), uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, mintMsg)
 
 	nftMintedEvents, err := event.FindTypedEvents[*nft.EventMint](res.Events)
 	requireT.NoError(err)
@@ -175,7 +182,7 @@ func TestAssetNFTMint(t *testing.T) {
 		sendMsg,
 	)
 	requireT.NoError(err)
-	requireT.Equal(chain.GasLimitByMsgs(sendMsg), uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, sendMsg)
 	nftSentEvents, err := event.FindTypedEvents[*nft.EventSend](res.Events)
 	requireT.NoError(err)
 	nftSentEvent := nftSentEvents[0]
@@ -193,3 +200,1187 @@ func TestAssetNFTMint(t *testing.T) {
 	requireT.NoError(err)
 	requireT.Equal(receiver.String(), ownerRes.Owner)
 }
+
+// TestAssetNFTMintWithReservedIDPrefix tests minting of non-fungible tokens with the reserved ID prefix
+// by the operator authorized using authz.
+func TestAssetNFTMintWithReservedIDPrefix(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	operator := chain.GenAccount()
+
+	nftClient := nft.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgReserveIDPrefix{},
+			},
+			Amount: sdk.NewInt(1_000_000),
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, operator, integrationtests.BalancesOptions{
+			Amount: sdk.NewInt(1_000_000),
+		}),
+	)
+
+	// issue new NFT class
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: issuer.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+
+	// reserve the ID prefix
+	reserveMsg := &assetnfttypes.MsgReserveIDPrefix{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		Prefix:  "gen1/",
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(reserveMsg)),
+		reserveMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res, reserveMsg)
+	reservedEvents, err := event.FindTypedEvents[*assetnfttypes.EventIDPrefixReserved](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetnfttypes.EventIDPrefixReserved{
+		ClassID: classID,
+		Prefix:  reserveMsg.Prefix,
+	}, reservedEvents[0])
+
+	// grant minting rights for the reserved prefix to the operator
+	grantMsg, err := authz.NewMsgGrant(
+		issuer,
+		operator,
+		assetnfttypes.NewMintAuthorization(classID, []string{reserveMsg.Prefix}),
+		time.Now().Add(time.Hour),
+	)
+	requireT.NoError(err)
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithSimulateAndExecute(true),
+		grantMsg,
+	)
+	requireT.NoError(err)
+
+	// mint the token with the reserved prefix by the operator
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "gen1/id-1",
+	}
+	execMsg := authz.NewMsgExec(operator, []sdk.Msg{mintMsg})
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(operator),
+		chain.TxFactory().WithSimulateAndExecute(true),
+		&execMsg,
+	)
+	requireT.NoError(err)
+
+	ownerRes, err := nftClient.Owner(ctx, &nft.QueryOwnerRequest{
+		ClassId: classID,
+		Id:      mintMsg.ID,
+	})
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), ownerRes.Owner)
+
+	// try to mint the token without the prefix by the operator
+	mintMsg.ID = "id-2"
+	execMsg = authz.NewMsgExec(operator, []sdk.Msg{mintMsg})
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(operator),
+		chain.TxFactory().WithGas(500_000),
+		&execMsg,
+	)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+}
+
+// TestAssetNFTTransferWithPayment tests the sale of the non-fungible token using the offer signed by the owner.
+func TestAssetNFTTransferWithPayment(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	seller := chain.GenAccount()
+	buyer := chain.GenAccount()
+
+	nftClient := nft.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, seller, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+			},
+		}),
+	)
+	price := chain.NewCoin(sdk.NewInt(1_000_000))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, buyer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgTransferWithPayment{},
+				&assetnfttypes.MsgTransferWithPayment{},
+			},
+			Amount: price.Amount,
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: seller.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  seller.String(),
+		ClassID: assetnfttypes.BuildClassID(issueMsg.Symbol, seller),
+		ID:      "id-1",
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(seller),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg)),
+		issueMsg, mintMsg,
+	)
+	requireT.NoError(err)
+
+	// sign the offer offline by the seller
+	offer := assetnfttypes.SaleOffer{
+		Seller:  seller.String(),
+		ClassID: mintMsg.ClassID,
+		ID:      mintMsg.ID,
+		Price:   price,
+		Buyer:   buyer.String(),
+	}
+	signature, pubKey, err := chain.ClientContext.Keyring().SignByAddress(
+		seller,
+		assetnfttypes.SaleOfferSignBytes(chain.ClientContext.ChainID(), offer),
+	)
+	requireT.NoError(err)
+
+	transferMsg := &assetnfttypes.MsgTransferWithPayment{
+		Sender: buyer.String(),
+		Offer: assetnfttypes.SignedSaleOffer{
+			Offer:     offer,
+			PubKey:    pubKey.Bytes(),
+			Signature: signature,
+		},
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(buyer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferMsg)),
+		transferMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res, transferMsg)
+
+	transferredEvents, err := event.FindTypedEvents[*assetnfttypes.EventTransferredWithPayment](res.Events)
+	requireT.NoError(err)
+	// the class has no royalty rate
+	requireT.True(transferredEvents[0].Royalty.IsZero())
+	requireT.Equal(&assetnfttypes.EventTransferredWithPayment{
+		ClassID:          offer.ClassID,
+		ID:               offer.ID,
+		Seller:           seller.String(),
+		Buyer:            buyer.String(),
+		Price:            price,
+		Royalty:          transferredEvents[0].Royalty,
+		RoyaltyRecipient: seller.String(),
+	}, transferredEvents[0])
+
+	ownerRes, err := nftClient.Owner(ctx, &nft.QueryOwnerRequest{
+		ClassId: offer.ClassID,
+		Id:      offer.ID,
+	})
+	requireT.NoError(err)
+	requireT.Equal(buyer.String(), ownerRes.Owner)
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: seller.String(),
+		Denom:   price.Denom,
+	})
+	requireT.NoError(err)
+	requireT.True(balanceRes.Balance.Amount.GTE(price.Amount))
+
+	// the offer can't be accepted twice
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(buyer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferMsg)),
+		transferMsg,
+	)
+	requireT.True(assetnfttypes.ErrSaleOfferAlreadyAccepted.Is(err))
+}
+
+// TestAssetNFTCancelSaleOffer tests canceling the sale offer by the seller.
+func TestAssetNFTCancelSaleOffer(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	seller := chain.GenAccount()
+	buyer := chain.GenAccount()
+
+	price := chain.NewCoin(sdk.NewInt(1_000_000))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, seller, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&assetnfttypes.MsgCancelSaleOffer{},
+			},
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, buyer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgTransferWithPayment{},
+			},
+			Amount: price.Amount,
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: seller.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  seller.String(),
+		ClassID: assetnfttypes.BuildClassID(issueMsg.Symbol, seller),
+		ID:      "id-1",
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(seller),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg)),
+		issueMsg, mintMsg,
+	)
+	requireT.NoError(err)
+
+	// the offer never expires
+	offer := assetnfttypes.SaleOffer{
+		Seller:  seller.String(),
+		ClassID: mintMsg.ClassID,
+		ID:      mintMsg.ID,
+		Price:   price,
+	}
+	signature, pubKey, err := chain.ClientContext.Keyring().SignByAddress(
+		seller,
+		assetnfttypes.SaleOfferSignBytes(chain.ClientContext.ChainID(), offer),
+	)
+	requireT.NoError(err)
+
+	cancelMsg := &assetnfttypes.MsgCancelSaleOffer{
+		Sender: seller.String(),
+		Offer:  offer,
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(seller),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(cancelMsg)),
+		cancelMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res, cancelMsg)
+
+	canceledEvents, err := event.FindTypedEvents[*assetnfttypes.EventSaleOfferCanceled](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(&assetnfttypes.EventSaleOfferCanceled{
+		ClassID: offer.ClassID,
+		ID:      offer.ID,
+		Seller:  seller.String(),
+	}, canceledEvents[0])
+
+	// the canceled offer can't be accepted
+	transferMsg := &assetnfttypes.MsgTransferWithPayment{
+		Sender: buyer.String(),
+		Offer: assetnfttypes.SignedSaleOffer{
+			Offer:     offer,
+			PubKey:    pubKey.Bytes(),
+			Signature: signature,
+		},
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(buyer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferMsg)),
+		transferMsg,
+	)
+	requireT.True(assetnfttypes.ErrSaleOfferCanceled.Is(err))
+}
+
+// TestAssetNFTGrantUser tests granting the time-bound right to use the non-fungible token.
+func TestAssetNFTGrantUser(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	owner := chain.GenAccount()
+	user := chain.GenAccount()
+
+	assetNftClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, owner, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&assetnfttypes.MsgGrantUser{},
+			},
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, user, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgRevokeUser{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: owner.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  owner.String(),
+		ClassID: assetnfttypes.BuildClassID(issueMsg.Symbol, owner),
+		ID:      "id-1",
+	}
+	grantMsg := &assetnfttypes.MsgGrantUser{
+		Sender:     owner.String(),
+		ClassID:    mintMsg.ClassID,
+		ID:         mintMsg.ID,
+		User:       user.String(),
+		Expiration: time.Now().UTC().Add(time.Hour).Truncate(time.Second),
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(owner),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg, grantMsg)),
+		issueMsg, mintMsg, grantMsg,
+	)
+	requireT.NoError(err)
+
+	userRes, err := assetNftClient.User(ctx, &assetnfttypes.QueryUserRequest{
+		ClassId: mintMsg.ClassID,
+		Id:      mintMsg.ID,
+	})
+	requireT.NoError(err)
+	requireT.Equal(user.String(), userRes.Grant.User)
+	requireT.Equal(grantMsg.Expiration, userRes.Grant.Expiration.UTC())
+
+	// the user gives up the right
+	revokeMsg := &assetnfttypes.MsgRevokeUser{
+		Sender:  user.String(),
+		ClassID: mintMsg.ClassID,
+		ID:      mintMsg.ID,
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(user),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(revokeMsg)),
+		revokeMsg,
+	)
+	requireT.NoError(err)
+
+	_, err = assetNftClient.User(ctx, &assetnfttypes.QueryUserRequest{
+		ClassId: mintMsg.ClassID,
+		Id:      mintMsg.ID,
+	})
+	requireT.ErrorContains(err, "is not used by anyone")
+}
+
+// TestAssetNFTTransferClassOwnership tests transferring the ownership of the non-fungible token class.
+func TestAssetNFTTransferClassOwnership(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	newOwner := chain.GenAccount()
+
+	assetNftClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgTransferClassOwnership{},
+			},
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, newOwner, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgAcceptClassOwnership{},
+				&assetnfttypes.MsgMint{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: issuer.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	transferMsg := &assetnfttypes.MsgTransferClassOwnership{
+		Sender:   issuer.String(),
+		ClassID:  assetnfttypes.BuildClassID(issueMsg.Symbol, issuer),
+		NewOwner: newOwner.String(),
+	}
+	res, err := tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, transferMsg)),
+		issueMsg, transferMsg,
+	)
+	requireT.NoError(err)
+	proposedEvents := tx.TypedEvents[*assetnfttypes.EventClassOwnershipTransferProposed](res)
+	requireT.Len(proposedEvents, 1)
+	requireT.Equal(&assetnfttypes.EventClassOwnershipTransferProposed{
+		ClassID:  transferMsg.ClassID,
+		Owner:    issuer.String(),
+		NewOwner: newOwner.String(),
+	}, proposedEvents[0])
+
+	ownerRes, err := assetNftClient.ClassOwner(ctx, &assetnfttypes.QueryClassOwnerRequest{ClassId: transferMsg.ClassID})
+	requireT.NoError(err)
+	requireT.Equal(&assetnfttypes.QueryClassOwnerResponse{
+		Owner:        issuer.String(),
+		PendingOwner: newOwner.String(),
+	}, ownerRes)
+
+	// the new owner accepts the ownership and mints the token
+	acceptMsg := &assetnfttypes.MsgAcceptClassOwnership{
+		Sender:  newOwner.String(),
+		ClassID: transferMsg.ClassID,
+	}
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  newOwner.String(),
+		ClassID: transferMsg.ClassID,
+		ID:      "id-1",
+	}
+	res, err = tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(newOwner),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(acceptMsg, mintMsg)),
+		acceptMsg, mintMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, acceptMsg, mintMsg)
+	requireT.Len(res.Msgs, 2)
+	requireT.Equal(chain.DeterministicGas().AssetNFTAcceptClassOwnership, res.Msgs[0].Gas)
+	requireT.Equal(chain.DeterministicGas().AssetNFTMint, res.Msgs[1].Gas)
+	transferredEvents := tx.TypedEvents[*assetnfttypes.EventClassOwnershipTransferred](res)
+	requireT.Len(transferredEvents, 1)
+	requireT.Equal(&assetnfttypes.EventClassOwnershipTransferred{
+		ClassID:       transferMsg.ClassID,
+		PreviousOwner: issuer.String(),
+		NewOwner:      newOwner.String(),
+	}, transferredEvents[0])
+
+	ownerRes, err = assetNftClient.ClassOwner(ctx, &assetnfttypes.QueryClassOwnerRequest{ClassId: transferMsg.ClassID})
+	requireT.NoError(err)
+	requireT.Equal(&assetnfttypes.QueryClassOwnerResponse{
+		Owner: newOwner.String(),
+	}, ownerRes)
+}
+
+// TestAssetNFTRewardPool tests locking the non-fungible token to earn the rewards from the pool of its class.
+func TestAssetNFTRewardPool(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	receiver := chain.GenAccount()
+
+	assetNftClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+	poolFunds := chain.NewCoin(sdk.NewInt(1_000_000))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&assetnfttypes.MsgCreateRewardPool{},
+				&assetnfttypes.MsgFundRewardPool{},
+				&assetnfttypes.MsgLockNFT{},
+				&nft.MsgSend{},
+				&assetnfttypes.MsgUnlockNFT{},
+			},
+			Amount: poolFunds.Amount,
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer: issuer.String(),
+		Symbol: "NFTClassSymbol",
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "id-1",
+	}
+	createPoolMsg := &assetnfttypes.MsgCreateRewardPool{
+		Sender:         issuer.String(),
+		ClassID:        classID,
+		RewardPerBlock: chain.NewCoin(sdk.NewInt(1_000)),
+	}
+	fundPoolMsg := &assetnfttypes.MsgFundRewardPool{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		Amount:  poolFunds,
+	}
+	lockMsg := &assetnfttypes.MsgLockNFT{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      mintMsg.ID,
+	}
+	res, err := tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg, createPoolMsg, fundPoolMsg, lockMsg)),
+		issueMsg, mintMsg, createPoolMsg, fundPoolMsg, lockMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, issueMsg, mintMsg, createPoolMsg, fundPoolMsg, lockMsg)
+	lockedEvents := tx.TypedEvents[*assetnfttypes.EventNFTLocked](res)
+	requireT.Len(lockedEvents, 1)
+	requireT.Equal(&assetnfttypes.EventNFTLocked{
+		ClassID: classID,
+		ID:      mintMsg.ID,
+		Owner:   issuer.String(),
+	}, lockedEvents[0])
+
+	// the locked token can't be transferred
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		Receiver: receiver.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.True(assetnfttypes.ErrNFTLocked.Is(err))
+
+	// the reward is accrued each block
+	rewardRes, err := assetNftClient.PendingReward(ctx, &assetnfttypes.QueryPendingRewardRequest{
+		ClassId: classID,
+		Id:      mintMsg.ID,
+	})
+	requireT.NoError(err)
+	requireT.True(rewardRes.Reward.IsPositive())
+
+	unlockMsg := &assetnfttypes.MsgUnlockNFT{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      mintMsg.ID,
+	}
+	res, err = tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(unlockMsg)),
+		unlockMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, unlockMsg)
+	unlockedEvents := tx.TypedEvents[*assetnfttypes.EventNFTUnlocked](res)
+	requireT.Len(unlockedEvents, 1)
+	requireT.True(unlockedEvents[0].Reward.IsGTE(rewardRes.Reward))
+
+	poolRes, err := assetNftClient.RewardPool(ctx, &assetnfttypes.QueryRewardPoolRequest{ClassId: classID})
+	requireT.NoError(err)
+	requireT.Zero(poolRes.Pool.LockedCount)
+	requireT.Equal(
+		poolFunds.Amount.Sub(unlockedEvents[0].Reward.Amount).String(),
+		poolRes.Pool.Balance.String(),
+	)
+}
+
+// TestAssetNFTClassFreeze tests freezing the transfers of all the tokens in the class.
+func TestAssetNFTClassFreeze(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	receiver := chain.GenAccount()
+
+	assetNftClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&assetnfttypes.MsgClassFreeze{},
+				&nft.MsgSend{},
+				&assetnfttypes.MsgClassUnfreeze{},
+				&nft.MsgSend{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:   issuer.String(),
+		Symbol:   "NFTClassSymbol",
+		Freezing: true,
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "id-1",
+	}
+	freezeMsg := &assetnfttypes.MsgClassFreeze{
+		Sender:  issuer.String(),
+		ClassID: classID,
+	}
+	res, err := tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg, freezeMsg)),
+		issueMsg, mintMsg, freezeMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, issueMsg, mintMsg, freezeMsg)
+	frozenEvents := tx.TypedEvents[*assetnfttypes.EventClassFrozen](res)
+	requireT.Len(frozenEvents, 1)
+	requireT.Equal(&assetnfttypes.EventClassFrozen{
+		ClassID: classID,
+		Owner:   issuer.String(),
+	}, frozenEvents[0])
+
+	frozenRes, err := assetNftClient.ClassFrozen(ctx, &assetnfttypes.QueryClassFrozenRequest{ClassId: classID})
+	requireT.NoError(err)
+	requireT.Equal(&assetnfttypes.QueryClassFrozenResponse{
+		Freezing: true,
+		Frozen:   true,
+	}, frozenRes)
+
+	// the tokens of the frozen class can't be transferred
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		Receiver: receiver.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.True(assetnfttypes.ErrClassFrozen.Is(err))
+
+	unfreezeMsg := &assetnfttypes.MsgClassUnfreeze{
+		Sender:  issuer.String(),
+		ClassID: classID,
+	}
+	res, err = tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(unfreezeMsg)),
+		unfreezeMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, unfreezeMsg)
+	unfrozenEvents := tx.TypedEvents[*assetnfttypes.EventClassUnfrozen](res)
+	requireT.Len(unfrozenEvents, 1)
+	requireT.Equal(&assetnfttypes.EventClassUnfrozen{
+		ClassID: classID,
+		Owner:   issuer.String(),
+	}, unfrozenEvents[0])
+
+	// the tokens are transferable once the class is unfrozen
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	frozenRes, err = assetNftClient.ClassFrozen(ctx, &assetnfttypes.QueryClassFrozenRequest{ClassId: classID})
+	requireT.NoError(err)
+	requireT.Equal(&assetnfttypes.QueryClassFrozenResponse{
+		Freezing: true,
+	}, frozenRes)
+}
+
+// TestAssetNFTRevokeNFT tests revoking the token held by another account.
+func TestAssetNFTRevokeNFT(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+
+	nftClient := nft.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&nft.MsgSend{},
+				&assetnfttypes.MsgRevokeNFT{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:    issuer.String(),
+		Symbol:    "NFTClassSymbol",
+		Revocable: true,
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "id-1",
+	}
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		Receiver: holder.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg, sendMsg)),
+		issueMsg, mintMsg, sendMsg,
+	)
+	requireT.NoError(err)
+
+	revokeMsg := &assetnfttypes.MsgRevokeNFT{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      mintMsg.ID,
+	}
+	res, err := tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(revokeMsg)),
+		revokeMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, revokeMsg)
+	revokedEvents := tx.TypedEvents[*assetnfttypes.EventNFTRevoked](res)
+	requireT.Len(revokedEvents, 1)
+	requireT.Equal(&assetnfttypes.EventNFTRevoked{
+		ClassID: classID,
+		ID:      mintMsg.ID,
+		Owner:   holder.String(),
+	}, revokedEvents[0])
+
+	// the revoked token doesn't exist anymore
+	_, err = nftClient.NFT(ctx, &nft.QueryNFTRequest{
+		ClassId: classID,
+		Id:      mintMsg.ID,
+	})
+	requireT.Error(err)
+}
+
+// TestAssetNFTSoulbound tests that the token of the soulbound class delivered to the holder can't be transferred
+// and might be burnt by the holder.
+func TestAssetNFTSoulbound(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	holder := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	nftClient := nft.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&nft.MsgSend{},
+			},
+		}),
+	)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, holder, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&nft.MsgSend{},
+				&assetnfttypes.MsgBurnNFT{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:    issuer.String(),
+		Symbol:    "NFTClassSymbol",
+		Soulbound: true,
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "id-1",
+	}
+	// the class owner delivers the minted token to the holder
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		Receiver: holder.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	res, err := tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg, sendMsg)),
+		issueMsg, mintMsg, sendMsg,
+	)
+	requireT.NoError(err)
+	issuedEvents := tx.TypedEvents[*assetnfttypes.EventClassIssued](res)
+	requireT.Len(issuedEvents, 1)
+	requireT.True(issuedEvents[0].Soulbound)
+
+	// the holder can't transfer the token
+	sendMsg = &nft.MsgSend{
+		Sender:   holder.String(),
+		Receiver: recipient.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.True(assetnfttypes.ErrNFTSoulbound.Is(err))
+
+	ownerRes, err := nftClient.Owner(ctx, &nft.QueryOwnerRequest{ClassId: classID, Id: mintMsg.ID})
+	requireT.NoError(err)
+	requireT.Equal(holder.String(), ownerRes.Owner)
+
+	// the holder burns the token
+	burnMsg := &assetnfttypes.MsgBurnNFT{
+		Sender:  holder.String(),
+		ClassID: classID,
+		ID:      mintMsg.ID,
+	}
+	res, err = tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(holder),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
+		burnMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, burnMsg)
+	burntEvents := tx.TypedEvents[*assetnfttypes.EventNFTBurnt](res)
+	requireT.Len(burntEvents, 1)
+	requireT.Equal(&assetnfttypes.EventNFTBurnt{
+		ClassID: classID,
+		ID:      mintMsg.ID,
+		Owner:   holder.String(),
+	}, burntEvents[0])
+
+	// the burnt token doesn't exist anymore
+	_, err = nftClient.NFT(ctx, &nft.QueryNFTRequest{
+		ClassId: classID,
+		Id:      mintMsg.ID,
+	})
+	requireT.Error(err)
+}
+
+// TestAssetNFTEventIndexing tests that the transactions of the non-fungible tokens are found by the tx_search queries
+// matching the plain attributes of the index events.
+func TestAssetNFTEventIndexing(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgClassFreeze{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:   issuer.String(),
+		Symbol:   "NFTIndexed",
+		Freezing: true,
+	}
+	issueRes, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg)),
+		issueMsg,
+	)
+	requireT.NoError(err)
+
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	freezeMsg := &assetnfttypes.MsgClassFreeze{
+		Sender:  issuer.String(),
+		ClassID: classID,
+	}
+	freezeRes, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(freezeMsg)),
+		freezeMsg,
+	)
+	requireT.NoError(err)
+
+	assertTxsSearched(ctx, t, chain, fmt.Sprintf("%s.%s='%s'",
+		assetnfttypes.EventTypeIndex, assetnfttypes.AttributeKeyClassID, classID,
+	), issueRes.TxHash, freezeRes.TxHash)
+	assertTxsSearched(ctx, t, chain, fmt.Sprintf("%s.%s='%s' AND %s.%s='%s'",
+		assetnfttypes.EventTypeIndex, assetnfttypes.AttributeKeyIssuer, issuer,
+		assetnfttypes.EventTypeIndex, assetnfttypes.AttributeKeyAccount, issuer,
+	), issueRes.TxHash, freezeRes.TxHash)
+}
+
+// TestAssetNFTClassWhitelist tests restricting the recipients of the tokens to the whitelisted accounts.
+func TestAssetNFTClassWhitelist(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+
+	assetNftClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&nft.MsgSend{},
+				&assetnfttypes.MsgAddToClassWhitelist{},
+				&nft.MsgSend{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:       issuer.String(),
+		Symbol:       "NFTClassSymbol",
+		Whitelisting: true,
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "id-1",
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg)),
+		issueMsg, mintMsg,
+	)
+	requireT.NoError(err)
+
+	// the token can't be sent to the account not whitelisted
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		Receiver: recipient.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.True(assetnfttypes.ErrNotWhitelisted.Is(err))
+
+	addMsg := &assetnfttypes.MsgAddToClassWhitelist{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		Account: recipient.String(),
+	}
+	res, err := tx.BroadcastTxTyped(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(addMsg)),
+		addMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res.TxResponse, addMsg)
+	addedEvents := tx.TypedEvents[*assetnfttypes.EventAddedToClassWhitelist](res)
+	requireT.Len(addedEvents, 1)
+	requireT.Equal(&assetnfttypes.EventAddedToClassWhitelist{
+		ClassID: classID,
+		Owner:   issuer.String(),
+		Account: recipient.String(),
+	}, addedEvents[0])
+
+	whitelistedRes, err := assetNftClient.ClassWhitelistedAccounts(ctx, &assetnfttypes.QueryClassWhitelistedAccountsRequest{
+		ClassId: classID,
+	})
+	requireT.NoError(err)
+	requireT.True(whitelistedRes.Whitelisting)
+	requireT.Equal([]string{recipient.String()}, whitelistedRes.Accounts)
+
+	// the whitelisted account receives the token
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+}
+
+// TestAssetNFTRoyalty tests the royalty paid to the class owner on the sale of the token.
+func TestAssetNFTRoyalty(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	seller := chain.GenAccount()
+	buyer := chain.GenAccount()
+
+	assetNftClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&nft.MsgSend{},
+			},
+		}),
+	)
+	price := chain.NewCoin(sdk.NewInt(1_000_000))
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, buyer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgTransferWithPayment{},
+			},
+			Amount: price.Amount,
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:      issuer.String(),
+		Symbol:      "NFTClassSymbol",
+		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ClassID: classID,
+		ID:      "id-1",
+	}
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		Receiver: seller.String(),
+		Id:       mintMsg.ID,
+		ClassId:  classID,
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsg, mintMsg, sendMsg)),
+		issueMsg, mintMsg, sendMsg,
+	)
+	requireT.NoError(err)
+
+	royaltyRateRes, err := assetNftClient.ClassRoyaltyRate(ctx, &assetnfttypes.QueryClassRoyaltyRateRequest{
+		ClassId: classID,
+	})
+	requireT.NoError(err)
+	requireT.Equal(issueMsg.RoyaltyRate.String(), royaltyRateRes.RoyaltyRate.String())
+
+	issuerBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   price.Denom,
+	})
+	requireT.NoError(err)
+
+	// sign the offer offline by the seller
+	offer := assetnfttypes.SaleOffer{
+		Seller:  seller.String(),
+		ClassID: classID,
+		ID:      mintMsg.ID,
+		Price:   price,
+	}
+	signature, pubKey, err := chain.ClientContext.Keyring().SignByAddress(
+		seller,
+		assetnfttypes.SaleOfferSignBytes(chain.ClientContext.ChainID(), offer),
+	)
+	requireT.NoError(err)
+
+	transferMsg := &assetnfttypes.MsgTransferWithPayment{
+		Sender: buyer.String(),
+		Offer: assetnfttypes.SignedSaleOffer{
+			Offer:     offer,
+			PubKey:    pubKey.Bytes(),
+			Signature: signature,
+		},
+	}
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(buyer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(transferMsg)),
+		transferMsg,
+	)
+	requireT.NoError(err)
+
+	royalty := chain.NewCoin(sdk.NewInt(100_000))
+	transferredEvents, err := event.FindTypedEvents[*assetnfttypes.EventTransferredWithPayment](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(royalty.String(), transferredEvents[0].Royalty.String())
+	requireT.Equal(issuer.String(), transferredEvents[0].RoyaltyRecipient)
+
+	// the seller receives the price without the royalty
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: seller.String(),
+		Denom:   price.Denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(price.Sub(royalty).String(), balanceRes.Balance.String())
+
+	balanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: issuer.String(),
+		Denom:   price.Denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(issuerBalanceRes.Balance.Add(royalty).String(), balanceRes.Balance.String())
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
 	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
 	"github.com/CoreumFoundation/coreum/pkg/tx"
+	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
 )
 
 // TODO (wojtek): once we have other coins add test verifying that transaction offering fee in coin other then CORE is rejected
@@ -83,6 +84,39 @@ func TestAuthFeeLimits(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestAuthMaxMsgs verifies that the transaction containing more messages than allowed is rejected.
+func TestAuthMaxMsgs(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+	requireT := require.New(t)
+
+	customParamsClient := customparamstypes.NewQueryClient(chain.ClientContext)
+	txParamsRes, err := customParamsClient.TxParams(ctx, &customparamstypes.QueryTxParamsRequest{})
+	requireT.NoError(err)
+
+	sender := chain.GenAccount()
+	msg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   sender.String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(1))),
+	}
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, sender, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{msg},
+	}))
+
+	// the transaction is rejected before the fees are deducted, so the gas of a single message is enough
+	msgs := make([]sdk.Msg, 0, txParamsRes.Params.MaxMsgs+1)
+	for i := uint32(0); i <= txParamsRes.Params.MaxMsgs; i++ {
+		msgs = append(msgs, msg)
+	}
+	_, err = tx.BroadcastTx(ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msg)),
+		msgs...)
+	requireT.True(sdkerrors.ErrInvalidRequest.Is(err))
+}
+
 // TestAuthMultisig tests the cosmos-sdk multisig accounts and API.
 func TestAuthMultisig(t *testing.T) {
 	t.Parallel()
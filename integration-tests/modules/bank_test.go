@@ -213,7 +213,7 @@ func TestBankSendDeterministicGas(t *testing.T) {
 			WithGas(bankSendGas),
 		msg)
 	require.NoError(t, err)
-	require.Equal(t, bankSendGas, uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, &banktypes.MsgSend{})
 }
 
 // TestBankSendDeterministicGasTwoBankSends checks that transfer takes the deterministic amount of gas
@@ -247,7 +247,7 @@ func TestBankSendDeterministicGasTwoBankSends(t *testing.T) {
 	txf := chain.ChainContext.TxFactory().WithGas(gasExpected)
 	result, err := tx.BroadcastTx(ctx, clientCtx, txf, bankSend1, bankSend2)
 	require.NoError(t, err)
-	require.EqualValues(t, gasExpected, uint64(result.GasUsed))
+	chain.AssertDeterministicGas(t, result, &banktypes.MsgSend{}, &banktypes.MsgSend{})
 }
 
 // TestBankSendDeterministicGasManyCoins checks that transfer takes the higher deterministic amount of gas when more coins are transferred
@@ -310,8 +310,9 @@ func TestBankSendDeterministicGasManyCoins(t *testing.T) {
 	zeroBankSendGas := chain.GasLimitByMsgs(&banktypes.MsgSend{})
 	require.Equal(t, deterministicGasConfig.FixedGas+deterministicGasConfig.BankSendPerEntry, zeroBankSendGas)
 
+	// the gas limit covers the gas charged for the features of the tokens, the tokens don't have any
 	bankSendGas := chain.GasLimitByMsgs(msg)
-	require.Equal(t, deterministicGasConfig.FixedGas+numOfTokens*deterministicGasConfig.BankSendPerEntry, bankSendGas)
+	require.Equal(t, deterministicGasConfig.FixedGas+numOfTokens*(deterministicGasConfig.BankSendPerEntry+chain.AllFTSendFeaturesGas()), bankSendGas)
 
 	res, err = tx.BroadcastTx(
 		ctx,
@@ -321,7 +322,7 @@ func TestBankSendDeterministicGasManyCoins(t *testing.T) {
 			WithGas(bankSendGas),
 		msg)
 	require.NoError(t, err)
-	require.Equal(t, bankSendGas, uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, msg)
 }
 
 // TestBankSendFailsIfNotEnoughGasIsProvided checks that transfer fails if not enough gas is provided
@@ -467,8 +468,9 @@ func TestBankMultiSendDeterministicGasManyCoins(t *testing.T) {
 	zeroBankMultiSendGas := chain.GasLimitByMsgs(&banktypes.MsgMultiSend{})
 	require.Equal(t, deterministicGasConfig.FixedGas+deterministicGasConfig.BankMultiSendPerEntry, zeroBankMultiSendGas)
 
+	// the gas limit covers the gas charged for the features of the tokens, the tokens don't have any
 	bankMultiSendGas := chain.GasLimitByMsgs(msg)
-	require.Equal(t, deterministicGasConfig.FixedGas+numOfTokens*deterministicGasConfig.BankMultiSendPerEntry, bankMultiSendGas)
+	require.Equal(t, deterministicGasConfig.FixedGas+numOfTokens*(deterministicGasConfig.BankMultiSendPerEntry+chain.AllFTSendFeaturesGas()), bankMultiSendGas)
 
 	res, err = tx.BroadcastTx(
 		ctx,
@@ -478,7 +480,7 @@ func TestBankMultiSendDeterministicGasManyCoins(t *testing.T) {
 			WithGas(bankMultiSendGas),
 		msg)
 	require.NoError(t, err)
-	require.Equal(t, bankMultiSendGas, uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, msg)
 }
 
 // TestBankMultiSend tests MultiSend message
@@ -571,7 +573,7 @@ func TestBankMultiSend(t *testing.T) {
 			WithGas(bankMultiSendGas),
 		msg)
 	require.NoError(t, err)
-	require.Equal(t, bankMultiSendGas, uint64(res.GasUsed))
+	chain.AssertDeterministicGas(t, res, msg)
 
 	bankClient := banktypes.NewQueryClient(chain.ClientContext)
 
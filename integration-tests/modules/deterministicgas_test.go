@@ -0,0 +1,45 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// TestDeterministicGasConfigQuery verifies that the deterministic gas reported by the chain matches the one used to
+// compute the gas limits of the transactions.
+func TestDeterministicGasConfigQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+	requireT := require.New(t)
+
+	res, err := deterministicgastypes.NewQueryClient(chain.ClientContext).
+		Config(ctx, &deterministicgastypes.QueryConfigRequest{})
+	requireT.NoError(err)
+
+	deterministicGas := chain.NetworkConfig.Fee.DeterministicGas
+	requireT.Equal(deterministicGas.FixedGas, res.FixedGas)
+	requireT.Equal(deterministicGas.FreeBytes, res.FreeBytes)
+	requireT.Equal(deterministicGas.FreeSignatures, res.FreeSignatures)
+
+	messages := map[string]uint64{}
+	for _, msg := range res.Messages {
+		messages[msg.MsgType] = msg.Gas
+	}
+	for _, msg := range []sdk.Msg{
+		&banktypes.MsgSend{},
+		&assetnfttypes.MsgIssueClass{},
+		&assetnfttypes.MsgMint{},
+	} {
+		requireT.Equal(chain.GasLimitByMsgs(msg)-res.FixedGas, messages[sdk.MsgTypeURL(msg)])
+	}
+}
@@ -58,7 +58,7 @@ func TestDistributionSpendCommunityPoolProposal(t *testing.T) {
 	)
 	requireT.NoError(err)
 	// validate the deterministic gas
-	requireT.Equal(chain.GasLimitByMsgs(msgFundCommunityPool), uint64(txResult.GasUsed))
+	chain.AssertDeterministicGas(t, txResult, msgFundCommunityPool)
 
 	poolAfterFunding := getCommunityPoolCoin(ctx, requireT, distributionClient)
 
@@ -208,7 +208,7 @@ func TestDistributionWithdrawRewardWithDeterministicGas(t *testing.T) {
 	)
 	requireT.NoError(err)
 	// validate the deterministic gas
-	requireT.Equal(chain.GasLimitByMsgs(withdrawRewardMsg), uint64(txResult.GasUsed))
+	chain.AssertDeterministicGas(t, txResult, withdrawRewardMsg)
 
 	delegatorBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
 		Address: delegator.String(),
@@ -242,7 +242,7 @@ func TestDistributionWithdrawRewardWithDeterministicGas(t *testing.T) {
 	)
 	requireT.NoError(err)
 	// validate the deterministic gas
-	requireT.Equal(chain.GasLimitByMsgs(setWithdrawAddressMsg), uint64(txResult.GasUsed))
+	chain.AssertDeterministicGas(t, txResult, setWithdrawAddressMsg)
 	// withdraw the reward second time
 	txResult, err = tx.BroadcastTx(
 		ctx,
@@ -252,7 +252,7 @@ func TestDistributionWithdrawRewardWithDeterministicGas(t *testing.T) {
 	)
 	requireT.NoError(err)
 	// validate the deterministic gas
-	requireT.Equal(chain.GasLimitByMsgs(withdrawRewardMsg), uint64(txResult.GasUsed))
+	chain.AssertDeterministicGas(t, txResult, withdrawRewardMsg)
 	delegatorRewardRecipientBalanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
 		Address: delegatorRewardRecipient.String(),
 		Denom:   delegatedCoin.Denom,
@@ -282,7 +282,7 @@ func TestDistributionWithdrawRewardWithDeterministicGas(t *testing.T) {
 	)
 	requireT.NoError(err)
 	// validate the deterministic gas
-	requireT.Equal(chain.GasLimitByMsgs(withdrawCommissionMsg), uint64(txResult.GasUsed))
+	chain.AssertDeterministicGas(t, txResult, withdrawCommissionMsg)
 
 	validatorStakerBalanceRes, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
 		Address: validatorStakerAddress.String(),
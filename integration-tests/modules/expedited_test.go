@@ -0,0 +1,125 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum-tools/pkg/retry"
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	expeditedtypes "github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+// TestExpeditedProposal verifies that the proposal of the expedited type reaching the expedited threshold passes
+// at the end of the expedited voting period.
+func TestExpeditedProposal(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+	requireT := require.New(t)
+
+	expeditedVotingPeriod, regularVotingPeriod := queryVotingPeriods(ctx, t, chain)
+	proposalID := proposeExpedited(ctx, t, chain)
+
+	proposal, err := chain.Governance.GetProposal(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(govtypes.StatusVotingPeriod, proposal.Status)
+	requireT.Equal(expeditedVotingPeriod, proposal.VotingEndTime.Sub(proposal.VotingStartTime))
+
+	expeditedClient := expeditedtypes.NewQueryClient(chain.ClientContext)
+	proposalsRes, err := expeditedClient.Proposals(ctx, &expeditedtypes.QueryProposalsRequest{})
+	requireT.NoError(err)
+	requireT.Contains(proposalsRes.ProposalIDs, proposalID)
+
+	requireT.NoError(chain.Governance.VoteAll(ctx, govtypes.OptionYes, proposalID))
+
+	finalStatus, err := chain.Governance.WaitForVotingToFinalize(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(govtypes.StatusPassed, finalStatus)
+
+	// the proposal is executed before the end of the regular voting period
+	proposal, err = chain.Governance.GetProposal(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(proposal.VotingStartTime.Add(expeditedVotingPeriod), proposal.VotingEndTime)
+	block, err := chain.ClientContext.Client().Block(ctx, nil)
+	requireT.NoError(err)
+	requireT.True(block.Block.Time.Before(proposal.VotingStartTime.Add(regularVotingPeriod)))
+}
+
+// TestExpeditedProposalConversion verifies that the expedited proposal not reaching the expedited threshold is
+// converted to the regular one and passes at the end of the regular voting period.
+func TestExpeditedProposalConversion(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+	requireT := require.New(t)
+
+	_, regularVotingPeriod := queryVotingPeriods(ctx, t, chain)
+	proposalID := proposeExpedited(ctx, t, chain)
+
+	// 60% of yes votes is above the regular threshold but below the expedited one
+	requireT.NoError(chain.Governance.VoteAllWeighted(ctx, govtypes.WeightedVoteOptions{
+		{Option: govtypes.OptionYes, Weight: sdk.MustNewDecFromStr("0.6")},
+		{Option: govtypes.OptionNo, Weight: sdk.MustNewDecFromStr("0.4")},
+	}, proposalID))
+
+	retryCtx, retryCancel := context.WithTimeout(ctx, regularVotingPeriod)
+	defer retryCancel()
+	requireT.NoError(retry.Do(retryCtx, time.Second, func() error {
+		proposal, err := chain.Governance.GetProposal(ctx, proposalID)
+		if err != nil {
+			return err
+		}
+		if proposal.VotingEndTime.Sub(proposal.VotingStartTime) != regularVotingPeriod {
+			return retry.Retryable(errors.Errorf("waiting for the proposal to be converted, status: %s", proposal.Status))
+		}
+		return nil
+	}))
+
+	finalStatus, err := chain.Governance.WaitForVotingToFinalize(ctx, proposalID)
+	requireT.NoError(err)
+	requireT.Equal(govtypes.StatusPassed, finalStatus)
+}
+
+func queryVotingPeriods(ctx context.Context, t *testing.T, chain integrationtests.Chain) (time.Duration, time.Duration) {
+	expeditedClient := expeditedtypes.NewQueryClient(chain.ClientContext)
+	expeditedParamsRes, err := expeditedClient.Params(ctx, &expeditedtypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Contains(t, expeditedParamsRes.Params.ProposalTypes, "/"+proto.MessageName(&upgradetypes.CancelSoftwareUpgradeProposal{}))
+
+	govClient := govtypes.NewQueryClient(chain.ClientContext)
+	govParamsRes, err := govClient.Params(ctx, &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamVoting})
+	require.NoError(t, err)
+	require.Greater(t, govParamsRes.VotingParams.VotingPeriod, expeditedParamsRes.Params.VotingPeriod)
+
+	return expeditedParamsRes.Params.VotingPeriod, govParamsRes.VotingParams.VotingPeriod
+}
+
+// proposeExpedited submits the cancellation of the software upgrade, which is expedited by default. No upgrade is
+// scheduled by the tests of this package, so executing the proposal has no effect.
+func proposeExpedited(ctx context.Context, t *testing.T, chain integrationtests.Chain) uint64 {
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	require.NoError(t, err)
+	require.NoError(t, chain.Faucet.FundAccounts(ctx, integrationtests.FundedAccount{Address: proposer, Amount: proposerBalance}))
+
+	proposalMsg, err := chain.Governance.NewMsgSubmitProposal(
+		ctx,
+		proposer,
+		upgradetypes.NewCancelSoftwareUpgradeProposal("Cancel upgrade", "Expedited cancellation of the upgrade"),
+	)
+	require.NoError(t, err)
+	proposalID, err := chain.Governance.Propose(ctx, proposalMsg)
+	require.NoError(t, err)
+
+	return proposalID
+}
@@ -0,0 +1,56 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+)
+
+// TestFaucetBudget tests that the faucet refuses the requests it can't afford and might be topped up.
+func TestFaucetBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+
+	// the request exceeding the balance of the faucet is refused with the amount to top up the faucet with
+	tooMuch := sdk.NewIntWithDecimal(1, 30)
+	err := chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(chain.GenAccount(), chain.NewCoin(tooMuch)))
+	var emptyErr integrationtests.FaucetEmptyError
+	requireT.True(errors.As(err, &emptyErr), err)
+	requireT.True(emptyErr.Required.AmountOf(chain.NetworkConfig.Denom).GT(tooMuch))
+	requireT.Equal(
+		emptyErr.Required.AmountOf(chain.NetworkConfig.Denom).Sub(emptyErr.Balance.AmountOf(chain.NetworkConfig.Denom)).String(),
+		emptyErr.TopUp().AmountOf(chain.NetworkConfig.Denom).String(),
+	)
+
+	// the refused request doesn't affect the next ones
+	account := chain.GenAccount()
+	topUpMsg := &banktypes.MsgSend{}
+	topUpAmount := sdk.NewInt(1000)
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, account, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{topUpMsg},
+			Amount:   topUpAmount,
+		}),
+	)
+
+	// the account tops the faucet up
+	requireT.NoError(chain.Faucet.TopUp(ctx, account, sdk.NewCoins(chain.NewCoin(topUpAmount))))
+
+	balanceRes, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: account.String(),
+		Denom:   chain.NetworkConfig.Denom,
+	})
+	requireT.NoError(err)
+	requireT.True(balanceRes.Balance.Amount.IsZero())
+}
@@ -3,18 +3,26 @@
 package modules
 
 import (
+	"encoding/hex"
+	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"go.uber.org/zap"
 
 	"github.com/CoreumFoundation/coreum-tools/pkg/logger"
 	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	"github.com/CoreumFoundation/coreum/pkg/tx"
 	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
@@ -116,6 +124,193 @@ func TestFeeModelProposalParamChange(t *testing.T) {
 	requireT.Equal(feeModelParams.String(), feeModelParamsRes.Params.Model.String())
 }
 
+// TestFeeModelFeeBurn checks that the share of the collected fees is burnt once the fee burn rate is set by governance.
+func TestFeeModelFeeBurn(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	// the rate is set and restored by two proposals
+	proposerBalance = proposerBalance.Add(proposerBalance)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	sender := chain.GenAccount()
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   chain.GenAccount().String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(1))),
+	}
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, sender, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{sendMsg},
+		Amount:   sdk.NewInt(1),
+	}))
+
+	setFeeBurnRate := func(rate sdk.Dec) {
+		err := chain.Governance.ProposeAndVote(ctx, proposer,
+			paramproposal.NewParameterChangeProposal(
+				"Set the fee burn rate",
+				"Setting the fee burn rate for the integration test",
+				[]paramproposal.ParamChange{
+					paramproposal.NewParamChange(
+						feemodeltypes.ModuleName, string(feemodeltypes.KeyFeeBurnRate), fmt.Sprintf("%q", rate.String()),
+					),
+				},
+			),
+			govtypes.OptionYes,
+		)
+		requireT.NoError(err)
+	}
+
+	setFeeBurnRate(sdk.MustNewDecFromStr("0.5"))
+	paramsRes, err := feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.5").String(), paramsRes.Params.FeeBurnRate.String())
+
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(sender),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	// the fees collected in the block of the transaction are burnt at the end of it
+	blockRes, err := chain.ClientContext.Client().BlockResults(ctx, &res.Height)
+	requireT.NoError(err)
+	var burntEvents []*feemodeltypes.EventFeesBurned
+	for _, event := range blockRes.EndBlockEvents {
+		if event.Type != proto.MessageName(&feemodeltypes.EventFeesBurned{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		requireT.NoError(err)
+		burntEvents = append(burntEvents, typedEvent.(*feemodeltypes.EventFeesBurned))
+	}
+	requireT.Len(burntEvents, 1)
+	requireT.Equal(chain.NetworkConfig.Denom, burntEvents[0].Amount.Denom)
+	requireT.True(burntEvents[0].Amount.IsPositive())
+
+	setFeeBurnRate(sdk.ZeroDec())
+}
+
+// TestFeeModelFailedTxGasRefund checks that the failed transaction pays for the full declared gas by default and that
+// the fee of the gas it hasn't used is refunded once the refund is enabled by governance.
+func TestFeeModelFailedTxGasRefund(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	bankClient := banktypes.NewQueryClient(chain.ClientContext)
+	feeModelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	// the refund is enabled and disabled by two proposals
+	proposerBalance = proposerBalance.Add(proposerBalance)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	// the send fails in the message handler because the amount exceeds the balance of the sender
+	sender := chain.GenAccount()
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: sender.String(),
+		ToAddress:   chain.GenAccount().String(),
+		Amount:      sdk.NewCoins(chain.NewCoin(sdk.NewInt(1_000_000_000_000))),
+	}
+	// the declared gas is much higher than the gas used by the failed transaction
+	gasLimit := 10 * chain.GasLimitByMsgs(sendMsg)
+	fee := chain.NetworkConfig.Fee.FeeModel.Params().InitialGasPrice.MulInt64(int64(gasLimit)).Ceil().TruncateInt()
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(sender, chain.NewCoin(fee.MulRaw(2)))))
+
+	broadcastFailingSend := func() (sdk.Int, abci.ResponseDeliverTx) {
+		balanceBefore, err := bankClient.Balance(ctx, banktypes.NewQueryBalanceRequest(sender, chain.NetworkConfig.Denom))
+		requireT.NoError(err)
+
+		res, err := tx.BroadcastTx(
+			ctx,
+			chain.ClientContext.WithFromAddress(sender).WithBroadcastMode(flags.BroadcastSync),
+			chain.TxFactory().WithGas(gasLimit),
+			sendMsg,
+		)
+		requireT.NoError(err)
+		_, err = tx.AwaitTx(ctx, chain.ClientContext, res.TxHash)
+		requireT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+		txHash, err := hex.DecodeString(res.TxHash)
+		requireT.NoError(err)
+		resultTx, err := chain.ClientContext.Client().Tx(ctx, txHash, false)
+		requireT.NoError(err)
+
+		balanceAfter, err := bankClient.Balance(ctx, banktypes.NewQueryBalanceRequest(sender, chain.NetworkConfig.Denom))
+		requireT.NoError(err)
+
+		return balanceBefore.Balance.Amount.Sub(balanceAfter.Balance.Amount), resultTx.TxResult
+	}
+
+	findRefundEvents := func(txResult abci.ResponseDeliverTx) []*feemodeltypes.EventFailedTxGasRefunded {
+		var refundEvents []*feemodeltypes.EventFailedTxGasRefunded
+		for _, event := range txResult.Events {
+			if event.Type != proto.MessageName(&feemodeltypes.EventFailedTxGasRefunded{}) {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			requireT.NoError(err)
+			refundEvents = append(refundEvents, typedEvent.(*feemodeltypes.EventFailedTxGasRefunded))
+		}
+		return refundEvents
+	}
+
+	setRefundFailedTxGas := func(enabled bool) {
+		err := chain.Governance.ProposeAndVote(ctx, proposer,
+			paramproposal.NewParameterChangeProposal(
+				"Set the gas refund policy of the failed transactions",
+				"Setting the gas refund policy of the failed transactions for the integration test",
+				[]paramproposal.ParamChange{
+					paramproposal.NewParamChange(
+						feemodeltypes.ModuleName, string(feemodeltypes.KeyRefundFailedTxGas), fmt.Sprintf("%t", enabled),
+					),
+				},
+			),
+			govtypes.OptionYes,
+		)
+		requireT.NoError(err)
+	}
+
+	// the failed transaction pays for the full declared gas by default
+	paidFee, txResult := broadcastFailingSend()
+	requireT.Less(txResult.GasUsed, txResult.GasWanted)
+	requireT.Equal(fee.String(), paidFee.String())
+	requireT.Empty(findRefundEvents(txResult))
+
+	setRefundFailedTxGas(true)
+	paramsRes, err := feeModelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.True(paramsRes.Params.RefundFailedTxGas)
+
+	// the fee of the unused gas is refunded once the refund is enabled
+	paidFee, txResult = broadcastFailingSend()
+	requireT.Less(txResult.GasUsed, txResult.GasWanted)
+	expectedRefund := fee.MulRaw(txResult.GasWanted - txResult.GasUsed).QuoRaw(txResult.GasWanted)
+	requireT.True(expectedRefund.IsPositive())
+	requireT.Equal(fee.Sub(expectedRefund).String(), paidFee.String())
+
+	refundEvents := findRefundEvents(txResult)
+	requireT.Len(refundEvents, 1)
+	requireT.Equal(sender.String(), refundEvents[0].Payer)
+	requireT.Equal(chain.NewCoin(expectedRefund).String(), refundEvents[0].Amount.String())
+	requireT.EqualValues(txResult.GasWanted, refundEvents[0].GasWanted)
+	requireT.EqualValues(txResult.GasUsed, refundEvents[0].GasUsed)
+
+	setRefundFailedTxGas(false)
+}
+
 func marshalParamChangeProposal(requireT *require.Assertions, modelParams feemodeltypes.ModelParams) string {
 	str, err := tmjson.Marshal(modelParams)
 	requireT.NoError(err)
@@ -71,7 +71,7 @@ func TestGovProposalWithDepositAndWeightedVotes(t *testing.T) {
 		depositMsg,
 	)
 	requireT.NoError(err)
-	require.Equal(t, chain.GasLimitByMsgs(depositMsg), uint64(result.GasUsed))
+	chain.AssertDeterministicGas(t, result, depositMsg)
 
 	logger.Get(ctx).Info("deposited more funds to proposal", zap.String("txHash", result.TxHash), zap.Int64("gas_used", result.GasUsed))
 
@@ -0,0 +1,127 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+	"github.com/CoreumFoundation/coreum/testutil/event"
+	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
+	oracletypes "github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+// TestOracleSubmitExchangeRateVote checks that the bonded validator is able to submit the exchange rate vote
+// and the account which is not a validator is not.
+func TestOracleSubmitExchangeRateVote(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	oracleClient := oracletypes.NewQueryClient(chain.ClientContext)
+
+	whitelistDenomViaGovernance(ctx, t, chain, chain.NetworkConfig.Denom)
+
+	customParamsClient := customparamstypes.NewQueryClient(chain.ClientContext)
+	customStakingParams, err := customParamsClient.StakingParams(ctx, &customparamstypes.QueryStakingParamsRequest{})
+	requireT.NoError(err)
+	validatorStakingAmount := customStakingParams.Params.MinSelfDelegation.Mul(sdk.NewInt(2)) // we multiply not to conflict with the tests which increases the min amount
+
+	validatorAccAddress, validatorAddress, deactivateValidator, err := integrationtests.CreateValidator(
+		ctx, chain, validatorStakingAmount, validatorStakingAmount,
+	)
+	requireT.NoError(err)
+	defer func() {
+		requireT.NoError(deactivateValidator())
+	}()
+
+	exchangeRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec(chain.NetworkConfig.Denom, sdk.MustNewDecFromStr("0.085")))
+	voteMsg := &oracletypes.MsgSubmitExchangeRateVote{
+		Validator:     validatorAddress.String(),
+		ExchangeRates: exchangeRates,
+	}
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, validatorAccAddress, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{voteMsg},
+	}))
+
+	res, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(validatorAccAddress),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(voteMsg)),
+		voteMsg,
+	)
+	requireT.NoError(err)
+	chain.AssertDeterministicGas(t, res, voteMsg)
+
+	votedEvts, err := event.FindTypedEvents[*oracletypes.EventExchangeRateVoted](res.Events)
+	requireT.NoError(err)
+	requireT.Equal(validatorAddress.String(), votedEvts[0].Validator)
+	requireT.Equal(exchangeRates.String(), votedEvts[0].ExchangeRates.String())
+
+	// the vote might be already tallied, so the miss counter is checked instead of the vote itself
+	missCounterRes, err := oracleClient.MissCounter(ctx, &oracletypes.QueryMissCounterRequest{
+		Validator: validatorAddress.String(),
+	})
+	requireT.NoError(err)
+	requireT.Zero(missCounterRes.Count)
+
+	// the account which is not a validator can't vote
+	notValidator := chain.GenAccount()
+	notValidatorVoteMsg := &oracletypes.MsgSubmitExchangeRateVote{
+		Validator:     sdk.ValAddress(notValidator).String(),
+		ExchangeRates: exchangeRates,
+	}
+	requireT.NoError(chain.Faucet.FundAccountsWithOptions(ctx, notValidator, integrationtests.BalancesOptions{
+		Messages: []sdk.Msg{notValidatorVoteMsg},
+	}))
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(notValidator),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(notValidatorVoteMsg)),
+		notValidatorVoteMsg,
+	)
+	requireT.True(oracletypes.ErrValidatorNotBonded.Is(err))
+}
+
+func whitelistDenomViaGovernance(ctx context.Context, t *testing.T, chain integrationtests.Chain, denom string) {
+	requireT := require.New(t)
+	oracleClient := oracletypes.NewQueryClient(chain.ClientContext)
+
+	paramsRes, err := oracleClient.Params(ctx, &oracletypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	if paramsRes.Params.IsWhitelisted(denom) {
+		return
+	}
+
+	proposer := chain.GenAccount()
+	proposerBalance, err := chain.Governance.ComputeProposerBalance(ctx)
+	requireT.NoError(err)
+	requireT.NoError(chain.Faucet.FundAccounts(ctx, integrationtests.NewFundedAccount(proposer, proposerBalance)))
+
+	whitelist, err := json.Marshal(append(paramsRes.Params.Whitelist, denom))
+	requireT.NoError(err)
+	err = chain.Governance.ProposeAndVote(ctx, proposer,
+		paramproposal.NewParameterChangeProposal(
+			"Whitelist the denom in the oracle",
+			"Whitelisting the denom for the integration test",
+			[]paramproposal.ParamChange{
+				paramproposal.NewParamChange(oracletypes.ModuleName, string(oracletypes.KeyWhitelist), string(whitelist)),
+			},
+		),
+		govtypes.OptionYes,
+	)
+	requireT.NoError(err)
+
+	paramsRes, err = oracleClient.Params(ctx, &oracletypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.True(paramsRes.Params.IsWhitelisted(denom))
+}
@@ -0,0 +1,238 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+)
+
+// TestRESTFeeModel checks that the REST routes of the feemodel queries return the same data as gRPC.
+func TestRESTFeeModel(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	feemodelClient := feemodeltypes.NewQueryClient(chain.ClientContext)
+
+	fields, err := chain.REST.GetFields(ctx, "/coreum/feemodel/v1/min_gas_price", nil)
+	requireT.NoError(err)
+	requireT.Contains(fields, "min_gas_price")
+
+	var minGasPriceRes feemodeltypes.QueryMinGasPriceResponse
+	requireT.NoError(chain.REST.Get(ctx, "/coreum/feemodel/v1/min_gas_price", nil, &minGasPriceRes))
+	requireT.Equal(chain.NetworkConfig.Denom, minGasPriceRes.MinGasPrice.Denom)
+	requireT.True(minGasPriceRes.MinGasPrice.Amount.IsPositive())
+
+	var paramsRes feemodeltypes.QueryParamsResponse
+	requireT.NoError(chain.REST.Get(ctx, "/coreum/feemodel/v1/params", nil, &paramsRes))
+	grpcParamsRes, err := feemodelClient.Params(ctx, &feemodeltypes.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(grpcParamsRes.Params, paramsRes.Params)
+}
+
+// TestRESTAssetFT checks that the REST routes of the asset ft queries return the same data as gRPC
+// and accept the pagination and filtering params.
+func TestRESTAssetFT(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	ftClient := assetfttypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetfttypes.MsgIssue{},
+				&assetfttypes.MsgIssue{},
+			},
+		}),
+	)
+
+	issueMsgs := []sdk.Msg{
+		&assetfttypes.MsgIssue{
+			Issuer:        issuer.String(),
+			Symbol:        "RESTA",
+			Subunit:       "uresta",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			BurnRate:      sdk.MustNewDecFromStr("0.1"),
+			Features: []assetfttypes.TokenFeature{
+				assetfttypes.TokenFeature_freeze, //nolint:nosnakecase
+			},
+		},
+		&assetfttypes.MsgIssue{
+			Issuer:        issuer.String(),
+			Symbol:        "RESTB",
+			Subunit:       "urestb",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			Features: []assetfttypes.TokenFeature{
+				assetfttypes.TokenFeature_whitelist, //nolint:nosnakecase
+			},
+		},
+	}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(issueMsgs...)),
+		issueMsgs...,
+	)
+	requireT.NoError(err)
+	denom := assetfttypes.BuildDenom("uresta", issuer)
+
+	// token
+	tokenPath := "/coreum/asset/ft/v1/denom/" + denom
+	tokenFields, err := chain.REST.GetFields(ctx, tokenPath, nil)
+	requireT.NoError(err)
+	requireT.Contains(tokenFields, "token")
+
+	var tokenRes assetfttypes.QueryTokenResponse
+	requireT.NoError(chain.REST.Get(ctx, tokenPath, nil, &tokenRes))
+	grpcTokenRes, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.Equal(grpcTokenRes.Token, tokenRes.Token)
+
+	// tokens with pagination
+	tokensPath := "/coreum/asset/ft/v1/tokens"
+	params := url.Values{}
+	params.Set("pagination.limit", "1")
+	params.Set("pagination.count_total", "true")
+
+	tokensFields, err := chain.REST.GetFields(ctx, tokensPath, params)
+	requireT.NoError(err)
+	requireT.Contains(tokensFields, "tokens")
+	requireT.Contains(tokensFields, "pagination")
+	var paginationFields map[string]json.RawMessage
+	requireT.NoError(json.Unmarshal(tokensFields["pagination"], &paginationFields))
+	requireT.Contains(paginationFields, "next_key")
+	requireT.Contains(paginationFields, "total")
+
+	var tokensRes assetfttypes.QueryTokensResponse
+	requireT.NoError(chain.REST.Get(ctx, tokensPath, params, &tokensRes))
+	requireT.Len(tokensRes.Tokens, 1)
+	requireT.NotNil(tokensRes.Pagination)
+	requireT.NotEmpty(tokensRes.Pagination.NextKey)
+	requireT.GreaterOrEqual(tokensRes.Pagination.Total, uint64(len(issueMsgs)))
+
+	// the next page is requested using the key returned in the previous one
+	params = url.Values{}
+	params.Set("pagination.limit", "1")
+	params.Set("pagination.key", base64.StdEncoding.EncodeToString(tokensRes.Pagination.NextKey))
+	var nextTokensRes assetfttypes.QueryTokensResponse
+	requireT.NoError(chain.REST.Get(ctx, tokensPath, params, &nextTokensRes))
+	requireT.Len(nextTokensRes.Tokens, 1)
+	requireT.NotEqual(tokensRes.Tokens[0].Denom, nextTokensRes.Tokens[0].Denom)
+
+	// tokens filtered by feature
+	params = url.Values{}
+	params.Set("feature", assetfttypes.TokenFeature_whitelist.String()) //nolint:nosnakecase
+	params.Set("pagination.limit", strconv.Itoa(100))
+	var featureTokensRes assetfttypes.QueryTokensResponse
+	requireT.NoError(chain.REST.Get(ctx, tokensPath, params, &featureTokensRes))
+	requireT.NotEmpty(featureTokensRes.Tokens)
+	for _, token := range featureTokensRes.Tokens {
+		requireT.Contains(token.Features, assetfttypes.TokenFeature_whitelist) //nolint:nosnakecase
+	}
+}
+
+// TestRESTAssetNFT checks that the REST routes of the asset nft and nft queries return the same data as gRPC.
+func TestRESTAssetNFT(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+	nftClient := nft.NewQueryClient(chain.ClientContext)
+	assetNFTClient := assetnfttypes.NewQueryClient(chain.ClientContext)
+
+	requireT.NoError(
+		chain.Faucet.FundAccountsWithOptions(ctx, issuer, integrationtests.BalancesOptions{
+			Messages: []sdk.Msg{
+				&assetnfttypes.MsgIssueClass{},
+				&assetnfttypes.MsgMint{},
+				&nft.MsgSend{},
+			},
+		}),
+	)
+
+	issueMsg := &assetnfttypes.MsgIssueClass{
+		Issuer:     issuer.String(),
+		Symbol:     "REST",
+		Name:       "name",
+		Provenance: true,
+	}
+	classID := assetnfttypes.BuildClassID(issueMsg.Symbol, issuer)
+	mintMsg := &assetnfttypes.MsgMint{
+		Sender:  issuer.String(),
+		ID:      "id-1",
+		ClassID: classID,
+		URI:     "https://my-nft-meta.invalid/1",
+		URIHash: "content-hash",
+	}
+	sendMsg := &nft.MsgSend{
+		Sender:   issuer.String(),
+		ClassId:  classID,
+		Id:       mintMsg.ID,
+		Receiver: recipient.String(),
+	}
+	msgs := []sdk.Msg{issueMsg, mintMsg, sendMsg}
+	_, err := tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(msgs...)),
+		msgs...,
+	)
+	requireT.NoError(err)
+
+	// nft
+	nftPath := "/coreum/nft/v1beta1/nfts/" + classID + "/" + mintMsg.ID
+	var nftRes nft.QueryNFTResponse
+	requireT.NoError(chain.REST.Get(ctx, nftPath, nil, &nftRes))
+	grpcNFTRes, err := nftClient.NFT(ctx, &nft.QueryNFTRequest{ClassId: classID, Id: mintMsg.ID})
+	requireT.NoError(err)
+	requireT.Equal(grpcNFTRes.Nft, nftRes.Nft)
+
+	nftFields, err := chain.REST.GetFields(ctx, nftPath, nil)
+	requireT.NoError(err)
+	requireT.Contains(nftFields, "nft")
+
+	// provenance
+	provenancePath := "/coreum/asset/nft/v1/classes/" + classID + "/nfts/" + mintMsg.ID + "/provenance"
+	params := url.Values{}
+	params.Set("pagination.count_total", "true")
+	var provenanceRes assetnfttypes.QueryProvenanceResponse
+	requireT.NoError(chain.REST.Get(ctx, provenancePath, params, &provenanceRes))
+	grpcProvenanceRes, err := assetNFTClient.Provenance(ctx, &assetnfttypes.QueryProvenanceRequest{
+		ClassId: classID,
+		Id:      mintMsg.ID,
+	})
+	requireT.NoError(err)
+	requireT.Equal(grpcProvenanceRes.Records, provenanceRes.Records)
+	requireT.Len(provenanceRes.Records, 1)
+	requireT.Equal(recipient.String(), provenanceRes.Records[0].To)
+	requireT.EqualValues(1, provenanceRes.Pagination.Total)
+
+	provenanceFields, err := chain.REST.GetFields(ctx, provenancePath, nil)
+	requireT.NoError(err)
+	requireT.Contains(provenanceFields, "records")
+	requireT.Contains(provenanceFields, "pagination")
+}
@@ -132,7 +132,7 @@ func TestStakingValidatorCRUDAndStaking(t *testing.T) {
 		editValidatorMsg,
 	)
 	require.NoError(t, err)
-	assert.EqualValues(t, int64(chain.GasLimitByMsgs(editValidatorMsg)), editValidatorRes.GasUsed)
+	chain.AssertDeterministicGas(t, editValidatorRes, editValidatorMsg)
 
 	valResp, err := stakingClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{
 		ValidatorAddr: validatorAddress.String(),
@@ -181,7 +181,7 @@ func TestStakingValidatorCRUDAndStaking(t *testing.T) {
 		redelegateMsg,
 	)
 	require.NoError(t, err)
-	assert.Equal(t, int64(chain.GasLimitByMsgs(redelegateMsg)), redelegateResult.GasUsed)
+	chain.AssertDeterministicGas(t, redelegateResult, redelegateMsg)
 	logger.Get(ctx).Info("Redelegation executed", zap.String("txHash", redelegateResult.TxHash))
 
 	ddResp, err = stakingClient.DelegatorDelegations(ctx, &stakingtypes.QueryDelegatorDelegationsRequest{
@@ -0,0 +1,88 @@
+//go:build integrationtests
+
+package modules
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	integrationtests "github.com/CoreumFoundation/coreum/integration-tests"
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+)
+
+// TestTimeTravelVesting verifies that the coins of the delayed vesting account become spendable once the time of
+// the chain is moved past the end of the vesting. The test must not run in parallel because the time is shifted for
+// the whole chain.
+func TestTimeTravelVesting(t *testing.T) {
+	ctx, chain := integrationtests.NewTestingContext(t)
+	requireT := require.New(t)
+
+	enabled, err := chain.IsTimeTravelEnabled(ctx)
+	requireT.NoError(err)
+	if !enabled {
+		t.Skip("time travel is disabled on the chain")
+	}
+
+	creator := chain.GenAccount()
+	vestingAcc := chain.GenAccount()
+	recipient := chain.GenAccount()
+	amount := chain.NewCoin(sdk.NewInt(1000))
+	requireT.NoError(chain.Faucet.FundAccounts(ctx,
+		integrationtests.NewFundedAccount(creator, chain.NewCoin(sdk.NewInt(1_000_000).Add(amount.Amount))),
+	))
+
+	blockTime, err := chain.BlockTime(ctx)
+	requireT.NoError(err)
+	vestingDuration := 7 * 24 * time.Hour
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(creator),
+		chain.TxFactory().WithSimulateAndExecute(true),
+		vestingtypes.NewMsgCreateVestingAccount(creator, vestingAcc, sdk.NewCoins(amount), blockTime.Add(vestingDuration).Unix(), true),
+	)
+	requireT.NoError(err)
+
+	// the vesting account must not exist before it is created, so it is funded to pay the fees afterwards
+	requireT.NoError(chain.Faucet.FundAccounts(ctx,
+		integrationtests.NewFundedAccount(vestingAcc, chain.NewCoin(sdk.NewInt(1_000_000))),
+	))
+
+	// the vesting coins can't be sent before the vesting ends
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: vestingAcc.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(amount),
+	}
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(vestingAcc),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.Error(err)
+
+	requireT.NoError(chain.FastForward(ctx, vestingDuration))
+	shiftedTime, err := chain.BlockTime(ctx)
+	requireT.NoError(err)
+	requireT.False(shiftedTime.Before(blockTime.Add(vestingDuration)))
+
+	_, err = tx.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(vestingAcc),
+		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
+		sendMsg,
+	)
+	requireT.NoError(err)
+
+	balance, err := banktypes.NewQueryClient(chain.ClientContext).Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: recipient.String(),
+		Denom:   amount.Denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(amount.String(), balance.Balance.String())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/CoreumFoundation/coreum/pkg/tx"
 	"github.com/CoreumFoundation/coreum/testutil/event"
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
 )
 
 var (
@@ -312,6 +313,12 @@ func TestWASMPinningAndUnpinningSmartContractUsingGovernance(t *testing.T) {
 	requireT.NoError(err)
 	requireT.Equal(1337, response.Count)
 
+	// the pinned contracts are discounted only if the discount set by the governance is positive
+	customParamsClient := customparamstypes.NewQueryClient(chain.ClientContext)
+	wasmParamsRes, err := customParamsClient.WasmParams(ctx, &customparamstypes.QueryWasmParamsRequest{})
+	requireT.NoError(err)
+	requireT.True(wasmParamsRes.Params.PinnedInstanceCostDiscount.IsPositive())
+
 	// execute contract to increment the count
 	gasUsedBeforePinning := incrementAndVerify(ctx, clientCtx, txf, contractAddr, requireT, 1338)
 
@@ -452,23 +459,25 @@ func TestWASMIssueFungibleTokenInContract(t *testing.T) {
 	ft, err := ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom1})
 	requireT.NoError(err)
 	requireT.EqualValues(assetfttypes.FT{
-		Denom:     denom1,
-		Issuer:    contractAddr,
-		Symbol:    symbol + "1",
-		Subunit:   subunit1,
-		Precision: precision,
-		BurnRate:  sdk.NewDec(0),
+		Denom:              denom1,
+		Issuer:             contractAddr,
+		Symbol:             symbol + "1",
+		Subunit:            subunit1,
+		Precision:          precision,
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.NewDec(0),
 	}, ft.GetToken())
 
 	ft, err = ftClient.Token(ctx, &assetfttypes.QueryTokenRequest{Denom: denom2})
 	requireT.NoError(err)
 	requireT.EqualValues(assetfttypes.FT{
-		Denom:     denom2,
-		Issuer:    contractAddr,
-		Symbol:    symbol + "2",
-		Subunit:   subunit2,
-		Precision: precision,
-		BurnRate:  sdk.NewDec(0),
+		Denom:              denom2,
+		Issuer:             contractAddr,
+		Symbol:             symbol + "2",
+		Subunit:            subunit2,
+		Precision:          precision,
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.NewDec(0),
 	}, ft.GetToken())
 
 	// check the counter
@@ -505,6 +514,94 @@ func TestWASMIssueFungibleTokenInContract(t *testing.T) {
 	assertT.Equal(contractAddr, infoResponse.Issuer)
 }
 
+// TestWASMAssetFTReceiveHook tests that the tokens with the receive hook feature can't be sent to the contract not
+// implementing the hook.
+func TestWASMAssetFTReceiveHook(t *testing.T) {
+	t.Parallel()
+
+	ctx, chain := integrationtests.NewTestingContext(t)
+
+	requireT := require.New(t)
+	issuer := chain.GenAccount()
+	recipient := chain.GenAccount()
+	requireT.NoError(chain.Faucet.FundAccounts(ctx,
+		integrationtests.NewFundedAccount(issuer, chain.NewCoin(sdk.NewInt(5000000000))),
+	))
+
+	clientCtx := chain.ClientContext.WithFromAddress(issuer)
+	txf := chain.TxFactory().
+		WithSimulateAndExecute(true)
+	bankClient := banktypes.NewQueryClient(clientCtx)
+
+	initialPayload, err := json.Marshal(simpleState{
+		Count: 1,
+	})
+	requireT.NoError(err)
+	contractAddr, _, err := deployAndInstantiateWASMContract(
+		ctx,
+		clientCtx,
+		txf,
+		simpleStateWASM,
+		instantiateConfig{
+			accessType: wasmtypes.AccessTypeUnspecified,
+			payload:    initialPayload,
+			label:      "simple_state",
+		},
+	)
+	requireT.NoError(err)
+
+	issueMsgs := []sdk.Msg{
+		&assetfttypes.MsgIssue{
+			Issuer:        issuer.String(),
+			Symbol:        "ABC",
+			Subunit:       "uabc",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			Features: []assetfttypes.TokenFeature{
+				assetfttypes.TokenFeature_receive_hook, //nolint:nosnakecase
+			},
+		},
+		&assetfttypes.MsgIssue{
+			Issuer:        issuer.String(),
+			Symbol:        "DEF",
+			Subunit:       "udef",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+		},
+	}
+	_, err = tx.BroadcastTx(ctx, clientCtx, txf, issueMsgs...)
+	requireT.NoError(err)
+	hookDenom := assetfttypes.BuildDenom("uabc", issuer)
+	denom := assetfttypes.BuildDenom("udef", issuer)
+
+	// the simple state contract doesn't implement the hook, so the transfer is rejected
+	_, err = tx.BroadcastTx(ctx, clientCtx, txf, &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   contractAddr,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 10)),
+	})
+	requireT.ErrorContains(err, "receive hook")
+
+	// the token without the feature and the transfer to the regular account aren't affected
+	_, err = tx.BroadcastTx(ctx, clientCtx, txf,
+		&banktypes.MsgSend{
+			FromAddress: issuer.String(),
+			ToAddress:   contractAddr,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+		},
+		&banktypes.MsgSend{
+			FromAddress: issuer.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 10)),
+		},
+	)
+	requireT.NoError(err)
+
+	balancesRes, err := bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: contractAddr})
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)).String(), balancesRes.Balances.String())
+}
+
 func methodToEmptyBodyPayload(methodName simpleStateMethod) (json.RawMessage, error) {
 	return json.Marshal(map[simpleStateMethod]struct{}{
 		methodName: {},
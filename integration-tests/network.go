@@ -6,23 +6,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/CoreumFoundation/coreum/pkg/config"
-	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/testutil/chainconfig"
 )
 
 const (
 	// votingPeriod is the proposal voting period duration
 	votingPeriod = time.Second * 15
+	// expeditedVotingPeriod is the expedited proposal voting period duration
+	expeditedVotingPeriod = time.Second * 5
 )
 
-// NewNetworkConfig returns the network config used by integration tests.
+// NewNetworkConfig returns the network config used by integration tests. The chain ID, the denom and the address
+// prefix of the chain are taken from the environment, see the chainconfig package.
 func NewNetworkConfig() (config.NetworkConfig, error) {
-	networkConfig, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	chainConfig, err := chainconfig.FromEnv()
+	if err != nil {
+		return config.NetworkConfig{}, err
+	}
+	networkConfig, err := chainConfig.NetworkConfig()
 	if err != nil {
 		return config.NetworkConfig{}, err
 	}
 	networkConfig.GovConfig.ProposalConfig = config.GovProposalConfig{
-		MinDepositAmount: "1000",
-		VotingPeriod:     votingPeriod.String(),
+		MinDepositAmount:      "1000",
+		VotingPeriod:          votingPeriod.String(),
+		ExpeditedVotingPeriod: expeditedVotingPeriod.String(),
 	}
 
 	networkConfig.FundedAccounts = nil
@@ -0,0 +1,85 @@
+package integrationtests
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/pkg/errors"
+)
+
+// RESTClient queries the REST routes exposed by the gRPC gateway of the chain.
+type RESTClient struct {
+	apiAddress string
+	httpClient *http.Client
+	codec      codec.JSONCodec
+}
+
+// NewRESTClient returns a new instance of the RESTClient.
+func NewRESTClient(apiAddress string, interfaceRegistry codectypes.InterfaceRegistry) RESTClient {
+	return RESTClient{
+		apiAddress: strings.TrimSuffix(apiAddress, "/"),
+		httpClient: &http.Client{},
+		codec:      codec.NewProtoCodec(interfaceRegistry),
+	}
+}
+
+// GetRaw sends the GET request to the REST route and returns the raw JSON body of the response.
+// An error is returned if the response status is not 200 OK.
+func (c RESTClient) GetRaw(ctx context.Context, path string, params url.Values) ([]byte, error) {
+	reqURL := c.apiAddress + path
+	if len(params) > 0 {
+		reqURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("REST request %s failed with status %d: %s", reqURL, resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// Get sends the GET request to the REST route and decodes the response into the proto message.
+// The decoding is strict, so the fields of the JSON response not matching the proto field names cause an error.
+func (c RESTClient) Get(ctx context.Context, path string, params url.Values, res codec.ProtoMarshaler) error {
+	body, err := c.GetRaw(ctx, path, params)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrapf(c.codec.UnmarshalJSON(body, res), "can't decode response of %s: %s", path, body)
+}
+
+// GetFields sends the GET request to the REST route and returns the top-level fields of the JSON response.
+func (c RESTClient) GetFields(ctx context.Context, path string, params url.Values) (map[string]json.RawMessage, error) {
+	body, err := c.GetRaw(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, errors.Wrapf(err, "can't decode response of %s: %s", path, body)
+	}
+
+	return fields, nil
+}
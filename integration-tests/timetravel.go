@@ -0,0 +1,65 @@
+package integrationtests
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+	timetraveltypes "github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+// BlockTime returns the time of the latest block as seen by the chain, including the offset the time is shifted by.
+// It should be used instead of the time of the tendermint block, which is never shifted.
+func (c ChainContext) BlockTime(ctx context.Context) (time.Time, error) {
+	res, err := timetraveltypes.NewQueryClient(c.ClientContext).Time(ctx, &timetraveltypes.QueryTimeRequest{})
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+	return res.BlockTime, nil
+}
+
+// IsTimeTravelEnabled returns true if the time of the chain might be moved forward.
+func (c ChainContext) IsTimeTravelEnabled(ctx context.Context) (bool, error) {
+	res, err := timetraveltypes.NewQueryClient(c.ClientContext).Time(ctx, &timetraveltypes.QueryTimeRequest{})
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return res.Enabled, nil
+}
+
+// FastForward moves the time of the chain forward by the duration and waits until the shift takes effect.
+// The time is shifted for the whole chain, so the tests waiting for the time based events, like the end of the
+// voting period, and running in parallel are affected too. That's why the tests using it must not run in parallel.
+func (c Chain) FastForward(ctx context.Context, duration time.Duration) error {
+	enabled, err := c.IsTimeTravelEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errors.New("time travel is disabled on the chain")
+	}
+
+	sender := c.GenAccount()
+	if err := c.Faucet.FundAccounts(ctx, NewFundedAccount(sender, c.NewCoin(sdk.NewInt(1_000_000)))); err != nil {
+		return err
+	}
+
+	msg := &timetraveltypes.MsgShiftTime{
+		Sender:   sender.String(),
+		Duration: duration,
+	}
+	if _, err := tx.BroadcastTx(
+		ctx,
+		c.ClientContext.WithFromAddress(sender),
+		c.TxFactory().WithSimulateAndExecute(true),
+		msg,
+	); err != nil {
+		return err
+	}
+
+	// the time is shifted starting from the block following the one including the transaction
+	return tx.AwaitNextBlocks(ctx, c.ClientContext, 1)
+}
@@ -0,0 +1,119 @@
+// Package address provides the helpers to derive, validate and convert the Coreum addresses. The package doesn't
+// depend on the Cosmos SDK, so it might be used by the wallet backends of the exchanges without pulling the whole chain.
+package address
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // the algorithm is used by the chain to derive the addresses
+
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+)
+
+const (
+	// PubKeyLength is the length of the compressed secp256k1 public key.
+	PubKeyLength = 33
+	// Length is the length of the address derived from the public key.
+	Length = 20
+	// ModuleLength is the length of the address of the module account or the contract.
+	ModuleLength = 32
+)
+
+// Prefixes returns the address prefixes of the predefined Coreum networks.
+func Prefixes() []string {
+	return []string{
+		constant.AddressPrefixMain,
+		constant.AddressPrefixDev,
+	}
+}
+
+// FromPubKey returns the address bytes of the compressed secp256k1 public key.
+func FromPubKey(pubKey []byte) ([]byte, error) {
+	if len(pubKey) != PubKeyLength {
+		return nil, errors.Errorf("invalid public key length %d, expected %d", len(pubKey), PubKeyLength)
+	}
+
+	sha := sha256.Sum256(pubKey)
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+	return hasher.Sum(nil), nil
+}
+
+// ToBech32 encodes the address bytes using the prefix.
+func ToBech32(prefix string, addr []byte) (string, error) {
+	if err := validateBytes(addr); err != nil {
+		return "", err
+	}
+	return bech32.EncodeFromBase256(prefix, addr)
+}
+
+// FromBech32 decodes the bech32 address, the prefix of the address must be equal to the provided one.
+func FromBech32(prefix, addr string) ([]byte, error) {
+	addrPrefix, bytes, err := Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+	if addrPrefix != prefix {
+		return nil, errors.Errorf("invalid address prefix %q, expected %q", addrPrefix, prefix)
+	}
+	return bytes, nil
+}
+
+// Parse decodes the bech32 address having any prefix and returns the prefix and the address bytes.
+func Parse(addr string) (string, []byte, error) {
+	prefix, bytes, err := bech32.DecodeToBase256(addr)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "invalid bech32 address %q", addr)
+	}
+	if err := validateBytes(bytes); err != nil {
+		return "", nil, err
+	}
+	return prefix, bytes, nil
+}
+
+// Validate checks the address is the valid bech32 address having the provided prefix.
+func Validate(prefix, addr string) error {
+	_, err := FromBech32(prefix, addr)
+	return err
+}
+
+// HexToBech32 converts the hex-encoded address bytes to the bech32 address having the prefix.
+// The optional 0x prefix of the hex string is accepted.
+func HexToBech32(prefix, hexAddr string) (string, error) {
+	bytes, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(hexAddr), "0x"))
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid hex address %q", hexAddr)
+	}
+	return ToBech32(prefix, bytes)
+}
+
+// Bech32ToHex converts the bech32 address having the prefix to the upper-case hex string, the same way the
+// addresses are printed by the chain.
+func Bech32ToHex(prefix, addr string) (string, error) {
+	bytes, err := FromBech32(prefix, addr)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(bytes)), nil
+}
+
+// ConvertPrefix converts the bech32 address to the one having the other prefix, e.g. to get the devnet address
+// of the mainnet account.
+func ConvertPrefix(addr, prefix string) (string, error) {
+	_, bytes, err := Parse(addr)
+	if err != nil {
+		return "", err
+	}
+	return ToBech32(prefix, bytes)
+}
+
+func validateBytes(addr []byte) error {
+	if len(addr) != Length && len(addr) != ModuleLength {
+		return errors.Errorf("invalid address length %d, expected %d or %d", len(addr), Length, ModuleLength)
+	}
+	return nil
+}
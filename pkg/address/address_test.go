@@ -0,0 +1,129 @@
+package address_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/require"
+
+	coreumaddress "github.com/CoreumFoundation/coreum/pkg/address"
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+)
+
+const mnemonic = "pitch basic bundle cause toe sound warm love town crucial divorce shell olympic convince scene middle garment glimpse narrow during fix fruit suffer honey"
+
+func TestDerivation(t *testing.T) {
+	requireT := require.New(t)
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	requireT.NoError(err)
+
+	// the address derived by the sdk
+	path := coreumaddress.HDPath(1, 0, 5)
+	requireT.Equal("m/44'/990'/1'/0/5", path)
+	hdParams, err := hd.NewParamsFromPath(path)
+	requireT.NoError(err)
+	master, chainCode := hd.ComputeMastersFromSeed(seed)
+	privKey, err := hd.DerivePrivateKeyForPath(master, chainCode, hdParams.String())
+	requireT.NoError(err)
+	expectedAddr := sdk.AccAddress((&secp256k1.PrivKey{Key: privKey}).PubKey().Address())
+
+	// from the seed
+	addr, err := coreumaddress.FromSeed(seed, path)
+	requireT.NoError(err)
+	requireT.Equal(expectedAddr.Bytes(), addr)
+
+	// from the xprv of the master key
+	masterKey, err := coreumaddress.NewMasterKey(seed)
+	requireT.NoError(err)
+	addr, err = coreumaddress.FromExtendedKey(masterKey.String(), path)
+	requireT.NoError(err)
+	requireT.Equal(expectedAddr.Bytes(), addr)
+
+	// from the xpub of the account
+	accountKey, err := coreumaddress.DeriveKey(masterKey, "m/44'/990'/1'")
+	requireT.NoError(err)
+	accountXPub, err := accountKey.Neuter()
+	requireT.NoError(err)
+	addr, err = coreumaddress.FromExtendedKey(accountXPub.String(), "0/5")
+	requireT.NoError(err)
+	requireT.Equal(expectedAddr.Bytes(), addr)
+
+	// the hardened keys can't be derived from the xpub
+	_, err = coreumaddress.FromExtendedKey(accountXPub.String(), "0'/5")
+	requireT.Error(err)
+
+	_, err = coreumaddress.FromExtendedKey("invalid", "0/5")
+	requireT.Error(err)
+}
+
+func TestParseHDPath(t *testing.T) {
+	requireT := require.New(t)
+
+	indexes, err := coreumaddress.ParseHDPath("m/44'/990'/0'/1/2")
+	requireT.NoError(err)
+	requireT.Equal([]uint32{44 + 1<<31, 990 + 1<<31, 1 << 31, 1, 2}, indexes)
+
+	indexes, err = coreumaddress.ParseHDPath("1/2")
+	requireT.NoError(err)
+	requireT.Equal([]uint32{1, 2}, indexes)
+
+	indexes, err = coreumaddress.ParseHDPath("m")
+	requireT.NoError(err)
+	requireT.Empty(indexes)
+
+	for _, path := range []string{"m/a", "m/1//2", "m/-1", "m/2147483648"} {
+		_, err = coreumaddress.ParseHDPath(path)
+		requireT.Error(err, path)
+	}
+}
+
+func TestConversion(t *testing.T) {
+	requireT := require.New(t)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr, err := coreumaddress.FromPubKey(pubKey.Bytes())
+	requireT.NoError(err)
+	requireT.Equal(pubKey.Address().Bytes(), addr)
+
+	_, err = coreumaddress.FromPubKey(addr)
+	requireT.Error(err)
+
+	for _, addr := range [][]byte{addr, address.Module("module", []byte("key"))} {
+		mainAddr, err := coreumaddress.ToBech32(constant.AddressPrefixMain, addr)
+		requireT.NoError(err)
+		expected, err := sdk.Bech32ifyAddressBytes(constant.AddressPrefixMain, addr)
+		requireT.NoError(err)
+		requireT.Equal(expected, mainAddr)
+
+		requireT.NoError(coreumaddress.Validate(constant.AddressPrefixMain, mainAddr))
+		requireT.Error(coreumaddress.Validate(constant.AddressPrefixDev, mainAddr))
+
+		devAddr, err := coreumaddress.ConvertPrefix(mainAddr, constant.AddressPrefixDev)
+		requireT.NoError(err)
+		prefix, decoded, err := coreumaddress.Parse(devAddr)
+		requireT.NoError(err)
+		requireT.Equal(constant.AddressPrefixDev, prefix)
+		requireT.Equal(addr, decoded)
+
+		hexAddr, err := coreumaddress.Bech32ToHex(constant.AddressPrefixDev, devAddr)
+		requireT.NoError(err)
+		requireT.Equal(hexAddr, fmt.Sprintf("%X", addr))
+
+		bech32Addr, err := coreumaddress.HexToBech32(constant.AddressPrefixDev, "0x"+hexAddr)
+		requireT.NoError(err)
+		requireT.Equal(devAddr, bech32Addr)
+	}
+
+	_, err = coreumaddress.ToBech32(constant.AddressPrefixMain, []byte{0x01})
+	requireT.Error(err)
+	_, err = coreumaddress.HexToBech32(constant.AddressPrefixMain, "zz")
+	requireT.Error(err)
+	_, _, err = coreumaddress.Parse("core1invalid")
+	requireT.Error(err)
+}
@@ -0,0 +1,106 @@
+package address
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/cosmos/btcutil/hdkeychain"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+)
+
+// HDPath returns the BIP44 path of the Coreum key, e.g. m/44'/990'/0'/0/0.
+func HDPath(account, change, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/%d/%d", constant.CoinType, account, change, index)
+}
+
+// ParseHDPath parses the derivation path into the child indexes. The path might be absolute (starting with "m/")
+// or relative to the extended key (e.g. "0/5"). The hardened indexes are marked with the apostrophe.
+func ParseHDPath(path string) ([]uint32, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "m"), "/")
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(path, "/")
+	indexes := make([]uint32, 0, len(parts))
+	for _, part := range parts {
+		var hardened bool
+		if strings.HasSuffix(part, "'") {
+			hardened = true
+			part = strings.TrimSuffix(part, "'")
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid index %q of the path %q", part, path)
+		}
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, errors.Errorf("index %d of the path %q is out of range", index, path)
+		}
+		if hardened {
+			index += hdkeychain.HardenedKeyStart
+		}
+		indexes = append(indexes, uint32(index))
+	}
+
+	return indexes, nil
+}
+
+// FromExtendedKey derives the address bytes from the base58-encoded extended key (xpub or xprv) using the path
+// relative to the key. The hardened indexes might be derived from the private key only, so the xpub of the account
+// (m/44'/990'/account') is usually exported to derive the addresses by the path "change/index".
+func FromExtendedKey(extendedKey, path string) ([]byte, error) {
+	key, err := hdkeychain.NewKeyFromString(extendedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid extended key")
+	}
+	return fromKey(key, path)
+}
+
+// FromSeed derives the address bytes from the BIP39 seed using the absolute path.
+func FromSeed(seed []byte, path string) ([]byte, error) {
+	key, err := NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	return fromKey(key, path)
+}
+
+// NewMasterKey returns the master extended private key of the BIP39 seed.
+func NewMasterKey(seed []byte) (*hdkeychain.ExtendedKey, error) {
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't create master key")
+	}
+	return key, nil
+}
+
+// DeriveKey derives the child extended key by the path relative to the key.
+func DeriveKey(key *hdkeychain.ExtendedKey, path string) (*hdkeychain.ExtendedKey, error) {
+	indexes, err := ParseHDPath(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		key, err = key.Derive(index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't derive child %d of the path %q", index, path)
+		}
+	}
+	return key, nil
+}
+
+func fromKey(key *hdkeychain.ExtendedKey, path string) ([]byte, error) {
+	key, err := DeriveKey(key, path)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get public key")
+	}
+	return FromPubKey(pubKey.SerializeCompressed())
+}
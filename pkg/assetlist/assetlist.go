@@ -0,0 +1,92 @@
+// Package assetlist generates the asset list of the chain in the format of the cosmos chain registry, so the wallets
+// and the IBC frontends pick up the tokens of the chain without the manual changes of the registry.
+package assetlist
+
+import (
+	"sort"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+const (
+	// Schema is the path of the schema of the asset list relative to the asset list in the chain registry.
+	Schema = "../assetlist.schema.json"
+	// TypeAssetSDKCoin is the type of the asset stored by the bank module.
+	TypeAssetSDKCoin = "sdk.coin"
+)
+
+// AssetList is the asset list of the chain registry.
+type AssetList struct {
+	Schema    string  `json:"$schema"`
+	ChainName string  `json:"chain_name"`
+	Assets    []Asset `json:"assets"`
+}
+
+// Asset is the asset of the asset list.
+type Asset struct {
+	Description string      `json:"description,omitempty"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	TypeAsset   string      `json:"type_asset"`
+	Base        string      `json:"base"`
+	Name        string      `json:"name"`
+	Display     string      `json:"display"`
+	Symbol      string      `json:"symbol"`
+	Keywords    []string    `json:"keywords,omitempty"`
+}
+
+// DenomUnit is the unit of the asset.
+type DenomUnit struct {
+	Denom    string   `json:"denom"`
+	Exponent uint32   `json:"exponent"`
+	Aliases  []string `json:"aliases,omitempty"`
+}
+
+// Build builds the asset list from the bank metadata of the denoms. The assets of the fungible tokens take the
+// description from the token definition if the metadata doesn't have one and list the enabled features as keywords.
+// The assets are sorted by the base denom.
+func Build(chainName string, metadatas []banktypes.Metadata, tokens []assetfttypes.FT) AssetList {
+	tokensByDenom := make(map[string]assetfttypes.FT, len(tokens))
+	for _, token := range tokens {
+		tokensByDenom[token.Denom] = token
+	}
+
+	assets := make([]Asset, 0, len(metadatas))
+	for _, metadata := range metadatas {
+		asset := Asset{
+			Description: metadata.Description,
+			DenomUnits:  make([]DenomUnit, 0, len(metadata.DenomUnits)),
+			TypeAsset:   TypeAssetSDKCoin,
+			Base:        metadata.Base,
+			Name:        metadata.Name,
+			Display:     metadata.Display,
+			Symbol:      metadata.Symbol,
+		}
+		for _, unit := range metadata.DenomUnits {
+			asset.DenomUnits = append(asset.DenomUnits, DenomUnit{
+				Denom:    unit.Denom,
+				Exponent: unit.Exponent,
+				Aliases:  unit.Aliases,
+			})
+		}
+		if token, ok := tokensByDenom[metadata.Base]; ok {
+			if asset.Description == "" {
+				asset.Description = token.Description
+			}
+			for _, feature := range token.Features {
+				asset.Keywords = append(asset.Keywords, feature.String())
+			}
+		}
+		assets = append(assets, asset)
+	}
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].Base < assets[j].Base
+	})
+
+	return AssetList{
+		Schema:    Schema,
+		ChainName: chainName,
+		Assets:    assets,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/x/nft"
+	oracletypes "github.com/CoreumFoundation/coreum/x/oracle/types"
 )
 
 // DefaultDeterministicGasRequirements returns default config for deterministic gas
@@ -21,17 +22,63 @@ func DefaultDeterministicGasRequirements() DeterministicGasRequirements {
 		FreeBytes:      2048,
 		FreeSignatures: 1,
 
-		AssetFTIssue:               80000,
-		AssetFTMint:                35000,
-		AssetFTBurn:                35000,
-		AssetFTFreeze:              55000,
-		AssetFTUnfreeze:            55000,
-		AssetFTGloballyFreeze:      5000,
-		AssetFTGloballyUnfreeze:    5000,
-		AssetFTSetWhitelistedLimit: 35000,
+		AssetFTIssue:                            80000,
+		AssetFTMint:                             35000,
+		AssetFTBurn:                             35000,
+		AssetFTGrantBurnAllowance:               25000,
+		AssetFTBurnFrom:                         40000,
+		AssetFTFreeze:                           55000,
+		AssetFTUnfreeze:                         55000,
+		AssetFTGloballyFreeze:                   5000,
+		AssetFTGloballyUnfreeze:                 5000,
+		AssetFTSetTokenStatus:                   5000,
+		AssetFTSetWhitelistedLimit:              35000,
+		AssetFTSetWhitelistExemption:            35000,
+		AssetFTSetBurnRateExemption:             35000,
+		AssetFTUpdateTokenMetadata:              25000,
+		AssetFTSetTokenAttribute:                25000,
+		AssetFTSetFrozenRate:                    35000,
+		AssetFTFreezeUntil:                      55000,
+		AssetFTFreezeAccount:                    15000,
+		AssetFTUnfreezeAccount:                  15000,
+		AssetFTBatchFreezePerEntry:              50000,
+		AssetFTBatchUnfreezePerEntry:            50000,
+		AssetFTBatchSetWhitelistedLimitPerEntry: 30000,
+		AssetFTWrap:                             50000,
+		AssetFTUnwrap:                           50000,
+		AssetFTBridgeMint:                       40000,
+		AssetFTBridgeMintPerAttestation:         5000,
+		AssetFTBridgeBurn:                       35000,
+		AssetFTRegisterIBCDenom:                 15000,
+		AssetFTReserve:                          50000,
+		AssetFTRelease:                          40000,
+		AssetFTCapture:                          60000,
+		AssetFTPublishReserveAttestation:        30000,
+		AssetFTTransferAdmin:                    10000,
+		AssetFTClearAdmin:                       10000,
+		AssetFTSendFreezeFeature:                5000,
+		AssetFTSendWhitelistFeature:             3000,
+		AssetFTSendReceiveHookFeature:           2000,
 
-		AssetNFTIssueClass: 20000,
-		AssetNFTMint:       30000,
+		AssetNFTIssueClass:               20000,
+		AssetNFTMint:                     30000,
+		AssetNFTReserveIDPrefix:          10000,
+		AssetNFTTransferWithPayment:      50000,
+		AssetNFTCancelSaleOffer:          10000,
+		AssetNFTGrantUser:                20000,
+		AssetNFTRevokeUser:               10000,
+		AssetNFTTransferClassOwnership:   10000,
+		AssetNFTAcceptClassOwnership:     10000,
+		AssetNFTCreateRewardPool:         10000,
+		AssetNFTFundRewardPool:           20000,
+		AssetNFTLockNFT:                  10000,
+		AssetNFTUnlockNFT:                20000,
+		AssetNFTClassFreeze:              8000,
+		AssetNFTClassUnfreeze:            8000,
+		AssetNFTRevokeNFT:                30000,
+		AssetNFTAddToClassWhitelist:      8000,
+		AssetNFTRemoveFromClassWhitelist: 8000,
+		AssetNFTBurnNFT:                  20000,
 
 		BankSendPerEntry:      22000,
 		BankMultiSendPerEntry: 27000,
@@ -48,6 +95,8 @@ func DefaultDeterministicGasRequirements() DeterministicGasRequirements {
 
 		NFTSend: 20000,
 
+		OracleSubmitExchangeRateVote: 15000,
+
 		SlashingUnjail: 25000,
 
 		StakingDelegate:        51000,
@@ -75,18 +124,66 @@ type DeterministicGasRequirements struct {
 	FreeSignatures uint64
 
 	// x/asset/ft
-	AssetFTIssue               uint64
-	AssetFTMint                uint64
-	AssetFTBurn                uint64
-	AssetFTFreeze              uint64
-	AssetFTUnfreeze            uint64
-	AssetFTGloballyFreeze      uint64
-	AssetFTGloballyUnfreeze    uint64
-	AssetFTSetWhitelistedLimit uint64
+	AssetFTIssue                            uint64
+	AssetFTMint                             uint64
+	AssetFTBurn                             uint64
+	AssetFTGrantBurnAllowance               uint64
+	AssetFTBurnFrom                         uint64
+	AssetFTFreeze                           uint64
+	AssetFTUnfreeze                         uint64
+	AssetFTGloballyFreeze                   uint64
+	AssetFTGloballyUnfreeze                 uint64
+	AssetFTSetTokenStatus                   uint64
+	AssetFTSetWhitelistedLimit              uint64
+	AssetFTSetWhitelistExemption            uint64
+	AssetFTSetBurnRateExemption             uint64
+	AssetFTUpdateTokenMetadata              uint64
+	AssetFTSetTokenAttribute                uint64
+	AssetFTSetFrozenRate                    uint64
+	AssetFTFreezeUntil                      uint64
+	AssetFTFreezeAccount                    uint64
+	AssetFTUnfreezeAccount                  uint64
+	AssetFTBatchFreezePerEntry              uint64
+	AssetFTBatchUnfreezePerEntry            uint64
+	AssetFTBatchSetWhitelistedLimitPerEntry uint64
+	AssetFTWrap                             uint64
+	AssetFTUnwrap                           uint64
+	AssetFTBridgeMint                       uint64
+	AssetFTBridgeMintPerAttestation         uint64
+	AssetFTBridgeBurn                       uint64
+	AssetFTRegisterIBCDenom                 uint64
+	AssetFTReserve                          uint64
+	AssetFTRelease                          uint64
+	AssetFTCapture                          uint64
+	AssetFTPublishReserveAttestation        uint64
+	AssetFTTransferAdmin                    uint64
+	AssetFTClearAdmin                       uint64
+	// AssetFTSend*Feature is the gas charged on top of the deterministic gas of the message for each fungible token
+	// with the feature enabled sent by the message. It covers the checks of the feature done on the send.
+	AssetFTSendFreezeFeature      uint64
+	AssetFTSendWhitelistFeature   uint64
+	AssetFTSendReceiveHookFeature uint64
 
 	// x/asset/nft
-	AssetNFTIssueClass uint64
-	AssetNFTMint       uint64
+	AssetNFTIssueClass               uint64
+	AssetNFTMint                     uint64
+	AssetNFTReserveIDPrefix          uint64
+	AssetNFTTransferWithPayment      uint64
+	AssetNFTCancelSaleOffer          uint64
+	AssetNFTGrantUser                uint64
+	AssetNFTRevokeUser               uint64
+	AssetNFTTransferClassOwnership   uint64
+	AssetNFTAcceptClassOwnership     uint64
+	AssetNFTCreateRewardPool         uint64
+	AssetNFTFundRewardPool           uint64
+	AssetNFTLockNFT                  uint64
+	AssetNFTUnlockNFT                uint64
+	AssetNFTClassFreeze              uint64
+	AssetNFTClassUnfreeze            uint64
+	AssetNFTRevokeNFT                uint64
+	AssetNFTAddToClassWhitelist      uint64
+	AssetNFTRemoveFromClassWhitelist uint64
+	AssetNFTBurnNFT                  uint64
 
 	// x/bank
 	BankSendPerEntry      uint64
@@ -107,6 +204,9 @@ type DeterministicGasRequirements struct {
 	// x/nft
 	NFTSend uint64
 
+	// x/oracle
+	OracleSubmitExchangeRateVote uint64
+
 	// x/slashing
 	SlashingUnjail uint64
 
@@ -134,20 +234,104 @@ func (dgr DeterministicGasRequirements) GasRequiredByMessage(msg sdk.Msg) (uint6
 		return dgr.AssetFTFreeze, true
 	case *assetfttypes.MsgUnfreeze:
 		return dgr.AssetFTUnfreeze, true
+	case *assetfttypes.MsgSetFrozenRate:
+		return dgr.AssetFTSetFrozenRate, true
+	case *assetfttypes.MsgFreezeUntil:
+		return dgr.AssetFTFreezeUntil, true
+	case *assetfttypes.MsgFreezeAccount:
+		return dgr.AssetFTFreezeAccount, true
+	case *assetfttypes.MsgUnfreezeAccount:
+		return dgr.AssetFTUnfreezeAccount, true
+	case *assetfttypes.MsgBatchFreeze:
+		return batchEntriesNum(m.Entries) * dgr.AssetFTBatchFreezePerEntry, true
+	case *assetfttypes.MsgBatchUnfreeze:
+		return batchEntriesNum(m.Entries) * dgr.AssetFTBatchUnfreezePerEntry, true
 	case *assetfttypes.MsgGloballyFreeze:
 		return dgr.AssetFTFreeze, true
 	case *assetfttypes.MsgGloballyUnfreeze:
 		return dgr.AssetFTUnfreeze, true
+	case *assetfttypes.MsgSetTokenStatus:
+		return dgr.AssetFTSetTokenStatus, true
 	case *assetfttypes.MsgMint:
 		return dgr.AssetFTMint, true
 	case *assetfttypes.MsgBurn:
 		return dgr.AssetFTBurn, true
+	case *assetfttypes.MsgGrantBurnAllowance:
+		return dgr.AssetFTGrantBurnAllowance, true
+	case *assetfttypes.MsgBurnFrom:
+		return dgr.AssetFTBurnFrom, true
 	case *assetfttypes.MsgSetWhitelistedLimit:
 		return dgr.AssetFTSetWhitelistedLimit, true
+	case *assetfttypes.MsgSetWhitelistExemption:
+		return dgr.AssetFTSetWhitelistExemption, true
+	case *assetfttypes.MsgBatchSetWhitelistedLimit:
+		return batchEntriesNum(m.Entries) * dgr.AssetFTBatchSetWhitelistedLimitPerEntry, true
+	case *assetfttypes.MsgSetBurnRateExemption:
+		return dgr.AssetFTSetBurnRateExemption, true
+	case *assetfttypes.MsgUpdateTokenMetadata:
+		return dgr.AssetFTUpdateTokenMetadata, true
+	case *assetfttypes.MsgSetTokenAttribute:
+		return dgr.AssetFTSetTokenAttribute, true
+	case *assetfttypes.MsgWrap:
+		return dgr.AssetFTWrap, true
+	case *assetfttypes.MsgUnwrap:
+		return dgr.AssetFTUnwrap, true
+	case *assetfttypes.MsgBridgeMint:
+		return dgr.AssetFTBridgeMint + uint64(len(m.Attestations))*dgr.AssetFTBridgeMintPerAttestation, true
+	case *assetfttypes.MsgBridgeBurn:
+		return dgr.AssetFTBridgeBurn, true
+	case *assetfttypes.MsgRegisterIBCDenom:
+		return dgr.AssetFTRegisterIBCDenom, true
+	case *assetfttypes.MsgReserve:
+		return dgr.AssetFTReserve, true
+	case *assetfttypes.MsgRelease:
+		return dgr.AssetFTRelease, true
+	case *assetfttypes.MsgCapture:
+		return dgr.AssetFTCapture, true
+	case *assetfttypes.MsgPublishReserveAttestation:
+		return dgr.AssetFTPublishReserveAttestation, true
+	case *assetfttypes.MsgTransferAdmin:
+		return dgr.AssetFTTransferAdmin, true
+	case *assetfttypes.MsgClearAdmin:
+		return dgr.AssetFTClearAdmin, true
 	case *assetnfttypes.MsgIssueClass:
 		return dgr.AssetNFTIssueClass, true
 	case *assetnfttypes.MsgMint:
 		return dgr.AssetNFTMint, true
+	case *assetnfttypes.MsgReserveIDPrefix:
+		return dgr.AssetNFTReserveIDPrefix, true
+	case *assetnfttypes.MsgTransferWithPayment:
+		return dgr.AssetNFTTransferWithPayment, true
+	case *assetnfttypes.MsgCancelSaleOffer:
+		return dgr.AssetNFTCancelSaleOffer, true
+	case *assetnfttypes.MsgGrantUser:
+		return dgr.AssetNFTGrantUser, true
+	case *assetnfttypes.MsgRevokeUser:
+		return dgr.AssetNFTRevokeUser, true
+	case *assetnfttypes.MsgTransferClassOwnership:
+		return dgr.AssetNFTTransferClassOwnership, true
+	case *assetnfttypes.MsgAcceptClassOwnership:
+		return dgr.AssetNFTAcceptClassOwnership, true
+	case *assetnfttypes.MsgCreateRewardPool:
+		return dgr.AssetNFTCreateRewardPool, true
+	case *assetnfttypes.MsgFundRewardPool:
+		return dgr.AssetNFTFundRewardPool, true
+	case *assetnfttypes.MsgLockNFT:
+		return dgr.AssetNFTLockNFT, true
+	case *assetnfttypes.MsgUnlockNFT:
+		return dgr.AssetNFTUnlockNFT, true
+	case *assetnfttypes.MsgClassFreeze:
+		return dgr.AssetNFTClassFreeze, true
+	case *assetnfttypes.MsgClassUnfreeze:
+		return dgr.AssetNFTClassUnfreeze, true
+	case *assetnfttypes.MsgRevokeNFT:
+		return dgr.AssetNFTRevokeNFT, true
+	case *assetnfttypes.MsgAddToClassWhitelist:
+		return dgr.AssetNFTAddToClassWhitelist, true
+	case *assetnfttypes.MsgRemoveFromClassWhitelist:
+		return dgr.AssetNFTRemoveFromClassWhitelist, true
+	case *assetnfttypes.MsgBurnNFT:
+		return dgr.AssetNFTBurnNFT, true
 	case *banktypes.MsgSend:
 		entriesNum := len(m.Amount)
 		if len(m.Amount) == 0 {
@@ -190,6 +374,8 @@ func (dgr DeterministicGasRequirements) GasRequiredByMessage(msg sdk.Msg) (uint6
 		return dgr.GovDeposit, true
 	case *nft.MsgSend:
 		return dgr.NFTSend, true
+	case *oracletypes.MsgSubmitExchangeRateVote:
+		return dgr.OracleSubmitExchangeRateVote, true
 	case *slashingtypes.MsgUnjail:
 		return dgr.SlashingUnjail, true
 	case *stakingtypes.MsgDelegate:
@@ -207,6 +393,45 @@ func (dgr DeterministicGasRequirements) GasRequiredByMessage(msg sdk.Msg) (uint6
 	}
 }
 
+// batchEntriesNum returns the number of the entries the batch message is charged for, the empty batch is charged as
+// the single entry.
+func batchEntriesNum(entries []assetfttypes.AccountCoin) uint64 {
+	if len(entries) == 0 {
+		return 1
+	}
+	return uint64(len(entries))
+}
+
+// AssetFTSendFeatureGas returns the gas charged on top of the deterministic gas of the message for each fungible token
+// sent, per feature enabled for the token.
+func (dgr DeterministicGasRequirements) AssetFTSendFeatureGas() map[assetfttypes.TokenFeature]uint64 {
+	return map[assetfttypes.TokenFeature]uint64{
+		assetfttypes.TokenFeature_freeze:       dgr.AssetFTSendFreezeFeature,      //nolint:nosnakecase
+		assetfttypes.TokenFeature_whitelist:    dgr.AssetFTSendWhitelistFeature,   //nolint:nosnakecase
+		assetfttypes.TokenFeature_receive_hook: dgr.AssetFTSendReceiveHookFeature, //nolint:nosnakecase
+	}
+}
+
+// TxGas returns the gas required by the tx containing the messages if all of them have the deterministic gas.
+// The size and the signatures exceeding the free ones are charged on top of the deterministic gas of the messages.
+func (dgr DeterministicGasRequirements) TxGas(msgs []sdk.Msg, txSize, signatures int, authParams authtypes.Params) (uint64, bool) {
+	gas := dgr.FixedGas
+	for _, msg := range msgs {
+		msgGas, exists := dgr.GasRequiredByMessage(msg)
+		if !exists {
+			return 0, false
+		}
+		gas += msgGas
+	}
+
+	txGas := uint64(txSize)*authParams.TxSizeCostPerByte + uint64(signatures)*authParams.SigVerifyCostSecp256k1
+	if baseGas := dgr.TxBaseGas(authParams); txGas > baseGas {
+		gas += txGas - baseGas
+	}
+
+	return gas, true
+}
+
 // TxBaseGas is the free gas we give to every transaction to cover costs of tx size and signature verification
 func (dgr DeterministicGasRequirements) TxBaseGas(params authtypes.Params) uint64 {
 	return dgr.FreeBytes*params.TxSizeCostPerByte + dgr.FreeSignatures*params.SigVerifyCostSecp256k1
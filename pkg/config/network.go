@@ -37,6 +37,10 @@ type ChainID = constant.ChainID
 // It is string, not bool, because -X flag supports strings only.
 var EnableFakeUpgradeHandler string
 
+// EnableTimeTravel is set to true during compilation to let the integration tests running on devnet move the time of
+// the chain forward. It is string, not bool, because -X flag supports strings only.
+var EnableTimeTravel string
+
 //go:embed networks/coreum-devnet-1
 var coreumDevnet1GenTxsFS embed.FS
 
@@ -50,8 +54,9 @@ func init() {
 
 		govConfig = GovConfig{
 			ProposalConfig: GovProposalConfig{
-				MinDepositAmount: "4000000000", // 4,000 CORE
-				VotingPeriod:     "120h",       // 5 days
+				MinDepositAmount:      "4000000000", // 4,000 CORE
+				VotingPeriod:          "120h",       // 5 days
+				ExpeditedVotingPeriod: "24h",        // 1 day
 			},
 		}
 
@@ -130,6 +135,7 @@ func init() {
 			},
 			GenTxs:                      readGenTxs(coreumDevnet1GenTxsFS),
 			IsFakeUpgradeHandlerEnabled: EnableFakeUpgradeHandler != "",
+			IsTimeTravelEnabled:         EnableTimeTravel != "",
 		},
 	}
 
@@ -198,6 +204,9 @@ type GovProposalConfig struct {
 
 	// VotingPeriod is the proposal voting period duration.
 	VotingPeriod string
+
+	// ExpeditedVotingPeriod is the voting period duration of the expedited proposal.
+	ExpeditedVotingPeriod string
 }
 
 // StakingConfig contains staking module configuration
@@ -238,6 +247,8 @@ type NetworkConfig struct {
 	Enabled bool
 	// TODO: remove this field once we have real upgrade handler
 	IsFakeUpgradeHandlerEnabled bool
+	// IsTimeTravelEnabled must never be set for the public networks
+	IsTimeTravelEnabled bool
 }
 
 // Network holds all the configuration for different predefined networks
@@ -253,6 +264,7 @@ type Network struct {
 	staking                  StakingConfig
 	customParams             CustomParamsConfig
 	enableFakeUpgradeHandler bool
+	enableTimeTravel         bool
 
 	mu             *sync.Mutex
 	fundedAccounts []FundedAccount
@@ -276,6 +288,7 @@ func NewNetwork(c NetworkConfig) Network {
 		fundedAccounts:           append([]FundedAccount{}, c.FundedAccounts...),
 		genTxs:                   append([]json.RawMessage{}, c.GenTxs...),
 		enableFakeUpgradeHandler: c.IsFakeUpgradeHandlerEnabled,
+		enableTimeTravel:         c.IsTimeTravelEnabled,
 	}
 
 	return n
@@ -489,6 +502,11 @@ func (n Network) IsFakeUpgradeHandlerEnabled() bool {
 	return n.enableFakeUpgradeHandler
 }
 
+// IsTimeTravelEnabled returns true if the time of the chain might be moved forward by the transactions
+func (n Network) IsTimeTravelEnabled() bool {
+	return n.enableTimeTravel
+}
+
 // DeterministicGas returns deterministic gas amounts required by some message types
 func (n Network) DeterministicGas() DeterministicGasRequirements {
 	return n.fee.DeterministicGas
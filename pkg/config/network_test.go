@@ -56,8 +56,9 @@ func testNetwork() config.Network {
 		GenTxs: []json.RawMessage{},
 		GovConfig: config.GovConfig{
 			ProposalConfig: config.GovProposalConfig{
-				MinDepositAmount: "10000000",
-				VotingPeriod:     "172800s",
+				MinDepositAmount:      "10000000",
+				VotingPeriod:          "172800s",
+				ExpeditedVotingPeriod: "86400s",
 			},
 		},
 		StakingConfig: config.StakingConfig{
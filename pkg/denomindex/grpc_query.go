@@ -0,0 +1,43 @@
+package denomindex
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+var _ QueryServer = QueryService{}
+
+// QueryService serves grpc query requests for the denom index.
+type QueryService struct {
+	index *Index
+}
+
+// NewQueryService initiates the new instance of query service. The index is nil if it is disabled on the node.
+func NewQueryService(index *Index) QueryService {
+	return QueryService{
+		index: index,
+	}
+}
+
+// Txs returns the transactions involving the fungible token.
+func (qs QueryService) Txs(ctx context.Context, req *QueryTxsRequest) (*QueryTxsResponse, error) {
+	if qs.index == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "denom index is disabled on the node, set %q to true in app.toml", FlagEnable)
+	}
+	if _, _, err := assetfttypes.DeconstructDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	txs, pageRes, err := qs.index.Txs(req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
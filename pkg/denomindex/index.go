@@ -0,0 +1,181 @@
+// Package denomindex implements the optional node-level index of the transactions involving the fungible tokens.
+// The index is not the part of the consensus state, it is built by the node from the events of the delivered
+// transactions and stored in the separate database.
+package denomindex
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+const (
+	// FlagEnable is the app.toml option enabling the index.
+	FlagEnable = "denom-index.enable"
+	// DBName is the name of the database storing the index in the data directory of the node.
+	DBName = "denom_index"
+
+	assetFTEventPrefix = "coreum.asset.ft.v1."
+)
+
+// txKeyPrefix defines the key prefix for the transactions of the denom.
+var txKeyPrefix = []byte{0x01}
+
+var _ baseapp.StreamingService = &Index{}
+
+// Index indexes the hashes of the delivered transactions by the fungible token denoms they involve.
+// It is registered in the BaseApp as the streaming service to receive the ABCI messages.
+type Index struct {
+	db dbm.DB
+
+	height  int64
+	txIndex uint32
+}
+
+// New returns the index stored in the database.
+func New(db dbm.DB) *Index {
+	return &Index{
+		db: db,
+	}
+}
+
+// ListenBeginBlock starts indexing of the new block.
+func (i *Index) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	i.height = req.Header.Height
+	i.txIndex = 0
+	return nil
+}
+
+// ListenEndBlock does nothing, the index is updated on each transaction.
+func (i *Index) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx stores the hash of the successful transaction under each fungible token denom found in its events.
+func (i *Index) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	txIndex := i.txIndex
+	i.txIndex++
+
+	if !res.IsOK() {
+		return nil
+	}
+
+	denoms := Denoms(res.Events)
+	if len(denoms) == 0 {
+		return nil
+	}
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	hash := tmhash.Sum(req.Tx)
+	for _, denom := range denoms {
+		if err := batch.Set(getTxKey(denom, i.height, txIndex), hash); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return errors.WithStack(batch.Write())
+}
+
+// Listeners returns no store listeners, the index is built from the ABCI messages only.
+func (i *Index) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Stream does nothing, the index is updated synchronously by the ABCI listener.
+func (i *Index) Stream(wg *sync.WaitGroup) error {
+	return nil
+}
+
+// Close closes the database of the index.
+func (i *Index) Close() error {
+	return errors.WithStack(i.db.Close())
+}
+
+// Txs returns the transactions involving the denom, ordered by height.
+func (i *Index) Txs(denom string, pagination *query.PageRequest) ([]IndexedTx, *query.PageResponse, error) {
+	txs := []IndexedTx{}
+	txStore := prefix.NewStore(dbadapter.Store{DB: i.db}, createTxsPrefix(denom))
+	pageRes, err := query.Paginate(txStore, pagination, func(key, value []byte) error {
+		if len(key) < 8 {
+			return errors.Errorf("invalid key %X of the indexed transaction", key)
+		}
+		txs = append(txs, IndexedTx{
+			Height: int64(binary.BigEndian.Uint64(key[:8])),
+			Hash:   fmt.Sprintf("%X", value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txs, pageRes, nil
+}
+
+// Denoms returns the fungible token denoms involved in the events. The denoms are taken from the denom attributes
+// of the asset ft events and from the amount attributes of the bank events.
+func Denoms(events []abci.Event) []string {
+	var denoms []string
+	seen := map[string]struct{}{}
+	add := func(denom string) {
+		if _, ok := seen[denom]; ok {
+			return
+		}
+		if _, _, err := assetfttypes.DeconstructDenom(denom); err != nil {
+			return
+		}
+		seen[denom] = struct{}{}
+		denoms = append(denoms, denom)
+	}
+
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			switch {
+			case strings.HasPrefix(event.Type, assetFTEventPrefix) && string(attr.Key) == "denom":
+				// typed events store the JSON encoded values
+				var denom string
+				if err := json.Unmarshal(attr.Value, &denom); err == nil {
+					add(denom)
+				}
+			case string(attr.Key) == sdk.AttributeKeyAmount:
+				coins, err := sdk.ParseCoinsNormalized(string(attr.Value))
+				if err != nil {
+					continue
+				}
+				for _, coin := range coins {
+					add(coin.Denom)
+				}
+			}
+		}
+	}
+
+	return denoms
+}
+
+func createTxsPrefix(denom string) []byte {
+	return store.JoinKeysWithLength(txKeyPrefix, []byte(denom))
+}
+
+func getTxKey(denom string, height int64, txIndex uint32) []byte {
+	key := make([]byte, 12)
+	binary.BigEndian.PutUint64(key, uint64(height))
+	binary.BigEndian.PutUint32(key[8:], txIndex)
+	return store.JoinKeys(createTxsPrefix(denom), key)
+}
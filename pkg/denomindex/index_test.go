@@ -0,0 +1,96 @@
+package denomindex_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/pkg/denomindex"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestIndex(t *testing.T) {
+	requireT := require.New(t)
+
+	issuer := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	denom1 := assetfttypes.BuildDenom("abc", issuer)
+	denom2 := assetfttypes.BuildDenom("def", issuer)
+
+	index := denomindex.New(dbm.NewMemDB())
+	ctx := sdk.Context{}
+
+	transferEvent := func(coins sdk.Coins) abci.Event {
+		return abci.Event{
+			Type: "transfer",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(sdk.AttributeKeyAmount), Value: []byte(coins.String())},
+			},
+		}
+	}
+
+	deliverTx := func(tx []byte, code uint32, events ...abci.Event) {
+		requireT.NoError(index.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: tx}, abci.ResponseDeliverTx{
+			Code:   code,
+			Events: events,
+		}))
+	}
+
+	// block 1
+	requireT.NoError(index.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	deliverTx([]byte("issue"), 0, abci.Event{
+		Type: "coreum.asset.ft.v1.EventTokenIssued",
+		Attributes: []abci.EventAttribute{
+			{Key: []byte("denom"), Value: []byte(fmt.Sprintf("%q", denom1))},
+		},
+	})
+	deliverTx([]byte("failed"), 1, transferEvent(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1))))
+	deliverTx([]byte("native"), 0, transferEvent(sdk.NewCoins(sdk.NewInt64Coin("ucore", 1))))
+	requireT.NoError(index.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+
+	// block 2
+	requireT.NoError(index.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}, abci.ResponseBeginBlock{}))
+	deliverTx([]byte("send"), 0, transferEvent(sdk.NewCoins(
+		sdk.NewInt64Coin(denom1, 1),
+		sdk.NewInt64Coin(denom2, 1),
+		sdk.NewInt64Coin("ucore", 1),
+	)))
+
+	qs := denomindex.NewQueryService(index)
+
+	res, err := qs.Txs(context.Background(), &denomindex.QueryTxsRequest{Denom: denom1})
+	requireT.NoError(err)
+	requireT.Equal([]denomindex.IndexedTx{
+		{Height: 1, Hash: fmt.Sprintf("%X", tmhash.Sum([]byte("issue")))},
+		{Height: 2, Hash: fmt.Sprintf("%X", tmhash.Sum([]byte("send")))},
+	}, res.Txs)
+
+	res, err = qs.Txs(context.Background(), &denomindex.QueryTxsRequest{
+		Denom:      denom1,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]denomindex.IndexedTx{
+		{Height: 2, Hash: fmt.Sprintf("%X", tmhash.Sum([]byte("send")))},
+	}, res.Txs)
+
+	res, err = qs.Txs(context.Background(), &denomindex.QueryTxsRequest{Denom: denom2})
+	requireT.NoError(err)
+	requireT.Len(res.Txs, 1)
+
+	_, err = qs.Txs(context.Background(), &denomindex.QueryTxsRequest{Denom: "ucore"})
+	requireT.Error(err)
+
+	// disabled index
+	_, err = denomindex.NewQueryService(nil).Txs(context.Background(), &denomindex.QueryTxsRequest{Denom: denom1})
+	requireT.True(sdkerrors.ErrNotSupported.Is(err))
+}
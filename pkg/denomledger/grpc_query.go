@@ -0,0 +1,67 @@
+package denomledger
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ QueryServer = QueryService{}
+
+// QueryService serves grpc query requests for the denom ledger.
+type QueryService struct {
+	ledger *Ledger
+}
+
+// NewQueryService initiates the new instance of query service. The ledger is nil if it is disabled on the node.
+func NewQueryService(ledger *Ledger) QueryService {
+	return QueryService{
+		ledger: ledger,
+	}
+}
+
+// Entries returns the ledger entries of the denom.
+func (qs QueryService) Entries(ctx context.Context, req *QueryEntriesRequest) (*QueryEntriesResponse, error) {
+	if qs.ledger == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "denom ledger is disabled on the node, set %q to true in app.toml", FlagEnable)
+	}
+	if !qs.ledger.IsRecorded(req.GetDenom()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "denom %q is not recorded by the ledger, add it to %q in app.toml", req.GetDenom(), FlagDenoms)
+	}
+	if req.Account != "" && req.Account != SupplyAccount {
+		if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %q", req.Account)
+		}
+	}
+	from, err := parseTime(req.FromTime)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTime(req.ToTime)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, pageRes, err := qs.ledger.Entries(req.Denom, req.Account, from, to, req.Pagination)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &QueryEntriesResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid time %q, RFC 3339 format is expected", value)
+	}
+	return t, nil
+}
@@ -0,0 +1,376 @@
+// Package denomledger implements the optional node-level ledger of the configured denoms. The ledger records every
+// operation affecting the balances of the denom in the double-entry form, so the issuers may export the movements of
+// their tokens for the financial reporting. The ledger is not the part of the consensus state, it is built by the node
+// from the events of the processed blocks and stored in the separate database.
+package denomledger
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+const (
+	// FlagEnable is the app.toml option enabling the ledger.
+	FlagEnable = "denom-ledger.enable"
+	// FlagDenoms is the app.toml option listing the denoms recorded by the ledger.
+	FlagDenoms = "denom-ledger.denoms"
+	// DBName is the name of the database storing the ledger in the data directory of the node.
+	DBName = "denom_ledger"
+
+	// SupplyAccount is the account balancing the postings of the minted and burnt amounts.
+	SupplyAccount = "supply"
+)
+
+var (
+	// entryKeyPrefix defines the key prefix for the entries of the denom.
+	entryKeyPrefix = []byte{0x01}
+	// accountEntryKeyPrefix defines the key prefix for the references to the entries posted to the account.
+	accountEntryKeyPrefix = []byte{0x02}
+)
+
+// explicitBurnActions are the messages burning the fungible tokens on request of the sender. The other burns of
+// the asset ft module executed by the transactions are caused by the burn rate.
+var explicitBurnActions = map[string]struct{}{
+	sdk.MsgTypeURL(&assetfttypes.MsgBurn{}):       {},
+	sdk.MsgTypeURL(&assetfttypes.MsgBridgeBurn{}): {},
+	sdk.MsgTypeURL(&assetfttypes.MsgUnwrap{}):     {},
+}
+
+var _ baseapp.StreamingService = &Ledger{}
+
+// Ledger records the balanced journal entries of the operations affecting the balances of the configured denoms.
+// It is registered in the BaseApp as the streaming service to receive the ABCI messages.
+type Ledger struct {
+	db           dbm.DB
+	denoms       map[string]struct{}
+	assetFTAddr  string
+	height       int64
+	blockTime    time.Time
+	nextSequence uint32
+}
+
+// New returns the ledger of the denoms stored in the database.
+func New(db dbm.DB, denoms []string) (*Ledger, error) {
+	if len(denoms) == 0 {
+		return nil, errors.New("no denoms to record")
+	}
+
+	denomSet := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, errors.Wrapf(err, "invalid denom %q", denom)
+		}
+		denomSet[denom] = struct{}{}
+	}
+
+	return &Ledger{
+		db:          db,
+		denoms:      denomSet,
+		assetFTAddr: authtypes.NewModuleAddress(assetfttypes.ModuleName).String(),
+	}, nil
+}
+
+// IsRecorded returns true if the ledger records the denom.
+func (l *Ledger) IsRecorded(denom string) bool {
+	_, ok := l.denoms[denom]
+	return ok
+}
+
+// ListenBeginBlock starts recording of the new block and records the operations executed by the begin blockers.
+func (l *Ledger) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.height = req.Header.Height
+	l.blockTime = req.Header.Time
+	l.nextSequence = 0
+	return l.record(Journal(res.Events, l.IsRecorded, noBurnRate), "")
+}
+
+// ListenEndBlock records the operations executed by the end blockers.
+func (l *Ledger) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return l.record(Journal(res.Events, l.IsRecorded, noBurnRate), "")
+}
+
+// ListenDeliverTx records the operations executed by the transaction. The failed transaction is recorded too,
+// because its fees are paid anyway.
+func (l *Ledger) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	entries := Journal(res.Events, l.IsRecorded, func(burner, action string) bool {
+		_, explicit := explicitBurnActions[action]
+		return burner == l.assetFTAddr && !explicit
+	})
+	return l.record(entries, fmt.Sprintf("%X", tmhash.Sum(req.Tx)))
+}
+
+// Listeners returns no store listeners, the ledger is built from the ABCI messages only.
+func (l *Ledger) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Stream does nothing, the ledger is updated synchronously by the ABCI listener.
+func (l *Ledger) Stream(wg *sync.WaitGroup) error {
+	return nil
+}
+
+// Close closes the database of the ledger.
+func (l *Ledger) Close() error {
+	return errors.WithStack(l.db.Close())
+}
+
+// Entries returns the entries of the denom in the period, ordered by time. If the account is not empty, only the
+// entries posted to it are returned. The zero time leaves the period unbounded.
+func (l *Ledger) Entries(
+	denom, account string,
+	from, to time.Time,
+	pagination *query.PageRequest,
+) ([]Entry, *query.PageResponse, error) {
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	if pagination.Offset > 0 {
+		return nil, nil, errors.New("offset pagination is not supported, use the key")
+	}
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	dbStore := dbadapter.Store{DB: l.db}
+	entryStore := prefix.NewStore(dbStore, createEntriesPrefix(denom))
+	indexStore := entryStore
+	if account != "" {
+		indexStore = prefix.NewStore(dbStore, createAccountEntriesPrefix(denom, account))
+	}
+
+	var start, end []byte
+	if !from.IsZero() {
+		start = timeKey(from)
+	}
+	if !to.IsZero() {
+		end = timeKey(to)
+	}
+
+	var iterator dbm.Iterator
+	if pagination.Reverse {
+		if len(pagination.Key) > 0 {
+			// the key of the next page is inclusive
+			end = append(append([]byte{}, pagination.Key...), 0x00)
+		}
+		iterator = indexStore.ReverseIterator(start, end)
+	} else {
+		if len(pagination.Key) > 0 {
+			start = pagination.Key
+		}
+		iterator = indexStore.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	entries := []Entry{}
+	for ; iterator.Valid() && uint64(len(entries)) < limit; iterator.Next() {
+		bz := entryStore.Get(iterator.Key())
+		if bz == nil {
+			return nil, nil, errors.Errorf("entry %X not found", iterator.Key())
+		}
+		var entry Entry
+		if err := proto.Unmarshal(bz, &entry); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+		entries = append(entries, entry)
+	}
+
+	pageRes := &query.PageResponse{}
+	if iterator.Valid() {
+		pageRes.NextKey = iterator.Key()
+	}
+
+	return entries, pageRes, nil
+}
+
+func (l *Ledger) record(entries []Entry, txHash string) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	batch := l.db.NewBatch()
+	defer batch.Close()
+
+	for i := range entries {
+		entry := &entries[i]
+		entry.Height = l.height
+		entry.Time = l.blockTime
+		entry.TxHash = txHash
+
+		bz, err := proto.Marshal(entry)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		key := sequenceKey(l.blockTime, l.nextSequence)
+		l.nextSequence++
+		if err := batch.Set(store.JoinKeys(createEntriesPrefix(entry.Denom), key), bz); err != nil {
+			return errors.WithStack(err)
+		}
+		for _, account := range entry.Accounts() {
+			if err := batch.Set(store.JoinKeys(createAccountEntriesPrefix(entry.Denom, account), key), []byte{}); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+	}
+
+	return errors.WithStack(batch.Write())
+}
+
+// Accounts returns the accounts the entry is posted to, sorted.
+func (e Entry) Accounts() []string {
+	seen := map[string]struct{}{}
+	var accounts []string
+	for _, posting := range e.Postings {
+		if _, ok := seen[posting.Account]; ok {
+			continue
+		}
+		seen[posting.Account] = struct{}{}
+		accounts = append(accounts, posting.Account)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+// Journal converts the events of the bank module into the balanced journal entries of the recorded denoms.
+// The balance changes are grouped into the entry until the debits and the credits of each denom are equal, so
+// the entry covers the whole transfer, mint or burn. isBurnRate tells if the burn is caused by the burn rate, based
+// on the burner and the action of the message executing it.
+func Journal(events []abci.Event, isRecorded func(denom string) bool, isBurnRate func(burner, action string) bool) []Entry {
+	var (
+		entries []Entry
+		action  string
+		open    = map[string]*Entry{}
+		net     = map[string]sdk.Int{}
+		denoms  []string
+	)
+
+	post := func(account, amount string, debit bool, kind EntryKind) {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return
+		}
+		for _, coin := range coins {
+			if !isRecorded(coin.Denom) {
+				continue
+			}
+			entry, ok := open[coin.Denom]
+			if !ok {
+				entry = &Entry{Denom: coin.Denom}
+				open[coin.Denom] = entry
+				net[coin.Denom] = sdk.ZeroInt()
+				denoms = append(denoms, coin.Denom)
+			}
+			if kind != EntryKind_send { //nolint:nosnakecase // generated name
+				entry.Kind = kind
+			}
+
+			posting := Posting{Account: account, Debit: sdk.ZeroInt(), Credit: sdk.ZeroInt()}
+			if debit {
+				posting.Debit = coin.Amount
+				net[coin.Denom] = net[coin.Denom].Add(coin.Amount)
+			} else {
+				posting.Credit = coin.Amount
+				net[coin.Denom] = net[coin.Denom].Sub(coin.Amount)
+			}
+			entry.Postings = append(entry.Postings, posting)
+		}
+	}
+
+	flush := func(force bool) {
+		if !force {
+			for _, denom := range denoms {
+				if !net[denom].IsZero() {
+					return
+				}
+			}
+		}
+		for _, denom := range denoms {
+			entries = append(entries, *open[denom])
+		}
+		open = map[string]*Entry{}
+		net = map[string]sdk.Int{}
+		denoms = nil
+	}
+
+	for _, event := range events {
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			if _, ok := attrs[string(attr.Key)]; !ok {
+				attrs[string(attr.Key)] = string(attr.Value)
+			}
+		}
+
+		switch event.Type {
+		case sdk.EventTypeMessage:
+			if msgAction, ok := attrs[sdk.AttributeKeyAction]; ok {
+				action = msgAction
+			}
+		case banktypes.EventTypeCoinSpent:
+			post(attrs[banktypes.AttributeKeySpender], attrs[sdk.AttributeKeyAmount], false, EntryKind_send) //nolint:nosnakecase // generated name
+		case banktypes.EventTypeCoinReceived:
+			post(attrs[banktypes.AttributeKeyReceiver], attrs[sdk.AttributeKeyAmount], true, EntryKind_send) //nolint:nosnakecase // generated name
+		case banktypes.EventTypeCoinMint:
+			post(SupplyAccount, attrs[sdk.AttributeKeyAmount], false, EntryKind_mint) //nolint:nosnakecase // generated name
+			flush(false)
+		case banktypes.EventTypeCoinBurn:
+			kind := EntryKind_burn //nolint:nosnakecase // generated name
+			if isBurnRate(attrs[banktypes.AttributeKeyBurner], action) {
+				kind = EntryKind_burn_rate //nolint:nosnakecase // generated name
+			}
+			post(SupplyAccount, attrs[sdk.AttributeKeyAmount], true, kind)
+			flush(false)
+		case banktypes.EventTypeTransfer:
+			flush(false)
+		}
+	}
+	flush(true)
+
+	return entries
+}
+
+// noBurnRate is used for the burns of the blockers, which are never caused by the burn rate.
+func noBurnRate(_, _ string) bool {
+	return false
+}
+
+func createEntriesPrefix(denom string) []byte {
+	return store.JoinKeysWithLength(entryKeyPrefix, []byte(denom))
+}
+
+func createAccountEntriesPrefix(denom, account string) []byte {
+	return store.JoinKeysWithLength(store.JoinKeysWithLength(accountEntryKeyPrefix, []byte(denom)), []byte(account))
+}
+
+func timeKey(t time.Time) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(t.UnixNano()))
+	return key
+}
+
+func sequenceKey(t time.Time, sequence uint32) []byte {
+	key := make([]byte, 12)
+	copy(key, timeKey(t))
+	binary.BigEndian.PutUint32(key[8:], sequence)
+	return key
+}
@@ -0,0 +1,224 @@
+package denomledger_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/pkg/denomledger"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestLedger(t *testing.T) {
+	requireT := require.New(t)
+
+	issuer := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	sender := sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20))
+	recipient1 := sdk.AccAddress(bytes.Repeat([]byte{0x03}, 20))
+	recipient2 := sdk.AccAddress(bytes.Repeat([]byte{0x04}, 20))
+	assetFTModule := authtypes.NewModuleAddress(assetfttypes.ModuleName)
+	denom := assetfttypes.BuildDenom("abc", issuer)
+
+	_, err := denomledger.New(dbm.NewMemDB(), nil)
+	requireT.Error(err)
+	ledger, err := denomledger.New(dbm.NewMemDB(), []string{denom})
+	requireT.NoError(err)
+	ctx := sdk.Context{}
+
+	coins := func(amount int64) sdk.Coins {
+		// the coins of the other denoms are ignored
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount), sdk.NewInt64Coin("ucore", 1))
+	}
+	action := func(msg sdk.Msg) abci.Event {
+		return abci.Event(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, sdk.MsgTypeURL(msg))))
+	}
+	send := func(from, to sdk.AccAddress, amount int64) []abci.Event {
+		return []abci.Event{
+			abci.Event(banktypes.NewCoinSpentEvent(from, coins(amount))),
+			abci.Event(banktypes.NewCoinReceivedEvent(to, coins(amount))),
+			abci.Event(sdk.NewEvent(banktypes.EventTypeTransfer, sdk.NewAttribute(banktypes.AttributeKeyRecipient, to.String()))),
+		}
+	}
+	burn := func(from sdk.AccAddress, amount int64) []abci.Event {
+		return append(send(from, assetFTModule, amount),
+			abci.Event(banktypes.NewCoinSpentEvent(assetFTModule, coins(amount))),
+			abci.Event(banktypes.NewCoinBurnEvent(assetFTModule, coins(amount))),
+		)
+	}
+	deliverTx := func(tx string, events ...[]abci.Event) {
+		var res abci.ResponseDeliverTx
+		for _, e := range events {
+			res.Events = append(res.Events, e...)
+		}
+		requireT.NoError(ledger.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte(tx)}, res))
+	}
+	posting := func(account string, debit, credit int64) denomledger.Posting {
+		return denomledger.Posting{Account: account, Debit: sdk.NewInt(debit), Credit: sdk.NewInt(credit)}
+	}
+
+	// block 1
+	time1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	requireT.NoError(ledger.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1, Time: time1}}, abci.ResponseBeginBlock{}))
+	deliverTx("mint",
+		[]abci.Event{
+			action(&assetfttypes.MsgMint{}),
+			abci.Event(banktypes.NewCoinReceivedEvent(assetFTModule, coins(1000))),
+			abci.Event(banktypes.NewCoinMintEvent(assetFTModule, coins(1000))),
+		},
+		send(assetFTModule, issuer, 1000),
+	)
+	deliverTx("burn", []abci.Event{action(&assetfttypes.MsgBurn{})}, burn(issuer, 100))
+	requireT.NoError(ledger.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+
+	// block 2
+	time2 := time1.Add(time.Hour)
+	requireT.NoError(ledger.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 2, Time: time2}}, abci.ResponseBeginBlock{}))
+	deliverTx("send", []abci.Event{action(&banktypes.MsgSend{})}, burn(issuer, 10), send(issuer, sender, 100))
+	deliverTx("multi-send",
+		[]abci.Event{
+			action(&banktypes.MsgMultiSend{}),
+			abci.Event(banktypes.NewCoinSpentEvent(sender, coins(30))),
+		},
+		send(sender, recipient1, 10)[1:],
+		send(sender, recipient2, 20)[1:],
+	)
+	requireT.NoError(ledger.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 2}, abci.ResponseEndBlock{
+		Events: burn(recipient2, 5),
+	}))
+
+	qs := denomledger.NewQueryService(ledger)
+	res, err := qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{Denom: denom})
+	requireT.NoError(err)
+
+	hash := func(tx string) string {
+		return fmt.Sprintf("%X", tmhash.Sum([]byte(tx)))
+	}
+	entry := func(height int64, tx string, kind denomledger.EntryKind, postings ...denomledger.Posting) denomledger.Entry {
+		entryTime := time1
+		if height == 2 {
+			entryTime = time2
+		}
+		return denomledger.Entry{
+			Height:   height,
+			Time:     entryTime,
+			TxHash:   tx,
+			Kind:     kind,
+			Denom:    denom,
+			Postings: postings,
+		}
+	}
+	//nolint:nosnakecase // generated names
+	expected := []denomledger.Entry{
+		entry(1, hash("mint"), denomledger.EntryKind_mint,
+			posting(assetFTModule.String(), 1000, 0), posting(denomledger.SupplyAccount, 0, 1000)),
+		entry(1, hash("mint"), denomledger.EntryKind_send,
+			posting(assetFTModule.String(), 0, 1000), posting(issuer.String(), 1000, 0)),
+		entry(1, hash("burn"), denomledger.EntryKind_send,
+			posting(issuer.String(), 0, 100), posting(assetFTModule.String(), 100, 0)),
+		entry(1, hash("burn"), denomledger.EntryKind_burn,
+			posting(assetFTModule.String(), 0, 100), posting(denomledger.SupplyAccount, 100, 0)),
+		entry(2, hash("send"), denomledger.EntryKind_send,
+			posting(issuer.String(), 0, 10), posting(assetFTModule.String(), 10, 0)),
+		entry(2, hash("send"), denomledger.EntryKind_burn_rate,
+			posting(assetFTModule.String(), 0, 10), posting(denomledger.SupplyAccount, 10, 0)),
+		entry(2, hash("send"), denomledger.EntryKind_send,
+			posting(issuer.String(), 0, 100), posting(sender.String(), 100, 0)),
+		entry(2, hash("multi-send"), denomledger.EntryKind_send,
+			posting(sender.String(), 0, 30), posting(recipient1.String(), 10, 0), posting(recipient2.String(), 20, 0)),
+		entry(2, "", denomledger.EntryKind_send,
+			posting(recipient2.String(), 0, 5), posting(assetFTModule.String(), 5, 0)),
+		// the burns of the blockers are never caused by the burn rate
+		entry(2, "", denomledger.EntryKind_burn,
+			posting(assetFTModule.String(), 0, 5), posting(denomledger.SupplyAccount, 5, 0)),
+	}
+	requireT.Equal(expected, res.Entries)
+
+	// the entries are balanced
+	for _, e := range res.Entries {
+		debit, credit := sdk.ZeroInt(), sdk.ZeroInt()
+		for _, p := range e.Postings {
+			debit = debit.Add(p.Debit)
+			credit = credit.Add(p.Credit)
+		}
+		requireT.Equal(debit.String(), credit.String())
+	}
+
+	// the entries of the account
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{Denom: denom, Account: issuer.String()})
+	requireT.NoError(err)
+	requireT.Equal([]denomledger.Entry{expected[1], expected[2], expected[4], expected[6]}, res.Entries)
+
+	// the entries of the period
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{
+		Denom:    denom,
+		Account:  issuer.String(),
+		FromTime: time2.Format(time.RFC3339),
+	})
+	requireT.NoError(err)
+	requireT.Equal([]denomledger.Entry{expected[4], expected[6]}, res.Entries)
+
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{
+		Denom:   denom,
+		Account: denomledger.SupplyAccount,
+		ToTime:  time2.Format(time.RFC3339),
+	})
+	requireT.NoError(err)
+	requireT.Equal([]denomledger.Entry{expected[0], expected[3]}, res.Entries)
+
+	// pagination
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{
+		Denom:      denom,
+		Account:    issuer.String(),
+		Pagination: &query.PageRequest{Limit: 3, Reverse: true},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]denomledger.Entry{expected[6], expected[4], expected[2]}, res.Entries)
+	requireT.NotEmpty(res.Pagination.NextKey)
+
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{
+		Denom:      denom,
+		Account:    issuer.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Reverse: true},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]denomledger.Entry{expected[1]}, res.Entries)
+	requireT.Empty(res.Pagination.NextKey)
+
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Limit: 8},
+	})
+	requireT.NoError(err)
+	requireT.Len(res.Entries, 8)
+	res, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	requireT.NoError(err)
+	requireT.Equal(expected[8:], res.Entries)
+
+	// invalid requests
+	_, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{Denom: "ucore"})
+	requireT.True(sdkerrors.ErrNotSupported.Is(err))
+	_, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{Denom: denom, Account: "invalid"})
+	requireT.True(sdkerrors.ErrInvalidAddress.Is(err))
+	_, err = qs.Entries(context.Background(), &denomledger.QueryEntriesRequest{Denom: denom, FromTime: "yesterday"})
+	requireT.True(sdkerrors.ErrInvalidRequest.Is(err))
+
+	// disabled ledger
+	_, err = denomledger.NewQueryService(nil).Entries(context.Background(), &denomledger.QueryEntriesRequest{Denom: denom})
+	requireT.True(sdkerrors.ErrNotSupported.Is(err))
+}
@@ -0,0 +1,132 @@
+// Package errorcodes contains the registry of the errors returned by the coreum modules.
+package errorcodes
+
+import (
+	"encoding/json"
+	"sort"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	oracletypes "github.com/CoreumFoundation/coreum/x/oracle/types"
+	timetraveltypes "github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+// Entry describes the error returned by the module.
+type Entry struct {
+	// Name is the name of the variable holding the error in the module.
+	Name string
+	// Error is the registered error.
+	Error *sdkerrors.Error
+}
+
+// Registry returns the entries of all the errors registered by the coreum modules.
+func Registry() []Entry {
+	return []Entry{
+		{Name: "ErrInvalidInput", Error: assetfttypes.ErrInvalidInput},
+		{Name: "ErrFTNotFound", Error: assetfttypes.ErrFTNotFound},
+		{Name: "ErrFeatureNotActive", Error: assetfttypes.ErrFeatureNotActive},
+		{Name: "ErrInvalidKey", Error: assetfttypes.ErrInvalidKey},
+		{Name: "ErrNotEnoughBalance", Error: assetfttypes.ErrNotEnoughBalance},
+		{Name: "ErrGloballyFrozen", Error: assetfttypes.ErrGloballyFrozen},
+		{Name: "ErrWhitelistedLimitExceeded", Error: assetfttypes.ErrWhitelistedLimitExceeded},
+		{Name: "ErrInvalidAttestation", Error: assetfttypes.ErrInvalidAttestation},
+		{Name: "ErrTransferAlreadyMinted", Error: assetfttypes.ErrTransferAlreadyMinted},
+		{Name: "ErrIBCDenomNotFound", Error: assetfttypes.ErrIBCDenomNotFound},
+		{Name: "ErrReservationExpired", Error: assetfttypes.ErrReservationExpired},
+		{Name: "ErrBurnAllowanceExceeded", Error: assetfttypes.ErrBurnAllowanceExceeded},
+		{Name: "ErrAccountFrozen", Error: assetfttypes.ErrAccountFrozen},
+		{Name: "ErrTokenNotActive", Error: assetfttypes.ErrTokenNotActive},
+		{Name: "ErrInvalidStatusTransition", Error: assetfttypes.ErrInvalidStatusTransition},
+
+		{Name: "ErrInvalidInput", Error: assetnfttypes.ErrInvalidInput},
+		{Name: "ErrInvalidID", Error: assetnfttypes.ErrInvalidID},
+		{Name: "ErrIDPrefixNotReserved", Error: assetnfttypes.ErrIDPrefixNotReserved},
+		{Name: "ErrInvalidSaleOffer", Error: assetnfttypes.ErrInvalidSaleOffer},
+		{Name: "ErrSaleOfferExpired", Error: assetnfttypes.ErrSaleOfferExpired},
+		{Name: "ErrSaleOfferAlreadyAccepted", Error: assetnfttypes.ErrSaleOfferAlreadyAccepted},
+		{Name: "ErrUserGrantActive", Error: assetnfttypes.ErrUserGrantActive},
+		{Name: "ErrNFTLocked", Error: assetnfttypes.ErrNFTLocked},
+		{Name: "ErrFeatureNotActive", Error: assetnfttypes.ErrFeatureNotActive},
+		{Name: "ErrClassFrozen", Error: assetnfttypes.ErrClassFrozen},
+		{Name: "ErrInvalidOwnershipProof", Error: assetnfttypes.ErrInvalidOwnershipProof},
+		{Name: "ErrNotWhitelisted", Error: assetnfttypes.ErrNotWhitelisted},
+		{Name: "ErrNFTSoulbound", Error: assetnfttypes.ErrNFTSoulbound},
+		{Name: "ErrSaleOfferCanceled", Error: assetnfttypes.ErrSaleOfferCanceled},
+
+		{Name: "ErrInvalidNFT", Error: nft.ErrInvalidNFT},
+		{Name: "ErrClassExists", Error: nft.ErrClassExists},
+		{Name: "ErrClassNotExists", Error: nft.ErrClassNotExists},
+		{Name: "ErrNFTExists", Error: nft.ErrNFTExists},
+		{Name: "ErrNFTNotExists", Error: nft.ErrNFTNotExists},
+		{Name: "ErrInvalidID", Error: nft.ErrInvalidID},
+		{Name: "ErrInvalidClassID", Error: nft.ErrInvalidClassID},
+		{Name: "ErrInvalidData", Error: nft.ErrInvalidData},
+
+		{Name: "ErrInvalidInput", Error: oracletypes.ErrInvalidInput},
+		{Name: "ErrValidatorNotBonded", Error: oracletypes.ErrValidatorNotBonded},
+		{Name: "ErrDenomNotWhitelisted", Error: oracletypes.ErrDenomNotWhitelisted},
+		{Name: "ErrExchangeRateNotFound", Error: oracletypes.ErrExchangeRateNotFound},
+
+		{Name: "ErrInvalidInput", Error: timetraveltypes.ErrInvalidInput},
+		{Name: "ErrDisabled", Error: timetraveltypes.ErrDisabled},
+	}
+}
+
+// Descriptor is the machine-readable description of the module error.
+type Descriptor struct {
+	// Codespace is the codespace of the error, equal to the name of the module registering it.
+	Codespace string `json:"codespace"`
+	// Code is the ABCI code of the error, unique within the codespace.
+	Code uint32 `json:"code"`
+	// Name is the name of the variable holding the error in the module.
+	Name string `json:"name"`
+	// Description is the message of the error.
+	Description string `json:"description"`
+}
+
+// BuildDescriptors builds the descriptors of the errors from the registry entries, sorted by codespace and code.
+func BuildDescriptors(entries []Entry) ([]Descriptor, error) {
+	type key struct {
+		codespace string
+		code      uint32
+	}
+
+	descriptors := make([]Descriptor, 0, len(entries))
+	codes := map[key]struct{}{}
+	for _, entry := range entries {
+		k := key{codespace: entry.Error.Codespace(), code: entry.Error.ABCICode()}
+		if _, exists := codes[k]; exists {
+			return nil, errors.Errorf("duplicated error %d in codespace %s", k.code, k.codespace)
+		}
+		codes[k] = struct{}{}
+
+		descriptors = append(descriptors, Descriptor{
+			Codespace:   k.codespace,
+			Code:        k.code,
+			Name:        entry.Name,
+			Description: entry.Error.Error(),
+		})
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool {
+		if descriptors[i].Codespace != descriptors[j].Codespace {
+			return descriptors[i].Codespace < descriptors[j].Codespace
+		}
+		return descriptors[i].Code < descriptors[j].Code
+	})
+
+	return descriptors, nil
+}
+
+// MarshalDescriptors returns the deterministic JSON representation of the descriptors.
+func MarshalDescriptors(descriptors []Descriptor) ([]byte, error) {
+	data, err := json.MarshalIndent(descriptors, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,77 @@
+package errorcodes_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/errorcodes"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+var errorRegexp = regexp.MustCompile(`(\w+)\s*=\s*sdkerrors\.Register\(\w+,\s*(\d+),\s*"([^"]*)"\)`)
+
+func TestRegistry_CoversAllErrors(t *testing.T) {
+	requireT := require.New(t)
+
+	registered := map[string]int{}
+	for _, entry := range errorcodes.Registry() {
+		registered[fmt.Sprintf("%s/%d/%s", entry.Name, entry.Error.ABCICode(), entry.Error.Error())]++
+	}
+
+	defined := map[string]int{}
+	requireT.NoError(filepath.Walk("../../x", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range errorRegexp.FindAllSubmatch(content, -1) {
+			defined[fmt.Sprintf("%s/%s/%s", match[1], match[2], match[3])]++
+		}
+		return nil
+	}))
+
+	requireT.NotEmpty(defined)
+	requireT.Equal(defined, registered)
+}
+
+func TestDescriptors_UpToDate(t *testing.T) {
+	requireT := require.New(t)
+
+	descriptors, err := errorcodes.BuildDescriptors(errorcodes.Registry())
+	requireT.NoError(err)
+	data, err := errorcodes.MarshalDescriptors(descriptors)
+	requireT.NoError(err)
+
+	expected, err := os.ReadFile("../../docs/static/errors.json")
+	requireT.NoError(err)
+	requireT.Equal(string(expected), string(data), "run `go run ./cmd/error-codes --out docs/static/errors.json`")
+}
+
+func TestBuildDescriptors(t *testing.T) {
+	requireT := require.New(t)
+
+	descriptors, err := errorcodes.BuildDescriptors(errorcodes.Registry())
+	requireT.NoError(err)
+	requireT.Contains(descriptors, errorcodes.Descriptor{
+		Codespace:   assetfttypes.ModuleName,
+		Code:        2,
+		Name:        "ErrFTNotFound",
+		Description: "fungible token not found",
+	})
+
+	// the same error can't be registered twice
+	_, err = errorcodes.BuildDescriptors([]errorcodes.Entry{
+		{Name: "ErrInvalidInput", Error: assetfttypes.ErrInvalidInput},
+		{Name: "ErrInvalidInput2", Error: assetfttypes.ErrInvalidInput},
+	})
+	requireT.Error(err)
+}
@@ -0,0 +1,120 @@
+// Package events contains the registry of the typed events emitted by the coreum modules.
+package events
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	expeditedtypes "github.com/CoreumFoundation/coreum/x/expedited/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	oracletypes "github.com/CoreumFoundation/coreum/x/oracle/types"
+	timetraveltypes "github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+// RegistryVersion is the version of the event registry. It must be increased each time an event is added to or removed
+// from the registry or the version of any registered event is changed.
+const RegistryVersion uint32 = 34
+
+const (
+	// EventTypeRegistry is the type of the event reporting the version of the event registry used by the chain.
+	EventTypeRegistry = "coreum_event_registry"
+	// AttributeKeyVersion is the attribute holding the version of the event registry.
+	AttributeKeyVersion = "version"
+)
+
+// Entry describes the typed event emitted by the module.
+type Entry struct {
+	// Module is the name of the module emitting the event.
+	Module string
+	// Version is the version of the event schema. It must be increased each time the event message is changed.
+	Version uint32
+	// Event is the instance of the event message.
+	Event proto.Message
+}
+
+// Type returns the type of the event as it appears in the emitted ABCI event.
+func (e Entry) Type() string {
+	return proto.MessageName(e.Event)
+}
+
+// Registry returns the entries of all the typed events emitted by the coreum modules.
+func Registry() []Entry {
+	return []Entry{
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventAccountFreezeChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventAdminCleared{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventAdminTransferCanceled{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventAdminTransferScheduled{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventAdminTransferred{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventBridgeBurnt{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventBridgeMinted{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventBurnAllowanceGranted{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventBurnedFrom{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventBurnRateExemptionChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventFrozenAmountChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventFrozenRateChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventFundsCaptured{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventFundsReleased{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventFundsReserved{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventGlobalFreezeChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventGlobalFreezeScheduled{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventIBCDenomRegistered{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventReserveAttestationPublished{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventTimedFreezeAdded{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventTokenAttributeSet{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventTimedFreezeExpired{}},
+		{Module: assetfttypes.ModuleName, Version: 2, Event: &assetfttypes.EventTokenIssued{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventTokenMetadataUpdated{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventTokenStatusChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventWhitelistedAmountChanged{}},
+		{Module: assetfttypes.ModuleName, Version: 1, Event: &assetfttypes.EventWhitelistExemptionChanged{}},
+
+		{Module: assetnfttypes.ModuleName, Version: 6, Event: &assetnfttypes.EventClassIssued{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventIDPrefixReserved{}},
+		{Module: assetnfttypes.ModuleName, Version: 2, Event: &assetnfttypes.EventTransferredWithPayment{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventSaleOfferCanceled{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventUserGranted{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventUserRevoked{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventClassOwnershipTransferProposed{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventClassOwnershipTransferred{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventRewardPoolCreated{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventRewardPoolFunded{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventNFTLocked{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventNFTUnlocked{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventClassFrozen{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventClassUnfrozen{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventNFTRevoked{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventNFTBurnt{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventAddedToClassWhitelist{}},
+		{Module: assetnfttypes.ModuleName, Version: 1, Event: &assetnfttypes.EventRemovedFromClassWhitelist{}},
+
+		{Module: expeditedtypes.ModuleName, Version: 1, Event: &expeditedtypes.EventProposalConverted{}},
+		{Module: expeditedtypes.ModuleName, Version: 1, Event: &expeditedtypes.EventProposalExpedited{}},
+
+		{Module: feemodeltypes.ModuleName, Version: 1, Event: &feemodeltypes.EventFailedTxGasRefunded{}},
+		{Module: feemodeltypes.ModuleName, Version: 1, Event: &feemodeltypes.EventFeesBurned{}},
+
+		{Module: nft.ModuleName, Version: 1, Event: &nft.EventBurn{}},
+		{Module: nft.ModuleName, Version: 1, Event: &nft.EventMint{}},
+		{Module: nft.ModuleName, Version: 1, Event: &nft.EventSend{}},
+		{Module: nft.ModuleName, Version: 1, Event: &nft.EventUpdate{}},
+
+		{Module: oracletypes.ModuleName, Version: 1, Event: &oracletypes.EventExchangeRateUpdated{}},
+		{Module: oracletypes.ModuleName, Version: 1, Event: &oracletypes.EventExchangeRateVoted{}},
+		{Module: oracletypes.ModuleName, Version: 1, Event: &oracletypes.EventValidatorPenalized{}},
+
+		{Module: timetraveltypes.ModuleName, Version: 1, Event: &timetraveltypes.EventTimeShifted{}},
+	}
+}
+
+// NewRegistryVersionEvent returns the event reporting the version of the event registry.
+func NewRegistryVersionEvent() sdk.Event {
+	return sdk.NewEvent(
+		EventTypeRegistry,
+		sdk.NewAttribute(AttributeKeyVersion, strconv.FormatUint(uint64(RegistryVersion), 10)),
+	)
+}
@@ -0,0 +1,94 @@
+package events_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/events"
+)
+
+var (
+	protoPackageRegexp = regexp.MustCompile(`(?m)^package ([\w.]+);`)
+	protoEventRegexp   = regexp.MustCompile(`(?m)^message (Event\w*) {`)
+)
+
+func TestRegistry_CoversAllEvents(t *testing.T) {
+	requireT := require.New(t)
+
+	registered := map[string]struct{}{}
+	for _, entry := range events.Registry() {
+		registered[entry.Type()] = struct{}{}
+	}
+
+	var defined []string
+	requireT.NoError(filepath.Walk("../../proto/coreum", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".proto" {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		pkg := protoPackageRegexp.FindSubmatch(content)
+		for _, match := range protoEventRegexp.FindAllSubmatch(content, -1) {
+			defined = append(defined, string(pkg[1])+"."+string(match[1]))
+		}
+		return nil
+	}))
+
+	requireT.NotEmpty(defined)
+	for _, eventType := range defined {
+		requireT.Contains(registered, eventType, "event %s is not registered", eventType)
+	}
+	requireT.Len(registered, len(defined))
+}
+
+func TestSchema_UpToDate(t *testing.T) {
+	requireT := require.New(t)
+
+	schema, err := events.BuildSchema(events.Registry())
+	requireT.NoError(err)
+	data, err := events.MarshalSchema(schema)
+	requireT.NoError(err)
+
+	expected, err := os.ReadFile("../../docs/static/events.json")
+	requireT.NoError(err)
+	requireT.Equal(string(expected), string(data), "run `go run ./cmd/event-schema --out docs/static/events.json`")
+}
+
+func TestBuildSchema(t *testing.T) {
+	requireT := require.New(t)
+
+	schema, err := events.BuildSchema(events.Registry())
+	requireT.NoError(err)
+	requireT.Equal(events.RegistryVersion, schema.RegistryVersion)
+
+	var found bool
+	for _, event := range schema.Events {
+		if event.Type != "coreum.asset.ft.v1.EventTokenIssued" {
+			continue
+		}
+		found = true
+		requireT.Equal("assetft", event.Module)
+		requireT.Contains(event.Attributes, events.AttributeSchema{Key: "denom", Type: "string"})
+		requireT.Contains(event.Attributes, events.AttributeSchema{Key: "precision", Type: "uint32"})
+		requireT.Contains(event.Attributes, events.AttributeSchema{
+			Key:      "features",
+			Type:     "coreum.asset.ft.v1.TokenFeature",
+			Repeated: true,
+		})
+	}
+	requireT.True(found)
+
+	entries := events.Registry()
+	_, err = events.BuildSchema(append(entries, entries[0]))
+	requireT.Error(err)
+
+	entries[0].Version = 0
+	_, err = events.BuildSchema(entries)
+	requireT.Error(err)
+}
@@ -0,0 +1,107 @@
+package events
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/pkg/errors"
+)
+
+// Schema is the machine-readable description of the typed events emitted by the coreum modules.
+type Schema struct {
+	// RegistryVersion is the version of the event registry the schema is generated from.
+	RegistryVersion uint32 `json:"registry_version"`
+	// Events are the schemas of the events sorted by type.
+	Events []EventSchema `json:"events"`
+}
+
+// EventSchema describes the single typed event.
+type EventSchema struct {
+	// Type is the type of the ABCI event.
+	Type string `json:"type"`
+	// Module is the name of the module emitting the event.
+	Module string `json:"module"`
+	// Version is the version of the event schema.
+	Version uint32 `json:"version"`
+	// Attributes are the attributes of the ABCI event in the order of the proto fields.
+	Attributes []AttributeSchema `json:"attributes"`
+}
+
+// AttributeSchema describes the attribute of the typed event. The value of the attribute is always JSON encoded.
+type AttributeSchema struct {
+	// Key is the key of the attribute.
+	Key string `json:"key"`
+	// Type is the proto scalar type or the full name of the proto message or enum stored in the attribute.
+	Type string `json:"type"`
+	// Repeated is true if the value of the attribute is the JSON array.
+	Repeated bool `json:"repeated,omitempty"`
+}
+
+// BuildSchema builds the schema of the events from the registry entries.
+func BuildSchema(entries []Entry) (Schema, error) {
+	schema := Schema{
+		RegistryVersion: RegistryVersion,
+		Events:          make([]EventSchema, 0, len(entries)),
+	}
+	types := map[string]struct{}{}
+	for _, entry := range entries {
+		eventType := entry.Type()
+		if eventType == "" {
+			return Schema{}, errors.Errorf("event %T is not registered in the proto registry", entry.Event)
+		}
+		if _, exists := types[eventType]; exists {
+			return Schema{}, errors.Errorf("duplicated event %s", eventType)
+		}
+		types[eventType] = struct{}{}
+
+		if entry.Version == 0 {
+			return Schema{}, errors.Errorf("version of event %s must be positive", eventType)
+		}
+
+		msg, ok := entry.Event.(descriptor.Message)
+		if !ok {
+			return Schema{}, errors.Errorf("event %s doesn't provide the descriptor", eventType)
+		}
+		_, msgDescriptor := descriptor.ForMessage(msg)
+
+		attributes := make([]AttributeSchema, 0, len(msgDescriptor.Field))
+		for _, field := range msgDescriptor.Field {
+			attributes = append(attributes, AttributeSchema{
+				Key:      field.GetName(),
+				Type:     fieldType(field),
+				Repeated: field.IsRepeated(),
+			})
+		}
+
+		schema.Events = append(schema.Events, EventSchema{
+			Type:       eventType,
+			Module:     entry.Module,
+			Version:    entry.Version,
+			Attributes: attributes,
+		})
+	}
+
+	sort.Slice(schema.Events, func(i, j int) bool {
+		return schema.Events[i].Type < schema.Events[j].Type
+	})
+
+	return schema, nil
+}
+
+// MarshalSchema returns the deterministic JSON representation of the schema.
+func MarshalSchema(schema Schema) ([]byte, error) {
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(data, '\n'), nil
+}
+
+func fieldType(field *descriptor.FieldDescriptorProto) string {
+	if field.IsMessage() || field.IsEnum() {
+		return strings.TrimPrefix(field.GetTypeName(), ".")
+	}
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
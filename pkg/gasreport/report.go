@@ -0,0 +1,78 @@
+// Package gasreport contains the tool reporting the relationship between the size and the deterministic gas of the
+// messages.
+//
+// The deterministic gas of the message doesn't depend on its size, the size of the transaction is charged separately
+// on top of the free bytes. The report helps to find the message types storing many bytes for little gas.
+package gasreport
+
+import (
+	"encoding/json"
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+)
+
+// Entry is the report of the single message type.
+type Entry struct {
+	// MsgType is the type URL of the message.
+	MsgType string `json:"msg_type"`
+	// Size is the number of bytes the message takes in the transaction body.
+	Size uint64 `json:"size"`
+	// Gas is the deterministic gas of the message.
+	Gas uint64 `json:"gas"`
+	// SizeGas is the gas charged for the size of the message if it doesn't fit into the free bytes of the transaction.
+	SizeGas uint64 `json:"size_gas"`
+	// GasPerByte is the deterministic gas of the message divided by its size.
+	GasPerByte float64 `json:"gas_per_byte"`
+}
+
+// Build builds the report of the messages, sorted by the gas per byte, so the message types charged the least for their
+// size come first.
+func Build(
+	deterministicGas config.DeterministicGasRequirements,
+	txSizeCostPerByte uint64,
+	msgs []sdk.Msg,
+) ([]Entry, error) {
+	entries := make([]Entry, 0, len(msgs))
+	for _, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+		gas, deterministic := deterministicGas.GasRequiredByMessage(msg)
+		if !deterministic {
+			return nil, errors.Errorf("message %s has no deterministic gas", msgType)
+		}
+
+		// the messages are stored in the transaction body packed into the Any type
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't pack message %s", msgType)
+		}
+		size := uint64(msgAny.Size())
+
+		entries = append(entries, Entry{
+			MsgType:    msgType,
+			Size:       size,
+			Gas:        gas,
+			SizeGas:    size * txSizeCostPerByte,
+			GasPerByte: float64(gas) / float64(size),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].GasPerByte < entries[j].GasPerByte
+	})
+
+	return entries, nil
+}
+
+// MarshalEntries returns the JSON representation of the report.
+func MarshalEntries(entries []Entry) ([]byte, error) {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,43 @@
+package gasreport_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/pkg/gasreport"
+)
+
+func TestBuild(t *testing.T) {
+	requireT := require.New(t)
+
+	msgs, err := gasreport.Samples()
+	requireT.NoError(err)
+
+	deterministicGas := config.DefaultDeterministicGasRequirements()
+	entries, err := gasreport.Build(deterministicGas, authtypes.DefaultTxSizeCostPerByte, msgs)
+	requireT.NoError(err)
+	requireT.Len(entries, len(msgs))
+
+	msgTypes := map[string]struct{}{}
+	for i, entry := range entries {
+		// each message type is reported once
+		requireT.NotContains(msgTypes, entry.MsgType)
+		msgTypes[entry.MsgType] = struct{}{}
+
+		requireT.Positive(entry.Size)
+		requireT.Positive(entry.Gas)
+		requireT.Equal(entry.Size*authtypes.DefaultTxSizeCostPerByte, entry.SizeGas)
+		if i > 0 {
+			requireT.LessOrEqual(entries[i-1].GasPerByte, entry.GasPerByte)
+		}
+	}
+
+	// the messages without the deterministic gas can't be reported
+	_, err = gasreport.Build(deterministicGas, authtypes.DefaultTxSizeCostPerByte, []sdk.Msg{&authz.MsgExec{}})
+	requireT.Error(err)
+}
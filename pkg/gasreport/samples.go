@@ -0,0 +1,235 @@
+package gasreport
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/pkg/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	oracletypes "github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+// Samples returns the representative messages of all the types having the deterministic gas. The addresses are
+// encoded using the prefix set in the global SDK config, so it must be set before the samples are built.
+//
+//nolint:funlen // it doesn't make sense to split entries
+func Samples() ([]sdk.Msg, error) {
+	issuer := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	account := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	validator := sdk.ValAddress(issuer)
+	denom := assetfttypes.BuildDenom("utoken", issuer)
+	classID := assetnfttypes.BuildClassID("nftclass", issuer)
+	coin := sdk.NewInt64Coin(denom, 1_000_000)
+	coreCoin := sdk.NewInt64Coin("ucore", 1_000_000)
+	expiration := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	pubKey := bytes.Repeat([]byte{3}, 33)
+	signature := bytes.Repeat([]byte{4}, 64)
+	uri := "https://my-class-meta.invalid/1"
+	uriHash := "35b326a2b3b605270c26185c38d2581e937b2eae0418b4964ef521efe79cdf34"
+
+	submitProposal, err := govtypes.NewMsgSubmitProposal(
+		govtypes.NewTextProposal("Proposal title", "Proposal description"),
+		sdk.NewCoins(coreCoin),
+		issuer,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	createValidator, err := stakingtypes.NewMsgCreateValidator(
+		validator,
+		ed25519.GenPrivKeyFromSecret([]byte("validator")).PubKey(),
+		coreCoin,
+		stakingtypes.NewDescription("moniker", "identity", "https://validator.invalid", "contact", "details"),
+		stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return []sdk.Msg{
+		&assetfttypes.MsgIssue{
+			Issuer:        issuer.String(),
+			Symbol:        "TOKEN",
+			Subunit:       "utoken",
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1_000_000_000),
+			Description:   "Token description",
+			Features: []assetfttypes.TokenFeature{
+				assetfttypes.TokenFeature_mint,            //nolint:nosnakecase
+				assetfttypes.TokenFeature_burn,            //nolint:nosnakecase
+				assetfttypes.TokenFeature_freeze,          //nolint:nosnakecase
+				assetfttypes.TokenFeature_whitelist,       //nolint:nosnakecase
+				assetfttypes.TokenFeature_metadata_update, //nolint:nosnakecase
+			},
+			BurnRate:           sdk.NewDecWithPrec(1, 2),
+			SendCommissionRate: sdk.NewDecWithPrec(2, 2),
+		},
+		&assetfttypes.MsgMint{Sender: issuer.String(), Coin: coin},
+		&assetfttypes.MsgBurn{Sender: issuer.String(), Coin: coin},
+		&assetfttypes.MsgGrantBurnAllowance{Sender: account, Spender: issuer.String(), Coin: coin},
+		&assetfttypes.MsgBurnFrom{Sender: issuer.String(), Account: account, Coin: coin},
+		&assetfttypes.MsgFreeze{Sender: issuer.String(), Account: account, Coin: coin},
+		&assetfttypes.MsgUnfreeze{Sender: issuer.String(), Account: account, Coin: coin},
+		&assetfttypes.MsgSetFrozenRate{Sender: issuer.String(), Account: account, Denom: denom, Rate: sdk.NewDecWithPrec(25, 2)},
+		&assetfttypes.MsgFreezeUntil{Sender: issuer.String(), Account: account, Coin: coin, UnfreezeTime: expiration},
+		&assetfttypes.MsgFreezeAccount{Sender: issuer.String(), Account: account},
+		&assetfttypes.MsgUnfreezeAccount{Sender: issuer.String(), Account: account},
+		&assetfttypes.MsgBatchFreeze{
+			Sender:  issuer.String(),
+			Entries: []assetfttypes.AccountCoin{{Account: account, Coin: coin}},
+		},
+		&assetfttypes.MsgBatchUnfreeze{
+			Sender:  issuer.String(),
+			Entries: []assetfttypes.AccountCoin{{Account: account, Coin: coin}},
+		},
+		&assetfttypes.MsgGloballyFreeze{Sender: issuer.String(), Denom: denom},
+		&assetfttypes.MsgGloballyUnfreeze{Sender: issuer.String(), Denom: denom},
+		&assetfttypes.MsgSetTokenStatus{Sender: issuer.String(), Denom: denom, Status: assetfttypes.TokenStatus_suspended}, //nolint:nosnakecase
+		&assetfttypes.MsgSetWhitelistedLimit{Sender: issuer.String(), Account: account, Coin: coin},
+		&assetfttypes.MsgSetWhitelistExemption{Sender: issuer.String(), Account: account, Denom: denom, Exempt: true},
+		&assetfttypes.MsgBatchSetWhitelistedLimit{
+			Sender:  issuer.String(),
+			Entries: []assetfttypes.AccountCoin{{Account: account, Coin: coin}},
+		},
+		&assetfttypes.MsgSetBurnRateExemption{Sender: issuer.String(), Account: account, Denom: denom, Exempt: true},
+		&assetfttypes.MsgUpdateTokenMetadata{
+			Sender:      issuer.String(),
+			Denom:       denom,
+			Description: "Updated token description",
+			URI:         "https://token.invalid/metadata.json",
+			URIHash:     "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		&assetfttypes.MsgSetTokenAttribute{Sender: issuer.String(), Denom: denom, Key: "ISIN", Value: "CH0012345678"},
+		&assetfttypes.MsgWrap{Sender: account, Coin: coreCoin},
+		&assetfttypes.MsgUnwrap{Sender: account, Coin: coreCoin},
+		&assetfttypes.MsgBridgeMint{
+			Sender:     issuer.String(),
+			Recipient:  account,
+			Coin:       coin,
+			TransferID: "0x8f0a4b6e2c1d3f5a7b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a",
+			Attestations: []assetfttypes.BridgeAttestation{
+				{Attester: account, PubKey: pubKey, Signature: signature},
+			},
+		},
+		&assetfttypes.MsgBridgeBurn{Sender: account, Coin: coin, Destination: "0x71c7656ec7ab88b098defb751b7401b5f6d8976f"},
+		&assetfttypes.MsgRegisterIBCDenom{
+			Sender: account,
+			Trace:  assetfttypes.IBCDenomTrace{Path: "transfer/channel-0", BaseDenom: denom},
+		},
+		&assetfttypes.MsgReserve{Payer: account, Payee: issuer.String(), Amount: coin, Expiration: expiration},
+		&assetfttypes.MsgRelease{Sender: issuer.String(), ID: 1},
+		&assetfttypes.MsgCapture{Sender: issuer.String(), ID: 1, Amount: coin},
+		&assetfttypes.MsgPublishReserveAttestation{
+			Sender:               issuer.String(),
+			Denom:                denom,
+			Amount:               sdk.NewInt(1_000_000),
+			AuditorSignatureHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			URI:                  "https://example.com/attestation.pdf",
+		},
+		&assetfttypes.MsgTransferAdmin{Sender: issuer.String(), Denom: denom, Account: account, GracePeriod: time.Hour},
+		&assetfttypes.MsgClearAdmin{Sender: issuer.String(), Denom: denom},
+
+		&assetnfttypes.MsgIssueClass{
+			Issuer:      issuer.String(),
+			Symbol:      "NFTCLASS",
+			Name:        "NFT class",
+			Description: "NFT class description",
+			URI:         uri,
+			URIHash:     uriHash,
+		},
+		&assetnfttypes.MsgMint{Sender: issuer.String(), ClassID: classID, ID: "nft1", URI: uri, URIHash: uriHash},
+		&assetnfttypes.MsgReserveIDPrefix{Sender: issuer.String(), ClassID: classID, Prefix: "series1"},
+		&assetnfttypes.MsgTransferWithPayment{
+			Sender: account,
+			Offer: assetnfttypes.SignedSaleOffer{
+				Offer: assetnfttypes.SaleOffer{
+					Seller:           issuer.String(),
+					ClassID:          classID,
+					ID:               "nft1",
+					Price:            coreCoin,
+					Buyer:            account,
+					ExpirationHeight: 1_000_000,
+				},
+				PubKey:    pubKey,
+				Signature: signature,
+			},
+		},
+		&assetnfttypes.MsgCancelSaleOffer{
+			Sender: issuer.String(),
+			Offer: assetnfttypes.SaleOffer{
+				Seller:           issuer.String(),
+				ClassID:          classID,
+				ID:               "nft1",
+				Price:            coreCoin,
+				Buyer:            account,
+				ExpirationHeight: 2_000_000,
+			},
+		},
+		&assetnfttypes.MsgGrantUser{Sender: issuer.String(), ClassID: classID, ID: "nft1", User: account, Expiration: expiration},
+		&assetnfttypes.MsgRevokeUser{Sender: issuer.String(), ClassID: classID, ID: "nft1"},
+		&assetnfttypes.MsgTransferClassOwnership{Sender: issuer.String(), ClassID: classID, NewOwner: account},
+		&assetnfttypes.MsgAcceptClassOwnership{Sender: account, ClassID: classID},
+		&assetnfttypes.MsgCreateRewardPool{Sender: issuer.String(), ClassID: classID, RewardPerBlock: coreCoin, MinLockDuration: time.Hour},
+		&assetnfttypes.MsgFundRewardPool{Sender: issuer.String(), ClassID: classID, Amount: coreCoin},
+		&assetnfttypes.MsgLockNFT{Sender: issuer.String(), ClassID: classID, ID: "nft1"},
+		&assetnfttypes.MsgUnlockNFT{Sender: issuer.String(), ClassID: classID, ID: "nft1"},
+		&assetnfttypes.MsgClassFreeze{Sender: issuer.String(), ClassID: classID},
+		&assetnfttypes.MsgClassUnfreeze{Sender: issuer.String(), ClassID: classID},
+		&assetnfttypes.MsgRevokeNFT{Sender: issuer.String(), ClassID: classID, ID: "nft1"},
+		&assetnfttypes.MsgAddToClassWhitelist{Sender: issuer.String(), ClassID: classID, Account: account},
+		&assetnfttypes.MsgRemoveFromClassWhitelist{Sender: issuer.String(), ClassID: classID, Account: account},
+		&assetnfttypes.MsgBurnNFT{Sender: issuer.String(), ClassID: classID, ID: "nft1"},
+
+		&banktypes.MsgSend{FromAddress: issuer.String(), ToAddress: account, Amount: sdk.NewCoins(coin)},
+		&banktypes.MsgMultiSend{
+			Inputs:  []banktypes.Input{{Address: issuer.String(), Coins: sdk.NewCoins(coin)}},
+			Outputs: []banktypes.Output{{Address: account, Coins: sdk.NewCoins(coin)}},
+		},
+
+		&distributiontypes.MsgFundCommunityPool{Depositor: issuer.String(), Amount: sdk.NewCoins(coreCoin)},
+		&distributiontypes.MsgSetWithdrawAddress{DelegatorAddress: issuer.String(), WithdrawAddress: account},
+		&distributiontypes.MsgWithdrawDelegatorReward{DelegatorAddress: account, ValidatorAddress: validator.String()},
+		&distributiontypes.MsgWithdrawValidatorCommission{ValidatorAddress: validator.String()},
+
+		submitProposal,
+		&govtypes.MsgVote{ProposalId: 1, Voter: account, Option: govtypes.OptionYes},
+		&govtypes.MsgVoteWeighted{ProposalId: 1, Voter: account, Options: govtypes.NewNonSplitVoteOption(govtypes.OptionYes)},
+		&govtypes.MsgDeposit{ProposalId: 1, Depositor: account, Amount: sdk.NewCoins(coreCoin)},
+
+		&nft.MsgSend{ClassId: classID, Id: "nft1", Sender: issuer.String(), Receiver: account},
+
+		&oracletypes.MsgSubmitExchangeRateVote{
+			Validator:     validator.String(),
+			ExchangeRates: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", sdk.NewDecWithPrec(12345, 5))),
+		},
+
+		&slashingtypes.MsgUnjail{ValidatorAddr: validator.String()},
+
+		&stakingtypes.MsgDelegate{DelegatorAddress: account, ValidatorAddress: validator.String(), Amount: coreCoin},
+		&stakingtypes.MsgUndelegate{DelegatorAddress: account, ValidatorAddress: validator.String(), Amount: coreCoin},
+		&stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    account,
+			ValidatorSrcAddress: validator.String(),
+			ValidatorDstAddress: sdk.ValAddress(bytes.Repeat([]byte{5}, 20)).String(),
+			Amount:              coreCoin,
+		},
+		createValidator,
+		stakingtypes.NewMsgEditValidator(
+			validator,
+			stakingtypes.NewDescription("moniker", "identity", "https://validator.invalid", "contact", "details"),
+			nil,
+			nil,
+		),
+	}, nil
+}
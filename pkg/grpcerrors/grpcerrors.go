@@ -0,0 +1,92 @@
+// Package grpcerrors converts the errors returned by the query services into the gRPC status errors, so the gRPC and
+// REST clients may branch on the status codes.
+package grpcerrors
+
+import (
+	"errors"
+	"strconv"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MetadataKeyCodespace is the key of the error info metadata holding the codespace of the module error.
+	MetadataKeyCodespace = "codespace"
+	// MetadataKeyCode is the key of the error info metadata holding the ABCI code of the module error.
+	MetadataKeyCode = "code"
+)
+
+// NotFound returns the status error with the NotFound code.
+func NotFound(err error) error {
+	return New(codes.NotFound, err)
+}
+
+// InvalidArgument returns the status error with the InvalidArgument code.
+func InvalidArgument(err error) error {
+	return New(codes.InvalidArgument, err)
+}
+
+// New returns the status error with the code and the message of the error. The codespace and the ABCI code of the
+// error are kept in the error info details of the status, the reason is the description of the registered error.
+// The returned error wraps the original one, so it still matches the registered errors.
+func New(code codes.Code, err error) error {
+	st := status.New(code, err.Error())
+	codespace, abciCode, _ := sdkerrors.ABCIInfo(err, false)
+	reason := err.Error()
+	var sdkErr *sdkerrors.Error
+	if errors.As(err, &sdkErr) {
+		reason = sdkErr.Error()
+	}
+	withDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: codespace,
+		Metadata: map[string]string{
+			MetadataKeyCodespace: codespace,
+			MetadataKeyCode:      strconv.FormatUint(uint64(abciCode), 10),
+		},
+	})
+	if detailsErr == nil {
+		st = withDetails
+	}
+	return &statusError{err: err, status: st}
+}
+
+// ErrorInfo returns the error info details of the status error.
+func ErrorInfo(err error) (*errdetails.ErrorInfo, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
+type statusError struct {
+	err    error
+	status *status.Status
+}
+
+func (e *statusError) Error() string {
+	return e.err.Error()
+}
+
+// GRPCStatus returns the status sent to the gRPC clients.
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// Cause returns the original error, it is used by the sdk errors to match the registered errors.
+func (e *statusError) Cause() error {
+	return e.err
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
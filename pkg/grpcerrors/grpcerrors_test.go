@@ -0,0 +1,45 @@
+package grpcerrors_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/pkg/grpcerrors"
+)
+
+func TestNew(t *testing.T) {
+	requireT := require.New(t)
+
+	moduleErr := sdkerrors.Register("grpcerrorstest", 7, "thing not found")
+	err := grpcerrors.NotFound(sdkerrors.Wrap(moduleErr, "thing 1"))
+
+	st, ok := status.FromError(err)
+	requireT.True(ok)
+	requireT.Equal(codes.NotFound, st.Code())
+	requireT.Equal("thing 1: thing not found", st.Message())
+	requireT.True(moduleErr.Is(err))
+
+	info, ok := grpcerrors.ErrorInfo(err)
+	requireT.True(ok)
+	requireT.Equal("thing not found", info.Reason)
+	requireT.Equal("grpcerrorstest", info.Domain)
+	requireT.Equal(map[string]string{
+		grpcerrors.MetadataKeyCodespace: "grpcerrorstest",
+		grpcerrors.MetadataKeyCode:      "7",
+	}, info.Metadata)
+
+	// the errors not registered by the modules are reported with the undefined codespace
+	err = grpcerrors.InvalidArgument(errors.New("invalid"))
+	requireT.Equal(codes.InvalidArgument, status.Code(err))
+	info, ok = grpcerrors.ErrorInfo(err)
+	requireT.True(ok)
+	requireT.Equal(sdkerrors.UndefinedCodespace, info.Domain)
+
+	_, ok = grpcerrors.ErrorInfo(errors.New("plain"))
+	requireT.False(ok)
+}
@@ -0,0 +1,41 @@
+package nodeprofile
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/CoreumFoundation/coreum/pkg/denomindex"
+)
+
+var _ QueryServer = QueryService{}
+
+// QueryService serves grpc query requests for the node profile.
+type QueryService struct {
+	response QueryProfileResponse
+}
+
+// NewQueryService initiates the new instance of query service reporting the settings the node is started with.
+func NewQueryService(appOpts servertypes.AppOptions) QueryService {
+	return QueryService{
+		response: QueryProfileResponse{
+			Profile:            cast.ToString(appOpts.Get(FlagProfile)),
+			Pruning:            cast.ToString(appOpts.Get(server.FlagPruning)),
+			PruningKeepRecent:  cast.ToUint64(appOpts.Get(server.FlagPruningKeepRecent)),
+			PruningInterval:    cast.ToUint64(appOpts.Get(server.FlagPruningInterval)),
+			SnapshotInterval:   cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+			SnapshotKeepRecent: cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+			TxIndexer:          cast.ToString(appOpts.Get(FlagTxIndexer)),
+			DenomIndex:         cast.ToBool(appOpts.Get(denomindex.FlagEnable)),
+			MinGasPrices:       cast.ToString(appOpts.Get(server.FlagMinGasPrices)),
+		},
+	}
+}
+
+// Profile returns the profile the node is started with and the settings it is running with.
+func (qs QueryService) Profile(ctx context.Context, req *QueryProfileRequest) (*QueryProfileResponse, error) {
+	response := qs.response
+	return &response, nil
+}
@@ -0,0 +1,129 @@
+// Package nodeprofile implements the presets of the node configuration for the typical roles of the node. The profile
+// sets the pruning, state sync snapshots, indexing and minimum gas prices coherently, so the integrators don't need to
+// tune each option of the config.toml and app.toml separately.
+package nodeprofile
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	"github.com/CoreumFoundation/coreum/pkg/denomindex"
+)
+
+const (
+	// FlagProfile is the flag of the start command choosing the profile.
+	FlagProfile = "profile"
+	// FlagTxIndexer is the config.toml option choosing the indexer of the transactions.
+	FlagTxIndexer = "tx_index.indexer"
+)
+
+// Profile is the name of the node configuration profile.
+type Profile string
+
+// Node configuration profiles.
+const (
+	// ProfileExchange is the profile of the node serving the deposits and withdrawals of the exchange. It keeps the
+	// recent state and indexes the transactions, including the transactions of the fungible tokens.
+	ProfileExchange Profile = "exchange"
+	// ProfileArchive is the profile of the node keeping the whole history of the chain. It indexes all the
+	// transactions and provides the state sync snapshots to the other nodes.
+	ProfileArchive Profile = "archive"
+	// ProfileValidator is the profile of the validator node. It keeps the minimal state required for the consensus
+	// and doesn't index the transactions.
+	ProfileValidator Profile = "validator"
+)
+
+// Settings are the node settings set by the profile.
+type Settings struct {
+	Pruning            string
+	PruningKeepRecent  uint64
+	PruningInterval    uint64
+	SnapshotInterval   uint64
+	SnapshotKeepRecent uint32
+	TxIndexer          string
+	DenomIndex         bool
+}
+
+var profiles = map[Profile]Settings{
+	ProfileExchange: {
+		Pruning:   storetypes.PruningOptionDefault,
+		TxIndexer: "kv",
+		// the exchanges track the transactions of the fungible tokens they list
+		DenomIndex: true,
+	},
+	ProfileArchive: {
+		Pruning:            storetypes.PruningOptionNothing,
+		SnapshotInterval:   1000,
+		SnapshotKeepRecent: 2,
+		TxIndexer:          "kv",
+		DenomIndex:         true,
+	},
+	ProfileValidator: {
+		Pruning:           storetypes.PruningOptionCustom,
+		PruningKeepRecent: 100,
+		PruningInterval:   10,
+		TxIndexer:         "null",
+	},
+}
+
+// Profiles returns the names of the available profiles.
+func Profiles() []Profile {
+	names := make([]Profile, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// SettingsOf returns the settings of the profile.
+func SettingsOf(profile Profile) (Settings, error) {
+	settings, ok := profiles[profile]
+	if !ok {
+		return Settings{}, errors.Errorf("unknown node profile %q, available profiles: %v", profile, Profiles())
+	}
+	return settings, nil
+}
+
+// Apply sets the settings of the profile chosen by the FlagProfile option to the node config. The minimum gas prices
+// are set to zero in the chain denom, because the minimum gas price is enforced by the fee model of the chain.
+// The options for which isFlagSet returns true are kept, so the profile might be tuned with the flags of the start
+// command. Nothing is changed if the profile is not chosen.
+func Apply(v *viper.Viper, tmCfg *tmcfg.Config, denom string, isFlagSet func(flag string) bool) error {
+	profile := Profile(v.GetString(FlagProfile))
+	if profile == "" {
+		return nil
+	}
+	settings, err := SettingsOf(profile)
+	if err != nil {
+		return err
+	}
+
+	set := func(flag string, value interface{}) {
+		if !isFlagSet(flag) {
+			v.Set(flag, value)
+		}
+	}
+	set(server.FlagPruning, settings.Pruning)
+	set(server.FlagPruningKeepRecent, settings.PruningKeepRecent)
+	set(server.FlagPruningKeepEvery, 0)
+	set(server.FlagPruningInterval, settings.PruningInterval)
+	set(server.FlagStateSyncSnapshotInterval, settings.SnapshotInterval)
+	set(server.FlagStateSyncSnapshotKeepRecent, settings.SnapshotKeepRecent)
+	set(server.FlagMinGasPrices, "0"+denom)
+	v.Set(denomindex.FlagEnable, settings.DenomIndex)
+	v.Set(FlagTxIndexer, settings.TxIndexer)
+	tmCfg.TxIndex.Indexer = settings.TxIndexer
+
+	if _, err := server.GetPruningOptionsFromFlags(v); err != nil {
+		return errors.Wrapf(err, "invalid pruning options of the node profile %q", profile)
+	}
+	return nil
+}
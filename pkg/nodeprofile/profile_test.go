@@ -0,0 +1,67 @@
+package nodeprofile_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+
+	"github.com/CoreumFoundation/coreum/pkg/denomindex"
+	"github.com/CoreumFoundation/coreum/pkg/nodeprofile"
+)
+
+func TestApply(t *testing.T) {
+	requireT := require.New(t)
+
+	notSet := func(string) bool { return false }
+
+	// nothing is changed if the profile is not chosen
+	v := viper.New()
+	v.Set(server.FlagPruning, "everything")
+	tmCfg := tmcfg.DefaultConfig()
+	requireT.NoError(nodeprofile.Apply(v, tmCfg, "ucore", notSet))
+	requireT.Equal("everything", v.GetString(server.FlagPruning))
+	requireT.Equal("kv", tmCfg.TxIndex.Indexer)
+
+	v = viper.New()
+	v.Set(nodeprofile.FlagProfile, "unknown")
+	requireT.Error(nodeprofile.Apply(v, tmcfg.DefaultConfig(), "ucore", notSet))
+
+	v = viper.New()
+	v.Set(nodeprofile.FlagProfile, string(nodeprofile.ProfileValidator))
+	v.Set(denomindex.FlagEnable, true)
+	tmCfg = tmcfg.DefaultConfig()
+	requireT.NoError(nodeprofile.Apply(v, tmCfg, "ucore", notSet))
+	requireT.Equal("custom", v.GetString(server.FlagPruning))
+	requireT.Equal(uint64(100), v.GetUint64(server.FlagPruningKeepRecent))
+	requireT.Equal(uint64(10), v.GetUint64(server.FlagPruningInterval))
+	requireT.Equal(uint64(0), v.GetUint64(server.FlagStateSyncSnapshotInterval))
+	requireT.Equal("0ucore", v.GetString(server.FlagMinGasPrices))
+	requireT.False(v.GetBool(denomindex.FlagEnable))
+	requireT.Equal("null", tmCfg.TxIndex.Indexer)
+	requireT.Equal("null", v.GetString(nodeprofile.FlagTxIndexer))
+
+	// the options set by the flags are kept
+	v = viper.New()
+	v.Set(nodeprofile.FlagProfile, string(nodeprofile.ProfileArchive))
+	v.Set(server.FlagStateSyncSnapshotInterval, 500)
+	tmCfg = tmcfg.DefaultConfig()
+	requireT.NoError(nodeprofile.Apply(v, tmCfg, "ucore", func(flag string) bool {
+		return flag == server.FlagStateSyncSnapshotInterval
+	}))
+	requireT.Equal("nothing", v.GetString(server.FlagPruning))
+	requireT.Equal(uint64(500), v.GetUint64(server.FlagStateSyncSnapshotInterval))
+	requireT.Equal(uint32(2), v.GetUint32(server.FlagStateSyncSnapshotKeepRecent))
+	requireT.True(v.GetBool(denomindex.FlagEnable))
+	requireT.Equal("kv", tmCfg.TxIndex.Indexer)
+
+	// the custom pruning options set by the flags are validated
+	v = viper.New()
+	v.Set(nodeprofile.FlagProfile, string(nodeprofile.ProfileValidator))
+	v.Set(server.FlagPruningInterval, 0)
+	requireT.Error(nodeprofile.Apply(v, tmcfg.DefaultConfig(), "ucore", func(flag string) bool {
+		return flag == server.FlagPruningInterval
+	}))
+}
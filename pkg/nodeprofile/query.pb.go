@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/nodeprofile/v1/query.proto
+
+package nodeprofile
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryProfileRequest struct{}
+
+func (m *QueryProfileRequest) Reset()         { *m = QueryProfileRequest{} }
+func (m *QueryProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileRequest) ProtoMessage()    {}
+func (*QueryProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27a40d4283173d71, []int{0}
+}
+
+func (m *QueryProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileRequest.Merge(m, src)
+}
+
+func (m *QueryProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProfileRequest proto.InternalMessageInfo
+
+type QueryProfileResponse struct {
+	// profile is the profile the node is started with, empty if the node is configured manually.
+	Profile            string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Pruning            string `protobuf:"bytes,2,opt,name=pruning,proto3" json:"pruning,omitempty"`
+	PruningKeepRecent  uint64 `protobuf:"varint,3,opt,name=pruning_keep_recent,json=pruningKeepRecent,proto3" json:"pruning_keep_recent,omitempty"`
+	PruningInterval    uint64 `protobuf:"varint,4,opt,name=pruning_interval,json=pruningInterval,proto3" json:"pruning_interval,omitempty"`
+	SnapshotInterval   uint64 `protobuf:"varint,5,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	SnapshotKeepRecent uint32 `protobuf:"varint,6,opt,name=snapshot_keep_recent,json=snapshotKeepRecent,proto3" json:"snapshot_keep_recent,omitempty"`
+	// tx_indexer is the indexer of the transactions configured in the config.toml.
+	TxIndexer    string `protobuf:"bytes,7,opt,name=tx_indexer,json=txIndexer,proto3" json:"tx_indexer,omitempty"`
+	DenomIndex   bool   `protobuf:"varint,8,opt,name=denom_index,json=denomIndex,proto3" json:"denom_index,omitempty"`
+	MinGasPrices string `protobuf:"bytes,9,opt,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices,omitempty"`
+}
+
+func (m *QueryProfileResponse) Reset()         { *m = QueryProfileResponse{} }
+func (m *QueryProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileResponse) ProtoMessage()    {}
+func (*QueryProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27a40d4283173d71, []int{1}
+}
+
+func (m *QueryProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileResponse.Merge(m, src)
+}
+
+func (m *QueryProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProfileResponse proto.InternalMessageInfo
+
+func (m *QueryProfileResponse) GetProfile() string {
+	if m != nil {
+		return m.Profile
+	}
+	return ""
+}
+
+func (m *QueryProfileResponse) GetPruning() string {
+	if m != nil {
+		return m.Pruning
+	}
+	return ""
+}
+
+func (m *QueryProfileResponse) GetPruningKeepRecent() uint64 {
+	if m != nil {
+		return m.PruningKeepRecent
+	}
+	return 0
+}
+
+func (m *QueryProfileResponse) GetPruningInterval() uint64 {
+	if m != nil {
+		return m.PruningInterval
+	}
+	return 0
+}
+
+func (m *QueryProfileResponse) GetSnapshotInterval() uint64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
+func (m *QueryProfileResponse) GetSnapshotKeepRecent() uint32 {
+	if m != nil {
+		return m.SnapshotKeepRecent
+	}
+	return 0
+}
+
+func (m *QueryProfileResponse) GetTxIndexer() string {
+	if m != nil {
+		return m.TxIndexer
+	}
+	return ""
+}
+
+func (m *QueryProfileResponse) GetDenomIndex() bool {
+	if m != nil {
+		return m.DenomIndex
+	}
+	return false
+}
+
+func (m *QueryProfileResponse) GetMinGasPrices() string {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryProfileRequest)(nil), "coreum.nodeprofile.v1.QueryProfileRequest")
+	proto.RegisterType((*QueryProfileResponse)(nil), "coreum.nodeprofile.v1.QueryProfileResponse")
+}
+
+func init() { proto.RegisterFile("coreum/nodeprofile/v1/query.proto", fileDescriptor_27a40d4283173d71) }
+
+var fileDescriptor_27a40d4283173d71 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
+	0x10, 0xc6, 0xeb, 0xb2, 0xbb, 0xdd, 0x9a, 0x7f, 0xbb, 0xde, 0x5d, 0xc9, 0xaa, 0x20, 0x84, 0x0a,
+	0xa1, 0x40, 0xa5, 0x84, 0x96, 0x37, 0x00, 0x09, 0x54, 0xc1, 0xa1, 0xe4, 0xc8, 0x25, 0x4a, 0x93,
+	0x21, 0xb5, 0xda, 0xd8, 0xae, 0xed, 0x54, 0xe5, 0xca, 0x91, 0x13, 0x12, 0x3c, 0x14, 0xc7, 0x4a,
+	0x5c, 0x38, 0xa2, 0x96, 0x13, 0x4f, 0x81, 0x70, 0x92, 0x12, 0xa4, 0x1e, 0xb8, 0xd9, 0xdf, 0xf7,
+	0xf3, 0xa7, 0xf1, 0xcc, 0xe0, 0xfb, 0x89, 0x50, 0x50, 0xe4, 0x01, 0x17, 0x29, 0x48, 0x25, 0xde,
+	0xb1, 0x05, 0x04, 0xab, 0x61, 0xb0, 0x2c, 0x40, 0xbd, 0xf7, 0xa5, 0x12, 0x46, 0x90, 0xab, 0x12,
+	0xf1, 0x1b, 0x88, 0xbf, 0x1a, 0xf6, 0xee, 0x64, 0x42, 0x64, 0x0b, 0x08, 0x62, 0xc9, 0x82, 0x98,
+	0x73, 0x61, 0x62, 0xc3, 0x04, 0xd7, 0xe5, 0xa3, 0xfe, 0x15, 0xbe, 0x78, 0xf3, 0x27, 0x63, 0x52,
+	0x3e, 0x08, 0x61, 0x59, 0x80, 0x36, 0xfd, 0x5f, 0x6d, 0x7c, 0xf9, 0xaf, 0xae, 0xa5, 0xe0, 0x1a,
+	0x08, 0xc5, 0x9d, 0x2a, 0x9b, 0x22, 0x17, 0x79, 0xdd, 0xb0, 0xbe, 0x96, 0x4e, 0xc1, 0x19, 0xcf,
+	0x68, 0xbb, 0x76, 0xec, 0x95, 0xf8, 0xf8, 0xa2, 0x3a, 0x46, 0x73, 0x00, 0x19, 0x29, 0x48, 0x80,
+	0x1b, 0x7a, 0xcd, 0x45, 0xde, 0x51, 0x78, 0x5e, 0x59, 0xaf, 0x00, 0x64, 0x68, 0x0d, 0xf2, 0x08,
+	0x9f, 0xd5, 0x3c, 0xe3, 0x06, 0xd4, 0x2a, 0x5e, 0xd0, 0x23, 0x0b, 0xdf, 0xae, 0xf4, 0x71, 0x25,
+	0x93, 0x01, 0x3e, 0xd7, 0x3c, 0x96, 0x7a, 0x26, 0xcc, 0x5f, 0xf6, 0xd8, 0xb2, 0x67, 0xb5, 0xb1,
+	0x87, 0x9f, 0xe0, 0xcb, 0x3d, 0xdc, 0x2c, 0xe4, 0xc4, 0x45, 0xde, 0xcd, 0x90, 0xd4, 0x5e, 0xa3,
+	0x92, 0xbb, 0x18, 0x9b, 0x75, 0xc4, 0x78, 0x0a, 0x6b, 0x50, 0xb4, 0x63, 0xbf, 0xd5, 0x35, 0xeb,
+	0x71, 0x29, 0x90, 0x7b, 0xf8, 0x7a, 0x0a, 0x5c, 0xe4, 0x25, 0x41, 0x4f, 0x5d, 0xe4, 0x9d, 0x86,
+	0xd8, 0x4a, 0x16, 0x21, 0x0f, 0xf0, 0xad, 0x9c, 0xf1, 0x28, 0x8b, 0x75, 0x24, 0x15, 0x4b, 0x40,
+	0xd3, 0xae, 0xcd, 0xb8, 0x91, 0x33, 0xfe, 0x32, 0xd6, 0x13, 0xab, 0x8d, 0xbe, 0x20, 0x7c, 0x6c,
+	0x9b, 0x4d, 0x3e, 0x22, 0xdc, 0xa9, 0x3a, 0x4e, 0x1e, 0xfb, 0x07, 0xe7, 0xe9, 0x1f, 0x18, 0x57,
+	0x6f, 0xf0, 0x5f, 0x6c, 0x39, 0xc2, 0xfe, 0xc3, 0x0f, 0xdf, 0x7e, 0x7e, 0x6e, 0xbb, 0xc4, 0x09,
+	0x0e, 0xef, 0x54, 0x75, 0x7c, 0xf6, 0xfa, 0xeb, 0xd6, 0x41, 0x9b, 0xad, 0x83, 0x7e, 0x6c, 0x1d,
+	0xf4, 0x69, 0xe7, 0xb4, 0x36, 0x3b, 0xa7, 0xf5, 0x7d, 0xe7, 0xb4, 0xde, 0x8e, 0x32, 0x66, 0x66,
+	0xc5, 0xd4, 0x4f, 0x44, 0x1e, 0x3c, 0xb7, 0x19, 0x2f, 0x44, 0xc1, 0x53, 0xbb, 0x58, 0x75, 0xa8,
+	0x9c, 0x67, 0xcd, 0xe0, 0xe9, 0x89, 0xdd, 0xb7, 0xa7, 0xbf, 0x07, 0x00, 0xbd, 0x30, 0x79, 0x1a,
+	0xc9, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Profile returns the profile the node is started with and the settings it is running with.
+	Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error) {
+	out := new(QueryProfileResponse)
+	err := c.cc.Invoke(ctx, "/coreum.nodeprofile.v1.Query/Profile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Profile returns the profile the node is started with and the settings it is running with.
+	Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) Profile(ctx context.Context, req *QueryProfileRequest) (*QueryProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.nodeprofile.v1.Query/Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Profile(ctx, req.(*QueryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.nodeprofile.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Profile",
+			Handler:    _Query_Profile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/nodeprofile/v1/query.proto",
+}
+
+func (m *QueryProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinGasPrices) > 0 {
+		i -= len(m.MinGasPrices)
+		copy(dAtA[i:], m.MinGasPrices)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinGasPrices)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DenomIndex {
+		i--
+		if m.DenomIndex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TxIndexer) > 0 {
+		i -= len(m.TxIndexer)
+		copy(dAtA[i:], m.TxIndexer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxIndexer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SnapshotKeepRecent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotKeepRecent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PruningInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PruningInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PruningKeepRecent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PruningKeepRecent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pruning) > 0 {
+		i -= len(m.Pruning)
+		copy(dAtA[i:], m.Pruning)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pruning)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Profile) > 0 {
+		i -= len(m.Profile)
+		copy(dAtA[i:], m.Profile)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Profile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Pruning)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PruningKeepRecent != 0 {
+		n += 1 + sovQuery(uint64(m.PruningKeepRecent))
+	}
+	if m.PruningInterval != 0 {
+		n += 1 + sovQuery(uint64(m.PruningInterval))
+	}
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotInterval))
+	}
+	if m.SnapshotKeepRecent != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotKeepRecent))
+	}
+	l = len(m.TxIndexer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DenomIndex {
+		n += 2
+	}
+	l = len(m.MinGasPrices)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pruning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningKeepRecent", wireType)
+			}
+			m.PruningKeepRecent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningKeepRecent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningInterval", wireType)
+			}
+			m.PruningInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotKeepRecent", wireType)
+			}
+			m.SnapshotKeepRecent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotKeepRecent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndexer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIndexer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIndex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenomIndex = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,104 @@
+// Package protoset builds the FileDescriptorSet of the proto files compiled into the binary, so the clients written in
+// other languages may generate the typed clients without the proto sources.
+package protoset
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck // the well-known types are registered in this registry
+	"github.com/pkg/errors"
+)
+
+// Build returns the set of the files and all their transitive dependencies. The files must be registered in the proto
+// registry, so the packages generated from them must be linked into the binary. Each file is added once and it follows
+// all its dependencies, so the set can be passed to protoc using `--descriptor_set_in`.
+func Build(files []string) (*descriptor.FileDescriptorSet, error) {
+	set := &descriptor.FileDescriptorSet{}
+	added := map[string]struct{}{}
+	for _, file := range files {
+		if err := addFile(set, added, file); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// Marshal returns the binary encoded set.
+func Marshal(set *descriptor.FileDescriptorSet) ([]byte, error) {
+	data, err := gogoproto.Marshal(set)
+	return data, errors.WithStack(err)
+}
+
+// ListFiles returns the names of the proto files stored in the dir. The names are relative to the dir, the same way
+// they are imported by the other proto files.
+func ListFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".proto" {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(name))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func addFile(set *descriptor.FileDescriptorSet, added map[string]struct{}, file string) error {
+	if _, exists := added[file]; exists {
+		return nil
+	}
+	added[file] = struct{}{}
+
+	fd, err := fileDescriptor(file)
+	if err != nil {
+		return err
+	}
+	for _, dep := range fd.Dependency {
+		if err := addFile(set, added, dep); err != nil {
+			return errors.Wrapf(err, "dependency of %s", file)
+		}
+	}
+	set.File = append(set.File, fd)
+	return nil
+}
+
+func fileDescriptor(file string) (*descriptor.FileDescriptorProto, error) {
+	// the well-known types aren't registered in the gogo registry, so both registries are checked
+	compressed := gogoproto.FileDescriptor(file)
+	if len(compressed) == 0 {
+		compressed = proto.FileDescriptor(file) //nolint:staticcheck // the registry of the well-known types
+	}
+	if len(compressed) == 0 {
+		return nil, errors.Errorf("proto file %s isn't registered", file)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, errors.Wrapf(err, "decompressing proto file %s failed", file)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decompressing proto file %s failed", file)
+	}
+
+	fd := &descriptor.FileDescriptorProto{}
+	if err := gogoproto.Unmarshal(data, fd); err != nil {
+		return nil, errors.Wrapf(err, "decoding proto file %s failed", file)
+	}
+	return fd, nil
+}
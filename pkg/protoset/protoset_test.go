@@ -0,0 +1,52 @@
+package protoset_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	_ "github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/protoset"
+)
+
+func TestBuild_CoversAllFiles(t *testing.T) {
+	requireT := require.New(t)
+
+	files, err := protoset.ListFiles("../../proto")
+	requireT.NoError(err)
+	requireT.Contains(files, "coreum/asset/ft/v1/tx.proto")
+
+	set, err := protoset.Build(files)
+	requireT.NoError(err)
+
+	positions := map[string]int{}
+	for i, fd := range set.File {
+		requireT.NotContains(positions, fd.GetName())
+		positions[fd.GetName()] = i
+		for _, dep := range fd.Dependency {
+			requireT.Contains(positions, dep)
+			requireT.Less(positions[dep], i, "dependency %s of %s must precede it", dep, fd.GetName())
+		}
+	}
+	for _, file := range files {
+		requireT.Contains(positions, file)
+	}
+	requireT.Contains(positions, "gogoproto/gogo.proto")
+	requireT.Contains(positions, "google/protobuf/any.proto")
+
+	// the set must be resolvable by the standard proto tooling
+	data, err := protoset.Marshal(set)
+	requireT.NoError(err)
+	decoded := &descriptorpb.FileDescriptorSet{}
+	requireT.NoError(proto.Unmarshal(data, decoded))
+	_, err = protodesc.NewFiles(decoded)
+	requireT.NoError(err)
+}
+
+func TestBuild_UnknownFile(t *testing.T) {
+	_, err := protoset.Build([]string{"coreum/unknown/v1/unknown.proto"})
+	require.ErrorContains(t, err, "isn't registered")
+}
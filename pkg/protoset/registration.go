@@ -0,0 +1,55 @@
+package protoset
+
+import (
+	"bytes"
+	"compress/gzip"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+// msgFile is the name of the proto file defining the signer option used by the coreum messages. The cosmos sdk
+// v0.45 imports it only to generate the code, the descriptor isn't compiled into the sdk, so the reflection service
+// and the set miss it.
+const msgFile = "cosmos/msg/v1/msg.proto"
+
+func init() {
+	if len(gogoproto.FileDescriptor(msgFile)) != 0 {
+		return
+	}
+	gogoproto.RegisterFile(msgFile, mustCompress(&descriptor.FileDescriptorProto{
+		Name:       gogoproto.String(msgFile),
+		Package:    gogoproto.String("cosmos.msg.v1"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		Extension: []*descriptor.FieldDescriptorProto{
+			{
+				Name:     gogoproto.String("signer"),
+				Number:   gogoproto.Int32(11110000),
+				Label:    descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Type:     descriptor.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Extendee: gogoproto.String(".google.protobuf.MessageOptions"),
+				JsonName: gogoproto.String("signer"),
+			},
+		},
+		Options: &descriptor.FileOptions{
+			GoPackage: gogoproto.String("github.com/cosmos/cosmos-sdk/types/msgservice"),
+		},
+		Syntax: gogoproto.String("proto3"),
+	}))
+}
+
+func mustCompress(fd *descriptor.FileDescriptorProto) []byte {
+	data, err := gogoproto.Marshal(fd)
+	if err != nil {
+		panic(err)
+	}
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(data); err != nil {
+		panic(err)
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,148 @@
+// Package shamir implements the Shamir's secret sharing over GF(256). The secret is split into the shares, so any
+// threshold number of them reconstructs the secret, while fewer shares reveal nothing about it.
+package shamir
+
+import (
+	"crypto/rand"
+
+	"github.com/pkg/errors"
+)
+
+// MaxShares is the maximum number of the shares the secret might be split into.
+const MaxShares = 255
+
+// expTable and logTable are the exponent and logarithm tables of GF(256) with the generator 0x03 and the reduction
+// polynomial x^8 + x^4 + x^3 + x + 1.
+var (
+	expTable [255]byte
+	logTable [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		expTable[i] = x
+		logTable[x] = byte(i)
+		// multiply by the generator: x * 3 = x * 2 + x
+		x2 := x << 1
+		if x&0x80 != 0 {
+			x2 ^= 0x1b
+		}
+		x = x2 ^ x
+	}
+}
+
+// Split splits the secret into the shares, any threshold number of them reconstructs the secret. Each share is one
+// byte longer than the secret, the first byte is the x-coordinate identifying the share.
+func Split(secret []byte, shares, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("secret must not be empty")
+	}
+	if threshold < 2 {
+		return nil, errors.Errorf("threshold must be at least 2, got %d", threshold)
+	}
+	if shares < threshold {
+		return nil, errors.Errorf("number of shares %d must not be lower than the threshold %d", shares, threshold)
+	}
+	if shares > MaxShares {
+		return nil, errors.Errorf("number of shares %d exceeds the limit of %d", shares, MaxShares)
+	}
+
+	result := make([][]byte, shares)
+	for i := range result {
+		result[i] = make([]byte, len(secret)+1)
+		result[i][0] = byte(i + 1)
+	}
+
+	// the coefficients of the polynomial, the first one is the byte of the secret
+	coefficients := make([]byte, threshold)
+	for pos, secretByte := range secret {
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		coefficients[0] = secretByte
+		for _, share := range result {
+			share[pos+1] = evaluate(coefficients, share[0])
+		}
+	}
+	zero(coefficients)
+
+	return result, nil
+}
+
+// Combine reconstructs the secret from the shares. The number of the shares must not be lower than the threshold
+// used to split the secret, otherwise the returned secret is garbage.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, errors.Errorf("at least 2 shares are required, got %d", len(shares))
+	}
+
+	length := len(shares[0])
+	if length < 2 {
+		return nil, errors.New("share is too short")
+	}
+	seen := map[byte]struct{}{}
+	for _, share := range shares {
+		if len(share) != length {
+			return nil, errors.New("shares have different lengths")
+		}
+		if share[0] == 0 {
+			return nil, errors.New("share has invalid x-coordinate")
+		}
+		if _, exists := seen[share[0]]; exists {
+			return nil, errors.Errorf("share %d is duplicated", share[0])
+		}
+		seen[share[0]] = struct{}{}
+	}
+
+	secret := make([]byte, length-1)
+	for pos := range secret {
+		// lagrange interpolation at x = 0
+		var value byte
+		for i, shareI := range shares {
+			basis := byte(1)
+			for j, shareJ := range shares {
+				if i == j {
+					continue
+				}
+				basis = mul(basis, div(shareJ[0], shareJ[0]^shareI[0]))
+			}
+			value ^= mul(shareI[pos+1], basis)
+		}
+		secret[pos] = value
+	}
+
+	return secret, nil
+}
+
+// evaluate evaluates the polynomial at x using the Horner's method.
+func evaluate(coefficients []byte, x byte) byte {
+	var result byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		result = mul(result, x) ^ coefficients[i]
+	}
+	return result
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])+int(logTable[b]))%255]
+}
+
+func div(a, b byte) byte {
+	if b == 0 {
+		panic("division by zero")
+	}
+	if a == 0 {
+		return 0
+	}
+	return expTable[(int(logTable[a])-int(logTable[b])+255)%255]
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,65 @@
+package shamir_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/shamir"
+)
+
+func TestSplitCombine(t *testing.T) {
+	requireT := require.New(t)
+
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	requireT.NoError(err)
+
+	shares, err := shamir.Split(secret, 5, 3)
+	requireT.NoError(err)
+	requireT.Len(shares, 5)
+	for _, share := range shares {
+		requireT.Len(share, len(secret)+1)
+	}
+
+	// any threshold number of shares reconstructs the secret
+	for _, subset := range [][]int{{0, 1, 2}, {0, 2, 4}, {4, 3, 1}, {0, 1, 2, 3, 4}} {
+		var selected [][]byte
+		for _, i := range subset {
+			selected = append(selected, shares[i])
+		}
+		combined, err := shamir.Combine(selected)
+		requireT.NoError(err)
+		requireT.Equal(secret, combined)
+	}
+
+	// fewer shares don't reconstruct the secret
+	combined, err := shamir.Combine(shares[:2])
+	requireT.NoError(err)
+	requireT.NotEqual(secret, combined)
+}
+
+func TestSplitCombine_Errors(t *testing.T) {
+	requireT := require.New(t)
+
+	_, err := shamir.Split(nil, 3, 2)
+	requireT.Error(err)
+	_, err = shamir.Split([]byte{1}, 3, 1)
+	requireT.Error(err)
+	_, err = shamir.Split([]byte{1}, 2, 3)
+	requireT.Error(err)
+	_, err = shamir.Split([]byte{1}, shamir.MaxShares+1, 2)
+	requireT.Error(err)
+
+	shares, err := shamir.Split([]byte{1, 2, 3}, 3, 2)
+	requireT.NoError(err)
+	_, err = shamir.Combine(shares[:1])
+	requireT.Error(err)
+	_, err = shamir.Combine([][]byte{shares[0], shares[0]})
+	requireT.Error(err)
+	_, err = shamir.Combine([][]byte{shares[0], shares[1][:2]})
+	requireT.Error(err)
+	_, err = shamir.Combine([][]byte{{0, 1, 2, 3}, shares[1]})
+	requireT.Error(err)
+}
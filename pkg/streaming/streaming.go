@@ -0,0 +1,363 @@
+// Package streaming decodes the state changes of the asset module stores written by the SDK file streaming service.
+package streaming
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+// StoreKeys are the store keys supported by the Decoder.
+var StoreKeys = []string{
+	assetfttypes.StoreKey,
+	assetnfttypes.StoreKey,
+	nftkeeper.StoreKey,
+}
+
+// UnknownType is the type of the records stored under the prefixes the Decoder doesn't know. Such records are
+// reported with the raw key only, so the new records added to the stores don't break the decoding.
+const UnknownType = "unknown"
+
+// StateChange is the decoded state change of the store.
+type StateChange struct {
+	// StoreKey is the name of the store the change belongs to.
+	StoreKey string `json:"store_key"`
+	// Type is the type of the record stored under the key.
+	Type string `json:"type"`
+	// Key is the hex encoded key of the record.
+	Key string `json:"key"`
+	// Delete is true if the record has been deleted.
+	Delete bool `json:"delete"`
+	// Attributes are the values decoded from the key.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Value is the JSON representation of the stored value.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ReadStoreKVPairs reads the store kv pairs from the file written by the file streaming service.
+// The file contains the length-prefixed ABCI request, the length-prefixed store kv pairs and the length-prefixed
+// ABCI response.
+func ReadStoreKVPairs(cdc codec.BinaryCodec, r io.Reader) ([]storetypes.StoreKVPair, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var chunks [][]byte
+	for len(data) > 0 {
+		size, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < size {
+			return nil, errors.New("malformed length-prefixed message")
+		}
+		chunks = append(chunks, data[n:n+int(size)])
+		data = data[n+int(size):]
+	}
+	if len(chunks) < 2 {
+		return nil, errors.Errorf("file must contain ABCI request and response, got %d messages", len(chunks))
+	}
+
+	pairs := make([]storetypes.StoreKVPair, 0, len(chunks)-2)
+	for _, chunk := range chunks[1 : len(chunks)-1] {
+		var pair storetypes.StoreKVPair
+		if err := cdc.Unmarshal(chunk, &pair); err != nil {
+			return nil, errors.Wrap(err, "can't unmarshal store kv pair")
+		}
+		pairs = append(pairs, pair)
+	}
+
+	return pairs, nil
+}
+
+// Decoder decodes the state changes of the asset module stores.
+type Decoder struct {
+	cdc codec.Codec
+}
+
+// NewDecoder returns new instance of the Decoder.
+func NewDecoder(cdc codec.Codec) Decoder {
+	return Decoder{
+		cdc: cdc,
+	}
+}
+
+// Decode decodes the store kv pair. It returns false if the store is not supported by the decoder.
+func (d Decoder) Decode(pair storetypes.StoreKVPair) (StateChange, bool, error) {
+	change := StateChange{
+		StoreKey: pair.StoreKey,
+		Key:      hex.EncodeToString(pair.Key),
+		Delete:   pair.Delete,
+	}
+	if len(pair.Key) == 0 {
+		return StateChange{}, false, errors.New("empty key")
+	}
+
+	var err error
+	switch pair.StoreKey {
+	case assetfttypes.StoreKey:
+		err = d.decodeAssetFT(&change, pair)
+	case assetnfttypes.StoreKey:
+		err = d.decodeAssetNFT(&change, pair)
+	case nftkeeper.StoreKey:
+		err = d.decodeNFT(&change, pair)
+	default:
+		return StateChange{}, false, nil
+	}
+	if err != nil {
+		return StateChange{}, false, errors.Wrapf(err, "can't decode %s key %s", pair.StoreKey, change.Key)
+	}
+
+	return change, true, nil
+}
+
+func (d Decoder) decodeAssetFT(change *StateChange, pair storetypes.StoreKVPair) error {
+	prefix, key := pair.Key[:1], pair.Key[1:]
+	switch {
+	case bytes.Equal(prefix, assetfttypes.FTKeyPrefix):
+		change.Type = "ft_definition"
+		return d.setValue(change, pair, &assetfttypes.FTDefinition{})
+	case bytes.Equal(prefix, assetfttypes.SymbolKeyPrefix):
+		change.Type = "symbol"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.FrozenBalancesKeyPrefix):
+		change.Type = "frozen_balance"
+		return d.decodeBalance(change, pair, key)
+	case bytes.Equal(prefix, assetfttypes.GlobalFreezeKeyPrefix):
+		change.Type = "global_freeze"
+		change.Attributes = map[string]string{"denom": string(key)}
+		return nil
+	case bytes.Equal(prefix, assetfttypes.TokenStatusKeyPrefix):
+		change.Type = "token_status"
+		change.Attributes = map[string]string{"denom": string(key)}
+		if pair.Delete || len(pair.Value) == 0 {
+			return nil
+		}
+		return d.setJSONValue(change, assetfttypes.TokenStatus(pair.Value[0]).String())
+	case bytes.Equal(prefix, assetfttypes.WhitelistedBalancesKeyPrefix):
+		change.Type = "whitelisted_balance"
+		return d.decodeBalance(change, pair, key)
+	case bytes.Equal(prefix, assetfttypes.BridgeMintRecordKeyPrefix):
+		change.Type = "bridge_mint_record"
+		return d.setValue(change, pair, &assetfttypes.BridgeMintRecord{})
+	case bytes.Equal(prefix, assetfttypes.WhitelistExemptionKeyPrefix):
+		change.Type = "whitelist_exemption"
+		return d.setValue(change, pair, &assetfttypes.WhitelistExemption{})
+	case bytes.Equal(prefix, assetfttypes.IBCDenomTraceKeyPrefix):
+		change.Type = "ibc_denom_trace"
+		return d.setValue(change, pair, &assetfttypes.IBCDenomTrace{})
+	case bytes.Equal(prefix, assetfttypes.NextReservationIDKey):
+		change.Type = "next_reservation_id"
+		return d.setUint64Value(change, pair)
+	case bytes.Equal(prefix, assetfttypes.ReservationKeyPrefix):
+		change.Type = "reservation"
+		return d.setValue(change, pair, &assetfttypes.Reservation{})
+	case bytes.Equal(prefix, assetfttypes.ReservationExpirationQueueKeyPrefix):
+		change.Type = "reservation_expiration_queue"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.PayeeReservationKeyPrefix):
+		change.Type = "payee_reservation"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.FeatureTokenKeyPrefix):
+		change.Type = "feature_token"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.IssueIdempotencyKeyPrefix):
+		change.Type = "issue_idempotency"
+		return d.setValue(change, pair, &assetfttypes.IssueIdempotencyRecord{})
+	case bytes.Equal(prefix, assetfttypes.PendingGlobalFreezeKeyPrefix):
+		change.Type = "pending_global_freeze"
+		return d.setValue(change, pair, &assetfttypes.PendingGlobalFreeze{})
+	case bytes.Equal(prefix, assetfttypes.PendingGlobalFreezeQueueKeyPrefix):
+		change.Type = "pending_global_freeze_queue"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.FrozenRateKeyPrefix):
+		change.Type = "frozen_rate"
+		return d.setValue(change, pair, &assetfttypes.FrozenRate{})
+	case bytes.Equal(prefix, assetfttypes.ReserveAttestationKeyPrefix):
+		change.Type = "reserve_attestations"
+		return d.setValue(change, pair, &assetfttypes.ReserveAttestationHistory{})
+	case bytes.Equal(prefix, assetfttypes.TokenAdminKeyPrefix):
+		change.Type = "token_admin"
+		return d.setValue(change, pair, &assetfttypes.TokenAdmin{})
+	case bytes.Equal(prefix, assetfttypes.PendingAdminTransferKeyPrefix):
+		change.Type = "pending_admin_transfer"
+		return d.setValue(change, pair, &assetfttypes.PendingAdminTransfer{})
+	case bytes.Equal(prefix, assetfttypes.PendingAdminTransferQueueKeyPrefix):
+		change.Type = "pending_admin_transfer_queue"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.TimedFreezeKeyPrefix):
+		change.Type = "timed_freeze"
+		return d.setValue(change, pair, &assetfttypes.TimedFreeze{})
+	case bytes.Equal(prefix, assetfttypes.TimedFreezeQueueKeyPrefix):
+		change.Type = "timed_freeze_queue"
+		return nil
+	case bytes.Equal(prefix, assetfttypes.BurnAllowanceKeyPrefix):
+		change.Type = "burn_allowance"
+		return d.setValue(change, pair, &assetfttypes.BurnAllowance{})
+	case bytes.Equal(prefix, assetfttypes.AccountFreezeKeyPrefix):
+		change.Type = "account_freeze"
+		return d.setValue(change, pair, &assetfttypes.AccountFreeze{})
+	case bytes.Equal(prefix, assetfttypes.TokenStatsKeyPrefix):
+		change.Type = "token_stats"
+		return d.setValue(change, pair, &assetfttypes.TokenStatsCounters{})
+	case bytes.Equal(prefix, assetfttypes.BurnRateExemptionKeyPrefix):
+		change.Type = "burn_rate_exemption"
+		return d.setValue(change, pair, &assetfttypes.BurnRateExemption{})
+	case bytes.Equal(prefix, assetfttypes.TokenAttributeKeyPrefix):
+		change.Type = "token_attribute"
+		return d.setValue(change, pair, &assetfttypes.TokenAttribute{})
+	default:
+		change.Type = UnknownType
+		return nil
+	}
+}
+
+func (d Decoder) decodeBalance(change *StateChange, pair storetypes.StoreKVPair, key []byte) error {
+	addr, err := assetfttypes.AddressFromBalancesStore(key)
+	if err != nil {
+		return err
+	}
+	change.Attributes = map[string]string{
+		"account": addr.String(),
+		"denom":   string(key[len(addr)+1:]),
+	}
+	return d.setValue(change, pair, &sdk.Coin{})
+}
+
+func (d Decoder) decodeNFT(change *StateChange, pair storetypes.StoreKVPair) error {
+	prefix := pair.Key[:1]
+	switch {
+	case bytes.Equal(prefix, nftkeeper.ClassKey):
+		change.Type = "class"
+		return d.setValue(change, pair, &nft.Class{})
+	case bytes.Equal(prefix, nftkeeper.NFTKey):
+		change.Type = "nft"
+		return d.setValue(change, pair, &nft.NFT{})
+	case bytes.Equal(prefix, nftkeeper.NFTOfClassByOwnerKey):
+		change.Type = "nft_of_class_by_owner"
+		return nil
+	case bytes.Equal(prefix, nftkeeper.OwnerKey):
+		change.Type = "owner"
+		return d.setAddressValue(change, pair)
+	case bytes.Equal(prefix, nftkeeper.ClassTotalSupply):
+		change.Type = "class_total_supply"
+		return d.setUint64Value(change, pair)
+	default:
+		change.Type = UnknownType
+		return nil
+	}
+}
+
+func (d Decoder) decodeAssetNFT(change *StateChange, pair storetypes.StoreKVPair) error {
+	prefix := pair.Key[:1]
+	switch {
+	case bytes.Equal(prefix, assetnfttypes.IDPrefixReservationKeyPrefix):
+		change.Type = "id_prefix_reservation"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.AcceptedSaleOfferKeyPrefix):
+		change.Type = "accepted_sale_offer"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.UserGrantKeyPrefix):
+		change.Type = "user_grant"
+		return d.setValue(change, pair, &assetnfttypes.UserGrant{})
+	case bytes.Equal(prefix, assetnfttypes.UserGrantExpirationQueueKeyPrefix):
+		change.Type = "user_grant_expiration_queue"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.ProvenanceClassKeyPrefix):
+		change.Type = "provenance_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.ProvenanceRecordKeyPrefix):
+		change.Type = "provenance_record"
+		return d.setValue(change, pair, &assetnfttypes.ProvenanceRecord{})
+	case bytes.Equal(prefix, assetnfttypes.ClassOwnerKeyPrefix):
+		change.Type = "class_owner"
+		return d.setAddressValue(change, pair)
+	case bytes.Equal(prefix, assetnfttypes.PendingClassOwnerKeyPrefix):
+		change.Type = "pending_class_owner"
+		return d.setAddressValue(change, pair)
+	case bytes.Equal(prefix, assetnfttypes.RewardPoolKeyPrefix):
+		change.Type = "reward_pool"
+		return d.setValue(change, pair, &assetnfttypes.RewardPool{})
+	case bytes.Equal(prefix, assetnfttypes.NFTLockKeyPrefix):
+		change.Type = "nft_lock"
+		return d.setValue(change, pair, &assetnfttypes.NFTLock{})
+	case bytes.Equal(prefix, assetnfttypes.FreezingClassKeyPrefix):
+		change.Type = "freezing_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.FrozenClassKeyPrefix):
+		change.Type = "frozen_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.RevocableClassKeyPrefix):
+		change.Type = "revocable_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.WhitelistingClassKeyPrefix):
+		change.Type = "whitelisting_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.ClassWhitelistKeyPrefix):
+		change.Type = "class_whitelist"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.ClassRoyaltyRateKeyPrefix):
+		change.Type = "class_royalty_rate"
+		return d.setValue(change, pair, &sdk.DecProto{})
+	case bytes.Equal(prefix, assetnfttypes.SoulboundClassKeyPrefix):
+		change.Type = "soulbound_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.IssuerClassKeyPrefix):
+		change.Type = "issuer_class"
+		return nil
+	case bytes.Equal(prefix, assetnfttypes.CanceledSaleOfferKeyPrefix):
+		change.Type = "canceled_sale_offer"
+		return nil
+	default:
+		change.Type = UnknownType
+		return nil
+	}
+}
+
+func (d Decoder) setValue(change *StateChange, pair storetypes.StoreKVPair, value codec.ProtoMarshaler) error {
+	if pair.Delete {
+		return nil
+	}
+	if err := d.cdc.Unmarshal(pair.Value, value); err != nil {
+		return errors.WithStack(err)
+	}
+	bz, err := d.cdc.MarshalJSON(value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	change.Value = bz
+	return nil
+}
+
+func (d Decoder) setAddressValue(change *StateChange, pair storetypes.StoreKVPair) error {
+	if pair.Delete {
+		return nil
+	}
+	return d.setJSONValue(change, sdk.AccAddress(pair.Value).String())
+}
+
+func (d Decoder) setUint64Value(change *StateChange, pair storetypes.StoreKVPair) error {
+	if pair.Delete {
+		return nil
+	}
+	return d.setJSONValue(change, sdk.BigEndianToUint64(pair.Value))
+}
+
+func (d Decoder) setJSONValue(change *StateChange, value any) error {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	change.Value = bz
+	return nil
+}
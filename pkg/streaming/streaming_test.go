@@ -0,0 +1,213 @@
+package streaming_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/CoreumFoundation/coreum/app"
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/pkg/streaming"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+func TestReadAndDecode(t *testing.T) {
+	requireT := require.New(t)
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+
+	issuer := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	denom := assetfttypes.BuildDenom("abc", issuer)
+	definition := assetfttypes.FTDefinition{
+		Denom:    denom,
+		Issuer:   issuer.String(),
+		BurnRate: sdk.MustNewDecFromStr("0.1"),
+	}
+	frozen := sdk.NewCoin(denom, sdk.NewInt(10))
+	class := nft.Class{Id: "kitty"}
+
+	pairs := []storetypes.StoreKVPair{
+		{
+			StoreKey: assetfttypes.StoreKey,
+			Key:      assetfttypes.GetTokenKey(denom),
+			Value:    cdc.MustMarshal(&definition),
+		},
+		{
+			StoreKey: assetfttypes.StoreKey,
+			Key:      append(assetfttypes.CreateFrozenBalancesPrefix(issuer), []byte(denom)...),
+			Value:    cdc.MustMarshal(&frozen),
+		},
+		{
+			StoreKey: assetfttypes.StoreKey,
+			Key:      assetfttypes.CreateGlobalFreezePrefix(denom),
+			Delete:   true,
+		},
+		{
+			StoreKey: nftkeeper.StoreKey,
+			Key:      append(append([]byte{}, nftkeeper.ClassKey...), []byte(class.Id)...),
+			Value:    cdc.MustMarshal(&class),
+		},
+		{
+			StoreKey: "bank",
+			Key:      []byte{0x02},
+			Value:    []byte{0x01},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	bz, err := cdc.MarshalLengthPrefixed(&abci.RequestDeliverTx{Tx: []byte{0x01}})
+	requireT.NoError(err)
+	buf.Write(bz)
+	for i := range pairs {
+		bz, err := cdc.MarshalLengthPrefixed(&pairs[i])
+		requireT.NoError(err)
+		buf.Write(bz)
+	}
+	bz, err = cdc.MarshalLengthPrefixed(&abci.ResponseDeliverTx{Code: 0})
+	requireT.NoError(err)
+	buf.Write(bz)
+
+	readPairs, err := streaming.ReadStoreKVPairs(cdc, buf)
+	requireT.NoError(err)
+	requireT.Len(readPairs, len(pairs))
+
+	decoder := streaming.NewDecoder(cdc)
+
+	change, ok, err := decoder.Decode(readPairs[0])
+	requireT.NoError(err)
+	requireT.True(ok)
+	requireT.Equal("ft_definition", change.Type)
+	var decodedDefinition assetfttypes.FTDefinition
+	requireT.NoError(cdc.UnmarshalJSON(change.Value, &decodedDefinition))
+	requireT.Equal(definition.Denom, decodedDefinition.Denom)
+	requireT.Equal(definition.BurnRate.String(), decodedDefinition.BurnRate.String())
+
+	change, ok, err = decoder.Decode(readPairs[1])
+	requireT.NoError(err)
+	requireT.True(ok)
+	requireT.Equal("frozen_balance", change.Type)
+	requireT.Equal(map[string]string{"account": issuer.String(), "denom": denom}, change.Attributes)
+	var decodedFrozen sdk.Coin
+	requireT.NoError(json.Unmarshal(change.Value, &decodedFrozen))
+	requireT.Equal(frozen.String(), decodedFrozen.String())
+
+	change, ok, err = decoder.Decode(readPairs[2])
+	requireT.NoError(err)
+	requireT.True(ok)
+	requireT.Equal("global_freeze", change.Type)
+	requireT.True(change.Delete)
+	requireT.Equal(map[string]string{"denom": denom}, change.Attributes)
+	requireT.Empty(change.Value)
+
+	change, ok, err = decoder.Decode(readPairs[3])
+	requireT.NoError(err)
+	requireT.True(ok)
+	requireT.Equal("class", change.Type)
+	var decodedClass nft.Class
+	requireT.NoError(cdc.UnmarshalJSON(change.Value, &decodedClass))
+	requireT.Equal(class.Id, decodedClass.Id)
+
+	_, ok, err = decoder.Decode(readPairs[4])
+	requireT.NoError(err)
+	requireT.False(ok)
+}
+
+func TestDecode_AllPrefixes(t *testing.T) {
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	decoder := streaming.NewDecoder(cdc)
+
+	// the key suffix is the length-prefixed address followed by the denom, so the keys of the balances can be decoded
+	keySuffix := append(address.MustLengthPrefix(bytes.Repeat([]byte{0x01}, 20)), []byte("denom")...)
+	for storeKey, keysFile := range map[string]string{
+		assetfttypes.StoreKey:  "../../x/asset/ft/types/keys.go",
+		assetnfttypes.StoreKey: "../../x/asset/nft/types/keys.go",
+		nftkeeper.StoreKey:     "../../x/nft/keeper/keys.go",
+	} {
+		prefixes := readKeyPrefixes(t, keysFile)
+		require.NotEmpty(t, prefixes)
+		for name, prefix := range prefixes {
+			change, ok, err := decoder.Decode(storetypes.StoreKVPair{
+				StoreKey: storeKey,
+				Delete:   true,
+				Key:      append([]byte{prefix}, keySuffix...),
+			})
+			require.NoError(t, err, name)
+			require.True(t, ok, name)
+			require.NotEqual(t, streaming.UnknownType, change.Type, "%s of %s isn't decoded", name, storeKey)
+		}
+	}
+}
+
+func TestDecode_UnknownPrefix(t *testing.T) {
+	requireT := require.New(t)
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	decoder := streaming.NewDecoder(cdc)
+
+	for _, storeKey := range streaming.StoreKeys {
+		change, ok, err := decoder.Decode(storetypes.StoreKVPair{
+			StoreKey: storeKey,
+			Key:      []byte{0xff, 0x01},
+			Value:    []byte{0x01},
+		})
+		requireT.NoError(err)
+		requireT.True(ok)
+		requireT.Equal(streaming.UnknownType, change.Type)
+		requireT.Equal("ff01", change.Key)
+		requireT.Empty(change.Value)
+	}
+}
+
+// readKeyPrefixes returns the one-byte key prefixes declared in the keys file, by their names.
+func readKeyPrefixes(t *testing.T, keysFile string) map[string]byte {
+	file, err := parser.ParseFile(token.NewFileSet(), keysFile, nil, 0)
+	require.NoError(t, err)
+
+	prefixes := map[string]byte{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+			return true
+		}
+		name := spec.Names[0].Name
+		// the delimiter and the placeholder of the nft module aren't the prefixes
+		if !strings.HasSuffix(name, "Key") && !strings.HasSuffix(name, "KeyPrefix") && name != "ClassTotalSupply" {
+			return true
+		}
+		lit, ok := spec.Values[0].(*ast.CompositeLit)
+		if !ok || len(lit.Elts) != 1 {
+			return true
+		}
+		value, ok := lit.Elts[0].(*ast.BasicLit)
+		if !ok {
+			return true
+		}
+		prefix, err := strconv.ParseUint(value.Value, 0, 8)
+		require.NoError(t, err)
+		prefixes[name] = byte(prefix)
+		return true
+	})
+	return prefixes
+}
+
+func TestReadStoreKVPairs_Malformed(t *testing.T) {
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+
+	_, err := streaming.ReadStoreKVPairs(cdc, bytes.NewReader([]byte{0x05, 0x01}))
+	require.Error(t, err)
+
+	_, err = streaming.ReadStoreKVPairs(cdc, bytes.NewReader([]byte{}))
+	require.Error(t, err)
+}
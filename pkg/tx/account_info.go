@@ -0,0 +1,70 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// AccountInfo is the account number and the sequence required to sign the transaction on behalf of the account.
+type AccountInfo struct {
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+}
+
+// AccountInfoProvider supplies the account number and the sequence of the account instead of querying the chain,
+// so the transactions might be constructed and signed on the machine with no network access.
+type AccountInfoProvider interface {
+	// AccountInfo returns the account number and the sequence of the account.
+	AccountInfo(ctx context.Context, address sdk.AccAddress) (AccountInfo, error)
+}
+
+var _ AccountInfoProvider = StaticAccountInfoProvider{}
+
+// StaticAccountInfoProvider provides the account infos known in advance, e.g. passed by the flags or read
+// from the file. The infos are indexed by the bech32 address of the account.
+type StaticAccountInfoProvider map[string]AccountInfo
+
+// NewStaticAccountInfoProvider returns the provider of the account info of the single account.
+func NewStaticAccountInfoProvider(address sdk.AccAddress, accountNumber, sequence uint64) StaticAccountInfoProvider {
+	return StaticAccountInfoProvider{
+		address.String(): {
+			AccountNumber: accountNumber,
+			Sequence:      sequence,
+		},
+	}
+}
+
+// NewFileAccountInfoProvider returns the provider of the account infos read from the JSON file mapping
+// the bech32 addresses to the account infos, e.g.
+// {"core1...": {"account_number": 3, "sequence": 5}}.
+func NewFileAccountInfoProvider(path string) (StaticAccountInfoProvider, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't read account info file %q", path)
+	}
+
+	var provider StaticAccountInfoProvider
+	if err := json.Unmarshal(bz, &provider); err != nil {
+		return nil, errors.Wrapf(err, "can't decode account info file %q", path)
+	}
+	for address := range provider {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return nil, errors.Wrapf(err, "invalid address %q in account info file %q", address, path)
+		}
+	}
+
+	return provider, nil
+}
+
+// AccountInfo returns the account number and the sequence of the account.
+func (p StaticAccountInfoProvider) AccountInfo(_ context.Context, address sdk.AccAddress) (AccountInfo, error) {
+	info, ok := p[address.String()]
+	if !ok {
+		return AccountInfo{}, errors.Errorf("account info of %s is not provided", address)
+	}
+	return info, nil
+}
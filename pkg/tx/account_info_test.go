@@ -0,0 +1,105 @@
+package tx_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+)
+
+func TestFileAccountInfoProvider(t *testing.T) {
+	requireT := require.New(t)
+
+	address := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	path := filepath.Join(t.TempDir(), "accounts.json")
+	requireT.NoError(os.WriteFile(path, []byte(`{"`+address.String()+`": {"account_number": 3, "sequence": 5}}`), 0o600))
+
+	provider, err := tx.NewFileAccountInfoProvider(path)
+	requireT.NoError(err)
+	info, err := provider.AccountInfo(context.Background(), address)
+	requireT.NoError(err)
+	requireT.Equal(tx.AccountInfo{AccountNumber: 3, Sequence: 5}, info)
+
+	// the info of the other account is not provided
+	_, err = provider.AccountInfo(context.Background(), sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	requireT.Error(err)
+
+	// invalid files
+	_, err = tx.NewFileAccountInfoProvider(filepath.Join(t.TempDir(), "missing.json"))
+	requireT.Error(err)
+	requireT.NoError(os.WriteFile(path, []byte(`{"invalid": {"account_number": 3, "sequence": 5}}`), 0o600))
+	_, err = tx.NewFileAccountInfoProvider(path)
+	requireT.Error(err)
+	requireT.NoError(os.WriteFile(path, []byte(`[]`), 0o600))
+	_, err = tx.NewFileAccountInfoProvider(path)
+	requireT.Error(err)
+}
+
+func TestBuildSignedTx(t *testing.T) {
+	requireT := require.New(t)
+
+	mockSigner := tx.NewMockSigner()
+	address := mockSigner.AddKey(secp256k1.GenPrivKey())
+
+	// no rpc client is set, so the transaction is built and signed with no network access
+	clientCtx := tx.NewClientContext(module.NewBasicManager(bank.AppModuleBasic{})).
+		WithChainID("test-chain").
+		WithFromAddress(address).
+		WithSigner(mockSigner).
+		WithAccountInfoProvider(tx.NewStaticAccountInfoProvider(address, 3, 5))
+	txf := tx.Factory{}.
+		WithTxConfig(clientCtx.TxConfig()).
+		WithChainID(clientCtx.ChainID()).
+		WithGas(100000)
+	msg := &banktypes.MsgSend{
+		FromAddress: address.String(),
+		ToAddress:   sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("ucore", 10)),
+	}
+
+	txBytes, err := tx.BuildSignedTx(context.Background(), clientCtx, txf, msg)
+	requireT.NoError(err)
+
+	decodedTx, err := clientCtx.TxConfig().TxDecoder()(txBytes)
+	requireT.NoError(err)
+	sigTx, ok := decodedTx.(authsigning.SigVerifiableTx)
+	requireT.True(ok)
+	signatures, err := sigTx.GetSignaturesV2()
+	requireT.NoError(err)
+	requireT.Len(signatures, 1)
+	requireT.NoError(authsigning.VerifySignature(
+		signatures[0].PubKey,
+		authsigning.SignerData{
+			ChainID:       "test-chain",
+			AccountNumber: 3,
+			Sequence:      5,
+		},
+		signatures[0].Data,
+		clientCtx.TxConfig().SignModeHandler(),
+		sigTx,
+	))
+
+	// the gas can't be simulated offline
+	_, err = tx.BuildSignedTx(context.Background(), clientCtx, txf.WithGas(0), msg)
+	requireT.Error(err)
+
+	// the account info of the sender is not provided
+	otherAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = tx.BuildSignedTx(
+		context.Background(),
+		clientCtx.WithAccountInfoProvider(tx.NewStaticAccountInfoProvider(otherAddress, 3, 5)),
+		txf,
+		msg,
+	)
+	requireT.Error(err)
+}
@@ -42,7 +42,9 @@ func NewClientContext(modules module.BasicManager) ClientContext {
 // ClientContext exposes the functionality of SDK context in a way where we may intercept GRPC-related method (Invoke)
 // to provide better implementation
 type ClientContext struct {
-	clientCtx client.Context
+	clientCtx           client.Context
+	signer              Signer
+	accountInfoProvider AccountInfoProvider
 }
 
 // ChainID returns chain ID
@@ -133,6 +135,29 @@ func (c ClientContext) WithKeyring(k keyring.Keyring) ClientContext {
 	return c
 }
 
+// Signer returns the signer used instead of the keyring
+func (c ClientContext) Signer() Signer {
+	return c.signer
+}
+
+// WithSigner returns a copy of the context with the signer used to sign the transactions instead of the keyring
+func (c ClientContext) WithSigner(signer Signer) ClientContext {
+	c.signer = signer
+	return c
+}
+
+// AccountInfoProvider returns the provider of the account infos used instead of querying the chain
+func (c ClientContext) AccountInfoProvider() AccountInfoProvider {
+	return c.accountInfoProvider
+}
+
+// WithAccountInfoProvider returns a copy of the context with the provider of the account number and the sequence
+// used to sign the transactions instead of querying the chain
+func (c ClientContext) WithAccountInfoProvider(provider AccountInfoProvider) ClientContext {
+	c.accountInfoProvider = provider
+	return c
+}
+
 // Invoke invokes GRPC method
 func (c ClientContext) Invoke(ctx context.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
 	if reflect.ValueOf(req).IsNil() {
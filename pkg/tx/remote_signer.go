@@ -0,0 +1,254 @@
+package tx
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// RemoteSignerSecretMinSize is the minimum size of the secret shared by the remote signer and its clients.
+const RemoteSignerSecretMinSize = 32
+
+const (
+	remoteSignerMethodPubKey = "pub_key"
+	remoteSignerMethodSign   = "sign"
+)
+
+// remoteSignerRequest is the request sent to the remote signer.
+type remoteSignerRequest struct {
+	Method    string `json:"method"`
+	Address   string `json:"address"`
+	SignBytes []byte `json:"sign_bytes,omitempty"`
+	// MAC is the HMAC-SHA256 of the request computed with the shared secret.
+	MAC []byte `json:"mac"`
+}
+
+// computeMAC returns the HMAC-SHA256 of the length-prefixed fields of the request computed with the secret.
+func (r remoteSignerRequest) computeMAC(secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	var size [binary.MaxVarintLen64]byte
+	for _, field := range [][]byte{[]byte(r.Method), []byte(r.Address), r.SignBytes} {
+		n := binary.PutUvarint(size[:], uint64(len(field)))
+		//nolint:errcheck // the hash never returns an error
+		mac.Write(size[:n])
+		//nolint:errcheck // the hash never returns an error
+		mac.Write(field)
+	}
+	return mac.Sum(nil)
+}
+
+// remoteSignerResponse is the response returned by the remote signer.
+type remoteSignerResponse struct {
+	PubKey    []byte `json:"pub_key,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+var _ Signer = RemoteSigner{}
+
+// RemoteSigner is the Signer delegating the signing to the external signing service.
+// Each request opens a new connection to the service and exchanges the single JSON encoded request and response.
+// The public keys of the accounts must be secp256k1 keys.
+//
+// The protocol of the tendermint remote signers, e.g. tmkms, isn't used because it signs the consensus messages of the
+// validator key only, the votes and the proposals, and the signer validates their content, so it can't sign the
+// transactions. The single JSON message over the plain connection is used instead of gRPC, so the signing service
+// in front of the HSM might be implemented in any language in a few lines, without the protobuf definitions.
+//
+// The signing service signs any bytes it's asked to, so it must accept the requests of the trusted clients only.
+// Every request is authenticated by the HMAC computed with the secret shared by the service and its clients, the
+// service rejects the requests without the valid HMAC. The connection is neither encrypted nor authenticating the
+// service, so the network path between the clients and the service must be trusted: the observer learns the signed
+// bytes and the signatures, which are public once the transaction is broadcast, and the replayed request is signed
+// again with the same signature, but nobody without the secret can get anything else signed. Prefer the unix socket
+// accessible to the client user only, and expose the tcp endpoint on the private network only.
+type RemoteSigner struct {
+	network string
+	address string
+	secret  []byte
+}
+
+// NewRemoteSigner returns the remote signer connecting to the signing service listening on the endpoint.
+// The endpoint is either "unix:///path/to/socket" or "tcp://host:port". The secret is shared with the service and
+// must be at least RemoteSignerSecretMinSize bytes long.
+func NewRemoteSigner(endpoint string, secret []byte) (RemoteSigner, error) {
+	network, address, ok := strings.Cut(endpoint, "://")
+	if !ok || address == "" {
+		return RemoteSigner{}, errors.Errorf("invalid remote signer endpoint %q", endpoint)
+	}
+	if network != "unix" && network != "tcp" {
+		return RemoteSigner{}, errors.Errorf("unsupported network %q of remote signer endpoint", network)
+	}
+	if err := validateRemoteSignerSecret(secret); err != nil {
+		return RemoteSigner{}, err
+	}
+
+	return RemoteSigner{
+		network: network,
+		address: address,
+		secret:  secret,
+	}, nil
+}
+
+// PubKey returns the public key of the account.
+func (s RemoteSigner) PubKey(ctx context.Context, address sdk.AccAddress) (cryptotypes.PubKey, error) {
+	res, err := s.call(ctx, remoteSignerRequest{
+		Method:  remoteSignerMethodPubKey,
+		Address: address.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.PubKey) != secp256k1.PubKeySize {
+		return nil, errors.Errorf("invalid public key of the address %s returned by the remote signer", address)
+	}
+
+	return &secp256k1.PubKey{Key: res.PubKey}, nil
+}
+
+// Sign signs the bytes with the key of the account.
+func (s RemoteSigner) Sign(ctx context.Context, address sdk.AccAddress, signBytes []byte) ([]byte, error) {
+	res, err := s.call(ctx, remoteSignerRequest{
+		Method:    remoteSignerMethodSign,
+		Address:   address.String(),
+		SignBytes: signBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Signature) == 0 {
+		return nil, errors.Errorf("empty signature returned by the remote signer for the address %s", address)
+	}
+
+	return res.Signature, nil
+}
+
+func (s RemoteSigner) call(ctx context.Context, req remoteSignerRequest) (remoteSignerResponse, error) {
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req.MAC = req.computeMAC(s.secret)
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(requestCtx, s.network, s.address)
+	if err != nil {
+		return remoteSignerResponse{}, errors.Wrap(err, "can't connect to the remote signer")
+	}
+	defer conn.Close()
+
+	if deadline, ok := requestCtx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return remoteSignerResponse{}, errors.WithStack(err)
+		}
+	}
+
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return remoteSignerResponse{}, errors.Wrap(err, "can't send the request to the remote signer")
+	}
+
+	var res remoteSignerResponse
+	if err := json.NewDecoder(conn).Decode(&res); err != nil {
+		return remoteSignerResponse{}, errors.Wrap(err, "can't read the response of the remote signer")
+	}
+	if res.Error != "" {
+		return remoteSignerResponse{}, errors.Errorf("remote signer failed: %s", res.Error)
+	}
+
+	return res, nil
+}
+
+// ServeRemoteSigner serves the requests of the remote signers on the listener using the signer until the context is
+// canceled. It is used by the signing services to expose the keys they manage. Only the requests authenticated by
+// the secret shared with the clients are served, see RemoteSigner for the threat model.
+func ServeRemoteSigner(ctx context.Context, listener net.Listener, signer Signer, secret []byte) error {
+	if err := validateRemoteSignerSecret(secret); err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errors.WithStack(err)
+		}
+		go serveRemoteSignerConn(ctx, conn, signer, secret)
+	}
+}
+
+func serveRemoteSignerConn(ctx context.Context, conn net.Conn, signer Signer, secret []byte) {
+	defer conn.Close()
+
+	requestCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	if deadline, ok := requestCtx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return
+		}
+	}
+
+	var req remoteSignerRequest
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		return
+	}
+
+	var res remoteSignerResponse
+	var err error
+	if hmac.Equal(req.MAC, req.computeMAC(secret)) {
+		res, err = handleRemoteSignerRequest(requestCtx, signer, req)
+	} else {
+		err = errors.New("request not authenticated")
+	}
+	if err != nil {
+		res = remoteSignerResponse{Error: err.Error()}
+	}
+	//nolint:errcheck // the client detects the broken response by itself
+	json.NewEncoder(conn).Encode(res)
+}
+
+func handleRemoteSignerRequest(ctx context.Context, signer Signer, req remoteSignerRequest) (remoteSignerResponse, error) {
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return remoteSignerResponse{}, errors.Wrapf(err, "invalid address %q", req.Address)
+	}
+
+	switch req.Method {
+	case remoteSignerMethodPubKey:
+		pubKey, err := signer.PubKey(ctx, address)
+		if err != nil {
+			return remoteSignerResponse{}, err
+		}
+		return remoteSignerResponse{PubKey: pubKey.Bytes()}, nil
+	case remoteSignerMethodSign:
+		signature, err := signer.Sign(ctx, address, req.SignBytes)
+		if err != nil {
+			return remoteSignerResponse{}, err
+		}
+		return remoteSignerResponse{Signature: signature}, nil
+	default:
+		return remoteSignerResponse{}, errors.Errorf("unsupported method %q", req.Method)
+	}
+}
+
+func validateRemoteSignerSecret(secret []byte) error {
+	if len(secret) < RemoteSignerSecretMinSize {
+		return errors.Errorf("remote signer secret must be at least %d bytes long", RemoteSignerSecretMinSize)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package tx
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+)
+
+// MsgResult is the result of the message included in the transaction.
+type MsgResult struct {
+	// Msg is the executed message.
+	Msg sdk.Msg
+	// Log is the log produced by the message execution.
+	Log string
+	// Events are the events emitted by the message.
+	Events sdk.StringEvents
+	// Gas is the gas required by the message, set only if the gas of the message is deterministic.
+	// The chain doesn't report the gas used by each message, so it is taken from the deterministic gas requirements.
+	Gas uint64
+	// Deterministic tells if the gas required by the message is deterministic.
+	Deterministic bool
+}
+
+// Result is the result of the transaction with the decoded log of each message and the parsed typed events.
+type Result struct {
+	*sdk.TxResponse
+	// Msgs are the results of the messages, in the order of the messages in the transaction. They are set only if the
+	// transaction has been executed, so the log is available, which is not the case for the sync and async broadcast
+	// modes.
+	Msgs []MsgResult
+	// TypedEvents are the typed events emitted by the transaction, in the order of emission.
+	TypedEvents []proto.Message
+}
+
+// BroadcastTxTyped broadcasts the transaction the same way as BroadcastTx, and returns the result with the decoded
+// log and the parsed typed events.
+func BroadcastTxTyped(ctx context.Context, clientCtx ClientContext, txf Factory, msgs ...sdk.Msg) (*Result, error) {
+	res, err := BroadcastTx(ctx, clientCtx, txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResult(res, msgs...)
+}
+
+// NewResult builds the result of the transaction containing the messages from the transaction response.
+func NewResult(res *sdk.TxResponse, msgs ...sdk.Msg) (*Result, error) {
+	typedEvents, err := parseTypedEvents(res.Events)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't parse typed events of transaction '%s'", res.TxHash)
+	}
+
+	result := &Result{
+		TxResponse:  res,
+		TypedEvents: typedEvents,
+	}
+	if len(res.Logs) == 0 {
+		return result, nil
+	}
+	if len(res.Logs) != len(msgs) {
+		return nil, errors.Errorf("transaction '%s' has %d message logs, but %d messages", res.TxHash, len(res.Logs), len(msgs))
+	}
+
+	gasRequirements := config.DefaultDeterministicGasRequirements()
+	result.Msgs = make([]MsgResult, 0, len(msgs))
+	for _, log := range res.Logs {
+		if int(log.MsgIndex) >= len(msgs) {
+			return nil, errors.Errorf("transaction '%s' has log of message %d, but %d messages", res.TxHash, log.MsgIndex, len(msgs))
+		}
+		msg := msgs[log.MsgIndex]
+		gas, deterministic := gasRequirements.GasRequiredByMessage(msg)
+		result.Msgs = append(result.Msgs, MsgResult{
+			Msg:           msg,
+			Log:           log.Log,
+			Events:        log.Events,
+			Gas:           gas,
+			Deterministic: deterministic,
+		})
+	}
+
+	return result, nil
+}
+
+// TypedEvents returns the typed events of the type emitted by the transaction.
+func TypedEvents[T proto.Message](res *Result) []T {
+	var events []T
+	for _, event := range res.TypedEvents {
+		if typedEvent, ok := event.(T); ok {
+			events = append(events, typedEvent)
+		}
+	}
+
+	return events
+}
+
+func parseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	var typedEvents []proto.Message
+	for _, event := range events {
+		// the events not registered as proto messages are the untyped ones, e.g. emitted by the message router
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't parse event %s", event.Type)
+		}
+		typedEvents = append(typedEvents, typedEvent)
+	}
+
+	return typedEvents, nil
+}
@@ -0,0 +1,87 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestNewResult(t *testing.T) {
+	requireT := require.New(t)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issueMsg := &assetfttypes.MsgIssue{
+		Issuer:  issuer.String(),
+		Symbol:  "ABC",
+		Subunit: "abc",
+	}
+	sendMsg := &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   issuer.String(),
+	}
+
+	// the events having no custom types are used, so they are equal after the parsing
+	classFrozenEvent := &assetnfttypes.EventClassFrozen{
+		ClassID: assetnfttypes.BuildClassID(issueMsg.Symbol, issuer),
+		Owner:   issuer.String(),
+	}
+	frozenEvent := &assetfttypes.EventGlobalFreezeChanged{
+		Denom:  assetfttypes.BuildDenom(issueMsg.Subunit, issuer),
+		Frozen: true,
+	}
+	classFrozenABCIEvent, err := sdk.TypedEventToEvent(classFrozenEvent)
+	requireT.NoError(err)
+	frozenABCIEvent, err := sdk.TypedEventToEvent(frozenEvent)
+	requireT.NoError(err)
+	messageEvent := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, "issue"))
+
+	res := &sdk.TxResponse{
+		TxHash: "hash",
+		Logs: sdk.ABCIMessageLogs{
+			sdk.NewABCIMessageLog(0, "issue log", sdk.Events{messageEvent}),
+			sdk.NewABCIMessageLog(1, "send log", nil),
+		},
+		Events: []abci.Event{abci.Event(messageEvent), abci.Event(classFrozenABCIEvent), abci.Event(frozenABCIEvent)},
+	}
+
+	result, err := tx.NewResult(res, issueMsg, sendMsg)
+	requireT.NoError(err)
+
+	// the untyped events are skipped
+	requireT.Len(result.TypedEvents, 2)
+	requireT.Equal([]*assetnfttypes.EventClassFrozen{classFrozenEvent}, tx.TypedEvents[*assetnfttypes.EventClassFrozen](result))
+	requireT.Equal([]*assetfttypes.EventGlobalFreezeChanged{frozenEvent}, tx.TypedEvents[*assetfttypes.EventGlobalFreezeChanged](result))
+	requireT.Empty(tx.TypedEvents[*assetfttypes.EventFrozenAmountChanged](result))
+
+	// the log and the deterministic gas of each message are set
+	gasRequirements := config.DefaultDeterministicGasRequirements()
+	requireT.Len(result.Msgs, 2)
+	requireT.Equal(issueMsg, result.Msgs[0].Msg)
+	requireT.Equal("issue log", result.Msgs[0].Log)
+	requireT.Equal(sdk.StringifyEvents([]abci.Event{abci.Event(messageEvent)}), result.Msgs[0].Events)
+	requireT.True(result.Msgs[0].Deterministic)
+	requireT.Equal(gasRequirements.AssetFTIssue, result.Msgs[0].Gas)
+	requireT.Equal(sendMsg, result.Msgs[1].Msg)
+	requireT.Equal("send log", result.Msgs[1].Log)
+	requireT.True(result.Msgs[1].Deterministic)
+
+	// the messages can't be matched to the logs if their number differs
+	_, err = tx.NewResult(res, issueMsg)
+	requireT.Error(err)
+
+	// the messages aren't set if the transaction hasn't been executed yet
+	res.Logs = nil
+	result, err = tx.NewResult(res, issueMsg, sendMsg)
+	requireT.NoError(err)
+	requireT.Empty(result.Msgs)
+	requireT.Len(result.TypedEvents, 2)
+}
@@ -0,0 +1,96 @@
+package tx
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/pkg/errors"
+)
+
+// Signer produces the signatures on behalf of the accounts whose keys are not stored in the keyring,
+// e.g. the keys kept in the HSM behind the external signing service.
+type Signer interface {
+	// PubKey returns the public key of the account.
+	PubKey(ctx context.Context, address sdk.AccAddress) (cryptotypes.PubKey, error)
+	// Sign signs the bytes with the key of the account.
+	Sign(ctx context.Context, address sdk.AccAddress, signBytes []byte) ([]byte, error)
+}
+
+// SignTx signs the transaction on behalf of the address using the signer set in the client context.
+// The existing signatures are overwritten. Only the single signer transactions are supported.
+// NOTE: copied from the link below and made some changes.
+// the main idea is to produce the signature by the Signer instead of the keyring
+// https://github.com/cosmos/cosmos-sdk/blob/v0.45.11/client/tx/tx.go
+func SignTx(
+	ctx context.Context,
+	clientCtx ClientContext,
+	txf Factory,
+	address sdk.AccAddress,
+	txBuilder client.TxBuilder,
+) error {
+	signer := clientCtx.Signer()
+	if signer == nil {
+		return errors.New("signer must be set prior to signing a transaction")
+	}
+	if len(txBuilder.GetTx().GetSigners()) > 1 {
+		return errors.New("signing the transaction with multiple signers is not supported")
+	}
+
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		// use the SignModeHandler's default mode if unspecified
+		signMode = clientCtx.TxConfig().SignModeHandler().DefaultMode()
+	}
+
+	pubKey, err := signer.PubKey(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !sdk.AccAddress(pubKey.Address()).Equals(address) {
+		return errors.Errorf("public key returned by the signer doesn't belong to the address %s", address)
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+	}
+
+	// The signer infos must be set before the sign bytes are generated, that's why the signature is set
+	// with the empty value first.
+	sigData := signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: nil,
+	}
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &sigData,
+		Sequence: txf.Sequence(),
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	bytesToSign, err := clientCtx.TxConfig().SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	sigBytes, err := signer.Sign(ctx, address, bytesToSign)
+	if err != nil {
+		return err
+	}
+
+	sigData = signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: sigBytes,
+	}
+	return errors.WithStack(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &sigData,
+		Sequence: txf.Sequence(),
+	}))
+}
@@ -0,0 +1,71 @@
+package tx
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+var _ Signer = &MockSigner{}
+
+// MockSigner is the Signer keeping the private keys in memory. It is used to mock the external signing service
+// in tests and must not be used in production.
+type MockSigner struct {
+	mu   sync.RWMutex
+	keys map[string]*secp256k1.PrivKey
+}
+
+// NewMockSigner returns the mock signer holding the keys.
+func NewMockSigner(keys ...*secp256k1.PrivKey) *MockSigner {
+	s := &MockSigner{
+		keys: map[string]*secp256k1.PrivKey{},
+	}
+	for _, key := range keys {
+		s.AddKey(key)
+	}
+	return s
+}
+
+// AddKey adds the key to the signer.
+func (s *MockSigner) AddKey(key *secp256k1.PrivKey) sdk.AccAddress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	address := sdk.AccAddress(key.PubKey().Address())
+	s.keys[address.String()] = key
+	return address
+}
+
+// PubKey returns the public key of the account.
+func (s *MockSigner) PubKey(_ context.Context, address sdk.AccAddress) (cryptotypes.PubKey, error) {
+	key, err := s.key(address)
+	if err != nil {
+		return nil, err
+	}
+	return key.PubKey(), nil
+}
+
+// Sign signs the bytes with the key of the account.
+func (s *MockSigner) Sign(_ context.Context, address sdk.AccAddress, signBytes []byte) ([]byte, error) {
+	key, err := s.key(address)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := key.Sign(signBytes)
+	return signature, errors.WithStack(err)
+}
+
+func (s *MockSigner) key(address sdk.AccAddress) (*secp256k1.PrivKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[address.String()]
+	if !ok {
+		return nil, errors.Errorf("key of the address %s not found", address)
+	}
+	return key, nil
+}
@@ -0,0 +1,124 @@
+package tx_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/tx"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	requireT := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	key := secp256k1.GenPrivKey()
+	mockSigner := tx.NewMockSigner(key)
+	address := sdk.AccAddress(key.PubKey().Address())
+
+	secret := bytes.Repeat([]byte{0x01}, tx.RemoteSignerSecretMinSize)
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", socket)
+	requireT.NoError(err)
+	go func() {
+		_ = tx.ServeRemoteSigner(ctx, listener, mockSigner, secret)
+	}()
+
+	remoteSigner, err := tx.NewRemoteSigner("unix://"+socket, secret)
+	requireT.NoError(err)
+
+	pubKey, err := remoteSigner.PubKey(ctx, address)
+	requireT.NoError(err)
+	requireT.True(key.PubKey().Equals(pubKey))
+
+	signBytes := []byte("bytes to sign")
+	signature, err := remoteSigner.Sign(ctx, address, signBytes)
+	requireT.NoError(err)
+	requireT.True(pubKey.VerifySignature(signBytes, signature))
+
+	// the key of the address is not managed by the signer
+	_, err = remoteSigner.Sign(ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), signBytes)
+	requireT.ErrorContains(err, "not found")
+
+	// the requests of the clients not knowing the secret are rejected
+	otherSecret := bytes.Repeat([]byte{0x02}, tx.RemoteSignerSecretMinSize)
+	unauthenticatedSigner, err := tx.NewRemoteSigner("unix://"+socket, otherSecret)
+	requireT.NoError(err)
+	_, err = unauthenticatedSigner.Sign(ctx, address, signBytes)
+	requireT.ErrorContains(err, "request not authenticated")
+	_, err = unauthenticatedSigner.PubKey(ctx, address)
+	requireT.ErrorContains(err, "request not authenticated")
+
+	_, err = tx.NewRemoteSigner(socket, secret)
+	requireT.Error(err)
+	_, err = tx.NewRemoteSigner("udp://localhost:26659", secret)
+	requireT.Error(err)
+	_, err = tx.NewRemoteSigner("unix://"+socket, secret[1:])
+	requireT.Error(err)
+	requireT.Error(tx.ServeRemoteSigner(ctx, listener, mockSigner, nil))
+}
+
+func TestSignTx(t *testing.T) {
+	requireT := require.New(t)
+
+	key := secp256k1.GenPrivKey()
+	mockSigner := tx.NewMockSigner()
+	address := mockSigner.AddKey(key)
+
+	clientCtx := tx.NewClientContext(module.NewBasicManager(bank.AppModuleBasic{})).
+		WithChainID("test-chain").
+		WithSigner(mockSigner)
+	txf := tx.Factory{}.
+		WithTxConfig(clientCtx.TxConfig()).
+		WithChainID(clientCtx.ChainID()).
+		WithAccountNumber(3).
+		WithSequence(5).
+		WithGas(100000)
+
+	txBuilder, err := txf.BuildUnsignedTx(&banktypes.MsgSend{
+		FromAddress: address.String(),
+		ToAddress:   sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("ucore", 10)),
+	})
+	requireT.NoError(err)
+	requireT.NoError(tx.SignTx(context.Background(), clientCtx, txf, address, txBuilder))
+
+	signatures, err := txBuilder.GetTx().GetSignaturesV2()
+	requireT.NoError(err)
+	requireT.Len(signatures, 1)
+	requireT.True(key.PubKey().Equals(signatures[0].PubKey))
+	requireT.EqualValues(5, signatures[0].Sequence)
+
+	requireT.NoError(authsigning.VerifySignature(
+		signatures[0].PubKey,
+		authsigning.SignerData{
+			ChainID:       "test-chain",
+			AccountNumber: 3,
+			Sequence:      5,
+		},
+		signatures[0].Data,
+		clientCtx.TxConfig().SignModeHandler(),
+		txBuilder.GetTx(),
+	))
+	requireT.Equal(signing.SignMode_SIGN_MODE_DIRECT, signatures[0].Data.(*signing.SingleSignatureData).SignMode)
+
+	// the signer doesn't manage the key of the address
+	otherAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.Error(tx.SignTx(context.Background(), clientCtx, txf, otherAddress, txBuilder))
+
+	// the signer is not set
+	requireT.Error(tx.SignTx(context.Background(), clientCtx.WithSigner(nil), txf, address, txBuilder))
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -79,22 +80,66 @@ func BroadcastTx(ctx context.Context, clientCtx ClientContext, txf Factory, msgs
 
 	unsignedTx.SetFeeGranter(clientCtx.FeeGranterAddress())
 
+	if err := signTx(ctx, clientCtx, txf, unsignedTx); err != nil {
+		return nil, err
+	}
+
+	return broadcastSignedTx(ctx, clientCtx, unsignedTx)
+}
+
+// BuildSignedTx generates and signs the transaction with the given set of messages without broadcasting it and
+// returns the encoded transaction, which might be broadcast later by BroadcastRawTx. The gas must be set in the factory,
+// and the account number and the sequence must be set in the factory or supplied by the account info provider of
+// the client context, so the transaction might be built and signed with no network access.
+func BuildSignedTx(ctx context.Context, clientCtx ClientContext, txf Factory, msgs ...sdk.Msg) ([]byte, error) {
+	if txf.SimulateAndExecute() || txf.Gas() == 0 {
+		return nil, errors.New("gas must be set to build the transaction without simulating it")
+	}
+
+	txf, err := prepareFactory(ctx, clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTx, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTx.SetFeeGranter(clientCtx.FeeGranterAddress())
+
+	if err := signTx(ctx, clientCtx, txf, unsignedTx); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig().TxEncoder()(unsignedTx.GetTx())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return txBytes, nil
+}
+
+// signTx signs the transaction by the signer set in the client context or by the key of the sender from the keyring.
+func signTx(ctx context.Context, clientCtx ClientContext, txf Factory, unsignedTx client.TxBuilder) error {
+	if clientCtx.Signer() != nil {
+		return SignTx(ctx, clientCtx, txf, clientCtx.FromAddress(), unsignedTx)
+	}
+
 	// in case the name is not provided by that address, take the name by the address
 	fromName := clientCtx.FromName()
 	if fromName == "" && len(clientCtx.FromAddress()) > 0 {
 		key, err := clientCtx.Keyring().KeyByAddress(clientCtx.FromAddress())
 		if err != nil {
-			return nil, errors.Errorf("failed to get key by the address %q from the keyring", clientCtx.FromAddress().String())
+			return errors.Errorf("failed to get key by the address %q from the keyring", clientCtx.FromAddress().String())
 		}
 		fromName = key.GetName()
 	}
 
-	err = tx.Sign(txf, fromName, unsignedTx, true)
-	if err != nil {
-		return nil, err
-	}
+	return tx.Sign(txf, fromName, unsignedTx, true)
+}
 
-	txBytes, err := clientCtx.TxConfig().TxEncoder()(unsignedTx.GetTx())
+func broadcastSignedTx(ctx context.Context, clientCtx ClientContext, signedTx client.TxBuilder) (*sdk.TxResponse, error) {
+	txBytes, err := clientCtx.TxConfig().TxEncoder()(signedTx.GetTx())
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +232,16 @@ func broadcastTxCommit(ctx context.Context, clientCtx ClientContext, encodedTx [
 
 func prepareFactory(ctx context.Context, clientCtx ClientContext, txf tx.Factory) (tx.Factory, error) {
 	if txf.AccountNumber() == 0 && txf.Sequence() == 0 {
+		if provider := clientCtx.AccountInfoProvider(); provider != nil {
+			info, err := provider.AccountInfo(ctx, clientCtx.FromAddress())
+			if err != nil {
+				return txf, err
+			}
+			return txf.
+				WithAccountNumber(info.AccountNumber).
+				WithSequence(info.Sequence), nil
+		}
+
 		acc, err := GetAccountInfo(ctx, clientCtx, clientCtx.FromAddress())
 		if err != nil {
 			return txf, err
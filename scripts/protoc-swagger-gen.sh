@@ -0,0 +1,72 @@
+#!/usr/bin/env bash
+# Generates the OpenAPI spec served by the node (docs/static/openapi.yml) from the query services of the coreum
+# modules and the modules of the dependencies used by the chain. Requires protoc and protoc-gen-swagger of the
+# grpc-gateway version used by the chain:
+#
+#   go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger@v1.16.0
+
+set -euo pipefail
+
+ROOT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+cd "${ROOT_DIR}"
+
+module_dir() {
+  go list -m -f '{{ if .Replace }}{{ .Replace.Dir }}{{ else }}{{ .Dir }}{{ end }}' "$1"
+}
+
+SDK_DIR="$(module_dir github.com/cosmos/cosmos-sdk)"
+WASMD_DIR="$(module_dir github.com/CosmWasm/wasmd)"
+IBC_DIR="$(module_dir github.com/cosmos/ibc-go/v4)"
+
+SWAGGER_DIR="$(mktemp -d)"
+trap 'rm -rf "${SWAGGER_DIR}"' EXIT
+
+# generate runs protoc-gen-swagger for the proto file found in the include dir.
+generate() {
+  local include_dir="$1" file="$2"
+  protoc \
+    -I "${ROOT_DIR}/proto" \
+    -I "${SDK_DIR}/proto" \
+    -I "${SDK_DIR}/third_party/proto" \
+    -I "${WASMD_DIR}/proto" \
+    -I "${IBC_DIR}/proto" \
+    -I "${IBC_DIR}/third_party/proto" \
+    --swagger_out=fqn_for_swagger_name=true,simple_operation_ids=true:"${SWAGGER_DIR}" \
+    "${include_dir}/${file}"
+}
+
+# the coreum modules exposing the REST routes
+for file in $(cd proto && grep -rl "google.api.http" . | sed 's#^\./##' | sort); do
+  generate "${ROOT_DIR}/proto" "${file}"
+done
+
+# the modules of the dependencies registered in the app
+for file in \
+  cosmos/auth/v1beta1/query.proto \
+  cosmos/authz/v1beta1/query.proto \
+  cosmos/bank/v1beta1/query.proto \
+  cosmos/base/tendermint/v1beta1/query.proto \
+  cosmos/distribution/v1beta1/query.proto \
+  cosmos/evidence/v1beta1/query.proto \
+  cosmos/feegrant/v1beta1/query.proto \
+  cosmos/gov/v1beta1/query.proto \
+  cosmos/mint/v1beta1/query.proto \
+  cosmos/params/v1beta1/query.proto \
+  cosmos/slashing/v1beta1/query.proto \
+  cosmos/staking/v1beta1/query.proto \
+  cosmos/tx/v1beta1/service.proto \
+  cosmos/upgrade/v1beta1/query.proto; do
+  generate "${SDK_DIR}/proto" "${file}"
+done
+generate "${WASMD_DIR}/proto" cosmwasm/wasm/v1/query.proto
+for file in \
+  ibc/applications/interchain_accounts/controller/v1/query.proto \
+  ibc/applications/interchain_accounts/host/v1/query.proto \
+  ibc/applications/transfer/v1/query.proto \
+  ibc/core/channel/v1/query.proto \
+  ibc/core/client/v1/query.proto \
+  ibc/core/connection/v1/query.proto; do
+  generate "${IBC_DIR}/proto" "${file}"
+done
+
+go run ./cmd/openapi-combine --swagger-dir "${SWAGGER_DIR}" --out docs/static/openapi.yml
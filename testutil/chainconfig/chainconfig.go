@@ -0,0 +1,136 @@
+// Package chainconfig provides the configuration of the chain the tests run against. The same test suites can be
+// executed against the dev, test and main networks as well as the custom forks of the chain by overriding the chain
+// ID, the denom and the address prefix using the environment variables.
+package chainconfig
+
+import (
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+)
+
+// Environment variables overriding the chain config.
+const (
+	EnvChainID       = "COREUM_CHAIN_ID"
+	EnvDenom         = "COREUM_DENOM"
+	EnvDisplayDenom  = "COREUM_DISPLAY_DENOM"
+	EnvAddressPrefix = "COREUM_ADDRESS_PREFIX"
+)
+
+// ChainIDTest is the chain ID of the test network.
+const ChainIDTest constant.ChainID = "coreum-testnet-1"
+
+// Config is the config of the chain the tests run against.
+type Config struct {
+	ChainID       constant.ChainID
+	Denom         string
+	DisplayDenom  string
+	AddressPrefix string
+}
+
+var presets = map[constant.ChainID]Config{
+	constant.ChainIDMain: {
+		ChainID:       constant.ChainIDMain,
+		Denom:         constant.DenomMain,
+		DisplayDenom:  constant.DenomMainDisplay,
+		AddressPrefix: constant.AddressPrefixMain,
+	},
+	ChainIDTest: {
+		ChainID:       ChainIDTest,
+		Denom:         constant.DenomTest,
+		DisplayDenom:  constant.DenomTestDisplay,
+		AddressPrefix: "testcore",
+	},
+	constant.ChainIDDev: {
+		ChainID:       constant.ChainIDDev,
+		Denom:         constant.DenomDev,
+		DisplayDenom:  constant.DenomDevDisplay,
+		AddressPrefix: constant.AddressPrefixDev,
+	},
+}
+
+// Default returns the config of the dev network.
+func Default() Config {
+	return presets[constant.ChainIDDev]
+}
+
+// ByChainID returns the config of the predefined network. The config of the dev network is returned for the unknown
+// chain ID, with the chain ID replaced, so the forks of the chain start from the dev settings.
+func ByChainID(chainID constant.ChainID) Config {
+	if cfg, ok := presets[chainID]; ok {
+		return cfg
+	}
+	cfg := Default()
+	cfg.ChainID = chainID
+	return cfg
+}
+
+// FromEnv returns the config built from the environment variables. The chain ID selects the predefined network, then
+// the denom, the display denom and the address prefix, if set, override its settings.
+func FromEnv() (Config, error) {
+	cfg := Default()
+	if chainID := os.Getenv(EnvChainID); chainID != "" {
+		cfg = ByChainID(constant.ChainID(chainID))
+	}
+	if denom := os.Getenv(EnvDenom); denom != "" {
+		cfg.Denom = denom
+	}
+	if displayDenom := os.Getenv(EnvDisplayDenom); displayDenom != "" {
+		cfg.DisplayDenom = displayDenom
+	}
+	if addressPrefix := os.Getenv(EnvAddressPrefix); addressPrefix != "" {
+		cfg.AddressPrefix = addressPrefix
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Validate checks that the config is valid.
+func (c Config) Validate() error {
+	if c.ChainID == "" {
+		return errors.New("chain ID must not be empty")
+	}
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return errors.Wrapf(err, "invalid denom %q", c.Denom)
+	}
+	if err := sdk.ValidateDenom(c.DisplayDenom); err != nil {
+		return errors.Wrapf(err, "invalid display denom %q", c.DisplayDenom)
+	}
+	if c.Denom == c.DisplayDenom {
+		return errors.Errorf("denom and display denom must be different, got %q", c.Denom)
+	}
+	if c.AddressPrefix == "" {
+		return errors.New("address prefix must not be empty")
+	}
+	return nil
+}
+
+// NetworkConfig returns the network config of the chain. The settings of the predefined network are used for the
+// known chain ID and the ones of the dev network otherwise, with the chain ID, the denoms and the address prefix taken
+// from the config.
+func (c Config) NetworkConfig() (config.NetworkConfig, error) {
+	if err := c.Validate(); err != nil {
+		return config.NetworkConfig{}, err
+	}
+
+	networkConfig, err := config.NetworkConfigByChainID(c.ChainID)
+	if err != nil {
+		if networkConfig, err = config.NetworkConfigByChainID(constant.ChainIDDev); err != nil {
+			return config.NetworkConfig{}, err
+		}
+	}
+
+	networkConfig.ChainID = c.ChainID
+	networkConfig.Denom = c.Denom
+	networkConfig.MetadataDisplayDenom = c.DisplayDenom
+	networkConfig.AddressPrefix = c.AddressPrefix
+
+	return networkConfig, nil
+}
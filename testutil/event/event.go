@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	tmtypes "github.com/tendermint/tendermint/abci/types"
@@ -64,6 +65,28 @@ func FindStringEventAttribute(events []tmtypes.Event, etype, attribute string) (
 	return "", errors.Errorf("can't find the types %q event attribute %q of ", etype, attribute)
 }
 
+// FindBurntCoins sums the coins burnt by the bank module in the events, e.g. the amount burnt because of the burn rate.
+func FindBurntCoins(events []tmtypes.Event) (sdk.Coins, error) {
+	burnt := sdk.NewCoins()
+	for _, ev := range events {
+		if ev.Type != banktypes.EventTypeCoinBurn {
+			continue
+		}
+		for _, attr := range ev.Attributes {
+			if string(attr.Key) != sdk.AttributeKeyAmount {
+				continue
+			}
+			coins, err := sdk.ParseCoinsNormalized(string(attr.Value))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %q event attribute %q as coins", ev.Type, sdk.AttributeKeyAmount)
+			}
+			burnt = burnt.Add(coins...)
+		}
+	}
+
+	return burnt, nil
+}
+
 func findAttribute(ev sdk.StringEvent, attr string) (string, bool) {
 	for _, attrItem := range ev.Attributes {
 		if attrItem.Key == attr {
@@ -0,0 +1,82 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// txFee is the fee paid by the transactions broadcast by the CLI helpers, it covers the gas limit set by default.
+var txFee = sdk.NewInt(1000000)
+
+// NewFundedAccount creates the account in the keyring of the first validator and funds it with the amount of the bond
+// denom sent by the validator. The account might be used to sign the transactions using the name or the address passed
+// to the --from flag.
+func NewFundedAccount(testNetwork *Network, name string, amount sdk.Int) (sdk.AccAddress, error) {
+	validator := testNetwork.Validators[0]
+	info, _, err := validator.ClientCtx.Keyring.NewMnemonic(
+		name,
+		keyring.English,
+		sdk.FullFundraiserPath,
+		keyring.DefaultBIP39Passphrase,
+		hd.Secp256k1,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't create account %q", name)
+	}
+
+	if _, err := ExecTxCmd(validator.ClientCtx, testNetwork, bankcli.NewSendTxCmd(), validator.Address, []string{
+		validator.Address.String(),
+		info.GetAddress().String(),
+		sdk.NewCoin(testNetwork.Config.BondDenom, amount).String(),
+	}); err != nil {
+		return nil, errors.Wrapf(err, "can't fund account %q", name)
+	}
+
+	return info.GetAddress(), nil
+}
+
+// TxArgs returns the flags required to sign the transaction by the account from the keyring and broadcast it in the
+// block mode.
+func TxArgs(testNetwork *Network, from sdk.AccAddress) []string {
+	return []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(testNetwork.Config.BondDenom, txFee)).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	}
+}
+
+// ExecTxCmd executes the tx command signed by the account from the keyring and returns the response of the included
+// transaction. The error is returned if the transaction fails.
+func ExecTxCmd(
+	clientCtx client.Context,
+	testNetwork *Network,
+	cmd *cobra.Command,
+	from sdk.AccAddress,
+	args []string,
+) (sdk.TxResponse, error) {
+	args = append(append([]string{}, args...), TxArgs(testNetwork, from)...)
+	buf, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	var res sdk.TxResponse
+	if err := clientCtx.Codec.UnmarshalJSON(buf.Bytes(), &res); err != nil {
+		return sdk.TxResponse{}, errors.Wrapf(err, "can't decode tx response: %s", buf.String())
+	}
+	if res.Code != 0 {
+		return res, errors.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return res, nil
+}
@@ -23,7 +23,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/app"
 	"github.com/CoreumFoundation/coreum/pkg/config"
-	"github.com/CoreumFoundation/coreum/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/testutil/chainconfig"
 )
 
 type (
@@ -98,23 +98,27 @@ func New(t *testing.T, configs ...network.Config) *network.Network {
 // DefaultConfig will initialize config for the network with custom application,
 // genesis and single validator. All other parameters are inherited from cosmos-sdk/testutil/network.DefaultConfig
 func DefaultConfig() network.Config {
-	devCfg, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	chainCfg, err := chainconfig.FromEnv()
+	if err != nil {
+		panic(errors.Wrap(err, "can't get chain config"))
+	}
+	networkCfg, err := chainCfg.NetworkConfig()
 	if err != nil {
 		panic(errors.Wrap(err, "can't get network config"))
 	}
-	// set to nil the devnet config we don't need
-	devCfg.FundedAccounts = nil
-	devCfg.GenTxs = nil
-	devCfg.CustomParamsConfig.Staking.MinSelfDelegation = sdk.NewInt(1)
+	// set to nil the network config we don't need
+	networkCfg.FundedAccounts = nil
+	networkCfg.GenTxs = nil
+	networkCfg.CustomParamsConfig.Staking.MinSelfDelegation = sdk.NewInt(1)
 
 	// init the network and set params
-	devNetwork := config.NewNetwork(devCfg)
-	app.ChosenNetwork = devNetwork
+	chainNetwork := config.NewNetwork(networkCfg)
+	app.ChosenNetwork = chainNetwork
 	// set and seal once
 	setNetworkConfigOnce.Do(func() {
-		devNetwork.SetSDKConfig()
+		chainNetwork.SetSDKConfig()
 	})
-	genesisDoc, err := devNetwork.GenesisDoc()
+	genesisDoc, err := chainNetwork.GenesisDoc()
 	if err != nil {
 		panic(errors.Wrap(err, "can't get network genesis doc"))
 	}
@@ -145,8 +149,8 @@ func DefaultConfig() network.Config {
 		TimeoutCommit:   2 * time.Second,
 		ChainID:         "chain-" + tmrand.NewRand().Str(6),
 		NumValidators:   1,
-		BondDenom:       devCfg.Denom,
-		MinGasPrices:    fmt.Sprintf("0.000006%s", devCfg.Denom),
+		BondDenom:       networkCfg.Denom,
+		MinGasPrices:    fmt.Sprintf("0.000006%s", networkCfg.Denom),
 		AccountTokens:   sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction),
 		StakingTokens:   sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction),
 		BondedTokens:    sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
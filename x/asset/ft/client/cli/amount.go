@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// amountRegex matches the decimal amount followed by the denom, the denom starts with the letter, so the split is
+// unambiguous.
+var amountRegex = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)([a-zA-Z][a-zA-Z0-9/:._-]{2,127})$`)
+
+// denomUnit is the unit of the denom resolved from the denom metadata.
+type denomUnit struct {
+	found    bool
+	base     string
+	exponent uint32
+}
+
+// AmountParser parses the amounts provided either in the base denom, e.g. `1500000uwbtc-devcore1...`, or in the
+// display unit registered in the denom metadata, e.g. `1.5WBTC-devcore1...`. The display unit of the fungible token is
+// its symbol suffixed with the issuer address. The resolved units are cached, so each denom is queried once.
+type AmountParser struct {
+	clientCtx client.Context
+	units     map[string]denomUnit
+}
+
+// NewAmountParser returns the new instance of the amount parser. If the client context is offline, the denom metadata
+// can't be queried, so the amounts are accepted in the base denom only.
+func NewAmountParser(clientCtx client.Context) *AmountParser {
+	return &AmountParser{
+		clientCtx: clientCtx,
+		units:     map[string]denomUnit{},
+	}
+}
+
+// Parse parses the amount and converts it to the coin of the base denom. The amount in the display unit having more
+// decimal places than the precision of the unit is rejected instead of being truncated.
+func (p *AmountParser) Parse(ctx context.Context, amount string) (sdk.Coin, error) {
+	matches := amountRegex.FindStringSubmatch(strings.TrimSpace(amount))
+	if matches == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount %q", amount)
+	}
+	value, denom := matches[1], matches[2]
+
+	unit, err := p.resolveUnit(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !unit.found {
+		// the denom without metadata, e.g. the IBC voucher, is accepted as the base denom
+		if strings.Contains(value, ".") {
+			return sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"precision of %s is unknown, provide the integer amount in the base denom",
+				denom,
+			)
+		}
+		unit.base = denom
+	}
+
+	baseAmount, err := types.FromMainUnit(value, unit.exponent)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(err, "invalid amount of %s", denom)
+	}
+	coin := sdk.Coin{Denom: unit.base, Amount: baseAmount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount %q: %s", amount, err)
+	}
+
+	return coin, nil
+}
+
+func (p *AmountParser) resolveUnit(ctx context.Context, denom string) (denomUnit, error) {
+	if unit, ok := p.units[denom]; ok {
+		return unit, nil
+	}
+	if p.clientCtx.Offline {
+		return denomUnit{}, nil
+	}
+
+	bankClient := banktypes.NewQueryClient(p.clientCtx)
+	var unit denomUnit
+	_, err := bankClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+	switch {
+	case err == nil:
+		unit = denomUnit{found: true, base: denom}
+	case status.Code(err) == codes.NotFound:
+		unit, err = findDisplayUnit(ctx, bankClient, denom)
+		if err != nil {
+			return denomUnit{}, err
+		}
+	default:
+		return denomUnit{}, errors.Wrapf(err, "failed to query metadata of %s", denom)
+	}
+
+	p.units[denom] = unit
+	return unit, nil
+}
+
+func findDisplayUnit(ctx context.Context, bankClient banktypes.QueryClient, denom string) (denomUnit, error) {
+	var pageKey []byte
+	for {
+		res, err := bankClient.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{
+			Pagination: &query.PageRequest{Key: pageKey},
+		})
+		if err != nil {
+			return denomUnit{}, errors.Wrap(err, "failed to query denom metadata")
+		}
+		for _, metadata := range res.Metadatas {
+			if exponent, ok := displayUnitExponent(metadata, denom); ok {
+				return denomUnit{found: true, base: metadata.Base, exponent: exponent}, nil
+			}
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return denomUnit{}, nil
+		}
+		pageKey = res.Pagination.NextKey
+	}
+}
+
+// displayUnitExponent returns the exponent of the unit of the metadata matching the denom. The symbols of the fungible
+// tokens are unique per issuer only, so the denom having the issuer suffix is matched against the units of the tokens
+// of that issuer, ignoring the case the same way the symbol uniqueness does.
+func displayUnitExponent(metadata banktypes.Metadata, denom string) (uint32, bool) {
+	matches := func(unit string) bool { return unit == denom }
+	if prefix, issuer, err := types.DeconstructDenom(denom); err == nil {
+		if !strings.HasSuffix(metadata.Base, "-"+issuer.String()) {
+			return 0, false
+		}
+		matches = func(unit string) bool { return strings.EqualFold(unit, prefix) }
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if matches(unit.Denom) {
+			return unit.Exponent, true
+		}
+		for _, alias := range unit.Aliases {
+			if matches(alias) {
+				return unit.Exponent, true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,37 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/client/cli"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestAmountParser_Offline(t *testing.T) {
+	requireT := require.New(t)
+
+	parser := cli.NewAmountParser(client.Context{}.WithOffline(true))
+	ctx := context.Background()
+
+	coin, err := parser.Parse(ctx, "100ucore")
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("ucore", 100), coin)
+
+	coin, err = parser.Parse(ctx, " 007ucore ")
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("ucore", 7), coin)
+
+	// the precision of the display unit can't be resolved offline
+	_, err = parser.Parse(ctx, "1.5core")
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	for _, amount := range []string{"", "ucore", "100", "-1ucore", "1.ucore", "1,5ucore", "100 ucore"} {
+		_, err := parser.Parse(ctx, amount)
+		requireT.ErrorIs(err, types.ErrInvalidInput, amount)
+	}
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/pkg/assetlist"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+const (
+	chainNameFlag    = "chain-name"
+	defaultChainName = "coreum"
+)
+
+// CmdQueryAssetList return the cobra command generating the asset list of the chain registry.
+func CmdQueryAssetList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-list",
+		Args:  cobra.NoArgs,
+		Short: "Generate the assetlist.json of the chain registry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate the assetlist.json of the cosmos chain registry from the bank metadata of the denoms and the definitions of the fungible tokens.
+
+Example:
+$ %[1]s query asset-ft asset-list --%[2]s %[3]s > assetlist.json
+`,
+				version.AppName, chainNameFlag, defaultChainName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			chainName, err := cmd.Flags().GetString(chainNameFlag)
+			if err != nil {
+				return err
+			}
+
+			metadatas, err := queryAllDenomsMetadata(cmd.Context(), banktypes.NewQueryClient(clientCtx))
+			if err != nil {
+				return err
+			}
+			tokens, err := queryAllTokens(cmd.Context(), types.NewQueryClient(clientCtx))
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(assetlist.Build(chainName, metadatas, tokens), "", "  ")
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal the asset list")
+			}
+
+			return clientCtx.PrintBytes(append(out, '\n'))
+		},
+	}
+
+	cmd.Flags().String(chainNameFlag, defaultChainName, "Name of the chain in the chain registry")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func queryAllDenomsMetadata(ctx context.Context, bankClient banktypes.QueryClient) ([]banktypes.Metadata, error) {
+	var (
+		metadatas []banktypes.Metadata
+		pageKey   []byte
+	)
+	for {
+		res, err := bankClient.DenomsMetadata(ctx, &banktypes.QueryDenomsMetadataRequest{
+			Pagination: &query.PageRequest{Key: pageKey},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query denom metadata")
+		}
+		metadatas = append(metadatas, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return metadatas, nil
+		}
+		pageKey = res.Pagination.NextKey
+	}
+}
+
+func queryAllTokens(ctx context.Context, queryClient types.QueryClient) ([]types.FT, error) {
+	var (
+		tokens  []types.FT
+		pageKey []byte
+	)
+	for {
+		res, err := queryClient.Tokens(ctx, &types.QueryTokensRequest{
+			Pagination: &query.PageRequest{Key: pageKey},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query fungible tokens")
+		}
+		tokens = append(tokens, res.Tokens...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return tokens, nil
+		}
+		pageKey = res.Pagination.NextKey
+	}
+}
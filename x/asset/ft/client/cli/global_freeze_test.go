@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"testing"
+	"time"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/google/uuid"
@@ -59,4 +60,21 @@ func TestGloballyFreezeUnfreeze(t *testing.T) {
 	requireT.NoError(err)
 	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
 	requireT.False(resp.Token.GloballyFrozen)
+
+	// Schedule the global freeze of the token
+	activationTime := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	args = append([]string{denom, "--activation-time", activationTime.Format(time.RFC3339), "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxGloballyFreeze(), args)
+	requireT.NoError(err)
+
+	var pendingResp types.QueryPendingGlobalFreezesResponse
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryPendingGlobalFreezes(), []string{"--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &pendingResp))
+	requireT.Equal([]types.PendingGlobalFreeze{{Denom: denom, ActivationTime: activationTime}}, pendingResp.PendingGlobalFreezes)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryTokenInfo(), []string{denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.False(resp.Token.GloballyFrozen)
 }
@@ -70,4 +70,21 @@ func TestMintBurn(t *testing.T) {
 	requireT.NoError(err)
 	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &supplyRsp))
 	requireT.Equal("677"+denom, supplyRsp.String())
+
+	// mint tokens using the amount in the display unit
+	token = "0.000001" + types.BuildDenom(symbol, issuer)
+	args = append([]string{token, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMint(), args)
+	requireT.NoError(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, bankcli.GetBalancesCmd(), []string{issuer.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &balanceRsp))
+	requireT.Equal("777", balanceRsp.Balances.AmountOf(denom).String())
+
+	// the amount having more decimal places than the precision is rejected
+	token = "0.000000001" + types.BuildDenom(symbol, issuer)
+	args = append([]string{token, "--output", "json"}, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMint(), args)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
 }
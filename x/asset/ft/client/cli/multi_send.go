@@ -0,0 +1,496 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	customparamstypes "github.com/CoreumFoundation/coreum/x/customparams/types"
+	feemodeltypes "github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// Flags defined on the multi-send transaction
+const (
+	csvFlag       = "csv"
+	denomFlag     = "denom"
+	msgsPerTxFlag = "msgs-per-tx"
+)
+
+// progressFileSuffix is appended to the path of the CSV file to get the path of the file storing the multi-send progress.
+const progressFileSuffix = ".progress"
+
+// numberRegex matches the amount given without the denom.
+var numberRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// secp256k1SignatureSize is the size of the signature added to the tx bytes when the tx is signed.
+const secp256k1SignatureSize = 64
+
+// defaultGasPriceMultiplier is applied to the minimum gas price if neither the fees nor the gas prices are set, so the
+// transactions stay valid if the minimum gas price grows while they are broadcast.
+var defaultGasPriceMultiplier = sdk.MustNewDecFromStr("1.2")
+
+// Payout is the transfer read from the row of the payouts CSV file.
+type Payout struct {
+	Row       int
+	Recipient sdk.AccAddress
+	Amount    sdk.Coin
+}
+
+// MultiSendProgress is the progress of the multi-send stored next to the CSV file. It lets the interrupted multi-send
+// resume without paying the recipients twice.
+type MultiSendProgress struct {
+	CSVHash     string   `json:"csv_hash"`
+	SentPayouts int      `json:"sent_payouts"`
+	TxHashes    []string `json:"tx_hashes"`
+}
+
+// CmdTxMultiSend returns MultiSend cobra command.
+func CmdTxMultiSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send --csv [payouts.csv] --from [sender]",
+		Args:  cobra.NoArgs,
+		Short: "Send the tokens to the recipients listed in the CSV file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the tokens to the recipients listed in the CSV file. Each row of the file contains the recipient and
+the amount, the amount is either the coin or the number denominated in the --denom. The amounts might be given in the
+display unit registered in the denom metadata, e.g. 1.5WBTC-devcore1..., they are converted to the base denom using the
+precision of the unit. The optional header row and the rows starting with # are skipped.
+
+The payouts are split into the transactions containing at most --msgs-per-tx messages, limited by the max_msgs
+parameter of the chain. The gas of the transactions is computed using the deterministic gas of the messages. If neither
+--fees nor --gas-prices is set, the fees are computed from the current minimum gas price. The summary is printed and
+confirmed before the first transaction is broadcast.
+
+The transactions are broadcast one by one and the progress is stored in the [payouts.csv]%s file. If the command fails,
+run it again to resume from the first payout not sent yet. The file is kept once all the payouts are sent, so running
+the command again doesn't pay anyone twice.
+
+Example:
+$ %s tx asset-ft multi-send --csv payouts.csv --denom ucore --from [sender]
+`,
+				progressFileSuffix,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly || clientCtx.Offline {
+				return errors.New("multi-send broadcasts the transactions, it can't be used in the generate-only or offline mode")
+			}
+			// the payouts are recorded as sent once their transaction is included in the block
+			if !cmd.Flags().Changed(flags.FlagBroadcastMode) {
+				clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastBlock)
+			}
+
+			csvPath, err := cmd.Flags().GetString(csvFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if csvPath == "" {
+				return errors.Errorf("the --%s flag is required", csvFlag)
+			}
+			denom, err := cmd.Flags().GetString(denomFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			msgsPerTx, err := cmd.Flags().GetUint32(msgsPerTxFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			csvContent, err := os.ReadFile(csvPath)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			amountParser := NewAmountParser(clientCtx)
+			payouts, err := ParsePayoutsCSV(bytes.NewReader(csvContent), denom, func(amount string) (sdk.Coin, error) {
+				return amountParser.Parse(cmd.Context(), amount)
+			})
+			if err != nil {
+				return err
+			}
+
+			progressPath := csvPath + progressFileSuffix
+			csvHash := sha256.Sum256(csvContent)
+			progress, err := readMultiSendProgress(progressPath, hex.EncodeToString(csvHash[:]))
+			if err != nil {
+				return err
+			}
+			if progress.SentPayouts > len(payouts) {
+				return errors.Errorf("progress file %s reports more payouts sent than the CSV file contains", progressPath)
+			}
+			if progress.SentPayouts == len(payouts) {
+				cmd.PrintErrf("all %d payouts have been sent already\n", len(payouts))
+				return nil
+			}
+			if progress.SentPayouts > 0 {
+				cmd.PrintErrf("resuming after %d payouts sent already\n", progress.SentPayouts)
+			}
+
+			txParamsRes, err := customparamstypes.NewQueryClient(clientCtx).TxParams(cmd.Context(), &customparamstypes.QueryTxParamsRequest{})
+			if err != nil {
+				return err
+			}
+			maxMsgs := txParamsRes.Params.MaxMsgs
+			if msgsPerTx > maxMsgs {
+				return errors.Errorf("--%s must not exceed the max_msgs parameter %d", msgsPerTxFlag, maxMsgs)
+			}
+			if msgsPerTx == 0 {
+				msgsPerTx = maxMsgs
+			}
+
+			authRes, err := authtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &authtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			feemodelQueryClient := feemodeltypes.NewQueryClient(clientCtx)
+			feemodelParamsRes, err := feemodelQueryClient.Params(cmd.Context(), &feemodeltypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			featureGas, err := multiSendFeatureGas(cmd.Context(), types.NewQueryClient(clientCtx), denom)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags()).Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+			gasPrice, err := multiSendGasPrice(cmd, txf, feemodelQueryClient)
+			if err != nil {
+				return err
+			}
+			fromInfo, err := clientCtx.Keyring.Key(clientCtx.GetFromName())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			chunks := ChunkPayouts(payouts[progress.SentPayouts:], int(msgsPerTx))
+			txfs := make([]tx.Factory, 0, len(chunks))
+			msgs := make([][]sdk.Msg, 0, len(chunks))
+			var totalGas uint64
+			totalFees := sdk.NewCoins()
+			for _, chunk := range chunks {
+				chunkMsgs := make([]sdk.Msg, 0, len(chunk))
+				for _, p := range chunk {
+					chunkMsgs = append(chunkMsgs, banktypes.NewMsgSend(clientCtx.GetFromAddress(), p.Recipient, sdk.NewCoins(p.Amount)))
+				}
+
+				chunkTxf, gas, fees, err := multiSendTxFactory(
+					clientCtx, txf, fromInfo.GetPubKey(), chunkMsgs, featureGas, authRes.Params, feemodelParamsRes.Params, gasPrice,
+				)
+				if err != nil {
+					return err
+				}
+				txfs = append(txfs, chunkTxf)
+				msgs = append(msgs, chunkMsgs)
+				totalGas += gas
+				totalFees = totalFees.Add(fees...)
+				txf = txf.WithSequence(txf.Sequence() + 1)
+			}
+
+			totalAmount := sdk.NewCoins()
+			for _, p := range payouts[progress.SentPayouts:] {
+				totalAmount = totalAmount.Add(p.Amount)
+			}
+			cmd.PrintErrf("payouts: %d\ntotal amount: %s\ntransactions: %d\ntotal gas: %d\ntotal fees: %s\n",
+				len(payouts)-progress.SentPayouts, totalAmount, len(chunks), totalGas, totalFees)
+
+			if !clientCtx.SkipConfirm {
+				ok, err := input.GetConfirmation("confirm multi-send", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				if !ok {
+					cmd.PrintErrln("canceled multi-send")
+					return nil
+				}
+			}
+
+			for i, chunkTxf := range txfs {
+				txHash, err := broadcastMultiSendTx(clientCtx, chunkTxf, msgs[i])
+				if err != nil {
+					return errors.Wrapf(err, "multi-send stopped after %d of %d payouts, run the command again to resume",
+						progress.SentPayouts, len(payouts))
+				}
+
+				progress.SentPayouts += len(msgs[i])
+				progress.TxHashes = append(progress.TxHashes, txHash)
+				if err := writeMultiSendProgress(progressPath, progress); err != nil {
+					return err
+				}
+				cmd.PrintErrf("sent payouts %d of %d in tx %s\n", progress.SentPayouts, len(payouts), txHash)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(csvFlag, "", "CSV file containing the recipient and the amount of each payout")
+	cmd.Flags().String(denomFlag, "", "Denom of the amounts given as the numbers in the CSV file")
+	cmd.Flags().Uint32(msgsPerTxFlag, 0, "Maximum number of the payouts sent in one transaction, the max_msgs parameter of the chain is used if not set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParsePayoutsCSV parses the payouts from the CSV rows containing the recipient and the amount. The amount is either
+// the coin or the number denominated in the denom, it is converted to the coin by the parseAmount function.
+// The optional header row and the rows starting with # are skipped.
+func ParsePayoutsCSV(r io.Reader, denom string, parseAmount func(amount string) (sdk.Coin, error)) ([]Payout, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var payouts []Payout
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid CSV file")
+		}
+		row, _ := reader.FieldPos(0)
+		recipientStr, amountStr := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+
+		if len(payouts) == 0 && (strings.EqualFold(recipientStr, "recipient") || strings.EqualFold(recipientStr, "address")) {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(recipientStr)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid recipient in row %d", row)
+		}
+
+		if numberRegex.MatchString(amountStr) {
+			if denom == "" {
+				return nil, errors.Errorf("amount in row %d has no denom and the --%s flag is not set", row, denomFlag)
+			}
+			amountStr += denom
+		}
+		amount, err := parseAmount(amountStr)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid amount in row %d", row)
+		}
+		if err := amount.Validate(); err != nil || !amount.IsPositive() {
+			return nil, errors.Errorf("amount in row %d must be the positive coin: %s", row, amountStr)
+		}
+
+		payouts = append(payouts, Payout{
+			Row:       row,
+			Recipient: recipient,
+			Amount:    amount,
+		})
+	}
+
+	if len(payouts) == 0 {
+		return nil, errors.New("CSV file contains no payouts")
+	}
+	return payouts, nil
+}
+
+// ChunkPayouts splits the payouts into the chunks containing at most size payouts.
+func ChunkPayouts(payouts []Payout, size int) [][]Payout {
+	if size <= 0 {
+		return nil
+	}
+	chunks := make([][]Payout, 0, (len(payouts)+size-1)/size)
+	for len(payouts) > size {
+		chunks = append(chunks, payouts[:size])
+		payouts = payouts[size:]
+	}
+	if len(payouts) > 0 {
+		chunks = append(chunks, payouts)
+	}
+	return chunks
+}
+
+// multiSendGasPrice returns the gas price used to compute the fees of the transactions. The zero price is returned if
+// the fees are set explicitly.
+func multiSendGasPrice(cmd *cobra.Command, txf tx.Factory, feemodelQueryClient feemodeltypes.QueryClient) (sdk.DecCoin, error) {
+	if !txf.Fees().IsZero() {
+		return sdk.DecCoin{}, nil
+	}
+	if gasPrices := txf.GasPrices(); !gasPrices.IsZero() {
+		if len(gasPrices) != 1 {
+			return sdk.DecCoin{}, errors.New("exactly one gas price must be set")
+		}
+		return gasPrices[0], nil
+	}
+
+	minGasPriceRes, err := feemodelQueryClient.MinGasPrice(cmd.Context(), &feemodeltypes.QueryMinGasPriceRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	return sdk.NewDecCoinFromDec(minGasPriceRes.MinGasPrice.Denom, minGasPriceRes.MinGasPrice.Amount.Mul(defaultGasPriceMultiplier)), nil
+}
+
+// multiSendFeatureGas returns the gas charged for the features of the token on top of the deterministic gas of each
+// payout.
+func multiSendFeatureGas(ctx context.Context, queryClient types.QueryClient, denom string) (uint64, error) {
+	if _, _, err := types.DeconstructDenom(denom); err != nil {
+		return 0, nil //nolint:nilerr // the features apply to the tokens issued by the module only
+	}
+	tokenRes, err := queryClient.Token(ctx, &types.QueryTokenRequest{Denom: denom})
+	if err != nil {
+		return 0, err
+	}
+	featureGasRes, err := queryClient.SendFeatureGas(ctx, &types.QuerySendFeatureGasRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	var gas uint64
+	for _, featureGas := range featureGasRes.Features {
+		for _, feature := range tokenRes.Token.Features {
+			if feature == featureGas.Feature {
+				gas += featureGas.Gas
+			}
+		}
+	}
+	return gas, nil
+}
+
+// multiSendTxFactory returns the factory with the deterministic gas and the fees of the tx containing the messages.
+// The gas charged for the features of the token is added for each message. The size of the tx is measured with the
+// placeholder signature and the maximum gas and fees, so it's never underestimated.
+func multiSendTxFactory(
+	clientCtx client.Context,
+	txf tx.Factory,
+	pubKey cryptotypes.PubKey,
+	msgs []sdk.Msg,
+	featureGas uint64,
+	authParams authtypes.Params,
+	feemodelParams feemodeltypes.Params,
+	gasPrice sdk.DecCoin,
+) (tx.Factory, uint64, sdk.Coins, error) {
+	sizeTxf := txf.WithGas(math.MaxUint64)
+	if !gasPrice.Amount.IsNil() {
+		sizeTxf = sizeTxf.WithGasPrices("").WithFees(sdk.NewCoin(gasPrice.Denom, sdk.NewIntFromUint64(math.MaxUint64)).String())
+	}
+	txBuilder, err := sizeTxf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return tx.Factory{}, 0, nil, err
+	}
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = clientCtx.TxConfig.SignModeHandler().DefaultMode()
+	}
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: make([]byte, secp256k1SignatureSize),
+		},
+		Sequence: txf.Sequence(),
+	}); err != nil {
+		return tx.Factory{}, 0, nil, errors.WithStack(err)
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return tx.Factory{}, 0, nil, errors.WithStack(err)
+	}
+
+	gas, deterministic := config.DefaultDeterministicGasRequirements().TxGas(msgs, len(txBytes), 1, authParams)
+	if !deterministic {
+		return tx.Factory{}, 0, nil, errors.New("messages don't have the deterministic gas")
+	}
+	gas += uint64(len(msgs)) * featureGas
+
+	txf = txf.WithGas(gas)
+	if gasPrice.Amount.IsNil() {
+		return txf, gas, txf.Fees(), nil
+	}
+	fees := sdk.NewCoins(sdk.NewCoin(
+		gasPrice.Denom,
+		gasPrice.Amount.MulInt64(int64(gas)).Ceil().TruncateInt().Add(feemodelParams.CalculateSurcharge(msgs)),
+	))
+	return txf.WithGasPrices("").WithFees(fees.String()), gas, fees, nil
+}
+
+// broadcastMultiSendTx signs and broadcasts the tx. The hash of the tx is returned in the error too, so the operator
+// may check if the tx has been included before resuming.
+func broadcastMultiSendTx(clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg) (string, error) {
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return "", err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	txHash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return "", errors.Wrapf(err, "broadcasting tx %s failed, check if it has been included", txHash)
+	}
+	if res.Code != 0 {
+		return "", errors.Errorf("tx %s failed with code %d: %s", txHash, res.Code, res.RawLog)
+	}
+	return txHash, nil
+}
+
+func readMultiSendProgress(path, csvHash string) (MultiSendProgress, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return MultiSendProgress{CSVHash: csvHash}, nil
+	}
+	if err != nil {
+		return MultiSendProgress{}, errors.WithStack(err)
+	}
+
+	var progress MultiSendProgress
+	if err := json.Unmarshal(content, &progress); err != nil {
+		return MultiSendProgress{}, errors.Wrapf(err, "invalid progress file %s", path)
+	}
+	if progress.CSVHash != csvHash {
+		return MultiSendProgress{}, errors.Errorf("CSV file has changed since the progress file %s was created, restore the file or remove the progress file", path)
+	}
+	return progress, nil
+}
+
+func writeMultiSendProgress(path string, progress MultiSendProgress) error {
+	content, err := json.Marshal(progress)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(path, content, 0o600))
+}
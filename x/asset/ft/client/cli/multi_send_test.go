@@ -0,0 +1,133 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/testutil/network"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/client/cli"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestParsePayoutsCSV(t *testing.T) {
+	requireT := require.New(t)
+
+	recipient1 := sdk.AccAddress(bytes.Repeat([]byte{0x01}, 20))
+	recipient2 := sdk.AccAddress(bytes.Repeat([]byte{0x02}, 20))
+
+	payouts, err := cli.ParsePayoutsCSV(strings.NewReader(fmt.Sprintf(`recipient,amount
+# comment
+%s, 100
+%s,20ucore
+`, recipient1, recipient2)), "denom", sdk.ParseCoinNormalized)
+	requireT.NoError(err)
+	requireT.Equal([]cli.Payout{
+		{Row: 3, Recipient: recipient1, Amount: sdk.NewInt64Coin("denom", 100)},
+		{Row: 4, Recipient: recipient2, Amount: sdk.NewInt64Coin("ucore", 20)},
+	}, payouts)
+
+	invalidCSVs := map[string]string{
+		"empty":             "recipient,amount\n",
+		"missing denom":     recipient1.String() + ",100\n",
+		"invalid recipient": "invalid,100ucore\n",
+		"invalid amount":    recipient1.String() + ",abc\n",
+		"zero amount":       recipient1.String() + ",0ucore\n",
+		"missing column":    recipient1.String() + "\n",
+		"header not first":  recipient1.String() + ",100ucore\nrecipient,amount\n",
+	}
+	for name, content := range invalidCSVs {
+		_, err := cli.ParsePayoutsCSV(strings.NewReader(content), "", sdk.ParseCoinNormalized)
+		requireT.Error(err, name)
+	}
+}
+
+func TestChunkPayouts(t *testing.T) {
+	requireT := require.New(t)
+
+	payouts := make([]cli.Payout, 5)
+	for i := range payouts {
+		payouts[i].Row = i
+	}
+
+	chunks := cli.ChunkPayouts(payouts, 2)
+	requireT.Equal([][]cli.Payout{payouts[:2], payouts[2:4], payouts[4:]}, chunks)
+	requireT.Equal([][]cli.Payout{payouts}, cli.ChunkPayouts(payouts, 5))
+	requireT.Equal([][]cli.Payout{payouts}, cli.ChunkPayouts(payouts, 10))
+	requireT.Empty(cli.ChunkPayouts(nil, 2))
+}
+
+func TestMultiSend(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	issuer := testNetwork.Validators[0].Address
+	denom := types.BuildDenom("subunit", issuer)
+
+	args := append([]string{"abc", "subunit", "8", "777", `"My Token"`}, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssue(), args)
+	requireT.NoError(err)
+
+	recipients := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	csvPath := filepath.Join(t.TempDir(), "payouts.csv")
+	requireT.NoError(os.WriteFile(csvPath, []byte(fmt.Sprintf("recipient,amount\n%s,10\n%s,20\n%s,30%s\n",
+		recipients[0], recipients[1], recipients[2], denom)), 0o600))
+
+	// the fees are computed from the deterministic gas
+	args = []string{
+		"--csv", csvPath,
+		"--denom", denom,
+		"--msgs-per-tx", "2",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, issuer.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	}
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMultiSend(), args)
+	requireT.NoError(err)
+
+	for i, recipient := range recipients {
+		var balanceRsp banktypes.QueryAllBalancesResponse
+		buf, err := clitestutil.ExecTestCLICmd(ctx, bankcli.GetBalancesCmd(), []string{recipient.String(), "--output", "json"})
+		requireT.NoError(err)
+		requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &balanceRsp))
+		requireT.Equal(sdk.NewInt(int64(10*(i+1))).String(), balanceRsp.Balances.AmountOf(denom).String())
+	}
+
+	progressContent, err := os.ReadFile(csvPath + ".progress")
+	requireT.NoError(err)
+	var progress cli.MultiSendProgress
+	requireT.NoError(json.Unmarshal(progressContent, &progress))
+	requireT.Equal(3, progress.SentPayouts)
+	requireT.Len(progress.TxHashes, 2)
+
+	// running the command again doesn't pay anyone twice
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMultiSend(), args)
+	requireT.NoError(err)
+
+	var balanceRsp banktypes.QueryAllBalancesResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, bankcli.GetBalancesCmd(), []string{issuer.String(), "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &balanceRsp))
+	requireT.Equal("717", balanceRsp.Balances.AmountOf(denom).String())
+
+	// the changed CSV file is rejected
+	requireT.NoError(os.WriteFile(csvPath, []byte(fmt.Sprintf("%s,10%s\n", recipients[0], denom)), 0o600))
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMultiSend(), args)
+	requireT.Error(err)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// CmdSubmitTokenStatusProposal returns the command submitting the governance proposal moving the fungible token to
+// another lifecycle status. The tx flags are added by the gov module.
+func CmdSubmitTokenStatusProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assetft-token-status [denom] [status] --title [title] --description [description] --deposit [deposit] --from [proposer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal moving the fungible token to another lifecycle status",
+		Long: fmt.Sprintf(`Submit a proposal moving the fungible token to another lifecycle status.
+Unlike the admin of the token, the governance might suspend and retire any token, including the tokens without
+the freeze feature and the tokens which admin has been cleared.
+
+Example:
+$ %s tx gov submit-proposal assetft-token-status ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 retired --title "Retire ABC" --description "..." --deposit 10000000ucore --from [proposer]
+`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := ParseTokenStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return errors.Wrapf(err, "invalid deposit %q", depositStr)
+			}
+
+			content := types.NewTokenStatusProposal(title, description, args[0], status)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+
+	return cmd
+}
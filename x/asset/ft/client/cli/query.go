@@ -2,16 +2,24 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
+// Flags defined on queries
+const (
+	featureFlag = "feature"
+	symbolFlag  = "symbol"
+)
+
 // GetQueryCmd returns the cli query commands for the module.
 func GetQueryCmd() *cobra.Command {
 	// Group asset queries under a subcommand
@@ -23,11 +31,99 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQuerySendFeatureGas())
 	cmd.AddCommand(CmdQueryTokenInfo())
+	cmd.AddCommand(CmdQueryTokens())
 	cmd.AddCommand(CmdQueryFrozenBalance())
 	cmd.AddCommand(CmdQueryFrozenBalances())
+	cmd.AddCommand(CmdQueryFrozenRate())
+	cmd.AddCommand(CmdQueryTimedFreezes())
+	cmd.AddCommand(CmdQueryAccountFrozen())
+	cmd.AddCommand(CmdQueryFrozenAccounts())
+	cmd.AddCommand(CmdQueryBurnAllowance())
+	cmd.AddCommand(CmdQueryBurnAllowances())
 	cmd.AddCommand(CmdQueryWhitelistedBalance())
 	cmd.AddCommand(CmdQueryWhitelistedBalances())
+	cmd.AddCommand(CmdQueryBridgeMintRecord())
+	cmd.AddCommand(CmdQueryWhitelistExemptions())
+	cmd.AddCommand(CmdQueryBurnRateExemptions())
+	cmd.AddCommand(CmdQueryTokenAttributes())
+	cmd.AddCommand(CmdQueryResolveIBCDenom())
+	cmd.AddCommand(CmdQueryReservation())
+	cmd.AddCommand(CmdQueryPayeeReservations())
+	cmd.AddCommand(CmdQueryPendingGlobalFreezes())
+	cmd.AddCommand(CmdQueryReserveAttestations())
+	cmd.AddCommand(CmdQueryAdmin())
+	cmd.AddCommand(CmdQueryTokenStats())
+	cmd.AddCommand(CmdQueryDenomAvailable())
+	cmd.AddCommand(CmdQueryAssetList())
+	return cmd
+}
+
+// CmdQueryParams return the QueryParams cobra command.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current asset ft parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current asset ft parameters.
+
+Example:
+$ %[1]s query asset-ft params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySendFeatureGas return the QuerySendFeatureGas cobra command.
+func CmdQuerySendFeatureGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-feature-gas",
+		Args:  cobra.NoArgs,
+		Short: "Query the gas charged for each feature of the token sent",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the gas charged on top of the deterministic gas of the message for each token sent, per feature enabled for the token.
+
+Example:
+$ %[1]s query asset-ft send-feature-gas
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SendFeatureGas(cmd.Context(), &types.QuerySendFeatureGasRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -67,6 +163,53 @@ $ %[1]s query asset-ft info [denom]
 	return cmd
 }
 
+// CmdQueryTokens return the QueryTokens cobra command.
+func CmdQueryTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens",
+		Args:  cobra.NoArgs,
+		Short: "Query fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible tokens, optionally only those having the feature enabled.
+
+Example:
+$ %[1]s query asset-ft tokens --%[2]s whitelist
+`,
+				version.AppName, featureFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			feature, err := cmd.Flags().GetString(featureFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Tokens(cmd.Context(), &types.QueryTokensRequest{
+				Pagination: pageReq,
+				Feature:    feature,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(featureFlag, "", "Return only the tokens having the feature enabled")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokens")
+
+	return cmd
+}
+
 // CmdQueryFrozenBalances return the QueryFrozenBalances cobra command.
 //
 //nolint:dupl // most code is identical, but reusing logic is not beneficial here.
@@ -150,19 +293,136 @@ $ %[1]s query asset-ft frozen-balance [account] [denom]
 	return cmd
 }
 
-// CmdQueryWhitelistedBalances return the QueryWhitelistedBalances cobra command.
-//
-//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
-func CmdQueryWhitelistedBalances() *cobra.Command {
+// CmdQueryFrozenRate return the QueryFrozenRate cobra command.
+func CmdQueryFrozenRate() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "whitelisted-balances [account]",
+		Use:   "frozen-rate [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query share of the fungible token balance frozen on an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query share of the fungible token balance frozen on an account and the amount of the current balance it freezes.
+
+Example:
+$ %[1]s query asset-ft frozen-rate [account] [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenRate(cmd.Context(), &types.QueryFrozenRateRequest{
+				Account: args[0],
+				Denom:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTimedFreezes return the QueryTimedFreezes cobra command.
+func CmdQueryTimedFreezes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timed-freezes [account] [denom]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query fungible token amounts frozen on an account until the unfreeze time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token amounts frozen on an account until the unfreeze time, optionally filtered by the denom.
+
+Example:
+$ %[1]s query asset-ft timed-freezes [account]
+$ %[1]s query asset-ft timed-freezes [account] [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTimedFreezesRequest{
+				Account:    args[0],
+				Pagination: pageReq,
+			}
+			if len(args) > 1 {
+				req.Denom = args[1]
+			}
+			res, err := queryClient.TimedFreezes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "timed freezes")
+
+	return cmd
+}
+
+// CmdQueryAccountFrozen return the QueryAccountFrozen cobra command.
+func CmdQueryAccountFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-frozen [issuer] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether all the fungible tokens of the issuer held by the account are frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether all the fungible tokens of the issuer held by the account are frozen.
+
+Example:
+$ %[1]s query asset-ft account-frozen [issuer] [account]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountFrozen(cmd.Context(), &types.QueryAccountFrozenRequest{
+				Issuer:  args[0],
+				Account: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryFrozenAccounts return the QueryFrozenAccounts cobra command.
+func CmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts [issuer]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query fungible token whitelisted balances",
+		Short: "Query the accounts which all the fungible tokens of the issuer are frozen on",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query whitelisted fungible token balances of an account.
+			fmt.Sprintf(`Query the accounts which all the fungible tokens of the issuer are frozen on.
 
 Example:
-$ %[1]s query asset-ft whitelisted-balances [account]
+$ %[1]s query asset-ft frozen-accounts [issuer]
 `,
 				version.AppName,
 			),
@@ -176,9 +436,8 @@ $ %[1]s query asset-ft whitelisted-balances [account]
 				return err
 			}
 
-			account := args[0]
-			res, err := queryClient.WhitelistedBalances(cmd.Context(), &types.QueryWhitelistedBalancesRequest{
-				Account:    account,
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{
+				Issuer:     args[0],
 				Pagination: pageReq,
 			})
 			if err != nil {
@@ -190,22 +449,22 @@ $ %[1]s query asset-ft whitelisted-balances [account]
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "whitelisted balances")
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
 
 	return cmd
 }
 
-// CmdQueryWhitelistedBalance return the QueryWhitelistedBalance cobra command.
-func CmdQueryWhitelistedBalance() *cobra.Command {
+// CmdQueryBurnAllowance return the QueryBurnAllowance cobra command.
+func CmdQueryBurnAllowance() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "whitelisted-balance [account] [denom]",
-		Args:  cobra.ExactArgs(2),
-		Short: "Query fungible token whitelisted balance",
+		Use:   "burn-allowance [owner] [spender] [denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query fungible token amount the spender is allowed to burn from the owner account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query whitelisted fungible token balance of an account.
+			fmt.Sprintf(`Query fungible token amount the spender is allowed to burn from the owner account.
 
 Example:
-$ %[1]s query asset-ft whitelisted-balance [account] [denom]
+$ %[1]s query asset-ft burn-allowance [owner] [spender] [denom]
 `,
 				version.AppName,
 			),
@@ -214,11 +473,604 @@ $ %[1]s query asset-ft whitelisted-balance [account] [denom]
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
-			account := args[0]
-			denom := args[1]
-			res, err := queryClient.WhitelistedBalance(cmd.Context(), &types.QueryWhitelistedBalanceRequest{
-				Account: account,
-				Denom:   denom,
+			res, err := queryClient.BurnAllowance(cmd.Context(), &types.QueryBurnAllowanceRequest{
+				Owner:   args[0],
+				Spender: args[1],
+				Denom:   args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBurnAllowances return the QueryBurnAllowances cobra command.
+func CmdQueryBurnAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-allowances [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token burn allowances granted by the owner account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token burn allowances granted by the owner account.
+
+Example:
+$ %[1]s query asset-ft burn-allowances [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnAllowances(cmd.Context(), &types.QueryBurnAllowancesRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn allowances")
+
+	return cmd
+}
+
+// CmdQueryWhitelistedBalances return the QueryWhitelistedBalances cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdQueryWhitelistedBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelisted-balances [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token whitelisted balances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whitelisted fungible token balances of an account.
+
+Example:
+$ %[1]s query asset-ft whitelisted-balances [account]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			account := args[0]
+			res, err := queryClient.WhitelistedBalances(cmd.Context(), &types.QueryWhitelistedBalancesRequest{
+				Account:    account,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "whitelisted balances")
+
+	return cmd
+}
+
+// CmdQueryWhitelistedBalance return the QueryWhitelistedBalance cobra command.
+func CmdQueryWhitelistedBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelisted-balance [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fungible token whitelisted balance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whitelisted fungible token balance of an account.
+
+Example:
+$ %[1]s query asset-ft whitelisted-balance [account] [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.WhitelistedBalance(cmd.Context(), &types.QueryWhitelistedBalanceRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryBridgeMintRecord return the QueryBridgeMintRecord cobra command.
+func CmdQueryBridgeMintRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-mint-record [denom] [transfer_id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the record of the transfer minted by the bridge",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the record of the transfer from the external chain minted by the bridge.
+
+Example:
+$ %[1]s query asset-ft bridge-mint-record [denom] [transfer_id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BridgeMintRecord(cmd.Context(), &types.QueryBridgeMintRecordRequest{
+				Denom:      args[0],
+				TransferId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryWhitelistExemptions return the QueryWhitelistExemptions cobra command.
+func CmdQueryWhitelistExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist-exemptions [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query accounts exempted from the whitelisted limits",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query accounts exempted from the whitelisted limits of the fungible token.
+
+Example:
+$ %[1]s query asset-ft whitelist-exemptions [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WhitelistExemptions(cmd.Context(), &types.QueryWhitelistExemptionsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "whitelist exemptions")
+
+	return cmd
+}
+
+// CmdQueryBurnRateExemptions return the QueryBurnRateExemptions cobra command.
+func CmdQueryBurnRateExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-rate-exemptions [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query accounts exempted from the burn rate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query accounts exempted from the burn rate of the fungible token.
+
+Example:
+$ %[1]s query asset-ft burn-rate-exemptions [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnRateExemptions(cmd.Context(), &types.QueryBurnRateExemptionsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burn rate exemptions")
+
+	return cmd
+}
+
+// CmdQueryTokenAttributes return the QueryTokenAttributes cobra command.
+func CmdQueryTokenAttributes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attributes [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query attributes of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query key-value attributes attached to the fungible token.
+
+Example:
+$ %[1]s query asset-ft attributes [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenAttributes(cmd.Context(), &types.QueryTokenAttributesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token attributes")
+
+	return cmd
+}
+
+// CmdQueryResolveIBCDenom return the QueryResolveIBCDenom cobra command.
+func CmdQueryResolveIBCDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-ibc-denom [ibc_denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the trace of the IBC denom and the token it represents",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the trace of the IBC voucher denom and, if the voucher represents the fungible token
+issued on the chain, the token. Both the full IBC denom and its hash are accepted.
+
+Example:
+$ %[1]s query asset-ft resolve-ibc-denom ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ResolveIBCDenom(cmd.Context(), &types.QueryResolveIBCDenomRequest{
+				Hash: strings.TrimPrefix(args[0], types.IBCDenomPrefix),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryReservation return the QueryReservation cobra command.
+func CmdQueryReservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reservation [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reservation of the funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reservation of the funds by its ID.
+
+Example:
+$ %[1]s query asset-ft reservation 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid id")
+			}
+
+			res, err := queryClient.Reservation(cmd.Context(), &types.QueryReservationRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryPayeeReservations return the QueryPayeeReservations cobra command.
+func CmdQueryPayeeReservations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payee-reservations [payee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reservations of the funds made for the payee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reservations of the funds which might be captured by the payee.
+
+Example:
+$ %[1]s query asset-ft payee-reservations [payee]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PayeeReservations(cmd.Context(), &types.QueryPayeeReservationsRequest{
+				Payee:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "payee reservations")
+
+	return cmd
+}
+
+// CmdQueryPendingGlobalFreezes return the QueryPendingGlobalFreezes cobra command.
+func CmdQueryPendingGlobalFreezes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-global-freezes",
+		Args:  cobra.NoArgs,
+		Short: "Query the global freezes scheduled to take effect in the future",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the global freezes of the fungible tokens scheduled to take effect in the future.
+
+Example:
+$ %[1]s query asset-ft pending-global-freezes
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingGlobalFreezes(cmd.Context(), &types.QueryPendingGlobalFreezesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending global freezes")
+
+	return cmd
+}
+
+// CmdQueryReserveAttestations return the QueryReserveAttestations cobra command.
+func CmdQueryReserveAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-attestations [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the latest reserve attestations of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the latest attestations of the reserves backing the fungible token published by the issuer, the oldest first.
+
+Example:
+$ %[1]s query asset-ft reserve-attestations [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReserveAttestations(cmd.Context(), &types.QueryReserveAttestationsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAdmin return the QueryAdmin cobra command.
+func CmdQueryAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the admin of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the admin holding the issuer privileges of the fungible token and the scheduled admin transfer.
+
+Example:
+$ %[1]s query asset-ft admin [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Admin(cmd.Context(), &types.QueryAdminRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTokenStats return the QueryTokenStats cobra command.
+func CmdQueryTokenStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-stats [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the supply and holder statistics of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply, the number of holders and the total frozen and whitelisted amounts of the fungible token.
+
+Example:
+$ %[1]s query asset-ft token-stats [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenStats(cmd.Context(), &types.QueryTokenStatsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Stats)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDenomAvailable return the QueryDenomAvailable cobra command.
+func CmdQueryDenomAvailable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-available [issuer] [subunit]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether the fungible token with the subunit and the symbol might be issued by the issuer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the fungible token with the subunit might be issued by the issuer, without broadcasting the issue transaction.
+The symbol is validated too if it is set by the --%[2]s flag.
+
+Example:
+$ %[1]s query asset-ft denom-available [issuer] uabc --%[2]s ABC
+`,
+				version.AppName, symbolFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			symbol, err := cmd.Flags().GetString(symbolFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomAvailable(cmd.Context(), &types.QueryDenomAvailableRequest{
+				Issuer:  args[0],
+				Subunit: args[1],
+				Symbol:  symbol,
 			})
 			if err != nil {
 				return err
@@ -228,6 +1080,7 @@ $ %[1]s query asset-ft whitelisted-balance [account] [denom]
 		},
 	}
 
+	cmd.Flags().String(symbolFlag, "", "Symbol of the token to validate")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -1,6 +1,7 @@
 package cli_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum/pkg/assetlist"
 	"github.com/CoreumFoundation/coreum/testutil/event"
 	"github.com/CoreumFoundation/coreum/testutil/network"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/client/cli"
@@ -37,17 +39,58 @@ func TestQueryToken(t *testing.T) {
 	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
 
 	requireT.Equal(types.FT{
-		Denom:       denom,
-		Issuer:      testNetwork.Validators[0].Address.String(),
-		Symbol:      symbol,
-		Subunit:     strings.ToLower(subunit),
-		Precision:   8,
-		Description: "",
-		Features:    []types.TokenFeature{},
-		BurnRate:    sdk.NewDec(0),
+		Denom:              denom,
+		Issuer:             testNetwork.Validators[0].Address.String(),
+		Symbol:             symbol,
+		Subunit:            strings.ToLower(subunit),
+		Precision:          8,
+		Description:        "",
+		Features:           []types.TokenFeature{},
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.NewDec(0),
 	}, resp.Token)
 }
 
+func TestQueryAssetList(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	symbol := "btc" + uuid.NewString()[:4]
+	subunit := "sub" + symbol
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	denom := issue(requireT, ctx, symbol, subunit, "8", testNetwork)
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryAssetList(), []string{"--chain-name", "coreumtest"})
+	requireT.NoError(err)
+
+	var assetList assetlist.AssetList
+	requireT.NoError(json.Unmarshal(buf.Bytes(), &assetList))
+	requireT.Equal(assetlist.Schema, assetList.Schema)
+	requireT.Equal("coreumtest", assetList.ChainName)
+
+	var found bool
+	for _, asset := range assetList.Assets {
+		if asset.Base != denom {
+			continue
+		}
+		found = true
+		requireT.Equal(assetlist.Asset{
+			DenomUnits: []assetlist.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: symbol, Exponent: 8},
+			},
+			TypeAsset: assetlist.TypeAssetSDKCoin,
+			Base:      denom,
+			Name:      symbol,
+			Display:   symbol,
+			Symbol:    symbol,
+		}, asset)
+	}
+	requireT.True(found)
+}
+
 func issue(requireT *require.Assertions, ctx client.Context, symbol, subunit, precision string, testNetwork *network.Network) string {
 	args := []string{symbol, subunit, precision, "", ""}
 	args = append(args, txValidator1Args(testNetwork)...)
@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -20,8 +22,17 @@ import (
 
 // Flags defined on transactions
 const (
-	featuresFlag = "features"
-	burnRateFlag = "burn-rate"
+	featuresFlag           = "features"
+	burnRateFlag           = "burn-rate"
+	sendCommissionRateFlag = "send-commission-rate"
+	idempotencyKeyFlag     = "idempotency-key"
+	activationTimeFlag     = "activation-time"
+	gracePeriodFlag        = "grace-period"
+	vestingPeriodsFlag     = "vesting-periods"
+	vestingStartFlag       = "vesting-start-time"
+	uriFlag                = "uri"
+	uriHashFlag            = "uri-hash"
+	draftFlag              = "draft"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,11 +49,38 @@ func GetTxCmd() *cobra.Command {
 		CmdTxIssue(),
 		CmdTxMint(),
 		CmdTxBurn(),
+		CmdTxGrantBurnAllowance(),
+		CmdTxBurnFrom(),
 		CmdTxFreeze(),
 		CmdTxUnfreeze(),
+		CmdTxSetFrozenRate(),
+		CmdTxFreezeUntil(),
+		CmdTxFreezeAccount(),
+		CmdTxUnfreezeAccount(),
+		CmdTxBatchFreeze(),
+		CmdTxBatchUnfreeze(),
 		CmdTxGloballyFreeze(),
 		CmdTxGloballyUnfreeze(),
+		CmdTxSetTokenStatus(),
 		CmdTxSetWhitelistedLimit(),
+		CmdTxSetWhitelistExemption(),
+		CmdTxBatchSetWhitelistedLimit(),
+		CmdTxSetBurnRateExemption(),
+		CmdTxUpdateTokenMetadata(),
+		CmdTxSetTokenAttribute(),
+		CmdTxWrap(),
+		CmdTxUnwrap(),
+		CmdTxSignBridgeMint(),
+		CmdTxBridgeMint(),
+		CmdTxBridgeBurn(),
+		CmdTxRegisterIBCDenom(),
+		CmdTxReserve(),
+		CmdTxRelease(),
+		CmdTxCapture(),
+		CmdTxPublishReserveAttestation(),
+		CmdTxTransferAdmin(),
+		CmdTxClearAdmin(),
+		CmdTxMultiSend(),
 	)
 
 	return cmd
@@ -56,7 +94,7 @@ func CmdTxIssue() *cobra.Command {
 	}
 	sort.Strings(allowedFeatures)
 	cmd := &cobra.Command{
-		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12",
+		Use:   "issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features=" + strings.Join(allowedFeatures, ",") + " --burn-rate=0.12 --send-commission-rate=0.2",
 		Args:  cobra.ExactArgs(5),
 		Short: "Issue new fungible token",
 		Long: strings.TrimSpace(
@@ -64,8 +102,12 @@ func CmdTxIssue() *cobra.Command {
 
 Example:
 $ %s tx asset-ft issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
+
+The initial amount might be vested on the issuer account, the issuer account is converted to the periodic vesting
+account then. Each period is set as [length]:[amount], the amounts must sum up to the initial amount:
+$ %s tx asset-ft issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --vesting-periods=0s:10000,8760h:90000 --from [issuer]
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -109,6 +151,18 @@ $ %s tx asset-ft issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [is
 				}
 			}
 
+			sendCommissionRate := sdk.NewDec(0)
+			sendCommissionRateStr, err := cmd.Flags().GetString(sendCommissionRateFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(sendCommissionRateStr) > 0 {
+				sendCommissionRate, err = sdk.NewDecFromStr(sendCommissionRateStr)
+				if err != nil {
+					return errors.Wrapf(err, "invalid send-commission-rate")
+				}
+			}
+
 			var features []types.TokenFeature
 			for _, str := range featuresString {
 				feature, ok := types.TokenFeature_value[str] //nolint:nosnakecase
@@ -119,15 +173,44 @@ $ %s tx asset-ft issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [is
 			}
 			description := args[4]
 
+			idempotencyKey, err := cmd.Flags().GetString(idempotencyKeyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			vestingSchedule, err := getVestingSchedule(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, err := cmd.Flags().GetString(uriFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(uriHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			draft, err := cmd.Flags().GetBool(draftFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
 			msg := &types.MsgIssue{
-				Issuer:        issuer.String(),
-				Symbol:        symbol,
-				Subunit:       subunit,
-				Precision:     uint32(precision),
-				InitialAmount: initialAmount,
-				Description:   description,
-				Features:      features,
-				BurnRate:      burnRate,
+				Issuer:             issuer.String(),
+				Symbol:             symbol,
+				Subunit:            subunit,
+				Precision:          uint32(precision),
+				InitialAmount:      initialAmount,
+				Description:        description,
+				Features:           features,
+				BurnRate:           burnRate,
+				SendCommissionRate: sendCommissionRate,
+				IdempotencyKey:     idempotencyKey,
+				VestingSchedule:    vestingSchedule,
+				URI:                uri,
+				URIHash:            uriHash,
+				Draft:              draft,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -135,6 +218,13 @@ $ %s tx asset-ft issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [is
 	}
 	cmd.Flags().StringSlice(featuresFlag, []string{}, "Features to be enabled on fungible token. e.g --features="+strings.Join(allowedFeatures, ","))
 	cmd.Flags().String(burnRateFlag, "0", "Burn rate indicates the rate at which coins will be burned on top of the send amount in every send action. Must be between 0 and 1.")
+	cmd.Flags().String(sendCommissionRateFlag, "0", "Send commission rate indicates the rate at which coins will be sent to the issuer on top of the send amount in every send action. Must be between 0 and 1.")
+	cmd.Flags().String(idempotencyKeyFlag, "", "Key making the issuance idempotent. If the token has been already issued with the same key, the transaction doesn't issue a new one.")
+	cmd.Flags().StringSlice(vestingPeriodsFlag, []string{}, "Periods the initial amount is vested with on the issuer account, set as [length]:[amount], e.g. --vesting-periods=0s:100,720h:900.")
+	cmd.Flags().String(vestingStartFlag, "", "Time (RFC3339) the first vesting period starts at. If not set, the block time of the issuance is used.")
+	cmd.Flags().String(uriFlag, "", "URI of the document describing the token.")
+	cmd.Flags().String(uriHashFlag, "", "Hash of the document referenced by the URI.")
+	cmd.Flags().Bool(draftFlag, false, "Issue the token in the draft status, so only the issuer might send and receive it until it is activated.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -166,7 +256,7 @@ $ %s tx asset-ft freeze [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6ve
 
 			sender := clientCtx.GetFromAddress()
 			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
@@ -185,6 +275,250 @@ $ %s tx asset-ft freeze [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6ve
 	return cmd
 }
 
+// CmdTxBatchFreeze returns BatchFreeze cobra command.
+func CmdTxBatchFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-freeze [account_address]:[amount]... --from [sender]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Freeze a portion of fungible token on many accounts in one transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a portion of fungible token on many accounts in one transaction. The entries are applied
+atomically, so none of them is applied if any fails.
+
+Example:
+$ %s tx asset-ft batch-freeze [account_address1]:100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 [account_address2]:50000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			entries, err := parseBatchEntries(cmd, clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchFreeze{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Entries: entries,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBatchUnfreeze returns BatchUnfreeze cobra command.
+func CmdTxBatchUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-unfreeze [account_address]:[amount]... --from [sender]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Unfreeze a portion of the frozen fungible tokens on many accounts in one transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze a portion of the frozen fungible tokens on many accounts in one transaction. The entries are
+applied atomically, so none of them is applied if any fails.
+
+Example:
+$ %s tx asset-ft batch-unfreeze [account_address1]:100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 [account_address2]:50000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			entries, err := parseBatchEntries(cmd, clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchUnfreeze{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Entries: entries,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetFrozenRate returns SetFrozenRate cobra command.
+func CmdTxSetFrozenRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-frozen-rate [account_address] [denom] [rate] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Freeze a share of the fungible token held by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a share of the fungible token held by an account at the time it is sent. The rate is a number
+between 0 and 1, the share is frozen on top of the frozen amount. The zero rate removes the relative freeze.
+
+Example:
+$ %s tx asset-ft set-frozen-rate [account_address] ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 0.25 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			rate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid rate")
+			}
+
+			msg := &types.MsgSetFrozenRate{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
+				Rate:    rate,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxFreezeUntil returns FreezeUntil cobra command.
+func CmdTxFreezeUntil() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-until [account_address] [amount] [unfreeze_time] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Freeze a portion of fungible token on an account until the unfreeze time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a portion of fungible token on an account until the unfreeze time (RFC3339). The amount is frozen
+on top of the frozen amount and unfrozen automatically once the block time reaches the unfreeze time, it can't be
+unfrozen earlier.
+
+Example:
+$ %s tx asset-ft freeze-until [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 2023-01-02T15:04:05Z --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			unfreezeTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid unfreeze time")
+			}
+
+			msg := &types.MsgFreezeUntil{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Account:      args[0],
+				Coin:         amount,
+				UnfreezeTime: unfreezeTime,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxFreezeAccount returns FreezeAccount cobra command.
+//
+//nolint:dupl // most code is identical between FreezeAccount/UnfreezeAccount cmd, but reusing logic is not beneficial here.
+func CmdTxFreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-account [account_address] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Freeze all the fungible tokens of the issuer held by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze all the fungible tokens of the issuer held by an account, including the tokens issued later. Only the
+tokens with the freezable feature enabled which issuer privileges haven't been transferred are affected.
+
+Example:
+$ %s tx asset-ft freeze-account [account_address] --from [issuer]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgFreezeAccount{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUnfreezeAccount returns UnfreezeAccount cobra command.
+//
+//nolint:dupl // most code is identical between FreezeAccount/UnfreezeAccount cmd, but reusing logic is not beneficial here.
+func CmdTxUnfreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-account [account_address] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unfreeze all the fungible tokens of the issuer held by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze all the fungible tokens of the issuer held by an account frozen by freeze-account. The amounts
+frozen per token stay frozen.
+
+Example:
+$ %s tx asset-ft unfreeze-account [account_address] --from [issuer]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUnfreezeAccount{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxUnfreeze returns Unfreeze cobra command.
 //
 //nolint:dupl // most code is identical between Freeze/Unfreeze cmd, but reusing logic is not beneficial here.
@@ -210,7 +544,7 @@ $ %s tx asset-ft unfreeze [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6
 
 			sender := clientCtx.GetFromAddress()
 			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
@@ -252,7 +586,7 @@ $ %s tx asset-ft mint 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 -
 			}
 
 			sender := clientCtx.GetFromAddress()
-			amount, err := sdk.ParseCoinNormalized(args[0])
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[0])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
@@ -293,7 +627,7 @@ $ %s tx asset-ft burn 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 -
 			}
 
 			sender := clientCtx.GetFromAddress()
-			amount, err := sdk.ParseCoinNormalized(args[0])
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[0])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
@@ -312,19 +646,18 @@ $ %s tx asset-ft burn 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 -
 	return cmd
 }
 
-// CmdTxSetWhitelistedLimit returns SetWhitelistedLimit cobra command.
-//
-//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
-func CmdTxSetWhitelistedLimit() *cobra.Command {
+// CmdTxGrantBurnAllowance returns GrantBurnAllowance cobra command.
+func CmdTxGrantBurnAllowance() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-whitelisted-limit [account_address] [amount] --from [sender]",
+		Use:   "grant-burn-allowance [spender] [amount] --from [sender]",
 		Args:  cobra.ExactArgs(2),
-		Short: "Set whitelisted limit on an account",
+		Short: "Allow the spender to burn some amount of fungible token from the sender balance",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Set whitelisted limit on an account.
+			fmt.Sprintf(`Allow the spender to burn some amount of fungible token from the sender balance, replacing the
+allowance granted before. The zero amount revokes the allowance. The spender must be the admin of the token.
 
 Example:
-$ %s tx asset-ft set-whitelisted-limit [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8-tEQ4 --from [sender]
+$ %s tx asset-ft grant-burn-allowance [spender] 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
 `,
 				version.AppName,
 			),
@@ -336,17 +669,18 @@ $ %s tx asset-ft set-whitelisted-limit [account_address] 100000ABC-devcore1tr3w8
 			}
 
 			sender := clientCtx.GetFromAddress()
-			account := args[0]
-			amount, err := sdk.ParseCoinNormalized(args[1])
+			spender := args[0]
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
-			msg := &types.MsgSetWhitelistedLimit{
+			msg := &types.MsgGrantBurnAllowance{
 				Sender:  sender.String(),
-				Account: account,
+				Spender: spender,
 				Coin:    amount,
 			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -356,18 +690,17 @@ $ %s tx asset-ft set-whitelisted-limit [account_address] 100000ABC-devcore1tr3w8
 	return cmd
 }
 
-// CmdTxGloballyFreeze returns GlobalFreeze cobra command.
-func CmdTxGloballyFreeze() *cobra.Command {
+// CmdTxBurnFrom returns BurnFrom cobra command.
+func CmdTxBurnFrom() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "globally-freeze [denom] --from [sender]",
-		Args:  cobra.ExactArgs(1),
-		Short: "freezes fungible token so no operations are allowed with it before unfrozen",
+		Use:   "burn-from [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn some amount of fungible token from the account which has granted the burn allowance to the sender",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Freezes fungible token so no operations are allowed with it before unfrozen.
-This operation is idempotent so global freeze of already frozen token does nothing.
+			fmt.Sprintf(`Burn some amount of fungible token from the account which has granted the burn allowance to the sender.
 
 Example:
-$ %s tx asset-ft globally-freeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+$ %s tx asset-ft burn-from [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
 `,
 				version.AppName,
 			),
@@ -379,12 +712,18 @@ $ %s tx asset-ft globally-freeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk
 			}
 
 			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			account := args[0]
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
 
-			msg := &types.MsgGloballyFreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgBurnFrom{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
 			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -394,18 +733,19 @@ $ %s tx asset-ft globally-freeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk
 	return cmd
 }
 
-// CmdTxGloballyUnfreeze returns GlobalUnfreeze cobra command.
-func CmdTxGloballyUnfreeze() *cobra.Command {
+// CmdTxSetWhitelistedLimit returns SetWhitelistedLimit cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxSetWhitelistedLimit() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "globally-unfreeze [denom] --from [sender]",
-		Args:  cobra.ExactArgs(1),
-		Short: "unfreezes fungible token and unblocks basic operations on it",
+		Use:   "set-whitelisted-limit [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set whitelisted limit on an account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Unfreezes fungible token and unblocks basic operations on it.
-This operation is idempotent so global unfreezing of non-frozen token does nothing.
+			fmt.Sprintf(`Set whitelisted limit on an account.
 
 Example:
-$ %s tx asset-ft globally-unfreeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+$ %s tx asset-ft set-whitelisted-limit [account_address] 100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8-tEQ4 --from [sender]
 `,
 				version.AppName,
 			),
@@ -417,11 +757,16 @@ $ %s tx asset-ft globally-unfreeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0
 			}
 
 			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			account := args[0]
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
 
-			msg := &types.MsgGloballyUnfreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgSetWhitelistedLimit{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -431,3 +776,955 @@ $ %s tx asset-ft globally-unfreeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0
 
 	return cmd
 }
+
+// CmdTxBatchSetWhitelistedLimit returns BatchSetWhitelistedLimit cobra command.
+func CmdTxBatchSetWhitelistedLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-set-whitelisted-limit [account_address]:[amount]... --from [sender]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Set whitelisted limits on many accounts in one transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set whitelisted limits on many accounts in one transaction. The entries are applied atomically, so none
+of them is applied if any fails.
+
+Example:
+$ %s tx asset-ft batch-set-whitelisted-limit [account_address1]:100000ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 [account_address2]:0ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			entries, err := parseBatchEntries(cmd, clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchSetWhitelistedLimit{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Entries: entries,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetWhitelistExemption returns SetWhitelistExemption cobra command.
+func CmdTxSetWhitelistExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-whitelist-exemption [account_address] [denom] [exempt] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exempt an account from the whitelisted limits or revoke the exemption",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exempt an account from the whitelisted limits of the fungible token or revoke the exemption.
+The balance received by the exempted account is not checked against its whitelisted limit.
+
+Example:
+$ %s tx asset-ft set-whitelist-exemption [account_address] ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 true --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			exempt, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid exempt flag")
+			}
+
+			msg := &types.MsgSetWhitelistExemption{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
+				Exempt:  exempt,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetBurnRateExemption returns SetBurnRateExemption cobra command.
+func CmdTxSetBurnRateExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-burn-rate-exemption [account_address] [denom] [exempt] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exempt an account from the burn rate or revoke the exemption",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exempt an account from the burn rate of the fungible token or revoke the exemption.
+The tokens sent from or to the exempted account, e.g. the escrow account of the contract, are not burnt.
+
+Example:
+$ %s tx asset-ft set-burn-rate-exemption [account_address] ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 true --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			exempt, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid exempt flag")
+			}
+
+			msg := &types.MsgSetBurnRateExemption{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Account: args[0],
+				Denom:   args[1],
+				Exempt:  exempt,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateTokenMetadata returns UpdateTokenMetadata cobra command.
+func CmdTxUpdateTokenMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata [denom] [description] [uri] [uri_hash] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Update the description, URI and URI hash of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the description, URI and URI hash of the fungible token issued with the metadata_update feature.
+The symbol, subunit and precision of the token are never updated. The empty values clear the metadata.
+
+Example:
+$ %s tx asset-ft update-metadata ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 "ABC Token" https://abc.invalid/meta.json "" --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUpdateTokenMetadata{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Denom:       args[0],
+				Description: args[1],
+				URI:         args[2],
+				URIHash:     args[3],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetTokenAttribute returns SetTokenAttribute cobra command.
+func CmdTxSetTokenAttribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-attribute [denom] [key] [value] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the key-value attribute of the fungible token or remove it if the value is empty",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the key-value attribute of the fungible token, e.g. its ISIN or jurisdiction.
+The attribute is removed if the value is empty.
+
+Example:
+$ %[1]s tx asset-ft set-attribute ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 jurisdiction CH --from [sender]
+$ %[1]s tx asset-ft set-attribute ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 jurisdiction "" --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSetTokenAttribute{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+				Key:    args[1],
+				Value:  args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGloballyFreeze returns GlobalFreeze cobra command.
+func CmdTxGloballyFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "globally-freeze [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freezes fungible token so no operations are allowed with it before unfrozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freezes fungible token so no operations are allowed with it before unfrozen.
+This operation is idempotent so global freeze of already frozen token does nothing.
+If the activation time (RFC3339) is set, the freeze is scheduled to take effect at that time, giving the holders
+the advance notice. The freeze scheduled before is replaced.
+
+Example:
+$ %[1]s tx asset-ft globally-freeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+$ %[1]s tx asset-ft globally-freeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --activation-time 2023-01-02T15:04:05Z --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgGloballyFreeze{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+
+			activationTimeStr, err := cmd.Flags().GetString(activationTimeFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if activationTimeStr != "" {
+				activationTime, err := time.Parse(time.RFC3339, activationTimeStr)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid activation time")
+				}
+				msg.ActivationTime = &activationTime
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(activationTimeFlag, "", "Time (RFC3339) the global freeze takes effect at. If not set, the token is frozen immediately.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGloballyUnfreeze returns GlobalUnfreeze cobra command.
+func CmdTxGloballyUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "globally-unfreeze [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unfreezes fungible token and unblocks basic operations on it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreezes fungible token and unblocks basic operations on it.
+This operation is idempotent so global unfreezing of non-frozen token does nothing.
+
+Example:
+$ %s tx asset-ft globally-unfreeze ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgGloballyUnfreeze{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetTokenStatus returns SetTokenStatus cobra command.
+func CmdTxSetTokenStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-status [denom] [status] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "moves fungible token to another lifecycle status",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Moves fungible token to another lifecycle status. The statuses are: %s.
+The draft token might be activated, the active token might be suspended if it has the freeze feature, the suspended
+token might be reactivated, and any token might be retired. The retired token is never moved to another status.
+
+Example:
+$ %s tx asset-ft set-status ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 active --from [sender]
+`,
+				strings.Join(tokenStatusNames(), ", "), version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			status, err := ParseTokenStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetTokenStatus{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+				Status: status,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ParseTokenStatus parses the name of the lifecycle status of the token.
+func ParseTokenStatus(name string) (types.TokenStatus, error) {
+	status, ok := types.TokenStatus_value[name] //nolint:nosnakecase
+	if !ok {
+		return 0, errors.Errorf("unknown status '%s', allowed statuses: %s", name, strings.Join(tokenStatusNames(), ","))
+	}
+	return types.TokenStatus(status), nil
+}
+
+func tokenStatusNames() []string {
+	names := make([]string, 0, len(types.TokenStatus_name)) //nolint:nosnakecase
+	for status := types.TokenStatus(0); int(status) < cap(names); status++ {
+		names = append(names, status.String())
+	}
+	return names
+}
+
+// CmdTxWrap returns Wrap cobra command.
+func CmdTxWrap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrap [amount] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "wrap native coins into the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock native coins in the module account and receive the same amount of the wrapped fungible token.
+
+Example:
+$ %s tx asset-ft wrap 100000udevcore --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgWrap{
+				Sender: sender.String(),
+				Coin:   amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUnwrap returns Unwrap cobra command.
+func CmdTxUnwrap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwrap [amount] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unwrap the fungible token back into native coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the wrapped fungible token and receive the same amount of native coins.
+
+Example:
+$ %s tx asset-ft unwrap 100000wudevcore-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgUnwrap{
+				Sender: sender.String(),
+				Coin:   amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSignBridgeMint returns SignBridgeMint cobra command.
+func CmdTxSignBridgeMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-bridge-mint [recipient] [amount] [transfer_id] --from [attester]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Attest the transfer from the external chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the transfer from the external chain offline and print the attestation to be included in the bridge mint transaction.
+
+Example:
+$ %s tx asset-ft sign-bridge-mint [recipient] 100000ubtc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 0x1f2e3d --from [attester] > attestation.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid recipient")
+			}
+			// the transfer is signed offline, so the amount is accepted in the base denom only
+			amount, err := NewAmountParser(clientCtx.WithOffline(true)).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			transferID := args[2]
+
+			signBytes := types.BridgeMintSignBytes(clientCtx.ChainID, transferID, recipient, amount)
+			signature, pubKey, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
+			if err != nil {
+				return errors.Wrap(err, "can't sign the transfer")
+			}
+
+			return clientCtx.PrintProto(&types.BridgeAttestation{
+				Attester:  clientCtx.GetFromAddress().String(),
+				PubKey:    pubKey.Bytes(),
+				Signature: signature,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBridgeMint returns BridgeMint cobra command.
+func CmdTxBridgeMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-mint [recipient] [amount] [transfer_id] [attestation_file]... --from [sender]",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "mint the fungible token transferred from the external chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint the fungible token transferred from the external chain to the recipient.
+The attestations are produced by the attesters using the sign-bridge-mint command.
+
+Example:
+$ %s tx asset-ft bridge-mint [recipient] 100000ubtc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 0x1f2e3d attestation1.json attestation2.json --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			attestations := make([]types.BridgeAttestation, 0, len(args)-3)
+			for _, file := range args[3:] {
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return errors.Wrapf(err, "can't read attestation file %s", file)
+				}
+				var attestation types.BridgeAttestation
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &attestation); err != nil {
+					return errors.Wrapf(err, "invalid attestation file %s", file)
+				}
+				attestations = append(attestations, attestation)
+			}
+
+			msg := &types.MsgBridgeMint{
+				Sender:       sender.String(),
+				Recipient:    args[0],
+				Coin:         amount,
+				TransferID:   args[2],
+				Attestations: attestations,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBridgeBurn returns BridgeBurn cobra command.
+func CmdTxBridgeBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-burn [amount] [destination] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn the fungible token to be released on the external chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the fungible token to be released to the destination address on the external chain by the bridge.
+
+Example:
+$ %s tx asset-ft bridge-burn 100000ubtc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgBridgeBurn{
+				Sender:      sender.String(),
+				Coin:        amount,
+				Destination: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRegisterIBCDenom returns RegisterIBCDenom cobra command.
+func CmdTxRegisterIBCDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-ibc-denom [path] [base_denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Register the trace of the IBC voucher denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register the trace of the IBC voucher denom, so it might be resolved to the original token.
+The IBC denom is derived from the path and the base denom, so anyone might register it. If the base denom is
+the fungible token issued on the chain, its denom metadata is registered for the IBC denom too.
+
+Example:
+$ %s tx asset-ft register-ibc-denom transfer/channel-0 ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRegisterIBCDenom{
+				Sender: clientCtx.GetFromAddress().String(),
+				Trace: types.IBCDenomTrace{
+					Path:      args[0],
+					BaseDenom: args[1],
+				},
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxReserve returns Reserve cobra command.
+func CmdTxReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve [payee] [amount] [expiration] --from [payer]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Reserve the funds to be captured by the payee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock the funds in the module escrow, so the payee might capture up to the reserved amount before
+the expiration time (RFC3339). The funds not captured are returned to the payer once the reservation is released
+or expired. The ID of the reservation is reported by the EventFundsReserved event.
+
+Example:
+$ %s tx asset-ft reserve devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 100000ubtc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 2023-01-01T00:00:00Z --from [payer]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			expiration, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid expiration")
+			}
+
+			msg := &types.MsgReserve{
+				Payer:      clientCtx.GetFromAddress().String(),
+				Payee:      args[0],
+				Amount:     amount,
+				Expiration: expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRelease returns Release cobra command.
+func CmdTxRelease() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release [id] --from [payee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Return the reserved funds to the payer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Return the funds locked by the reservation to the payer. Only the payee might release the reservation.
+
+Example:
+$ %s tx asset-ft release 1 --from [payee]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid id")
+			}
+
+			msg := &types.MsgRelease{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCapture returns Capture cobra command.
+func CmdTxCapture() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capture [id] [amount] --from [payee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Capture the reserved funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer up to the reserved amount to the payee and return the rest of the locked funds to the payer.
+Only the payee might capture the reservation, once and before it expires.
+
+Example:
+$ %s tx asset-ft capture 1 50000ubtc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [payee]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid id")
+			}
+			amount, err := NewAmountParser(clientCtx).Parse(cmd.Context(), args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgCapture{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     id,
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxPublishReserveAttestation returns PublishReserveAttestation cobra command.
+func CmdTxPublishReserveAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish-reserve-attestation [denom] [amount] [auditor_signature_hash] [uri] --from [admin]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Publish the attestation of the reserves backing the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Publish the attestation of the reserves backing the fungible token. The amount is the amount of the reserves
+confirmed by the auditor, the auditor signature hash is the hex-encoded hash of the auditor signature of the attestation
+report located at the URI. Only the admin of the token might publish the attestation and only the latest %d attestations are kept.
+
+Example:
+$ %s tx asset-ft publish-reserve-attestation ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 1000000 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 https://example.com/attestations/2023-01.pdf --from [admin]
+`,
+				types.MaxReserveAttestations, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrap(types.ErrInvalidInput, "invalid amount")
+			}
+
+			msg := &types.MsgPublishReserveAttestation{
+				Sender:               clientCtx.GetFromAddress().String(),
+				Denom:                args[0],
+				Amount:               amount,
+				AuditorSignatureHash: args[2],
+				URI:                  args[3],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxTransferAdmin returns TransferAdmin cobra command.
+func CmdTxTransferAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-admin [denom] [account] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the issuer privileges of the fungible token to another account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the issuer privileges of the fungible token, like minting, freezing and whitelisting, to another account.
+If the grace period is set, the current admin keeps the privileges until it elapses. The transfer scheduled before is
+replaced, and the transfer to the current admin cancels it.
+
+Example:
+$ %s tx asset-ft transfer-admin ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq --grace-period 72h --from [admin]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			gracePeriod, err := cmd.Flags().GetDuration(gracePeriodFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgTransferAdmin{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Denom:       args[0],
+				Account:     args[1],
+				GracePeriod: gracePeriod,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Duration(gracePeriodFlag, 0, "Duration the current admin keeps the privileges for. If not set, the privileges are transferred immediately.")
+
+	return cmd
+}
+
+// CmdTxClearAdmin returns ClearAdmin cobra command.
+func CmdTxClearAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-admin [denom] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the admin of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the admin of the fungible token, so nobody holds its issuer privileges anymore. This can't be undone.
+
+Example:
+$ %s tx asset-ft clear-admin ABC-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [admin]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgClearAdmin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Denom:  args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getVestingSchedule returns the vesting schedule set by the flags or nil if the vesting periods aren't set.
+func getVestingSchedule(cmd *cobra.Command) (*types.VestingSchedule, error) {
+	periodsStr, err := cmd.Flags().GetStringSlice(vestingPeriodsFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	startTimeStr, err := cmd.Flags().GetString(vestingStartFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(periodsStr) == 0 {
+		if startTimeStr != "" {
+			return nil, errors.Errorf("vesting start time can't be set without the vesting periods")
+		}
+		return nil, nil //nolint:nilnil // nil schedule means the initial amount isn't vested
+	}
+
+	schedule := &types.VestingSchedule{}
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid vesting start time")
+		}
+		schedule.StartTime = &startTime
+	}
+	for _, periodStr := range periodsStr {
+		lengthStr, amountStr, ok := strings.Cut(periodStr, ":")
+		if !ok {
+			return nil, errors.Errorf("invalid vesting period %q, it must be set as [length]:[amount]", periodStr)
+		}
+		length, err := time.ParseDuration(lengthStr)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid length of the vesting period %q", periodStr)
+		}
+		amount, ok := sdk.NewIntFromString(amountStr)
+		if !ok {
+			return nil, errors.Errorf("invalid amount of the vesting period %q", periodStr)
+		}
+		schedule.Periods = append(schedule.Periods, types.VestingPeriod{
+			Length: length,
+			Amount: amount,
+		})
+	}
+
+	return schedule, nil
+}
+
+// parseBatchEntries parses the entries of the batch message given as [account_address]:[amount].
+func parseBatchEntries(cmd *cobra.Command, clientCtx client.Context, args []string) ([]types.AccountCoin, error) {
+	amountParser := NewAmountParser(clientCtx)
+	entries := make([]types.AccountCoin, 0, len(args))
+	for _, arg := range args {
+		account, amountStr, ok := strings.Cut(arg, ":")
+		if !ok {
+			return nil, errors.Errorf("invalid entry %q, it must be set as [account_address]:[amount]", arg)
+		}
+		amount, err := amountParser.Parse(cmd.Context(), amountStr)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid amount of the entry %q", arg)
+		}
+		entries = append(entries, types.AccountCoin{
+			Account: account,
+			Coin:    amount,
+		})
+	}
+
+	return entries, nil
+}
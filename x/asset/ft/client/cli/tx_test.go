@@ -1,17 +1,17 @@
 package cli_test
 
 import (
-	"fmt"
 	"testing"
 
-	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CoreumFoundation/coreum/testutil/network"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/client/cli"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
 func TestIssue(t *testing.T) {
@@ -36,11 +36,49 @@ func TestIssue(t *testing.T) {
 	requireT.Equal(uint32(0), res.Code, "can't submit Issue tx", res)
 }
 
+func TestIssueMintFreezeByAccount(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	issuer, err := network.NewFundedAccount(testNetwork, "issuer", sdk.NewInt(10_000_000))
+	requireT.NoError(err)
+	holder, err := network.NewFundedAccount(testNetwork, "holder", sdk.NewInt(10_000_000))
+	requireT.NoError(err)
+
+	// the denom must start from the letter
+	symbol := "l" + uuid.NewString()[:4]
+	subunit := "sub" + symbol
+	denom := types.BuildDenom(subunit, issuer)
+
+	_, err = network.ExecTxCmd(ctx, testNetwork, cli.CmdTxIssue(), issuer, []string{
+		symbol, subunit, "6", "100", `"My Token"`,
+		"--features", types.TokenFeature_mint.String() + "," + types.TokenFeature_freeze.String(), //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	_, err = network.ExecTxCmd(ctx, testNetwork, cli.CmdTxMint(), issuer, []string{"50" + denom})
+	requireT.NoError(err)
+
+	_, err = network.ExecTxCmd(ctx, testNetwork, bankcli.NewSendTxCmd(), issuer, []string{
+		issuer.String(), holder.String(), "150" + denom,
+	})
+	requireT.NoError(err)
+
+	_, err = network.ExecTxCmd(ctx, testNetwork, cli.CmdTxFreeze(), issuer, []string{holder.String(), "40" + denom})
+	requireT.NoError(err)
+
+	// only the issuer is allowed to freeze
+	_, err = network.ExecTxCmd(ctx, testNetwork, cli.CmdTxFreeze(), holder, []string{holder.String(), "40" + denom})
+	requireT.Error(err)
+
+	var resp types.QueryFrozenBalanceResponse
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFrozenBalance(), []string{holder.String(), denom, "--output", "json"})
+	requireT.NoError(err)
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+	requireT.Equal("40"+denom, resp.Balance.String())
+}
+
 func txValidator1Args(testNetwork *network.Network) []string {
-	return []string{
-		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
-		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(testNetwork.Config.BondDenom, sdk.NewInt(1000000))).String()),
-		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-	}
+	return network.TxArgs(testNetwork, testNetwork.Validators[0].Address)
 }
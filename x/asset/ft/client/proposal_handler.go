@@ -0,0 +1,25 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/client/cli"
+)
+
+// ProposalHandler is the gov proposal handler submitting the change of the lifecycle status of the fungible token.
+var ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitTokenStatusProposal, restProposalHandler)
+
+// restProposalHandler rejects the requests, the legacy REST endpoint is not supported.
+func restProposalHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "assetft_token_status",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST route is not supported, use the CLI or gRPC instead")
+		},
+	}
+}
@@ -12,43 +12,136 @@ import (
 
 // InitGenesis initializes the asset module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Params.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+
 	// Init fungible token definitions
 	for _, ft := range genState.Tokens {
 		issuerAddress := sdk.MustAccAddressFromBech32(ft.Issuer)
 		definition := types.FTDefinition{
-			Denom:    ft.Denom,
-			Issuer:   ft.Issuer,
-			Features: ft.Features,
-			BurnRate: ft.BurnRate,
+			Denom:              ft.Denom,
+			Issuer:             ft.Issuer,
+			Features:           ft.Features,
+			BurnRate:           ft.BurnRate,
+			SendCommissionRate: ft.SendCommissionRate,
+			URI:                ft.URI,
+			URIHash:            ft.URIHash,
 		}
 		k.SetTokenDefinition(ctx, definition)
 		err := k.StoreSymbol(ctx, ft.Symbol, issuerAddress)
 		if err != nil {
 			panic(err)
 		}
-		if ft.GloballyFrozen {
-			k.SetGlobalFreeze(ctx, ft.Denom, true)
+		// the tokens exported before the statuses were introduced are globally frozen instead of being suspended
+		status := ft.Status
+		if ft.GloballyFrozen && status == types.TokenStatus_active { //nolint:nosnakecase
+			status = types.TokenStatus_suspended //nolint:nosnakecase
 		}
+		k.SetTokenStatusRecord(ctx, ft.Denom, status)
 	}
 
 	// Init frozen balances
-	for _, frozenBalance := range genState.FrozenBalances {
-		address := sdk.MustAccAddressFromBech32(frozenBalance.Address)
-		k.SetFrozenBalances(ctx, address, frozenBalance.Coins)
+	if err := k.SetFrozenBalancesBatch(ctx, genState.FrozenBalances); err != nil {
+		panic(err)
+	}
+
+	// Init frozen rates
+	for _, frozenRate := range genState.FrozenRates {
+		k.SetFrozenRateRecord(ctx, frozenRate)
+	}
+
+	// Init timed freezes
+	for _, timedFreeze := range genState.TimedFreezes {
+		k.SetTimedFreeze(ctx, timedFreeze)
+	}
+
+	// Init burn allowances
+	for _, burnAllowance := range genState.BurnAllowances {
+		k.SetBurnAllowance(ctx, burnAllowance)
+	}
+
+	// Init account freezes
+	for _, accountFreeze := range genState.AccountFreezes {
+		k.SetAccountFreeze(ctx, accountFreeze)
+	}
+
+	// Init burn rate exemptions
+	for _, exemption := range genState.BurnRateExemptions {
+		k.SetBurnRateExemptionRecord(ctx, exemption)
+	}
+
+	// Init token attributes
+	for _, attribute := range genState.TokenAttributes {
+		k.SetTokenAttributeRecord(ctx, attribute)
 	}
 
 	// Init whitelisted balances
-	for _, whitelistedBalance := range genState.WhitelistedBalances {
-		address := sdk.MustAccAddressFromBech32(whitelistedBalance.Address)
-		k.SetWhitelistedBalances(ctx, address, whitelistedBalance.Coins)
+	if err := k.SetWhitelistedBalancesBatch(ctx, genState.WhitelistedBalances); err != nil {
+		panic(err)
+	}
+
+	// Init bridge mint records
+	for _, record := range genState.BridgeMintRecords {
+		k.SetBridgeMintRecord(ctx, record)
 	}
+
+	// Init whitelist exemptions
+	for _, exemption := range genState.WhitelistExemptions {
+		k.SetWhitelistExemptionRecord(ctx, exemption)
+	}
+
+	// Init IBC denom traces
+	for _, trace := range genState.IBCDenomTraces {
+		k.SetIBCDenomTrace(ctx, trace)
+	}
+
+	// Init reservations
+	for _, reservation := range genState.Reservations {
+		k.SetReservation(ctx, reservation)
+	}
+	if genState.NextReservationID != 0 {
+		k.SetNextReservationID(ctx, genState.NextReservationID)
+	}
+
+	// Init issue idempotency records
+	for _, record := range genState.IssueIdempotencyRecords {
+		k.SetIssueIdempotencyRecord(ctx, record)
+	}
+
+	// Init pending global freezes
+	for _, pendingGlobalFreeze := range genState.PendingGlobalFreezes {
+		k.SetPendingGlobalFreeze(ctx, pendingGlobalFreeze)
+	}
+
+	// Init reserve attestations
+	for _, attestation := range genState.ReserveAttestations {
+		k.AddReserveAttestation(ctx, attestation)
+	}
+
+	// Init token admins
+	for _, tokenAdmin := range genState.TokenAdmins {
+		k.SetTokenAdmin(ctx, tokenAdmin)
+	}
+
+	// Init pending admin transfers
+	for _, pendingAdminTransfer := range genState.PendingAdminTransfers {
+		k.SetPendingAdminTransfer(ctx, pendingAdminTransfer)
+	}
+
+	// Init holder counters from the balances initialized by the bank module
+	k.CountHolders(ctx)
 }
 
 // ExportGenesis returns the asset module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// Export fungible token definitions
-	tokens, _, err := k.GetTokens(ctx, &query.PageRequest{Limit: query.MaxLimit})
-	if err != nil {
+	var tokens []types.FT
+	if err := k.IterateTokens(ctx, func(token types.FT) bool {
+		tokens = append(tokens, token)
+		return false
+	}); err != nil {
 		panic(err)
 	}
 
@@ -65,8 +158,25 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Tokens:              tokens,
-		FrozenBalances:      frozenBalances,
-		WhitelistedBalances: whitelistedBalances,
+		Tokens:                  tokens,
+		FrozenBalances:          frozenBalances,
+		FrozenRates:             k.GetFrozenRates(ctx),
+		TimedFreezes:            k.GetAllTimedFreezes(ctx),
+		BurnAllowances:          k.GetAllBurnAllowances(ctx),
+		AccountFreezes:          k.GetAllAccountFreezes(ctx),
+		BurnRateExemptions:      k.GetAllBurnRateExemptions(ctx),
+		TokenAttributes:         k.GetAllTokenAttributes(ctx),
+		WhitelistedBalances:     whitelistedBalances,
+		BridgeMintRecords:       k.GetBridgeMintRecords(ctx),
+		WhitelistExemptions:     k.GetAllWhitelistExemptions(ctx),
+		IBCDenomTraces:          k.GetIBCDenomTraces(ctx),
+		Reservations:            k.GetReservations(ctx),
+		NextReservationID:       k.GetNextReservationID(ctx),
+		IssueIdempotencyRecords: k.GetIssueIdempotencyRecords(ctx),
+		PendingGlobalFreezes:    k.GetPendingGlobalFreezes(ctx),
+		ReserveAttestations:     k.GetAllReserveAttestations(ctx),
+		TokenAdmins:             k.GetTokenAdmins(ctx),
+		PendingAdminTransfers:   k.GetPendingAdminTransfers(ctx),
+		Params:                  k.GetParams(ctx),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -33,12 +34,13 @@ func TestImportAndExportGenesis(t *testing.T) {
 	var tokens []types.FT
 	for i := 0; i < 5; i++ {
 		ft := types.FT{
-			Denom:     types.BuildDenom(fmt.Sprintf("abc%d", i), issuer),
-			Issuer:    issuer.String(),
-			Symbol:    fmt.Sprintf("ABC%d", i),
-			Subunit:   fmt.Sprintf("abc%d", i),
-			Precision: uint32(rand.Int31n(100)),
-			BurnRate:  sdk.MustNewDecFromStr(fmt.Sprintf("0.%d", i)),
+			Denom:              types.BuildDenom(fmt.Sprintf("abc%d", i), issuer),
+			Issuer:             issuer.String(),
+			Symbol:             fmt.Sprintf("ABC%d", i),
+			Subunit:            fmt.Sprintf("abc%d", i),
+			Precision:          uint32(rand.Int31n(100)),
+			BurnRate:           sdk.MustNewDecFromStr(fmt.Sprintf("0.%d", i)),
+			SendCommissionRate: sdk.MustNewDecFromStr(fmt.Sprintf("0.%d", i+1)),
 			Features: []types.TokenFeature{
 				types.TokenFeature_freeze,    //nolint:nosnakecase // proto enum
 				types.TokenFeature_whitelist, //nolint:nosnakecase // proto enum
@@ -47,6 +49,10 @@ func TestImportAndExportGenesis(t *testing.T) {
 		// Globally freeze some FTs.
 		if i%2 == 0 {
 			ft.GloballyFrozen = true
+			ft.Status = types.TokenStatus_suspended //nolint:nosnakecase // proto enum
+		}
+		if i == 3 {
+			ft.Status = types.TokenStatus_retired //nolint:nosnakecase // proto enum
 		}
 		tokens = append(tokens, ft)
 		ftKeeper.SetDenomMetadata(ctx, ft.Denom, ft.Symbol, ft.Description, ft.Precision)
@@ -66,6 +72,47 @@ func TestImportAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// frozen rates
+	var frozenRates []types.FrozenRate
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		frozenRates = append(frozenRates, types.FrozenRate{
+			Account: addr.String(),
+			Denom:   tokens[i].Denom,
+			Rate:    sdk.NewDecWithPrec(int64(i+1), 1),
+		})
+	}
+
+	// timed freezes
+	var timedFreezes []types.TimedFreeze
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		timedFreezes = append(timedFreezes, types.TimedFreeze{
+			Account:      addr.String(),
+			Coin:         sdk.NewCoin(tokens[i].Denom, sdk.NewInt(rand.Int63())),
+			UnfreezeTime: time.Date(2023, 5, i+1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
+	// burn allowances
+	var burnAllowances []types.BurnAllowance
+	for i := 0; i < 5; i++ {
+		burnAllowances = append(burnAllowances, types.BurnAllowance{
+			Owner:   sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Spender: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Coin:    sdk.NewCoin(tokens[i].Denom, sdk.NewInt(rand.Int63())),
+		})
+	}
+
+	// account freezes
+	var accountFreezes []types.AccountFreeze
+	for i := 0; i < 5; i++ {
+		accountFreezes = append(accountFreezes, types.AccountFreeze{
+			Issuer:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Account: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		})
+	}
+
 	// whitelisted balances
 	var whitelistedBalances []types.Balance
 	for i := 0; i < 5; i++ {
@@ -80,10 +127,127 @@ func TestImportAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// whitelist exemptions
+	var whitelistExemptions []types.WhitelistExemption
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		whitelistExemptions = append(whitelistExemptions, types.WhitelistExemption{
+			Denom:   tokens[i].Denom,
+			Account: addr.String(),
+		})
+	}
+
+	// token attributes
+	var tokenAttributes []types.TokenAttribute
+	for i := 0; i < 5; i++ {
+		tokenAttributes = append(tokenAttributes, types.TokenAttribute{
+			Denom: tokens[i].Denom,
+			Key:   "ISIN",
+			Value: fmt.Sprintf("CH00000000%d", i),
+		})
+	}
+
+	// IBC denom traces
+	var ibcDenomTraces []types.IBCDenomTrace
+	for i := 0; i < 5; i++ {
+		ibcDenomTraces = append(ibcDenomTraces, types.IBCDenomTrace{
+			Path:      fmt.Sprintf("transfer/channel-%d", i),
+			BaseDenom: tokens[i].Denom,
+		})
+	}
+
+	// reservations
+	var reservations []types.Reservation
+	for i := 0; i < 5; i++ {
+		amount := sdk.NewInt64Coin(tokens[i].Denom, int64(100*(i+1)))
+		reservations = append(reservations, types.Reservation{
+			ID:                 uint64(i + 1),
+			Payer:              sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Payee:              sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Amount:             amount,
+			Escrow:             amount,
+			Expiration:         time.Date(2023, 1, i+1, 0, 0, 0, 0, time.UTC),
+			BurnRate:           sdk.ZeroDec(),
+			SendCommissionRate: sdk.ZeroDec(),
+		})
+	}
+
+	// issue idempotency records
+	var issueIdempotencyRecords []types.IssueIdempotencyRecord
+	for i := 0; i < 5; i++ {
+		issueIdempotencyRecords = append(issueIdempotencyRecords, types.IssueIdempotencyRecord{
+			Issuer: tokens[i].Issuer,
+			Key:    fmt.Sprintf("key%d", i),
+			Denom:  tokens[i].Denom,
+		})
+	}
+
+	// pending global freezes
+	var pendingGlobalFreezes []types.PendingGlobalFreeze
+	for i := 0; i < 5; i++ {
+		pendingGlobalFreezes = append(pendingGlobalFreezes, types.PendingGlobalFreeze{
+			Denom:          tokens[i].Denom,
+			ActivationTime: time.Date(2023, 2, i+1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
+	// reserve attestations
+	var reserveAttestations []types.ReserveAttestation
+	for i := 0; i < 5; i++ {
+		reserveAttestations = append(reserveAttestations, types.ReserveAttestation{
+			Denom:                tokens[i%2].Denom,
+			Amount:               sdk.NewInt(int64(1000 * (i + 1))),
+			AuditorSignatureHash: fmt.Sprintf("%064x", i),
+			URI:                  fmt.Sprintf("https://example.com/attestation-%d.pdf", i),
+			Timestamp:            time.Date(2023, 3, i+1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
+	// token admins
+	tokenAdmins := []types.TokenAdmin{
+		{
+			Denom: tokens[0].Denom,
+			Admin: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		},
+		{
+			Denom: tokens[1].Denom,
+		},
+	}
+
+	// pending admin transfers
+	var pendingAdminTransfers []types.PendingAdminTransfer
+	for i := 2; i < 5; i++ {
+		pendingAdminTransfers = append(pendingAdminTransfers, types.PendingAdminTransfer{
+			Denom:          tokens[i].Denom,
+			Account:        sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			ActivationTime: time.Date(2023, 4, i+1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
 	genState := types.GenesisState{
-		Tokens:              tokens,
-		FrozenBalances:      frozenBalances,
-		WhitelistedBalances: whitelistedBalances,
+		Tokens:                  tokens,
+		FrozenBalances:          frozenBalances,
+		FrozenRates:             frozenRates,
+		TimedFreezes:            timedFreezes,
+		BurnAllowances:          burnAllowances,
+		AccountFreezes:          accountFreezes,
+		WhitelistedBalances:     whitelistedBalances,
+		WhitelistExemptions:     whitelistExemptions,
+		TokenAttributes:         tokenAttributes,
+		IBCDenomTraces:          ibcDenomTraces,
+		Reservations:            reservations,
+		NextReservationID:       6,
+		IssueIdempotencyRecords: issueIdempotencyRecords,
+		PendingGlobalFreezes:    pendingGlobalFreezes,
+		ReserveAttestations:     reserveAttestations,
+		TokenAdmins:             tokenAdmins,
+		PendingAdminTransfers:   pendingAdminTransfers,
+		Params: types.Params{
+			MaxSymbolLength:              10,
+			MaxDescriptionLength:         20,
+			MaxTokenAttributes:           5,
+			MaxTokenAttributeValueLength: 30,
+		},
 	}
 
 	// init the keeper
@@ -91,6 +255,9 @@ func TestImportAndExportGenesis(t *testing.T) {
 
 	// assert the keeper state
 
+	// params
+	assertT.Equal(genState.Params, ftKeeper.GetParams(ctx))
+
 	// token definitions
 	for _, definition := range tokens {
 		storedFT, err := ftKeeper.GetToken(ctx, definition.Denom)
@@ -107,6 +274,40 @@ func TestImportAndExportGenesis(t *testing.T) {
 		assertT.EqualValues(balance.Coins.String(), coins.String())
 	}
 
+	// frozen rates
+	for _, frozenRate := range frozenRates {
+		address, err := sdk.AccAddressFromBech32(frozenRate.Account)
+		requireT.NoError(err)
+		assertT.Equal(frozenRate.Rate.String(), ftKeeper.GetFrozenRate(ctx, address, frozenRate.Denom).String())
+	}
+
+	// timed freezes
+	for _, timedFreeze := range timedFreezes {
+		address, err := sdk.AccAddressFromBech32(timedFreeze.Account)
+		requireT.NoError(err)
+		storedTimedFreezes, _, err := ftKeeper.GetTimedFreezes(ctx, address, "", nil)
+		requireT.NoError(err)
+		assertT.Equal([]types.TimedFreeze{timedFreeze}, storedTimedFreezes)
+	}
+
+	// burn allowances
+	for _, burnAllowance := range burnAllowances {
+		owner, err := sdk.AccAddressFromBech32(burnAllowance.Owner)
+		requireT.NoError(err)
+		spender, err := sdk.AccAddressFromBech32(burnAllowance.Spender)
+		requireT.NoError(err)
+		assertT.Equal(burnAllowance.Coin, ftKeeper.GetBurnAllowance(ctx, owner, spender, burnAllowance.Coin.Denom))
+	}
+
+	// account freezes
+	for _, accountFreeze := range accountFreezes {
+		issuer, err := sdk.AccAddressFromBech32(accountFreeze.Issuer)
+		requireT.NoError(err)
+		account, err := sdk.AccAddressFromBech32(accountFreeze.Account)
+		requireT.NoError(err)
+		assertT.True(ftKeeper.IsAccountFrozen(ctx, issuer, account))
+	}
+
 	// whitelisted balances
 	for _, balance := range whitelistedBalances {
 		address, err := sdk.AccAddressFromBech32(balance.Address)
@@ -116,10 +317,101 @@ func TestImportAndExportGenesis(t *testing.T) {
 		assertT.EqualValues(balance.Coins.String(), coins.String())
 	}
 
+	// whitelist exemptions
+	for _, exemption := range whitelistExemptions {
+		address, err := sdk.AccAddressFromBech32(exemption.Account)
+		requireT.NoError(err)
+		assertT.True(ftKeeper.IsWhitelistExempt(ctx, address, exemption.Denom))
+	}
+
+	// token attributes
+	for _, attribute := range tokenAttributes {
+		storedAttributes, _, err := ftKeeper.GetTokenAttributes(ctx, attribute.Denom, nil)
+		requireT.NoError(err)
+		assertT.Equal([]types.TokenAttribute{attribute}, storedAttributes)
+	}
+
+	// IBC denom traces
+	for _, trace := range ibcDenomTraces {
+		storedTrace, found := ftKeeper.GetIBCDenomTrace(ctx, trace.Hash())
+		requireT.True(found)
+		assertT.Equal(trace, storedTrace)
+	}
+
+	// reservations
+	for _, reservation := range reservations {
+		storedReservation, found := ftKeeper.GetReservation(ctx, reservation.ID)
+		requireT.True(found)
+		assertT.Equal(reservation, storedReservation)
+	}
+	assertT.EqualValues(6, ftKeeper.GetNextReservationID(ctx))
+
+	// issue idempotency records
+	for _, record := range issueIdempotencyRecords {
+		issuer, err := sdk.AccAddressFromBech32(record.Issuer)
+		requireT.NoError(err)
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:         issuer,
+			IdempotencyKey: record.Key,
+		})
+		requireT.NoError(err)
+		assertT.Equal(record.Denom, denom)
+	}
+
+	// pending global freezes
+	for _, pendingGlobalFreeze := range pendingGlobalFreezes {
+		storedPendingGlobalFreeze, found := ftKeeper.GetPendingGlobalFreeze(ctx, pendingGlobalFreeze.Denom)
+		requireT.True(found)
+		assertT.Equal(pendingGlobalFreeze, storedPendingGlobalFreeze)
+	}
+
+	// reserve attestations
+	for _, token := range tokens[:2] {
+		var expectedAttestations []types.ReserveAttestation
+		for _, attestation := range reserveAttestations {
+			if attestation.Denom == token.Denom {
+				expectedAttestations = append(expectedAttestations, attestation)
+			}
+		}
+		assertT.Equal(expectedAttestations, ftKeeper.GetReserveAttestations(ctx, token.Denom))
+	}
+
+	// token admins
+	for _, tokenAdmin := range tokenAdmins {
+		admin, err := ftKeeper.GetAdmin(ctx, tokenAdmin.Denom)
+		requireT.NoError(err)
+		assertT.Equal(tokenAdmin.Admin, admin)
+	}
+	admin, err := ftKeeper.GetAdmin(ctx, tokens[2].Denom)
+	requireT.NoError(err)
+	assertT.Equal(tokens[2].Issuer, admin)
+
+	// pending admin transfers
+	for _, pendingAdminTransfer := range pendingAdminTransfers {
+		storedPendingAdminTransfer, found := ftKeeper.GetPendingAdminTransfer(ctx, pendingAdminTransfer.Denom)
+		requireT.True(found)
+		assertT.Equal(pendingAdminTransfer, storedPendingAdminTransfer)
+	}
+
 	// check that export is equal import
 	exportedGenState := ft.ExportGenesis(ctx, ftKeeper)
 
 	assertT.ElementsMatch(genState.Tokens, exportedGenState.Tokens)
 	assertT.ElementsMatch(genState.FrozenBalances, exportedGenState.FrozenBalances)
+	assertT.ElementsMatch(genState.FrozenRates, exportedGenState.FrozenRates)
+	assertT.ElementsMatch(genState.TimedFreezes, exportedGenState.TimedFreezes)
+	assertT.ElementsMatch(genState.BurnAllowances, exportedGenState.BurnAllowances)
+	assertT.ElementsMatch(genState.AccountFreezes, exportedGenState.AccountFreezes)
 	assertT.ElementsMatch(genState.WhitelistedBalances, exportedGenState.WhitelistedBalances)
+	assertT.ElementsMatch(genState.WhitelistExemptions, exportedGenState.WhitelistExemptions)
+	assertT.ElementsMatch(genState.TokenAttributes, exportedGenState.TokenAttributes)
+	assertT.ElementsMatch(genState.IBCDenomTraces, exportedGenState.IBCDenomTraces)
+	assertT.ElementsMatch(genState.Reservations, exportedGenState.Reservations)
+	assertT.Equal(genState.NextReservationID, exportedGenState.NextReservationID)
+	assertT.ElementsMatch(genState.IssueIdempotencyRecords, exportedGenState.IssueIdempotencyRecords)
+	assertT.ElementsMatch(genState.PendingGlobalFreezes, exportedGenState.PendingGlobalFreezes)
+	assertT.ElementsMatch(genState.ReserveAttestations, exportedGenState.ReserveAttestations)
+	assertT.ElementsMatch(genState.TokenAdmins, exportedGenState.TokenAdmins)
+	assertT.ElementsMatch(genState.PendingAdminTransfers, exportedGenState.PendingAdminTransfers)
+	assertT.Equal(genState.Params, exportedGenState.Params)
 }
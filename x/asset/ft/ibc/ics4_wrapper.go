@@ -0,0 +1,68 @@
+// Package ibc implements the IBC middleware of the assetft module controlling which fungible tokens may leave the
+// chain over IBC.
+package ibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// FTKeeper defines the expected assetft keeper.
+type FTKeeper interface {
+	ValidateIBCTransfer(ctx sdk.Context, denom string) error
+}
+
+var _ porttypes.ICS4Wrapper = ICS4Wrapper{}
+
+// ICS4Wrapper is the middleware between the IBC transfer module and the channel keeper. It rejects the ics20 packets
+// sending the fungible tokens without the ibc feature, so the tokens of the issuers who haven't allowed it never leave
+// the chain.
+type ICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	ftKeeper    FTKeeper
+}
+
+// NewICS4Wrapper returns the middleware wrapping the ICS4Wrapper passed to the IBC transfer keeper, usually the
+// channel keeper.
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, ftKeeper FTKeeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		ftKeeper:    ftKeeper,
+	}
+}
+
+// SendPacket sends the packet if it doesn't transfer the fungible token without the ibc feature.
+func (w ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	var data transfertypes.FungibleTokenPacketData
+	// the packets which aren't ics20 ones are validated by the receiving module
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		// no event is emitted for the rejected transfer, because the events are dropped together with the failed tx
+		if err := w.ftKeeper.ValidateIBCTransfer(ctx, data.Denom); err != nil {
+			return err
+		}
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement writes the acknowledgement using the wrapped ICS4Wrapper.
+func (w ICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the version of the application using the wrapped ICS4Wrapper.
+func (w ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return w.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
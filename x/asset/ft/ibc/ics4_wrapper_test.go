@@ -0,0 +1,89 @@
+package ibc_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetftibc "github.com/CoreumFoundation/coreum/x/asset/ft/ibc"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+type channelKeeperMock struct {
+	sent []ibcexported.PacketI
+}
+
+func (m *channelKeeperMock) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	m.sent = append(m.sent, packet)
+	return nil
+}
+
+func (m *channelKeeperMock) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return nil
+}
+
+func (m *channelKeeperMock) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return transfertypes.Version, true
+}
+
+func TestICS4Wrapper_SendPacket(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issue := func(subunit string, features ...types.TokenFeature) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			Features:      features,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	ibcDenom := issue("abc", types.TokenFeature_ibc) //nolint:nosnakecase
+	nonIBCDenom := issue("def")
+
+	channelKeeper := &channelKeeperMock{}
+	wrapper := assetftibc.NewICS4Wrapper(channelKeeper, ftKeeper)
+	packet := func(denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "100", issuer.String(), "receiver")
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1",
+			clienttypes.NewHeight(1, 100), 0,
+		)
+	}
+
+	// the token with the ibc feature and the denoms which aren't the fungible tokens are sent
+	requireT.NoError(wrapper.SendPacket(ctx, nil, packet(ibcDenom)))
+	requireT.NoError(wrapper.SendPacket(ctx, nil, packet("ucore")))
+	requireT.Len(channelKeeper.sent, 2)
+
+	// the token without the ibc feature is rejected
+	err := wrapper.SendPacket(ctx, nil, packet(nonIBCDenom))
+	requireT.True(types.ErrFeatureNotActive.Is(err))
+	requireT.Len(channelKeeper.sent, 2)
+}
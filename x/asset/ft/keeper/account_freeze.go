@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// FreezeAccount freezes all the tokens of the issuer held by the account, including the tokens issued later. Only the
+// tokens with the freezable feature enabled which privileges are still held by the issuer are affected.
+func (k Keeper) FreezeAccount(ctx sdk.Context, issuer, account sdk.AccAddress) error {
+	return k.setAccountFrozen(ctx, issuer, account, true)
+}
+
+// UnfreezeAccount removes the freeze of all the tokens of the issuer held by the account. The amounts frozen per denom
+// stay frozen.
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, issuer, account sdk.AccAddress) error {
+	return k.setAccountFrozen(ctx, issuer, account, false)
+}
+
+func (k Keeper) setAccountFrozen(ctx sdk.Context, issuer, account sdk.AccAddress, frozen bool) error {
+	if issuer.Equals(account) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "issuer can't freeze its own account")
+	}
+
+	accountFreeze := types.AccountFreeze{
+		Issuer:  issuer.String(),
+		Account: account.String(),
+	}
+	if frozen {
+		k.SetAccountFreeze(ctx, accountFreeze)
+	} else {
+		k.removeAccountFreeze(ctx, issuer, account)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIssuerIndexEvent(issuer.String(), account.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAccountFreezeChanged{
+		Issuer:  issuer.String(),
+		Account: account.String(),
+		Frozen:  frozen,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventAccountFreezeChanged: %s", err)
+	}
+
+	return nil
+}
+
+// SetAccountFreeze stores the freeze of all the tokens of the issuer held by the account.
+func (k Keeper) SetAccountFreeze(ctx sdk.Context, accountFreeze types.AccountFreeze) {
+	issuer := sdk.MustAccAddressFromBech32(accountFreeze.Issuer)
+	account := sdk.MustAccAddressFromBech32(accountFreeze.Account)
+	ctx.KVStore(k.storeKey).Set(types.GetAccountFreezeKey(issuer, account), k.cdc.MustMarshal(&accountFreeze))
+}
+
+func (k Keeper) removeAccountFreeze(ctx sdk.Context, issuer, account sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetAccountFreezeKey(issuer, account))
+}
+
+// IsAccountFrozen returns true if all the tokens of the issuer held by the account are frozen.
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, issuer, account sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAccountFreezeKey(issuer, account))
+}
+
+// GetFrozenAccounts returns the accounts which all the tokens of the issuer are frozen on.
+func (k Keeper) GetFrozenAccounts(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.AccountFreeze, *query.PageResponse, error) {
+	accountFreezes := []types.AccountFreeze{}
+	pageRes, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateAccountFreezesPrefix(issuer)),
+		pagination,
+		func(key, value []byte) error {
+			var accountFreeze types.AccountFreeze
+			if err := k.cdc.Unmarshal(value, &accountFreeze); err != nil {
+				return err
+			}
+			accountFreezes = append(accountFreezes, accountFreeze)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accountFreezes, pageRes, nil
+}
+
+// GetAllAccountFreezes returns the accounts frozen by all the issuers.
+func (k Keeper) GetAllAccountFreezes(ctx sdk.Context) []types.AccountFreeze {
+	accountFreezes := []types.AccountFreeze{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountFreezeKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accountFreeze types.AccountFreeze
+		k.cdc.MustUnmarshal(iterator.Value(), &accountFreeze)
+		accountFreezes = append(accountFreezes, accountFreeze)
+	}
+
+	return accountFreezes
+}
+
+// isFrozenByIssuer returns true if the token held by the account is frozen by the account freeze of its issuer. The
+// freeze applies to the tokens with the freezable feature enabled as long as the issuer holds their privileges.
+func (k Keeper) isFrozenByIssuer(ctx sdk.Context, addr sdk.AccAddress, ft types.FTDefinition) bool {
+	if !ft.IsFeatureEnabled(types.TokenFeature_freeze) { //nolint:nosnakecase
+		return false
+	}
+	if k.getAdmin(ctx, ft) != ft.Issuer {
+		return false
+	}
+
+	return k.IsAccountFrozen(ctx, sdk.MustAccAddressFromBech32(ft.Issuer), addr)
+}
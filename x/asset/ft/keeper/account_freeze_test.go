@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_FreezeAccount(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherIssuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issue := func(issuer sdk.AccAddress, subunit string, features ...types.TokenFeature) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			Features:      features,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	denom := issue(issuer, "abc", types.TokenFeature_freeze)            //nolint:nosnakecase
+	transferredDenom := issue(issuer, "def", types.TokenFeature_freeze) //nolint:nosnakecase
+	unfreezableDenom := issue(issuer, "ghi")
+	otherDenom := issue(otherIssuer, "jkl", types.TokenFeature_freeze) //nolint:nosnakecase
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, transferredDenom, otherIssuer, 0))
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(
+		sdk.NewInt64Coin(denom, 100),
+		sdk.NewInt64Coin(transferredDenom, 100),
+		sdk.NewInt64Coin(unfreezableDenom, 100),
+	)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, otherIssuer, account, sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 100))))
+
+	// the issuer can't freeze itself
+	requireT.True(types.ErrInvalidInput.Is(ftKeeper.FreezeAccount(ctx, issuer, issuer)))
+
+	requireT.NoError(ftKeeper.FreezeAccount(ctx, issuer, account))
+	requireT.True(ftKeeper.IsAccountFrozen(ctx, issuer, account))
+	requireT.False(ftKeeper.IsAccountFrozen(ctx, otherIssuer, account))
+	frozenAccounts, _, err := ftKeeper.GetFrozenAccounts(ctx, issuer, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.AccountFreeze{{Issuer: issuer.String(), Account: account.String()}}, frozenAccounts)
+
+	// the freezable tokens of the issuer can't be sent, the rest of the tokens is not affected
+	requireT.True(types.ErrAccountFrozen.Is(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+	))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(
+		sdk.NewInt64Coin(transferredDenom, 1),
+		sdk.NewInt64Coin(unfreezableDenom, 1),
+		sdk.NewInt64Coin(otherDenom, 1),
+	)))
+
+	// the account might still receive the frozen tokens
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+
+	// the tokens issued after the freeze are frozen too
+	newDenom := issue(issuer, "mno", types.TokenFeature_freeze) //nolint:nosnakecase
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(newDenom, 10))))
+	requireT.True(types.ErrAccountFrozen.Is(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(newDenom, 1))),
+	))
+
+	// the amounts frozen per denom stay frozen after the account is unfrozen
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(ftKeeper.UnfreezeAccount(ctx, issuer, account))
+	requireT.False(ftKeeper.IsAccountFrozen(ctx, issuer, account))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 100), bankKeeper.GetBalance(ctx, account, denom))
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// TransferAdmin transfers the issuer privileges of the fungible token to the account. If the grace period is positive,
+// the transfer is scheduled to take effect once it elapses and the current admin keeps the privileges until then.
+// The transfer scheduled before is replaced, and the transfer to the current admin cancels it.
+func (k Keeper) TransferAdmin(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	account sdk.AccAddress,
+	gracePeriod time.Duration,
+) error {
+	if gracePeriod < 0 {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "grace period must not be negative")
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := k.checkAdmin(ctx, sender, ft); err != nil {
+		return err
+	}
+
+	if account.Equals(sender) {
+		return k.cancelPendingAdminTransfer(ctx, denom)
+	}
+
+	k.deletePendingAdminTransfer(ctx, denom)
+	if gracePeriod == 0 {
+		return k.setAdmin(ctx, denom, sender.String(), account.String())
+	}
+
+	activationTime := ctx.BlockTime().Add(gracePeriod)
+	k.SetPendingAdminTransfer(ctx, types.PendingAdminTransfer{
+		Denom:          denom,
+		Account:        account.String(),
+		ActivationTime: activationTime,
+	})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, sender.String(), account.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferScheduled{
+		Denom:          denom,
+		Admin:          sender.String(),
+		PendingAdmin:   account.String(),
+		ActivationTime: activationTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventAdminTransferScheduled: %s", err)
+	}
+
+	return nil
+}
+
+// ClearAdmin removes the admin of the fungible token, so nobody holds its issuer privileges anymore. The admin
+// transfer scheduled before is canceled.
+func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := k.checkAdmin(ctx, sender, ft); err != nil {
+		return err
+	}
+
+	k.deletePendingAdminTransfer(ctx, denom)
+	k.SetTokenAdmin(ctx, types.TokenAdmin{Denom: denom})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventAdminCleared: %s", err)
+	}
+
+	return nil
+}
+
+// GetAdmin returns the address holding the issuer privileges of the denom. The empty string is returned if the admin
+// has been cleared.
+func (k Keeper) GetAdmin(ctx sdk.Context, denom string) (string, error) {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	return k.getAdmin(ctx, ft), nil
+}
+
+// SetTokenAdmin stores the admin of the denom replacing the issuer in holding its privileges.
+func (k Keeper) SetTokenAdmin(ctx sdk.Context, tokenAdmin types.TokenAdmin) {
+	ctx.KVStore(k.storeKey).Set(types.GetTokenAdminKey(tokenAdmin.Denom), k.cdc.MustMarshal(&tokenAdmin))
+}
+
+// GetTokenAdmins returns the admins of all the denoms which issuer privileges have been transferred or cleared.
+func (k Keeper) GetTokenAdmins(ctx sdk.Context) []types.TokenAdmin {
+	tokenAdmins := []types.TokenAdmin{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenAdminKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenAdmin types.TokenAdmin
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenAdmin)
+		tokenAdmins = append(tokenAdmins, tokenAdmin)
+	}
+
+	return tokenAdmins
+}
+
+// GetPendingAdminTransfer returns the admin transfer of the denom scheduled to take effect in the future.
+func (k Keeper) GetPendingAdminTransfer(ctx sdk.Context, denom string) (types.PendingAdminTransfer, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingAdminTransferKey(denom))
+	if bz == nil {
+		return types.PendingAdminTransfer{}, false
+	}
+
+	var pendingAdminTransfer types.PendingAdminTransfer
+	k.cdc.MustUnmarshal(bz, &pendingAdminTransfer)
+	return pendingAdminTransfer, true
+}
+
+// GetPendingAdminTransfers returns all the admin transfers scheduled to take effect in the future.
+func (k Keeper) GetPendingAdminTransfers(ctx sdk.Context) []types.PendingAdminTransfer {
+	pendingAdminTransfers := []types.PendingAdminTransfer{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAdminTransferKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingAdminTransfer types.PendingAdminTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingAdminTransfer)
+		pendingAdminTransfers = append(pendingAdminTransfers, pendingAdminTransfer)
+	}
+
+	return pendingAdminTransfers
+}
+
+// SetPendingAdminTransfer stores the scheduled admin transfer together with its entry in the activation queue.
+func (k Keeper) SetPendingAdminTransfer(ctx sdk.Context, pendingAdminTransfer types.PendingAdminTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPendingAdminTransferKey(pendingAdminTransfer.Denom)
+	store.Set(key, k.cdc.MustMarshal(&pendingAdminTransfer))
+	store.Set(types.GetPendingAdminTransferQueueKey(pendingAdminTransfer.Denom, pendingAdminTransfer.ActivationTime), key)
+}
+
+func (k Keeper) deletePendingAdminTransfer(ctx sdk.Context, denom string) {
+	pendingAdminTransfer, found := k.GetPendingAdminTransfer(ctx, denom)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingAdminTransferKey(denom))
+	store.Delete(types.GetPendingAdminTransferQueueKey(denom, pendingAdminTransfer.ActivationTime))
+}
+
+func (k Keeper) cancelPendingAdminTransfer(ctx sdk.Context, denom string) error {
+	pendingAdminTransfer, found := k.GetPendingAdminTransfer(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "no admin transfer of %s is scheduled", denom)
+	}
+
+	k.deletePendingAdminTransfer(ctx, denom)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, pendingAdminTransfer.Account))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferCanceled{
+		Denom:        denom,
+		PendingAdmin: pendingAdminTransfer.Account,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventAdminTransferCanceled: %s", err)
+	}
+
+	return nil
+}
+
+// activatePendingAdminTransfers transfers the privileges of the tokens which admin transfers are due by the current
+// block time.
+func (k Keeper) activatePendingAdminTransfers(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.PendingAdminTransferQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.CreatePendingAdminTransferQueuePrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var pendingAdminTransfers []types.PendingAdminTransfer
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingAdminTransfer types.PendingAdminTransfer
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &pendingAdminTransfer)
+		pendingAdminTransfers = append(pendingAdminTransfers, pendingAdminTransfer)
+	}
+
+	for _, pendingAdminTransfer := range pendingAdminTransfers {
+		ft, err := k.GetTokenDefinition(ctx, pendingAdminTransfer.Denom)
+		if err != nil {
+			return err
+		}
+
+		k.deletePendingAdminTransfer(ctx, pendingAdminTransfer.Denom)
+		if err := k.setAdmin(ctx, ft.Denom, k.getAdmin(ctx, ft), pendingAdminTransfer.Account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setAdmin(ctx sdk.Context, denom, previousAdmin, admin string) error {
+	k.SetTokenAdmin(ctx, types.TokenAdmin{
+		Denom: denom,
+		Admin: admin,
+	})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, previousAdmin, admin))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: previousAdmin,
+		CurrentAdmin:  admin,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventAdminTransferred: %s", err)
+	}
+
+	return nil
+}
+
+// getAdmin returns the admin of the token, the issuer is the admin until the privileges are transferred or cleared.
+func (k Keeper) getAdmin(ctx sdk.Context, ft types.FTDefinition) string {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenAdminKey(ft.Denom))
+	if bz == nil {
+		return ft.Issuer
+	}
+
+	var tokenAdmin types.TokenAdmin
+	k.cdc.MustUnmarshal(bz, &tokenAdmin)
+	return tokenAdmin.Admin
+}
+
+func (k Keeper) checkAdmin(ctx sdk.Context, sender sdk.AccAddress, ft types.FTDefinition) error {
+	if admin := k.getAdmin(ctx, ft); admin == "" || admin != sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to perform this operation", sender.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_TransferAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ADMIN",
+		Subunit:       "admin",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(777),
+		Features: []types.TokenFeature{
+			types.TokenFeature_mint,   //nolint:nosnakecase
+			types.TokenFeature_freeze, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	// the issuer is the admin of the issued token
+	admin, err := ftKeeper.GetAdmin(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), admin)
+
+	// only the admin can transfer the privileges
+	newAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.TransferAdmin(ctx, randomAddr, denom, newAdmin, 0)))
+
+	// the privileges are transferred immediately without the grace period
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, denom, newAdmin, 0))
+	admin, err = ftKeeper.GetAdmin(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(newAdmin.String(), admin)
+
+	// the issuer has lost the privileges, the new admin has got them
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 10))))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.GloballyFreeze(ctx, issuer, denom)))
+	requireT.NoError(ftKeeper.Mint(ctx, newAdmin, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, newAdmin, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 10), testApp.BankKeeper.GetBalance(ctx, newAdmin, denom))
+}
+
+func TestKeeper_TransferAdminWithGracePeriod(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ADMIN",
+		Subunit:       "admin",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(777),
+		Features:      []types.TokenFeature{types.TokenFeature_mint}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	// there is nothing to cancel
+	requireT.True(types.ErrInvalidInput.Is(ftKeeper.TransferAdmin(ctx, issuer, denom, issuer, 0)))
+
+	// the scheduled transfer is replaced by the next one
+	wrongAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, denom, wrongAdmin, time.Hour))
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, denom, newAdmin, 2*time.Hour))
+	pendingAdminTransfer, found := ftKeeper.GetPendingAdminTransfer(ctx, denom)
+	requireT.True(found)
+	requireT.Equal(types.PendingAdminTransfer{
+		Denom:          denom,
+		Account:        newAdmin.String(),
+		ActivationTime: now.Add(2 * time.Hour),
+	}, pendingAdminTransfer)
+	requireT.Len(ftKeeper.GetPendingAdminTransfers(ctx), 1)
+
+	// the issuer keeps the privileges during the grace period
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 10)))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.Mint(ctx, newAdmin, sdk.NewInt64Coin(denom, 10))))
+
+	// the privileges are transferred by the end blocker once the grace period elapses
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	admin, err := ftKeeper.GetAdmin(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(newAdmin.String(), admin)
+	_, found = ftKeeper.GetPendingAdminTransfer(ctx, denom)
+	requireT.False(found)
+	requireT.NoError(ftKeeper.Mint(ctx, newAdmin, sdk.NewInt64Coin(denom, 10)))
+
+	// the transfer to the current admin cancels the scheduled one
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, newAdmin, denom, issuer, time.Hour))
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, newAdmin, denom, newAdmin, 0))
+	requireT.Empty(ftKeeper.GetPendingAdminTransfers(ctx))
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	admin, err = ftKeeper.GetAdmin(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(newAdmin.String(), admin)
+}
+
+func TestKeeper_ClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ADMIN",
+		Subunit:       "admin",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(777),
+		Features:      []types.TokenFeature{types.TokenFeature_mint}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	// only the admin can clear the admin
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.ClearAdmin(ctx, randomAddr, denom)))
+
+	// the scheduled transfer is canceled by clearing the admin
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, denom, randomAddr, time.Hour))
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+	requireT.Empty(ftKeeper.GetPendingAdminTransfers(ctx))
+
+	admin, err := ftKeeper.GetAdmin(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(admin)
+
+	// nobody holds the privileges anymore
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 10))))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.TransferAdmin(ctx, issuer, denom, issuer, 0)))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.ClearAdmin(ctx, issuer, denom)))
+}
+
+func TestKeeper_SendAfterAdminTransfer(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ADMIN",
+		Subunit:            "admin",
+		Precision:          6,
+		InitialAmount:      sdk.NewInt(1000),
+		Features:           []types.TokenFeature{types.TokenFeature_whitelist}, //nolint:nosnakecase
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	})
+	requireT.NoError(err)
+
+	newAdmin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, newAdmin, sdk.NewInt64Coin(denom, 1000)))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 1000)))
+
+	// the issuer sends to the new admin without being charged
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, newAdmin, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 700), bankKeeper.GetBalance(ctx, issuer, denom))
+
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, denom, newAdmin, 0))
+
+	// the issuer isn't exempted from the whitelisted limits anymore
+	err = bankKeeper.SendCoins(ctx, newAdmin, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(err, types.ErrWhitelistedLimitExceeded)
+
+	// the issuer is charged the burn rate and the commission, the commission is sent to the new admin
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 570), bankKeeper.GetBalance(ctx, issuer, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 100), bankKeeper.GetBalance(ctx, recipient, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 320), bankKeeper.GetBalance(ctx, newAdmin, denom))
+
+	// the new admin is exempted
+	requireT.NoError(bankKeeper.SendCoins(ctx, newAdmin, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 220), bankKeeper.GetBalance(ctx, newAdmin, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 200), bankKeeper.GetBalance(ctx, recipient, denom))
+
+	// once the admin is cleared, nobody is exempted from the burn rate and the commission isn't charged
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, newAdmin, denom))
+	requireT.NoError(bankKeeper.SendCoins(ctx, newAdmin, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 110), bankKeeper.GetBalance(ctx, newAdmin, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 300), bankKeeper.GetBalance(ctx, recipient, denom))
+}
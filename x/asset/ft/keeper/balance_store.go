@@ -4,8 +4,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/CoreumFoundation/coreum/pkg/store"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
@@ -66,6 +68,66 @@ func (s balanceStore) SetBalance(coin sdk.Coin) {
 	}
 }
 
+// setBalancesBatch validates the balances of the accounts and writes them to the store. All the balances are validated
+// before anything is written, then each coin is written with a single store operation, without reading or deleting
+// the previous value, so the batch is meant to fill the empty store, e.g. in genesis.
+func setBalancesBatch(
+	cdc codec.BinaryCodec,
+	kvStore sdk.KVStore,
+	accountPrefix func(addr []byte) []byte,
+	balances []types.Balance,
+) error {
+	addresses := make([]sdk.AccAddress, 0, len(balances))
+	seen := make(map[string]struct{}, len(balances))
+	// the balances of the accounts share a few denoms, so each of them is validated once
+	validDenoms := map[string]struct{}{}
+	for _, balance := range balances {
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address %s: %s", balance.Address, err)
+		}
+		if _, exists := seen[balance.Address]; exists {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "duplicate balances of %s", balance.Address)
+		}
+		seen[balance.Address] = struct{}{}
+		if err := validateBatchCoins(balance.Coins, validDenoms); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid balances of %s: %s", balance.Address, err)
+		}
+		addresses = append(addresses, addr)
+	}
+
+	for i, balance := range balances {
+		accountKey := accountPrefix(addresses[i])
+		for _, coin := range balance.Coins {
+			coin := coin
+			kvStore.Set(store.JoinKeys(accountKey, []byte(coin.Denom)), cdc.MustMarshal(&coin))
+		}
+	}
+
+	return nil
+}
+
+// validateBatchCoins performs the same checks as sdk.Coins.Validate, but validates each denom once only, since the
+// validation of the denom against the regular expression dominates the cost of importing large number of balances.
+func validateBatchCoins(coins sdk.Coins, validDenoms map[string]struct{}) error {
+	for i, coin := range coins {
+		if _, ok := validDenoms[coin.Denom]; !ok {
+			if err := sdk.ValidateDenom(coin.Denom); err != nil {
+				return err
+			}
+			validDenoms[coin.Denom] = struct{}{}
+		}
+		if coin.Amount.IsNil() || !coin.Amount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coin %s amount is not positive", coin)
+		}
+		if i > 0 && coins[i-1].Denom >= coin.Denom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denomination %s is not sorted or duplicated", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 func collectBalances(cdc codec.BinaryCodec, store sdk.KVStore, pagination *query.PageRequest) ([]types.Balance, *query.PageResponse, error) {
 	var balances []types.Balance
 	mapAddressToBalancesIdx := make(map[string]int)
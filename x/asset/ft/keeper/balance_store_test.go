@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+type balancesBatchSetter struct {
+	name        string
+	setBatch    func(k keeper.Keeper, ctx sdk.Context, balances []types.Balance) error
+	setBalances func(k keeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins)
+	getBalances func(k keeper.Keeper, ctx sdk.Context) ([]types.Balance, error)
+}
+
+var balancesBatchSetters = []balancesBatchSetter{
+	{
+		name:        "frozen",
+		setBatch:    keeper.Keeper.SetFrozenBalancesBatch,
+		setBalances: keeper.Keeper.SetFrozenBalances,
+		getBalances: func(k keeper.Keeper, ctx sdk.Context) ([]types.Balance, error) {
+			balances, _, err := k.GetAccountsFrozenBalances(ctx, &query.PageRequest{Limit: query.MaxLimit})
+			return balances, err
+		},
+	},
+	{
+		name:        "whitelisted",
+		setBatch:    keeper.Keeper.SetWhitelistedBalancesBatch,
+		setBalances: keeper.Keeper.SetWhitelistedBalances,
+		getBalances: func(k keeper.Keeper, ctx sdk.Context) ([]types.Balance, error) {
+			balances, _, err := k.GetAccountsWhitelistedBalances(ctx, &query.PageRequest{Limit: query.MaxLimit})
+			return balances, err
+		},
+	},
+}
+
+func TestKeeper_SetBalancesBatch(t *testing.T) {
+	for _, setter := range balancesBatchSetters {
+		setter := setter
+		t.Run(setter.name, func(t *testing.T) {
+			requireT := require.New(t)
+
+			testApp := simapp.New()
+			ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+			ftKeeper := testApp.AssetFTKeeper
+
+			balances := genBalances(3, 2)
+			requireT.NoError(setter.setBatch(ftKeeper, ctx, balances))
+
+			storedBalances, err := setter.getBalances(ftKeeper, ctx)
+			requireT.NoError(err)
+			requireT.ElementsMatch(balances, storedBalances)
+		})
+	}
+}
+
+func TestKeeper_SetBalancesBatchInvalid(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	testCases := []struct {
+		name     string
+		balances []types.Balance
+	}{
+		{
+			name:     "invalid address",
+			balances: []types.Balance{{Address: "invalid", Coins: sdk.NewCoins(sdk.NewInt64Coin("abc", 1))}},
+		},
+		{
+			name: "duplicate address",
+			balances: []types.Balance{
+				{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("abc", 1))},
+				{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("def", 1))},
+			},
+		},
+		{
+			name:     "invalid denom",
+			balances: []types.Balance{{Address: addr, Coins: sdk.Coins{sdk.NewInt64Coin("abc", 1), {Denom: "1", Amount: sdk.NewInt(1)}}}},
+		},
+		{
+			name:     "zero amount",
+			balances: []types.Balance{{Address: addr, Coins: sdk.Coins{sdk.NewInt64Coin("abc", 0)}}},
+		},
+		{
+			name: "unsorted coins",
+			balances: []types.Balance{{Address: addr, Coins: sdk.Coins{
+				sdk.NewInt64Coin("def", 1),
+				sdk.NewInt64Coin("abc", 1),
+			}}},
+		},
+	}
+
+	for _, setter := range balancesBatchSetters {
+		for _, tc := range testCases {
+			setter, tc := setter, tc
+			t.Run(setter.name+"/"+tc.name, func(t *testing.T) {
+				requireT := require.New(t)
+
+				testApp := simapp.New()
+				ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+				ftKeeper := testApp.AssetFTKeeper
+
+				// the valid balance preceding the invalid one isn't stored
+				balances := append(genBalances(1, 1), tc.balances...)
+				requireT.True(types.ErrInvalidInput.Is(setter.setBatch(ftKeeper, ctx, balances)))
+
+				storedBalances, err := setter.getBalances(ftKeeper, ctx)
+				requireT.NoError(err)
+				requireT.Empty(storedBalances)
+			})
+		}
+	}
+}
+
+func BenchmarkSetBalances(b *testing.B) {
+	const (
+		accounts = 10_000
+		denoms   = 3
+	)
+	balances := genBalances(accounts, denoms)
+
+	for _, setter := range balancesBatchSetters {
+		setter := setter
+		b.Run(fmt.Sprintf("%s/batch", setter.name), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				testApp := simapp.New()
+				ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+				b.StartTimer()
+
+				require.NoError(b, setter.setBatch(testApp.AssetFTKeeper, ctx, balances))
+			}
+		})
+		b.Run(fmt.Sprintf("%s/per-account", setter.name), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				testApp := simapp.New()
+				ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+				b.StartTimer()
+
+				for _, balance := range balances {
+					setter.setBalances(
+						testApp.AssetFTKeeper,
+						ctx,
+						sdk.MustAccAddressFromBech32(balance.Address),
+						balance.Coins,
+					)
+				}
+			}
+		})
+	}
+}
+
+func genBalances(accounts, denoms int) []types.Balance {
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	balances := make([]types.Balance, 0, accounts)
+	for i := 0; i < accounts; i++ {
+		coins := make(sdk.Coins, 0, denoms)
+		for j := 0; j < denoms; j++ {
+			coins = append(coins, sdk.NewInt64Coin(types.BuildDenom(fmt.Sprintf("denom%d", j), issuer), int64(i+1)))
+		}
+		balances = append(balances, types.Balance{
+			Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Coins:   coins,
+		})
+	}
+
+	return balances
+}
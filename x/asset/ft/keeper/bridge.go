@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// BridgeMint mints the fungible token transferred from the external chain to the recipient.
+// Signatures of all the provided attestations are verified, the threshold of the attesters is enforced
+// by the BridgeAuthorization the bridge uses to execute the message on behalf of the issuer.
+func (k Keeper) BridgeMint(ctx sdk.Context, settings types.BridgeMintSettings) error {
+	if err := types.ValidateTransferID(settings.TransferID); err != nil {
+		return err
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, settings.Coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", settings.Coin.Denom)
+	}
+
+	if err := k.checkFeatureAllowed(ctx, settings.Sender, ft, types.TokenFeature_mint); err != nil { //nolint:nosnakecase
+		return err
+	}
+
+	if _, found := k.GetBridgeMintRecord(ctx, settings.Coin.Denom, settings.TransferID); found {
+		return sdkerrors.Wrapf(types.ErrTransferAlreadyMinted, "transfer %q of %s", settings.TransferID, settings.Coin.Denom)
+	}
+
+	if err := types.ValidateBridgeAttestations(settings.Attestations); err != nil {
+		return err
+	}
+	signBytes := types.BridgeMintSignBytes(ctx.ChainID(), settings.TransferID, settings.Recipient, settings.Coin)
+	attesters := make([]string, 0, len(settings.Attestations))
+	for _, attestation := range settings.Attestations {
+		if err := attestation.Verify(signBytes); err != nil {
+			return err
+		}
+		attesters = append(attesters, attestation.Attester)
+	}
+
+	if err := k.mint(ctx, ft, settings.Coin.Amount, settings.Recipient); err != nil {
+		return err
+	}
+
+	record := types.BridgeMintRecord{
+		TransferID: settings.TransferID,
+		Recipient:  settings.Recipient.String(),
+		Coin:       settings.Coin,
+		Attesters:  attesters,
+	}
+	k.SetBridgeMintRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(record.Coin.Denom, record.Recipient))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeMinted{
+		TransferID: record.TransferID,
+		Recipient:  record.Recipient,
+		Coin:       record.Coin,
+		Attesters:  record.Attesters,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventBridgeMinted: %s", err)
+	}
+
+	return nil
+}
+
+// BridgeBurn burns the fungible token to be released on the external chain by the bridge.
+func (k Keeper) BridgeBurn(ctx sdk.Context, settings types.BridgeBurnSettings) error {
+	if err := types.ValidateDestination(settings.Destination); err != nil {
+		return err
+	}
+
+	if err := k.Burn(ctx, settings.Sender, settings.Coin); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.Coin.Denom, settings.Sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBridgeBurnt{
+		Sender:      settings.Sender.String(),
+		Coin:        settings.Coin,
+		Destination: settings.Destination,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventBridgeBurnt: %s", err)
+	}
+
+	return nil
+}
+
+// GetBridgeMintRecord returns the record of the transfer minted by the bridge.
+func (k Keeper) GetBridgeMintRecord(ctx sdk.Context, denom, transferID string) (types.BridgeMintRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBridgeMintRecordKey(denom, transferID))
+	if bz == nil {
+		return types.BridgeMintRecord{}, false
+	}
+
+	var record types.BridgeMintRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetBridgeMintRecords returns the records of all the transfers minted by the bridges.
+func (k Keeper) GetBridgeMintRecords(ctx sdk.Context) []types.BridgeMintRecord {
+	records := []types.BridgeMintRecord{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.BridgeMintRecordKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BridgeMintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetBridgeMintRecord stores the record of the transfer minted by the bridge.
+func (k Keeper) SetBridgeMintRecord(ctx sdk.Context, record types.BridgeMintRecord) {
+	ctx.KVStore(k.storeKey).Set(types.GetBridgeMintRecordKey(record.Coin.Denom, record.TransferID), k.cdc.MustMarshal(&record))
+}
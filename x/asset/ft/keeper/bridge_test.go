@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func attest(
+	t *testing.T,
+	attester *secp256k1.PrivKey,
+	chainID, transferID string,
+	recipient sdk.AccAddress,
+	coin sdk.Coin,
+) types.BridgeAttestation {
+	signature, err := attester.Sign(types.BridgeMintSignBytes(chainID, transferID, recipient, coin))
+	require.NoError(t, err)
+	return types.BridgeAttestation{
+		Attester:  sdk.AccAddress(attester.PubKey().Address()).String(),
+		PubKey:    attester.PubKey().Bytes(),
+		Signature: signature,
+	}
+}
+
+func TestKeeper_BridgeMint(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{ChainID: "test-chain"})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	attester1 := secp256k1.GenPrivKey()
+	attester2 := secp256k1.GenPrivKey()
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "WBTC",
+		Subunit:       "wsatoshi",
+		InitialAmount: sdk.ZeroInt(),
+		Features: []types.TokenFeature{
+			types.TokenFeature_mint, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	coin := sdk.NewCoin(denom, sdk.NewInt(100))
+	settings := types.BridgeMintSettings{
+		Sender:     issuer,
+		Recipient:  recipient,
+		Coin:       coin,
+		TransferID: "0x1f2e3d",
+		Attestations: []types.BridgeAttestation{
+			attest(t, attester1, ctx.ChainID(), "0x1f2e3d", recipient, coin),
+			attest(t, attester2, ctx.ChainID(), "0x1f2e3d", recipient, coin),
+		},
+	}
+
+	// the attestation of other chain is rejected
+	invalidSettings := settings
+	invalidSettings.Attestations = []types.BridgeAttestation{
+		attest(t, attester1, "other-chain", "0x1f2e3d", recipient, coin),
+	}
+	requireT.ErrorIs(ftKeeper.BridgeMint(ctx, invalidSettings), types.ErrInvalidAttestation)
+
+	// the attestation of other amount is rejected
+	invalidSettings.Attestations = []types.BridgeAttestation{
+		attest(t, attester1, ctx.ChainID(), "0x1f2e3d", recipient, sdk.NewCoin(denom, sdk.NewInt(1000))),
+	}
+	requireT.ErrorIs(ftKeeper.BridgeMint(ctx, invalidSettings), types.ErrInvalidAttestation)
+
+	// only the issuer can mint
+	invalidSettings = settings
+	invalidSettings.Sender = recipient
+	requireT.ErrorIs(ftKeeper.BridgeMint(ctx, invalidSettings), sdkerrors.ErrUnauthorized)
+
+	requireT.NoError(ftKeeper.BridgeMint(ctx, settings))
+	requireT.Equal(coin.String(), bankKeeper.GetBalance(ctx, recipient, denom).String())
+
+	record, found := ftKeeper.GetBridgeMintRecord(ctx, denom, settings.TransferID)
+	requireT.True(found)
+	requireT.Equal(types.BridgeMintRecord{
+		TransferID: settings.TransferID,
+		Recipient:  recipient.String(),
+		Coin:       coin,
+		Attesters: []string{
+			sdk.AccAddress(attester1.PubKey().Address()).String(),
+			sdk.AccAddress(attester2.PubKey().Address()).String(),
+		},
+	}, record)
+
+	// the transfer can't be minted twice
+	requireT.ErrorIs(ftKeeper.BridgeMint(ctx, settings), types.ErrTransferAlreadyMinted)
+	requireT.Len(ftKeeper.GetBridgeMintRecords(ctx), 1)
+}
+
+func TestKeeper_BridgeBurn(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "WBTC",
+		Subunit:       "wsatoshi",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.TokenFeature{
+			types.TokenFeature_burn, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	settings := types.BridgeBurnSettings{
+		Sender:      issuer,
+		Coin:        sdk.NewCoin(denom, sdk.NewInt(400)),
+		Destination: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+	}
+
+	invalidSettings := settings
+	invalidSettings.Destination = ""
+	requireT.ErrorIs(ftKeeper.BridgeBurn(ctx, invalidSettings), types.ErrInvalidInput)
+
+	requireT.NoError(ftKeeper.BridgeBurn(ctx, settings))
+	requireT.Equal(sdk.NewInt(600).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(600).String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+}
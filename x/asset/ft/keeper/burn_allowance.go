@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// GrantBurnAllowance allows the spender to burn the coin from the owner account, replacing the allowance granted
+// before. The zero amount revokes the allowance.
+func (k Keeper) GrantBurnAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, coin sdk.Coin) error {
+	if coin.IsNil() || coin.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "burn allowance can't be negative")
+	}
+	if owner.Equals(spender) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "burn allowance can't be granted to the owner")
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+	if !ft.IsFeatureEnabled(types.TokenFeature_burn) { //nolint:nosnakecase
+		return sdkerrors.Wrapf(types.ErrFeatureNotActive, "denom:%s, feature:%s", ft.Denom, types.TokenFeature_burn) //nolint:nosnakecase
+	}
+
+	k.SetBurnAllowance(ctx, types.BurnAllowance{
+		Owner:   owner.String(),
+		Spender: spender.String(),
+		Coin:    coin,
+	})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(coin.Denom, owner.String(), spender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnAllowanceGranted{
+		Owner:   owner.String(),
+		Spender: spender.String(),
+		Coin:    coin,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventBurnAllowanceGranted: %s", err)
+	}
+
+	return nil
+}
+
+// BurnFrom burns the coin from the owner account using the burn allowance granted to the sender. The burning is the
+// privilege of the admin, so the sender must be the admin of the token, the allowance is the consent of the owner.
+func (k Keeper) BurnFrom(ctx sdk.Context, sender, owner sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "burn amount should be positive")
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_burn) //nolint:nosnakecase
+	if err != nil {
+		return err
+	}
+
+	allowance := k.GetBurnAllowance(ctx, owner, sender, coin.Denom)
+	if allowance.IsLT(coin) {
+		return sdkerrors.Wrapf(
+			types.ErrBurnAllowanceExceeded,
+			"burn allowance %s granted by %s is less than %s",
+			allowance,
+			owner,
+			coin,
+		)
+	}
+
+	if err := k.burn(ctx, owner, ft, coin.Amount); err != nil {
+		return err
+	}
+
+	remainingAllowance := allowance.Sub(coin)
+	k.SetBurnAllowance(ctx, types.BurnAllowance{
+		Owner:   owner.String(),
+		Spender: sender.String(),
+		Coin:    remainingAllowance,
+	})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(coin.Denom, owner.String(), sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnedFrom{
+		Owner:              owner.String(),
+		Spender:            sender.String(),
+		Coin:               coin,
+		RemainingAllowance: remainingAllowance,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventBurnedFrom: %s", err)
+	}
+
+	return nil
+}
+
+// SetBurnAllowance stores the burn allowance, the zero allowance is removed from the store.
+func (k Keeper) SetBurnAllowance(ctx sdk.Context, burnAllowance types.BurnAllowance) {
+	owner := sdk.MustAccAddressFromBech32(burnAllowance.Owner)
+	spender := sdk.MustAccAddressFromBech32(burnAllowance.Spender)
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBurnAllowanceKey(owner, spender, burnAllowance.Coin.Denom)
+	if burnAllowance.Coin.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&burnAllowance))
+}
+
+// GetBurnAllowance returns the amount of the denom the spender is allowed to burn from the owner account.
+func (k Keeper) GetBurnAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, denom string) sdk.Coin {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBurnAllowanceKey(owner, spender, denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var burnAllowance types.BurnAllowance
+	k.cdc.MustUnmarshal(bz, &burnAllowance)
+	return burnAllowance.Coin
+}
+
+// GetBurnAllowances returns the burn allowances granted by the owner.
+func (k Keeper) GetBurnAllowances(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.BurnAllowance, *query.PageResponse, error) {
+	burnAllowances := []types.BurnAllowance{}
+	pageRes, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateBurnAllowancesPrefix(owner)),
+		pagination,
+		func(key, value []byte) error {
+			var burnAllowance types.BurnAllowance
+			if err := k.cdc.Unmarshal(value, &burnAllowance); err != nil {
+				return err
+			}
+			burnAllowances = append(burnAllowances, burnAllowance)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return burnAllowances, pageRes, nil
+}
+
+// GetAllBurnAllowances returns the burn allowances granted by all the owners.
+func (k Keeper) GetAllBurnAllowances(ctx sdk.Context) []types.BurnAllowance {
+	burnAllowances := []types.BurnAllowance{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnAllowanceKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var burnAllowance types.BurnAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &burnAllowance)
+		burnAllowances = append(burnAllowances, burnAllowance)
+	}
+
+	return burnAllowances
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_BurnFrom(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(666),
+		Features: []types.TokenFeature{
+			types.TokenFeature_burn,   //nolint:nosnakecase
+			types.TokenFeature_freeze, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	unburnableDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(666),
+	})
+	requireT.NoError(err)
+
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	spender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(
+		sdk.NewInt64Coin(denom, 100),
+		sdk.NewInt64Coin(unburnableDenom, 100),
+	)))
+
+	// the token must be burnable and the allowance can't be granted to the owner
+	requireT.True(types.ErrFeatureNotActive.Is(
+		ftKeeper.GrantBurnAllowance(ctx, holder, issuer, sdk.NewInt64Coin(unburnableDenom, 10)),
+	))
+	requireT.True(types.ErrInvalidInput.Is(
+		ftKeeper.GrantBurnAllowance(ctx, holder, holder, sdk.NewInt64Coin(denom, 10)),
+	))
+
+	// nothing can be burnt without the allowance
+	requireT.True(types.ErrBurnAllowanceExceeded.Is(
+		ftKeeper.BurnFrom(ctx, issuer, holder, sdk.NewInt64Coin(denom, 1)),
+	))
+
+	requireT.NoError(ftKeeper.GrantBurnAllowance(ctx, holder, issuer, sdk.NewInt64Coin(denom, 50)))
+	requireT.NoError(ftKeeper.GrantBurnAllowance(ctx, holder, spender, sdk.NewInt64Coin(denom, 50)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50), ftKeeper.GetBurnAllowance(ctx, holder, issuer, denom))
+
+	// the spender must be the admin
+	requireT.True(sdkerrors.ErrUnauthorized.Is(
+		ftKeeper.BurnFrom(ctx, spender, holder, sdk.NewInt64Coin(denom, 10)),
+	))
+
+	// the amount can't exceed the allowance
+	requireT.True(types.ErrBurnAllowanceExceeded.Is(
+		ftKeeper.BurnFrom(ctx, issuer, holder, sdk.NewInt64Coin(denom, 60)),
+	))
+
+	requireT.NoError(ftKeeper.BurnFrom(ctx, issuer, holder, sdk.NewInt64Coin(denom, 30)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 70), bankKeeper.GetBalance(ctx, holder, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 636), bankKeeper.GetSupply(ctx, denom))
+	requireT.Equal(sdk.NewInt64Coin(denom, 20), ftKeeper.GetBurnAllowance(ctx, holder, issuer, denom))
+
+	burnAllowances, _, err := ftKeeper.GetBurnAllowances(ctx, holder, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]types.BurnAllowance{
+		{Owner: holder.String(), Spender: issuer.String(), Coin: sdk.NewInt64Coin(denom, 20)},
+		{Owner: holder.String(), Spender: spender.String(), Coin: sdk.NewInt64Coin(denom, 50)},
+	}, burnAllowances)
+
+	// the frozen amount can't be burnt
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 60)))
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(
+		ftKeeper.BurnFrom(ctx, issuer, holder, sdk.NewInt64Coin(denom, 20)),
+	))
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 60)))
+
+	// the used up allowance is removed
+	requireT.NoError(ftKeeper.BurnFrom(ctx, issuer, holder, sdk.NewInt64Coin(denom, 20)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50), bankKeeper.GetBalance(ctx, holder, denom))
+	requireT.True(ftKeeper.GetBurnAllowance(ctx, holder, issuer, denom).IsZero())
+
+	// the zero amount revokes the allowance
+	requireT.NoError(ftKeeper.GrantBurnAllowance(ctx, holder, spender, sdk.NewInt64Coin(denom, 0)))
+	requireT.Empty(ftKeeper.GetAllBurnAllowances(ctx))
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// SetBurnRateExemption exempts the account from the burn rate of the denom or revokes the exemption. The tokens sent
+// from or to the exempted account aren't burnt, so the escrow accounts of the modules and the contracts don't tax the
+// funds moving in and out of them.
+func (k Keeper) SetBurnRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, exempt bool) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := k.checkAdmin(ctx, sender, ft); err != nil {
+		return err
+	}
+	if ft.BurnRate.IsNil() || !ft.BurnRate.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "denom %s has no burn rate", denom)
+	}
+
+	if exempt {
+		k.SetBurnRateExemptionRecord(ctx, types.BurnRateExemption{
+			Denom:   denom,
+			Account: addr.String(),
+		})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.GetBurnRateExemptionKey(denom, addr))
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, denom, types.AttributeValueActionBurnRateExemptionChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, addr.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnRateExemptionChanged{
+		Account: addr.String(),
+		Denom:   denom,
+		Exempt:  exempt,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventBurnRateExemptionChanged: %s", err)
+	}
+
+	return nil
+}
+
+// SetBurnRateExemptionRecord stores the exemption of the account from the burn rate.
+func (k Keeper) SetBurnRateExemptionRecord(ctx sdk.Context, exemption types.BurnRateExemption) {
+	addr := sdk.MustAccAddressFromBech32(exemption.Account)
+	ctx.KVStore(k.storeKey).Set(types.GetBurnRateExemptionKey(exemption.Denom, addr), k.cdc.MustMarshal(&exemption))
+}
+
+// IsBurnRateExempt returns true if the account is exempted from the burn rate of the denom.
+func (k Keeper) IsBurnRateExempt(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetBurnRateExemptionKey(denom, addr))
+}
+
+// GetBurnRateExemptions returns the accounts exempted from the burn rate of the denom.
+func (k Keeper) GetBurnRateExemptions(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	accounts := []string{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateBurnRateExemptionsPrefix(denom))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		accounts = append(accounts, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
+// GetAllBurnRateExemptions returns the exemptions from the burn rates of all the denoms.
+func (k Keeper) GetAllBurnRateExemptions(ctx sdk.Context) []types.BurnRateExemption {
+	exemptions := []types.BurnRateExemption{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnRateExemptionKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exemption types.BurnRateExemption
+		k.cdc.MustUnmarshal(iterator.Value(), &exemption)
+		exemptions = append(exemptions, exemption)
+	}
+
+	return exemptions
+}
+
+// calculateSendOutcome returns the balance changes caused by sending the amount from sender to recipient. Nothing is
+// burnt if the sender or the recipient is exempted from the burn rate.
+func (k Keeper) calculateSendOutcome(
+	ctx sdk.Context,
+	ft types.FTDefinition,
+	sender, recipient sdk.AccAddress,
+	amount sdk.Int,
+) types.SendOutcome {
+	outcome := ft.CalculateSendOutcome(k.getAdmin(ctx, ft), sender, recipient, amount)
+	if !outcome.Burnt.IsPositive() {
+		return outcome
+	}
+	if k.IsBurnRateExempt(ctx, sender, ft.Denom) || k.IsBurnRateExempt(ctx, recipient, ft.Denom) {
+		outcome.Sent = outcome.Sent.Sub(outcome.Burnt)
+		outcome.Burnt = sdk.ZeroInt()
+	}
+
+	return outcome
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_BurnRateExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := assetKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.25"),
+	})
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	escrow := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 600))))
+
+	// only the admin may exempt the accounts
+	err = assetKeeper.SetBurnRateExemption(ctx, recipient, escrow, denom, true)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	requireT.NoError(assetKeeper.SetBurnRateExemption(ctx, issuer, escrow, denom, true))
+	requireT.True(assetKeeper.IsBurnRateExempt(ctx, escrow, denom))
+
+	accounts, _, err := assetKeeper.GetBurnRateExemptions(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{escrow.String()}, accounts)
+	requireT.Equal([]types.BurnRateExemption{
+		{Denom: denom, Account: escrow.String()},
+	}, assetKeeper.GetAllBurnRateExemptions(ctx))
+
+	// send to the exempted account (burn must not apply)
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    400,
+		&recipient: 400,
+		&escrow:    200,
+	})
+
+	// send from the exempted account (burn must not apply)
+	requireT.NoError(bankKeeper.SendCoins(ctx, escrow, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    400,
+		&recipient: 500,
+		&escrow:    100,
+	})
+
+	// revoke the exemption (burn must apply)
+	requireT.NoError(assetKeeper.SetBurnRateExemption(ctx, issuer, escrow, denom, false))
+	requireT.False(assetKeeper.IsBurnRateExempt(ctx, escrow, denom))
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, escrow, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    400,
+		&recipient: 375,
+		&escrow:    200,
+	})
+
+	// the token without the burn rate can't have the exemptions
+	denomWithoutBurnRate, err := assetKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	err = assetKeeper.SetBurnRateExemption(ctx, issuer, escrow, denomWithoutBurnRate, true)
+	requireT.True(types.ErrInvalidInput.Is(err))
+}
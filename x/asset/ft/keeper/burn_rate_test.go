@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -167,6 +168,86 @@ func TestKeeper_BurnRate_BankSend(t *testing.T) {
 	})
 }
 
+func TestKeeper_BurnRate_MultiSend(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := assetKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	sender1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sender2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	escrow := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender1, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender2, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(assetKeeper.SetBurnRateExemption(ctx, issuer, escrow, denom, true))
+
+	// the input is taxed once, only for the share sent to the recipient, the outputs to the issuer and to the
+	// exempted account aren't taxed
+	requireT.NoError(bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: sender1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 200))}},
+		[]banktypes.Output{
+			{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 50))},
+			{Address: recipient.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 100))},
+			{Address: escrow.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 50))},
+		},
+	))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    400 + 50,
+		&sender1:   300 - 200 - 10,
+		&sender2:   300,
+		&recipient: 100,
+		&escrow:    50,
+	})
+
+	// the inputs are split across the outputs proportionally and each of them is rounded up separately
+	requireT.NoError(bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{
+			{Address: sender1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 10))},
+			{Address: sender2.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 20))},
+		},
+		[]banktypes.Output{
+			{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 10))},
+			{Address: recipient.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 20))},
+		},
+	))
+	// sender1: ceil(0.1 * 10 * 20 / 30) = 1, sender2: ceil(0.1 * 20 * 20 / 30) = 2
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    450 + 10,
+		&sender1:   90 - 10 - 1,
+		&sender2:   300 - 20 - 2,
+		&recipient: 100 + 20,
+		&escrow:    50,
+	})
+
+	// nothing is burnt from the exempted account
+	requireT.NoError(bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: escrow.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 50))}},
+		[]banktypes.Output{{Address: recipient.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 50))}},
+	))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    460,
+		&sender1:   79,
+		&sender2:   278,
+		&recipient: 170,
+	})
+}
+
 type bankAssertion struct {
 	t   require.TestingT
 	bk  keeper.BaseKeeperWrapper
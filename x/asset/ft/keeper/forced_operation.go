@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// ReconcileForcedDebit reconciles the records of the account the coins of the denom have been taken from by the
+// forced operation bypassing the freezing, e.g. the clawback or the recovery. The frozen amount exceeding the remaining
+// balance is unfrozen, so the frozen amount doesn't exceed the balance afterwards. It must be called after the coins
+// are taken.
+// Note: The frozen amount may exceed the balance when it's frozen in advance, so it's reconciled for the accounts
+// affected by the forced operation only.
+func (k Keeper) ReconcileForcedDebit(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+	frozenStore := k.frozenAccountBalanceStore(ctx, addr)
+	frozenBalance := frozenStore.Balance(denom)
+	if frozenBalance.Amount.LTE(balance.Amount) {
+		return nil
+	}
+
+	frozenStore.SetBalance(balance)
+	k.addFrozenTotal(ctx, denom, balance.Amount.Sub(frozenBalance.Amount))
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, denom, types.AttributeValueActionFrozenAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, addr.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
+		Account:        addr.String(),
+		PreviousAmount: frozenBalance,
+		CurrentAmount:  balance,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventFrozenAmountChanged: %s", err)
+	}
+
+	return nil
+}
+
+// ReconcileForcedCredit reconciles the records of the account the coins of the denom have been given to by the forced
+// operation bypassing the whitelisting, e.g. the recovery. The whitelisted limit is raised to the balance, so the
+// account isn't left above its limit. It must be called after the coins are given.
+func (k Keeper) ReconcileForcedCredit(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+	//nolint:nosnakecase
+	if !ft.IsFeatureEnabled(types.TokenFeature_whitelist) || k.getAdmin(ctx, ft) == addr.String() ||
+		k.IsWhitelistExempt(ctx, addr, denom) {
+		return nil
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+	whitelistedStore := k.whitelistedAccountBalanceStore(ctx, addr)
+	whitelistedBalance := whitelistedStore.Balance(denom)
+	if whitelistedBalance.Amount.GTE(balance.Amount) {
+		return nil
+	}
+
+	whitelistedStore.SetBalance(balance)
+	k.addWhitelistedTotal(ctx, denom, balance.Amount.Sub(whitelistedBalance.Amount))
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, denom, types.AttributeValueActionWhitelistedAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, addr.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWhitelistedAmountChanged{
+		Account:        addr.String(),
+		Denom:          denom,
+		PreviousAmount: whitelistedBalance.Amount,
+		CurrentAmount:  balance.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventWhitelistedAmountChanged: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_ReconcileForcedDebit(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+
+	// the frozen amount not exceeding the balance is kept
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(60))))
+	requireT.NoError(ftKeeper.ReconcileForcedDebit(ctx, account, denom))
+	requireT.Equal(sdk.NewInt(60).String(), ftKeeper.GetFrozenBalance(ctx, account, denom).Amount.String())
+
+	// the frozen amount exceeding the balance is reduced to the balance
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(90))))
+	requireT.NoError(ftKeeper.ReconcileForcedDebit(ctx, account, denom))
+	requireT.Equal(sdk.NewInt(100).String(), ftKeeper.GetFrozenBalance(ctx, account, denom).Amount.String())
+	stats, err := ftKeeper.GetTokenStats(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(100).String(), stats.Frozen.Amount.String())
+
+	// nothing is frozen on the account without the balance
+	emptyAccount := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, emptyAccount, sdk.NewCoin(denom, sdk.NewInt(10))))
+	requireT.NoError(ftKeeper.ReconcileForcedDebit(ctx, emptyAccount, denom))
+	requireT.True(ftKeeper.GetFrozenBalance(ctx, emptyAccount, denom).IsZero())
+}
+
+func TestKeeper_ReconcileForcedCredit(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.TokenFeature{types.TokenFeature_whitelist}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	// the account receives the coins above its limit while it's exempt
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(50))))
+	requireT.NoError(ftKeeper.SetWhitelistExemption(ctx, issuer, account, denom, true))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+
+	// the limit of the exempt account isn't changed
+	requireT.NoError(ftKeeper.ReconcileForcedCredit(ctx, account, denom))
+	requireT.Equal(sdk.NewInt(50).String(), ftKeeper.GetWhitelistedBalance(ctx, account, denom).Amount.String())
+
+	// the limit is raised to the balance
+	requireT.NoError(ftKeeper.SetWhitelistExemption(ctx, issuer, account, denom, false))
+	requireT.NoError(ftKeeper.ReconcileForcedCredit(ctx, account, denom))
+	requireT.Equal(sdk.NewInt(100).String(), ftKeeper.GetWhitelistedBalance(ctx, account, denom).Amount.String())
+
+	// the limit above the balance is kept
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, account, sdk.NewCoin(denom, sdk.NewInt(200))))
+	requireT.NoError(ftKeeper.ReconcileForcedCredit(ctx, account, denom))
+	requireT.Equal(sdk.NewInt(200).String(), ftKeeper.GetWhitelistedBalance(ctx, account, denom).Amount.String())
+
+	// the issuer isn't limited
+	requireT.NoError(ftKeeper.ReconcileForcedCredit(ctx, issuer, denom))
+	requireT.True(ftKeeper.GetWhitelistedBalance(ctx, issuer, denom).IsZero())
+}
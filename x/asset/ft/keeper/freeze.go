@@ -20,7 +20,7 @@ func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Co
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
@@ -29,7 +29,12 @@ func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Co
 	frozenBalance := frozenStore.Balance(coin.Denom)
 	newFrozenBalance := frozenBalance.Add(coin)
 	frozenStore.SetBalance(newFrozenBalance)
+	k.addFrozenTotal(ctx, coin.Denom, coin.Amount)
 
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, coin.Denom, types.AttributeValueActionFrozenAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(coin.Denom, addr.String()))
 	return ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
 		PreviousAmount: frozenBalance,
@@ -48,7 +53,7 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
@@ -65,7 +70,12 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 
 	newFrozenBalance := frozenBalance.Sub(coin)
 	frozenStore.SetBalance(newFrozenBalance)
+	k.addFrozenTotal(ctx, coin.Denom, coin.Amount.Neg())
 
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, coin.Denom, types.AttributeValueActionFrozenAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(coin.Denom, addr.String()))
 	return ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
 		PreviousAmount: frozenBalance,
@@ -73,18 +83,121 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 	})
 }
 
+// SetFrozenRate freezes the share of the balance of the denom held by the account at the time it is sent. The zero
+// rate removes the relative freeze.
+func (k Keeper) SetFrozenRate(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, rate sdk.Dec) error {
+	if err := types.ValidateFrozenRate(rate); err != nil {
+		return err
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	if err != nil {
+		return err
+	}
+
+	previousRate := k.GetFrozenRate(ctx, addr, denom)
+	if rate.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.GetFrozenRateKey(addr, denom))
+	} else {
+		k.SetFrozenRateRecord(ctx, types.FrozenRate{
+			Account: addr.String(),
+			Denom:   denom,
+			Rate:    rate,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, denom, types.AttributeValueActionFrozenAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, addr.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenRateChanged{
+		Account:      addr.String(),
+		Denom:        denom,
+		PreviousRate: previousRate,
+		CurrentRate:  rate,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventFrozenRateChanged: %s", err)
+	}
+
+	return nil
+}
+
+// SetFrozenRateRecord stores the share of the balance frozen on the account.
+func (k Keeper) SetFrozenRateRecord(ctx sdk.Context, frozenRate types.FrozenRate) {
+	addr := sdk.MustAccAddressFromBech32(frozenRate.Account)
+	ctx.KVStore(k.storeKey).Set(types.GetFrozenRateKey(addr, frozenRate.Denom), k.cdc.MustMarshal(&frozenRate))
+}
+
+// GetFrozenRate returns the share of the balance of the denom frozen on the account, zero if it's not set.
+func (k Keeper) GetFrozenRate(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFrozenRateKey(addr, denom))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var frozenRate types.FrozenRate
+	k.cdc.MustUnmarshal(bz, &frozenRate)
+	return frozenRate.Rate
+}
+
+// GetFrozenRateAmount returns the share of the balance of the denom frozen on the account and the amount of the current
+// balance it freezes.
+func (k Keeper) GetFrozenRateAmount(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Dec, sdk.Coin) {
+	rate := k.GetFrozenRate(ctx, addr, denom)
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+	return rate, sdk.NewCoin(denom, types.FrozenRateAmount(balance.Amount, rate))
+}
+
+// GetFrozenRates returns the shares of the balances frozen on all the accounts.
+func (k Keeper) GetFrozenRates(ctx sdk.Context) []types.FrozenRate {
+	frozenRates := []types.FrozenRate{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.FrozenRateKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var frozenRate types.FrozenRate
+		k.cdc.MustUnmarshal(iterator.Value(), &frozenRate)
+		frozenRates = append(frozenRates, frozenRate)
+	}
+
+	return frozenRates
+}
+
 // SetFrozenBalances sets the frozen balances of a specified account
 func (k Keeper) SetFrozenBalances(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
 	frozenStore := k.frozenAccountBalanceStore(ctx, addr)
 	for _, coin := range coins {
+		k.addFrozenTotal(ctx, coin.Denom, coin.Amount.Sub(frozenStore.Balance(coin.Denom).Amount))
 		frozenStore.SetBalance(coin)
 	}
 }
 
+// SetFrozenBalancesBatch validates and stores the frozen balances of many accounts at once. It is meant for
+// InitGenesis and the migrations filling the empty store, the existing balances of the accounts aren't cleared.
+func (k Keeper) SetFrozenBalancesBatch(ctx sdk.Context, balances []types.Balance) error {
+	if err := setBalancesBatch(k.cdc, ctx.KVStore(k.storeKey), types.CreateFrozenBalancesPrefix, balances); err != nil {
+		return err
+	}
+	for _, balance := range balances {
+		for _, coin := range balance.Coins {
+			k.addFrozenTotal(ctx, coin.Denom, coin.Amount)
+		}
+	}
+	return nil
+}
+
 // areCoinsSpendable returns an error if there are not enough coins balances to be spent
 func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, ft types.FTDefinition, amount sdk.Int) error {
-	if k.isGloballyFrozen(ctx, ft.Denom) {
-		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", ft.Denom)
+	if err := k.checkTokenStatus(ctx, addr, ft); err != nil {
+		return err
+	}
+	if k.isFrozenByIssuer(ctx, addr, ft) {
+		return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s of account %s is frozen by the issuer", ft.Denom, addr)
 	}
 
 	availableBalance := k.availableBalance(ctx, addr, ft.Denom)
@@ -101,11 +214,12 @@ func (k Keeper) availableBalance(ctx sdk.Context, addr sdk.AccAddress, denom str
 		return balance
 	}
 
-	frozenBalance := k.GetFrozenBalance(ctx, addr, denom)
-	if frozenBalance.IsGTE(balance) {
-		return sdk.NewCoin(denom, sdk.ZeroInt())
-	}
-	return balance.Sub(frozenBalance)
+	// the share frozen by the rate and the amounts frozen until the unfreeze time are frozen on top of the frozen
+	// amount
+	frozenAmount := k.GetFrozenBalance(ctx, addr, denom).Amount.
+		Add(types.FrozenRateAmount(balance.Amount, k.GetFrozenRate(ctx, addr, denom))).
+		Add(k.getTimedFrozenAmount(ctx, addr, denom))
+	return sdk.NewCoin(denom, types.AvailableAmount(balance.Amount, frozenAmount))
 }
 
 // GetFrozenBalance returns the frozen balance of a denom and account
@@ -155,3 +155,145 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	balance = bankKeeper.GetBalance(ctx, receiver2, denom)
 	requireT.Equal(sdk.NewCoin(denom, sdk.NewInt(100)), balance)
 }
+
+func TestKeeper_AccountNotificationEvents(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(777),
+		Features: []types.TokenFeature{
+			types.TokenFeature_freeze,    //nolint:nosnakecase
+			types.TokenFeature_whitelist, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	notifications := func(action func(ctx sdk.Context) error) []sdk.Event {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		requireT.NoError(action(ctx))
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeAccountNotification {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+	accountNotification := func(action string) []sdk.Event {
+		return []sdk.Event{types.NewAccountNotificationEvent(account, denom, action)}
+	}
+
+	coin := sdk.NewInt64Coin(denom, 10)
+	requireT.Equal(accountNotification(types.AttributeValueActionFrozenAmountChanged), notifications(func(ctx sdk.Context) error {
+		return ftKeeper.Freeze(ctx, issuer, account, coin)
+	}))
+	requireT.Equal(accountNotification(types.AttributeValueActionFrozenAmountChanged), notifications(func(ctx sdk.Context) error {
+		return ftKeeper.Unfreeze(ctx, issuer, account, coin)
+	}))
+	requireT.Equal(accountNotification(types.AttributeValueActionWhitelistedAmountChanged), notifications(func(ctx sdk.Context) error {
+		return ftKeeper.SetWhitelistedBalance(ctx, issuer, account, coin)
+	}))
+	requireT.Equal(accountNotification(types.AttributeValueActionWhitelistExemptionChanged), notifications(func(ctx sdk.Context) error {
+		return ftKeeper.SetWhitelistExemption(ctx, issuer, account, denom, true)
+	}))
+
+	// the global freeze notification isn't addressed to the particular account
+	globalFreezeNotification := []sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeAccountNotification,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAction, types.AttributeValueActionGlobalFreezeChanged),
+		),
+	}
+	requireT.Equal(globalFreezeNotification, notifications(func(ctx sdk.Context) error {
+		return ftKeeper.GloballyFreeze(ctx, issuer, denom)
+	}))
+	requireT.Equal(globalFreezeNotification, notifications(func(ctx sdk.Context) error {
+		return ftKeeper.GloballyUnfreeze(ctx, issuer, denom)
+	}))
+
+	// the notification isn't emitted if the action fails
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.Error(ftKeeper.Freeze(ctx, account, account, coin))
+	requireT.Empty(ctx.EventManager().Events())
+}
+
+func TestKeeper_SetFrozenRate(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+	unfreezableDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// only the issuer of the freezable token may set the rate
+	err = ftKeeper.SetFrozenRate(ctx, recipient, holder, denom, sdk.NewDecWithPrec(25, 2))
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = ftKeeper.SetFrozenRate(ctx, issuer, holder, unfreezableDenom, sdk.NewDecWithPrec(25, 2))
+	requireT.ErrorIs(err, types.ErrFeatureNotActive)
+	err = ftKeeper.SetFrozenRate(ctx, issuer, holder, denom, sdk.NewDecWithPrec(11, 1))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	requireT.NoError(ftKeeper.SetFrozenRate(ctx, issuer, holder, denom, sdk.NewDecWithPrec(25, 2)))
+	rate, frozenAmount := ftKeeper.GetFrozenRateAmount(ctx, holder, denom)
+	requireT.Equal(sdk.NewDecWithPrec(25, 2).String(), rate.String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 25).String(), frozenAmount.String())
+
+	// the share of the balance at the time of the send is frozen
+	err = bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 76)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 70))))
+	// 30 is left, 8 of them are frozen after rounding up
+	err = bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 23)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 22))))
+
+	// the share is frozen on top of the frozen amount
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, holder, sdk.NewInt64Coin(denom, 4)))
+	err = bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 3)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 2))))
+
+	requireT.Equal([]types.FrozenRate{
+		{Account: holder.String(), Denom: denom, Rate: sdk.NewDecWithPrec(25, 2)},
+	}, ftKeeper.GetFrozenRates(ctx))
+
+	// the zero rate removes the relative freeze
+	requireT.NoError(ftKeeper.SetFrozenRate(ctx, issuer, holder, denom, sdk.ZeroDec()))
+	requireT.True(ftKeeper.GetFrozenRate(ctx, holder, denom).IsZero())
+	requireT.Empty(ftKeeper.GetFrozenRates(ctx))
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 2))))
+}
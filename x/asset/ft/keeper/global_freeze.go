@@ -1,58 +1,223 @@
 package keeper
 
 import (
-	"bytes"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
-var globalFreezeEnabledStoreVal = []byte{0x00}
-
-// GloballyFreeze enables global freeze on a fungible token. This function is idempotent.
+// GloballyFreeze enables global freeze on a fungible token by suspending it. This function is idempotent.
+// The global freeze scheduled before is canceled.
 func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
 	ft, err := k.GetTokenDefinition(ctx, denom)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
 
-	k.SetGlobalFreeze(ctx, denom, true)
+	return k.setGlobalFreeze(ctx, denom, true)
+}
+
+// ScheduleGlobalFreeze schedules the global freeze of a fungible token to take effect at the activation time,
+// giving the holders the advance notice. The global freeze scheduled before is replaced.
+func (k Keeper) ScheduleGlobalFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string, activationTime time.Time) error {
+	if !activationTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "activation time %s must be after the current block time", activationTime)
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	if err != nil {
+		return err
+	}
+	if err := checkGlobalFreezeApplicable(denom, k.GetTokenStatus(ctx, denom)); err != nil {
+		return err
+	}
+
+	k.deletePendingGlobalFreeze(ctx, denom)
+	k.SetPendingGlobalFreeze(ctx, types.PendingGlobalFreeze{
+		Denom:          denom,
+		ActivationTime: activationTime,
+	})
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(nil, denom, types.AttributeValueActionGlobalFreezeScheduled),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGlobalFreezeScheduled{
+		Denom:          denom,
+		ActivationTime: activationTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventGlobalFreezeScheduled: %s", err)
+	}
+
 	return nil
 }
 
-// GloballyUnfreeze disables global freeze on a fungible token. This function is idempotent.
+// GloballyUnfreeze disables global freeze on a fungible token by reactivating it. This function is idempotent.
+// The global freeze scheduled before is canceled.
 func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
 	ft, err := k.GetTokenDefinition(ctx, denom)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
 
-	k.SetGlobalFreeze(ctx, denom, false)
-	return nil
+	return k.setGlobalFreeze(ctx, denom, false)
 }
 
-// SetGlobalFreeze enables/disables global freeze on a fungible token depending on frozen arg.
-func (k Keeper) SetGlobalFreeze(ctx sdk.Context, denom string, frozen bool) {
+// setGlobalFreeze suspends or reactivates the token depending on frozen arg. The draft and retired tokens can't be
+// globally frozen or unfrozen. The global freeze scheduled before is canceled.
+func (k Keeper) setGlobalFreeze(ctx sdk.Context, denom string, frozen bool) error {
+	current := k.GetTokenStatus(ctx, denom)
+	if err := checkGlobalFreezeApplicable(denom, current); err != nil {
+		return err
+	}
+
+	next := types.TokenStatus_active //nolint:nosnakecase
 	if frozen {
-		ctx.KVStore(k.storeKey).Set(types.CreateGlobalFreezePrefix(denom), globalFreezeEnabledStoreVal)
+		next = types.TokenStatus_suspended //nolint:nosnakecase
+	}
+	if current == next {
+		k.deletePendingGlobalFreeze(ctx, denom)
+		return k.emitGlobalFreezeChanged(ctx, denom, frozen)
+	}
+
+	return k.changeTokenStatus(ctx, denom, current, next)
+}
+
+func checkGlobalFreezeApplicable(denom string, status types.TokenStatus) error {
+	if status != types.TokenStatus_active && status != types.TokenStatus_suspended { //nolint:nosnakecase
+		return sdkerrors.Wrapf(types.ErrInvalidStatusTransition, "%s is %s, it can't be globally frozen or unfrozen", denom, status)
+	}
+	return nil
+}
+
+// GetPendingGlobalFreeze returns the global freeze of the denom scheduled to take effect in the future.
+func (k Keeper) GetPendingGlobalFreeze(ctx sdk.Context, denom string) (types.PendingGlobalFreeze, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingGlobalFreezeKey(denom))
+	if bz == nil {
+		return types.PendingGlobalFreeze{}, false
+	}
+
+	var pendingGlobalFreeze types.PendingGlobalFreeze
+	k.cdc.MustUnmarshal(bz, &pendingGlobalFreeze)
+	return pendingGlobalFreeze, true
+}
+
+// GetPendingGlobalFreezesWithPagination returns the global freezes scheduled to take effect in the future.
+func (k Keeper) GetPendingGlobalFreezesWithPagination(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.PendingGlobalFreeze, *query.PageResponse, error) {
+	pendingGlobalFreezes := []types.PendingGlobalFreeze{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingGlobalFreezeKeyPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var pendingGlobalFreeze types.PendingGlobalFreeze
+		k.cdc.MustUnmarshal(value, &pendingGlobalFreeze)
+		pendingGlobalFreezes = append(pendingGlobalFreezes, pendingGlobalFreeze)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pendingGlobalFreezes, pageRes, nil
+}
+
+// GetPendingGlobalFreezes returns all the global freezes scheduled to take effect in the future.
+func (k Keeper) GetPendingGlobalFreezes(ctx sdk.Context) []types.PendingGlobalFreeze {
+	pendingGlobalFreezes := []types.PendingGlobalFreeze{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingGlobalFreezeKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingGlobalFreeze types.PendingGlobalFreeze
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingGlobalFreeze)
+		pendingGlobalFreezes = append(pendingGlobalFreezes, pendingGlobalFreeze)
+	}
+
+	return pendingGlobalFreezes
+}
+
+// SetPendingGlobalFreeze stores the scheduled global freeze together with its entry in the activation queue.
+func (k Keeper) SetPendingGlobalFreeze(ctx sdk.Context, pendingGlobalFreeze types.PendingGlobalFreeze) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPendingGlobalFreezeKey(pendingGlobalFreeze.Denom)
+	store.Set(key, k.cdc.MustMarshal(&pendingGlobalFreeze))
+	store.Set(types.GetPendingGlobalFreezeQueueKey(pendingGlobalFreeze.Denom, pendingGlobalFreeze.ActivationTime), key)
+}
+
+func (k Keeper) deletePendingGlobalFreeze(ctx sdk.Context, denom string) {
+	pendingGlobalFreeze, found := k.GetPendingGlobalFreeze(ctx, denom)
+	if !found {
 		return
 	}
-	ctx.KVStore(k.storeKey).Delete(types.CreateGlobalFreezePrefix(denom))
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingGlobalFreezeKey(denom))
+	store.Delete(types.GetPendingGlobalFreezeQueueKey(denom, pendingGlobalFreeze.ActivationTime))
 }
 
-func (k Keeper) isGloballyFrozen(ctx sdk.Context, denom string) bool {
-	globFreezeVal := ctx.KVStore(k.storeKey).Get(types.CreateGlobalFreezePrefix(denom))
-	return bytes.Equal(globFreezeVal, globalFreezeEnabledStoreVal)
+// activatePendingGlobalFreezes freezes the tokens which global freezes are due by the current block time.
+func (k Keeper) activatePendingGlobalFreezes(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.PendingGlobalFreezeQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.CreatePendingGlobalFreezeQueuePrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var pendingGlobalFreezes []types.PendingGlobalFreeze
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingGlobalFreeze types.PendingGlobalFreeze
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &pendingGlobalFreeze)
+		pendingGlobalFreezes = append(pendingGlobalFreezes, pendingGlobalFreeze)
+	}
+
+	for _, pendingGlobalFreeze := range pendingGlobalFreezes {
+		k.deletePendingGlobalFreeze(ctx, pendingGlobalFreeze.Denom)
+		// the token might have been moved to the status which can't be globally frozen after the freeze was scheduled
+		if checkGlobalFreezeApplicable(pendingGlobalFreeze.Denom, k.GetTokenStatus(ctx, pendingGlobalFreeze.Denom)) != nil {
+			continue
+		}
+		if err := k.setGlobalFreeze(ctx, pendingGlobalFreeze.Denom, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) emitGlobalFreezeChanged(ctx sdk.Context, denom string, frozen bool) error {
+	// the global freeze affects all the holders of the token, so the account isn't set
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(nil, denom, types.AttributeValueActionGlobalFreezeChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGlobalFreezeChanged{
+		Denom:  denom,
+		Frozen: frozen,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventGlobalFreezeChanged: %s", err)
+	}
+
+	return nil
 }
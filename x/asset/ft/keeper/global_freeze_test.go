@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -119,3 +120,68 @@ func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
 	balance = bankKeeper.GetBalance(ctx, randomAddr, freezableDenom)
 	requireT.Equal(sdk.NewCoin(freezableDenom, sdk.NewInt(12)), balance)
 }
+
+func TestKeeper_ScheduleGlobalFreeze(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FREEZE",
+		Subunit:       "freeze",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(777),
+		Features:      []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	// the activation time must be in the future
+	requireT.True(types.ErrInvalidInput.Is(ftKeeper.ScheduleGlobalFreeze(ctx, issuer, denom, now)))
+
+	// only the issuer can schedule the global freeze
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.ScheduleGlobalFreeze(ctx, randomAddr, denom, now.Add(time.Hour))))
+
+	// the scheduled global freeze is replaced by the next one
+	requireT.NoError(ftKeeper.ScheduleGlobalFreeze(ctx, issuer, denom, now.Add(time.Hour)))
+	requireT.NoError(ftKeeper.ScheduleGlobalFreeze(ctx, issuer, denom, now.Add(2*time.Hour)))
+	pendingGlobalFreeze, found := ftKeeper.GetPendingGlobalFreeze(ctx, denom)
+	requireT.True(found)
+	requireT.Equal(now.Add(2*time.Hour), pendingGlobalFreeze.ActivationTime)
+	requireT.Len(ftKeeper.GetPendingGlobalFreezes(ctx), 1)
+
+	// the token isn't frozen before the activation time, even at the time of the replaced schedule
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.False(token.GloballyFrozen)
+
+	// the token is frozen by the end blocker at the activation time
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(token.GloballyFrozen)
+	_, found = ftKeeper.GetPendingGlobalFreeze(ctx, denom)
+	requireT.False(found)
+
+	// the global unfreeze cancels the scheduled global freeze
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, issuer, denom))
+	requireT.NoError(ftKeeper.ScheduleGlobalFreeze(ctx, issuer, denom, now.Add(3*time.Hour)))
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, issuer, denom))
+	_, found = ftKeeper.GetPendingGlobalFreeze(ctx, denom)
+	requireT.False(found)
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.False(token.GloballyFrozen)
+	requireT.Empty(ftKeeper.GetPendingGlobalFreezes(ctx))
+}
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/CoreumFoundation/coreum/pkg/grpcerrors"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
@@ -14,11 +15,35 @@ var _ types.QueryServer = QueryService{}
 
 // QueryKeeper defines subscope of keeper methods required by query service.
 type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetSendFeatureGas() []types.FeatureGas
 	GetToken(ctx sdk.Context, denom string) (types.FT, error)
+	GetTokens(ctx sdk.Context, pagination *query.PageRequest) ([]types.FT, *query.PageResponse, error)
+	GetTokensByFeature(ctx sdk.Context, feature types.TokenFeature, pagination *query.PageRequest) ([]types.FT, *query.PageResponse, error)
 	GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetFrozenBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	GetFrozenRateAmount(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Dec, sdk.Coin)
+	GetTimedFreezes(ctx sdk.Context, addr sdk.AccAddress, denom string, pagination *query.PageRequest) ([]types.TimedFreeze, *query.PageResponse, error)
+	IsAccountFrozen(ctx sdk.Context, issuer, account sdk.AccAddress) bool
+	GetFrozenAccounts(ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest) ([]types.AccountFreeze, *query.PageResponse, error)
+	GetBurnAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, denom string) sdk.Coin
+	GetBurnAllowances(ctx sdk.Context, owner sdk.AccAddress, pagination *query.PageRequest) ([]types.BurnAllowance, *query.PageResponse, error)
 	GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	GetBridgeMintRecord(ctx sdk.Context, denom, transferID string) (types.BridgeMintRecord, bool)
+	GetWhitelistExemptions(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
+	GetBurnRateExemptions(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
+	GetTokenAttributes(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.TokenAttribute, *query.PageResponse, error)
+	ResolveIBCDenom(ctx sdk.Context, denom string) (types.IBCDenomTrace, *types.FT, error)
+	GetReservation(ctx sdk.Context, id uint64) (types.Reservation, bool)
+	GetPayeeReservations(ctx sdk.Context, payee sdk.AccAddress, pagination *query.PageRequest) ([]types.Reservation, *query.PageResponse, error)
+	GetPendingGlobalFreezesWithPagination(ctx sdk.Context, pagination *query.PageRequest) ([]types.PendingGlobalFreeze, *query.PageResponse, error)
+	GetReserveAttestations(ctx sdk.Context, denom string) []types.ReserveAttestation
+	GetAdmin(ctx sdk.Context, denom string) (string, error)
+	GetPendingAdminTransfer(ctx sdk.Context, denom string) (types.PendingAdminTransfer, bool)
+	GetTokenStats(ctx sdk.Context, denom string) (types.TokenStats, error)
+	ValidateDenomAvailable(ctx sdk.Context, subunit string, issuer sdk.AccAddress) error
+	ValidateSymbolAvailable(ctx sdk.Context, symbol string, issuer sdk.AccAddress) error
 }
 
 // QueryService serves grpc query requests for assets module.
@@ -33,11 +58,31 @@ func NewQueryService(keeper QueryKeeper) QueryService {
 	}
 }
 
+// Params queries the parameters of x/asset/ft module.
+func (qs QueryService) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(goCtx)),
+	}, nil
+}
+
+// SendFeatureGas returns the gas charged on top of the deterministic gas for each feature of the token sent.
+func (qs QueryService) SendFeatureGas(
+	goCtx context.Context,
+	req *types.QuerySendFeatureGasRequest,
+) (*types.QuerySendFeatureGasResponse, error) {
+	return &types.QuerySendFeatureGasResponse{
+		Features: qs.keeper.GetSendFeatureGas(),
+	}, nil
+}
+
 // Token queries an fungible token.
 func (qs QueryService) Token(ctx context.Context, req *types.QueryTokenRequest) (*types.QueryTokenResponse, error) {
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
 	token, err := qs.keeper.GetToken(sdk.UnwrapSDKContext(ctx), req.GetDenom())
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 
 	return &types.QueryTokenResponse{
@@ -45,16 +90,43 @@ func (qs QueryService) Token(ctx context.Context, req *types.QueryTokenRequest)
 	}, nil
 }
 
+// Tokens lists the fungible tokens, optionally filtered by the enabled feature.
+func (qs QueryService) Tokens(goCtx context.Context, req *types.QueryTokensRequest) (*types.QueryTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var (
+		tokens  []types.FT
+		pageRes *query.PageResponse
+		err     error
+	)
+	if req.GetFeature() == "" {
+		tokens, pageRes, err = qs.keeper.GetTokens(ctx, req.Pagination)
+	} else {
+		feature, ok := types.TokenFeature_value[req.GetFeature()] //nolint:nosnakecase // generated name
+		if !ok {
+			return nil, grpcerrors.InvalidArgument(sdkerrors.Wrapf(types.ErrInvalidInput, "unknown feature %q", req.GetFeature()))
+		}
+		tokens, pageRes, err = qs.keeper.GetTokensByFeature(ctx, types.TokenFeature(feature), req.Pagination)
+	}
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryTokensResponse{
+		Tokens:     tokens,
+		Pagination: pageRes,
+	}, nil
+}
+
 // FrozenBalances lists frozen balances on a given account
 func (qs QueryService) FrozenBalances(goCtx context.Context, req *types.QueryFrozenBalancesRequest) (*types.QueryFrozenBalancesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	account, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
 	}
 	balances, pageRes, err := qs.keeper.GetFrozenBalances(ctx, account, req.Pagination)
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 
 	return &types.QueryFrozenBalancesResponse{
@@ -66,9 +138,12 @@ func (qs QueryService) FrozenBalances(goCtx context.Context, req *types.QueryFro
 // FrozenBalance lists frozen balance of a denom on a given account
 func (qs QueryService) FrozenBalance(goCtx context.Context, req *types.QueryFrozenBalanceRequest) (*types.QueryFrozenBalanceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
 	account, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
 	}
 	balance := qs.keeper.GetFrozenBalance(ctx, account, req.GetDenom())
 
@@ -77,16 +152,130 @@ func (qs QueryService) FrozenBalance(goCtx context.Context, req *types.QueryFroz
 	}, nil
 }
 
+// FrozenRate returns the share of the balance of a denom frozen on a given account
+func (qs QueryService) FrozenRate(goCtx context.Context, req *types.QueryFrozenRateRequest) (*types.QueryFrozenRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
+	}
+	rate, frozenAmount := qs.keeper.GetFrozenRateAmount(ctx, account, req.GetDenom())
+
+	return &types.QueryFrozenRateResponse{
+		Rate:         rate,
+		FrozenAmount: frozenAmount,
+	}, nil
+}
+
+// TimedFreezes lists the amounts frozen on a given account until the unfreeze time
+func (qs QueryService) TimedFreezes(goCtx context.Context, req *types.QueryTimedFreezesRequest) (*types.QueryTimedFreezesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.GetDenom() != "" {
+		if err := validateDenom(req.GetDenom()); err != nil {
+			return nil, err
+		}
+	}
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
+	}
+	timedFreezes, pageRes, err := qs.keeper.GetTimedFreezes(ctx, account, req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTimedFreezesResponse{
+		TimedFreezes: timedFreezes,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// AccountFrozen queries whether all the tokens of the issuer held by a given account are frozen
+func (qs QueryService) AccountFrozen(goCtx context.Context, req *types.QueryAccountFrozenRequest) (*types.QueryAccountFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid issuer address"))
+	}
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
+	}
+
+	return &types.QueryAccountFrozenResponse{
+		Frozen: qs.keeper.IsAccountFrozen(ctx, issuer, account),
+	}, nil
+}
+
+// FrozenAccounts lists the accounts which all the tokens of a given issuer are frozen on
+func (qs QueryService) FrozenAccounts(goCtx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid issuer address"))
+	}
+	accountFreezes, pageRes, err := qs.keeper.GetFrozenAccounts(ctx, issuer, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAccountsResponse{
+		AccountFreezes: accountFreezes,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// BurnAllowance queries the amount the spender is allowed to burn from the owner account
+func (qs QueryService) BurnAllowance(goCtx context.Context, req *types.QueryBurnAllowanceRequest) (*types.QueryBurnAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid owner address"))
+	}
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid spender address"))
+	}
+
+	return &types.QueryBurnAllowanceResponse{
+		Allowance: qs.keeper.GetBurnAllowance(ctx, owner, spender, req.GetDenom()),
+	}, nil
+}
+
+// BurnAllowances lists the burn allowances granted by a given account
+func (qs QueryService) BurnAllowances(goCtx context.Context, req *types.QueryBurnAllowancesRequest) (*types.QueryBurnAllowancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid owner address"))
+	}
+	burnAllowances, pageRes, err := qs.keeper.GetBurnAllowances(ctx, owner, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBurnAllowancesResponse{
+		BurnAllowances: burnAllowances,
+		Pagination:     pageRes,
+	}, nil
+}
+
 // WhitelistedBalances lists whitelisted balances on a given account
 func (qs QueryService) WhitelistedBalances(goCtx context.Context, req *types.QueryWhitelistedBalancesRequest) (*types.QueryWhitelistedBalancesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	account, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
 	}
 	balances, pageRes, err := qs.keeper.GetWhitelistedBalances(ctx, account, req.Pagination)
 	if err != nil {
-		return nil, err
+		return nil, queryError(err)
 	}
 
 	return &types.QueryWhitelistedBalancesResponse{
@@ -98,9 +287,12 @@ func (qs QueryService) WhitelistedBalances(goCtx context.Context, req *types.Que
 // WhitelistedBalance lists whitelisted balance of a denom on a given account
 func (qs QueryService) WhitelistedBalance(goCtx context.Context, req *types.QueryWhitelistedBalanceRequest) (*types.QueryWhitelistedBalanceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
 	account, err := sdk.AccAddressFromBech32(req.Account)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address"))
 	}
 	balance := qs.keeper.GetWhitelistedBalance(ctx, account, req.GetDenom())
 
@@ -108,3 +300,230 @@ func (qs QueryService) WhitelistedBalance(goCtx context.Context, req *types.Quer
 		Balance: balance,
 	}, nil
 }
+
+// BridgeMintRecord returns the record of the transfer minted by the bridge.
+func (qs QueryService) BridgeMintRecord(goCtx context.Context, req *types.QueryBridgeMintRecordRequest) (*types.QueryBridgeMintRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+	record, found := qs.keeper.GetBridgeMintRecord(ctx, req.GetDenom(), req.GetTransferId())
+	if !found {
+		return nil, grpcerrors.NotFound(sdkerrors.Wrapf(sdkerrors.ErrNotFound, "transfer %q of %s not found", req.GetTransferId(), req.GetDenom()))
+	}
+
+	return &types.QueryBridgeMintRecordResponse{
+		Record: record,
+	}, nil
+}
+
+// WhitelistExemptions lists the accounts exempted from the whitelisted limits of the denom.
+func (qs QueryService) WhitelistExemptions(goCtx context.Context, req *types.QueryWhitelistExemptionsRequest) (*types.QueryWhitelistExemptionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+	accounts, pageRes, err := qs.keeper.GetWhitelistExemptions(ctx, req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryWhitelistExemptionsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BurnRateExemptions lists the accounts exempted from the burn rate of the denom.
+func (qs QueryService) BurnRateExemptions(goCtx context.Context, req *types.QueryBurnRateExemptionsRequest) (*types.QueryBurnRateExemptionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+	accounts, pageRes, err := qs.keeper.GetBurnRateExemptions(ctx, req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryBurnRateExemptionsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenAttributes lists the key-value attributes attached to the fungible token.
+func (qs QueryService) TokenAttributes(goCtx context.Context, req *types.QueryTokenAttributesRequest) (*types.QueryTokenAttributesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+	attributes, pageRes, err := qs.keeper.GetTokenAttributes(ctx, req.GetDenom(), req.Pagination)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryTokenAttributesResponse{
+		Attributes: attributes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ResolveIBCDenom returns the trace of the IBC denom and the fungible token issued on the chain the voucher represents.
+func (qs QueryService) ResolveIBCDenom(goCtx context.Context, req *types.QueryResolveIBCDenomRequest) (*types.QueryResolveIBCDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	trace, token, err := qs.keeper.ResolveIBCDenom(ctx, req.GetHash())
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryResolveIBCDenomResponse{
+		IBCDenom: trace.IBCDenom(),
+		Trace:    trace,
+		Token:    token,
+	}, nil
+}
+
+// Reservation returns the reservation of the funds.
+func (qs QueryService) Reservation(goCtx context.Context, req *types.QueryReservationRequest) (*types.QueryReservationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	reservation, found := qs.keeper.GetReservation(ctx, req.GetId())
+	if !found {
+		return nil, grpcerrors.NotFound(sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reservation %d not found", req.GetId()))
+	}
+
+	return &types.QueryReservationResponse{
+		Reservation: reservation,
+	}, nil
+}
+
+// PayeeReservations lists the reservations of the funds made for the payee.
+func (qs QueryService) PayeeReservations(goCtx context.Context, req *types.QueryPayeeReservationsRequest) (*types.QueryPayeeReservationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	payee, err := sdk.AccAddressFromBech32(req.Payee)
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid payee address"))
+	}
+	reservations, pageRes, err := qs.keeper.GetPayeeReservations(ctx, payee, req.Pagination)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryPayeeReservationsResponse{
+		Reservations: reservations,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// PendingGlobalFreezes lists the global freezes scheduled to take effect in the future.
+func (qs QueryService) PendingGlobalFreezes(goCtx context.Context, req *types.QueryPendingGlobalFreezesRequest) (*types.QueryPendingGlobalFreezesResponse, error) {
+	pendingGlobalFreezes, pageRes, err := qs.keeper.GetPendingGlobalFreezesWithPagination(sdk.UnwrapSDKContext(goCtx), req.Pagination)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryPendingGlobalFreezesResponse{
+		PendingGlobalFreezes: pendingGlobalFreezes,
+		Pagination:           pageRes,
+	}, nil
+}
+
+// ReserveAttestations returns the latest reserve attestations published by the issuer of the denom.
+func (qs QueryService) ReserveAttestations(
+	goCtx context.Context,
+	req *types.QueryReserveAttestationsRequest,
+) (*types.QueryReserveAttestationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReserveAttestationsResponse{
+		Attestations: qs.keeper.GetReserveAttestations(ctx, req.GetDenom()),
+	}, nil
+}
+
+// Admin returns the current admin of the denom and the scheduled admin transfer.
+func (qs QueryService) Admin(goCtx context.Context, req *types.QueryAdminRequest) (*types.QueryAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	admin, err := qs.keeper.GetAdmin(ctx, req.GetDenom())
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	res := &types.QueryAdminResponse{Admin: admin}
+	if pendingAdminTransfer, found := qs.keeper.GetPendingAdminTransfer(ctx, req.GetDenom()); found {
+		res.PendingAdminTransfer = &pendingAdminTransfer
+	}
+
+	return res, nil
+}
+
+// TokenStats returns the total supply, the number of holders and the total frozen and whitelisted amounts of the denom.
+func (qs QueryService) TokenStats(
+	goCtx context.Context,
+	req *types.QueryTokenStatsRequest,
+) (*types.QueryTokenStatsResponse, error) {
+	if err := validateDenom(req.GetDenom()); err != nil {
+		return nil, err
+	}
+
+	stats, err := qs.keeper.GetTokenStats(sdk.UnwrapSDKContext(goCtx), req.GetDenom())
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryTokenStatsResponse{Stats: stats}, nil
+}
+
+// DenomAvailable returns whether the token with the subunit and the symbol might be issued by the issuer.
+func (qs QueryService) DenomAvailable(
+	goCtx context.Context,
+	req *types.QueryDenomAvailableRequest,
+) (*types.QueryDenomAvailableResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, err := sdk.AccAddressFromBech32(req.GetIssuer())
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid issuer address"))
+	}
+
+	res := &types.QueryDenomAvailableResponse{
+		Denom:          types.BuildDenom(req.GetSubunit(), issuer),
+		DenomAvailable: true,
+		SymbolValid:    true,
+	}
+	if err := qs.keeper.ValidateDenomAvailable(ctx, req.GetSubunit(), issuer); err != nil {
+		res.DenomAvailable = false
+		res.DenomError = err.Error()
+	}
+	if req.GetSymbol() != "" {
+		if err := qs.keeper.ValidateSymbolAvailable(ctx, req.GetSymbol(), issuer); err != nil {
+			res.SymbolValid = false
+			res.SymbolError = err.Error()
+		}
+	}
+
+	return res, nil
+}
+
+func validateDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error()))
+	}
+	return nil
+}
+
+// queryError converts the error returned by the keeper into the status error with the code the clients may branch on.
+func queryError(err error) error {
+	switch {
+	case types.ErrFTNotFound.Is(err), types.ErrIBCDenomNotFound.Is(err), sdkerrors.ErrNotFound.Is(err):
+		return grpcerrors.NotFound(err)
+	case types.ErrInvalidInput.Is(err), sdkerrors.ErrInvalidRequest.Is(err):
+		return grpcerrors.InvalidArgument(err)
+	default:
+		return err
+	}
+}
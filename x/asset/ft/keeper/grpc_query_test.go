@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/pkg/grpcerrors"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestQueryService_ErrorCodes(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	goCtx := sdk.WrapSDKContext(testApp.BaseApp.NewContext(false, tmproto.Header{}))
+	queryService := keeper.NewQueryService(testApp.AssetFTKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	missingDenom := types.BuildDenom("abc", issuer)
+
+	requireCode := func(code codes.Code, codespace string, err error) {
+		requireT.Equal(code, status.Code(err), err)
+		info, ok := grpcerrors.ErrorInfo(err)
+		requireT.True(ok)
+		requireT.Equal(codespace, info.Domain)
+		requireT.Equal(codespace, info.Metadata[grpcerrors.MetadataKeyCodespace])
+	}
+
+	// missing entities
+	_, err := queryService.Token(goCtx, &types.QueryTokenRequest{Denom: missingDenom})
+	requireCode(codes.NotFound, types.ModuleName, err)
+	// the registered error is still matched
+	requireT.True(types.ErrFTNotFound.Is(err))
+	info, _ := grpcerrors.ErrorInfo(err)
+	requireT.Equal(types.ErrFTNotFound.Error(), info.Reason)
+	requireT.Equal("2", info.Metadata[grpcerrors.MetadataKeyCode])
+
+	_, err = queryService.BridgeMintRecord(goCtx, &types.QueryBridgeMintRecordRequest{Denom: missingDenom, TransferId: "id"})
+	requireCode(codes.NotFound, sdkerrors.RootCodespace, err)
+	_, err = queryService.Reservation(goCtx, &types.QueryReservationRequest{Id: 1})
+	requireCode(codes.NotFound, sdkerrors.RootCodespace, err)
+	_, err = queryService.ResolveIBCDenom(goCtx, &types.QueryResolveIBCDenomRequest{
+		Hash: types.IBCDenomPrefix + strings.Repeat("AB", 32),
+	})
+	requireCode(codes.NotFound, types.ModuleName, err)
+
+	// invalid arguments
+	_, err = queryService.Token(goCtx, &types.QueryTokenRequest{Denom: "1invalid"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.Tokens(goCtx, &types.QueryTokensRequest{Feature: "unknown"})
+	requireCode(codes.InvalidArgument, types.ModuleName, err)
+	_, err = queryService.FrozenBalances(goCtx, &types.QueryFrozenBalancesRequest{Account: "invalid"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	requireT.True(sdkerrors.ErrInvalidAddress.Is(err))
+	_, err = queryService.FrozenBalance(goCtx, &types.QueryFrozenBalanceRequest{Account: "invalid", Denom: missingDenom})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.WhitelistedBalances(goCtx, &types.QueryWhitelistedBalancesRequest{Account: "invalid"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.WhitelistedBalance(goCtx, &types.QueryWhitelistedBalanceRequest{Account: issuer.String(), Denom: ""})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.WhitelistExemptions(goCtx, &types.QueryWhitelistExemptionsRequest{Denom: "1invalid"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.PayeeReservations(goCtx, &types.QueryPayeeReservationsRequest{Payee: "invalid"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.ResolveIBCDenom(goCtx, &types.QueryResolveIBCDenomRequest{Hash: "ibc/invalid"})
+	requireCode(codes.InvalidArgument, types.ModuleName, err)
+	_, err = queryService.DenomAvailable(goCtx, &types.QueryDenomAvailableRequest{Issuer: "invalid", Subunit: "abc"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+
+	// valid requests of the missing balances succeed
+	_, err = queryService.FrozenBalance(goCtx, &types.QueryFrozenBalanceRequest{Account: issuer.String(), Denom: missingDenom})
+	requireT.NoError(err)
+}
+
+func TestQueryService_DenomAvailable(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryService := keeper.NewQueryService(testApp.AssetFTKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err := testApp.AssetFTKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "abc",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	// available
+	res, err := queryService.DenomAvailable(goCtx, &types.QueryDenomAvailableRequest{
+		Issuer:  issuer.String(),
+		Subunit: "udef",
+		Symbol:  "DEF",
+	})
+	requireT.NoError(err)
+	requireT.Equal(&types.QueryDenomAvailableResponse{
+		Denom:          types.BuildDenom("udef", issuer),
+		DenomAvailable: true,
+		SymbolValid:    true,
+	}, res)
+
+	// the symbol is optional
+	res, err = queryService.DenomAvailable(goCtx, &types.QueryDenomAvailableRequest{
+		Issuer:  issuer.String(),
+		Subunit: "udef",
+	})
+	requireT.NoError(err)
+	requireT.True(res.DenomAvailable)
+	requireT.True(res.SymbolValid)
+
+	// used by the issued token
+	res, err = queryService.DenomAvailable(goCtx, &types.QueryDenomAvailableRequest{
+		Issuer:  issuer.String(),
+		Subunit: "uabc",
+		Symbol:  "ABC",
+	})
+	requireT.NoError(err)
+	requireT.Equal(types.BuildDenom("uabc", issuer), res.Denom)
+	requireT.False(res.DenomAvailable)
+	requireT.Contains(res.DenomError, "already registered")
+	requireT.False(res.SymbolValid)
+	requireT.Contains(res.SymbolError, "duplicate symbol")
+
+	// the same subunit and symbol are available for another issuer
+	res, err = queryService.DenomAvailable(goCtx, &types.QueryDenomAvailableRequest{
+		Issuer:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Subunit: "uabc",
+		Symbol:  "ABC",
+	})
+	requireT.NoError(err)
+	requireT.True(res.DenomAvailable)
+	requireT.True(res.SymbolValid)
+
+	// invalid
+	res, err = queryService.DenomAvailable(goCtx, &types.QueryDenomAvailableRequest{
+		Issuer:  issuer.String(),
+		Subunit: "1abc",
+		Symbol:  "1ABC",
+	})
+	requireT.NoError(err)
+	requireT.False(res.DenomAvailable)
+	requireT.NotEmpty(res.DenomError)
+	requireT.False(res.SymbolValid)
+	requireT.NotEmpty(res.SymbolError)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// RegisterIBCDenom registers the trace of the IBC voucher denom. If the voucher represents the fungible token
+// issued on the chain and the IBC denom has no denom metadata yet, the metadata of the token is registered for it,
+// so the wallets display the voucher with the symbol and precision of the original token.
+func (k Keeper) RegisterIBCDenom(ctx sdk.Context, trace types.IBCDenomTrace) error {
+	if err := trace.Validate(); err != nil {
+		return err
+	}
+
+	k.SetIBCDenomTrace(ctx, trace)
+
+	ibcDenom := trace.IBCDenom()
+	token, err := k.GetToken(ctx, trace.BaseDenom)
+	switch {
+	case err == nil:
+		if _, found := k.bankKeeper.GetDenomMetaData(ctx, ibcDenom); !found {
+			k.SetDenomMetadata(ctx, ibcDenom, token.Symbol, types.IBCDenomDescription(trace), token.Precision)
+		}
+	case !types.ErrFTNotFound.Is(err):
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(ibcDenom))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIBCDenomRegistered{
+		IBCDenom:  ibcDenom,
+		Path:      trace.Path,
+		BaseDenom: trace.BaseDenom,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventIBCDenomRegistered: %s", err)
+	}
+
+	return nil
+}
+
+// ResolveIBCDenom returns the trace of the IBC denom and the fungible token issued on the chain the voucher
+// represents. The token is nil if the voucher represents the foreign token.
+func (k Keeper) ResolveIBCDenom(ctx sdk.Context, denom string) (types.IBCDenomTrace, *types.FT, error) {
+	hash, err := types.ParseIBCDenomHash(denom)
+	if err != nil {
+		return types.IBCDenomTrace{}, nil, err
+	}
+
+	trace, found := k.GetIBCDenomTrace(ctx, hash)
+	if !found {
+		return types.IBCDenomTrace{}, nil, sdkerrors.Wrapf(types.ErrIBCDenomNotFound, "denom: %s", denom)
+	}
+
+	token, err := k.GetToken(ctx, trace.BaseDenom)
+	if err != nil {
+		if types.ErrFTNotFound.Is(err) {
+			return trace, nil, nil
+		}
+		return types.IBCDenomTrace{}, nil, err
+	}
+
+	return trace, &token, nil
+}
+
+// GetIBCDenomTrace returns the trace of the IBC denom by its hash.
+func (k Keeper) GetIBCDenomTrace(ctx sdk.Context, hash []byte) (types.IBCDenomTrace, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetIBCDenomTraceKey(hash))
+	if bz == nil {
+		return types.IBCDenomTrace{}, false
+	}
+
+	var trace types.IBCDenomTrace
+	k.cdc.MustUnmarshal(bz, &trace)
+	return trace, true
+}
+
+// GetIBCDenomTraces returns all the registered traces of the IBC denoms.
+func (k Keeper) GetIBCDenomTraces(ctx sdk.Context) []types.IBCDenomTrace {
+	traces := []types.IBCDenomTrace{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCDenomTraceKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trace types.IBCDenomTrace
+		k.cdc.MustUnmarshal(iterator.Value(), &trace)
+		traces = append(traces, trace)
+	}
+
+	return traces
+}
+
+// SetIBCDenomTrace stores the trace of the IBC denom.
+func (k Keeper) SetIBCDenomTrace(ctx sdk.Context, trace types.IBCDenomTrace) {
+	ctx.KVStore(k.storeKey).Set(types.GetIBCDenomTraceKey(trace.Hash()), k.cdc.MustMarshal(&trace))
+}
+
+// ValidateIBCTransfer returns an error if the denom is the fungible token which can't leave the chain over IBC because
+// the ibc feature isn't enabled for it. The other denoms aren't restricted.
+func (k Keeper) ValidateIBCTransfer(ctx sdk.Context, denom string) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		if types.ErrFTNotFound.Is(err) {
+			return nil
+		}
+		return err
+	}
+	if !ft.IsFeatureEnabled(types.TokenFeature_ibc) { //nolint:nosnakecase
+		return sdkerrors.Wrapf(types.ErrFeatureNotActive, "denom:%s, feature:%s", denom, types.TokenFeature_ibc) //nolint:nosnakecase
+	}
+	return nil
+}
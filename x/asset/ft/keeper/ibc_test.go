@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_RegisterIBCDenom(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(100),
+	})
+	requireT.NoError(err)
+
+	// the trace of the local token
+	localTrace := types.IBCDenomTrace{
+		Path:      "transfer/channel-0/transfer/channel-7",
+		BaseDenom: denom,
+	}
+	_, _, err = ftKeeper.ResolveIBCDenom(ctx, localTrace.IBCDenom())
+	requireT.True(types.ErrIBCDenomNotFound.Is(err))
+
+	requireT.NoError(ftKeeper.RegisterIBCDenom(ctx, localTrace))
+	trace, token, err := ftKeeper.ResolveIBCDenom(ctx, localTrace.IBCDenom())
+	requireT.NoError(err)
+	requireT.Equal(localTrace, trace)
+	requireT.NotNil(token)
+	requireT.Equal(denom, token.Denom)
+	requireT.Equal("ABC", token.Symbol)
+	requireT.EqualValues(6, token.Precision)
+
+	// the denom metadata of the token is registered for the IBC denom
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, localTrace.IBCDenom())
+	requireT.True(found)
+	requireT.Equal(localTrace.IBCDenom(), metadata.Base)
+	requireT.Equal("ABC", metadata.Display)
+	requireT.Equal(types.IBCDenomDescription(localTrace), metadata.Description)
+
+	// the trace of the foreign token
+	foreignTrace := types.IBCDenomTrace{
+		Path:      "transfer/channel-3",
+		BaseDenom: "uatom",
+	}
+	requireT.NoError(ftKeeper.RegisterIBCDenom(ctx, foreignTrace))
+	trace, token, err = ftKeeper.ResolveIBCDenom(ctx, foreignTrace.IBCDenom())
+	requireT.NoError(err)
+	requireT.Equal(foreignTrace, trace)
+	requireT.Nil(token)
+	_, found = bankKeeper.GetDenomMetaData(ctx, foreignTrace.IBCDenom())
+	requireT.False(found)
+
+	// invalid trace
+	requireT.True(types.ErrInvalidInput.Is(ftKeeper.RegisterIBCDenom(ctx, types.IBCDenomTrace{
+		Path:      "transfer",
+		BaseDenom: "uatom",
+	})))
+
+	requireT.ElementsMatch([]types.IBCDenomTrace{localTrace, foreignTrace}, ftKeeper.GetIBCDenomTraces(ctx))
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+const wrappedReserveInvariantName = "wrapped-reserve"
+
+// RegisterInvariants registers the assetft module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, wrappedReserveInvariantName, WrappedReserveInvariant(k))
+}
+
+// WrappedReserveInvariant checks that the native coins locked in the module account cover the supply of the wrapped token.
+func WrappedReserveInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		reserve := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), k.stakingKeeper.BondDenom(ctx))
+		supply := k.bankKeeper.GetSupply(ctx, k.GetWrappedDenom(ctx))
+		broken := reserve.Amount.LT(supply.Amount)
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			wrappedReserveInvariantName,
+			fmt.Sprintf("\treserve: %s\n\twrapped supply: %s\n", reserve, supply),
+		), broken
+	}
+}
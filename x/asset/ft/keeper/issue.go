@@ -6,26 +6,65 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/samber/lo"
 
 	"github.com/CoreumFoundation/coreum/pkg/store"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
-// Issue issues new fungible token and returns it's denom.
+// Issue issues new fungible token and returns it's denom. If the token has been already issued by the issuer with
+// the same idempotency key, its denom is returned and nothing else is done.
 func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, error) {
+	if err := types.ValidateIdempotencyKey(settings.IdempotencyKey); err != nil {
+		return "", err
+	}
+	if settings.IdempotencyKey != "" {
+		if denom, found := k.getIssueIdempotencyDenom(ctx, settings.Issuer, settings.IdempotencyKey); found {
+			return denom, nil
+		}
+	}
+
 	if err := types.ValidateSubunit(settings.Subunit); err != nil {
 		return "", sdkerrors.Wrapf(err, "provided subunit: %s", settings.Subunit)
 	}
 
+	if err := types.ValidatePrecision(settings.Precision); err != nil {
+		return "", err
+	}
+
 	if err := types.ValidateBurnRate(settings.BurnRate); err != nil {
 		return "", err
 	}
 
+	if err := types.ValidateSendCommissionRate(settings.SendCommissionRate); err != nil {
+		return "", err
+	}
+
 	err := types.ValidateSymbol(settings.Symbol)
 	if err != nil {
 		return "", sdkerrors.Wrapf(err, "provided symbol: %s", settings.Symbol)
 	}
 
+	params := k.GetParams(ctx)
+	if err := types.ValidateSymbolLength(settings.Symbol, params.MaxSymbolLength); err != nil {
+		return "", err
+	}
+	if err := types.ValidateDescription(settings.Description, params.MaxDescriptionLength); err != nil {
+		return "", err
+	}
+	if err := types.ValidateURI(settings.URI, settings.URIHash); err != nil {
+		return "", err
+	}
+
+	if settings.VestingSchedule != nil {
+		if err := types.ValidateVestingSchedule(*settings.VestingSchedule, settings.InitialAmount); err != nil {
+			return "", err
+		}
+		if err := k.validateVestingIssuer(ctx, settings.Issuer); err != nil {
+			return "", err
+		}
+	}
+
 	if err := k.StoreSymbol(ctx, settings.Symbol, settings.Issuer); err != nil {
 		return "", sdkerrors.Wrapf(err, "provided symbol: %s", settings.Symbol)
 	}
@@ -43,27 +82,53 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 	k.SetDenomMetadata(ctx, denom, settings.Symbol, settings.Description, settings.Precision)
 
 	definition := types.FTDefinition{
-		Denom:    denom,
-		Issuer:   settings.Issuer.String(),
-		Features: settings.Features,
-		BurnRate: settings.BurnRate,
+		Denom:              denom,
+		Issuer:             settings.Issuer.String(),
+		Features:           settings.Features,
+		BurnRate:           settings.BurnRate,
+		SendCommissionRate: settings.SendCommissionRate,
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
 	}
 	k.SetTokenDefinition(ctx, definition)
+	if settings.Draft {
+		k.SetTokenStatusRecord(ctx, denom, types.TokenStatus_draft) //nolint:nosnakecase
+	}
+	if settings.IdempotencyKey != "" {
+		k.SetIssueIdempotencyRecord(ctx, types.IssueIdempotencyRecord{
+			Issuer: settings.Issuer.String(),
+			Key:    settings.IdempotencyKey,
+			Denom:  denom,
+		})
+	}
 
 	if err := k.mint(ctx, definition, settings.InitialAmount, settings.Issuer); err != nil {
 		return "", err
 	}
+	if settings.VestingSchedule != nil {
+		err := k.setIssuerVesting(
+			ctx,
+			settings.Issuer,
+			sdk.NewCoin(denom, settings.InitialAmount),
+			*settings.VestingSchedule,
+		)
+		if err != nil {
+			return "", err
+		}
+	}
 
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, settings.Issuer.String()))
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventTokenIssued{
-		Denom:         denom,
-		Issuer:        settings.Issuer.String(),
-		Symbol:        settings.Symbol,
-		Subunit:       settings.Subunit,
-		Precision:     settings.Precision,
-		Description:   settings.Description,
-		InitialAmount: settings.InitialAmount,
-		Features:      settings.Features,
-		BurnRate:      settings.BurnRate,
+		Denom:              denom,
+		Issuer:             settings.Issuer.String(),
+		Symbol:             settings.Symbol,
+		Subunit:            settings.Subunit,
+		Precision:          settings.Precision,
+		Description:        settings.Description,
+		InitialAmount:      settings.InitialAmount,
+		Features:           settings.Features,
+		BurnRate:           settings.BurnRate,
+		SendCommissionRate: settings.SendCommissionRate,
 	}); err != nil {
 		return "", sdkerrors.Wrap(err, "can't emit EventTokenIssued event")
 	}
@@ -73,6 +138,73 @@ func (k Keeper) Issue(ctx sdk.Context, settings types.IssueSettings) (string, er
 	return denom, nil
 }
 
+// SetIssueIdempotencyRecord stores the denom of the token issued by the issuer with the idempotency key.
+func (k Keeper) SetIssueIdempotencyRecord(ctx sdk.Context, record types.IssueIdempotencyRecord) {
+	issuer := sdk.MustAccAddressFromBech32(record.Issuer)
+	ctx.KVStore(k.storeKey).Set(types.GetIssueIdempotencyKey(issuer, record.Key), k.cdc.MustMarshal(&record))
+}
+
+// GetIssueIdempotencyRecords returns all the idempotency keys used by the issuers.
+func (k Keeper) GetIssueIdempotencyRecords(ctx sdk.Context) []types.IssueIdempotencyRecord {
+	records := []types.IssueIdempotencyRecord{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.IssueIdempotencyKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.IssueIdempotencyRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (k Keeper) getIssueIdempotencyDenom(ctx sdk.Context, issuer sdk.AccAddress, key string) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetIssueIdempotencyKey(issuer, key))
+	if bz == nil {
+		return "", false
+	}
+	var record types.IssueIdempotencyRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record.Denom, true
+}
+
+// ValidateDenomAvailable returns the error Issue fails with if the token with the subunit can't be issued by the issuer,
+// because the subunit is invalid or the denom is already registered.
+func (k Keeper) ValidateDenomAvailable(ctx sdk.Context, subunit string, issuer sdk.AccAddress) error {
+	if err := types.ValidateSubunit(subunit); err != nil {
+		return sdkerrors.Wrapf(err, "provided subunit: %s", subunit)
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, types.BuildDenom(subunit, issuer)); found {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"subunit %s already registered for the address %s",
+			subunit,
+			issuer.String(),
+		)
+	}
+
+	return nil
+}
+
+// ValidateSymbolAvailable returns the error Issue fails with if the token with the symbol can't be issued by the
+// issuer, because the symbol is invalid or it is used by another token of the issuer.
+func (k Keeper) ValidateSymbolAvailable(ctx sdk.Context, symbol string, issuer sdk.AccAddress) error {
+	if err := types.ValidateSymbol(symbol); err != nil {
+		return sdkerrors.Wrapf(err, "provided symbol: %s", symbol)
+	}
+	if err := types.ValidateSymbolLength(symbol, k.GetParams(ctx).MaxSymbolLength); err != nil {
+		return err
+	}
+	if k.IsSymbolDuplicate(ctx, symbol, issuer) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "duplicate symbol %s", symbol)
+	}
+
+	return nil
+}
+
 // IsSymbolDuplicate checks symbol exists in the store
 func (k Keeper) IsSymbolDuplicate(ctx sdk.Context, symbol string, issuer sdk.AccAddress) bool {
 	symbol = types.NormalizeSymbolForKey(symbol)
@@ -116,7 +248,7 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.FTDefinition)
 
 	precision := -1
 	for _, unit := range metadata.DenomUnits {
-		if unit.Denom == metadata.Symbol {
+		if unit.Denom == metadata.Symbol || lo.Contains(unit.Aliases, metadata.Symbol) {
 			precision = int(unit.Exponent)
 			break
 		}
@@ -126,37 +258,66 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.FTDefinition)
 		return types.FT{}, sdkerrors.Wrap(types.ErrInvalidInput, "precision not found")
 	}
 
+	status := k.GetTokenStatus(ctx, definition.Denom)
 	return types.FT{
-		Denom:          definition.Denom,
-		Issuer:         definition.Issuer,
-		Symbol:         metadata.Symbol,
-		Precision:      uint32(precision),
-		Subunit:        subunit,
-		Description:    metadata.Description,
-		Features:       definition.Features,
-		BurnRate:       definition.BurnRate,
-		GloballyFrozen: k.isGloballyFrozen(ctx, definition.Denom),
+		Denom:              definition.Denom,
+		Issuer:             definition.Issuer,
+		Symbol:             metadata.Symbol,
+		Precision:          uint32(precision),
+		Subunit:            subunit,
+		Description:        metadata.Description,
+		Features:           definition.Features,
+		BurnRate:           definition.BurnRate,
+		SendCommissionRate: definition.SendCommissionRate,
+		GloballyFrozen:     status == types.TokenStatus_suspended, //nolint:nosnakecase
+		Status:             status,
+		URI:                definition.URI,
+		URIHash:            definition.URIHash,
 	}, nil
 }
 
 // GetTokens returns all fungible tokens.
 func (k Keeper) GetTokens(ctx sdk.Context, pagination *query.PageRequest) ([]types.FT, *query.PageResponse, error) {
-	definitions, pageResponse, err := k.GetTokenDefinitions(ctx, pagination)
+	var tokens []types.FT
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FTKeyPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var definition types.FTDefinition
+		if err := k.cdc.Unmarshal(value, &definition); err != nil {
+			return err
+		}
+		token, err := k.getTokenFullInfo(ctx, definition)
+		if err != nil {
+			return err
+		}
+		tokens = append(tokens, token)
+		return nil
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var tokens []types.FT
-	for _, definition := range definitions {
+	return tokens, pageRes, nil
+}
+
+// IterateTokens calls the callback for each fungible token ordered by denom without loading all the tokens into
+// the memory. The iteration stops when the callback returns true.
+func (k Keeper) IterateTokens(ctx sdk.Context, cb func(token types.FT) (stop bool)) error {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.FTKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var definition types.FTDefinition
+		k.cdc.MustUnmarshal(iterator.Value(), &definition)
 		token, err := k.getTokenFullInfo(ctx, definition)
 		if err != nil {
-			return nil, nil, err
+			return err
+		}
+		if cb(token) {
+			return nil
 		}
-
-		tokens = append(tokens, token)
 	}
 
-	return tokens, pageResponse, nil
+	return nil
 }
 
 // GetTokenDefinitions returns all fungible token definitions.
@@ -200,10 +361,36 @@ func (k Keeper) GetTokenDefinition(ctx sdk.Context, denom string) (types.FTDefin
 	return definition, nil
 }
 
-// SetTokenDefinition stores the TokenDefinition.
+// SetTokenDefinition stores the TokenDefinition and indexes it by the enabled features.
 func (k Keeper) SetTokenDefinition(ctx sdk.Context, definition types.FTDefinition) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetTokenKey(definition.Denom), k.cdc.MustMarshal(&definition))
+	for _, feature := range definition.Features {
+		store.Set(types.GetFeatureTokenKey(feature, definition.Denom), []byte{0x01})
+	}
+}
+
+// GetTokensByFeature returns the fungible tokens having the feature enabled.
+func (k Keeper) GetTokensByFeature(
+	ctx sdk.Context,
+	feature types.TokenFeature,
+	pagination *query.PageRequest,
+) ([]types.FT, *query.PageResponse, error) {
+	tokens := []types.FT{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateFeatureTokensPrefix(feature))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		token, err := k.GetToken(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		tokens = append(tokens, token)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, pageRes, nil
 }
 
 // SetDenomMetadata registers denom metadata on the bank keeper
@@ -212,21 +399,33 @@ func (k Keeper) SetDenomMetadata(ctx sdk.Context, denom, symbol, description str
 		Name:        symbol,
 		Symbol:      symbol,
 		Description: description,
+		// the units are sorted by the exponent starting from the base one as required by the bank metadata validation
 		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    symbol,
-				Exponent: precision,
-			},
 			{
 				Denom:    denom,
 				Exponent: uint32(0),
 			},
+			{
+				Denom:    symbol,
+				Exponent: precision,
+			},
 		},
 		// here take subunit provided by the user, generate the denom and used it as base,
 		// and we take the symbol provided by the user and use it as symbol
 		Base:    denom,
 		Display: symbol,
 	}
+	// the units must have different exponents, so the symbol of the token with zero precision is the alias of the base
+	if precision == 0 {
+		denomMetadata.DenomUnits = []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: uint32(0),
+				Aliases:  []string{symbol},
+			},
+		}
+		denomMetadata.Display = denom
+	}
 
 	k.bankKeeper.SetDenomMetaData(ctx, denomMetadata)
 }
@@ -235,7 +434,7 @@ func (k Keeper) mint(ctx sdk.Context, ft types.FTDefinition, amount sdk.Int, rec
 	if !amount.IsPositive() {
 		return nil
 	}
-	if err := k.isCoinReceivable(ctx, recipient, ft, amount); err != nil {
+	if err := k.applyReceiveRestrictions(ctx, recipient, ft, amount); err != nil {
 		return sdkerrors.Wrapf(err, "coins are not receivable")
 	}
 
@@ -244,6 +443,15 @@ func (k Keeper) mint(ctx sdk.Context, ft types.FTDefinition, amount sdk.Int, rec
 		return sdkerrors.Wrapf(err, "can't mint %s for the module %s", coinsToMint.String(), types.ModuleName)
 	}
 
+	// the module accounts are blocked from receiving the coins sent to the accounts, so the coins minted to the module
+	// issuers are sent between the modules
+	if moduleName, ok := k.moduleIssuers[recipient.String()]; ok {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, moduleName, coinsToMint); err != nil {
+			return sdkerrors.Wrapf(err, "can't send minted coins from module %s to module %s", types.ModuleName, moduleName)
+		}
+		return nil
+	}
+
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coinsToMint); err != nil {
 		return sdkerrors.Wrapf(err, "can't send minted coins from module %s to account %s", types.ModuleName, recipient.String())
 	}
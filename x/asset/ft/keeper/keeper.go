@@ -7,25 +7,76 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
+// ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
+type ParamSubspace interface {
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
+
 // Keeper is the asset module keeper.
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   sdk.StoreKey
-	bankKeeper types.BankKeeper
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	paramSubspace ParamSubspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+	wasmKeeper    types.WasmKeeper
+	// moduleIssuers maps the addresses of the module accounts scoped to issue the tokens to the module names.
+	moduleIssuers map[string]string
+	// sendFeatureGas is the gas charged for each token sent, per feature enabled for the token.
+	sendFeatureGas map[types.TokenFeature]uint64
+	holders        holderTracker
 }
 
 // NewKeeper creates a new instance of the Keeper.
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, bankKeeper types.BankKeeper) Keeper {
-	return Keeper{
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSubspace ParamSubspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	wasmKeeper types.WasmKeeper,
+	sendFeatureGas map[types.TokenFeature]uint64,
+) Keeper {
+	holders := holderTracker{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		bankKeeper: bankKeeper,
 	}
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		paramSubspace:  paramSubspace,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     holderTrackingBankKeeper{BankKeeper: bankKeeper, holders: holders},
+		stakingKeeper:  stakingKeeper,
+		wasmKeeper:     wasmKeeper,
+		moduleIssuers:  map[string]string{},
+		sendFeatureGas: sendFeatureGas,
+		holders:        holders,
+	}
+}
+
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	// the params don't exist in the store of the chain started before they were introduced,
+	// in that case the defaults are used
+	params := types.DefaultParams()
+	k.paramSubspace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
 // BeforeSendCoins checks that a transfer request is allowed or not
@@ -41,25 +92,39 @@ func (k Keeper) BeforeSendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccA
 			}
 			return err
 		}
+		k.consumeSendFeatureGas(ctx, ft)
 		if err := k.isCoinSpendable(ctx, fromAddress, ft, coin.Amount); err != nil {
 			return err
 		}
-		if err := k.isCoinReceivable(ctx, toAddress, ft, coin.Amount); err != nil {
+		if err := k.applyReceiveRestrictions(ctx, toAddress, ft, coin.Amount); err != nil {
 			return err
 		}
 		if err := k.applyBurnRate(ctx, ft, fromAddress, toAddress, coin); err != nil {
 			return err
 		}
+		if err := k.applySendCommissionRate(ctx, ft, fromAddress, toAddress, coin); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (k Keeper) applyBurnRate(ctx sdk.Context, ft types.FTDefinition, fromAddress, toAddress sdk.AccAddress, coin sdk.Coin) error {
-	if !ft.BurnRate.IsNil() && ft.BurnRate.IsPositive() && ft.Issuer != fromAddress.String() && ft.Issuer != toAddress.String() {
-		coinToBurn := ft.CalculateBurnRateAmount(coin)
-		err := k.burn(ctx, fromAddress, ft, coinToBurn)
-		if err != nil {
+	outcome := k.calculateSendOutcome(ctx, ft, fromAddress, toAddress, coin.Amount)
+	if outcome.Burnt.IsPositive() {
+		if err := k.burn(ctx, fromAddress, ft, outcome.Burnt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) applySendCommissionRate(ctx sdk.Context, ft types.FTDefinition, fromAddress, toAddress sdk.AccAddress, coin sdk.Coin) error {
+	outcome := k.calculateSendOutcome(ctx, ft, fromAddress, toAddress, coin.Amount)
+	if outcome.Commission.IsPositive() {
+		if err := k.sendCommission(ctx, fromAddress, ft, outcome.Commission); err != nil {
 			return err
 		}
 	}
@@ -67,8 +132,58 @@ func (k Keeper) applyBurnRate(ctx sdk.Context, ft types.FTDefinition, fromAddres
 	return nil
 }
 
+// sendCommission sends the commission charged by the send commission rate from the account to the admin.
+func (k Keeper) sendCommission(ctx sdk.Context, account sdk.AccAddress, ft types.FTDefinition, amount sdk.Int) error {
+	if err := k.isCoinSpendable(ctx, account, ft, amount); err != nil {
+		return sdkerrors.Wrapf(err, "coins are not spendable")
+	}
+
+	admin := sdk.MustAccAddressFromBech32(k.getAdmin(ctx, ft))
+	commission := sdk.NewCoins(sdk.NewCoin(ft.Denom, amount))
+	if err := k.bankKeeper.SendCoins(ctx, account, admin, commission); err != nil {
+		return sdkerrors.Wrapf(err, "can't send commission %s from account %s to admin %s", commission, account, admin)
+	}
+
+	return nil
+}
+
 // BeforeInputOutputCoins extends InputOutputCoins method of the bank keeper
 func (k Keeper) BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	// the amounts sent from and to the same account are summed up, so the restrictions can't be bypassed by splitting
+	// the transfer into several inputs or outputs
+	inAddresses, inCoins, err := sumIOCoins(inputs, func(in banktypes.Input) (string, sdk.Coins) { return in.Address, in.Coins })
+	if err != nil {
+		return err
+	}
+	outAddresses, outCoins, err := sumIOCoins(outputs, func(out banktypes.Output) (string, sdk.Coins) { return out.Address, out.Coins })
+	if err != nil {
+		return err
+	}
+
+	for i, inAddress := range inAddresses {
+		for _, coin := range inCoins[i] {
+			ft, err := k.GetTokenDefinition(ctx, coin.Denom)
+			if types.ErrFTNotFound.Is(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			k.consumeSendFeatureGas(ctx, ft)
+			if err := k.isCoinSpendable(ctx, inAddress, ft, coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, outAddress := range outAddresses {
+		for _, coin := range outCoins[i] {
+			if err := k.ApplyReceiveRestrictions(ctx, outAddress, coin); err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -85,10 +200,15 @@ func (k Keeper) BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input
 				return err
 			}
 
-			if !ft.BurnRate.IsNil() && ft.BurnRate.IsPositive() && ft.Issuer != inAddress.String() {
-				coinsToBurn := ft.CalculateBurnRateAmount(coin)
-				err = k.burn(ctx, inAddress, ft, coinsToBurn)
-				if err != nil {
+			burnt := k.calculateMultiSendBurnRateAmount(ctx, ft, inAddress, coin.Amount, outAddresses, outCoins)
+			if burnt.IsPositive() {
+				if err := k.burn(ctx, inAddress, ft, burnt); err != nil {
+					return err
+				}
+			}
+			commission := k.calculateMultiSendCommissionAmount(ctx, ft, inAddress, coin.Amount, outAddresses, outCoins)
+			if commission.IsPositive() {
+				if err := k.sendCommission(ctx, inAddress, ft, commission); err != nil {
 					return err
 				}
 			}
@@ -97,6 +217,93 @@ func (k Keeper) BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input
 	return nil
 }
 
+// calculateMultiSendBurnRateAmount returns the amount burnt from the input of the multi-send. The burn rate is applied
+// once to the input amount, only to its share sent to the outputs the burn rate applies to, i.e. not to the admin and
+// not to the accounts exempted from the burn rate.
+func (k Keeper) calculateMultiSendBurnRateAmount(
+	ctx sdk.Context,
+	ft types.FTDefinition,
+	inAddress sdk.AccAddress,
+	inAmount sdk.Int,
+	outAddresses []sdk.AccAddress,
+	outCoins []sdk.Coins,
+) sdk.Int {
+	admin := k.getAdmin(ctx, ft)
+	if !ft.IsBurnRateApplicable(admin, inAddress, nil) || k.IsBurnRateExempt(ctx, inAddress, ft.Denom) {
+		return sdk.ZeroInt()
+	}
+
+	totalOutAmount := sdk.ZeroInt()
+	taxedOutAmount := sdk.ZeroInt()
+	for i, outAddress := range outAddresses {
+		amount := outCoins[i].AmountOf(ft.Denom)
+		if !amount.IsPositive() {
+			continue
+		}
+		totalOutAmount = totalOutAmount.Add(amount)
+		if ft.IsBurnRateApplicable(admin, inAddress, outAddress) && !k.IsBurnRateExempt(ctx, outAddress, ft.Denom) {
+			taxedOutAmount = taxedOutAmount.Add(amount)
+		}
+	}
+
+	return ft.CalculateMultiSendBurnRateAmount(inAmount, taxedOutAmount, totalOutAmount)
+}
+
+// calculateMultiSendCommissionAmount returns the commission charged on the input of the multi-send. Like the burn rate,
+// it is applied once to the input amount, only to its share sent to the outputs other than the admin.
+func (k Keeper) calculateMultiSendCommissionAmount(
+	ctx sdk.Context,
+	ft types.FTDefinition,
+	inAddress sdk.AccAddress,
+	inAmount sdk.Int,
+	outAddresses []sdk.AccAddress,
+	outCoins []sdk.Coins,
+) sdk.Int {
+	admin := k.getAdmin(ctx, ft)
+	if !ft.IsSendCommissionRateApplicable(admin, inAddress, nil) {
+		return sdk.ZeroInt()
+	}
+
+	totalOutAmount := sdk.ZeroInt()
+	taxedOutAmount := sdk.ZeroInt()
+	for i, outAddress := range outAddresses {
+		amount := outCoins[i].AmountOf(ft.Denom)
+		if !amount.IsPositive() {
+			continue
+		}
+		totalOutAmount = totalOutAmount.Add(amount)
+		if ft.IsSendCommissionRateApplicable(admin, inAddress, outAddress) {
+			taxedOutAmount = taxedOutAmount.Add(amount)
+		}
+	}
+
+	return ft.CalculateMultiSendCommissionAmount(inAmount, taxedOutAmount, totalOutAmount)
+}
+
+// sumIOCoins sums up the coins of the inputs or outputs by the address, keeping the order the addresses first appear in.
+func sumIOCoins[T any](ios []T, unpack func(T) (string, sdk.Coins)) ([]sdk.AccAddress, []sdk.Coins, error) {
+	addresses := make([]sdk.AccAddress, 0, len(ios))
+	coins := make([]sdk.Coins, 0, len(ios))
+	indexes := map[string]int{}
+	for _, io := range ios {
+		address, ioCoins := unpack(io)
+		if i, exists := indexes[address]; exists {
+			coins[i] = coins[i].Add(ioCoins...)
+			continue
+		}
+
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, nil, err
+		}
+		indexes[address] = len(addresses)
+		addresses = append(addresses, accAddress)
+		coins = append(coins, ioCoins)
+	}
+
+	return addresses, coins, nil
+}
+
 // Logger returns the Keeper logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -109,7 +316,7 @@ func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_mint) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_mint) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
@@ -124,7 +331,7 @@ func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_burn) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_burn) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
@@ -132,14 +339,15 @@ func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 	return k.burn(ctx, sender, ft, coin.Amount)
 }
 
-func (k Keeper) checkFeatureAllowed(sender sdk.AccAddress, ft types.FTDefinition, feature types.TokenFeature) error {
+func (k Keeper) checkFeatureAllowed(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	ft types.FTDefinition,
+	feature types.TokenFeature,
+) error {
 	if !ft.IsFeatureEnabled(feature) {
 		return sdkerrors.Wrapf(types.ErrFeatureNotActive, "denom:%s, feature:%s", ft.Denom, feature)
 	}
 
-	if ft.Issuer != sender.String() {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is unauthorized to perform this operation", sender.String())
-	}
-
-	return nil
+	return k.checkAdmin(ctx, sender, ft)
 }
@@ -2,17 +2,22 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
 	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
 )
 
@@ -162,14 +167,15 @@ func TestKeeper_Issue(t *testing.T) {
 	gotToken, err := ftKeeper.GetToken(ctx, denom)
 	requireT.NoError(err)
 	requireT.Equal(types.FT{
-		Denom:       denom,
-		Issuer:      settings.Issuer.String(),
-		Symbol:      settings.Symbol,
-		Description: settings.Description,
-		Subunit:     strings.ToLower(settings.Subunit),
-		Precision:   settings.Precision,
-		Features:    []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
-		BurnRate:    sdk.NewDec(0),
+		Denom:              denom,
+		Issuer:             settings.Issuer.String(),
+		Symbol:             settings.Symbol,
+		Description:        settings.Description,
+		Subunit:            strings.ToLower(settings.Subunit),
+		Precision:          settings.Precision,
+		Features:           []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
+		BurnRate:           sdk.NewDec(0),
+		SendCommissionRate: sdk.NewDec(0),
 	}, gotToken)
 
 	// check the metadata
@@ -180,18 +186,19 @@ func TestKeeper_Issue(t *testing.T) {
 		Symbol:      settings.Symbol,
 		Description: settings.Description,
 		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    settings.Symbol,
-				Exponent: settings.Precision,
-			},
 			{
 				Denom:    denom,
 				Exponent: 0,
 			},
+			{
+				Denom:    settings.Symbol,
+				Exponent: settings.Precision,
+			},
 		},
 		Base:    denom,
 		Display: settings.Symbol,
 	}, storedMetadata)
+	requireT.NoError(storedMetadata.Validate())
 
 	// check the account state
 	issuedAssetBalance := bankKeeper.GetBalance(ctx, addr, denom)
@@ -209,6 +216,292 @@ func TestKeeper_Issue(t *testing.T) {
 	st.Symbol = "aBc"
 	_, err = ftKeeper.Issue(ctx, st)
 	requireT.True(errors.Is(types.ErrInvalidInput, err))
+
+	// check too high precision
+	st = settings
+	st.Subunit = "high"
+	st.Symbol = "HIGH"
+	st.Precision = types.MaxPrecision + 1
+	_, err = ftKeeper.Issue(ctx, st)
+	requireT.True(types.ErrInvalidInput.Is(err))
+}
+
+func TestKeeper_IssueIdempotency(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:         issuer,
+		Symbol:         "ABC",
+		Subunit:        "abc",
+		Precision:      6,
+		InitialAmount:  sdk.NewInt(777),
+		IdempotencyKey: "key1",
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// the retried issuance returns the same denom without issuing the token again
+	retrySettings := settings
+	retrySettings.Symbol = "ABC2"
+	retrySettings.Subunit = "abc2"
+	retriedDenom, err := ftKeeper.Issue(ctx, retrySettings)
+	requireT.NoError(err)
+	requireT.Equal(denom, retriedDenom)
+	requireT.Equal(sdk.NewInt(777).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	_, err = ftKeeper.GetToken(ctx, types.BuildDenom(retrySettings.Subunit, issuer))
+	requireT.True(types.ErrFTNotFound.Is(err))
+
+	// the key is scoped to the issuer
+	otherSettings := retrySettings
+	otherSettings.Issuer = otherIssuer
+	otherDenom, err := ftKeeper.Issue(ctx, otherSettings)
+	requireT.NoError(err)
+	requireT.NotEqual(denom, otherDenom)
+
+	// the other key issues the new token
+	retrySettings.IdempotencyKey = "key2"
+	newDenom, err := ftKeeper.Issue(ctx, retrySettings)
+	requireT.NoError(err)
+	requireT.NotEqual(denom, newDenom)
+
+	// the issuance without the key isn't idempotent
+	settings.IdempotencyKey = ""
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	// the too long key is rejected
+	retrySettings.IdempotencyKey = strings.Repeat("k", types.MaxIdempotencyKeyLength+1)
+	_, err = ftKeeper.Issue(ctx, retrySettings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	requireT.ElementsMatch([]types.IssueIdempotencyRecord{
+		{Issuer: issuer.String(), Key: "key1", Denom: denom},
+		{Issuer: otherIssuer.String(), Key: "key1", Denom: otherDenom},
+		{Issuer: issuer.String(), Key: "key2", Denom: newDenom},
+	}, ftKeeper.GetIssueIdempotencyRecords(ctx))
+}
+
+func TestKeeper_IssuePrecision(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	for _, precision := range []uint32{0, 1, 18, 19, types.MaxPrecision} {
+		symbol := fmt.Sprintf("ABC%d", precision)
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        addr,
+			Symbol:        symbol,
+			Subunit:       fmt.Sprintf("abc%d", precision),
+			Precision:     precision,
+			InitialAmount: sdk.NewInt(777),
+		})
+		requireT.NoError(err)
+
+		gotToken, err := ftKeeper.GetToken(ctx, denom)
+		requireT.NoError(err)
+		requireT.Equal(precision, gotToken.Precision)
+		requireT.Equal(symbol, gotToken.Symbol)
+
+		storedMetadata, found := bankKeeper.GetDenomMetaData(ctx, denom)
+		requireT.True(found)
+		requireT.NoError(storedMetadata.Validate())
+		if precision == 0 {
+			// the symbol of the token with zero precision is the alias of the base unit
+			requireT.Equal([]*banktypes.DenomUnit{
+				{
+					Denom:    denom,
+					Exponent: 0,
+					Aliases:  []string{symbol},
+				},
+			}, storedMetadata.DenomUnits)
+			requireT.Equal(denom, storedMetadata.Display)
+		}
+	}
+}
+
+func TestKeeper_IssueLengthParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	requireT.Equal(types.DefaultParams(), ftKeeper.GetParams(ctx))
+	ftKeeper.SetParams(ctx, types.Params{
+		MaxSymbolLength:      5,
+		MaxDescriptionLength: 10,
+	})
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        addr,
+		Symbol:        "ABCDE",
+		Subunit:       "abc",
+		Description:   strings.Repeat("a", 10),
+		InitialAmount: sdk.NewInt(777),
+	}
+
+	// the limits are applied on top of the ones checked by the messages
+	invalidSettings := settings
+	invalidSettings.Symbol = "ABCDEF"
+	_, err := ftKeeper.Issue(ctx, invalidSettings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	invalidSettings = settings
+	invalidSettings.Description = strings.Repeat("a", 11)
+	_, err = ftKeeper.Issue(ctx, invalidSettings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	res, err := keeper.NewQueryService(ftKeeper).Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	requireT.NoError(err)
+	requireT.Equal(ftKeeper.GetParams(ctx), res.Params)
+}
+
+func TestKeeper_ParamsNotStored(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the params are missing in the store of the chain started before they were introduced
+	paramsStore := prefix.NewStore(ctx.KVStore(testApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyMaxSymbolLength,
+		types.KeyMaxDescriptionLength,
+		types.KeyMaxTokenAttributes,
+		types.KeyMaxTokenAttributeValueLength,
+	} {
+		requireT.True(paramsStore.Has(key))
+		paramsStore.Delete(key)
+	}
+
+	ftKeeper := testApp.AssetFTKeeper
+	requireT.Equal(types.DefaultParams(), ftKeeper.GetParams(ctx))
+	_, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		InitialAmount: sdk.NewInt(777),
+	})
+	requireT.NoError(err)
+}
+
+func TestKeeper_GetTokensByFeature(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	issue := func(subunit string, features ...types.TokenFeature) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        addr,
+			Symbol:        strings.ToUpper(subunit),
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdk.NewInt(100),
+			Features:      features,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	denom1 := issue("abc", types.TokenFeature_freeze, types.TokenFeature_whitelist) //nolint:nosnakecase
+	denom2 := issue("def", types.TokenFeature_whitelist)                            //nolint:nosnakecase
+	issue("ghi")
+
+	denoms := func(feature types.TokenFeature, pagination *query.PageRequest) []string {
+		tokens, _, err := ftKeeper.GetTokensByFeature(ctx, feature, pagination)
+		requireT.NoError(err)
+		denoms := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			denoms = append(denoms, token.Denom)
+		}
+		return denoms
+	}
+
+	requireT.ElementsMatch([]string{denom1, denom2}, denoms(types.TokenFeature_whitelist, nil)) //nolint:nosnakecase
+	requireT.Equal([]string{denom1}, denoms(types.TokenFeature_freeze, nil))                    //nolint:nosnakecase
+	requireT.Empty(denoms(types.TokenFeature_mint, nil))                                        //nolint:nosnakecase
+	requireT.Len(denoms(types.TokenFeature_whitelist, &query.PageRequest{Limit: 1}), 1)         //nolint:nosnakecase
+
+	// the query service returns all the tokens if the feature isn't provided
+	queryService := keeper.NewQueryService(ftKeeper)
+	res, err := queryService.Tokens(sdk.WrapSDKContext(ctx), &types.QueryTokensRequest{})
+	requireT.NoError(err)
+	requireT.Len(res.Tokens, 3)
+
+	res, err = queryService.Tokens(sdk.WrapSDKContext(ctx), &types.QueryTokensRequest{Feature: "whitelist"})
+	requireT.NoError(err)
+	requireT.Len(res.Tokens, 2)
+
+	_, err = queryService.Tokens(sdk.WrapSDKContext(ctx), &types.QueryTokensRequest{Feature: "unknown"})
+	requireT.True(types.ErrInvalidInput.Is(err))
+}
+
+func TestKeeper_IterateTokens(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denoms := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        addr,
+			Symbol:        fmt.Sprintf("ABC%d", i),
+			Subunit:       fmt.Sprintf("abc%d", i),
+			Precision:     6,
+			InitialAmount: sdk.NewInt(100),
+		})
+		requireT.NoError(err)
+		denoms = append(denoms, denom)
+	}
+
+	// all the tokens are iterated in the same order as returned by GetTokens
+	tokens, _, err := ftKeeper.GetTokens(ctx, nil)
+	requireT.NoError(err)
+	var iteratedTokens []types.FT
+	requireT.NoError(ftKeeper.IterateTokens(ctx, func(token types.FT) bool {
+		iteratedTokens = append(iteratedTokens, token)
+		return false
+	}))
+	requireT.Equal(tokens, iteratedTokens)
+	iteratedDenoms := make([]string, 0, len(iteratedTokens))
+	for _, token := range iteratedTokens {
+		iteratedDenoms = append(iteratedDenoms, token.Denom)
+	}
+	requireT.ElementsMatch(denoms, iteratedDenoms)
+
+	// the iteration stops when the callback returns true
+	var count int
+	requireT.NoError(ftKeeper.IterateTokens(ctx, func(token types.FT) bool {
+		count++
+		return count == 2
+	}))
+	requireT.Equal(2, count)
 }
 
 func TestKeeper_Mint(t *testing.T) {
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the global freezes of the fungible tokens to the suspended status.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	globalFreezeStore := prefix.NewStore(store, types.GlobalFreezeKeyPrefix)
+
+	var denoms []string
+	iterator := globalFreezeStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, denom := range denoms {
+		globalFreezeStore.Delete([]byte(denom))
+		m.keeper.SetTokenStatusRecord(ctx, denom, types.TokenStatus_suspended) //nolint:nosnakecase
+	}
+
+	return nil
+}
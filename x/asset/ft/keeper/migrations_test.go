@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestMigrator_Migrate1to2(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issue := func(i int) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        fmt.Sprintf("ABC%d", i),
+			Subunit:       fmt.Sprintf("uabc%d", i),
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+		})
+		requireT.NoError(err)
+		requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+		return denom
+	}
+
+	var frozenDenoms, activeDenoms []string
+	for i := 0; i < 3; i++ {
+		frozenDenoms = append(frozenDenoms, issue(i))
+	}
+	for i := 3; i < 5; i++ {
+		activeDenoms = append(activeDenoms, issue(i))
+	}
+
+	// the global freezes stored by the version 1 of the module
+	store := ctx.KVStore(testApp.GetKey(types.StoreKey))
+	for _, denom := range frozenDenoms {
+		store.Set(types.CreateGlobalFreezePrefix(denom), []byte{0x00})
+	}
+
+	requireT.NoError(keeper.NewMigrator(ftKeeper).Migrate1to2(ctx))
+
+	for _, denom := range frozenDenoms {
+		requireT.Equal(types.TokenStatus_suspended, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+		token, err := ftKeeper.GetToken(ctx, denom)
+		requireT.NoError(err)
+		requireT.True(token.GloballyFrozen)
+		err = bankKeeper.SendCoins(ctx, holder, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+		requireT.ErrorIs(err, types.ErrGloballyFrozen)
+	}
+	for _, denom := range activeDenoms {
+		requireT.Equal(types.TokenStatus_active, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+		token, err := ftKeeper.GetToken(ctx, denom)
+		requireT.NoError(err)
+		requireT.False(token.GloballyFrozen)
+		requireT.NoError(bankKeeper.SendCoins(ctx, holder, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	}
+
+	// the legacy records are removed
+	iterator := prefix.NewStore(store, types.GlobalFreezeKeyPrefix).Iterator(nil, nil)
+	requireT.False(iterator.Valid())
+	requireT.NoError(iterator.Close())
+
+	// the migration of the migrated store doesn't change anything
+	requireT.NoError(keeper.NewMigrator(ftKeeper).Migrate1to2(ctx))
+	for _, denom := range frozenDenoms {
+		requireT.Equal(types.TokenStatus_suspended, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+	}
+	for _, denom := range activeDenoms {
+		requireT.Equal(types.TokenStatus_active, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+	}
+}
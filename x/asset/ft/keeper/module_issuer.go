@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// ModuleIssuer performs the issuer operations on behalf of the module account, so the modules may own the fungible
+// tokens, e.g. the LP shares or the receipts, without signing the messages. It is the capability granted to the module
+// at the app wiring, the operations not permitted there are rejected.
+type ModuleIssuer struct {
+	keeper      Keeper
+	moduleName  string
+	address     sdk.AccAddress
+	permissions map[string]struct{}
+}
+
+// ScopeToModule returns the issuer operating the fungible tokens of the module account with the granted permissions.
+// It must be called at the app wiring only, once per module.
+func (k Keeper) ScopeToModule(moduleName string, permissions ...string) ModuleIssuer {
+	address := authtypes.NewModuleAddress(moduleName)
+	if _, exists := k.moduleIssuers[address.String()]; exists {
+		panic(sdkerrors.Wrapf(types.ErrInvalidInput, "module %s is scoped already", moduleName))
+	}
+	if moduleName == types.ModuleName {
+		panic(sdkerrors.Wrapf(types.ErrInvalidInput, "module %s can't be scoped", moduleName))
+	}
+	k.moduleIssuers[address.String()] = moduleName
+
+	mi := ModuleIssuer{
+		keeper:      k,
+		moduleName:  moduleName,
+		address:     address,
+		permissions: map[string]struct{}{},
+	}
+	for _, permission := range permissions {
+		mi.permissions[permission] = struct{}{}
+	}
+	return mi
+}
+
+// Address returns the address of the module account issuing the tokens.
+func (mi ModuleIssuer) Address() sdk.AccAddress {
+	return mi.address
+}
+
+// Issue issues the fungible token by the module account. The initial amount is minted to the module account.
+func (mi ModuleIssuer) Issue(ctx sdk.Context, settings types.IssueSettings) (string, error) {
+	if err := mi.checkPermission(types.IssuerPermissionIssue); err != nil {
+		return "", err
+	}
+	settings.Issuer = mi.address
+	return mi.keeper.Issue(ctx, settings)
+}
+
+// Mint mints the fungible token issued by the module account to the recipient.
+func (mi ModuleIssuer) Mint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) error {
+	if err := mi.checkPermission(types.IssuerPermissionMint); err != nil {
+		return err
+	}
+	ft, err := mi.keeper.GetTokenDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+	if err := mi.keeper.checkFeatureAllowed(ctx, mi.address, ft, types.TokenFeature_mint); err != nil { //nolint:nosnakecase
+		return err
+	}
+
+	return mi.keeper.mint(ctx, ft, coin.Amount, recipient)
+}
+
+// Burn burns the fungible token held by the module account.
+func (mi ModuleIssuer) Burn(ctx sdk.Context, coin sdk.Coin) error {
+	if err := mi.checkPermission(types.IssuerPermissionBurn); err != nil {
+		return err
+	}
+	return mi.keeper.Burn(ctx, mi.address, coin)
+}
+
+// Freeze freezes the fungible token issued by the module account on the account.
+func (mi ModuleIssuer) Freeze(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	if err := mi.checkPermission(types.IssuerPermissionFreeze); err != nil {
+		return err
+	}
+	return mi.keeper.Freeze(ctx, mi.address, addr, coin)
+}
+
+// Unfreeze unfreezes the fungible token issued by the module account on the account.
+func (mi ModuleIssuer) Unfreeze(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	if err := mi.checkPermission(types.IssuerPermissionFreeze); err != nil {
+		return err
+	}
+	return mi.keeper.Unfreeze(ctx, mi.address, addr, coin)
+}
+
+// GloballyFreeze freezes the fungible token issued by the module account on all the accounts.
+func (mi ModuleIssuer) GloballyFreeze(ctx sdk.Context, denom string) error {
+	if err := mi.checkPermission(types.IssuerPermissionGlobalFreeze); err != nil {
+		return err
+	}
+	return mi.keeper.GloballyFreeze(ctx, mi.address, denom)
+}
+
+// GloballyUnfreeze unfreezes the fungible token issued by the module account on all the accounts.
+func (mi ModuleIssuer) GloballyUnfreeze(ctx sdk.Context, denom string) error {
+	if err := mi.checkPermission(types.IssuerPermissionGlobalFreeze); err != nil {
+		return err
+	}
+	return mi.keeper.GloballyUnfreeze(ctx, mi.address, denom)
+}
+
+// SetWhitelistedBalance sets the whitelisted limit of the fungible token issued by the module account on the account.
+func (mi ModuleIssuer) SetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	if err := mi.checkPermission(types.IssuerPermissionWhitelist); err != nil {
+		return err
+	}
+	return mi.keeper.SetWhitelistedBalance(ctx, mi.address, addr, coin)
+}
+
+func (mi ModuleIssuer) checkPermission(permission string) error {
+	if _, ok := mi.permissions[permission]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module %s has no %s permission", mi.moduleName, permission)
+	}
+	return nil
+}
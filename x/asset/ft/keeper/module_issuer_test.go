@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestModuleIssuer(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	moduleIssuer := ftKeeper.ScopeToModule(
+		distrtypes.ModuleName,
+		types.IssuerPermissionIssue,
+		types.IssuerPermissionMint,
+		types.IssuerPermissionFreeze,
+		types.IssuerPermissionGlobalFreeze,
+	)
+	requireT.Equal(authtypes.NewModuleAddress(distrtypes.ModuleName), moduleIssuer.Address())
+
+	// the module can be scoped once only
+	requireT.Panics(func() {
+		ftKeeper.ScopeToModule(distrtypes.ModuleName, types.IssuerPermissionBurn)
+	})
+	requireT.Panics(func() {
+		ftKeeper.ScopeToModule(types.ModuleName, types.IssuerPermissionIssue)
+	})
+
+	// the initial amount is received by the module account even though it can't receive the coins sent to the accounts
+	denom, err := moduleIssuer.Issue(ctx, types.IssueSettings{
+		Symbol:        "LP",
+		Subunit:       "ulp",
+		InitialAmount: sdk.NewInt(100),
+		Features: []types.TokenFeature{
+			types.TokenFeature_mint,   //nolint:nosnakecase // generated name
+			types.TokenFeature_burn,   //nolint:nosnakecase // generated name
+			types.TokenFeature_freeze, //nolint:nosnakecase // generated name
+		},
+	})
+	requireT.NoError(err)
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(moduleIssuer.Address().String(), token.Issuer)
+	requireT.Equal(sdk.NewInt64Coin(denom, 100), bankKeeper.GetBalance(ctx, moduleIssuer.Address(), denom))
+
+	// the module mints the tokens to the accounts and to itself
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(moduleIssuer.Mint(ctx, holder, sdk.NewInt64Coin(denom, 50)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50), bankKeeper.GetBalance(ctx, holder, denom))
+	requireT.NoError(moduleIssuer.Mint(ctx, moduleIssuer.Address(), sdk.NewInt64Coin(denom, 10)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 110), bankKeeper.GetBalance(ctx, moduleIssuer.Address(), denom))
+
+	// the module freezes the tokens programmatically
+	requireT.NoError(moduleIssuer.Freeze(ctx, holder, sdk.NewInt64Coin(denom, 20)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 20), ftKeeper.GetFrozenBalance(ctx, holder, denom))
+	requireT.NoError(moduleIssuer.Unfreeze(ctx, holder, sdk.NewInt64Coin(denom, 5)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 15), ftKeeper.GetFrozenBalance(ctx, holder, denom))
+
+	requireT.NoError(moduleIssuer.GloballyFreeze(ctx, denom))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(token.GloballyFrozen)
+	requireT.NoError(moduleIssuer.GloballyUnfreeze(ctx, denom))
+
+	// the operations without the permissions are rejected
+	requireT.True(sdkerrors.ErrUnauthorized.Is(moduleIssuer.Burn(ctx, sdk.NewInt64Coin(denom, 10))))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(
+		moduleIssuer.SetWhitelistedBalance(ctx, holder, sdk.NewInt64Coin(denom, 10)),
+	))
+
+	// the module can't operate the tokens issued by others
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		InitialAmount: sdk.NewInt(100),
+		Features:      []types.TokenFeature{types.TokenFeature_mint}, //nolint:nosnakecase // generated name
+	})
+	requireT.NoError(err)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(moduleIssuer.Mint(ctx, holder, sdk.NewInt64Coin(otherDenom, 10))))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,11 +18,40 @@ type MsgKeeper interface {
 	GetToken(ctx sdk.Context, denom string) (types.FT, error)
 	Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	SetFrozenRate(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, rate sdk.Dec) error
+	FreezeUntil(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, unfreezeTime time.Time) error
+	FreezeAccount(ctx sdk.Context, issuer, account sdk.AccAddress) error
+	UnfreezeAccount(ctx sdk.Context, issuer, account sdk.AccAddress) error
 	Mint(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
 	Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
+	GrantBurnAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, coin sdk.Coin) error
+	BurnFrom(ctx sdk.Context, sender, owner sdk.AccAddress, coin sdk.Coin) error
 	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	ScheduleGlobalFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string, activationTime time.Time) error
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	SetTokenStatus(ctx sdk.Context, sender sdk.AccAddress, denom string, status types.TokenStatus) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	SetWhitelistExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, exempt bool) error
+	SetBurnRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, exempt bool) error
+	UpdateTokenMetadata(ctx sdk.Context, sender sdk.AccAddress, denom, description, uri, uriHash string) error
+	SetTokenAttribute(ctx sdk.Context, sender sdk.AccAddress, denom, key, value string) error
+	Wrap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
+	Unwrap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
+	BridgeMint(ctx sdk.Context, settings types.BridgeMintSettings) error
+	BridgeBurn(ctx sdk.Context, settings types.BridgeBurnSettings) error
+	RegisterIBCDenom(ctx sdk.Context, trace types.IBCDenomTrace) error
+	Reserve(ctx sdk.Context, settings types.ReserveSettings) (uint64, error)
+	Release(ctx sdk.Context, sender sdk.AccAddress, id uint64) error
+	Capture(ctx sdk.Context, settings types.CaptureSettings) error
+	PublishReserveAttestation(ctx sdk.Context, settings types.ReserveAttestationSettings) error
+	TransferAdmin(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		account sdk.AccAddress,
+		gracePeriod time.Duration,
+	) error
+	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -37,26 +67,34 @@ func NewMsgServer(keeper MsgKeeper) MsgServer {
 }
 
 // Issue defines a tx handler to issue a new fungible token.
-func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.EmptyResponse, error) {
+func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.MsgIssueResponse, error) {
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid issuer in MsgIssue")
 	}
-	_, err = ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), types.IssueSettings{
-		Issuer:        issuer,
-		Symbol:        req.Symbol,
-		Subunit:       req.Subunit,
-		Precision:     req.Precision,
-		Description:   req.Description,
-		InitialAmount: req.InitialAmount,
-		Features:      req.Features,
-		BurnRate:      req.BurnRate,
+	denom, err := ms.keeper.Issue(sdk.UnwrapSDKContext(ctx), types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             req.Symbol,
+		Subunit:            req.Subunit,
+		Precision:          req.Precision,
+		Description:        req.Description,
+		InitialAmount:      req.InitialAmount,
+		Features:           req.Features,
+		BurnRate:           req.BurnRate,
+		SendCommissionRate: req.SendCommissionRate,
+		IdempotencyKey:     req.IdempotencyKey,
+		VestingSchedule:    req.VestingSchedule,
+		URI:                req.URI,
+		URIHash:            req.URIHash,
+		Draft:              req.Draft,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.EmptyResponse{}, nil
+	return &types.MsgIssueResponse{
+		Denom: denom,
+	}, nil
 }
 
 // Freeze freezes coins on an account.
@@ -101,6 +139,104 @@ func (ms MsgServer) Unfreeze(goCtx context.Context, req *types.MsgUnfreeze) (*ty
 	return &types.EmptyResponse{}, nil
 }
 
+// SetFrozenRate freezes the share of the balance held by an account.
+func (ms MsgServer) SetFrozenRate(goCtx context.Context, req *types.MsgSetFrozenRate) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.SetFrozenRate(ctx, sender, account, req.Denom, req.Rate); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// FreezeUntil freezes a part of the balance held by an account until the unfreeze time.
+func (ms MsgServer) FreezeUntil(goCtx context.Context, req *types.MsgFreezeUntil) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.FreezeUntil(ctx, sender, account, req.Coin, req.UnfreezeTime); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// FreezeAccount freezes all the tokens of the sender held by an account.
+func (ms MsgServer) FreezeAccount(goCtx context.Context, req *types.MsgFreezeAccount) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.FreezeAccount(ctx, sender, account); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UnfreezeAccount removes the freeze of all the tokens of the sender held by an account.
+func (ms MsgServer) UnfreezeAccount(goCtx context.Context, req *types.MsgUnfreezeAccount) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.UnfreezeAccount(ctx, sender, account); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BatchFreeze freezes coins on many accounts.
+func (ms MsgServer) BatchFreeze(goCtx context.Context, req *types.MsgBatchFreeze) (*types.EmptyResponse, error) {
+	if err := processBatch(sdk.UnwrapSDKContext(goCtx), req.Sender, req.Entries, ms.keeper.Freeze); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BatchUnfreeze unfreezes coins on many accounts.
+func (ms MsgServer) BatchUnfreeze(goCtx context.Context, req *types.MsgBatchUnfreeze) (*types.EmptyResponse, error) {
+	if err := processBatch(sdk.UnwrapSDKContext(goCtx), req.Sender, req.Entries, ms.keeper.Unfreeze); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // Mint mints new fungible tokens.
 func (ms MsgServer) Mint(goCtx context.Context, req *types.MsgMint) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -133,7 +269,50 @@ func (ms MsgServer) Burn(goCtx context.Context, req *types.MsgBurn) (*types.Empt
 	return &types.EmptyResponse{}, nil
 }
 
-// GloballyFreeze globally freezes fungible token
+// GrantBurnAllowance allows the spender to burn the fungible tokens from the sender balance.
+func (ms MsgServer) GrantBurnAllowance(
+	goCtx context.Context,
+	req *types.MsgGrantBurnAllowance,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid spender address")
+	}
+
+	if err := ms.keeper.GrantBurnAllowance(ctx, sender, spender, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BurnFrom burns the fungible tokens from the account which has granted the burn allowance to the sender.
+func (ms MsgServer) BurnFrom(goCtx context.Context, req *types.MsgBurnFrom) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.BurnFrom(ctx, sender, account, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GloballyFreeze globally freezes fungible token or schedules the global freeze if the activation time is set
 func (ms MsgServer) GloballyFreeze(goCtx context.Context, req *types.MsgGloballyFreeze) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(req.Sender)
@@ -141,6 +320,13 @@ func (ms MsgServer) GloballyFreeze(goCtx context.Context, req *types.MsgGlobally
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
 	}
 
+	if req.ActivationTime != nil {
+		if err := ms.keeper.ScheduleGlobalFreeze(ctx, sender, req.Denom, *req.ActivationTime); err != nil {
+			return nil, err
+		}
+		return &types.EmptyResponse{}, nil
+	}
+
 	if err := ms.keeper.GloballyFreeze(ctx, sender, req.Denom); err != nil {
 		return nil, err
 	}
@@ -163,6 +349,21 @@ func (ms MsgServer) GloballyUnfreeze(goCtx context.Context, req *types.MsgGlobal
 	return &types.EmptyResponse{}, nil
 }
 
+// SetTokenStatus moves the fungible token to another lifecycle status.
+func (ms MsgServer) SetTokenStatus(goCtx context.Context, req *types.MsgSetTokenStatus) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.SetTokenStatus(ctx, sender, req.Denom, req.Status); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // SetWhitelistedLimit sets the limit of how many tokens account may hold
 func (ms MsgServer) SetWhitelistedLimit(goCtx context.Context, req *types.MsgSetWhitelistedLimit) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -183,3 +384,317 @@ func (ms MsgServer) SetWhitelistedLimit(goCtx context.Context, req *types.MsgSet
 
 	return &types.EmptyResponse{}, nil
 }
+
+// BatchSetWhitelistedLimit sets the whitelisted limits of many accounts.
+func (ms MsgServer) BatchSetWhitelistedLimit(
+	goCtx context.Context,
+	req *types.MsgBatchSetWhitelistedLimit,
+) (*types.EmptyResponse, error) {
+	err := processBatch(sdk.UnwrapSDKContext(goCtx), req.Sender, req.Entries, ms.keeper.SetWhitelistedBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetWhitelistExemption exempts the account from the whitelisted limits or revokes the exemption.
+func (ms MsgServer) SetWhitelistExemption(goCtx context.Context, req *types.MsgSetWhitelistExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.SetWhitelistExemption(ctx, sender, account, req.Denom, req.Exempt); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetBurnRateExemption exempts the account from the burn rate or revokes the exemption.
+func (ms MsgServer) SetBurnRateExemption(goCtx context.Context, req *types.MsgSetBurnRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.SetBurnRateExemption(ctx, sender, account, req.Denom, req.Exempt); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateTokenMetadata updates the description, URI and URI hash of the fungible token.
+func (ms MsgServer) UpdateTokenMetadata(goCtx context.Context, req *types.MsgUpdateTokenMetadata) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateTokenMetadata(ctx, sender, req.Denom, req.Description, req.URI, req.URIHash); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetTokenAttribute sets or removes the key-value attribute of the fungible token.
+func (ms MsgServer) SetTokenAttribute(goCtx context.Context, req *types.MsgSetTokenAttribute) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.SetTokenAttribute(ctx, sender, req.Denom, req.Key, req.Value); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Wrap locks native coins and mints the wrapped fungible token.
+func (ms MsgServer) Wrap(goCtx context.Context, req *types.MsgWrap) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.Wrap(ctx, sender, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Unwrap burns the wrapped fungible token and releases native coins.
+func (ms MsgServer) Unwrap(goCtx context.Context, req *types.MsgUnwrap) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.Unwrap(ctx, sender, req.Coin); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BridgeMint mints the fungible token transferred from the external chain.
+func (ms MsgServer) BridgeMint(goCtx context.Context, req *types.MsgBridgeMint) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	}
+
+	if err := ms.keeper.BridgeMint(ctx, types.BridgeMintSettings{
+		Sender:       sender,
+		Recipient:    recipient,
+		Coin:         req.Coin,
+		TransferID:   req.TransferID,
+		Attestations: req.Attestations,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BridgeBurn burns the fungible token to be released on the external chain.
+func (ms MsgServer) BridgeBurn(goCtx context.Context, req *types.MsgBridgeBurn) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.BridgeBurn(ctx, types.BridgeBurnSettings{
+		Sender:      sender,
+		Coin:        req.Coin,
+		Destination: req.Destination,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RegisterIBCDenom registers the trace of the IBC voucher denom.
+func (ms MsgServer) RegisterIBCDenom(goCtx context.Context, req *types.MsgRegisterIBCDenom) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.keeper.RegisterIBCDenom(ctx, req.Trace); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Reserve locks the funds of the payer for the payee.
+func (ms MsgServer) Reserve(goCtx context.Context, req *types.MsgReserve) (*types.MsgReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	payer, err := sdk.AccAddressFromBech32(req.Payer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid payer address")
+	}
+	payee, err := sdk.AccAddressFromBech32(req.Payee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid payee address")
+	}
+
+	id, err := ms.keeper.Reserve(ctx, types.ReserveSettings{
+		Payer:      payer,
+		Payee:      payee,
+		Amount:     req.Amount,
+		Expiration: req.Expiration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReserveResponse{
+		ID: id,
+	}, nil
+}
+
+// Release returns the reserved funds to the payer.
+func (ms MsgServer) Release(goCtx context.Context, req *types.MsgRelease) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.Release(ctx, sender, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Capture transfers the reserved funds to the payee.
+func (ms MsgServer) Capture(goCtx context.Context, req *types.MsgCapture) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.Capture(ctx, types.CaptureSettings{
+		Sender: sender,
+		ID:     req.ID,
+		Amount: req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// PublishReserveAttestation publishes the attestation of the reserves backing the fungible token.
+func (ms MsgServer) PublishReserveAttestation(
+	goCtx context.Context,
+	req *types.MsgPublishReserveAttestation,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.PublishReserveAttestation(ctx, types.ReserveAttestationSettings{
+		Sender:               sender,
+		Denom:                req.Denom,
+		Amount:               req.Amount,
+		AuditorSignatureHash: req.AuditorSignatureHash,
+		URI:                  req.URI,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// TransferAdmin transfers the issuer privileges of the fungible token to another account.
+func (ms MsgServer) TransferAdmin(goCtx context.Context, req *types.MsgTransferAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.TransferAdmin(ctx, sender, req.Denom, account, req.GracePeriod); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClearAdmin removes the admin of the fungible token.
+func (ms MsgServer) ClearAdmin(goCtx context.Context, req *types.MsgClearAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.ClearAdmin(ctx, sender, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// processBatch applies the operation to each entry of the batch message. The first failing entry fails the whole
+// message, so the state changes made by the previous entries are reverted together with the transaction.
+func processBatch(
+	ctx sdk.Context,
+	senderAddr string,
+	entries []types.AccountCoin,
+	apply func(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error,
+) error {
+	sender, err := sdk.AccAddressFromBech32(senderAddr)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	for i, entry := range entries {
+		account, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address in entry %d", i)
+		}
+		if err := apply(ctx, sender, account, entry.Coin); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d failed", i)
+		}
+	}
+
+	return nil
+}
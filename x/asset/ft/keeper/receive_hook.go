@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// receiveHookMsg is the sudo message sent to the contract receiving the token with the receive hook feature.
+type receiveHookMsg struct {
+	Receive receiveHookMsgData `json:"receive"`
+}
+
+type receiveHookMsgData struct {
+	Sender string   `json:"sender"`
+	Coin   sdk.Coin `json:"coin"`
+}
+
+// CallReceiveHooks calls the receive hook of the contract for each of the transferred tokens having the receive hook
+// feature enabled. Nothing is done if the recipient isn't a contract. The transfer is reverted if the hook fails,
+// including the case when the contract doesn't implement it.
+func (k Keeper) CallReceiveHooks(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) error {
+	if !k.wasmKeeper.HasContractInfo(ctx, toAddress) {
+		return nil
+	}
+
+	for _, coin := range coins {
+		ft, err := k.GetTokenDefinition(ctx, coin.Denom)
+		if types.ErrFTNotFound.Is(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !ft.IsFeatureEnabled(types.TokenFeature_receive_hook) { //nolint:nosnakecase
+			continue
+		}
+		if fromAddress == nil {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"receive hook of %s can't be called for the transfer with multiple inputs",
+				coin.Denom,
+			)
+		}
+		if err := k.callReceiveHook(ctx, fromAddress, toAddress, coin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CallInputOutputReceiveHooks calls the receive hooks of the contracts receiving the tokens by the multi-send. The
+// hooks are called for the transfers having single input only, so the sender of the tokens is known.
+func (k Keeper) CallInputOutputReceiveHooks(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var fromAddress sdk.AccAddress
+	if len(inputs) == 1 {
+		var err error
+		fromAddress, err = sdk.AccAddressFromBech32(inputs[0].Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, out := range outputs {
+		toAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.CallReceiveHooks(ctx, fromAddress, toAddress, out.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) callReceiveHook(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coin sdk.Coin) error {
+	msg, err := json.Marshal(receiveHookMsg{
+		Receive: receiveHookMsgData{
+			Sender: fromAddress.String(),
+			Coin:   coin,
+		},
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't marshal receive hook message: %s", err)
+	}
+
+	// the transfers are charged the deterministic gas, so the gas of the contract call is charged on top of it
+	if _, err := k.wasmKeeper.Sudo(deterministicgastypes.WithOriginalGasMeter(ctx), toAddress, msg); err != nil {
+		return sdkerrors.Wrapf(err, "receive hook of contract %s failed for %s", toAddress, coin)
+	}
+
+	return nil
+}
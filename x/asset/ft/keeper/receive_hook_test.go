@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+type wasmKeeperMock struct {
+	contracts map[string]bool
+	sudoErr   error
+	sudoMsgs  []string
+}
+
+func (k *wasmKeeperMock) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	return k.contracts[contractAddress.String()]
+}
+
+func (k *wasmKeeperMock) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	if k.sudoErr != nil {
+		return nil, k.sudoErr
+	}
+	k.sudoMsgs = append(k.sudoMsgs, contractAddress.String()+":"+string(msg))
+	return nil, nil
+}
+
+func TestKeeper_CallReceiveHooks(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	wasmKeeper := &wasmKeeperMock{contracts: map[string]bool{contract.String(): true}}
+	ftKeeper := keeper.NewKeeper(
+		testApp.AppCodec(),
+		testApp.GetKey(types.StoreKey),
+		testApp.GetSubspace(types.ModuleName),
+		testApp.AccountKeeper,
+		testApp.BankKeeper.BaseKeeper,
+		testApp.StakingKeeper,
+		wasmKeeper,
+		nil,
+	)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	hookDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.TokenFeature{types.TokenFeature_receive_hook}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 10), sdk.NewInt64Coin(denom, 20), sdk.NewInt64Coin("ucore", 30))
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the hook is called for the tokens with the feature sent to the contracts only
+	requireT.NoError(ftKeeper.CallReceiveHooks(ctx, issuer, account, coins))
+	requireT.Empty(wasmKeeper.sudoMsgs)
+	requireT.NoError(ftKeeper.CallReceiveHooks(ctx, issuer, contract, coins))
+	requireT.Equal([]string{
+		contract.String() + `:{"receive":{"sender":"` + issuer.String() + `","coin":{"denom":"` + hookDenom + `","amount":"10"}}}`,
+	}, wasmKeeper.sudoMsgs)
+
+	// the sender of the multi-send is known if there is single input only
+	wasmKeeper.sudoMsgs = nil
+	requireT.NoError(ftKeeper.CallInputOutputReceiveHooks(ctx,
+		[]banktypes.Input{{Address: issuer.String(), Coins: coins}},
+		[]banktypes.Output{
+			{Address: account.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 4))},
+			{Address: contract.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 6))},
+		},
+	))
+	requireT.Len(wasmKeeper.sudoMsgs, 1)
+	requireT.True(types.ErrInvalidInput.Is(ftKeeper.CallInputOutputReceiveHooks(ctx,
+		[]banktypes.Input{
+			{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 5))},
+			{Address: account.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 5))},
+		},
+		[]banktypes.Output{{Address: contract.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(hookDenom, 10))}},
+	)))
+
+	// the failure of the hook fails the transfer
+	wasmKeeper.sudoErr = errors.New("no sudo entry point")
+	requireT.ErrorContains(ftKeeper.CallReceiveHooks(ctx, issuer, contract, coins), "no sudo entry point")
+}
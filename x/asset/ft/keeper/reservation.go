@@ -0,0 +1,317 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// Reserve locks the amount of the fungible token in the module escrow for the payee and returns the ID of the
+// reservation. The burn rate and the send commission of the transfer from the payer to the payee are locked too and
+// charged on the capture by the rates applicable when the reservation is made.
+func (k Keeper) Reserve(ctx sdk.Context, settings types.ReserveSettings) (uint64, error) {
+	if settings.Payer.Equals(settings.Payee) {
+		return 0, sdkerrors.Wrap(types.ErrInvalidInput, "payer and payee must be different")
+	}
+	if !settings.Amount.Amount.IsPositive() {
+		return 0, sdkerrors.Wrap(types.ErrInvalidInput, "amount to reserve must be positive")
+	}
+	if !settings.Expiration.After(ctx.BlockTime()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "expiration %s must be after the current block time", settings.Expiration)
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, settings.Amount.Denom)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", settings.Amount.Denom)
+	}
+
+	outcome := k.calculateSendOutcome(ctx, ft, settings.Payer, settings.Payee, settings.Amount.Amount)
+	escrow := sdk.NewCoin(ft.Denom, outcome.Sent)
+	burnRate := sdk.ZeroDec()
+	if outcome.Burnt.IsPositive() {
+		burnRate = ft.BurnRate
+	}
+	sendCommissionRate := sdk.ZeroDec()
+	if outcome.Commission.IsPositive() {
+		sendCommissionRate = ft.SendCommissionRate
+	}
+	if err := k.isCoinSpendable(ctx, settings.Payer, ft, escrow.Amount); err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, settings.Payer, types.ModuleName, sdk.NewCoins(escrow)); err != nil {
+		return 0, sdkerrors.Wrapf(err, "can't lock %s in the module %s", escrow.String(), types.ModuleName)
+	}
+
+	reservation := types.Reservation{
+		ID:                 k.nextReservationID(ctx),
+		Payer:              settings.Payer.String(),
+		Payee:              settings.Payee.String(),
+		Amount:             settings.Amount,
+		Escrow:             escrow,
+		Expiration:         settings.Expiration,
+		BurnRate:           burnRate,
+		SendCommissionRate: sendCommissionRate,
+	}
+	k.SetReservation(ctx, reservation)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(reservation.Amount.Denom, reservation.Payer, reservation.Payee))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFundsReserved{
+		ID:         reservation.ID,
+		Payer:      reservation.Payer,
+		Payee:      reservation.Payee,
+		Amount:     reservation.Amount,
+		Expiration: reservation.Expiration,
+	}); err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventFundsReserved: %s", err)
+	}
+
+	return reservation.ID, nil
+}
+
+// Capture transfers the amount from the reservation to the payee and returns the rest of the escrow to the payer.
+// Only the payee might capture the reservation, once.
+func (k Keeper) Capture(ctx sdk.Context, settings types.CaptureSettings) error {
+	reservation, found := k.GetReservation(ctx, settings.ID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reservation %d not found", settings.ID)
+	}
+	if reservation.Payee != settings.Sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the payee of reservation %d", settings.Sender, settings.ID)
+	}
+	if !reservation.Expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrReservationExpired, "reservation %d expired at %s", settings.ID, reservation.Expiration)
+	}
+	if settings.Amount.Denom != reservation.Amount.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "denom of reservation %d is %s", settings.ID, reservation.Amount.Denom)
+	}
+	if !settings.Amount.Amount.IsPositive() || settings.Amount.Amount.GT(reservation.Amount.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "amount to capture must be positive and not exceed %s", reservation.Amount)
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, reservation.Amount.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", reservation.Amount.Denom)
+	}
+	if err := k.applyReceiveRestrictions(ctx, settings.Sender, ft, settings.Amount.Amount); err != nil {
+		return err
+	}
+
+	if reservation.BurnRate.IsNil() || reservation.SendCommissionRate.IsNil() {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "rates of reservation %d are not stored", settings.ID)
+	}
+	payer := sdk.MustAccAddressFromBech32(reservation.Payer)
+	outcome := reservation.CalculateCaptureOutcome(settings.Amount.Amount)
+	if outcome.Sent.GT(reservation.Escrow.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrNotEnoughBalance,
+			"escrow %s of reservation %d doesn't cover the capture of %s",
+			reservation.Escrow, settings.ID, sdk.NewCoin(ft.Denom, outcome.Sent),
+		)
+	}
+	released := reservation.Escrow.Sub(sdk.NewCoin(ft.Denom, outcome.Sent))
+
+	k.deleteReservation(ctx, reservation)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, settings.Sender, sdk.NewCoins(settings.Amount)); err != nil {
+		return sdkerrors.Wrapf(err, "can't release %s from the module %s", settings.Amount.String(), types.ModuleName)
+	}
+	if outcome.Burnt.IsPositive() {
+		burnt := sdk.NewCoins(sdk.NewCoin(ft.Denom, outcome.Burnt))
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnt); err != nil {
+			return sdkerrors.Wrapf(err, "can't burn %s for the module %s", burnt.String(), types.ModuleName)
+		}
+	}
+	admin := k.getAdmin(ctx, ft)
+	if admin == "" {
+		// the commission locked by the reservation isn't charged once the admin is cleared
+		released = released.AddAmount(outcome.Commission)
+		outcome.Commission = sdk.ZeroInt()
+	}
+	if outcome.Commission.IsPositive() {
+		commission := sdk.NewCoins(sdk.NewCoin(ft.Denom, outcome.Commission))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, sdk.MustAccAddressFromBech32(admin), commission,
+		); err != nil {
+			return sdkerrors.Wrapf(err, "can't send commission %s from the module %s", commission.String(), types.ModuleName)
+		}
+	}
+	if released.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.NewCoins(released)); err != nil {
+			return sdkerrors.Wrapf(err, "can't release %s from the module %s", released.String(), types.ModuleName)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(reservation.Amount.Denom, reservation.Payer, reservation.Payee))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFundsCaptured{
+		ID:       reservation.ID,
+		Payer:    reservation.Payer,
+		Payee:    reservation.Payee,
+		Amount:   settings.Amount,
+		Released: released,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventFundsCaptured: %s", err)
+	}
+
+	return nil
+}
+
+// Release returns the escrow of the reservation to the payer. Only the payee might release the reservation, the
+// expired reservations are released by the end blocker.
+func (k Keeper) Release(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	reservation, found := k.GetReservation(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reservation %d not found", id)
+	}
+	if reservation.Payee != sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the payee of reservation %d", sender, id)
+	}
+
+	return k.release(ctx, reservation, false)
+}
+
+// EndBlocker releases the reservations which have expired, activates the global freezes and the admin transfers
+// which are due by the current block time and removes the expired timed freezes.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.releaseExpiredReservations(ctx)
+	if err := k.activatePendingGlobalFreezes(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.activatePendingAdminTransfers(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.deleteExpiredTimedFreezes(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) releaseExpiredReservations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.ReservationExpirationQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.CreateReservationExpirationQueuePrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var reservations []types.Reservation
+	for ; iterator.Valid(); iterator.Next() {
+		var reservation types.Reservation
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &reservation)
+		reservations = append(reservations, reservation)
+	}
+
+	for _, reservation := range reservations {
+		if err := k.release(ctx, reservation, true); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetReservation returns the reservation by its ID.
+func (k Keeper) GetReservation(ctx sdk.Context, id uint64) (types.Reservation, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetReservationKey(id))
+	if bz == nil {
+		return types.Reservation{}, false
+	}
+
+	var reservation types.Reservation
+	k.cdc.MustUnmarshal(bz, &reservation)
+	return reservation, true
+}
+
+// GetPayeeReservations returns the reservations made for the payee.
+func (k Keeper) GetPayeeReservations(
+	ctx sdk.Context,
+	payee sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.Reservation, *query.PageResponse, error) {
+	reservations := []types.Reservation{}
+	store := ctx.KVStore(k.storeKey)
+	payeeStore := prefix.NewStore(store, types.CreatePayeeReservationsPrefix(payee))
+	pageRes, err := query.Paginate(payeeStore, pagination, func(key, value []byte) error {
+		var reservation types.Reservation
+		k.cdc.MustUnmarshal(store.Get(types.GetReservationKey(sdk.BigEndianToUint64(key))), &reservation)
+		reservations = append(reservations, reservation)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reservations, pageRes, nil
+}
+
+// GetReservations returns all the reservations.
+func (k Keeper) GetReservations(ctx sdk.Context) []types.Reservation {
+	reservations := []types.Reservation{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReservationKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reservation types.Reservation
+		k.cdc.MustUnmarshal(iterator.Value(), &reservation)
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations
+}
+
+// SetReservation stores the reservation together with its entries in the expiration queue and the payee index.
+func (k Keeper) SetReservation(ctx sdk.Context, reservation types.Reservation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetReservationKey(reservation.ID)
+	store.Set(key, k.cdc.MustMarshal(&reservation))
+	store.Set(types.GetReservationExpirationQueueKey(reservation.ID, reservation.Expiration), key)
+	store.Set(types.GetPayeeReservationKey(sdk.MustAccAddressFromBech32(reservation.Payee), reservation.ID), []byte{0x01})
+}
+
+// GetNextReservationID returns the ID assigned to the next reservation.
+func (k Keeper) GetNextReservationID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextReservationIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextReservationID stores the ID assigned to the next reservation.
+func (k Keeper) SetNextReservationID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextReservationIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) nextReservationID(ctx sdk.Context) uint64 {
+	id := k.GetNextReservationID(ctx)
+	k.SetNextReservationID(ctx, id+1)
+	return id
+}
+
+func (k Keeper) release(ctx sdk.Context, reservation types.Reservation, expired bool) error {
+	k.deleteReservation(ctx, reservation)
+
+	payer := sdk.MustAccAddressFromBech32(reservation.Payer)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.NewCoins(reservation.Escrow)); err != nil {
+		return sdkerrors.Wrapf(err, "can't release %s from the module %s", reservation.Escrow.String(), types.ModuleName)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(reservation.Amount.Denom, reservation.Payer, reservation.Payee))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFundsReleased{
+		ID:       reservation.ID,
+		Payer:    reservation.Payer,
+		Payee:    reservation.Payee,
+		Released: reservation.Escrow,
+		Expired:  expired,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventFundsReleased: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) deleteReservation(ctx sdk.Context, reservation types.Reservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetReservationKey(reservation.ID))
+	store.Delete(types.GetReservationExpirationQueueKey(reservation.ID, reservation.Expiration))
+	store.Delete(types.GetPayeeReservationKey(sdk.MustAccAddressFromBech32(reservation.Payee), reservation.ID))
+}
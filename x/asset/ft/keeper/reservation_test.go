@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_ReserveCapture(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, payer, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+
+	settings := types.ReserveSettings{
+		Payer:      payer,
+		Payee:      payee,
+		Amount:     sdk.NewInt64Coin(denom, 100),
+		Expiration: now.Add(time.Hour),
+	}
+
+	// expiration must be in the future
+	invalidSettings := settings
+	invalidSettings.Expiration = now
+	_, err = ftKeeper.Reserve(ctx, invalidSettings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	// the burn rate must be covered by the balance
+	invalidSettings = settings
+	invalidSettings.Amount = sdk.NewInt64Coin(denom, 190)
+	_, err = ftKeeper.Reserve(ctx, invalidSettings)
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// the amount and the burn rate are locked in the module
+	id, err := ftKeeper.Reserve(ctx, settings)
+	requireT.NoError(err)
+	requireT.EqualValues(1, id)
+	requireT.Equal(sdk.NewInt(90).String(), bankKeeper.GetBalance(ctx, payer, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(110).String(), bankKeeper.GetBalance(ctx, moduleAddress, denom).Amount.String())
+
+	reservation, found := ftKeeper.GetReservation(ctx, id)
+	requireT.True(found)
+	requireT.Equal(types.Reservation{
+		ID:                 id,
+		Payer:              payer.String(),
+		Payee:              payee.String(),
+		Amount:             settings.Amount,
+		Escrow:             sdk.NewInt64Coin(denom, 110),
+		Expiration:         settings.Expiration,
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.ZeroDec(),
+	}, reservation)
+
+	reservations, _, err := ftKeeper.GetPayeeReservations(ctx, payee, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.Reservation{reservation}, reservations)
+
+	captureSettings := types.CaptureSettings{
+		Sender: payee,
+		ID:     id,
+		Amount: sdk.NewInt64Coin(denom, 60),
+	}
+
+	// only the payee can capture the funds
+	invalidCaptureSettings := captureSettings
+	invalidCaptureSettings.Sender = payer
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.Capture(ctx, invalidCaptureSettings)))
+
+	// the reserved amount can't be exceeded
+	invalidCaptureSettings = captureSettings
+	invalidCaptureSettings.Amount = sdk.NewInt64Coin(denom, 101)
+	requireT.True(types.ErrInvalidInput.Is(ftKeeper.Capture(ctx, invalidCaptureSettings)))
+
+	// the funds can't be captured once the reservation expires
+	requireT.True(types.ErrReservationExpired.Is(ftKeeper.Capture(ctx.WithBlockTime(settings.Expiration), captureSettings)))
+
+	// the captured amount is sent to the payee, its burn rate is burnt and the rest is returned to the payer
+	supply := bankKeeper.GetSupply(ctx, denom)
+	requireT.NoError(ftKeeper.Capture(ctx, captureSettings))
+	requireT.Equal(sdk.NewInt(60).String(), bankKeeper.GetBalance(ctx, payee, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(134).String(), bankKeeper.GetBalance(ctx, payer, denom).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, moduleAddress, denom).IsZero())
+	requireT.Equal(supply.Amount.SubRaw(6).String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	// the reservation can be captured once
+	_, found = ftKeeper.GetReservation(ctx, id)
+	requireT.False(found)
+	requireT.True(sdkerrors.ErrNotFound.Is(ftKeeper.Capture(ctx, captureSettings)))
+	reservations, _, err = ftKeeper.GetPayeeReservations(ctx, payee, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(reservations)
+}
+
+func TestKeeper_CaptureAfterBurnRateExemptionRevoked(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, payer, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+
+	// the payee is exempted, so no burn rate is locked
+	requireT.NoError(ftKeeper.SetBurnRateExemption(ctx, issuer, payee, denom, true))
+	id, err := ftKeeper.Reserve(ctx, types.ReserveSettings{
+		Payer:      payer,
+		Payee:      payee,
+		Amount:     sdk.NewInt64Coin(denom, 100),
+		Expiration: now.Add(time.Hour),
+	})
+	requireT.NoError(err)
+	reservation, found := ftKeeper.GetReservation(ctx, id)
+	requireT.True(found)
+	requireT.Equal(sdk.NewInt64Coin(denom, 100).String(), reservation.Escrow.String())
+	requireT.True(reservation.BurnRate.IsZero())
+
+	// the exemption revoked after the reservation doesn't change the outcome of the capture
+	requireT.NoError(ftKeeper.SetBurnRateExemption(ctx, issuer, payee, denom, false))
+	supply := bankKeeper.GetSupply(ctx, denom)
+	requireT.NoError(ftKeeper.Capture(ctx, types.CaptureSettings{
+		Sender: payee,
+		ID:     id,
+		Amount: sdk.NewInt64Coin(denom, 100),
+	}))
+	requireT.Equal(sdk.NewInt(100).String(), bankKeeper.GetBalance(ctx, payee, denom).Amount.String())
+	requireT.Equal(sdk.NewInt(100).String(), bankKeeper.GetBalance(ctx, payer, denom).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, moduleAddress, denom).IsZero())
+	requireT.Equal(supply.String(), bankKeeper.GetSupply(ctx, denom).String())
+}
+
+func TestKeeper_CaptureRatesNotStored(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, payer, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+
+	id, err := ftKeeper.Reserve(ctx, types.ReserveSettings{
+		Payer:      payer,
+		Payee:      payee,
+		Amount:     sdk.NewInt64Coin(denom, 100),
+		Expiration: now.Add(time.Hour),
+	})
+	requireT.NoError(err)
+
+	// every reservation stores the rates, so the capture of the reservation without them is rejected
+	store := ctx.KVStore(testApp.GetKey(types.StoreKey))
+	bz := store.Get(types.GetReservationKey(id))
+	// the rates are the last fields of the reservation, encoded as "0"
+	rates := []byte{0x3a, 0x01, '0', 0x42, 0x01, '0'}
+	requireT.Equal(rates, bz[len(bz)-len(rates):])
+	store.Set(types.GetReservationKey(id), bz[:len(bz)-len(rates)])
+	reservation, found := ftKeeper.GetReservation(ctx, id)
+	requireT.True(found)
+	requireT.True(reservation.BurnRate.IsNil())
+
+	requireT.True(sdkerrors.ErrLogic.Is(ftKeeper.Capture(ctx, types.CaptureSettings{
+		Sender: payee,
+		ID:     id,
+		Amount: sdk.NewInt64Coin(denom, 100),
+	})))
+	_, found = ftKeeper.GetReservation(ctx, id)
+	requireT.True(found)
+}
+
+func TestKeeper_ReleaseExpire(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "uabc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	settings := types.ReserveSettings{
+		Payer:      issuer,
+		Payee:      payee,
+		Amount:     sdk.NewInt64Coin(denom, 100),
+		Expiration: now.Add(time.Hour),
+	}
+	id1, err := ftKeeper.Reserve(ctx, settings)
+	requireT.NoError(err)
+	settings.Expiration = now.Add(2 * time.Hour)
+	id2, err := ftKeeper.Reserve(ctx, settings)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt(800).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+
+	// only the payee can release the funds
+	requireT.True(sdkerrors.ErrUnauthorized.Is(ftKeeper.Release(ctx, issuer, id1)))
+
+	requireT.NoError(ftKeeper.Release(ctx, payee, id1))
+	requireT.Equal(sdk.NewInt(900).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	_, found := ftKeeper.GetReservation(ctx, id1)
+	requireT.False(found)
+	requireT.True(sdkerrors.ErrNotFound.Is(ftKeeper.Release(ctx, payee, id1)))
+
+	// the reservation isn't released by the end blocker before the expiration time
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	_, found = ftKeeper.GetReservation(ctx, id2)
+	requireT.True(found)
+
+	// the reservation is released by the end blocker
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	_, found = ftKeeper.GetReservation(ctx, id2)
+	requireT.False(found)
+	requireT.Equal(sdk.NewInt(1000).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	store := ctx.KVStore(testApp.GetKey(types.StoreKey))
+	requireT.Nil(store.Get(types.GetReservationExpirationQueueKey(id2, settings.Expiration)))
+}
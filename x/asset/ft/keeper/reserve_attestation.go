@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// PublishReserveAttestation publishes the attestation of the reserves backing the fungible token.
+// Only the latest MaxReserveAttestations attestations of the token are kept, the oldest one is dropped.
+func (k Keeper) PublishReserveAttestation(ctx sdk.Context, settings types.ReserveAttestationSettings) error {
+	if err := types.ValidateReserveAttestation(settings.Amount, settings.AuditorSignatureHash, settings.URI); err != nil {
+		return err
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, settings.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", settings.Denom)
+	}
+
+	if err := k.checkAdmin(ctx, settings.Sender, ft); err != nil {
+		return err
+	}
+
+	k.AddReserveAttestation(ctx, types.ReserveAttestation{
+		Denom:                settings.Denom,
+		Amount:               settings.Amount,
+		AuditorSignatureHash: settings.AuditorSignatureHash,
+		URI:                  settings.URI,
+		Timestamp:            ctx.BlockTime(),
+	})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.Denom, settings.Sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventReserveAttestationPublished{
+		Denom:                settings.Denom,
+		Amount:               settings.Amount,
+		AuditorSignatureHash: settings.AuditorSignatureHash,
+		URI:                  settings.URI,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventReserveAttestationPublished: %s", err)
+	}
+
+	return nil
+}
+
+// GetReserveAttestations returns the latest reserve attestations of the denom, the oldest first.
+func (k Keeper) GetReserveAttestations(ctx sdk.Context, denom string) []types.ReserveAttestation {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetReserveAttestationsKey(denom))
+	if bz == nil {
+		return []types.ReserveAttestation{}
+	}
+
+	var history types.ReserveAttestationHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history.Attestations
+}
+
+// GetAllReserveAttestations returns the latest reserve attestations of all the denoms.
+func (k Keeper) GetAllReserveAttestations(ctx sdk.Context) []types.ReserveAttestation {
+	attestations := []types.ReserveAttestation{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReserveAttestationKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ReserveAttestationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		attestations = append(attestations, history.Attestations...)
+	}
+
+	return attestations
+}
+
+// AddReserveAttestation appends the reserve attestation to the latest attestations of its denom, dropping the oldest
+// ones exceeding MaxReserveAttestations.
+func (k Keeper) AddReserveAttestation(ctx sdk.Context, attestation types.ReserveAttestation) {
+	attestations := append(k.GetReserveAttestations(ctx, attestation.Denom), attestation)
+	if len(attestations) > types.MaxReserveAttestations {
+		attestations = attestations[len(attestations)-types.MaxReserveAttestations:]
+	}
+
+	history := types.ReserveAttestationHistory{Attestations: attestations}
+	ctx.KVStore(k.storeKey).Set(types.GetReserveAttestationsKey(attestation.Denom), k.cdc.MustMarshal(&history))
+}
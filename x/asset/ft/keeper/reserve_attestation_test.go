@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_PublishReserveAttestation(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+	requireT.Empty(ftKeeper.GetReserveAttestations(ctx, denom))
+
+	settings := types.ReserveAttestationSettings{
+		Sender:               issuer,
+		Denom:                denom,
+		Amount:               sdk.NewInt(1000),
+		AuditorSignatureHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		URI:                  "https://example.com/attestation.pdf",
+	}
+
+	// only the issuer may publish the attestation
+	invalidSettings := settings
+	invalidSettings.Sender = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.ErrorIs(ftKeeper.PublishReserveAttestation(ctx, invalidSettings), sdkerrors.ErrUnauthorized)
+
+	invalidSettings = settings
+	invalidSettings.Denom = types.BuildDenom("abc", issuer)
+	requireT.ErrorIs(ftKeeper.PublishReserveAttestation(ctx, invalidSettings), types.ErrFTNotFound)
+
+	invalidSettings = settings
+	invalidSettings.AuditorSignatureHash = "not-hex"
+	requireT.ErrorIs(ftKeeper.PublishReserveAttestation(ctx, invalidSettings), types.ErrInvalidInput)
+
+	requireT.NoError(ftKeeper.PublishReserveAttestation(ctx, settings))
+	requireT.Equal([]types.ReserveAttestation{
+		{
+			Denom:                denom,
+			Amount:               settings.Amount,
+			AuditorSignatureHash: settings.AuditorSignatureHash,
+			URI:                  settings.URI,
+			Timestamp:            blockTime,
+		},
+	}, ftKeeper.GetReserveAttestations(ctx, denom))
+
+	// only the latest attestations are kept
+	for i := 1; i <= types.MaxReserveAttestations; i++ {
+		settings.Amount = sdk.NewInt(int64(1000 + i))
+		settings.URI = fmt.Sprintf("https://example.com/attestation-%d.pdf", i)
+		requireT.NoError(ftKeeper.PublishReserveAttestation(ctx, settings))
+	}
+	attestations := ftKeeper.GetReserveAttestations(ctx, denom)
+	requireT.Len(attestations, types.MaxReserveAttestations)
+	requireT.Equal(sdk.NewInt(1001).String(), attestations[0].Amount.String())
+	requireT.Equal(settings.Amount.String(), attestations[types.MaxReserveAttestations-1].Amount.String())
+	requireT.Equal(settings.URI, attestations[types.MaxReserveAttestations-1].URI)
+
+	requireT.Equal(attestations, ftKeeper.GetAllReserveAttestations(ctx))
+}
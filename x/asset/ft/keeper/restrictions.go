@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// ApplyReceiveRestrictions returns an error if the recipient is not allowed to receive the coin. It is the single place
+// enforcing the lifecycle status and the whitelisting of the fungible tokens on every path the tokens enter the
+// account, including the bank sends, the minting, the bridge minting, the capture of the reservation and the IBC
+// receive.
+// The coins of the denoms not issued by the module are not restricted.
+func (k Keeper) ApplyReceiveRestrictions(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) error {
+	ft, err := k.GetTokenDefinition(ctx, coin.Denom)
+	if err != nil {
+		if types.ErrFTNotFound.Is(err) {
+			return nil
+		}
+		return err
+	}
+
+	return k.applyReceiveRestrictions(ctx, recipient, ft, coin.Amount)
+}
+
+func (k Keeper) applyReceiveRestrictions(ctx sdk.Context, recipient sdk.AccAddress, ft types.FTDefinition, amount sdk.Int) error {
+	if err := k.checkTokenStatus(ctx, recipient, ft); err != nil {
+		return err
+	}
+
+	return k.isCoinReceivable(ctx, recipient, ft, amount)
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_ApplyReceiveRestrictions(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		InitialAmount: sdk.NewInt(100),
+		Features: []types.TokenFeature{
+			types.TokenFeature_freeze,    //nolint:nosnakecase
+			types.TokenFeature_whitelist, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	// the coins of the denoms not issued by the module are not restricted
+	requireT.NoError(ftKeeper.ApplyReceiveRestrictions(ctx, recipient, sdk.NewInt64Coin("ucore", 10)))
+
+	// the recipient might receive only the whitelisted amount
+	requireT.True(types.ErrWhitelistedLimitExceeded.Is(ftKeeper.ApplyReceiveRestrictions(ctx, recipient, sdk.NewInt64Coin(denom, 10))))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.ApplyReceiveRestrictions(ctx, recipient, sdk.NewInt64Coin(denom, 10)))
+	requireT.NoError(ftKeeper.ApplyReceiveRestrictions(ctx, issuer, sdk.NewInt64Coin(denom, 100)))
+
+	// nobody might receive the globally frozen token, including the issuer
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	requireT.True(types.ErrGloballyFrozen.Is(ftKeeper.ApplyReceiveRestrictions(ctx, recipient, sdk.NewInt64Coin(denom, 10))))
+	requireT.True(types.ErrGloballyFrozen.Is(ftKeeper.ApplyReceiveRestrictions(ctx, issuer, sdk.NewInt64Coin(denom, 10))))
+}
+
+// TestKeeper_ReceiveRestrictionsConformance verifies that none of the paths the fungible token might enter the account
+// bypasses the compliance restrictions.
+func TestKeeper_ReceiveRestrictionsConformance(t *testing.T) {
+	const amount = 100
+
+	type env struct {
+		testApp   *simapp.App
+		issuer    sdk.AccAddress
+		holder    sdk.AccAddress
+		recipient sdk.AccAddress
+		attester  *secp256k1.PrivKey
+		denom     string
+	}
+
+	type path struct {
+		name string
+		// issuerReceives tells if the tokens are received by the issuer, which is not restricted by the whitelisting.
+		issuerReceives bool
+		// spends tells if the tokens are spent from the holder account by the path.
+		spends bool
+		// prepare is executed before the restriction is applied.
+		prepare func(ctx sdk.Context, e env) error
+		exec    func(ctx sdk.Context, e env) error
+	}
+
+	type restriction struct {
+		name string
+		// spending tells if the restriction applies only to the paths spending the tokens of the holder.
+		spending    bool
+		apply       func(ctx sdk.Context, e env) error
+		expectedErr *sdkerrors.Error
+	}
+
+	var reservationID uint64
+	ibcEscrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+
+	paths := []path{
+		{
+			name:   "bank send",
+			spends: true,
+			exec: func(ctx sdk.Context, e env) error {
+				return e.testApp.BankKeeper.SendCoins(ctx, e.holder, e.recipient, sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount)))
+			},
+		},
+		{
+			name:   "multi send",
+			spends: true,
+			exec: func(ctx sdk.Context, e env) error {
+				return e.testApp.BankKeeper.InputOutputCoins(ctx,
+					[]banktypes.Input{
+						{Address: e.holder.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount))},
+					},
+					[]banktypes.Output{
+						{Address: e.recipient.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount))},
+					},
+				)
+			},
+		},
+		{
+			// each output is below the whitelisted limit, but the sum is not
+			name:   "multi send split into outputs",
+			spends: true,
+			exec: func(ctx sdk.Context, e env) error {
+				return e.testApp.BankKeeper.InputOutputCoins(ctx,
+					[]banktypes.Input{
+						{Address: e.holder.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount))},
+					},
+					[]banktypes.Output{
+						{Address: e.recipient.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount/2))},
+						{Address: e.recipient.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount/2))},
+					},
+				)
+			},
+		},
+		{
+			name:           "mint",
+			issuerReceives: true,
+			exec: func(ctx sdk.Context, e env) error {
+				return e.testApp.AssetFTKeeper.Mint(ctx, e.issuer, sdk.NewInt64Coin(e.denom, amount))
+			},
+		},
+		{
+			name: "bridge mint",
+			exec: func(ctx sdk.Context, e env) error {
+				coin := sdk.NewInt64Coin(e.denom, amount)
+				return e.testApp.AssetFTKeeper.BridgeMint(ctx, types.BridgeMintSettings{
+					Sender:       e.issuer,
+					Recipient:    e.recipient,
+					Coin:         coin,
+					TransferID:   "0x01",
+					Attestations: []types.BridgeAttestation{attest(t, e.attester, ctx.ChainID(), "0x01", e.recipient, coin)},
+				})
+			},
+		},
+		{
+			name: "capture",
+			prepare: func(ctx sdk.Context, e env) error {
+				var err error
+				reservationID, err = e.testApp.AssetFTKeeper.Reserve(ctx, types.ReserveSettings{
+					Payer:      e.holder,
+					Payee:      e.recipient,
+					Amount:     sdk.NewInt64Coin(e.denom, amount),
+					Expiration: ctx.BlockTime().Add(time.Hour),
+				})
+				return err
+			},
+			exec: func(ctx sdk.Context, e env) error {
+				return e.testApp.AssetFTKeeper.Capture(ctx, types.CaptureSettings{
+					Sender: e.recipient,
+					ID:     reservationID,
+					Amount: sdk.NewInt64Coin(e.denom, amount),
+				})
+			},
+		},
+		{
+			// the transfer module unescrows the tokens returning to the chain by the bank send
+			name: "ibc receive",
+			prepare: func(ctx sdk.Context, e env) error {
+				return e.testApp.BankKeeper.SendCoins(ctx, e.holder, ibcEscrow, sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount)))
+			},
+			exec: func(ctx sdk.Context, e env) error {
+				return e.testApp.BankKeeper.SendCoins(ctx, ibcEscrow, e.recipient, sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount)))
+			},
+		},
+	}
+
+	restrictions := []restriction{
+		{
+			name: "none",
+			apply: func(ctx sdk.Context, e env) error {
+				return e.testApp.AssetFTKeeper.SetWhitelistedBalance(ctx, e.issuer, e.recipient, sdk.NewInt64Coin(e.denom, amount))
+			},
+		},
+		{
+			name: "whitelist",
+			apply: func(ctx sdk.Context, e env) error {
+				return e.testApp.AssetFTKeeper.SetWhitelistedBalance(ctx, e.issuer, e.recipient, sdk.NewInt64Coin(e.denom, amount-1))
+			},
+			expectedErr: types.ErrWhitelistedLimitExceeded,
+		},
+		{
+			name: "global freeze",
+			apply: func(ctx sdk.Context, e env) error {
+				if err := e.testApp.AssetFTKeeper.SetWhitelistedBalance(ctx, e.issuer, e.recipient, sdk.NewInt64Coin(e.denom, amount)); err != nil {
+					return err
+				}
+				return e.testApp.AssetFTKeeper.GloballyFreeze(ctx, e.issuer, e.denom)
+			},
+			expectedErr: types.ErrGloballyFrozen,
+		},
+		{
+			name:     "freeze",
+			spending: true,
+			apply: func(ctx sdk.Context, e env) error {
+				if err := e.testApp.AssetFTKeeper.SetWhitelistedBalance(ctx, e.issuer, e.recipient, sdk.NewInt64Coin(e.denom, amount)); err != nil {
+					return err
+				}
+				return e.testApp.AssetFTKeeper.Freeze(ctx, e.issuer, e.holder, sdk.NewInt64Coin(e.denom, amount))
+			},
+			expectedErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, p := range paths {
+		for _, r := range restrictions {
+			p, r := p, r
+			if r.spending && !p.spends {
+				continue
+			}
+			t.Run(fmt.Sprintf("%s/%s", p.name, r.name), func(t *testing.T) {
+				requireT := require.New(t)
+
+				testApp := simapp.New()
+				ctx := testApp.BaseApp.NewContext(false, tmproto.Header{
+					ChainID: "test-chain",
+					Time:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				})
+
+				e := env{
+					testApp:   testApp,
+					issuer:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+					holder:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+					recipient: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+					attester:  secp256k1.GenPrivKey(),
+				}
+				var err error
+				e.denom, err = testApp.AssetFTKeeper.Issue(ctx, types.IssueSettings{
+					Issuer:        e.issuer,
+					Symbol:        "ABC",
+					Subunit:       "abc",
+					InitialAmount: sdk.NewInt(amount),
+					Features: []types.TokenFeature{
+						types.TokenFeature_mint,      //nolint:nosnakecase
+						types.TokenFeature_freeze,    //nolint:nosnakecase
+						types.TokenFeature_whitelist, //nolint:nosnakecase
+					},
+				})
+				requireT.NoError(err)
+
+				// the holder, the module and the escrow account are allowed to hold all the tokens
+				for _, addr := range []sdk.AccAddress{e.holder, testApp.AccountKeeper.GetModuleAddress(types.ModuleName), ibcEscrow} {
+					requireT.NoError(testApp.AssetFTKeeper.SetWhitelistExemption(ctx, e.issuer, addr, e.denom, true))
+				}
+				requireT.NoError(testApp.BankKeeper.SendCoins(ctx, e.issuer, e.holder, sdk.NewCoins(sdk.NewInt64Coin(e.denom, amount))))
+
+				if p.prepare != nil {
+					requireT.NoError(p.prepare(ctx, e))
+				}
+				requireT.NoError(r.apply(ctx, e))
+
+				err = p.exec(ctx, e)
+				if r.expectedErr == nil || (p.issuerReceives && r.expectedErr == types.ErrWhitelistedLimitExceeded) {
+					requireT.NoError(err)
+					return
+				}
+				requireT.True(r.expectedErr.Is(err), "unexpected error: %v", err)
+			})
+		}
+	}
+}
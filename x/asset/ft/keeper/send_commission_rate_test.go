@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_SendCommissionRate_BankSend(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	assetKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	// issue with more than 1 send commission rate
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		Description:        "DEF Desc",
+		InitialAmount:      sdk.NewInt(600),
+		Features:           []types.TokenFeature{},
+		SendCommissionRate: sdk.MustNewDecFromStr("1.01"),
+	}
+
+	_, err := assetKeeper.Issue(ctx, settings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	// issue token
+	settings.SendCommissionRate = sdk.MustNewDecFromStr("0.25")
+	settings.BurnRate = sdk.MustNewDecFromStr("0.1")
+	denom, err := assetKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// send from issuer to recipient (commission must not apply)
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(500)),
+	))
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&recipient: 500,
+		&issuer:    100,
+	})
+
+	// send from recipient to recipient2 (commission and burn must apply)
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = bankKeeper.SendCoins(ctx, recipient, recipient2, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(100)),
+	))
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&recipient:  365,
+		&recipient2: 100,
+		&issuer:     125,
+	})
+
+	// the commission and the burn rate of the multi-send are charged only for the share of the input not sent to
+	// the issuer
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(200)))}},
+		[]banktypes.Output{
+			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))},
+			{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))},
+		},
+	)
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&recipient:  130,
+		&recipient2: 200,
+		&issuer:     250,
+	})
+
+	// the multi-send to the issuer only is not charged
+	err = bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))}},
+		[]banktypes.Output{
+			{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))},
+		},
+	)
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&recipient:  130,
+		&recipient2: 100,
+		&issuer:     350,
+	})
+
+	// the balance must cover the commission
+	cacheCtx, _ := ctx.CacheContext()
+	err = bankKeeper.SendCoins(cacheCtx, recipient, recipient2, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(110)),
+	))
+	requireT.Error(err)
+
+	// send from recipient to issuer account (commission must not apply)
+	err = bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(
+		sdk.NewCoin(denom, sdk.NewInt(130)),
+	))
+	requireT.NoError(err)
+
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&recipient2: 100,
+		&issuer:     480,
+	})
+}
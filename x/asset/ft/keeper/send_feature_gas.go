@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	deterministicgastypes "github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// GetSendFeatureGas returns the gas charged for each token sent, per feature enabled for the token.
+func (k Keeper) GetSendFeatureGas() []types.FeatureGas {
+	featureGas := make([]types.FeatureGas, 0, len(k.sendFeatureGas))
+	for feature, gas := range k.sendFeatureGas {
+		featureGas = append(featureGas, types.FeatureGas{
+			Feature: feature,
+			Gas:     gas,
+		})
+	}
+	sort.Slice(featureGas, func(i, j int) bool {
+		return featureGas[i].Feature < featureGas[j].Feature
+	})
+
+	return featureGas
+}
+
+// consumeSendFeatureGas charges the gas for the checks of the features enabled for the token sent. The gas is charged
+// on top of the deterministic gas of the message, so the tokens with many features pay for the checks they require.
+func (k Keeper) consumeSendFeatureGas(ctx sdk.Context, ft types.FTDefinition) {
+	var gas uint64
+	for _, feature := range ft.Features {
+		gas += k.sendFeatureGas[feature]
+	}
+	if gas == 0 {
+		return
+	}
+
+	deterministicgastypes.WithOriginalGasMeter(ctx).GasMeter().ConsumeGas(gas, "AssetFTSendFeatures")
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// featureGasMeter records the gas charged for the features of the tokens sent.
+type featureGasMeter struct {
+	sdk.GasMeter
+	featureGas uint64
+}
+
+func (m *featureGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	if descriptor == "AssetFTSendFeatures" {
+		m.featureGas += amount
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func TestKeeper_SendFeatureGas(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	featureGas := map[types.TokenFeature]uint64{}
+	for _, fg := range ftKeeper.GetSendFeatureGas() {
+		featureGas[fg.Feature] = fg.Gas
+	}
+	requireT.Positive(featureGas[types.TokenFeature_freeze])    //nolint:nosnakecase
+	requireT.Positive(featureGas[types.TokenFeature_whitelist]) //nolint:nosnakecase
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issue := func(subunit string, features ...types.TokenFeature) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     6,
+			InitialAmount: sdk.NewInt(1000),
+			Features:      features,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	plainDenom := issue("abc")
+	heavyDenom := issue("def", types.TokenFeature_freeze, types.TokenFeature_whitelist) //nolint:nosnakecase
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient, sdk.NewInt64Coin(heavyDenom, 100)))
+
+	send := func(coins sdk.Coins) uint64 {
+		gasMeter := &featureGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+		requireT.NoError(bankKeeper.SendCoins(ctx.WithGasMeter(gasMeter), issuer, recipient, coins))
+		return gasMeter.featureGas
+	}
+
+	// no gas is charged for the tokens without the features
+	requireT.Zero(send(sdk.NewCoins(sdk.NewInt64Coin(plainDenom, 1))))
+
+	// the gas is charged per feature for each token sent
+	heavyGas := featureGas[types.TokenFeature_freeze] + featureGas[types.TokenFeature_whitelist] //nolint:nosnakecase
+	requireT.Equal(heavyGas, send(sdk.NewCoins(sdk.NewInt64Coin(heavyDenom, 1))))
+	requireT.Equal(heavyGas, send(sdk.NewCoins(sdk.NewInt64Coin(heavyDenom, 1), sdk.NewInt64Coin(plainDenom, 1))))
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// FreezeUntil freezes the coin on the account until the unfreeze time. The amount is unfrozen once the block time
+// reaches the unfreeze time, no matter if the end blocker has already removed the timed freeze from the store.
+func (k Keeper) FreezeUntil(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	coin sdk.Coin,
+	unfreezeTime time.Time,
+) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "freeze amount should be positive")
+	}
+	if !unfreezeTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "unfreeze time %s must be after the current block time", unfreezeTime)
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_freeze) //nolint:nosnakecase
+	if err != nil {
+		return err
+	}
+
+	// the amounts frozen until the same time are summed up
+	timedFreeze := types.TimedFreeze{
+		Account:      addr.String(),
+		Coin:         coin,
+		UnfreezeTime: unfreezeTime,
+	}
+	if existing, found := k.getTimedFreeze(ctx, addr, coin.Denom, unfreezeTime); found {
+		timedFreeze.Coin = timedFreeze.Coin.Add(existing.Coin)
+	}
+	k.SetTimedFreeze(ctx, timedFreeze)
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, coin.Denom, types.AttributeValueActionFrozenAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(coin.Denom, addr.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTimedFreezeAdded{
+		Account:      addr.String(),
+		Coin:         coin,
+		UnfreezeTime: unfreezeTime,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTimedFreezeAdded: %s", err)
+	}
+
+	return nil
+}
+
+// SetTimedFreeze stores the timed freeze together with its entry in the expiration queue.
+func (k Keeper) SetTimedFreeze(ctx sdk.Context, timedFreeze types.TimedFreeze) {
+	addr := sdk.MustAccAddressFromBech32(timedFreeze.Account)
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTimedFreezeKey(addr, timedFreeze.Coin.Denom, timedFreeze.UnfreezeTime)
+	store.Set(key, k.cdc.MustMarshal(&timedFreeze))
+	store.Set(types.GetTimedFreezeQueueKey(addr, timedFreeze.Coin.Denom, timedFreeze.UnfreezeTime), key)
+}
+
+// GetTimedFreezes returns the timed freezes of the account which haven't expired yet, optionally filtered by the
+// denom.
+func (k Keeper) GetTimedFreezes(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.TimedFreeze, *query.PageResponse, error) {
+	storePrefix := types.CreateTimedFreezesPrefix(addr)
+	if denom != "" {
+		storePrefix = types.CreateDenomTimedFreezesPrefix(addr, denom)
+	}
+
+	timedFreezes := []types.TimedFreeze{}
+	pageRes, err := query.FilteredPaginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix),
+		pagination,
+		func(key, value []byte, accumulate bool) (bool, error) {
+			var timedFreeze types.TimedFreeze
+			if err := k.cdc.Unmarshal(value, &timedFreeze); err != nil {
+				return false, err
+			}
+			if !isTimedFreezeActive(ctx, timedFreeze) {
+				return false, nil
+			}
+			if accumulate {
+				timedFreezes = append(timedFreezes, timedFreeze)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return timedFreezes, pageRes, nil
+}
+
+// GetAllTimedFreezes returns the timed freezes of all the accounts.
+func (k Keeper) GetAllTimedFreezes(ctx sdk.Context) []types.TimedFreeze {
+	timedFreezes := []types.TimedFreeze{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.TimedFreezeKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var timedFreeze types.TimedFreeze
+		k.cdc.MustUnmarshal(iterator.Value(), &timedFreeze)
+		timedFreezes = append(timedFreezes, timedFreeze)
+	}
+
+	return timedFreezes
+}
+
+// getTimedFrozenAmount returns the amount of the denom frozen on the account by the timed freezes which haven't
+// expired yet.
+func (k Keeper) getTimedFrozenAmount(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Int {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDenomTimedFreezesPrefix(addr, denom)).
+		Iterator(nil, nil)
+	defer iterator.Close()
+
+	amount := sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var timedFreeze types.TimedFreeze
+		k.cdc.MustUnmarshal(iterator.Value(), &timedFreeze)
+		if isTimedFreezeActive(ctx, timedFreeze) {
+			amount = amount.Add(timedFreeze.Coin.Amount)
+		}
+	}
+
+	return amount
+}
+
+func (k Keeper) getTimedFreeze(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	denom string,
+	unfreezeTime time.Time,
+) (types.TimedFreeze, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTimedFreezeKey(addr, denom, unfreezeTime))
+	if bz == nil {
+		return types.TimedFreeze{}, false
+	}
+
+	var timedFreeze types.TimedFreeze
+	k.cdc.MustUnmarshal(bz, &timedFreeze)
+	return timedFreeze, true
+}
+
+// deleteExpiredTimedFreezes removes the timed freezes which have expired by the current block time.
+func (k Keeper) deleteExpiredTimedFreezes(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.TimedFreezeQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.CreateTimedFreezeQueuePrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var timedFreezes []types.TimedFreeze
+	for ; iterator.Valid(); iterator.Next() {
+		var timedFreeze types.TimedFreeze
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &timedFreeze)
+		timedFreezes = append(timedFreezes, timedFreeze)
+	}
+
+	for _, timedFreeze := range timedFreezes {
+		addr := sdk.MustAccAddressFromBech32(timedFreeze.Account)
+		store.Delete(types.GetTimedFreezeKey(addr, timedFreeze.Coin.Denom, timedFreeze.UnfreezeTime))
+		store.Delete(types.GetTimedFreezeQueueKey(addr, timedFreeze.Coin.Denom, timedFreeze.UnfreezeTime))
+
+		ctx.EventManager().EmitEvent(
+			types.NewAccountNotificationEvent(addr, timedFreeze.Coin.Denom, types.AttributeValueActionFrozenAmountChanged),
+		)
+		ctx.EventManager().EmitEvent(types.NewIndexEvent(timedFreeze.Coin.Denom, timedFreeze.Account))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTimedFreezeExpired{
+			Account: timedFreeze.Account,
+			Coin:    timedFreeze.Coin,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTimedFreezeExpired: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// isTimedFreezeActive returns true if the timed freeze hasn't expired by the current block time. The expired timed
+// freezes are removed by the end blocker, but they are already ignored by the transactions of the block they expire
+// in.
+func isTimedFreezeActive(ctx sdk.Context, timedFreeze types.TimedFreeze) bool {
+	return timedFreeze.UnfreezeTime.After(ctx.BlockTime())
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+//nolint:funlen // this is complex test scenario and breaking it down is not helpful
+func TestKeeper_FreezeUntil(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	unfreezableDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(666),
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(
+		sdk.NewInt64Coin(denom, 100),
+		sdk.NewInt64Coin(unfreezableDenom, 100),
+	)))
+
+	// the token must be freezable, the unfreeze time must be in the future and the sender must be the admin
+	requireT.True(types.ErrFeatureNotActive.Is(
+		ftKeeper.FreezeUntil(ctx, issuer, account, sdk.NewInt64Coin(unfreezableDenom, 10), now.Add(time.Hour)),
+	))
+	requireT.True(types.ErrInvalidInput.Is(
+		ftKeeper.FreezeUntil(ctx, issuer, account, sdk.NewInt64Coin(denom, 10), now),
+	))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(
+		ftKeeper.FreezeUntil(ctx, recipient, account, sdk.NewInt64Coin(denom, 10), now.Add(time.Hour)),
+	))
+
+	// the amounts frozen until the same time are summed up
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, account, sdk.NewInt64Coin(denom, 20), now.Add(2*time.Hour)))
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, account, sdk.NewInt64Coin(denom, 10), now.Add(time.Hour)))
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, account, sdk.NewInt64Coin(denom, 30), now.Add(2*time.Hour)))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 40)))
+
+	timedFreezes, _, err := ftKeeper.GetTimedFreezes(ctx, account, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.TimedFreeze{
+		{
+			Account:      account.String(),
+			Coin:         sdk.NewInt64Coin(denom, 10),
+			UnfreezeTime: now.Add(time.Hour),
+		},
+		{
+			Account:      account.String(),
+			Coin:         sdk.NewInt64Coin(denom, 50),
+			UnfreezeTime: now.Add(2 * time.Hour),
+		},
+	}, timedFreezes)
+
+	// the timed freezes are frozen on top of the frozen amount and can't be unfrozen explicitly
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+	))
+	requireT.True(types.ErrNotEnoughBalance.Is(ftKeeper.Unfreeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 50))))
+
+	// the amount is unfrozen once the block time reaches the unfreeze time, even before the end blocker runs
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+	))
+	timedFreezes, _, err = ftKeeper.GetTimedFreezes(ctx, account, "", nil)
+	requireT.NoError(err)
+	requireT.Len(timedFreezes, 1)
+
+	// the end blocker removes the expired timed freezes
+	ftKeeper.EndBlocker(ctx)
+	requireT.Len(ftKeeper.GetAllTimedFreezes(ctx), 1)
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	requireT.Empty(ftKeeper.GetAllTimedFreezes(ctx))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 40), bankKeeper.GetBalance(ctx, account, denom))
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// SetTokenAttribute sets the key-value attribute of the fungible token or removes it if the value is empty.
+func (k Keeper) SetTokenAttribute(ctx sdk.Context, sender sdk.AccAddress, denom, key, value string) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := k.checkAdmin(ctx, sender, ft); err != nil {
+		return err
+	}
+
+	if err := types.ValidateTokenAttributeKey(key); err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	if err := types.ValidateTokenAttributeValue(value, params.MaxTokenAttributeValueLength); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	attributeKey := types.GetTokenAttributeKey(denom, key)
+	if value == "" {
+		store.Delete(attributeKey)
+	} else {
+		if !store.Has(attributeKey) && k.countTokenAttributes(ctx, denom) >= params.MaxTokenAttributes {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"denom %s can't have more than %d attributes",
+				denom,
+				params.MaxTokenAttributes,
+			)
+		}
+		k.SetTokenAttributeRecord(ctx, types.TokenAttribute{
+			Denom: denom,
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewIndexEvent(denom).AppendAttributes(sdk.NewAttribute(types.AttributeKeyTokenAttribute, key)),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTokenAttributeSet{
+		Denom: denom,
+		Key:   key,
+		Value: value,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTokenAttributeSet: %s", err)
+	}
+
+	return nil
+}
+
+// SetTokenAttributeRecord stores the attribute of the fungible token.
+func (k Keeper) SetTokenAttributeRecord(ctx sdk.Context, attribute types.TokenAttribute) {
+	ctx.KVStore(k.storeKey).Set(
+		types.GetTokenAttributeKey(attribute.Denom, attribute.Key),
+		k.cdc.MustMarshal(&attribute),
+	)
+}
+
+// GetTokenAttributes returns the attributes of the denom sorted by the key.
+func (k Keeper) GetTokenAttributes(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.TokenAttribute, *query.PageResponse, error) {
+	attributes := []types.TokenAttribute{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateTokenAttributesPrefix(denom))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var attribute types.TokenAttribute
+		if err := k.cdc.Unmarshal(value, &attribute); err != nil {
+			return err
+		}
+		attributes = append(attributes, attribute)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attributes, pageRes, nil
+}
+
+// GetAllTokenAttributes returns the attributes of all the denoms.
+func (k Keeper) GetAllTokenAttributes(ctx sdk.Context) []types.TokenAttribute {
+	attributes := []types.TokenAttribute{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenAttributeKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attribute types.TokenAttribute
+		k.cdc.MustUnmarshal(iterator.Value(), &attribute)
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
+}
+
+func (k Keeper) countTokenAttributes(ctx sdk.Context, denom string) uint32 {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateTokenAttributesPrefix(denom)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var count uint32
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_TokenAttributes(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	params := ftKeeper.GetParams(ctx)
+	params.MaxTokenAttributes = 2
+	params.MaxTokenAttributeValueLength = 12
+	ftKeeper.SetParams(ctx, params)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	// only the admin may set the attributes
+	err = ftKeeper.SetTokenAttribute(ctx, randomAddr, denom, "ISIN", "CH0012345678")
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// the value must fit the params limit
+	err = ftKeeper.SetTokenAttribute(ctx, issuer, denom, "ISIN", strings.Repeat("a", 13))
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	requireT.NoError(ftKeeper.SetTokenAttribute(ctx, issuer, denom, "jurisdiction", "LI"))
+	requireT.NoError(ftKeeper.SetTokenAttribute(ctx, issuer, denom, "ISIN", "CH0012345678"))
+
+	// the number of attributes must fit the params limit
+	err = ftKeeper.SetTokenAttribute(ctx, issuer, denom, "LEI", "5493001KJTIIGC8Y1R12")
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	// the existing attribute might be updated even if the limit is reached
+	requireT.NoError(ftKeeper.SetTokenAttribute(ctx, issuer, denom, "jurisdiction", "CH"))
+
+	attributes, _, err := ftKeeper.GetTokenAttributes(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.TokenAttribute{
+		{Denom: denom, Key: "ISIN", Value: "CH0012345678"},
+		{Denom: denom, Key: "jurisdiction", Value: "CH"},
+	}, attributes)
+
+	// the empty value removes the attribute
+	requireT.NoError(ftKeeper.SetTokenAttribute(ctx, issuer, denom, "jurisdiction", ""))
+	attributes, _, err = ftKeeper.GetTokenAttributes(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.TokenAttribute{
+		{Denom: denom, Key: "ISIN", Value: "CH0012345678"},
+	}, attributes)
+	requireT.Equal(attributes, ftKeeper.GetAllTokenAttributes(ctx))
+
+	// the attribute key is indexed in the events
+	var indexed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeIndex {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyTokenAttribute && string(attribute.Value) == "jurisdiction" {
+				indexed = true
+			}
+		}
+	}
+	requireT.True(indexed)
+
+	// the attributes can't be set for the token which doesn't exist
+	err = ftKeeper.SetTokenAttribute(ctx, issuer, fmt.Sprintf("abc-%s", issuer), "ISIN", "CH0012345678")
+	requireT.True(types.ErrFTNotFound.Is(err))
+}
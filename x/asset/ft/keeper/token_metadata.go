@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// UpdateTokenMetadata updates the description, URI and URI hash of the fungible token. The symbol, subunit and
+// precision set at the issuance are kept.
+func (k Keeper) UpdateTokenMetadata(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom, description, uri, uriHash string,
+) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_metadata_update); err != nil { //nolint:nosnakecase
+		return err
+	}
+
+	if err := types.ValidateDescription(description, k.GetParams(ctx).MaxDescriptionLength); err != nil {
+		return err
+	}
+	if err := types.ValidateURI(uri, uriHash); err != nil {
+		return err
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrFTNotFound, "metadata for %s denom not found", denom)
+	}
+	metadata.Description = description
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ft.URI = uri
+	ft.URIHash = uriHash
+	k.SetTokenDefinition(ctx, ft)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTokenMetadataUpdated{
+		Denom:       denom,
+		Description: description,
+		URI:         uri,
+		URIHash:     uriHash,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTokenMetadataUpdated: %s", err)
+	}
+
+	return nil
+}
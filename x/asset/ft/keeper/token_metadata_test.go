@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_UpdateTokenMetadata(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.TokenFeature{
+			types.TokenFeature_metadata_update, //nolint:nosnakecase
+		},
+		URI:     "https://def.invalid/v1.json",
+		URIHash: "hash-v1",
+	})
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("DEF Desc", token.Description)
+	requireT.Equal("https://def.invalid/v1.json", token.URI)
+	requireT.Equal("hash-v1", token.URIHash)
+
+	// only the admin may update the metadata
+	err = ftKeeper.UpdateTokenMetadata(ctx, randomAddr, denom, "new", "", "")
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// the description must fit the params limit
+	err = ftKeeper.UpdateTokenMetadata(
+		ctx, issuer, denom, strings.Repeat("a", int(ftKeeper.GetParams(ctx).MaxDescriptionLength)+1), "", "",
+	)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	requireT.NoError(ftKeeper.UpdateTokenMetadata(ctx, issuer, denom, "DEF Desc v2", "https://def.invalid/v2.json", "hash-v2"))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.FT{
+		Denom:              denom,
+		Issuer:             issuer.String(),
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          6,
+		Description:        "DEF Desc v2",
+		Features:           []types.TokenFeature{types.TokenFeature_metadata_update}, //nolint:nosnakecase
+		BurnRate:           token.BurnRate,
+		SendCommissionRate: token.SendCommissionRate,
+		URI:                "https://def.invalid/v2.json",
+		URIHash:            "hash-v2",
+	}, token)
+
+	// the metadata of the token without the feature can't be updated
+	denomWithoutFeature, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	err = ftKeeper.UpdateTokenMetadata(ctx, issuer, denomWithoutFeature, "new", "", "")
+	requireT.True(types.ErrFeatureNotActive.Is(err))
+}
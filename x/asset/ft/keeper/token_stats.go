@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// GetTokenStats returns the total supply, the number of holders and the total frozen and whitelisted amounts of the
+// fungible token.
+func (k Keeper) GetTokenStats(ctx sdk.Context, denom string) (types.TokenStats, error) {
+	if _, err := k.GetTokenDefinition(ctx, denom); err != nil {
+		return types.TokenStats{}, err
+	}
+
+	counters := getTokenStatsCounters(ctx, k.cdc, k.storeKey, denom)
+	return types.TokenStats{
+		Supply:      k.bankKeeper.GetSupply(ctx, denom),
+		Holders:     counters.Holders,
+		Frozen:      sdk.NewCoin(denom, counters.Frozen),
+		Whitelisted: sdk.NewCoin(denom, counters.Whitelisted),
+	}, nil
+}
+
+// TrackHolders runs the change of the balances of the addresses and updates the number of holders of the fungible
+// tokens among the coins which the addresses start or stop holding.
+func (k Keeper) TrackHolders(ctx sdk.Context, addrs []sdk.AccAddress, coins sdk.Coins, change func() error) error {
+	return k.holders.track(ctx, addrs, coins, change)
+}
+
+// CountHolders sets the number of holders of each fungible token counting the balances stored in the bank. It is
+// meant for InitGenesis, after the balances of the bank are initialized.
+func (k Keeper) CountHolders(ctx sdk.Context) {
+	holders := map[string]uint64{}
+	denoms := []string{}
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		if !coin.IsPositive() || !k.holders.isFT(ctx, coin.Denom) {
+			return false
+		}
+		if _, ok := holders[coin.Denom]; !ok {
+			denoms = append(denoms, coin.Denom)
+		}
+		holders[coin.Denom]++
+		return false
+	})
+
+	for _, denom := range denoms {
+		counters := getTokenStatsCounters(ctx, k.cdc, k.storeKey, denom)
+		counters.Holders = holders[denom]
+		setTokenStatsCounters(ctx, k.cdc, k.storeKey, denom, counters)
+	}
+}
+
+func (k Keeper) addFrozenTotal(ctx sdk.Context, denom string, delta sdk.Int) {
+	counters := getTokenStatsCounters(ctx, k.cdc, k.storeKey, denom)
+	counters.Frozen = counters.Frozen.Add(delta)
+	setTokenStatsCounters(ctx, k.cdc, k.storeKey, denom, counters)
+}
+
+func (k Keeper) addWhitelistedTotal(ctx sdk.Context, denom string, delta sdk.Int) {
+	counters := getTokenStatsCounters(ctx, k.cdc, k.storeKey, denom)
+	counters.Whitelisted = counters.Whitelisted.Add(delta)
+	setTokenStatsCounters(ctx, k.cdc, k.storeKey, denom, counters)
+}
+
+func getTokenStatsCounters(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	denom string,
+) types.TokenStatsCounters {
+	bz := ctx.KVStore(storeKey).Get(types.GetTokenStatsKey(denom))
+	if bz == nil {
+		return types.TokenStatsCounters{
+			Frozen:      sdk.ZeroInt(),
+			Whitelisted: sdk.ZeroInt(),
+		}
+	}
+	var counters types.TokenStatsCounters
+	cdc.MustUnmarshal(bz, &counters)
+	return counters
+}
+
+func setTokenStatsCounters(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	denom string,
+	counters types.TokenStatsCounters,
+) {
+	ctx.KVStore(storeKey).Set(types.GetTokenStatsKey(denom), cdc.MustMarshal(&counters))
+}
+
+// holderTracker updates the number of holders of the fungible tokens on the changes of the balances.
+type holderTracker struct {
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	bankKeeper types.BankKeeper
+}
+
+func (t holderTracker) track(ctx sdk.Context, addrs []sdk.AccAddress, coins sdk.Coins, change func() error) error {
+	type holding struct {
+		addr  sdk.AccAddress
+		denom string
+		held  bool
+	}
+
+	var holdings []holding
+	seen := map[string]struct{}{}
+	for _, coin := range coins {
+		if !t.isFT(ctx, coin.Denom) {
+			continue
+		}
+		for _, addr := range addrs {
+			key := coin.Denom + "/" + string(addr)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			holdings = append(holdings, holding{
+				addr:  addr,
+				denom: coin.Denom,
+				held:  t.bankKeeper.GetBalance(ctx, addr, coin.Denom).IsPositive(),
+			})
+		}
+	}
+
+	if err := change(); err != nil {
+		return err
+	}
+
+	for _, h := range holdings {
+		held := t.bankKeeper.GetBalance(ctx, h.addr, h.denom).IsPositive()
+		if held == h.held {
+			continue
+		}
+		counters := getTokenStatsCounters(ctx, t.cdc, t.storeKey, h.denom)
+		if held {
+			counters.Holders++
+		} else if counters.Holders > 0 {
+			counters.Holders--
+		}
+		setTokenStatsCounters(ctx, t.cdc, t.storeKey, h.denom, counters)
+	}
+
+	return nil
+}
+
+func (t holderTracker) isFT(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(t.storeKey).Has(types.GetTokenKey(denom))
+}
+
+// holderTrackingBankKeeper decorates the bank keeper used by the module to keep the number of holders of the
+// fungible tokens up to date on the mints, burns and transfers done by the module.
+type holderTrackingBankKeeper struct {
+	types.BankKeeper
+	holders holderTracker
+}
+
+func (k holderTrackingBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	return k.holders.track(ctx, []sdk.AccAddress{authtypes.NewModuleAddress(moduleName)}, amounts, func() error {
+		return k.BankKeeper.MintCoins(ctx, moduleName, amounts)
+	})
+}
+
+func (k holderTrackingBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	return k.holders.track(ctx, []sdk.AccAddress{authtypes.NewModuleAddress(moduleName)}, amounts, func() error {
+		return k.BankKeeper.BurnCoins(ctx, moduleName, amounts)
+	})
+}
+
+func (k holderTrackingBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	addrs := []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), recipientAddr}
+	return k.holders.track(ctx, addrs, amt, func() error {
+		return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+func (k holderTrackingBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	addrs := []sdk.AccAddress{senderAddr, authtypes.NewModuleAddress(recipientModule)}
+	return k.holders.track(ctx, addrs, amt, func() error {
+		return k.BankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+func (k holderTrackingBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context,
+	senderModule, recipientModule string,
+	amt sdk.Coins,
+) error {
+	addrs := []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule)}
+	return k.holders.track(ctx, addrs, amt, func() error {
+		return k.BankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+func (k holderTrackingBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.holders.track(ctx, []sdk.AccAddress{fromAddr, toAddr}, amt, func() error {
+		return k.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
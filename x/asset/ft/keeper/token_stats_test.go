@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_TokenStats(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "abc",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.TokenFeature{
+			types.TokenFeature_burn,      //nolint:nosnakecase
+			types.TokenFeature_freeze,    //nolint:nosnakecase
+			types.TokenFeature_whitelist, //nolint:nosnakecase
+		},
+	})
+	requireT.NoError(err)
+
+	assertStats := func(supply, holders, frozen, whitelisted int64) {
+		stats, err := ftKeeper.GetTokenStats(ctx, denom)
+		requireT.NoError(err)
+		requireT.Equal(types.TokenStats{
+			Supply:      sdk.NewInt64Coin(denom, supply),
+			Holders:     uint64(holders),
+			Frozen:      sdk.NewInt64Coin(denom, frozen),
+			Whitelisted: sdk.NewInt64Coin(denom, whitelisted),
+		}, stats)
+	}
+	assertStats(1000, 1, 0, 0)
+
+	// whitelisting
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient1, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient2, sdk.NewInt64Coin(denom, 50)))
+	assertStats(1000, 1, 0, 150)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, recipient1, sdk.NewInt64Coin(denom, 60)))
+	assertStats(1000, 1, 0, 110)
+
+	// sending
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient1, sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+	assertStats(1000, 2, 0, 110)
+	requireT.NoError(bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{{Address: issuer.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 20))}},
+		[]banktypes.Output{
+			{Address: recipient1.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 10))},
+			{Address: recipient2.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(denom, 10))},
+		},
+	))
+	assertStats(1000, 3, 0, 110)
+
+	// freezing
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewInt64Coin(denom, 15)))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient2, sdk.NewInt64Coin(denom, 5)))
+	assertStats(1000, 3, 20, 110)
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, recipient1, sdk.NewInt64Coin(denom, 15)))
+	assertStats(1000, 3, 5, 110)
+
+	// the account sending the whole balance is not the holder anymore
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient1, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))))
+	assertStats(1000, 2, 5, 110)
+
+	// burning
+	requireT.NoError(ftKeeper.Burn(ctx, issuer, sdk.NewInt64Coin(denom, 990)))
+	assertStats(10, 1, 5, 110)
+
+	// the counted holders match the tracked ones
+	ftKeeper.CountHolders(ctx)
+	assertStats(10, 1, 5, 110)
+
+	_, err = ftKeeper.GetTokenStats(ctx, types.BuildDenom("nonexistent", issuer))
+	requireT.True(types.ErrFTNotFound.Is(err))
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// SetTokenStatus moves the fungible token to the lifecycle status on behalf of its admin. Suspending the token and
+// reactivating the suspended token is the global freeze, so it requires the freeze feature.
+func (k Keeper) SetTokenStatus(ctx sdk.Context, sender sdk.AccAddress, denom string, status types.TokenStatus) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := k.checkAdmin(ctx, sender, ft); err != nil {
+		return err
+	}
+
+	current := k.GetTokenStatus(ctx, denom)
+	if status == types.TokenStatus_suspended || current == types.TokenStatus_suspended { //nolint:nosnakecase
+		if !ft.IsFeatureEnabled(types.TokenFeature_freeze) { //nolint:nosnakecase
+			return sdkerrors.Wrapf(types.ErrFeatureNotActive, "denom:%s, feature:%s", denom, types.TokenFeature_freeze) //nolint:nosnakecase
+		}
+	}
+
+	return k.changeTokenStatus(ctx, denom, current, status)
+}
+
+// SetTokenStatusByGovernance moves the fungible token to the lifecycle status on behalf of the governance. Neither the
+// admin nor the features of the token are checked, so the governance might suspend and retire any token.
+func (k Keeper) SetTokenStatusByGovernance(ctx sdk.Context, denom string, status types.TokenStatus) error {
+	if _, err := k.GetTokenDefinition(ctx, denom); err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	return k.changeTokenStatus(ctx, denom, k.GetTokenStatus(ctx, denom), status)
+}
+
+// GetTokenStatus returns the lifecycle status of the fungible token.
+func (k Keeper) GetTokenStatus(ctx sdk.Context, denom string) types.TokenStatus {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenStatusKey(denom))
+	if len(bz) == 0 {
+		return types.TokenStatus_active //nolint:nosnakecase
+	}
+	return types.TokenStatus(bz[0])
+}
+
+// SetTokenStatusRecord stores the lifecycle status of the fungible token. Nothing is stored for the active tokens.
+func (k Keeper) SetTokenStatusRecord(ctx sdk.Context, denom string, status types.TokenStatus) {
+	if status == types.TokenStatus_active { //nolint:nosnakecase
+		ctx.KVStore(k.storeKey).Delete(types.GetTokenStatusKey(denom))
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetTokenStatusKey(denom), []byte{byte(status)})
+}
+
+// changeTokenStatus moves the token from the current status to the next one. The global freeze scheduled before is
+// canceled, because the status is set explicitly.
+func (k Keeper) changeTokenStatus(ctx sdk.Context, denom string, current, next types.TokenStatus) error {
+	if err := types.ValidateTokenStatusTransition(current, next); err != nil {
+		return err
+	}
+
+	k.deletePendingGlobalFreeze(ctx, denom)
+	k.SetTokenStatusRecord(ctx, denom, next)
+	return k.emitTokenStatusChanged(ctx, denom, current, next)
+}
+
+// checkTokenStatus returns an error if the account isn't allowed to send or receive the token in its current status.
+func (k Keeper) checkTokenStatus(ctx sdk.Context, addr sdk.AccAddress, ft types.FTDefinition) error {
+	switch k.GetTokenStatus(ctx, ft.Denom) {
+	case types.TokenStatus_suspended: //nolint:nosnakecase
+		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", ft.Denom)
+	case types.TokenStatus_draft: //nolint:nosnakecase
+		if k.getAdmin(ctx, ft) != addr.String() {
+			return sdkerrors.Wrapf(types.ErrTokenNotActive, "%s is draft, only its admin might hold it", ft.Denom)
+		}
+	case types.TokenStatus_retired: //nolint:nosnakecase
+		return sdkerrors.Wrapf(types.ErrTokenNotActive, "%s is retired", ft.Denom)
+	}
+
+	return nil
+}
+
+func (k Keeper) emitTokenStatusChanged(ctx sdk.Context, denom string, previous, status types.TokenStatus) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTokenStatusChanged{
+		Denom:          denom,
+		PreviousStatus: previous,
+		Status:         status,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTokenStatusChanged: %s", err)
+	}
+
+	// suspending and reactivating the token is the global freeze, so the clients tracking it are notified the same way
+	if previous == types.TokenStatus_suspended || status == types.TokenStatus_suspended { //nolint:nosnakecase
+		return k.emitGlobalFreezeChanged(ctx, denom, status == types.TokenStatus_suspended) //nolint:nosnakecase
+	}
+
+	// the status affects all the holders of the token, so the account isn't set
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(nil, denom, types.AttributeValueActionTokenStatusChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom))
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+//nolint:funlen // this is complex tests scenario and breaking it down is not beneficial
+func TestKeeper_TokenStatus(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DRAFT",
+		Subunit:       "draft",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features: []types.TokenFeature{
+			types.TokenFeature_freeze, //nolint:nosnakecase
+			types.TokenFeature_mint,   //nolint:nosnakecase
+		},
+		Draft: true,
+	})
+	requireT.NoError(err)
+	requireT.Equal(types.TokenStatus_draft, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+	requireT.Equal(sdk.NewInt(1000), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+
+	// only the admin holds the draft token
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	requireT.ErrorIs(err, types.ErrTokenNotActive)
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 100))
+	requireT.NoError(err)
+
+	// the draft token can't be globally frozen or unfrozen
+	requireT.ErrorIs(ftKeeper.GloballyFreeze(ctx, issuer, denom), types.ErrInvalidStatusTransition)
+	requireT.ErrorIs(ftKeeper.GloballyUnfreeze(ctx, issuer, denom), types.ErrInvalidStatusTransition)
+	requireT.ErrorIs(
+		ftKeeper.ScheduleGlobalFreeze(ctx, issuer, denom, ctx.BlockTime().Add(time.Hour)),
+		types.ErrInvalidStatusTransition,
+	)
+
+	// only the admin changes the status
+	err = ftKeeper.SetTokenStatus(ctx, recipient, denom, types.TokenStatus_active) //nolint:nosnakecase
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+	err = ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_suspended) //nolint:nosnakecase
+	requireT.ErrorIs(err, types.ErrInvalidStatusTransition)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_active)) //nolint:nosnakecase
+	requireT.Equal(types.TokenStatus_active, ftKeeper.GetTokenStatus(ctx, denom))           //nolint:nosnakecase
+	requireT.Equal([]*types.EventTokenStatusChanged{{
+		Denom:          denom,
+		PreviousStatus: types.TokenStatus_draft,  //nolint:nosnakecase
+		Status:         types.TokenStatus_active, //nolint:nosnakecase
+	}}, tokenStatusChangedEvents(t, ctx))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// suspension is the global freeze
+	requireT.NoError(ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_suspended)) //nolint:nosnakecase
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(token.GloballyFrozen)
+	requireT.Equal(types.TokenStatus_suspended, token.Status) //nolint:nosnakecase
+	err = bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(err, types.ErrGloballyFrozen)
+
+	// the suspended token can't enter any account, so even the admin can't mint it
+	err = ftKeeper.Mint(ctx, issuer, sdk.NewInt64Coin(denom, 100))
+	requireT.ErrorIs(err, types.ErrGloballyFrozen)
+	requireT.Equal(sdk.NewInt(1000), bankKeeper.GetBalance(ctx, issuer, denom).Amount)
+
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, issuer, denom))
+	requireT.Equal(types.TokenStatus_active, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	requireT.Equal(types.TokenStatus_suspended, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+
+	// the retired token is blocked for everyone and its status is final
+	requireT.NoError(ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_retired)) //nolint:nosnakecase
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(err, types.ErrTokenNotActive)
+	err = ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_active) //nolint:nosnakecase
+	requireT.ErrorIs(err, types.ErrInvalidStatusTransition)
+	requireT.ErrorIs(ftKeeper.GloballyUnfreeze(ctx, issuer, denom), types.ErrInvalidStatusTransition)
+}
+
+func TestKeeper_TokenStatusWithoutFreezeFeature(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	// the admin can't suspend the token without the freeze feature
+	err = ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_suspended) //nolint:nosnakecase
+	requireT.ErrorIs(err, types.ErrFeatureNotActive)
+
+	// the governance can
+	handler := ft.NewProposalHandler(ftKeeper)
+	requireT.NoError(handler(ctx, types.NewTokenStatusProposal(
+		"Suspend", "Suspend the token", denom, types.TokenStatus_suspended, //nolint:nosnakecase
+	)))
+	requireT.Equal(types.TokenStatus_suspended, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+
+	// the transitions are validated for the governance too
+	err = handler(ctx, types.NewTokenStatusProposal(
+		"Draft", "Move the token to draft", denom, types.TokenStatus_draft, //nolint:nosnakecase
+	))
+	requireT.ErrorIs(err, types.ErrInvalidStatusTransition)
+
+	err = handler(ctx, types.NewTokenStatusProposal(
+		"Retire", "Retire the missing token", types.BuildDenom("missing", issuer), types.TokenStatus_retired, //nolint:nosnakecase
+	))
+	requireT.ErrorIs(err, types.ErrFTNotFound)
+}
+
+func TestKeeper_PendingGlobalFreezeOfRetiredToken(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		Features:      []types.TokenFeature{types.TokenFeature_freeze}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.ScheduleGlobalFreeze(ctx, issuer, denom, ctx.BlockTime().Add(time.Hour)))
+	requireT.NoError(ftKeeper.SetTokenStatus(ctx, issuer, denom, types.TokenStatus_retired)) //nolint:nosnakecase
+
+	// the status change cancels the scheduled global freeze
+	_, found := ftKeeper.GetPendingGlobalFreeze(ctx, denom)
+	requireT.False(found)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	ftKeeper.EndBlocker(ctx)
+	requireT.Equal(types.TokenStatus_retired, ftKeeper.GetTokenStatus(ctx, denom)) //nolint:nosnakecase
+}
+
+func tokenStatusChangedEvents(t *testing.T, ctx sdk.Context) []*types.EventTokenStatusChanged {
+	var events []*types.EventTokenStatusChanged
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != "coreum.asset.ft.v1.EventTokenStatusChanged" {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		events = append(events, typedEvent.(*types.EventTokenStatusChanged))
+	}
+	return events
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// validateVestingIssuer checks that the issuer account can be converted to the vesting one. Only the base accounts
+// and the accounts which don't exist yet can be converted, so the module and vesting accounts are rejected.
+func (k Keeper) validateVestingIssuer(ctx sdk.Context, issuer sdk.AccAddress) error {
+	acc := k.accountKeeper.GetAccount(ctx, issuer)
+	if acc == nil {
+		return nil
+	}
+	if _, ok := acc.(*authtypes.BaseAccount); !ok {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"the initial amount can be vested on the base account only, the issuer account %s is %T",
+			issuer,
+			acc,
+		)
+	}
+
+	return nil
+}
+
+// setIssuerVesting converts the issuer account holding the minted initial amount to the periodic vesting account
+// vesting that amount according to the schedule.
+func (k Keeper) setIssuerVesting(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	initialAmount sdk.Coin,
+	schedule types.VestingSchedule,
+) error {
+	// the account is created by the mint if it didn't exist, its type is checked by validateVestingIssuer before
+	baseAcc, ok := k.accountKeeper.GetAccount(ctx, issuer).(*authtypes.BaseAccount)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "issuer account %s doesn't exist", issuer)
+	}
+
+	startTime := ctx.BlockTime()
+	if schedule.StartTime != nil {
+		startTime = *schedule.StartTime
+	}
+
+	k.accountKeeper.SetAccount(ctx, vestingtypes.NewPeriodicVestingAccount(
+		baseAcc,
+		sdk.NewCoins(initialAmount),
+		startTime.Unix(),
+		schedule.SDKPeriods(initialAmount.Denom),
+	))
+
+	return nil
+}
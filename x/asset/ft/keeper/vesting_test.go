@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_IssueWithVesting(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	accountKeeper := testApp.AccountKeeper
+
+	// the existing base account of the issuer is converted
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	accountKeeper.SetAccount(ctx, accountKeeper.NewAccountWithAddress(ctx, issuer))
+	accountNumber := accountKeeper.GetAccount(ctx, issuer).GetAccountNumber()
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		VestingSchedule: &types.VestingSchedule{
+			Periods: []types.VestingPeriod{
+				{Length: 0, Amount: sdk.NewInt(100)},
+				{Length: time.Hour, Amount: sdk.NewInt(400)},
+				{Length: time.Hour, Amount: sdk.NewInt(500)},
+			},
+		},
+	}
+
+	// the periods must sum up to the initial amount
+	invalidSettings := settings
+	invalidSettings.InitialAmount = sdk.NewInt(999)
+	_, err := ftKeeper.Issue(ctx, invalidSettings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	vestingAcc, ok := accountKeeper.GetAccount(ctx, issuer).(*vestingtypes.PeriodicVestingAccount)
+	requireT.True(ok)
+	requireT.Equal(accountNumber, vestingAcc.GetAccountNumber())
+	requireT.Equal(now.Unix(), vestingAcc.StartTime)
+	requireT.Equal(now.Add(2*time.Hour).Unix(), vestingAcc.EndTime)
+	requireT.Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)), vestingAcc.OriginalVesting)
+
+	// nothing is vested at the start time, the amount of the zero length period is vested right after it
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(
+		bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+	))
+	ctx = ctx.WithBlockTime(now.Add(time.Second))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(
+		bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+	))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 400))))
+	requireT.True(sdkerrors.ErrInsufficientFunds.Is(
+		bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+	))
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+
+	// the vesting account can't be converted again
+	settings.Symbol = "ABC"
+	settings.Subunit = "abc"
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// the issuance without the vesting is still possible
+	settings.VestingSchedule = nil
+	_, err = ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+}
+
+func TestKeeper_IssueWithVestingNewAccount(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	// the account which doesn't exist yet is created by the issuance
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	startTime := now.Add(time.Hour)
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     6,
+		InitialAmount: sdk.NewInt(1000),
+		VestingSchedule: &types.VestingSchedule{
+			StartTime: &startTime,
+			Periods:   []types.VestingPeriod{{Length: 24 * time.Hour, Amount: sdk.NewInt(1000)}},
+		},
+	})
+	requireT.NoError(err)
+
+	vestingAcc, ok := testApp.AccountKeeper.GetAccount(ctx, issuer).(*vestingtypes.PeriodicVestingAccount)
+	requireT.True(ok)
+	requireT.Equal(startTime.Unix(), vestingAcc.StartTime)
+	requireT.Equal(sdk.NewInt64Coin(denom, 1000), testApp.BankKeeper.GetBalance(ctx, issuer, denom))
+	requireT.True(testApp.BankKeeper.SpendableCoins(ctx, issuer).AmountOf(denom).IsZero())
+}
@@ -20,7 +20,7 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	err = k.checkFeatureAllowed(sender, ft, types.TokenFeature_whitelist) //nolint:nosnakecase
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_whitelist) //nolint:nosnakecase
 	if err != nil {
 		return err
 	}
@@ -28,7 +28,12 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 	whitelistedStore := k.whitelistedAccountBalanceStore(ctx, addr)
 	previousWhitelistedBalance := whitelistedStore.Balance(coin.Denom)
 	whitelistedStore.SetBalance(coin)
+	k.addWhitelistedTotal(ctx, coin.Denom, coin.Amount.Sub(previousWhitelistedBalance.Amount))
 
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, coin.Denom, types.AttributeValueActionWhitelistedAmountChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(coin.Denom, addr.String()))
 	return ctx.EventManager().EmitTypedEvent(&types.EventWhitelistedAmountChanged{
 		Account:        addr.String(),
 		Denom:          coin.Denom,
@@ -41,10 +46,25 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 func (k Keeper) SetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
 	frozenStore := k.whitelistedAccountBalanceStore(ctx, addr)
 	for _, coin := range coins {
+		k.addWhitelistedTotal(ctx, coin.Denom, coin.Amount.Sub(frozenStore.Balance(coin.Denom).Amount))
 		frozenStore.SetBalance(coin)
 	}
 }
 
+// SetWhitelistedBalancesBatch validates and stores the whitelisted balances of many accounts at once. It is meant for
+// InitGenesis and the migrations filling the empty store, the existing balances of the accounts aren't cleared.
+func (k Keeper) SetWhitelistedBalancesBatch(ctx sdk.Context, balances []types.Balance) error {
+	if err := setBalancesBatch(k.cdc, ctx.KVStore(k.storeKey), types.CreateWhitelistedBalancesPrefix, balances); err != nil {
+		return err
+	}
+	for _, balance := range balances {
+		for _, coin := range balance.Coins {
+			k.addWhitelistedTotal(ctx, coin.Denom, coin.Amount)
+		}
+	}
+	return nil
+}
+
 // GetWhitelistedBalance returns the whitelisted balance of a denom and account
 func (k Keeper) GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	return k.whitelistedAccountBalanceStore(ctx, addr).Balance(denom)
@@ -60,6 +80,83 @@ func (k Keeper) GetAccountsWhitelistedBalances(ctx sdk.Context, pagination *quer
 	return collectBalances(k.cdc, k.whitelistedBalancesStore(ctx), pagination)
 }
 
+// SetWhitelistExemption exempts the account from the whitelisted limits of the denom or revokes the exemption.
+func (k Keeper) SetWhitelistExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, exempt bool) error {
+	ft, err := k.GetTokenDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	err = k.checkFeatureAllowed(ctx, sender, ft, types.TokenFeature_whitelist) //nolint:nosnakecase
+	if err != nil {
+		return err
+	}
+
+	if exempt {
+		k.SetWhitelistExemptionRecord(ctx, types.WhitelistExemption{
+			Denom:   denom,
+			Account: addr.String(),
+		})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.GetWhitelistExemptionKey(denom, addr))
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.NewAccountNotificationEvent(addr, denom, types.AttributeValueActionWhitelistExemptionChanged),
+	)
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(denom, addr.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWhitelistExemptionChanged{
+		Account: addr.String(),
+		Denom:   denom,
+		Exempt:  exempt,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventWhitelistExemptionChanged: %s", err)
+	}
+
+	return nil
+}
+
+// SetWhitelistExemptionRecord stores the exemption of the account from the whitelisted limits.
+func (k Keeper) SetWhitelistExemptionRecord(ctx sdk.Context, exemption types.WhitelistExemption) {
+	addr := sdk.MustAccAddressFromBech32(exemption.Account)
+	ctx.KVStore(k.storeKey).Set(types.GetWhitelistExemptionKey(exemption.Denom, addr), k.cdc.MustMarshal(&exemption))
+}
+
+// IsWhitelistExempt returns true if the account is exempted from the whitelisted limits of the denom.
+func (k Keeper) IsWhitelistExempt(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetWhitelistExemptionKey(denom, addr))
+}
+
+// GetWhitelistExemptions returns the accounts exempted from the whitelisted limits of the denom.
+func (k Keeper) GetWhitelistExemptions(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	accounts := []string{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateWhitelistExemptionsPrefix(denom))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		accounts = append(accounts, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
+// GetAllWhitelistExemptions returns the exemptions from the whitelisted limits of all the denoms.
+func (k Keeper) GetAllWhitelistExemptions(ctx sdk.Context) []types.WhitelistExemption {
+	exemptions := []types.WhitelistExemption{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhitelistExemptionKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var exemption types.WhitelistExemption
+		k.cdc.MustUnmarshal(iterator.Value(), &exemption)
+		exemptions = append(exemptions, exemption)
+	}
+
+	return exemptions
+}
+
 // whitelistedBalancesStore get the store for the whitelisted balances of all accounts
 func (k Keeper) whitelistedBalancesStore(ctx sdk.Context) prefix.Store {
 	return prefix.NewStore(ctx.KVStore(k.storeKey), types.WhitelistedBalancesKeyPrefix)
@@ -74,7 +171,11 @@ func (k Keeper) whitelistedAccountBalanceStore(ctx sdk.Context, addr sdk.AccAddr
 // areCoinsReceivable returns an error if whitelisted amount is too low to receive coins
 func (k Keeper) isCoinReceivable(ctx sdk.Context, addr sdk.AccAddress, ft types.FTDefinition, amount sdk.Int) error {
 	//nolint:nosnakecase
-	if !ft.IsFeatureEnabled(types.TokenFeature_whitelist) || ft.Issuer == addr.String() {
+	if !ft.IsFeatureEnabled(types.TokenFeature_whitelist) || k.getAdmin(ctx, ft) == addr.String() {
+		return nil
+	}
+
+	if k.IsWhitelistExempt(ctx, addr, ft.Denom) {
 		return nil
 	}
 
@@ -114,3 +114,56 @@ func TestKeeper_Whitelist(t *testing.T) {
 	requireT.Error(err)
 	assertT.True(sdkerrors.IsOf(err, sdkerrors.ErrUnauthorized))
 }
+
+func TestKeeper_WhitelistExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Description:   "DEF Desc",
+		InitialAmount: sdk.NewInt(666),
+		Features:      []types.TokenFeature{types.TokenFeature_whitelist}, //nolint:nosnakecase
+	})
+	requireT.NoError(err)
+
+	// operator can't receive the tokens without the whitelisted limit
+	err = bankKeeper.SendCoins(ctx, issuer, operator, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	requireT.True(types.ErrWhitelistedLimitExceeded.Is(err))
+
+	// try to exempt the operator from non issuer address
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.SetWhitelistExemption(ctx, randomAddr, operator, denom, true)
+	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
+
+	// exempt the operator
+	requireT.NoError(ftKeeper.SetWhitelistExemption(ctx, issuer, operator, denom, true))
+	requireT.True(ftKeeper.IsWhitelistExempt(ctx, operator, denom))
+
+	accounts, pageRes, err := ftKeeper.GetWhitelistExemptions(ctx, denom, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]string{operator.String()}, accounts)
+	requireT.EqualValues(1, pageRes.GetTotal())
+
+	// exempted operator receives the tokens above the whitelisted limit
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, operator, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))))
+	requireT.Equal(sdk.NewInt(100).String(), bankKeeper.GetBalance(ctx, operator, denom).Amount.String())
+
+	// revoke the exemption
+	requireT.NoError(ftKeeper.SetWhitelistExemption(ctx, issuer, operator, denom, false))
+	requireT.False(ftKeeper.IsWhitelistExempt(ctx, operator, denom))
+	requireT.Empty(ftKeeper.GetAllWhitelistExemptions(ctx))
+
+	err = bankKeeper.SendCoins(ctx, issuer, operator, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1))))
+	requireT.True(types.ErrWhitelistedLimitExceeded.Is(err))
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+// Wrap locks the native coins in the module account and mints the same amount of the wrapped fungible token to the sender.
+// The wrapped token is issued by the module account on the first wrapping.
+func (k Keeper) Wrap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
+	nativeDenom := k.stakingKeeper.BondDenom(ctx)
+	if coin.Denom != nativeDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "only %s can be wrapped", nativeDenom)
+	}
+	if !coin.Amount.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "amount to wrap must be positive")
+	}
+
+	ft, err := k.getOrIssueWrappedToken(ctx, nativeDenom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't lock %s in the module %s", coin.String(), types.ModuleName)
+	}
+
+	return k.mint(ctx, ft, coin.Amount, sender)
+}
+
+// Unwrap burns the wrapped fungible token of the sender and releases the same amount of the native coins.
+func (k Keeper) Unwrap(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
+	nativeDenom := k.stakingKeeper.BondDenom(ctx)
+	wrappedDenom := k.GetWrappedDenom(ctx)
+	if coin.Denom != wrappedDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "only %s can be unwrapped", wrappedDenom)
+	}
+	if !coin.Amount.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "amount to unwrap must be positive")
+	}
+
+	ft, err := k.GetTokenDefinition(ctx, wrappedDenom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", wrappedDenom)
+	}
+
+	if err := k.burn(ctx, sender, ft, coin.Amount); err != nil {
+		return err
+	}
+
+	nativeCoins := sdk.NewCoins(sdk.NewCoin(nativeDenom, coin.Amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, nativeCoins); err != nil {
+		return sdkerrors.Wrapf(err, "can't release %s from the module %s", nativeCoins.String(), types.ModuleName)
+	}
+
+	return nil
+}
+
+// GetWrappedDenom returns the denom of the fungible token wrapping the native coin.
+func (k Keeper) GetWrappedDenom(ctx sdk.Context) string {
+	return types.BuildDenom(types.BuildWrappedSubunit(k.stakingKeeper.BondDenom(ctx)), authtypes.NewModuleAddress(types.ModuleName))
+}
+
+func (k Keeper) getOrIssueWrappedToken(ctx sdk.Context, nativeDenom string) (types.FTDefinition, error) {
+	wrappedDenom := k.GetWrappedDenom(ctx)
+	ft, err := k.GetTokenDefinition(ctx, wrappedDenom)
+	if err == nil {
+		return ft, nil
+	}
+	if !types.ErrFTNotFound.Is(err) {
+		return types.FTDefinition{}, err
+	}
+
+	symbol := types.BuildWrappedSubunit(nativeDenom)
+	precision := uint32(0)
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, nativeDenom); found {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				symbol = types.BuildWrappedSubunit(metadata.Display)
+				precision = unit.Exponent
+			}
+		}
+	}
+
+	if _, err := k.Issue(ctx, types.IssueSettings{
+		Issuer:        authtypes.NewModuleAddress(types.ModuleName),
+		Symbol:        symbol,
+		Subunit:       types.BuildWrappedSubunit(nativeDenom),
+		Precision:     precision,
+		Description:   fmt.Sprintf("Wrapped %s", nativeDenom),
+		InitialAmount: sdk.ZeroInt(),
+		BurnRate:      sdk.ZeroDec(),
+	}); err != nil {
+		return types.FTDefinition{}, sdkerrors.Wrapf(err, "can't issue wrapped token for %s", nativeDenom)
+	}
+
+	return k.GetTokenDefinition(ctx, wrappedDenom)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestKeeper_WrapUnwrap(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	nativeDenom := testApp.StakingKeeper.BondDenom(ctx)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, account, sdk.NewCoins(sdk.NewCoin(nativeDenom, sdk.NewInt(1000)))))
+
+	// only native coins can be wrapped
+	err := ftKeeper.Wrap(ctx, account, sdk.NewCoin("other", sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// wrapping more than the balance must fail
+	err = ftKeeper.Wrap(ctx, account, sdk.NewCoin(nativeDenom, sdk.NewInt(1001)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the wrapped token is issued on the first wrapping
+	requireT.NoError(ftKeeper.Wrap(ctx, account, sdk.NewCoin(nativeDenom, sdk.NewInt(400))))
+	wrappedDenom := ftKeeper.GetWrappedDenom(ctx)
+	requireT.Equal(types.BuildDenom("w"+nativeDenom, moduleAddress), wrappedDenom)
+	token, err := ftKeeper.GetToken(ctx, wrappedDenom)
+	requireT.NoError(err)
+	requireT.Equal(moduleAddress.String(), token.Issuer)
+	requireT.Empty(token.Features)
+
+	requireT.NoError(ftKeeper.Wrap(ctx, account, sdk.NewCoin(nativeDenom, sdk.NewInt(100))))
+	requireT.Equal(sdk.NewInt(500).String(), bankKeeper.GetBalance(ctx, account, nativeDenom).Amount.String())
+	requireT.Equal(sdk.NewInt(500).String(), bankKeeper.GetBalance(ctx, account, wrappedDenom).Amount.String())
+	requireT.Equal(sdk.NewInt(500).String(), bankKeeper.GetBalance(ctx, moduleAddress, nativeDenom).Amount.String())
+	requireT.Equal(sdk.NewInt(500).String(), bankKeeper.GetSupply(ctx, wrappedDenom).Amount.String())
+
+	// the wrapped token is transferable like any other fungible token
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewCoin(wrappedDenom, sdk.NewInt(200)))))
+
+	// only the wrapped token can be unwrapped
+	err = ftKeeper.Unwrap(ctx, recipient, sdk.NewCoin(nativeDenom, sdk.NewInt(100)))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// unwrapping more than the balance must fail
+	err = ftKeeper.Unwrap(ctx, recipient, sdk.NewCoin(wrappedDenom, sdk.NewInt(201)))
+	requireT.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	requireT.NoError(ftKeeper.Unwrap(ctx, recipient, sdk.NewCoin(wrappedDenom, sdk.NewInt(150))))
+	requireT.Equal(sdk.NewInt(150).String(), bankKeeper.GetBalance(ctx, recipient, nativeDenom).Amount.String())
+	requireT.Equal(sdk.NewInt(50).String(), bankKeeper.GetBalance(ctx, recipient, wrappedDenom).Amount.String())
+	requireT.Equal(sdk.NewInt(350).String(), bankKeeper.GetBalance(ctx, moduleAddress, nativeDenom).Amount.String())
+	requireT.Equal(sdk.NewInt(350).String(), bankKeeper.GetSupply(ctx, wrappedDenom).Amount.String())
+
+	_, broken := keeper.WrappedReserveInvariant(ftKeeper)(ctx)
+	requireT.False(broken)
+}
+
+func TestKeeper_WrappedReserveInvariant(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	nativeDenom := testApp.StakingKeeper.BondDenom(ctx)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+
+	// no wrapped token
+	_, broken := keeper.WrappedReserveInvariant(ftKeeper)(ctx)
+	requireT.False(broken)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, account, sdk.NewCoins(sdk.NewCoin(nativeDenom, sdk.NewInt(100)))))
+	requireT.NoError(ftKeeper.Wrap(ctx, account, sdk.NewCoin(nativeDenom, sdk.NewInt(100))))
+
+	_, broken = keeper.WrappedReserveInvariant(ftKeeper)(ctx)
+	requireT.False(broken)
+
+	// drain the reserve bypassing the keeper
+	requireT.NoError(testApp.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, account, sdk.NewCoins(sdk.NewCoin(nativeDenom, sdk.NewInt(1))),
+	))
+	requireT.Equal(sdk.NewInt(99).String(), testApp.BankKeeper.GetBalance(ctx, moduleAddress, nativeDenom).Amount.String())
+
+	_, broken = keeper.WrappedReserveInvariant(ftKeeper)(ctx)
+	requireT.True(broken)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/samber/lo"
+)
+
+var _ authz.Authorization = &BridgeAuthorization{}
+
+// NewBridgeAuthorization creates a new BridgeAuthorization object.
+func NewBridgeAuthorization(denom string, attesters []string, threshold uint32) *BridgeAuthorization {
+	return &BridgeAuthorization{
+		Denom:     denom,
+		Attesters: attesters,
+		Threshold: threshold,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a BridgeAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgBridgeMint{})
+}
+
+// Accept implements Authorization.Accept.
+// The signatures of the attestations are verified by the keeper, here it's checked that the threshold of the
+// authorized attesters attested the transfer.
+func (a BridgeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mintMsg, ok := msg.(*MsgBridgeMint)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if mintMsg.Coin.Denom != a.Denom {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "bridge minting of %q is not authorized", mintMsg.Coin.Denom)
+	}
+
+	attesters := map[string]struct{}{}
+	for _, attestation := range mintMsg.Attestations {
+		if !lo.Contains(a.Attesters, attestation.Attester) {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "attester %s is not authorized", attestation.Attester)
+		}
+		attesters[attestation.Attester] = struct{}{}
+	}
+
+	if uint32(len(attesters)) < a.Threshold {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"transfer is attested by %d attesters, at least %d required",
+			len(attesters),
+			a.Threshold,
+		)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a BridgeAuthorization) ValidateBasic() error {
+	if _, _, err := DeconstructDenom(a.Denom); err != nil {
+		return err
+	}
+
+	if len(a.Attesters) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one attester must be authorized")
+	}
+
+	if len(lo.Uniq(a.Attesters)) != len(a.Attesters) {
+		return sdkerrors.Wrap(ErrInvalidInput, "attesters must be unique")
+	}
+
+	for _, attester := range a.Attesters {
+		if _, err := sdk.AccAddressFromBech32(attester); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester %s", attester)
+		}
+	}
+
+	if a.Threshold == 0 || a.Threshold > uint32(len(a.Attesters)) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "threshold must be between 1 and %d", len(a.Attesters))
+	}
+
+	return nil
+}
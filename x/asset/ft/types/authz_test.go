@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestBridgeAuthorization(t *testing.T) {
+	requireT := require.New(t)
+
+	denom := "wsatoshi-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	attester1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	attester2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	attester3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	authorization := types.NewBridgeAuthorization(denom, []string{attester1, attester2, attester3}, 2)
+	requireT.NoError(authorization.ValidateBasic())
+	requireT.Equal("/coreum.asset.ft.v1.MsgBridgeMint", authorization.MsgTypeURL())
+
+	ctx := sdk.Context{}
+	msg := &types.MsgBridgeMint{
+		Coin: sdk.NewCoin(denom, sdk.NewInt(100)),
+		Attestations: []types.BridgeAttestation{
+			{Attester: attester1},
+			{Attester: attester3},
+		},
+	}
+	res, err := authorization.Accept(ctx, msg)
+	requireT.NoError(err)
+	requireT.True(res.Accept)
+	requireT.False(res.Delete)
+
+	// below the threshold
+	msg.Attestations = []types.BridgeAttestation{{Attester: attester1}}
+	_, err = authorization.Accept(ctx, msg)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the same attester twice
+	msg.Attestations = []types.BridgeAttestation{{Attester: attester1}, {Attester: attester1}}
+	_, err = authorization.Accept(ctx, msg)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// attester which is not authorized
+	msg.Attestations = []types.BridgeAttestation{
+		{Attester: attester1},
+		{Attester: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()},
+	}
+	_, err = authorization.Accept(ctx, msg)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// other denom
+	msg.Coin = sdk.NewCoin("other-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", sdk.NewInt(100))
+	msg.Attestations = []types.BridgeAttestation{{Attester: attester1}, {Attester: attester2}}
+	_, err = authorization.Accept(ctx, msg)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// other message
+	_, err = authorization.Accept(ctx, &types.MsgMint{})
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidType)
+
+	requireT.Error(types.NewBridgeAuthorization("x", []string{attester1}, 1).ValidateBasic())
+	requireT.ErrorIs(types.NewBridgeAuthorization(denom, nil, 1).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewBridgeAuthorization(denom, []string{attester1, attester1}, 1).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewBridgeAuthorization(denom, []string{attester1}, 0).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewBridgeAuthorization(denom, []string{attester1}, 2).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewBridgeAuthorization(denom, []string{"invalid"}, 1).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	maxTransferIDLength  = 128
+	maxDestinationLength = 256
+)
+
+// BridgeMintSettings is the model which represents the params for the bridge minting.
+type BridgeMintSettings struct {
+	Sender       sdk.AccAddress
+	Recipient    sdk.AccAddress
+	Coin         sdk.Coin
+	TransferID   string
+	Attestations []BridgeAttestation
+}
+
+// BridgeBurnSettings is the model which represents the params for the bridge burning.
+type BridgeBurnSettings struct {
+	Sender      sdk.AccAddress
+	Coin        sdk.Coin
+	Destination string
+}
+
+// BridgeMintSignBytes returns the bytes the attesters sign to confirm the transfer from the external chain.
+// The chain ID is included to prevent the attestation from being replayed on another chain.
+func BridgeMintSignBytes(chainID, transferID string, recipient sdk.AccAddress, coin sdk.Coin) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID    string `json:"chain_id"`
+		TransferID string `json:"transfer_id"`
+		Recipient  string `json:"recipient"`
+		Coin       string `json:"coin"`
+	}{
+		ChainID:    chainID,
+		TransferID: transferID,
+		Recipient:  recipient.String(),
+		Coin:       coin.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateTransferID checks the provided transfer ID is valid.
+func ValidateTransferID(transferID string) error {
+	if len(transferID) == 0 || len(transferID) > maxTransferIDLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "transfer ID length must be between 1 and %d", maxTransferIDLength)
+	}
+	return nil
+}
+
+// ValidateDestination checks the provided external chain destination is valid.
+func ValidateDestination(destination string) error {
+	if len(destination) == 0 || len(destination) > maxDestinationLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "destination length must be between 1 and %d", maxDestinationLength)
+	}
+	return nil
+}
+
+// ValidateBridgeAttestations checks that the attesters are unique and their public keys match the addresses.
+func ValidateBridgeAttestations(attestations []BridgeAttestation) error {
+	attesters := make(map[string]struct{}, len(attestations))
+	for _, attestation := range attestations {
+		if err := attestation.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := attesters[attestation.Attester]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated attestation of %s", attestation.Attester)
+		}
+		attesters[attestation.Attester] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic checks that the public key of the attestation belongs to the attester.
+func (a BridgeAttestation) ValidateBasic() error {
+	attester, err := sdk.AccAddressFromBech32(a.Attester)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester %s", a.Attester)
+	}
+	if len(a.PubKey) != secp256k1.PubKeySize {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "invalid public key of the attester %s", a.Attester)
+	}
+	if !attester.Equals(sdk.AccAddress(a.pubKey().Address())) {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "public key doesn't belong to the attester %s", a.Attester)
+	}
+	if len(a.Signature) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "empty signature of the attester %s", a.Attester)
+	}
+	return nil
+}
+
+// Verify checks that the attestation signature of the sign bytes is valid.
+func (a BridgeAttestation) Verify(signBytes []byte) error {
+	if err := a.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.pubKey().VerifySignature(signBytes, a.Signature) {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "invalid signature of the attester %s", a.Attester)
+	}
+	return nil
+}
+
+func (a BridgeAttestation) pubKey() *secp256k1.PubKey {
+	return &secp256k1.PubKey{Key: a.PubKey}
+}
@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/burn_allowance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BurnAllowance is the amount of the fungible token the spender is allowed to burn from the owner account.
+type BurnAllowance struct {
+	Owner   string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender string     `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Coin    types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *BurnAllowance) Reset()         { *m = BurnAllowance{} }
+func (m *BurnAllowance) String() string { return proto.CompactTextString(m) }
+func (*BurnAllowance) ProtoMessage()    {}
+func (*BurnAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de0527be7fe0d062, []int{0}
+}
+
+func (m *BurnAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BurnAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BurnAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnAllowance.Merge(m, src)
+}
+
+func (m *BurnAllowance) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BurnAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnAllowance proto.InternalMessageInfo
+
+func (m *BurnAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *BurnAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *BurnAllowance) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*BurnAllowance)(nil), "coreum.asset.ft.v1.BurnAllowance")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/ft/v1/burn_allowance.proto", fileDescriptor_de0527be7fe0d062)
+}
+
+var fileDescriptor_de0527be7fe0d062 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xef, 0x2b, 0x20, 0x82, 0x58, 0xa2, 0x0e, 0xa1, 0x83, 0xa9, 0x58, 0xe8, 0xe4,
+	0xa3, 0x90, 0x2b, 0x20, 0x95, 0xd8, 0x58, 0x3a, 0xb2, 0x20, 0xc7, 0x75, 0x43, 0xa4, 0xc6, 0x27,
+	0xf2, 0x4f, 0x0a, 0x77, 0xc1, 0x65, 0x75, 0xec, 0xc8, 0x84, 0x50, 0x72, 0x23, 0x28, 0x76, 0x2b,
+	0x36, 0x1f, 0x9f, 0x47, 0xe7, 0xd1, 0xfb, 0xc6, 0xf7, 0x02, 0xb5, 0x74, 0x0d, 0x70, 0x63, 0xa4,
+	0x85, 0x8d, 0x85, 0x2e, 0x83, 0xd2, 0x69, 0xf5, 0xca, 0xb7, 0x5b, 0xdc, 0x71, 0x25, 0x24, 0x6b,
+	0x35, 0x5a, 0x4c, 0x92, 0x00, 0x32, 0x0f, 0xb2, 0x8d, 0x65, 0x5d, 0x36, 0x9b, 0x56, 0x58, 0xa1,
+	0x5f, 0xc3, 0xf8, 0x0a, 0xe4, 0x8c, 0x0a, 0x34, 0x0d, 0x1a, 0x28, 0xb9, 0x91, 0xd0, 0x65, 0xa5,
+	0xb4, 0x3c, 0x03, 0x81, 0xb5, 0x0a, 0xfb, 0x3b, 0x1b, 0x5f, 0x17, 0x4e, 0xab, 0xc7, 0x93, 0x20,
+	0x99, 0xc6, 0x67, 0xb8, 0x53, 0x52, 0xa7, 0x64, 0x4e, 0x16, 0x97, 0xab, 0x30, 0x24, 0x69, 0x7c,
+	0x61, 0x5a, 0xa9, 0xd6, 0x52, 0xa7, 0xff, 0xfc, 0xff, 0x69, 0x4c, 0xf2, 0x78, 0x32, 0x9e, 0x4b,
+	0xff, 0xcf, 0xc9, 0xe2, 0xea, 0xe1, 0x86, 0x05, 0x1f, 0x1b, 0x7d, 0xec, 0xe8, 0x63, 0x4b, 0xac,
+	0x55, 0x31, 0xd9, 0x7f, 0xdf, 0x46, 0x2b, 0x0f, 0x17, 0xcf, 0xfb, 0x9e, 0x92, 0x43, 0x4f, 0xc9,
+	0x4f, 0x4f, 0xc9, 0xe7, 0x40, 0xa3, 0xc3, 0x40, 0xa3, 0xaf, 0x81, 0x46, 0x2f, 0x79, 0x55, 0xdb,
+	0x37, 0x57, 0x32, 0x81, 0x0d, 0x2c, 0x7d, 0xc8, 0x27, 0x74, 0x6a, 0xcd, 0x6d, 0x8d, 0x0a, 0x8e,
+	0xf5, 0xbc, 0xff, 0x15, 0x64, 0x3f, 0x5a, 0x69, 0xca, 0x73, 0x9f, 0x25, 0xff, 0x1d, 0x00, 0xd3,
+	0x81, 0xdd, 0x37, 0x40, 0x01, 0x00, 0x00,
+}
+
+func (m *BurnAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBurnAllowance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintBurnAllowance(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintBurnAllowance(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBurnAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBurnAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *BurnAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovBurnAllowance(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovBurnAllowance(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovBurnAllowance(uint64(l))
+	return n
+}
+
+func sovBurnAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozBurnAllowance(x uint64) (n int) {
+	return sovBurnAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *BurnAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurnAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurnAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurnAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurnAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurnAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurnAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipBurnAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBurnAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBurnAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBurnAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBurnAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBurnAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBurnAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBurnAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBurnAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBurnAllowance = fmt.Errorf("proto: unexpected end of group")
+)
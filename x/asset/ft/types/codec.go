@@ -3,9 +3,14 @@ package types
 import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterInterfaces registers the asset module tx interfaces.
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*authz.Authorization)(nil), &BridgeAuthorization{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &TokenStatusProposal{})
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc) //nolint:nosnakecase // generated name
 }
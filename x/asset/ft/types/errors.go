@@ -19,4 +19,20 @@ var (
 	ErrGloballyFrozen = sdkerrors.Register(ModuleName, 6, "token is globally frozen")
 	// ErrWhitelistedLimitExceeded is returned when new balance after receiving coins exceeds the whitelisted limit
 	ErrWhitelistedLimitExceeded = sdkerrors.Register(ModuleName, 7, "whitelisted limit exceeded")
+	// ErrInvalidAttestation is returned when the bridge attestation doesn't confirm the transfer
+	ErrInvalidAttestation = sdkerrors.Register(ModuleName, 8, "invalid attestation")
+	// ErrTransferAlreadyMinted is returned when the bridge transfer has been minted already
+	ErrTransferAlreadyMinted = sdkerrors.Register(ModuleName, 9, "transfer already minted")
+	// ErrIBCDenomNotFound is returned when the trace of the IBC denom is not registered
+	ErrIBCDenomNotFound = sdkerrors.Register(ModuleName, 10, "IBC denom not found")
+	// ErrReservationExpired is returned when the expired reservation is captured
+	ErrReservationExpired = sdkerrors.Register(ModuleName, 11, "reservation expired")
+	// ErrBurnAllowanceExceeded is returned when the amount burnt from the account exceeds the burn allowance
+	ErrBurnAllowanceExceeded = sdkerrors.Register(ModuleName, 12, "burn allowance exceeded")
+	// ErrAccountFrozen is returned when all the tokens of the issuer held by the account are frozen
+	ErrAccountFrozen = sdkerrors.Register(ModuleName, 13, "account is frozen by the issuer")
+	// ErrTokenNotActive is returned when the draft or retired token is sent or received by the account not allowed to
+	ErrTokenNotActive = sdkerrors.Register(ModuleName, 14, "token is not active")
+	// ErrInvalidStatusTransition is returned when the token can't be moved from its current status to the requested one
+	ErrInvalidStatusTransition = sdkerrors.Register(ModuleName, 15, "invalid token status transition")
 )
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The account notification event is emitted in addition to the typed events whenever the compliance action affects
+// the account. Its attributes are not JSON-encoded, so the wallets may subscribe to them using the Tendermint event
+// query, e.g. `asset_ft_account_notification.account='devcore1...'`.
+const (
+	// EventTypeAccountNotification is the type of the event notifying the account about the compliance action.
+	EventTypeAccountNotification = "asset_ft_account_notification"
+
+	// AttributeKeyAccount is the attribute holding the address of the affected account. It is not set if the action
+	// affects all the holders of the token.
+	AttributeKeyAccount = "account"
+	// AttributeKeyDenom is the attribute holding the denom of the token.
+	AttributeKeyDenom = "denom"
+	// AttributeKeyAction is the attribute holding the compliance action.
+	AttributeKeyAction = "action"
+
+	// AttributeValueActionFrozenAmountChanged is the action changing the frozen amount of the account.
+	AttributeValueActionFrozenAmountChanged = "frozen_amount_changed"
+	// AttributeValueActionWhitelistedAmountChanged is the action changing the whitelisted limit of the account.
+	AttributeValueActionWhitelistedAmountChanged = "whitelisted_amount_changed"
+	// AttributeValueActionWhitelistExemptionChanged is the action changing the whitelist exemption of the account.
+	AttributeValueActionWhitelistExemptionChanged = "whitelist_exemption_changed"
+	// AttributeValueActionBurnRateExemptionChanged is the action changing the burn rate exemption of the account.
+	AttributeValueActionBurnRateExemptionChanged = "burn_rate_exemption_changed"
+	// AttributeValueActionGlobalFreezeChanged is the action changing the global freeze of the token.
+	AttributeValueActionGlobalFreezeChanged = "global_freeze_changed"
+	// AttributeValueActionGlobalFreezeScheduled is the action scheduling the global freeze of the token.
+	AttributeValueActionGlobalFreezeScheduled = "global_freeze_scheduled"
+	// AttributeValueActionTokenStatusChanged is the action moving the token to another lifecycle status.
+	AttributeValueActionTokenStatusChanged = "token_status_changed"
+)
+
+// NewAccountNotificationEvent returns the event notifying the account about the compliance action affecting it.
+// If the account is empty the action affects all the holders of the token.
+func NewAccountNotificationEvent(account sdk.AccAddress, denom, action string) sdk.Event {
+	attributes := make([]sdk.Attribute, 0, 3)
+	if !account.Empty() {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyAccount, account.String()))
+	}
+	attributes = append(attributes,
+		sdk.NewAttribute(AttributeKeyDenom, denom),
+		sdk.NewAttribute(AttributeKeyAction, action),
+	)
+
+	return sdk.NewEvent(EventTypeAccountNotification, attributes...)
+}
+
+// The index event is emitted next to each typed event of the module. The attributes of the typed events are
+// JSON-encoded, so they can't be matched by the plain values in the Tendermint tx_search queries, while the attributes
+// of the index event can, e.g. `assetft.denom='abc-devcore1...'` or `assetft.account='devcore1...'`.
+const (
+	// EventTypeIndex is the type of the event indexing the typed events of the module.
+	EventTypeIndex = "assetft"
+
+	// AttributeKeyIssuer is the attribute holding the address of the issuer of the token.
+	AttributeKeyIssuer = "issuer"
+	// AttributeKeyTokenAttribute is the attribute holding the key of the token attribute changed by the event.
+	AttributeKeyTokenAttribute = "token_attribute"
+)
+
+// NewIndexEvent returns the event indexing the typed event by the denom, the issuer taken from the denom and the
+// accounts affected by it. The issuer is not set for the denoms not issued by the module, e.g. the IBC ones.
+func NewIndexEvent(denom string, accounts ...string) sdk.Event {
+	var issuer string
+	if _, issuerAddr, err := DeconstructDenom(denom); err == nil {
+		issuer = issuerAddr.String()
+	}
+
+	return newIndexEvent(denom, issuer, accounts)
+}
+
+// NewIssuerIndexEvent returns the event indexing the typed event affecting all the tokens of the issuer.
+func NewIssuerIndexEvent(issuer string, accounts ...string) sdk.Event {
+	return newIndexEvent("", issuer, accounts)
+}
+
+func newIndexEvent(denom, issuer string, accounts []string) sdk.Event {
+	attributes := make([]sdk.Attribute, 0, 2+len(accounts))
+	if denom != "" {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyDenom, denom))
+	}
+	if issuer != "" {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyIssuer, issuer))
+	}
+	seen := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if account == "" {
+			continue
+		}
+		if _, ok := seen[account]; ok {
+			continue
+		}
+		seen[account] = struct{}{}
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyAccount, account))
+	}
+
+	return sdk.NewEvent(EventTypeIndex, attributes...)
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestNewIndexEvent(t *testing.T) {
+	issuer := "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	account := "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"
+	denom := "abc-" + issuer
+
+	require.Equal(t, sdk.NewEvent(types.EventTypeIndex,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyIssuer, issuer),
+		sdk.NewAttribute(types.AttributeKeyAccount, account),
+		sdk.NewAttribute(types.AttributeKeyAccount, issuer),
+	), types.NewIndexEvent(denom, account, "", issuer, account))
+
+	// the issuer isn't set for the denoms not issued by the module
+	require.Equal(t, sdk.NewEvent(types.EventTypeIndex,
+		sdk.NewAttribute(types.AttributeKeyDenom, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"),
+	), types.NewIndexEvent("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+
+	require.Equal(t, sdk.NewEvent(types.EventTypeIndex,
+		sdk.NewAttribute(types.AttributeKeyIssuer, issuer),
+		sdk.NewAttribute(types.AttributeKeyAccount, account),
+	), types.NewIssuerIndexEvent(issuer, account))
+}
@@ -2,12 +2,13 @@ package types
 
 // DefaultGenesis returns the default asset genesis state.
 func DefaultGenesis() *GenesisState {
-	// TODO(dhil) replace with real implementation
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
-	// TODO(dhil) replace with real implementation
-	return nil
+	// TODO(dhil) validate the rest of the state
+	return gs.Params.ValidateBasic()
 }
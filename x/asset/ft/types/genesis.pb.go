@@ -37,6 +37,40 @@ type GenesisState struct {
 	FrozenBalances []Balance `protobuf:"bytes,2,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances"`
 	// whitelisted_balances contains the whitelisted balances on all of the accounts
 	WhitelistedBalances []Balance `protobuf:"bytes,3,rep,name=whitelisted_balances,json=whitelistedBalances,proto3" json:"whitelisted_balances"`
+	// bridge_mint_records contains the records of the transfers minted by the bridges
+	BridgeMintRecords []BridgeMintRecord `protobuf:"bytes,4,rep,name=bridge_mint_records,json=bridgeMintRecords,proto3" json:"bridge_mint_records"`
+	// whitelist_exemptions contains the accounts exempted from the whitelisted limits
+	WhitelistExemptions []WhitelistExemption `protobuf:"bytes,5,rep,name=whitelist_exemptions,json=whitelistExemptions,proto3" json:"whitelist_exemptions"`
+	// ibc_denom_traces contains the registered traces of the IBC voucher denoms
+	IBCDenomTraces []IBCDenomTrace `protobuf:"bytes,6,rep,name=ibc_denom_traces,json=ibcDenomTraces,proto3" json:"ibc_denom_traces"`
+	// reservations contains the active reservations of the funds
+	Reservations []Reservation `protobuf:"bytes,7,rep,name=reservations,proto3" json:"reservations"`
+	// next_reservation_id is the ID assigned to the next reservation
+	NextReservationID uint64 `protobuf:"varint,8,opt,name=next_reservation_id,json=nextReservationId,proto3" json:"next_reservation_id,omitempty"`
+	// issue_idempotency_records contains the idempotency keys used by the issuers
+	IssueIdempotencyRecords []IssueIdempotencyRecord `protobuf:"bytes,9,rep,name=issue_idempotency_records,json=issueIdempotencyRecords,proto3" json:"issue_idempotency_records"`
+	// pending_global_freezes contains the global freezes scheduled to take effect in the future
+	PendingGlobalFreezes []PendingGlobalFreeze `protobuf:"bytes,10,rep,name=pending_global_freezes,json=pendingGlobalFreezes,proto3" json:"pending_global_freezes"`
+	// params defines all the parameters of the module
+	Params Params `protobuf:"bytes,11,opt,name=params,proto3" json:"params"`
+	// frozen_rates contains the shares of the balances frozen on the accounts
+	FrozenRates []FrozenRate `protobuf:"bytes,12,rep,name=frozen_rates,json=frozenRates,proto3" json:"frozen_rates"`
+	// reserve_attestations contains the latest reserve attestations of the tokens
+	ReserveAttestations []ReserveAttestation `protobuf:"bytes,13,rep,name=reserve_attestations,json=reserveAttestations,proto3" json:"reserve_attestations"`
+	// token_admins contains the admins of the tokens which issuer privileges have been transferred or cleared
+	TokenAdmins []TokenAdmin `protobuf:"bytes,14,rep,name=token_admins,json=tokenAdmins,proto3" json:"token_admins"`
+	// pending_admin_transfers contains the transfers of the issuer privileges scheduled to take effect in the future
+	PendingAdminTransfers []PendingAdminTransfer `protobuf:"bytes,15,rep,name=pending_admin_transfers,json=pendingAdminTransfers,proto3" json:"pending_admin_transfers"`
+	// timed_freezes contains the amounts frozen on the accounts until the unfreeze time
+	TimedFreezes []TimedFreeze `protobuf:"bytes,16,rep,name=timed_freezes,json=timedFreezes,proto3" json:"timed_freezes"`
+	// burn_allowances contains the amounts the spenders are allowed to burn from the owner accounts
+	BurnAllowances []BurnAllowance `protobuf:"bytes,17,rep,name=burn_allowances,json=burnAllowances,proto3" json:"burn_allowances"`
+	// account_freezes contains the accounts which all the fungible tokens of the issuers are frozen on
+	AccountFreezes []AccountFreeze `protobuf:"bytes,18,rep,name=account_freezes,json=accountFreezes,proto3" json:"account_freezes"`
+	// burn_rate_exemptions contains the accounts exempted from the burn rates
+	BurnRateExemptions []BurnRateExemption `protobuf:"bytes,19,rep,name=burn_rate_exemptions,json=burnRateExemptions,proto3" json:"burn_rate_exemptions"`
+	// token_attributes contains the key-value attributes attached to the fungible tokens
+	TokenAttributes []TokenAttribute `protobuf:"bytes,20,rep,name=token_attributes,json=tokenAttributes,proto3" json:"token_attributes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +132,366 @@ func (m *GenesisState) GetWhitelistedBalances() []Balance {
 	return nil
 }
 
+func (m *GenesisState) GetBridgeMintRecords() []BridgeMintRecord {
+	if m != nil {
+		return m.BridgeMintRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWhitelistExemptions() []WhitelistExemption {
+	if m != nil {
+		return m.WhitelistExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIBCDenomTraces() []IBCDenomTrace {
+	if m != nil {
+		return m.IBCDenomTraces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReservations() []Reservation {
+	if m != nil {
+		return m.Reservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextReservationID() uint64 {
+	if m != nil {
+		return m.NextReservationID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetIssueIdempotencyRecords() []IssueIdempotencyRecord {
+	if m != nil {
+		return m.IssueIdempotencyRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingGlobalFreezes() []PendingGlobalFreeze {
+	if m != nil {
+		return m.PendingGlobalFreezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetFrozenRates() []FrozenRate {
+	if m != nil {
+		return m.FrozenRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserveAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.ReserveAttestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenAdmins() []TokenAdmin {
+	if m != nil {
+		return m.TokenAdmins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAdminTransfers() []PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTimedFreezes() []TimedFreeze {
+	if m != nil {
+		return m.TimedFreezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnAllowances() []BurnAllowance {
+	if m != nil {
+		return m.BurnAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountFreezes() []AccountFreeze {
+	if m != nil {
+		return m.AccountFreezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnRateExemptions() []BurnRateExemption {
+	if m != nil {
+		return m.BurnRateExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenAttributes() []TokenAttribute {
+	if m != nil {
+		return m.TokenAttributes
+	}
+	return nil
+}
+
+// IssueIdempotencyRecord defines the denom of the token issued by the issuer with the idempotency key.
+type IssueIdempotencyRecord struct {
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Key    string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *IssueIdempotencyRecord) Reset()         { *m = IssueIdempotencyRecord{} }
+func (m *IssueIdempotencyRecord) String() string { return proto.CompactTextString(m) }
+func (*IssueIdempotencyRecord) ProtoMessage()    {}
+func (*IssueIdempotencyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{1}
+}
+
+func (m *IssueIdempotencyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *IssueIdempotencyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueIdempotencyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *IssueIdempotencyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueIdempotencyRecord.Merge(m, src)
+}
+
+func (m *IssueIdempotencyRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *IssueIdempotencyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueIdempotencyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueIdempotencyRecord proto.InternalMessageInfo
+
+func (m *IssueIdempotencyRecord) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *IssueIdempotencyRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *IssueIdempotencyRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FrozenRate defines the share of the balance of the denom frozen on the account.
+type FrozenRate struct {
+	Account string                                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *FrozenRate) Reset()         { *m = FrozenRate{} }
+func (m *FrozenRate) String() string { return proto.CompactTextString(m) }
+func (*FrozenRate) ProtoMessage()    {}
+func (*FrozenRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{2}
+}
+
+func (m *FrozenRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FrozenRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FrozenRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenRate.Merge(m, src)
+}
+
+func (m *FrozenRate) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FrozenRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenRate proto.InternalMessageInfo
+
+func (m *FrozenRate) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *FrozenRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// WhitelistExemption defines the account exempted from the whitelisted limits of the denom.
+type WhitelistExemption struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *WhitelistExemption) Reset()         { *m = WhitelistExemption{} }
+func (m *WhitelistExemption) String() string { return proto.CompactTextString(m) }
+func (*WhitelistExemption) ProtoMessage()    {}
+func (*WhitelistExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{3}
+}
+
+func (m *WhitelistExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WhitelistExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WhitelistExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistExemption.Merge(m, src)
+}
+
+func (m *WhitelistExemption) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WhitelistExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhitelistExemption proto.InternalMessageInfo
+
+func (m *WhitelistExemption) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *WhitelistExemption) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// BurnRateExemption defines the account exempted from the burn rate of the denom.
+type BurnRateExemption struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *BurnRateExemption) Reset()         { *m = BurnRateExemption{} }
+func (m *BurnRateExemption) String() string { return proto.CompactTextString(m) }
+func (*BurnRateExemption) ProtoMessage()    {}
+func (*BurnRateExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{4}
+}
+
+func (m *BurnRateExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BurnRateExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnRateExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BurnRateExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnRateExemption.Merge(m, src)
+}
+
+func (m *BurnRateExemption) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BurnRateExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnRateExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnRateExemption proto.InternalMessageInfo
+
+func (m *BurnRateExemption) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BurnRateExemption) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -111,7 +505,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d281657d6c91cb92, []int{1}
+	return fileDescriptor_d281657d6c91cb92, []int{5}
 }
 
 func (m *Balance) XXX_Unmarshal(b []byte) error {
@@ -161,36 +555,82 @@ func (m *Balance) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.ft.v1.GenesisState")
+	proto.RegisterType((*IssueIdempotencyRecord)(nil), "coreum.asset.ft.v1.IssueIdempotencyRecord")
+	proto.RegisterType((*FrozenRate)(nil), "coreum.asset.ft.v1.FrozenRate")
+	proto.RegisterType((*WhitelistExemption)(nil), "coreum.asset.ft.v1.WhitelistExemption")
+	proto.RegisterType((*BurnRateExemption)(nil), "coreum.asset.ft.v1.BurnRateExemption")
 	proto.RegisterType((*Balance)(nil), "coreum.asset.ft.v1.Balance")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0x6e, 0x2f, 0xf7, 0x42, 0xee, 0xdc, 0x1b, 0x4d, 0x2a, 0x31, 0x88, 0x49, 0x21, 0xac, 0xd8,
-	0x38, 0x63, 0xc5, 0x27, 0x28, 0x09, 0x26, 0x26, 0x6e, 0x90, 0x95, 0x1b, 0x33, 0x6d, 0x87, 0xd2,
-	0x00, 0x3d, 0xa4, 0x67, 0xc0, 0x9f, 0x07, 0x70, 0xed, 0x73, 0xf8, 0x24, 0x2c, 0x59, 0xba, 0x52,
-	0x03, 0x89, 0xcf, 0x61, 0x3a, 0x33, 0x04, 0x12, 0x5c, 0xb8, 0x6a, 0x67, 0xbe, 0x9f, 0x33, 0xe7,
-	0xfb, 0x48, 0x3d, 0x84, 0x4c, 0x4c, 0xc7, 0x8c, 0x23, 0x0a, 0xc9, 0xfa, 0x92, 0xcd, 0x3c, 0x16,
-	0x8b, 0x54, 0x60, 0x82, 0x74, 0x92, 0x81, 0x04, 0xc7, 0xd1, 0x0c, 0xaa, 0x18, 0xb4, 0x2f, 0xe9,
-	0xcc, 0xab, 0x96, 0x63, 0x88, 0x41, 0xc1, 0x2c, 0xff, 0xd3, 0xcc, 0xaa, 0x1b, 0x02, 0x8e, 0x01,
-	0x59, 0xc0, 0x51, 0xb0, 0x99, 0x17, 0x08, 0xc9, 0x3d, 0x16, 0x42, 0x92, 0x6e, 0xf0, 0x9d, 0x59,
-	0x12, 0x86, 0xc2, 0xe0, 0x8d, 0x4f, 0x9b, 0xfc, 0xbf, 0xd0, 0xb3, 0xaf, 0x25, 0x97, 0xc2, 0x39,
-	0x27, 0x45, 0x85, 0x63, 0xc5, 0xae, 0x17, 0x9a, 0xff, 0xce, 0x0e, 0xe9, 0xee, 0x5b, 0x68, 0xa7,
-	0xe7, 0xff, 0x9e, 0xbf, 0xd5, 0xac, 0xae, 0xe1, 0x3a, 0x97, 0x64, 0xbf, 0x9f, 0xc1, 0xa3, 0x48,
-	0x6f, 0x03, 0x3e, 0xe2, 0x69, 0x28, 0xb0, 0xf2, 0x4b, 0xc9, 0x8f, 0xbf, 0x93, 0xfb, 0x9a, 0x63,
-	0x3c, 0xf6, 0xb4, 0xd2, 0x5c, 0xa2, 0xd3, 0x23, 0xe5, 0xbb, 0x41, 0x22, 0xc5, 0x28, 0x41, 0x29,
-	0xa2, 0x8d, 0x61, 0xe1, 0xa7, 0x86, 0x07, 0x5b, 0xf2, 0xb5, 0x6b, 0xe3, 0xc9, 0x26, 0x25, 0x73,
-	0x70, 0x2a, 0xa4, 0xc4, 0xa3, 0x28, 0x13, 0x98, 0x2f, 0x69, 0x37, 0xff, 0x76, 0xd7, 0x47, 0x87,
-	0x93, 0x3f, 0x79, 0x78, 0xeb, 0xd7, 0x1f, 0x51, 0x1d, 0x2f, 0xcd, 0xe3, 0xa5, 0x26, 0x5e, 0xda,
-	0x86, 0x24, 0xf5, 0x4f, 0xf3, 0x51, 0x2f, 0xef, 0xb5, 0x66, 0x9c, 0xc8, 0xc1, 0x34, 0xa0, 0x21,
-	0x8c, 0x99, 0xe9, 0x42, 0x7f, 0x4e, 0x30, 0x1a, 0x32, 0xf9, 0x30, 0x11, 0xa8, 0x04, 0xd8, 0xd5,
-	0xce, 0xfe, 0xd5, 0x7c, 0xe9, 0xda, 0x8b, 0xa5, 0x6b, 0x7f, 0x2c, 0x5d, 0xfb, 0x79, 0xe5, 0x5a,
-	0x8b, 0x95, 0x6b, 0xbd, 0xae, 0x5c, 0xeb, 0xa6, 0xb5, 0x65, 0xd5, 0x56, 0x4b, 0x76, 0x60, 0x9a,
-	0x46, 0x5c, 0x26, 0x90, 0x32, 0xd3, 0xe3, 0xfd, 0xa6, 0x49, 0xe5, 0x1d, 0x14, 0x55, 0x8f, 0xad,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0xcf, 0xf2, 0x58, 0x55, 0x02, 0x00, 0x00,
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xfd, 0xdb, 0xac, 0x65, 0xd9, 0x5a, 0x2b, 0x0a, 0xe3, 0x16, 0x92, 0x2a, 0x24, 0x8e,
+	0x50, 0xb4, 0x64, 0x9d, 0xf4, 0xd0, 0xab, 0x69, 0xc7, 0x86, 0x0b, 0xa4, 0x08, 0x14, 0x01, 0x2d,
+	0x02, 0x14, 0x04, 0x7f, 0x56, 0xca, 0xc2, 0x22, 0x57, 0xe0, 0xac, 0x1c, 0x3b, 0xa7, 0x9e, 0x7a,
+	0xee, 0x53, 0xf4, 0xd0, 0x27, 0xc9, 0x31, 0xc7, 0xa2, 0x07, 0xb7, 0x90, 0x5f, 0xa4, 0xd8, 0xe5,
+	0x52, 0x24, 0xad, 0x95, 0x9b, 0x9c, 0xa4, 0x9d, 0xf9, 0xe6, 0xdb, 0x8f, 0x33, 0xb3, 0xb3, 0x8b,
+	0xda, 0x01, 0x4b, 0xc8, 0x24, 0xb2, 0x3d, 0x00, 0xc2, 0xed, 0x01, 0xb7, 0x2f, 0x0e, 0xec, 0x21,
+	0x89, 0x09, 0x50, 0xb0, 0xc6, 0x09, 0xe3, 0x0c, 0xe3, 0x14, 0x61, 0x49, 0x84, 0x35, 0xe0, 0xd6,
+	0xc5, 0xc1, 0x5e, 0x7d, 0xc8, 0x86, 0x4c, 0xba, 0x6d, 0xf1, 0x2f, 0x45, 0xee, 0x35, 0x03, 0x06,
+	0x11, 0x03, 0xdb, 0xf7, 0x80, 0xd8, 0x17, 0x07, 0x3e, 0xe1, 0xde, 0x81, 0x1d, 0x30, 0x1a, 0x2b,
+	0xff, 0x13, 0xcd, 0x5e, 0x5e, 0x10, 0xb0, 0x49, 0xcc, 0xdd, 0x41, 0x42, 0xc8, 0x3b, 0x92, 0x13,
+	0xcd, 0x03, 0xc3, 0x68, 0x46, 0xd4, 0xd2, 0xf8, 0xfd, 0x84, 0x86, 0x43, 0x72, 0xc7, 0x4e, 0xfe,
+	0x24, 0x89, 0x5d, 0x6f, 0x34, 0x62, 0x6f, 0xbd, 0x38, 0xc8, 0x80, 0xfb, 0xba, 0xcf, 0x1f, 0x31,
+	0xdf, 0x1b, 0x95, 0x15, 0x7d, 0xa1, 0xc1, 0x51, 0x3f, 0xb8, 0x43, 0xcf, 0xd8, 0x4b, 0xbc, 0x48,
+	0xe5, 0x70, 0xef, 0x91, 0x06, 0x90, 0x10, 0x20, 0xc9, 0x85, 0xc7, 0x29, 0xcb, 0x3e, 0xeb, 0xeb,
+	0x85, 0x28, 0xe2, 0x7a, 0x9c, 0x13, 0xe0, 0x45, 0xf4, 0x63, 0x0d, 0x9a, 0xd3, 0x88, 0x84, 0xff,
+	0x9f, 0x4b, 0xce, 0xce, 0x89, 0xa2, 0xe9, 0xfc, 0xb1, 0x85, 0x2a, 0xa7, 0x69, 0xc1, 0x5f, 0x71,
+	0x8f, 0x13, 0xfc, 0x1d, 0x5a, 0x97, 0x7e, 0x30, 0x8d, 0xf6, 0x4a, 0x77, 0xf3, 0x69, 0xc3, 0x9a,
+	0x6f, 0x00, 0xeb, 0xa4, 0xef, 0xac, 0xbe, 0xbf, 0x6e, 0x2d, 0xf5, 0x14, 0x16, 0xff, 0x80, 0xb6,
+	0x07, 0x09, 0x7b, 0x47, 0x62, 0xd7, 0xf7, 0x46, 0x22, 0xc1, 0x60, 0x2e, 0xcb, 0xf0, 0xcf, 0x75,
+	0xe1, 0x4e, 0x8a, 0x51, 0x1c, 0xd5, 0x34, 0x52, 0x19, 0x01, 0xf7, 0x51, 0xfd, 0xed, 0x1b, 0xca,
+	0xc9, 0x88, 0x02, 0x27, 0x61, 0x4e, 0xb8, 0xf2, 0xb1, 0x84, 0xbb, 0x85, 0xf0, 0x19, 0xeb, 0x6b,
+	0xb4, 0x9b, 0xf6, 0x88, 0x1b, 0xd1, 0x98, 0xbb, 0x09, 0x09, 0x58, 0x12, 0x82, 0xb9, 0x2a, 0x49,
+	0x1f, 0x69, 0x49, 0x25, 0xfc, 0x05, 0x8d, 0x79, 0x4f, 0x82, 0x15, 0x7b, 0xcd, 0xbf, 0x65, 0x07,
+	0xec, 0x16, 0x14, 0xbb, 0xe4, 0x92, 0x44, 0x63, 0x51, 0x28, 0x30, 0xd7, 0x24, 0xf9, 0xbe, 0x8e,
+	0xfc, 0xa7, 0x0c, 0xff, 0x3c, 0x83, 0xcf, 0x89, 0x9f, 0x79, 0x00, 0x07, 0x68, 0x87, 0xfa, 0x81,
+	0x1b, 0x92, 0x98, 0x45, 0x2e, 0x4f, 0x3c, 0x91, 0x8e, 0x75, 0x49, 0xfe, 0xa5, 0x8e, 0xfc, 0xcc,
+	0x39, 0x3a, 0x16, 0xd0, 0xbe, 0x40, 0x3a, 0x0d, 0xc1, 0x3b, 0xbd, 0x6e, 0x55, 0x4b, 0x66, 0xe8,
+	0x55, 0xa9, 0x1f, 0x14, 0xd6, 0xf8, 0x0c, 0x55, 0x0a, 0x4d, 0x09, 0xe6, 0x86, 0xdc, 0xa0, 0xa5,
+	0xdb, 0xa0, 0x97, 0xe3, 0x94, 0xec, 0x52, 0x28, 0x7e, 0x8e, 0x76, 0x63, 0x72, 0xc9, 0xdd, 0x82,
+	0xd1, 0xa5, 0xa1, 0xf9, 0x59, 0xdb, 0xe8, 0xae, 0x3a, 0xf7, 0xa7, 0xd7, 0xad, 0xda, 0x8f, 0xe4,
+	0x92, 0x17, 0x58, 0xce, 0x8e, 0x7b, 0xb5, 0xf8, 0x96, 0x29, 0xc4, 0x23, 0xf4, 0x90, 0x02, 0x4c,
+	0x88, 0x4b, 0x43, 0x12, 0x8d, 0x19, 0x27, 0x71, 0x70, 0x35, 0xab, 0xdc, 0x3d, 0x29, 0xef, 0x2b,
+	0xed, 0xf7, 0x8b, 0xa0, 0xb3, 0x3c, 0xa6, 0x54, 0xbf, 0x07, 0x54, 0xeb, 0x15, 0x49, 0x6e, 0x8c,
+	0x49, 0x1c, 0xd2, 0x78, 0xe8, 0x96, 0x66, 0x00, 0x98, 0x48, 0x6e, 0xf5, 0x44, 0xb7, 0xd5, 0xcb,
+	0x34, 0xe2, 0x54, 0x06, 0x9c, 0x48, 0xbc, 0xda, 0xa7, 0x3e, 0x9e, 0x77, 0x01, 0xfe, 0x1e, 0xad,
+	0xa7, 0xa3, 0xc1, 0xdc, 0x6c, 0x1b, 0xdd, 0xcd, 0xa7, 0x7b, 0x5a, 0x52, 0x89, 0xc8, 0x8e, 0x58,
+	0x8a, 0xc7, 0xa7, 0xa8, 0xa2, 0x8e, 0x58, 0xe2, 0x71, 0x02, 0x66, 0x45, 0x8a, 0x6a, 0x6a, 0x8f,
+	0xa7, 0xc4, 0xf5, 0x3c, 0x9e, 0x69, 0xd9, 0x1c, 0xcc, 0x2c, 0xb2, 0x5b, 0x35, 0x63, 0x05, 0xcc,
+	0xad, 0xc5, 0xdd, 0x9a, 0x96, 0x85, 0x1c, 0xe6, 0xf0, 0xac, 0x5b, 0x93, 0x39, 0x8f, 0x54, 0x2a,
+	0xc7, 0x82, 0x2b, 0x87, 0x36, 0x98, 0xd5, 0xc5, 0x4a, 0xfb, 0x02, 0x77, 0x28, 0x60, 0x99, 0x52,
+	0x3e, 0xb3, 0x00, 0x1e, 0xa0, 0x07, 0x59, 0x45, 0x24, 0x95, 0x68, 0xfd, 0x18, 0x06, 0x24, 0x01,
+	0x73, 0x5b, 0x72, 0x76, 0xef, 0x28, 0x89, 0xe4, 0xe8, 0xab, 0x00, 0xc5, 0x7e, 0x7f, 0xac, 0xf1,
+	0x89, 0xe9, 0xb5, 0x55, 0x1c, 0x9d, 0x60, 0xee, 0x2c, 0x6e, 0xfd, 0xbe, 0x00, 0x96, 0x0a, 0x5d,
+	0xe1, 0xb9, 0x09, 0xf0, 0x4b, 0xb4, 0x5d, 0xbe, 0x6a, 0xc0, 0xac, 0x2d, 0x3e, 0xa9, 0xce, 0x24,
+	0x89, 0x0f, 0x33, 0x64, 0x36, 0x0f, 0xfd, 0xa2, 0x51, 0x32, 0x96, 0xaf, 0x49, 0x30, 0xf1, 0x62,
+	0xc6, 0xc3, 0x14, 0x5a, 0x52, 0x58, 0xf5, 0x8a, 0x46, 0xc0, 0xbf, 0xa0, 0xba, 0xd4, 0x28, 0x1a,
+	0xa9, 0x38, 0xaf, 0x76, 0x25, 0xed, 0xe3, 0x45, 0x42, 0x45, 0xfb, 0xdc, 0x1e, 0x57, 0xd8, 0xbf,
+	0xed, 0x00, 0xfc, 0x0a, 0xed, 0xa8, 0xfa, 0x73, 0x9e, 0x50, 0x7f, 0x22, 0xba, 0xb5, 0x2e, 0xa9,
+	0x3b, 0x8b, 0x7b, 0x20, 0x83, 0x2a, 0xde, 0x6d, 0x5e, 0xb2, 0x42, 0xe7, 0x67, 0xd4, 0xd0, 0x1f,
+	0x6b, 0xdc, 0x40, 0xeb, 0xf2, 0x48, 0x27, 0xa6, 0xd1, 0x36, 0xba, 0xf7, 0x7a, 0x6a, 0x85, 0x77,
+	0xd0, 0xca, 0x39, 0xb9, 0x32, 0x97, 0xa5, 0x51, 0xfc, 0xc5, 0x75, 0xb4, 0x26, 0x47, 0xa8, 0xb9,
+	0x22, 0x6d, 0xe9, 0xa2, 0xf3, 0xab, 0x81, 0x50, 0x7e, 0x62, 0xb0, 0x89, 0x36, 0x54, 0xba, 0x14,
+	0x5f, 0xb6, 0xcc, 0xc3, 0x97, 0x0b, 0xe1, 0xd8, 0x41, 0xab, 0x22, 0x8f, 0x29, 0xa7, 0x63, 0x09,
+	0xf5, 0x7f, 0x5f, 0xb7, 0xf6, 0x87, 0x94, 0xbf, 0x99, 0xf8, 0x56, 0xc0, 0x22, 0x5b, 0xbd, 0x8b,
+	0xd2, 0x9f, 0x6f, 0x20, 0x3c, 0xb7, 0xf9, 0xd5, 0x98, 0x80, 0x75, 0x4c, 0x82, 0x9e, 0x8c, 0xed,
+	0x1c, 0x23, 0x3c, 0x7f, 0x21, 0xe4, 0xfb, 0x19, 0xc5, 0xfd, 0x0a, 0xfa, 0x96, 0x4b, 0xfa, 0x3a,
+	0x47, 0xa8, 0x36, 0x57, 0xa6, 0x4f, 0x26, 0xf9, 0xcd, 0x40, 0x1b, 0xea, 0xd2, 0x94, 0xa8, 0x30,
+	0x4c, 0x08, 0xc0, 0x2c, 0x15, 0xe9, 0x12, 0x7b, 0x68, 0x4d, 0xbc, 0xec, 0xb2, 0x5b, 0xfe, 0xa1,
+	0x95, 0x7e, 0x9c, 0x25, 0xde, 0x7e, 0x96, 0x7a, 0xfb, 0x59, 0x47, 0x8c, 0xc6, 0xce, 0xb7, 0x22,
+	0x21, 0x7f, 0xfe, 0xd3, 0xea, 0x7e, 0x44, 0x42, 0x44, 0x00, 0xf4, 0x52, 0x66, 0xe7, 0xc5, 0xfb,
+	0x69, 0xd3, 0xf8, 0x30, 0x6d, 0x1a, 0xff, 0x4e, 0x9b, 0xc6, 0xef, 0x37, 0xcd, 0xa5, 0x0f, 0x37,
+	0xcd, 0xa5, 0xbf, 0x6e, 0x9a, 0x4b, 0xaf, 0x9f, 0x15, 0xa8, 0x8e, 0x64, 0x3f, 0x9d, 0xb0, 0x49,
+	0x1c, 0xca, 0xe9, 0x63, 0xab, 0xf7, 0xce, 0x65, 0xfe, 0xe2, 0x91, 0xdc, 0xfe, 0xba, 0x7c, 0xef,
+	0x3c, 0xfb, 0x6f, 0x00, 0xc9, 0x28, 0xc9, 0x1f, 0xf2, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,10 +653,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.WhitelistedBalances) > 0 {
-		for iNdEx := len(m.WhitelistedBalances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TokenAttributes) > 0 {
+		for iNdEx := len(m.TokenAttributes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.WhitelistedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TokenAttributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -224,13 +664,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.FrozenBalances) > 0 {
-		for iNdEx := len(m.FrozenBalances) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BurnRateExemptions) > 0 {
+		for iNdEx := len(m.BurnRateExemptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FrozenBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BurnRateExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -238,13 +680,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AccountFreezes) > 0 {
+		for iNdEx := len(m.AccountFreezes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AccountFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -252,123 +696,1699 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Balance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if len(m.BurnAllowances) > 0 {
+		for iNdEx := len(m.BurnAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TimedFreezes) > 0 {
+		for iNdEx := len(m.TimedFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for iNdEx := len(m.PendingAdminTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TokenAdmins) > 0 {
+		for iNdEx := len(m.TokenAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ReserveAttestations) > 0 {
+		for iNdEx := len(m.ReserveAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FrozenRates) > 0 {
+		for iNdEx := len(m.FrozenRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PendingGlobalFreezes) > 0 {
+		for iNdEx := len(m.PendingGlobalFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingGlobalFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.IssueIdempotencyRecords) > 0 {
+		for iNdEx := len(m.IssueIdempotencyRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssueIdempotencyRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextReservationID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextReservationID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reservations) > 0 {
+		for iNdEx := len(m.Reservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.IBCDenomTraces) > 0 {
+		for iNdEx := len(m.IBCDenomTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCDenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WhitelistExemptions) > 0 {
+		for iNdEx := len(m.WhitelistExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BridgeMintRecords) > 0 {
+		for iNdEx := len(m.BridgeMintRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeMintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WhitelistedBalances) > 0 {
+		for iNdEx := len(m.WhitelistedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FrozenBalances) > 0 {
+		for iNdEx := len(m.FrozenBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueIdempotencyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+func (m *IssueIdempotencyRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IssueIdempotencyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnRateExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnRateExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnRateExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenBalances) > 0 {
+		for _, e := range m.FrozenBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhitelistedBalances) > 0 {
+		for _, e := range m.WhitelistedBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeMintRecords) > 0 {
+		for _, e := range m.BridgeMintRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhitelistExemptions) > 0 {
+		for _, e := range m.WhitelistExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IBCDenomTraces) > 0 {
+		for _, e := range m.IBCDenomTraces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reservations) > 0 {
+		for _, e := range m.Reservations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextReservationID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextReservationID))
+	}
+	if len(m.IssueIdempotencyRecords) > 0 {
+		for _, e := range m.IssueIdempotencyRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingGlobalFreezes) > 0 {
+		for _, e := range m.PendingGlobalFreezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenRates) > 0 {
+		for _, e := range m.FrozenRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveAttestations) > 0 {
+		for _, e := range m.ReserveAttestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenAdmins) > 0 {
+		for _, e := range m.TokenAdmins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for _, e := range m.PendingAdminTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimedFreezes) > 0 {
+		for _, e := range m.TimedFreezes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnAllowances) > 0 {
+		for _, e := range m.BurnAllowances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountFreezes) > 0 {
+		for _, e := range m.AccountFreezes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnRateExemptions) > 0 {
+		for _, e := range m.BurnRateExemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenAttributes) > 0 {
+		for _, e := range m.TokenAttributes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IssueIdempotencyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FrozenRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *WhitelistExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BurnRateExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, FT{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBalances = append(m.FrozenBalances, Balance{})
+			if err := m.FrozenBalances[len(m.FrozenBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedBalances = append(m.WhitelistedBalances, Balance{})
+			if err := m.WhitelistedBalances[len(m.WhitelistedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeMintRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeMintRecords = append(m.BridgeMintRecords, BridgeMintRecord{})
+			if err := m.BridgeMintRecords[len(m.BridgeMintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistExemptions = append(m.WhitelistExemptions, WhitelistExemption{})
+			if err := m.WhitelistExemptions[len(m.WhitelistExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenomTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCDenomTraces = append(m.IBCDenomTraces, IBCDenomTrace{})
+			if err := m.IBCDenomTraces[len(m.IBCDenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reservations = append(m.Reservations, Reservation{})
+			if err := m.Reservations[len(m.Reservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReservationID", wireType)
+			}
+			m.NextReservationID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReservationID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueIdempotencyRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssueIdempotencyRecords = append(m.IssueIdempotencyRecords, IssueIdempotencyRecord{})
+			if err := m.IssueIdempotencyRecords[len(m.IssueIdempotencyRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingGlobalFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingGlobalFreezes = append(m.PendingGlobalFreezes, PendingGlobalFreeze{})
+			if err := m.PendingGlobalFreezes[len(m.PendingGlobalFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenRates = append(m.FrozenRates, FrozenRate{})
+			if err := m.FrozenRates[len(m.FrozenRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAttestations = append(m.ReserveAttestations, ReserveAttestation{})
+			if err := m.ReserveAttestations[len(m.ReserveAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAdmins = append(m.TokenAdmins, TokenAdmin{})
+			if err := m.TokenAdmins[len(m.TokenAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminTransfers = append(m.PendingAdminTransfers, PendingAdminTransfer{})
+			if err := m.PendingAdminTransfers[len(m.PendingAdminTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedFreezes = append(m.TimedFreezes, TimedFreeze{})
+			if err := m.TimedFreezes[len(m.TimedFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnAllowances = append(m.BurnAllowances, BurnAllowance{})
+			if err := m.BurnAllowances[len(m.BurnAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountFreezes = append(m.AccountFreezes, AccountFreeze{})
+			if err := m.AccountFreezes[len(m.AccountFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRateExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnRateExemptions = append(m.BurnRateExemptions, BurnRateExemption{})
+			if err := m.BurnRateExemptions[len(m.BurnRateExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAttributes = append(m.TokenAttributes, TokenAttribute{})
+			if err := m.TokenAttributes[len(m.TokenAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IssueIdempotencyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueIdempotencyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueIdempotencyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FrozenRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.FrozenBalances) > 0 {
-		for _, e := range m.FrozenBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.WhitelistedBalances) > 0 {
-		for _, e := range m.WhitelistedBalances {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Balance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *WhitelistExemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -391,17 +2411,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhitelistExemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhitelistExemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -411,31 +2431,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, FT{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -445,31 +2463,80 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrozenBalances = append(m.FrozenBalances, Balance{})
-			if err := m.FrozenBalances[len(m.FrozenBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *BurnRateExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnRateExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnRateExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -479,25 +2546,55 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedBalances = append(m.WhitelistedBalances, Balance{})
-			if err := m.WhitelistedBalances[len(m.WhitelistedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/global_freeze.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingGlobalFreeze is the global freeze of the fungible token scheduled by the issuer to take effect in the future.
+type PendingGlobalFreeze struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// activation_time is the block time the global freeze takes effect at.
+	ActivationTime time.Time `protobuf:"bytes,2,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *PendingGlobalFreeze) Reset()         { *m = PendingGlobalFreeze{} }
+func (m *PendingGlobalFreeze) String() string { return proto.CompactTextString(m) }
+func (*PendingGlobalFreeze) ProtoMessage()    {}
+func (*PendingGlobalFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fafb5d47526f371, []int{0}
+}
+
+func (m *PendingGlobalFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *PendingGlobalFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingGlobalFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *PendingGlobalFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingGlobalFreeze.Merge(m, src)
+}
+
+func (m *PendingGlobalFreeze) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *PendingGlobalFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingGlobalFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingGlobalFreeze proto.InternalMessageInfo
+
+func (m *PendingGlobalFreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingGlobalFreeze) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PendingGlobalFreeze)(nil), "coreum.asset.ft.v1.PendingGlobalFreeze")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/ft/v1/global_freeze.proto", fileDescriptor_4fafb5d47526f371)
+}
+
+var fileDescriptor_4fafb5d47526f371 = []byte{
+	// 264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xb3, 0x82, 0xa2, 0x11, 0x14, 0x62, 0x0f, 0x25, 0x87, 0x4d, 0xf1, 0x20, 0x3d, 0xed,
+	0x52, 0xfb, 0x06, 0x15, 0xea, 0xa9, 0x20, 0xc5, 0x93, 0x97, 0x92, 0x3f, 0x93, 0x35, 0x90, 0xec,
+	0x84, 0x64, 0x12, 0xb4, 0x4f, 0xd1, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x2a, 0xc9, 0x8b, 0x48, 0x76,
+	0x2d, 0xbd, 0xed, 0x30, 0xbf, 0x6f, 0x7f, 0xcc, 0xe7, 0x3e, 0xc4, 0x58, 0x41, 0x53, 0xc8, 0xb0,
+	0xae, 0x81, 0x64, 0x4a, 0xb2, 0x9d, 0x49, 0x95, 0x63, 0x14, 0xe6, 0x9b, 0xb4, 0x02, 0xd8, 0x82,
+	0x28, 0x2b, 0x24, 0xf4, 0x3c, 0xcb, 0x09, 0xc3, 0x89, 0x94, 0x44, 0x3b, 0xf3, 0x47, 0x0a, 0x15,
+	0x9a, 0xb5, 0x1c, 0x5e, 0x96, 0xf4, 0x03, 0x85, 0xa8, 0x72, 0x90, 0x66, 0x8a, 0x9a, 0x54, 0x52,
+	0x56, 0x40, 0x4d, 0x61, 0x51, 0x5a, 0xe0, 0x7e, 0xeb, 0xde, 0xbd, 0x80, 0x4e, 0x32, 0xad, 0x9e,
+	0x8d, 0x68, 0x69, 0x3c, 0xde, 0xc8, 0x3d, 0x4f, 0x40, 0x63, 0x31, 0x66, 0x13, 0x36, 0xbd, 0x5a,
+	0xdb, 0xc1, 0x5b, 0xb9, 0xb7, 0x61, 0x4c, 0x59, 0x1b, 0x52, 0x86, 0x7a, 0x33, 0x7c, 0x35, 0x3e,
+	0x9b, 0xb0, 0xe9, 0xf5, 0xa3, 0x2f, 0xac, 0x47, 0x1c, 0x3d, 0xe2, 0xf5, 0xe8, 0x59, 0x5c, 0xee,
+	0xbf, 0x03, 0x67, 0xf7, 0x13, 0xb0, 0xf5, 0xcd, 0x29, 0x3c, 0xac, 0x17, 0xab, 0x7d, 0xc7, 0xd9,
+	0xa1, 0xe3, 0xec, 0xb7, 0xe3, 0x6c, 0xd7, 0x73, 0xe7, 0xd0, 0x73, 0xe7, 0xab, 0xe7, 0xce, 0xdb,
+	0x5c, 0x65, 0xf4, 0xde, 0x44, 0x22, 0xc6, 0x42, 0x3e, 0x99, 0x5b, 0x97, 0xd8, 0xe8, 0xc4, 0x44,
+	0xe5, 0x7f, 0x49, 0x1f, 0xa7, 0x9a, 0xe8, 0xb3, 0x84, 0x3a, 0xba, 0x30, 0xf2, 0xf9, 0xdf, 0x00,
+	0xdb, 0xd4, 0x29, 0x11, 0x46, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingGlobalFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingGlobalFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingGlobalFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGlobalFreeze(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGlobalFreeze(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGlobalFreeze(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGlobalFreeze(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *PendingGlobalFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGlobalFreeze(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovGlobalFreeze(uint64(l))
+	return n
+}
+
+func sovGlobalFreeze(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozGlobalFreeze(x uint64) (n int) {
+	return sovGlobalFreeze(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *PendingGlobalFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGlobalFreeze
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingGlobalFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingGlobalFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGlobalFreeze
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGlobalFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGlobalFreeze
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGlobalFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGlobalFreeze(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGlobalFreeze
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipGlobalFreeze(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGlobalFreeze
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalFreeze
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGlobalFreeze
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGlobalFreeze
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGlobalFreeze
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGlobalFreeze
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGlobalFreeze        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGlobalFreeze          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGlobalFreeze = fmt.Errorf("proto: unexpected end of group")
+)
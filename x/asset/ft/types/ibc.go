@@ -0,0 +1,73 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// IBCDenomPrefix is the prefix of the IBC voucher denoms.
+	IBCDenomPrefix = "ibc/"
+
+	maxIBCPathLength = 512
+)
+
+// ibcIdentifierRegex is the regex of the port and channel identifiers defined by ICS-024.
+var ibcIdentifierRegex = regexp.MustCompile(`^[a-zA-Z0-9\.\_\+\-\#\[\]\<\>]{2,128}$`)
+
+// Hash returns the hash of the trace the IBC denom is built from, the same way the IBC transfer module does.
+func (t IBCDenomTrace) Hash() []byte {
+	hash := sha256.Sum256([]byte(t.Path + "/" + t.BaseDenom))
+	return hash[:]
+}
+
+// IBCDenom returns the IBC voucher denom of the trace.
+func (t IBCDenomTrace) IBCDenom() string {
+	return IBCDenomPrefix + strings.ToUpper(hex.EncodeToString(t.Hash()))
+}
+
+// Validate checks the trace is valid.
+func (t IBCDenomTrace) Validate() error {
+	if err := sdk.ValidateDenom(t.BaseDenom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid base denom: %s", err)
+	}
+	if strings.HasPrefix(t.BaseDenom, IBCDenomPrefix) {
+		return sdkerrors.Wrap(ErrInvalidInput, "base denom must not be the IBC denom")
+	}
+
+	if t.Path == "" || len(t.Path) > maxIBCPathLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "path length must be between 1 and %d", maxIBCPathLength)
+	}
+	identifiers := strings.Split(t.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "path must consist of the port and channel identifier pairs")
+	}
+	for _, identifier := range identifiers {
+		if !ibcIdentifierRegex.MatchString(identifier) {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid identifier %q in the path", identifier)
+		}
+	}
+
+	return nil
+}
+
+// ParseIBCDenomHash returns the hash of the IBC denom. Both the full "ibc/{hash}" denom and the hash are accepted.
+func ParseIBCDenomHash(denom string) ([]byte, error) {
+	hash, err := hex.DecodeString(strings.TrimPrefix(denom, IBCDenomPrefix))
+	if err != nil || len(hash) != sha256.Size {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "invalid IBC denom %q", denom)
+	}
+	return hash, nil
+}
+
+// IBCDenomDescription returns the description of the denom metadata registered for the IBC voucher of the
+// fungible token issued on the chain.
+func IBCDenomDescription(trace IBCDenomTrace) string {
+	return fmt.Sprintf("IBC voucher of %s transferred through %s", trace.BaseDenom, trace.Path)
+}
@@ -0,0 +1,30 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestIBCDenomTrace_IBCDenom(t *testing.T) {
+	requireT := require.New(t)
+
+	// the denom matches the one built by the IBC transfer module
+	trace := types.IBCDenomTrace{Path: "transfer/channel-0", BaseDenom: "ucore"}
+	requireT.Equal("ibc/86B47DEC6B136705939A347805A8927510446F3BF929DF96022EA1921287D8AC", trace.IBCDenom())
+
+	hash, err := types.ParseIBCDenomHash(trace.IBCDenom())
+	requireT.NoError(err)
+	requireT.Equal(trace.Hash(), hash)
+
+	hash, err = types.ParseIBCDenomHash("86b47dec6b136705939a347805a8927510446f3bf929df96022ea1921287d8ac")
+	requireT.NoError(err)
+	requireT.Equal(trace.Hash(), hash)
+
+	_, err = types.ParseIBCDenomHash("ibc/86B47DEC")
+	requireT.True(types.ErrInvalidInput.Is(err))
+	_, err = types.ParseIBCDenomHash("ucore")
+	requireT.True(types.ErrInvalidInput.Is(err))
+}
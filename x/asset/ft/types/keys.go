@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
@@ -29,10 +31,65 @@ var (
 	SymbolKeyPrefix = []byte{0x02}
 	// FrozenBalancesKeyPrefix defines the key prefix to track frozen balances
 	FrozenBalancesKeyPrefix = []byte{0x03}
-	// GlobalFreezeKeyPrefix defines the key prefix to track global freezing of a Fungible Token.
+	// GlobalFreezeKeyPrefix defines the legacy key prefix to track global freezing of a Fungible Token. The global
+	// freezes are migrated to the suspended status stored under TokenStatusKeyPrefix.
 	GlobalFreezeKeyPrefix = []byte{0x04}
 	// WhitelistedBalancesKeyPrefix defines the key prefix to track whitelisted balances
 	WhitelistedBalancesKeyPrefix = []byte{0x05}
+	// BridgeMintRecordKeyPrefix defines the key prefix for the records of the transfers minted by the bridges.
+	BridgeMintRecordKeyPrefix = []byte{0x06}
+	// WhitelistExemptionKeyPrefix defines the key prefix for the accounts exempted from the whitelisted limits.
+	WhitelistExemptionKeyPrefix = []byte{0x07}
+	// IBCDenomTraceKeyPrefix defines the key prefix for the traces of the IBC voucher denoms.
+	IBCDenomTraceKeyPrefix = []byte{0x08}
+	// NextReservationIDKey defines the key for the ID assigned to the next reservation.
+	NextReservationIDKey = []byte{0x09}
+	// ReservationKeyPrefix defines the key prefix for the reservations of the funds.
+	ReservationKeyPrefix = []byte{0x0a}
+	// ReservationExpirationQueueKeyPrefix defines the key prefix for the queue of the reservations ordered by
+	// expiration time.
+	ReservationExpirationQueueKeyPrefix = []byte{0x0b}
+	// PayeeReservationKeyPrefix defines the key prefix for the index of the reservations by payee.
+	PayeeReservationKeyPrefix = []byte{0x0c}
+	// FeatureTokenKeyPrefix defines the key prefix for the index of the fungible tokens by enabled feature.
+	FeatureTokenKeyPrefix = []byte{0x0d}
+	// IssueIdempotencyKeyPrefix defines the key prefix for the denoms of the tokens issued with the idempotency keys.
+	IssueIdempotencyKeyPrefix = []byte{0x0e}
+	// PendingGlobalFreezeKeyPrefix defines the key prefix for the global freezes scheduled to take effect in the future.
+	PendingGlobalFreezeKeyPrefix = []byte{0x0f}
+	// PendingGlobalFreezeQueueKeyPrefix defines the key prefix for the queue of the scheduled global freezes ordered by
+	// activation time.
+	PendingGlobalFreezeQueueKeyPrefix = []byte{0x10}
+	// FrozenRateKeyPrefix defines the key prefix for the shares of the balances frozen on the accounts.
+	FrozenRateKeyPrefix = []byte{0x11}
+	// ReserveAttestationKeyPrefix defines the key prefix for the latest reserve attestations of the fungible tokens.
+	ReserveAttestationKeyPrefix = []byte{0x12}
+	// TokenAdminKeyPrefix defines the key prefix for the admins of the fungible tokens which issuer privileges have been
+	// transferred or cleared.
+	TokenAdminKeyPrefix = []byte{0x13}
+	// PendingAdminTransferKeyPrefix defines the key prefix for the admin transfers scheduled to take effect in the future.
+	PendingAdminTransferKeyPrefix = []byte{0x14}
+	// PendingAdminTransferQueueKeyPrefix defines the key prefix for the queue of the scheduled admin transfers ordered by
+	// activation time.
+	PendingAdminTransferQueueKeyPrefix = []byte{0x15}
+	// TimedFreezeKeyPrefix defines the key prefix for the amounts frozen on the accounts until the unfreeze time.
+	TimedFreezeKeyPrefix = []byte{0x16}
+	// TimedFreezeQueueKeyPrefix defines the key prefix for the queue of the timed freezes ordered by unfreeze time.
+	TimedFreezeQueueKeyPrefix = []byte{0x17}
+	// BurnAllowanceKeyPrefix defines the key prefix for the amounts the spenders are allowed to burn from the accounts.
+	BurnAllowanceKeyPrefix = []byte{0x18}
+	// AccountFreezeKeyPrefix defines the key prefix for the accounts which all the fungible tokens of the issuers are
+	// frozen on.
+	AccountFreezeKeyPrefix = []byte{0x19}
+	// TokenStatsKeyPrefix defines the key prefix for the counters of the holders and the frozen and whitelisted amounts
+	// of the fungible tokens.
+	TokenStatsKeyPrefix = []byte{0x1a}
+	// BurnRateExemptionKeyPrefix defines the key prefix for the accounts exempted from the burn rates.
+	BurnRateExemptionKeyPrefix = []byte{0x1b}
+	// TokenAttributeKeyPrefix defines the key prefix for the key-value attributes attached to the fungible tokens.
+	TokenAttributeKeyPrefix = []byte{0x1c}
+	// TokenStatusKeyPrefix defines the key prefix for the lifecycle statuses of the fungible tokens which aren't active.
+	TokenStatusKeyPrefix = []byte{0x1d}
 )
 
 // GetTokenKey constructs the key for the fungible token.
@@ -45,6 +102,11 @@ func CreateFrozenBalancesPrefix(addr []byte) []byte {
 	return store.JoinKeys(FrozenBalancesKeyPrefix, address.MustLengthPrefix(addr))
 }
 
+// GetFrozenRateKey constructs the key for the share of the balance of the denom frozen on the account.
+func GetFrozenRateKey(addr sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(FrozenRateKeyPrefix, address.MustLengthPrefix(addr), []byte(denom))
+}
+
 // CreateGlobalFreezePrefix creates the prefix for fungible token global freeze key.
 func CreateGlobalFreezePrefix(denom string) []byte {
 	return store.JoinKeys(GlobalFreezeKeyPrefix, []byte(denom))
@@ -55,6 +117,186 @@ func CreateWhitelistedBalancesPrefix(addr []byte) []byte {
 	return store.JoinKeys(WhitelistedBalancesKeyPrefix, address.MustLengthPrefix(addr))
 }
 
+// GetBridgeMintRecordKey constructs the key for the record of the transfer minted by the bridge.
+func GetBridgeMintRecordKey(denom, transferID string) []byte {
+	return store.JoinKeys(store.JoinKeysWithLength(BridgeMintRecordKeyPrefix, []byte(denom)), []byte(transferID))
+}
+
+// CreateWhitelistExemptionsPrefix creates the prefix for the accounts exempted from the whitelisted limits of the denom.
+func CreateWhitelistExemptionsPrefix(denom string) []byte {
+	return store.JoinKeysWithLength(WhitelistExemptionKeyPrefix, []byte(denom))
+}
+
+// GetWhitelistExemptionKey constructs the key for the account exempted from the whitelisted limits of the denom.
+func GetWhitelistExemptionKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateWhitelistExemptionsPrefix(denom), addr)
+}
+
+// GetIBCDenomTraceKey constructs the key for the trace of the IBC voucher denom.
+func GetIBCDenomTraceKey(hash []byte) []byte {
+	return store.JoinKeys(IBCDenomTraceKeyPrefix, hash)
+}
+
+// GetReservationKey constructs the key for the reservation.
+func GetReservationKey(id uint64) []byte {
+	return store.JoinKeys(ReservationKeyPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateReservationExpirationQueuePrefix creates the prefix for the reservations expiring at the time.
+func CreateReservationExpirationQueuePrefix(expiration time.Time) []byte {
+	return store.JoinKeys(ReservationExpirationQueueKeyPrefix, sdk.FormatTimeBytes(expiration))
+}
+
+// GetReservationExpirationQueueKey constructs the key for the reservation in the expiration queue.
+func GetReservationExpirationQueueKey(id uint64, expiration time.Time) []byte {
+	return store.JoinKeys(CreateReservationExpirationQueuePrefix(expiration), sdk.Uint64ToBigEndian(id))
+}
+
+// CreatePayeeReservationsPrefix creates the prefix for the reservations of the payee.
+func CreatePayeeReservationsPrefix(payee sdk.AccAddress) []byte {
+	return store.JoinKeys(PayeeReservationKeyPrefix, address.MustLengthPrefix(payee))
+}
+
+// GetPayeeReservationKey constructs the key for the reservation in the index of the payee.
+func GetPayeeReservationKey(payee sdk.AccAddress, id uint64) []byte {
+	return store.JoinKeys(CreatePayeeReservationsPrefix(payee), sdk.Uint64ToBigEndian(id))
+}
+
+// CreateFeatureTokensPrefix creates the prefix for the fungible tokens having the feature enabled.
+func CreateFeatureTokensPrefix(feature TokenFeature) []byte {
+	return store.JoinKeys(FeatureTokenKeyPrefix, sdk.Uint64ToBigEndian(uint64(feature)))
+}
+
+// GetFeatureTokenKey constructs the key for the fungible token in the index of the feature.
+func GetFeatureTokenKey(feature TokenFeature, denom string) []byte {
+	return store.JoinKeys(CreateFeatureTokensPrefix(feature), []byte(denom))
+}
+
+// GetIssueIdempotencyKey constructs the key for the denom of the token issued by the issuer with the idempotency key.
+func GetIssueIdempotencyKey(issuer sdk.AccAddress, key string) []byte {
+	return store.JoinKeys(store.JoinKeysWithLength(IssueIdempotencyKeyPrefix, issuer), []byte(key))
+}
+
+// GetPendingGlobalFreezeKey constructs the key for the global freeze of the denom scheduled to take effect in the future.
+func GetPendingGlobalFreezeKey(denom string) []byte {
+	return store.JoinKeys(PendingGlobalFreezeKeyPrefix, []byte(denom))
+}
+
+// CreatePendingGlobalFreezeQueuePrefix creates the prefix for the global freezes taking effect at the time.
+func CreatePendingGlobalFreezeQueuePrefix(activationTime time.Time) []byte {
+	return store.JoinKeys(PendingGlobalFreezeQueueKeyPrefix, sdk.FormatTimeBytes(activationTime))
+}
+
+// GetPendingGlobalFreezeQueueKey constructs the key for the scheduled global freeze in the activation queue.
+func GetPendingGlobalFreezeQueueKey(denom string, activationTime time.Time) []byte {
+	return store.JoinKeys(CreatePendingGlobalFreezeQueuePrefix(activationTime), []byte(denom))
+}
+
+// GetReserveAttestationsKey constructs the key for the latest reserve attestations of the denom.
+func GetReserveAttestationsKey(denom string) []byte {
+	return store.JoinKeys(ReserveAttestationKeyPrefix, []byte(denom))
+}
+
+// GetTokenAdminKey constructs the key for the admin of the denom.
+func GetTokenAdminKey(denom string) []byte {
+	return store.JoinKeys(TokenAdminKeyPrefix, []byte(denom))
+}
+
+// GetPendingAdminTransferKey constructs the key for the admin transfer of the denom scheduled to take effect in the
+// future.
+func GetPendingAdminTransferKey(denom string) []byte {
+	return store.JoinKeys(PendingAdminTransferKeyPrefix, []byte(denom))
+}
+
+// CreatePendingAdminTransferQueuePrefix creates the prefix for the admin transfers taking effect at the time.
+func CreatePendingAdminTransferQueuePrefix(activationTime time.Time) []byte {
+	return store.JoinKeys(PendingAdminTransferQueueKeyPrefix, sdk.FormatTimeBytes(activationTime))
+}
+
+// GetPendingAdminTransferQueueKey constructs the key for the scheduled admin transfer in the activation queue.
+func GetPendingAdminTransferQueueKey(denom string, activationTime time.Time) []byte {
+	return store.JoinKeys(CreatePendingAdminTransferQueuePrefix(activationTime), []byte(denom))
+}
+
+// CreateTimedFreezesPrefix creates the prefix for the timed freezes of the account.
+func CreateTimedFreezesPrefix(addr sdk.AccAddress) []byte {
+	return store.JoinKeys(TimedFreezeKeyPrefix, address.MustLengthPrefix(addr))
+}
+
+// CreateDenomTimedFreezesPrefix creates the prefix for the timed freezes of the denom on the account.
+func CreateDenomTimedFreezesPrefix(addr sdk.AccAddress, denom string) []byte {
+	return store.JoinKeysWithLength(CreateTimedFreezesPrefix(addr), []byte(denom))
+}
+
+// GetTimedFreezeKey constructs the key for the amount of the denom frozen on the account until the unfreeze time.
+func GetTimedFreezeKey(addr sdk.AccAddress, denom string, unfreezeTime time.Time) []byte {
+	return store.JoinKeys(CreateDenomTimedFreezesPrefix(addr, denom), sdk.FormatTimeBytes(unfreezeTime))
+}
+
+// CreateTimedFreezeQueuePrefix creates the prefix for the timed freezes expiring at the time.
+func CreateTimedFreezeQueuePrefix(unfreezeTime time.Time) []byte {
+	return store.JoinKeys(TimedFreezeQueueKeyPrefix, sdk.FormatTimeBytes(unfreezeTime))
+}
+
+// GetTimedFreezeQueueKey constructs the key for the timed freeze in the expiration queue.
+func GetTimedFreezeQueueKey(addr sdk.AccAddress, denom string, unfreezeTime time.Time) []byte {
+	return store.JoinKeys(
+		CreateTimedFreezeQueuePrefix(unfreezeTime),
+		store.JoinKeysWithLength(address.MustLengthPrefix(addr), []byte(denom)),
+	)
+}
+
+// CreateBurnAllowancesPrefix creates the prefix for the burn allowances granted by the owner.
+func CreateBurnAllowancesPrefix(owner sdk.AccAddress) []byte {
+	return store.JoinKeys(BurnAllowanceKeyPrefix, address.MustLengthPrefix(owner))
+}
+
+// GetBurnAllowanceKey constructs the key for the amount of the denom the spender is allowed to burn from the owner
+// account.
+func GetBurnAllowanceKey(owner, spender sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(CreateBurnAllowancesPrefix(owner), address.MustLengthPrefix(spender), []byte(denom))
+}
+
+// CreateAccountFreezesPrefix creates the prefix for the accounts frozen by the issuer.
+func CreateAccountFreezesPrefix(issuer sdk.AccAddress) []byte {
+	return store.JoinKeys(AccountFreezeKeyPrefix, address.MustLengthPrefix(issuer))
+}
+
+// GetAccountFreezeKey constructs the key for the account which all the fungible tokens of the issuer are frozen on.
+func GetAccountFreezeKey(issuer, account sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateAccountFreezesPrefix(issuer), address.MustLengthPrefix(account))
+}
+
+// GetTokenStatsKey constructs the key for the counters of the fungible token.
+func GetTokenStatsKey(denom string) []byte {
+	return store.JoinKeys(TokenStatsKeyPrefix, []byte(denom))
+}
+
+// CreateBurnRateExemptionsPrefix creates the prefix for the accounts exempted from the burn rate of the denom.
+func CreateBurnRateExemptionsPrefix(denom string) []byte {
+	return store.JoinKeysWithLength(BurnRateExemptionKeyPrefix, []byte(denom))
+}
+
+// GetBurnRateExemptionKey constructs the key for the account exempted from the burn rate of the denom.
+func GetBurnRateExemptionKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateBurnRateExemptionsPrefix(denom), addr)
+}
+
+// CreateTokenAttributesPrefix creates the prefix for the attributes attached to the denom.
+func CreateTokenAttributesPrefix(denom string) []byte {
+	return store.JoinKeysWithLength(TokenAttributeKeyPrefix, []byte(denom))
+}
+
+// GetTokenAttributeKey constructs the key for the attribute of the denom.
+func GetTokenAttributeKey(denom, key string) []byte {
+	return store.JoinKeys(CreateTokenAttributesPrefix(denom), []byte(key))
+}
+
+// GetTokenStatusKey constructs the key for the lifecycle status of the denom.
+func GetTokenStatusKey(denom string) []byte {
+	return store.JoinKeys(TokenStatusKeyPrefix, []byte(denom))
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
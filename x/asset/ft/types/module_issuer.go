@@ -0,0 +1,18 @@
+package types
+
+// The permissions granted to the module issuing the fungible tokens from its account. They are granted at the app
+// wiring, when the module receives its scoped issuer, because the modules can't sign the messages.
+const (
+	// IssuerPermissionIssue allows the module to issue the tokens.
+	IssuerPermissionIssue = "issue"
+	// IssuerPermissionMint allows the module to mint the tokens it issued.
+	IssuerPermissionMint = "mint"
+	// IssuerPermissionBurn allows the module to burn the tokens it holds.
+	IssuerPermissionBurn = "burn"
+	// IssuerPermissionFreeze allows the module to freeze the tokens it issued on the accounts.
+	IssuerPermissionFreeze = "freeze"
+	// IssuerPermissionGlobalFreeze allows the module to freeze the tokens it issued on all the accounts.
+	IssuerPermissionGlobalFreeze = "global_freeze"
+	// IssuerPermissionWhitelist allows the module to set the whitelisted limits of the tokens it issued.
+	IssuerPermissionWhitelist = "whitelist"
+)
@@ -9,15 +9,41 @@ var (
 	_ sdk.Msg = &MsgIssue{}
 	_ sdk.Msg = &MsgFreeze{}
 	_ sdk.Msg = &MsgUnfreeze{}
+	_ sdk.Msg = &MsgFreezeUntil{}
+	_ sdk.Msg = &MsgFreezeAccount{}
+	_ sdk.Msg = &MsgUnfreezeAccount{}
+	_ sdk.Msg = &MsgBatchFreeze{}
+	_ sdk.Msg = &MsgBatchUnfreeze{}
+	_ sdk.Msg = &MsgSetFrozenRate{}
 	_ sdk.Msg = &MsgMint{}
 	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgGrantBurnAllowance{}
+	_ sdk.Msg = &MsgBurnFrom{}
 	_ sdk.Msg = &MsgSetWhitelistedLimit{}
+	_ sdk.Msg = &MsgSetWhitelistExemption{}
+	_ sdk.Msg = &MsgBatchSetWhitelistedLimit{}
+	_ sdk.Msg = &MsgSetBurnRateExemption{}
+	_ sdk.Msg = &MsgSetTokenStatus{}
+	_ sdk.Msg = &MsgUpdateTokenMetadata{}
+	_ sdk.Msg = &MsgSetTokenAttribute{}
+	_ sdk.Msg = &MsgWrap{}
+	_ sdk.Msg = &MsgUnwrap{}
+	_ sdk.Msg = &MsgBridgeMint{}
+	_ sdk.Msg = &MsgBridgeBurn{}
+	_ sdk.Msg = &MsgRegisterIBCDenom{}
+	_ sdk.Msg = &MsgReserve{}
+	_ sdk.Msg = &MsgRelease{}
+	_ sdk.Msg = &MsgCapture{}
+	_ sdk.Msg = &MsgPublishReserveAttestation{}
+	_ sdk.Msg = &MsgTransferAdmin{}
+	_ sdk.Msg = &MsgClearAdmin{}
 )
 
+// MaxBatchEntries is the maximum number of the entries processed by the single batch message.
+const MaxBatchEntries = 500
+
 // ValidateBasic validates the message.
 func (msg MsgIssue) ValidateBasic() error {
-	const maxDescriptionLength = 200
-
 	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer %s", msg.Issuer)
 	}
@@ -30,20 +56,43 @@ func (msg MsgIssue) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidatePrecision(msg.Precision); err != nil {
+		return err
+	}
+
+	if err := ValidateFeatures(msg.Features); err != nil {
+		return err
+	}
+
 	if err := ValidateBurnRate(msg.BurnRate); err != nil {
 		return err
 	}
 
+	if err := ValidateSendCommissionRate(msg.SendCommissionRate); err != nil {
+		return err
+	}
+
+	if err := ValidateIdempotencyKey(msg.IdempotencyKey); err != nil {
+		return err
+	}
+
+	if err := ValidateURI(msg.URI, msg.URIHash); err != nil {
+		return err
+	}
+
 	// we allow zero initial amount, in that case we won't mint it initially
 	if msg.InitialAmount.IsNil() || msg.InitialAmount.IsNegative() {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid initial amount %s, can't be negative", msg.InitialAmount.String())
 	}
 
-	if len(msg.Description) > maxDescriptionLength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", msg.Description, maxDescriptionLength)
+	if msg.VestingSchedule != nil {
+		if err := ValidateVestingSchedule(*msg.VestingSchedule, msg.InitialAmount); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	// the limits set by the params are checked by the keeper
+	return ValidateDescription(msg.Description, MaxDescriptionLength)
 }
 
 // GetSigners returns the message signers.
@@ -101,6 +150,106 @@ func (msg MsgUnfreeze) GetSigners() []sdk.AccAddress {
 	}
 }
 
+// ValidateBasic checks that message fields are valid
+func (msg MsgFreezeUntil) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if msg.UnfreezeTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "unfreeze time must be set")
+	}
+
+	return validatePositiveCoin(msg.Coin)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgFreezeUntil) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	return validateAccountFreeze(msg.Sender, msg.Account)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	return validateAccountFreeze(msg.Sender, msg.Account)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgBatchFreeze) ValidateBasic() error {
+	return validateBatchEntries(msg.Sender, msg.Entries)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgBatchFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgBatchUnfreeze) ValidateBasic() error {
+	return validateBatchEntries(msg.Sender, msg.Entries)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgBatchUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgSetFrozenRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateFrozenRate(msg.Rate)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgSetFrozenRate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
 // ValidateBasic checks that message fields are valid
 func (msg MsgMint) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
@@ -141,6 +290,59 @@ func (msg MsgBurn) GetSigners() []sdk.AccAddress {
 	}
 }
 
+// ValidateBasic checks that message fields are valid
+func (msg MsgGrantBurnAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Spender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid spender address")
+	}
+
+	if msg.Sender == msg.Spender {
+		return sdkerrors.Wrap(ErrInvalidInput, "burn allowance can't be granted to the sender")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	// the zero amount revokes the allowance
+	return msg.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgGrantBurnAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgBurnFrom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	return validatePositiveCoin(msg.Coin)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgBurnFrom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
 // ValidateBasic checks that message fields are valid
 func (msg MsgGloballyFreeze) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
@@ -151,6 +353,10 @@ func (msg MsgGloballyFreeze) ValidateBasic() error {
 		return err
 	}
 
+	if msg.ActivationTime != nil && msg.ActivationTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "activation time must not be zero")
+	}
+
 	return nil
 }
 
@@ -181,6 +387,26 @@ func (msg MsgGloballyUnfreeze) GetSigners() []sdk.AccAddress {
 	}
 }
 
+// ValidateBasic checks that message fields are valid
+func (msg MsgSetTokenStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateTokenStatus(msg.Status)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgSetTokenStatus) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
 // ValidateBasic checks that message fields are valid
 func (msg MsgSetWhitelistedLimit) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
@@ -204,3 +430,418 @@ func (msg MsgSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
 		sdk.MustAccAddressFromBech32(msg.Sender),
 	}
 }
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgBatchSetWhitelistedLimit) ValidateBasic() error {
+	return validateBatchEntries(msg.Sender, msg.Entries)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgBatchSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgSetWhitelistExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgSetWhitelistExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgSetBurnRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgSetBurnRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgUpdateTokenMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateURI(msg.URI, msg.URIHash); err != nil {
+		return err
+	}
+
+	// the limits set by the params are checked by the keeper
+	return ValidateDescription(msg.Description, MaxDescriptionLength)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgUpdateTokenMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgSetTokenAttribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenAttributeKey(msg.Key); err != nil {
+		return err
+	}
+
+	// the limits set by the params are checked by the keeper
+	return ValidateTokenAttributeValue(msg.Value, MaxTokenAttributeValueLength)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgSetTokenAttribute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgWrap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return validatePositiveCoin(msg.Coin)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgWrap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgUnwrap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	return validatePositiveCoin(msg.Coin)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgUnwrap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+func validatePositiveCoin(coin sdk.Coin) error {
+	if err := coin.Validate(); err != nil {
+		return err
+	}
+	if !coin.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "amount must be positive, got %s", coin.String())
+	}
+	return nil
+}
+
+func validateAccountFreeze(sender, account string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+	if sender == account {
+		return sdkerrors.Wrap(ErrInvalidInput, "issuer can't freeze its own account")
+	}
+	return nil
+}
+
+// validateBatchEntries checks the entries of the batch message. The same coin denom can't be applied to the account
+// twice in one batch, so the result doesn't depend on the order of the entries.
+func validateBatchEntries(sender string, entries []AccountCoin) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	if len(entries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "batch must contain at least one entry")
+	}
+	if len(entries) > MaxBatchEntries {
+		return sdkerrors.Wrapf(ErrInvalidInput, "batch must not contain more than %d entries", MaxBatchEntries)
+	}
+
+	seen := make(map[string]struct{}, len(entries))
+	for i, entry := range entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Account); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account address in entry %d", i)
+		}
+		if _, _, err := DeconstructDenom(entry.Coin.Denom); err != nil {
+			return sdkerrors.Wrapf(err, "invalid denom in entry %d", i)
+		}
+		if err := entry.Coin.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin in entry %d: %s", i, err)
+		}
+
+		key := entry.Account + "/" + entry.Coin.Denom
+		if _, exists := seen[key]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated entry %d for account %s and denom %s", i, entry.Account, entry.Coin.Denom,
+			)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgBridgeMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid recipient address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateTransferID(msg.TransferID); err != nil {
+		return err
+	}
+
+	if err := ValidateBridgeAttestations(msg.Attestations); err != nil {
+		return err
+	}
+
+	return msg.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgBridgeMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgBridgeBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateDestination(msg.Destination); err != nil {
+		return err
+	}
+
+	return msg.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgBridgeBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgRegisterIBCDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return msg.Trace.Validate()
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgRegisterIBCDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgReserve) ValidateBasic() error {
+	payer, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid payer address")
+	}
+
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid payee address")
+	}
+	if payer.Equals(payee) {
+		return sdkerrors.Wrap(ErrInvalidInput, "payer and payee must be different")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Amount.Denom); err != nil {
+		return err
+	}
+
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration must be set")
+	}
+
+	return validatePositiveCoin(msg.Amount)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgReserve) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Payer),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgRelease) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgRelease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgCapture) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Amount.Denom); err != nil {
+		return err
+	}
+
+	return validatePositiveCoin(msg.Amount)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgCapture) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgPublishReserveAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return ValidateReserveAttestation(msg.Amount, msg.AuditorSignatureHash, msg.URI)
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgPublishReserveAttestation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgTransferAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if msg.GracePeriod < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "grace period must not be negative")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgTransferAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid
+func (msg MsgClearAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type
+func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
@@ -1,9 +1,12 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
@@ -71,6 +74,110 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 	msg = msgF()
 	msg.Subunit = ""
 	requireT.Error(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.SendCommissionRate = sdk.MustNewDecFromStr("0.25")
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.SendCommissionRate = sdk.MustNewDecFromStr("1.01")
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.IdempotencyKey = strings.Repeat("k", types.MaxIdempotencyKeyLength)
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.IdempotencyKey = strings.Repeat("k", types.MaxIdempotencyKeyLength+1)
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.Precision = 0
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.Precision = types.MaxPrecision
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.Precision = types.MaxPrecision + 1
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.Features = []types.TokenFeature{
+		types.TokenFeature_freeze,    //nolint:nosnakecase
+		types.TokenFeature_mint,      //nolint:nosnakecase
+		types.TokenFeature_burn,      //nolint:nosnakecase
+		types.TokenFeature_whitelist, //nolint:nosnakecase
+	}
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.Features = []types.TokenFeature{
+		types.TokenFeature_mint, //nolint:nosnakecase
+		types.TokenFeature_mint, //nolint:nosnakecase
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.Features = []types.TokenFeature{100}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.Features = []types.TokenFeature{
+		types.TokenFeature_freeze,    //nolint:nosnakecase
+		types.TokenFeature_mint,      //nolint:nosnakecase
+		types.TokenFeature_burn,      //nolint:nosnakecase
+		types.TokenFeature_whitelist, //nolint:nosnakecase
+		types.TokenFeature_freeze,    //nolint:nosnakecase
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{
+		Periods: []types.VestingPeriod{
+			{Length: 0, Amount: sdk.NewInt(77)},
+			{Length: time.Hour, Amount: sdk.NewInt(700)},
+		},
+	}
+	requireT.NoError(msg.ValidateBasic())
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{
+		Periods: []types.VestingPeriod{{Length: time.Hour, Amount: sdk.NewInt(776)}},
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{
+		Periods: []types.VestingPeriod{
+			{Length: time.Hour, Amount: sdk.NewInt(777)},
+			{Length: time.Hour, Amount: sdk.NewInt(0)},
+		},
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{
+		Periods: []types.VestingPeriod{{Length: time.Millisecond, Amount: sdk.NewInt(777)}},
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{
+		Periods: []types.VestingPeriod{{Length: -time.Hour, Amount: sdk.NewInt(777)}},
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
+
+	msg = msgF()
+	msg.VestingSchedule = &types.VestingSchedule{
+		Periods: make([]types.VestingPeriod, types.MaxVestingPeriods+1),
+	}
+	requireT.ErrorIs(msg.ValidateBasic(), types.ErrInvalidInput)
 }
 
 func TestMsgFreeze_ValidateBasic(t *testing.T) {
@@ -205,6 +312,186 @@ func TestMsgUnfreeze_ValidateBasic(t *testing.T) {
 }
 
 //nolint:dupl // tests and mint tests are identical, but merging them is not beneficial
+func TestMsgSetFrozenRate_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetFrozenRate
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Rate:    sdk.MustNewDecFromStr("0.25"),
+			},
+		},
+		{
+			name: "valid zero rate",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Rate:    sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Rate:    sdk.MustNewDecFromStr("0.25"),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Rate:    sdk.MustNewDecFromStr("0.25"),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+				Rate:    sdk.MustNewDecFromStr("0.25"),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "rate out of range",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Rate:    sdk.MustNewDecFromStr("1.01"),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "rate precision too high",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Rate:    sdk.MustNewDecFromStr("0.00001"),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "missing rate",
+			message: types.MsgSetFrozenRate{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgFreezeUntil_ValidateBasic(t *testing.T) {
+	unfreezeTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		message       types.MsgFreezeUntil
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgFreezeUntil{
+				Sender:       "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:      "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:         sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				UnfreezeTime: unfreezeTime,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgFreezeUntil{
+				Sender:       "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account:      "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:         sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				UnfreezeTime: unfreezeTime,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgFreezeUntil{
+				Sender:       "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:      "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin:         sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				UnfreezeTime: unfreezeTime,
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgFreezeUntil{
+				Sender:       "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:      "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:         sdk.NewInt64Coin("abc", 100),
+				UnfreezeTime: unfreezeTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgFreezeUntil{
+				Sender:       "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:      "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:         sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 0),
+				UnfreezeTime: unfreezeTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "missing unfreeze time",
+			message: types.MsgFreezeUntil{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:    sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 func TestMsgMint_ValidateBasic(t *testing.T) {
 	type M = types.MsgMint
 
@@ -299,74 +586,1276 @@ func TestMsgBurn_ValidateBasic(t *testing.T) {
 }
 
 //nolint:dupl // test cases are identical between freeze and unfreeze, but reuse is not beneficial for tests
-func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
+func TestMsgGrantBurnAllowance_ValidateBasic(t *testing.T) {
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	spender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := types.BuildDenom("abc", acc)
+
 	testCases := []struct {
-		name                string
-		message             types.MsgSetWhitelistedLimit
-		expectedError       error
-		expectedErrorString string
+		name          string
+		message       types.MsgGrantBurnAllowance
+		expectedError error
 	}{
 		{
 			name: "valid msg",
-			message: types.MsgSetWhitelistedLimit{
-				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
-				Coin: sdk.Coin{
-					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-					Amount: sdk.NewInt(100),
-				},
+			message: types.MsgGrantBurnAllowance{
+				Sender:  acc.String(),
+				Spender: spender.String(),
+				Coin:    sdk.NewInt64Coin(denom, 100),
 			},
 		},
 		{
-			name: "invalid sender address",
-			message: types.MsgSetWhitelistedLimit{
-				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
-				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
-				Coin: sdk.Coin{
-					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-					Amount: sdk.NewInt(100),
-				},
+			name: "revoke",
+			message: types.MsgGrantBurnAllowance{
+				Sender:  acc.String(),
+				Spender: spender.String(),
+				Coin:    sdk.NewInt64Coin(denom, 0),
+			},
+		},
+		{
+			name: "invalid spender",
+			message: types.MsgGrantBurnAllowance{
+				Sender:  acc.String(),
+				Spender: "invalid",
+				Coin:    sdk.NewInt64Coin(denom, 100),
 			},
 			expectedError: sdkerrors.ErrInvalidAddress,
 		},
+		{
+			name: "sender as spender",
+			message: types.MsgGrantBurnAllowance{
+				Sender:  acc.String(),
+				Spender: acc.String(),
+				Coin:    sdk.NewInt64Coin(denom, 100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgGrantBurnAllowance{
+				Sender:  acc.String(),
+				Spender: spender.String(),
+				Coin:    sdk.NewInt64Coin("abc", 100),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgBurnFrom_ValidateBasic(t *testing.T) {
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := types.BuildDenom("abc", acc)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgBurnFrom
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgBurnFrom{
+				Sender:  acc.String(),
+				Account: account.String(),
+				Coin:    sdk.NewInt64Coin(denom, 100),
+			},
+		},
 		{
 			name: "invalid account",
-			message: types.MsgSetWhitelistedLimit{
-				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
-				Coin: sdk.Coin{
-					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-					Amount: sdk.NewInt(100),
-				},
+			message: types.MsgBurnFrom{
+				Sender:  acc.String(),
+				Account: "invalid",
+				Coin:    sdk.NewInt64Coin(denom, 100),
 			},
 			expectedError: sdkerrors.ErrInvalidAddress,
 		},
 		{
-			name: "invalid denom",
-			message: types.MsgSetWhitelistedLimit{
-				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
-				Coin: sdk.Coin{
-					Denom:  "0abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-					Amount: sdk.NewInt(100),
-				},
+			name: "zero amount",
+			message: types.MsgBurnFrom{
+				Sender:  acc.String(),
+				Account: account.String(),
+				Coin:    sdk.NewInt64Coin(denom, 0),
 			},
-			expectedErrorString: "invalid denom",
+			expectedError: types.ErrInvalidInput,
 		},
 	}
 
-	for _, testCase := range testCases {
-		tc := testCase
+	for _, tc := range testCases {
+		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			assertT := assert.New(t)
 			err := tc.message.ValidateBasic()
-			switch {
-			case tc.expectedError == nil && tc.expectedErrorString == "":
-				assertT.NoError(err)
-			case tc.expectedErrorString != "":
-				assertT.Contains(err.Error(), tc.expectedErrorString)
-			default:
-				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgFreezeAccount_ValidateBasic(t *testing.T) {
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name          string
+		message       types.MsgFreezeAccount
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgFreezeAccount{
+				Sender:  issuer.String(),
+				Account: account.String(),
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgFreezeAccount{
+				Sender:  "invalid",
+				Account: account.String(),
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgFreezeAccount{
+				Sender:  issuer.String(),
+				Account: "invalid",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "sender account",
+			message: types.MsgFreezeAccount{
+				Sender:  issuer.String(),
+				Account: issuer.String(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgBatchFreeze_ValidateBasic(t *testing.T) {
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := types.BuildDenom("abc", issuer)
+	coin := sdk.NewInt64Coin(denom, 100)
+
+	tooManyEntries := make([]types.AccountCoin, 0, types.MaxBatchEntries+1)
+	for i := 0; i <= types.MaxBatchEntries; i++ {
+		tooManyEntries = append(tooManyEntries, types.AccountCoin{
+			Account: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Coin:    coin,
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		message       types.MsgBatchFreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgBatchFreeze{
+				Sender: issuer.String(),
+				Entries: []types.AccountCoin{
+					{Account: account1.String(), Coin: coin},
+					{Account: account2.String(), Coin: coin},
+				},
+			},
+		},
+		{
+			name: "invalid sender",
+			message: types.MsgBatchFreeze{
+				Sender:  "invalid",
+				Entries: []types.AccountCoin{{Account: account1.String(), Coin: coin}},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no entries",
+			message: types.MsgBatchFreeze{
+				Sender: issuer.String(),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too many entries",
+			message: types.MsgBatchFreeze{
+				Sender:  issuer.String(),
+				Entries: tooManyEntries,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgBatchFreeze{
+				Sender: issuer.String(),
+				Entries: []types.AccountCoin{
+					{Account: account1.String(), Coin: coin},
+					{Account: "invalid", Coin: coin},
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgBatchFreeze{
+				Sender:  issuer.String(),
+				Entries: []types.AccountCoin{{Account: account1.String(), Coin: sdk.NewInt64Coin("abc", 100)}},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid coin",
+			message: types.MsgBatchFreeze{
+				Sender: issuer.String(),
+				Entries: []types.AccountCoin{
+					{Account: account1.String(), Coin: sdk.Coin{Denom: denom, Amount: sdk.NewInt(-1)}},
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "duplicated entry",
+			message: types.MsgBatchFreeze{
+				Sender: issuer.String(),
+				Entries: []types.AccountCoin{
+					{Account: account1.String(), Coin: coin},
+					{Account: account1.String(), Coin: coin},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name                string
+		message             types.MsgSetWhitelistedLimit
+		expectedError       error
+		expectedErrorString string
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetWhitelistedLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetWhitelistedLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetWhitelistedLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetWhitelistedLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "0abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdk.NewInt(100),
+				},
+			},
+			expectedErrorString: "invalid denom",
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			switch {
+			case tc.expectedError == nil && tc.expectedErrorString == "":
+				assertT.NoError(err)
+			case tc.expectedErrorString != "":
+				assertT.Contains(err.Error(), tc.expectedErrorString)
+			default:
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetWhitelistExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetWhitelistExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetWhitelistExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Exempt:  true,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetWhitelistExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetWhitelistExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetWhitelistExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetBurnRateExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetBurnRateExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetBurnRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Exempt:  true,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetBurnRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetBurnRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetBurnRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetTokenStatus_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetTokenStatus
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetTokenStatus{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Status: types.TokenStatus_retired, //nolint:nosnakecase
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetTokenStatus{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetTokenStatus{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "unknown status",
+			message: types.MsgSetTokenStatus{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Status: 10,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateTokenMetadata_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgUpdateTokenMetadata
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgUpdateTokenMetadata{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:       "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Description: "updated description",
+				URI:         "https://my-token-meta.invalid/1",
+				URIHash:     "content-hash",
+			},
+		},
+		{
+			name: "valid msg clearing the metadata",
+			message: types.MsgUpdateTokenMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgUpdateTokenMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpdateTokenMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too long description",
+			message: types.MsgUpdateTokenMetadata{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:       "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Description: strings.Repeat("a", types.MaxDescriptionLength+1),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too long URI",
+			message: types.MsgUpdateTokenMetadata{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				URI:    strings.Repeat("a", types.MaxURILength+1),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too long URI hash",
+			message: types.MsgUpdateTokenMetadata{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				URIHash: strings.Repeat("a", types.MaxURIHashLength+1),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetTokenAttribute_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetTokenAttribute
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Key:    "ISIN",
+				Value:  "CH0012345678",
+			},
+		},
+		{
+			name: "valid msg removing the attribute",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Key:    "legal/jurisdiction",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Key:    "ISIN",
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+				Key:    "ISIN",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty key",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Value:  "CH",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid key",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Key:    "juris diction",
+				Value:  "CH",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too long key",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Key:    strings.Repeat("a", 65),
+				Value:  "CH",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too long value",
+			message: types.MsgSetTokenAttribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Key:    "ISIN",
+				Value:  strings.Repeat("a", types.MaxTokenAttributeValueLength+1),
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgWrap_ValidateBasic(t *testing.T) {
+	type M = types.MsgWrap
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Coin:   sdk.NewCoin("udevcore", sdk.NewInt(100)),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid coin",
+			modifyMsg:   func(m M) M { m.Coin = sdk.Coin{}; return m },
+			expectError: true,
+		},
+		{
+			name:        "zero amount",
+			modifyMsg:   func(m M) M { m.Coin.Amount = sdk.ZeroInt(); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgUnwrap_ValidateBasic(t *testing.T) {
+	type M = types.MsgUnwrap
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Coin:   sdk.NewCoin("wudevcore"+"-"+acc.String(), sdk.NewInt(100)),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "native denom",
+			modifyMsg:   func(m M) M { m.Coin.Denom = "udevcore"; return m },
+			expectError: true,
+		},
+		{
+			name:        "zero amount",
+			modifyMsg:   func(m M) M { m.Coin.Amount = sdk.ZeroInt(); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgBridgeMint_ValidateBasic(t *testing.T) {
+	type M = types.MsgBridgeMint
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	attesterKey := secp256k1.GenPrivKey()
+	attestation := types.BridgeAttestation{
+		Attester:  sdk.AccAddress(attesterKey.PubKey().Address()).String(),
+		PubKey:    attesterKey.PubKey().Bytes(),
+		Signature: []byte("signature"),
+	}
+	defaultMsg := func() M {
+		return M{
+			Sender:       acc.String(),
+			Recipient:    acc.String(),
+			Coin:         sdk.NewCoin("ABC"+"-"+acc.String(), sdk.NewInt(100)),
+			TransferID:   "0x1f2e3d",
+			Attestations: []types.BridgeAttestation{attestation},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid recipient address",
+			modifyMsg:   func(m M) M { m.Recipient = "invalid recipient"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid coin",
+			modifyMsg:   func(m M) M { m.Coin = sdk.Coin{}; return m },
+			expectError: true,
+		},
+		{
+			name:        "empty transfer ID",
+			modifyMsg:   func(m M) M { m.TransferID = ""; return m },
+			expectError: true,
+		},
+		{
+			name: "duplicated attestation",
+			modifyMsg: func(m M) M {
+				m.Attestations = []types.BridgeAttestation{attestation, attestation}
+				return m
+			},
+			expectError: true,
+		},
+		{
+			name: "public key of other attester",
+			modifyMsg: func(m M) M {
+				m.Attestations = []types.BridgeAttestation{{
+					Attester:  acc.String(),
+					PubKey:    attestation.PubKey,
+					Signature: attestation.Signature,
+				}}
+				return m
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgBridgeBurn_ValidateBasic(t *testing.T) {
+	type M = types.MsgBridgeBurn
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:      acc.String(),
+			Coin:        sdk.NewCoin("ABC"+"-"+acc.String(), sdk.NewInt(100)),
+			Destination: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq",
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid coin",
+			modifyMsg:   func(m M) M { m.Coin = sdk.Coin{}; return m },
+			expectError: true,
+		},
+		{
+			name:        "empty destination",
+			modifyMsg:   func(m M) M { m.Destination = ""; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRegisterIBCDenom_ValidateBasic(t *testing.T) {
+	type M = types.MsgRegisterIBCDenom
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Trace: types.IBCDenomTrace{
+				Path:      "transfer/channel-0/transfer/channel-12",
+				BaseDenom: "ABC" + "-" + acc.String(),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "empty path",
+			modifyMsg:   func(m M) M { m.Trace.Path = ""; return m },
+			expectError: true,
+		},
+		{
+			name:        "path without channel",
+			modifyMsg:   func(m M) M { m.Trace.Path = "transfer"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid identifier in path",
+			modifyMsg:   func(m M) M { m.Trace.Path = "transfer/channel 0"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid base denom",
+			modifyMsg:   func(m M) M { m.Trace.BaseDenom = "1abc"; return m },
+			expectError: true,
+		},
+		{
+			name: "IBC base denom",
+			modifyMsg: func(m M) M {
+				m.Trace.BaseDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+				return m
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgReserve_ValidateBasic(t *testing.T) {
+	type M = types.MsgReserve
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	payee := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Payer:      acc.String(),
+			Payee:      payee.String(),
+			Amount:     sdk.NewCoin("ABC"+"-"+acc.String(), sdk.NewInt(100)),
+			Expiration: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid payer address",
+			modifyMsg:   func(m M) M { m.Payer = "invalid payer"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid payee address",
+			modifyMsg:   func(m M) M { m.Payee = "invalid payee"; return m },
+			expectError: true,
+		},
+		{
+			name:        "payee equal to payer",
+			modifyMsg:   func(m M) M { m.Payee = m.Payer; return m },
+			expectError: true,
+		},
+		{
+			name:        "native denom",
+			modifyMsg:   func(m M) M { m.Amount = sdk.NewCoin("ucore", sdk.NewInt(100)); return m },
+			expectError: true,
+		},
+		{
+			name:        "zero amount",
+			modifyMsg:   func(m M) M { m.Amount.Amount = sdk.ZeroInt(); return m },
+			expectError: true,
+		},
+		{
+			name:        "missing expiration",
+			modifyMsg:   func(m M) M { m.Expiration = time.Time{}; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgCapture_ValidateBasic(t *testing.T) {
+	type M = types.MsgCapture
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			ID:     1,
+			Amount: sdk.NewCoin("ABC"+"-"+acc.String(), sdk.NewInt(100)),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid coin",
+			modifyMsg:   func(m M) M { m.Amount = sdk.Coin{}; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgPublishReserveAttestation_ValidateBasic(t *testing.T) {
+	type M = types.MsgPublishReserveAttestation
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:               acc.String(),
+			Denom:                "abc" + "-" + acc.String(),
+			Amount:               sdk.NewInt(100),
+			AuditorSignatureHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			URI:                  "https://example.com/attestation.pdf",
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:      "zero amount",
+			modifyMsg: func(m M) M { m.Amount = sdk.ZeroInt(); return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+		{
+			name:        "missing amount",
+			modifyMsg:   func(m M) M { m.Amount = sdk.Int{}; return m },
+			expectError: true,
+		},
+		{
+			name:        "negative amount",
+			modifyMsg:   func(m M) M { m.Amount = sdk.NewInt(-1); return m },
+			expectError: true,
+		},
+		{
+			name:        "missing auditor signature hash",
+			modifyMsg:   func(m M) M { m.AuditorSignatureHash = ""; return m },
+			expectError: true,
+		},
+		{
+			name:        "auditor signature hash not hex-encoded",
+			modifyMsg:   func(m M) M { m.AuditorSignatureHash = "not-hex"; return m },
+			expectError: true,
+		},
+		{
+			name:        "missing uri",
+			modifyMsg:   func(m M) M { m.URI = ""; return m },
+			expectError: true,
+		},
+		{
+			name:        "uri too long",
+			modifyMsg:   func(m M) M { m.URI = strings.Repeat("a", 257); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgTransferAdmin_ValidateBasic(t *testing.T) {
+	type M = types.MsgTransferAdmin
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	defaultMsg := func() M {
+		return M{
+			Sender:  acc.String(),
+			Denom:   "abc" + "-" + acc.String(),
+			Account: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:      "with grace period",
+			modifyMsg: func(m M) M { m.GracePeriod = time.Hour; return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Denom = "abc"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "negative grace period",
+			modifyMsg:   func(m M) M { m.GracePeriod = -time.Hour; return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
 			}
 		})
 	}
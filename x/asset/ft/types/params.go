@@ -0,0 +1,124 @@
+package types
+
+import (
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+// Parameter keys
+var (
+	KeyMaxSymbolLength              = []byte("MaxSymbolLength")
+	KeyMaxDescriptionLength         = []byte("MaxDescriptionLength")
+	KeyMaxTokenAttributes           = []byte("MaxTokenAttributes")
+	KeyMaxTokenAttributeValueLength = []byte("MaxTokenAttributeValueLength")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		MaxSymbolLength:              MaxSymbolLength,
+		MaxDescriptionLength:         MaxDescriptionLength,
+		MaxTokenAttributes:           DefaultMaxTokenAttributes,
+		MaxTokenAttributeValueLength: MaxTokenAttributeValueLength,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of the asset ft parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxSymbolLength, &m.MaxSymbolLength, validateMaxSymbolLength),
+		paramtypes.NewParamSetPair(KeyMaxDescriptionLength, &m.MaxDescriptionLength, validateMaxDescriptionLength),
+		paramtypes.NewParamSetPair(KeyMaxTokenAttributes, &m.MaxTokenAttributes, validateMaxTokenAttributes),
+		paramtypes.NewParamSetPair(
+			KeyMaxTokenAttributeValueLength, &m.MaxTokenAttributeValueLength, validateMaxTokenAttributeValueLength,
+		),
+	}
+}
+
+// ValidateBasic validates the asset ft parameters.
+func (m Params) ValidateBasic() error {
+	if err := validateMaxSymbolLength(m.MaxSymbolLength); err != nil {
+		return errors.Wrap(err, "invalid max symbol length")
+	}
+	if err := validateMaxDescriptionLength(m.MaxDescriptionLength); err != nil {
+		return errors.Wrap(err, "invalid max description length")
+	}
+	if err := validateMaxTokenAttributes(m.MaxTokenAttributes); err != nil {
+		return errors.Wrap(err, "invalid max token attributes")
+	}
+	if err := validateMaxTokenAttributeValueLength(m.MaxTokenAttributeValueLength); err != nil {
+		return errors.Wrap(err, "invalid max token attribute value length")
+	}
+	return nil
+}
+
+// validateMaxSymbolLength validates the max symbol length. The symbol can't be empty, so the length must be positive,
+// and it can't exceed the limit checked by the messages.
+func validateMaxSymbolLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("max symbol length must be positive")
+	}
+	if v > MaxSymbolLength {
+		return errors.Errorf("max symbol length must not exceed %d, got %d", MaxSymbolLength, v)
+	}
+
+	return nil
+}
+
+// validateMaxDescriptionLength validates the max description length. The length can't exceed the limit checked by
+// the messages, because the longer description would be rejected before reaching the keeper anyway.
+func validateMaxDescriptionLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxDescriptionLength {
+		return errors.Errorf("max description length must not exceed %d, got %d", MaxDescriptionLength, v)
+	}
+
+	return nil
+}
+
+// validateMaxTokenAttributes validates the max number of the token attributes. The zero value disables the attributes.
+// The number can't exceed the upper bound keeping the iteration counting the attributes cheap.
+func validateMaxTokenAttributes(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTokenAttributes {
+		return errors.Errorf("max token attributes must not exceed %d, got %d", MaxTokenAttributes, v)
+	}
+
+	return nil
+}
+
+// validateMaxTokenAttributeValueLength validates the max length of the token attribute value. The length can't exceed
+// the limit checked by the messages.
+func validateMaxTokenAttributeValueLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTokenAttributeValueLength {
+		return errors.Errorf(
+			"max token attribute value length must not exceed %d, got %d", MaxTokenAttributeValueLength, v,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params store gov manageable asset ft parameters.
+type Params struct {
+	// max_symbol_length is the maximum length of the symbol of the fungible token.
+	MaxSymbolLength uint32 `protobuf:"varint,1,opt,name=max_symbol_length,json=maxSymbolLength,proto3" json:"max_symbol_length,omitempty" yaml:"max_symbol_length"`
+	// max_description_length is the maximum length of the description of the fungible token.
+	MaxDescriptionLength uint32 `protobuf:"varint,2,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty" yaml:"max_description_length"`
+	// max_token_attributes is the maximum number of the attributes attached to the fungible token.
+	MaxTokenAttributes uint32 `protobuf:"varint,3,opt,name=max_token_attributes,json=maxTokenAttributes,proto3" json:"max_token_attributes,omitempty" yaml:"max_token_attributes"`
+	// max_token_attribute_value_length is the maximum length of the value of the attribute of the fungible token.
+	MaxTokenAttributeValueLength uint32 `protobuf:"varint,4,opt,name=max_token_attribute_value_length,json=maxTokenAttributeValueLength,proto3" json:"max_token_attribute_value_length,omitempty" yaml:"max_token_attribute_value_length"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b08ee2013666b045, []int{0}
+}
+
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxSymbolLength() uint32 {
+	if m != nil {
+		return m.MaxSymbolLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDescriptionLength() uint32 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTokenAttributes() uint32 {
+	if m != nil {
+		return m.MaxTokenAttributes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTokenAttributeValueLength() uint32 {
+	if m != nil {
+		return m.MaxTokenAttributeValueLength
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "coreum.asset.ft.v1.Params")
+}
+
+func init() { proto.RegisterFile("coreum/asset/ft/v1/params.proto", fileDescriptor_b08ee2013666b045) }
+
+var fileDescriptor_b08ee2013666b045 = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0xd7, 0x29, 0x3b, 0x14, 0x44, 0x2c, 0x43, 0x86, 0xce, 0x76, 0xe6, 0xa2, 0x20, 0x34,
+	0x8c, 0xdd, 0xbc, 0x39, 0x45, 0x3c, 0x28, 0x68, 0x15, 0x05, 0x2f, 0x25, 0xdd, 0xb2, 0xae, 0xd8,
+	0x34, 0xa5, 0xf9, 0x3a, 0xba, 0xb7, 0xf0, 0xb1, 0x3c, 0xee, 0xe8, 0xa9, 0xc8, 0xf6, 0x06, 0xf5,
+	0x05, 0x24, 0xd9, 0x66, 0xd1, 0xea, 0xad, 0xfc, 0xbf, 0xdf, 0xf7, 0x6b, 0x92, 0xbf, 0x6e, 0x0d,
+	0x78, 0x42, 0x53, 0x86, 0x89, 0x10, 0x14, 0xf0, 0x08, 0xf0, 0xa4, 0x8b, 0x63, 0x92, 0x10, 0x26,
+	0xec, 0x38, 0xe1, 0xc0, 0x0d, 0x63, 0x09, 0xd8, 0x0a, 0xb0, 0x47, 0x60, 0x4f, 0xba, 0x7b, 0x4d,
+	0x9f, 0xfb, 0x5c, 0x8d, 0xb1, 0xfc, 0x5a, 0x92, 0xe8, 0xb3, 0xae, 0x37, 0x6e, 0xd5, 0xaa, 0x71,
+	0xa5, 0xef, 0x30, 0x92, 0xb9, 0x62, 0xca, 0x3c, 0x1e, 0xba, 0x21, 0x8d, 0x7c, 0x18, 0xb7, 0xb4,
+	0x8e, 0x76, 0xbc, 0xd5, 0x6f, 0x17, 0xb9, 0xd5, 0x9a, 0x12, 0x16, 0x9e, 0xa2, 0x0a, 0x82, 0x9c,
+	0x6d, 0x46, 0xb2, 0x7b, 0x15, 0x5d, 0xab, 0xc4, 0x78, 0xd2, 0x77, 0x25, 0x36, 0xa4, 0x62, 0x90,
+	0x04, 0x31, 0x04, 0x3c, 0x5a, 0xeb, 0xea, 0x4a, 0x77, 0x58, 0xe4, 0xd6, 0x41, 0xa9, 0xab, 0x72,
+	0xc8, 0x69, 0x32, 0x92, 0x5d, 0x94, 0xf9, 0x4a, 0x7c, 0xa7, 0xcb, 0xdc, 0x05, 0xfe, 0x42, 0x23,
+	0x97, 0x00, 0x24, 0x81, 0x97, 0x02, 0x15, 0xad, 0x0d, 0xa5, 0xb5, 0x8a, 0xdc, 0xda, 0x2f, 0xb5,
+	0xbf, 0x29, 0xe4, 0x18, 0x8c, 0x64, 0x0f, 0x32, 0x3d, 0xfb, 0x0e, 0x0d, 0xa1, 0x77, 0xfe, 0x80,
+	0xdd, 0x09, 0x09, 0x53, 0xba, 0x3e, 0xf5, 0xa6, 0xd2, 0x9f, 0x14, 0xb9, 0x75, 0xf4, 0xaf, 0xfe,
+	0xc7, 0x06, 0x72, 0xda, 0x95, 0x5f, 0x3d, 0xca, 0xf9, 0xf2, 0x1e, 0xfd, 0x9b, 0xb7, 0xb9, 0xa9,
+	0xcd, 0xe6, 0xa6, 0xf6, 0x31, 0x37, 0xb5, 0xd7, 0x85, 0x59, 0x9b, 0x2d, 0xcc, 0xda, 0xfb, 0xc2,
+	0xac, 0x3d, 0xf7, 0xfc, 0x00, 0xc6, 0xa9, 0x67, 0x0f, 0x38, 0xc3, 0xe7, 0xaa, 0xc4, 0x4b, 0x9e,
+	0x46, 0x43, 0x22, 0x1f, 0x01, 0xaf, 0x6a, 0xcf, 0xca, 0xe2, 0x61, 0x1a, 0x53, 0xe1, 0x35, 0x54,
+	0x97, 0xbd, 0xaf, 0x01, 0x00, 0x34, 0x83, 0x28, 0x95, 0x18, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTokenAttributeValueLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTokenAttributeValueLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTokenAttributes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTokenAttributes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDescriptionLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDescriptionLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSymbolLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSymbolLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSymbolLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxSymbolLength))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxDescriptionLength))
+	}
+	if m.MaxTokenAttributes != 0 {
+		n += 1 + sovParams(uint64(m.MaxTokenAttributes))
+	}
+	if m.MaxTokenAttributeValueLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTokenAttributeValueLength))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSymbolLength", wireType)
+			}
+			m.MaxSymbolLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSymbolLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
+			}
+			m.MaxDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDescriptionLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenAttributes", wireType)
+			}
+			m.MaxTokenAttributes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokenAttributes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenAttributeValueLength", wireType)
+			}
+			m.MaxTokenAttributeValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokenAttributeValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestParams_ValidateBasic(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.ValidateBasic())
+	require.NoError(t, types.DefaultGenesis().Validate())
+
+	// the description might be disabled completely
+	params.MaxDescriptionLength = 0
+	require.NoError(t, params.ValidateBasic())
+
+	// the symbol can't be disabled
+	params.MaxSymbolLength = 0
+	require.Error(t, params.ValidateBasic())
+
+	// the limits can't exceed the ones checked by the messages
+	params = types.DefaultParams()
+	params.MaxSymbolLength = types.MaxSymbolLength + 1
+	require.Error(t, params.ValidateBasic())
+	params = types.DefaultParams()
+	params.MaxDescriptionLength = types.MaxDescriptionLength + 1
+	require.Error(t, params.ValidateBasic())
+	params = types.DefaultParams()
+	params.MaxTokenAttributes = types.MaxTokenAttributes + 1
+	require.Error(t, params.ValidateBasic())
+	params = types.DefaultParams()
+	params.MaxTokenAttributeValueLength = types.MaxTokenAttributeValueLength + 1
+	require.Error(t, params.ValidateBasic())
+	params = types.DefaultParams()
+	params.MaxDescriptionLength = types.MaxDescriptionLength + 1
+	require.Error(t, types.GenesisState{Params: params}.Validate())
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxPrecision is the maximum precision of the fungible token.
+const MaxPrecision uint32 = 36
+
+// ValidatePrecision checks the provided precision is valid.
+func ValidatePrecision(precision uint32) error {
+	if precision > MaxPrecision {
+		return sdkerrors.Wrapf(ErrInvalidInput, "precision %d exceeds the maximum %d", precision, MaxPrecision)
+	}
+
+	return nil
+}
+
+// ToMainUnit converts the amount in the subunit to the exact decimal amount in the main unit (symbol) of
+// the token with the provided precision. The trailing zeros of the fractional part are trimmed.
+// The string is used instead of sdk.Dec because sdk.Dec can't represent more than 18 decimal places.
+func ToMainUnit(amount sdk.Int, precision uint32) string {
+	digits := amount.Abs().String()
+	sign := ""
+	if amount.IsNegative() {
+		sign = "-"
+	}
+	if precision == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= int(precision) {
+		digits = strings.Repeat("0", int(precision)-len(digits)+1) + digits
+	}
+	integral := digits[:len(digits)-int(precision)]
+	fractional := strings.TrimRight(digits[len(digits)-int(precision):], "0")
+	if fractional == "" {
+		return sign + integral
+	}
+
+	return sign + integral + "." + fractional
+}
+
+// FromMainUnit converts the decimal amount in the main unit (symbol) to the amount in the subunit of the token
+// with the provided precision. The amount having more significant decimal places than the precision is rejected
+// instead of being rounded.
+func FromMainUnit(amount string, precision uint32) (sdk.Int, error) {
+	digits := amount
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign = "-"
+		digits = digits[1:]
+	}
+
+	integral, fractional, _ := strings.Cut(digits, ".")
+	if integral == "" || !isDigits(integral) || !isDigits(fractional) {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalidInput, "invalid amount %q", amount)
+	}
+
+	fractional = strings.TrimRight(fractional, "0")
+	if len(fractional) > int(precision) {
+		return sdk.Int{}, sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"amount %q has more than %d decimal places",
+			amount,
+			precision,
+		)
+	}
+
+	// the leading zeros are trimmed because sdk.NewIntFromString treats the number starting with zero as octal
+	subunitDigits := strings.TrimLeft(integral+fractional+strings.Repeat("0", int(precision)-len(fractional)), "0")
+	if subunitDigits == "" {
+		subunitDigits = "0"
+	}
+	result, ok := sdk.NewIntFromString(sign + subunitDigits)
+	if !ok {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalidInput, "invalid amount %q", amount)
+	}
+
+	return result, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/ft/types"
+)
+
+func TestValidatePrecision(t *testing.T) {
+	requireT := require.New(t)
+
+	for _, precision := range []uint32{0, 1, 6, 18, 19, types.MaxPrecision} {
+		requireT.NoError(types.ValidatePrecision(precision))
+	}
+	for _, precision := range []uint32{types.MaxPrecision + 1, 100} {
+		requireT.True(types.ErrInvalidInput.Is(types.ValidatePrecision(precision)))
+	}
+}
+
+func TestMainUnitConversion(t *testing.T) {
+	testCases := []struct {
+		amount    string
+		precision uint32
+		mainUnit  string
+	}{
+		{amount: "0", precision: 0, mainUnit: "0"},
+		{amount: "0", precision: 6, mainUnit: "0"},
+		{amount: "1", precision: 0, mainUnit: "1"},
+		{amount: "123456", precision: 0, mainUnit: "123456"},
+		{amount: "-123456", precision: 0, mainUnit: "-123456"},
+		{amount: "1", precision: 1, mainUnit: "0.1"},
+		{amount: "10", precision: 1, mainUnit: "1"},
+		{amount: "1", precision: 6, mainUnit: "0.000001"},
+		{amount: "100000", precision: 6, mainUnit: "0.1"},
+		{amount: "1000000", precision: 6, mainUnit: "1"},
+		{amount: "1234567", precision: 6, mainUnit: "1.234567"},
+		{amount: "1230000", precision: 6, mainUnit: "1.23"},
+		{amount: "-1230000", precision: 6, mainUnit: "-1.23"},
+		{amount: "-1", precision: 6, mainUnit: "-0.000001"},
+		{amount: "1", precision: 18, mainUnit: "0.000000000000000001"},
+		{amount: "1000000000000000000", precision: 18, mainUnit: "1"},
+		{amount: "123456789012345678901234567890", precision: 18, mainUnit: "123456789012.34567890123456789"},
+		{amount: "1", precision: 19, mainUnit: "0.0000000000000000001"},
+		{amount: "12345678901234567890", precision: 19, mainUnit: "1.234567890123456789"},
+		{amount: "1", precision: types.MaxPrecision, mainUnit: "0.000000000000000000000000000000000001"},
+		{amount: "1000000000000000000000000000000000000", precision: types.MaxPrecision, mainUnit: "1"},
+		{
+			amount:    "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+			precision: types.MaxPrecision,
+			mainUnit:  "115792089237316195423570985008687907853269.984665640564039457584007913129639935",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s-%d", tc.amount, tc.precision), func(t *testing.T) {
+			requireT := require.New(t)
+
+			amount, ok := sdk.NewIntFromString(tc.amount)
+			requireT.True(ok)
+			requireT.Equal(tc.mainUnit, types.ToMainUnit(amount, tc.precision))
+
+			converted, err := types.FromMainUnit(tc.mainUnit, tc.precision)
+			requireT.NoError(err)
+			requireT.Equal(amount.String(), converted.String())
+		})
+	}
+}
+
+func TestFromMainUnit(t *testing.T) {
+	testCases := []struct {
+		mainUnit  string
+		precision uint32
+		amount    string
+		expectErr bool
+	}{
+		{mainUnit: "1.", precision: 0, amount: "1"},
+		{mainUnit: "1.000", precision: 0, amount: "1"},
+		{mainUnit: "0.10", precision: 1, amount: "1"},
+		{mainUnit: "00.5", precision: 6, amount: "500000"},
+		{mainUnit: "1.2345670000", precision: 6, amount: "1234567"},
+		{mainUnit: "-0.0", precision: 6, amount: "0"},
+		{mainUnit: "0.010", precision: 3, amount: "10"},
+		{mainUnit: "0.1", precision: 0, expectErr: true},
+		{mainUnit: "1.0000001", precision: 6, expectErr: true},
+		{mainUnit: "0.0000000000000000001", precision: 18, expectErr: true},
+		{mainUnit: "", precision: 6, expectErr: true},
+		{mainUnit: ".5", precision: 6, expectErr: true},
+		{mainUnit: "-", precision: 6, expectErr: true},
+		{mainUnit: "+1", precision: 6, expectErr: true},
+		{mainUnit: "1e6", precision: 6, expectErr: true},
+		{mainUnit: "1.2.3", precision: 6, expectErr: true},
+		{mainUnit: "1,5", precision: 6, expectErr: true},
+		{mainUnit: " 1", precision: 6, expectErr: true},
+		{mainUnit: "1000000000000000000000000000000000000000000", precision: types.MaxPrecision, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s-%d", tc.mainUnit, tc.precision), func(t *testing.T) {
+			requireT := require.New(t)
+
+			amount, err := types.FromMainUnit(tc.mainUnit, tc.precision)
+			if tc.expectErr {
+				requireT.True(types.ErrInvalidInput.Is(err))
+				return
+			}
+			requireT.NoError(err)
+			requireT.Equal(tc.amount, amount.String())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalTypeTokenStatus defines the type of the proposal moving the token to another lifecycle status.
+const ProposalTypeTokenStatus = "AssetFTTokenStatus"
+
+var _ govtypes.Content = &TokenStatusProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTokenStatus)
+	govtypes.RegisterProposalTypeCodec(&TokenStatusProposal{}, "assetft/TokenStatusProposal")
+}
+
+// NewTokenStatusProposal returns the proposal moving the token to the status.
+func NewTokenStatusProposal(title, description, denom string, status TokenStatus) *TokenStatusProposal {
+	return &TokenStatusProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		Status:      status,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p *TokenStatusProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p *TokenStatusProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p *TokenStatusProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p *TokenStatusProposal) ProposalType() string { return ProposalTypeTokenStatus }
+
+// ValidateBasic validates the proposal.
+func (p *TokenStatusProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, _, err := DeconstructDenom(p.Denom); err != nil {
+		return err
+	}
+	return ValidateTokenStatus(p.Status)
+}
+
+// String returns the human-readable representation of the proposal.
+func (p TokenStatusProposal) String() string {
+	return fmt.Sprintf(`Token Status Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Status:      %s
+`, p.Title, p.Description, p.Denom, p.Status)
+}
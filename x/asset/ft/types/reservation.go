@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReserveSettings is the model which represents the params for the reservation of the funds.
+type ReserveSettings struct {
+	Payer      sdk.AccAddress
+	Payee      sdk.AccAddress
+	Amount     sdk.Coin
+	Expiration time.Time
+}
+
+// CaptureSettings is the model which represents the params for the capture of the reserved funds.
+type CaptureSettings struct {
+	Sender sdk.AccAddress
+	ID     uint64
+	Amount sdk.Coin
+}
+
+// CalculateCaptureOutcome returns the outcome of the capture of the amount from the reservation. The burn rate and
+// the send commission rate stored when the reservation was made are charged, so the outcome doesn't depend on the
+// exemptions changed after that.
+func (r Reservation) CalculateCaptureOutcome(amount sdk.Int) SendOutcome {
+	burnt := sdk.ZeroInt()
+	if !r.BurnRate.IsNil() && r.BurnRate.IsPositive() {
+		burnt = r.BurnRate.MulInt(amount).Ceil().RoundInt()
+	}
+	commission := sdk.ZeroInt()
+	if !r.SendCommissionRate.IsNil() && r.SendCommissionRate.IsPositive() {
+		commission = r.SendCommissionRate.MulInt(amount).Ceil().RoundInt()
+	}
+
+	return SendOutcome{
+		Sent:       amount.Add(burnt).Add(commission),
+		Received:   amount,
+		Burnt:      burnt,
+		Commission: commission,
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxReserveAttestations is the number of the latest reserve attestations kept for the fungible token.
+	MaxReserveAttestations = 10
+
+	maxAuditorSignatureHashLength  = 128
+	maxReserveAttestationURILength = 256
+)
+
+// ReserveAttestationSettings is the model which represents the params for the publication of the reserve attestation.
+type ReserveAttestationSettings struct {
+	Sender               sdk.AccAddress
+	Denom                string
+	Amount               sdk.Int
+	AuditorSignatureHash string
+	URI                  string
+}
+
+// ValidateReserveAttestation checks the provided reserve attestation fields are valid.
+func ValidateReserveAttestation(amount sdk.Int, auditorSignatureHash, uri string) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "amount must not be negative")
+	}
+
+	if len(auditorSignatureHash) == 0 || len(auditorSignatureHash) > maxAuditorSignatureHashLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"auditor signature hash length must be between 1 and %d",
+			maxAuditorSignatureHashLength,
+		)
+	}
+	if _, err := hex.DecodeString(auditorSignatureHash); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "auditor signature hash must be hex-encoded: %s", err)
+	}
+
+	if len(uri) == 0 || len(uri) > maxReserveAttestationURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "URI length must be between 1 and %d", maxReserveAttestationURILength)
+	}
+
+	return nil
+}
@@ -0,0 +1,743 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/reserve_attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReserveAttestation is the attestation of the reserves backing the fungible token published by the issuer.
+type ReserveAttestation struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of the reserves confirmed by the auditor.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// auditor_signature_hash is the hex-encoded hash of the auditor signature of the attestation report.
+	AuditorSignatureHash string `protobuf:"bytes,3,opt,name=auditor_signature_hash,json=auditorSignatureHash,proto3" json:"auditor_signature_hash,omitempty"`
+	// uri is the location of the attestation report.
+	URI string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	// timestamp is the block time the attestation has been published at.
+	Timestamp time.Time `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *ReserveAttestation) Reset()         { *m = ReserveAttestation{} }
+func (m *ReserveAttestation) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestation) ProtoMessage()    {}
+func (*ReserveAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed2a6e58afffb9c, []int{0}
+}
+
+func (m *ReserveAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ReserveAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ReserveAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestation.Merge(m, src)
+}
+
+func (m *ReserveAttestation) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ReserveAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestation proto.InternalMessageInfo
+
+func (m *ReserveAttestation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetAuditorSignatureHash() string {
+	if m != nil {
+		return m.AuditorSignatureHash
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *ReserveAttestation) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// ReserveAttestationHistory is the list of the latest reserve attestations of the fungible token, the oldest first.
+type ReserveAttestationHistory struct {
+	Attestations []ReserveAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+}
+
+func (m *ReserveAttestationHistory) Reset()         { *m = ReserveAttestationHistory{} }
+func (m *ReserveAttestationHistory) String() string { return proto.CompactTextString(m) }
+func (*ReserveAttestationHistory) ProtoMessage()    {}
+func (*ReserveAttestationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eed2a6e58afffb9c, []int{1}
+}
+
+func (m *ReserveAttestationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ReserveAttestationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveAttestationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ReserveAttestationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveAttestationHistory.Merge(m, src)
+}
+
+func (m *ReserveAttestationHistory) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ReserveAttestationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveAttestationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveAttestationHistory proto.InternalMessageInfo
+
+func (m *ReserveAttestationHistory) GetAttestations() []ReserveAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReserveAttestation)(nil), "coreum.asset.ft.v1.ReserveAttestation")
+	proto.RegisterType((*ReserveAttestationHistory)(nil), "coreum.asset.ft.v1.ReserveAttestationHistory")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/ft/v1/reserve_attestation.proto", fileDescriptor_eed2a6e58afffb9c)
+}
+
+var fileDescriptor_eed2a6e58afffb9c = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0xd6, 0x6d, 0xa1, 0x5b, 0x4e, 0xab, 0x08, 0xb9, 0x39, 0xd8, 0x51, 0x0f, 0x55, 0x0e,
+	0xb0, 0xab, 0xb6, 0xfc, 0x00, 0x46, 0xaa, 0xda, 0x03, 0x12, 0x32, 0x70, 0xe1, 0x12, 0x6d, 0xe2,
+	0x8d, 0x6d, 0xc1, 0x7a, 0xa2, 0xdd, 0xd9, 0x88, 0x7e, 0x01, 0xd7, 0x7e, 0x56, 0x8f, 0x3d, 0x22,
+	0x0e, 0x01, 0x39, 0x3f, 0x82, 0xbc, 0x76, 0x48, 0x50, 0x4e, 0xf6, 0xcc, 0xbc, 0x79, 0x6f, 0xdf,
+	0xcc, 0xd0, 0x57, 0x33, 0x30, 0xca, 0x69, 0x21, 0xad, 0x55, 0x28, 0xe6, 0x28, 0x96, 0x97, 0xc2,
+	0x28, 0xab, 0xcc, 0x52, 0x4d, 0x24, 0xa2, 0xb2, 0x28, 0xb1, 0x82, 0x9a, 0x2f, 0x0c, 0x20, 0x30,
+	0xd6, 0xa1, 0xb9, 0x47, 0xf3, 0x39, 0xf2, 0xe5, 0xe5, 0x70, 0x50, 0x40, 0x01, 0xbe, 0x2c, 0xda,
+	0xbf, 0x0e, 0x39, 0x4c, 0x0a, 0x80, 0xe2, 0x9b, 0x12, 0x3e, 0x9a, 0xba, 0xb9, 0xc0, 0x4a, 0xb7,
+	0x64, 0x7a, 0xd1, 0x01, 0xce, 0x7f, 0x1c, 0x50, 0x96, 0x75, 0x42, 0x6f, 0xb7, 0x3a, 0x6c, 0x40,
+	0x8f, 0x72, 0x55, 0x83, 0x8e, 0xc8, 0x88, 0x8c, 0x4f, 0xb2, 0x2e, 0x60, 0x37, 0xf4, 0x58, 0x6a,
+	0x70, 0x35, 0x46, 0x07, 0x6d, 0x3a, 0xe5, 0x8f, 0xab, 0x24, 0xf8, 0xb5, 0x4a, 0x2e, 0x8a, 0x0a,
+	0x4b, 0x37, 0xe5, 0x33, 0xd0, 0x62, 0x06, 0x56, 0x83, 0xed, 0x3f, 0xaf, 0x6d, 0xfe, 0x55, 0xe0,
+	0xfd, 0x42, 0x59, 0x7e, 0x57, 0x63, 0xd6, 0x77, 0xb3, 0x37, 0xf4, 0xa5, 0x74, 0x79, 0x85, 0x60,
+	0x26, 0xb6, 0x2a, 0x6a, 0x89, 0xce, 0xa8, 0x49, 0x29, 0x6d, 0x19, 0x85, 0x5e, 0x6e, 0xd0, 0x57,
+	0x3f, 0x6e, 0x8a, 0xb7, 0xd2, 0x96, 0xec, 0x8c, 0x86, 0xce, 0x54, 0xd1, 0xa1, 0x97, 0x7e, 0xd6,
+	0xac, 0x92, 0xf0, 0x73, 0x76, 0x97, 0xb5, 0x39, 0x96, 0xd2, 0x93, 0x7f, 0xc6, 0xa2, 0xa3, 0x11,
+	0x19, 0x9f, 0x5e, 0x0d, 0x79, 0x67, 0x9d, 0x6f, 0xac, 0xf3, 0x4f, 0x1b, 0x44, 0xfa, 0xbc, 0x7d,
+	0xf7, 0xc3, 0xef, 0x84, 0x64, 0xdb, 0xb6, 0x73, 0x4d, 0xcf, 0xf6, 0x07, 0x71, 0x5b, 0x59, 0x04,
+	0x73, 0xcf, 0x3e, 0xd0, 0x17, 0x3b, 0x6b, 0xb0, 0x11, 0x19, 0x85, 0xe3, 0xd3, 0xab, 0x0b, 0xbe,
+	0xbf, 0x08, 0xbe, 0x4f, 0x92, 0x1e, 0xb6, 0x7a, 0xd9, 0x7f, 0x0c, 0xe9, 0xfb, 0xc7, 0x26, 0x26,
+	0x4f, 0x4d, 0x4c, 0xfe, 0x34, 0x31, 0x79, 0x58, 0xc7, 0xc1, 0xd3, 0x3a, 0x0e, 0x7e, 0xae, 0xe3,
+	0xe0, 0xcb, 0xf5, 0xce, 0x34, 0xdf, 0x79, 0xfe, 0x1b, 0x70, 0x75, 0xee, 0xfb, 0x44, 0x7f, 0x27,
+	0xdf, 0xb7, 0x97, 0xe2, 0xc7, 0x3b, 0x3d, 0xf6, 0x36, 0xaf, 0xff, 0x0e, 0x00, 0xdd, 0x58, 0xa0,
+	0x96, 0x49, 0x02, 0x00, 0x00,
+}
+
+func (m *ReserveAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReserveAttestation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AuditorSignatureHash) > 0 {
+		i -= len(m.AuditorSignatureHash)
+		copy(dAtA[i:], m.AuditorSignatureHash)
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(len(m.AuditorSignatureHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintReserveAttestation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveAttestationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveAttestationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveAttestationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReserveAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReserveAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReserveAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *ReserveAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovReserveAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovReserveAttestation(uint64(l))
+	l = len(m.AuditorSignatureHash)
+	if l > 0 {
+		n += 1 + l + sovReserveAttestation(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovReserveAttestation(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovReserveAttestation(uint64(l))
+	return n
+}
+
+func (m *ReserveAttestationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovReserveAttestation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReserveAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozReserveAttestation(x uint64) (n int) {
+	return sovReserveAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ReserveAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserveAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditorSignatureHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditorSignatureHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserveAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ReserveAttestationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserveAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveAttestationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveAttestationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, ReserveAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserveAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserveAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipReserveAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReserveAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserveAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReserveAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReserveAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReserveAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReserveAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReserveAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReserveAttestation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/timed_freeze.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TimedFreeze is the amount of the fungible token frozen on the account until the unfreeze time. The amounts frozen
+// by the issuer until the same time are summed up.
+type TimedFreeze struct {
+	Account string     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Coin    types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// unfreeze_time is the block time the amount is unfrozen at.
+	UnfreezeTime time.Time `protobuf:"bytes,3,opt,name=unfreeze_time,json=unfreezeTime,proto3,stdtime" json:"unfreeze_time"`
+}
+
+func (m *TimedFreeze) Reset()         { *m = TimedFreeze{} }
+func (m *TimedFreeze) String() string { return proto.CompactTextString(m) }
+func (*TimedFreeze) ProtoMessage()    {}
+func (*TimedFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_852efb3d507e9996, []int{0}
+}
+
+func (m *TimedFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TimedFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TimedFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedFreeze.Merge(m, src)
+}
+
+func (m *TimedFreeze) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TimedFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedFreeze proto.InternalMessageInfo
+
+func (m *TimedFreeze) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *TimedFreeze) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *TimedFreeze) GetUnfreezeTime() time.Time {
+	if m != nil {
+		return m.UnfreezeTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*TimedFreeze)(nil), "coreum.asset.ft.v1.TimedFreeze")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/ft/v1/timed_freeze.proto", fileDescriptor_852efb3d507e9996)
+}
+
+var fileDescriptor_852efb3d507e9996 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0x3d, 0x4e, 0xc3, 0x30,
+	0x14, 0xc7, 0x63, 0xa8, 0xf8, 0x48, 0x61, 0x89, 0x18, 0x42, 0x07, 0xb7, 0x42, 0x42, 0xea, 0xf4,
+	0xac, 0xd0, 0x1b, 0xb4, 0x52, 0x25, 0x06, 0x96, 0xaa, 0x13, 0x4b, 0xe5, 0xb8, 0x4e, 0x88, 0x44,
+	0xfc, 0xaa, 0xda, 0xae, 0x80, 0x53, 0xf4, 0x16, 0x5c, 0xa5, 0x63, 0x47, 0x26, 0x40, 0xed, 0x45,
+	0x90, 0xed, 0x44, 0x6c, 0x79, 0xfa, 0x7f, 0xe4, 0xe7, 0x7f, 0x7c, 0x2f, 0x70, 0x2d, 0x6d, 0xcd,
+	0xb8, 0xd6, 0xd2, 0xb0, 0xc2, 0xb0, 0x4d, 0xc6, 0x4c, 0x55, 0xcb, 0xe5, 0xa2, 0x58, 0x4b, 0xf9,
+	0x21, 0x61, 0xb5, 0x46, 0x83, 0x49, 0x12, 0x6c, 0xe0, 0x6d, 0x50, 0x18, 0xd8, 0x64, 0xbd, 0x9b,
+	0x12, 0x4b, 0xf4, 0x32, 0x73, 0x5f, 0xc1, 0xd9, 0xeb, 0x97, 0x88, 0xe5, 0xab, 0x64, 0xfe, 0xca,
+	0x6d, 0xe1, 0xdb, 0xb4, 0xe1, 0xf5, 0xaa, 0x31, 0x50, 0x81, 0xba, 0x46, 0xcd, 0x72, 0xae, 0x25,
+	0xdb, 0x64, 0xb9, 0x34, 0x3c, 0x63, 0x02, 0x2b, 0x15, 0xf4, 0xbb, 0x4f, 0x12, 0x77, 0xe7, 0x8e,
+	0x60, 0xea, 0x01, 0x92, 0x34, 0x3e, 0xe7, 0x42, 0xa0, 0x55, 0x26, 0x25, 0x03, 0x32, 0xbc, 0x9c,
+	0xb5, 0x67, 0x32, 0x8a, 0x3b, 0x2e, 0x97, 0x9e, 0x0c, 0xc8, 0xb0, 0xfb, 0x70, 0x0b, 0xa1, 0x18,
+	0x5c, 0x31, 0x34, 0xc5, 0x30, 0xc1, 0x4a, 0x8d, 0x3b, 0xbb, 0xef, 0x7e, 0x34, 0xf3, 0xe6, 0xe4,
+	0x31, 0xbe, 0xb6, 0x2a, 0xbc, 0x6d, 0xe1, 0xd0, 0xd2, 0x53, 0x9f, 0xee, 0x41, 0xe0, 0x86, 0x96,
+	0x1b, 0xe6, 0x2d, 0xf7, 0xf8, 0xc2, 0xc5, 0xb7, 0x3f, 0x7d, 0x32, 0xbb, 0x6a, 0xa3, 0x4e, 0x1c,
+	0x3f, 0xed, 0x0e, 0x94, 0xec, 0x0f, 0x94, 0xfc, 0x1e, 0x28, 0xd9, 0x1e, 0x69, 0xb4, 0x3f, 0xd2,
+	0xe8, 0xeb, 0x48, 0xa3, 0xe7, 0x51, 0x59, 0x99, 0x17, 0x9b, 0x83, 0xc0, 0x9a, 0x4d, 0xfc, 0x72,
+	0x53, 0xb4, 0x6a, 0xc9, 0x4d, 0x85, 0x8a, 0x35, 0x8b, 0xbf, 0xfd, 0x6f, 0x6e, 0xde, 0x57, 0x52,
+	0xe7, 0x67, 0xfe, 0xd7, 0xa3, 0xbf, 0x01, 0x00, 0xeb, 0xeb, 0x36, 0xfa, 0x93, 0x01, 0x00, 0x00,
+}
+
+func (m *TimedFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnfreezeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnfreezeTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTimedFreeze(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTimedFreeze(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTimedFreeze(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimedFreeze(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimedFreeze(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TimedFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTimedFreeze(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTimedFreeze(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnfreezeTime)
+	n += 1 + l + sovTimedFreeze(uint64(l))
+	return n
+}
+
+func sovTimedFreeze(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTimedFreeze(x uint64) (n int) {
+	return sovTimedFreeze(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TimedFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimedFreeze
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfreezeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimedFreeze
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnfreezeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimedFreeze(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimedFreeze
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipTimedFreeze(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimedFreeze
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimedFreeze
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimedFreeze
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimedFreeze
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimedFreeze
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimedFreeze
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimedFreeze        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimedFreeze          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimedFreeze = fmt.Errorf("proto: unexpected end of group")
+)
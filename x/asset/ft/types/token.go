@@ -22,6 +22,20 @@ var (
 
 const (
 	denomSeparator = "-"
+	wrappedPrefix  = "w"
+
+	// MaxIdempotencyKeyLength is the maximum length of the idempotency key of the issuance.
+	MaxIdempotencyKeyLength = 128
+	// MaxSymbolLength is the upper bound of the symbol length, the limit applied by the chain is defined by the
+	// module params and can't exceed it.
+	MaxSymbolLength = 128
+	// MaxDescriptionLength is the upper bound of the description length, the limit applied by the chain is defined
+	// by the module params and can't exceed it.
+	MaxDescriptionLength = 200
+	// MaxURILength is the maximum length of the URI of the token.
+	MaxURILength = 256
+	// MaxURIHashLength is the maximum length of the URI hash of the token.
+	MaxURIHashLength = 128
 )
 
 func init() {
@@ -39,6 +53,20 @@ type IssueSettings struct {
 	InitialAmount sdk.Int
 	Features      []TokenFeature
 	BurnRate      sdk.Dec
+	// SendCommissionRate is the optional rate of the amount sent to the issuer on top of every non-issuer transfer.
+	SendCommissionRate sdk.Dec
+	// IdempotencyKey is the optional key, the token issued by the issuer with the same key is returned instead of
+	// issuing the new one.
+	IdempotencyKey string
+	// VestingSchedule is the optional schedule the initial amount is vested with on the issuer account.
+	VestingSchedule *VestingSchedule
+	// URI is the optional URI of the document describing the token.
+	URI string
+	// URIHash is the optional hash of the document referenced by the URI.
+	URIHash string
+	// Draft is true to issue the token in the draft status, only the issuer might send and receive it until it is
+	// activated.
+	Draft bool
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
@@ -46,6 +74,11 @@ func BuildDenom(subunit string, issuer sdk.AccAddress) string {
 	return strings.ToLower(subunit) + denomSeparator + issuer.String()
 }
 
+// BuildWrappedSubunit builds the subunit of the fungible token wrapping the native denom.
+func BuildWrappedSubunit(nativeDenom string) string {
+	return wrappedPrefix + nativeDenom
+}
+
 // DeconstructDenom splits the denom string into the symbol and issuer address.
 func DeconstructDenom(denom string) (prefix string, issuer sdk.Address, err error) {
 	denomParts := strings.Split(denom, denomSeparator)
@@ -101,11 +134,74 @@ func NormalizeSymbolForKey(in string) string {
 	return strings.ToLower(in)
 }
 
+// ValidateDescription checks the length of the description doesn't exceed the limit.
+func ValidateDescription(description string, maxLength uint32) error {
+	if len(description) > int(maxLength) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must not exceed %d", description, maxLength)
+	}
+
+	return nil
+}
+
+// ValidateSymbolLength checks the length of the symbol doesn't exceed the limit.
+func ValidateSymbolLength(symbol string, maxLength uint32) error {
+	if len(symbol) > int(maxLength) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid symbol %q, the length must not exceed %d", symbol, maxLength)
+	}
+
+	return nil
+}
+
+// ValidateURI checks the lengths of the URI and its hash don't exceed the limits.
+func ValidateURI(uri, uriHash string) error {
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must not exceed %d", uri, MaxURILength)
+	}
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "invalid URI hash %q, the length must not exceed %d", uriHash, MaxURIHashLength,
+		)
+	}
+
+	return nil
+}
+
+// ValidateIdempotencyKey checks the provided idempotency key is valid. The empty key is valid and means that
+// the issuance isn't idempotent.
+func ValidateIdempotencyKey(key string) error {
+	if len(key) > MaxIdempotencyKeyLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "idempotency key length must not exceed %d", MaxIdempotencyKeyLength)
+	}
+
+	return nil
+}
+
 // IsFeatureEnabled returns true if feature is enabled for a fungible token.
 func (ftd *FTDefinition) IsFeatureEnabled(feature TokenFeature) bool {
 	return lo.Contains(ftd.Features, feature)
 }
 
+// ValidateFeatures checks that the features are known and not duplicated.
+func ValidateFeatures(features []TokenFeature) error {
+	maxFeatures := len(TokenFeature_name) //nolint:nosnakecase
+	if len(features) > maxFeatures {
+		return sdkerrors.Wrapf(ErrInvalidInput, "number of features %d exceeds the maximum %d", len(features), maxFeatures)
+	}
+
+	present := make(map[TokenFeature]struct{}, len(features))
+	for _, feature := range features {
+		if _, ok := TokenFeature_name[int32(feature)]; !ok { //nolint:nosnakecase
+			return sdkerrors.Wrapf(ErrInvalidInput, "unknown feature %d", feature)
+		}
+		if _, ok := present[feature]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated feature %s", feature)
+		}
+		present[feature] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidateBurnRate checks the provide burn rate is valid
 func ValidateBurnRate(burnRate sdk.Dec) error {
 	if burnRate.IsNil() {
@@ -123,6 +219,40 @@ func ValidateBurnRate(burnRate sdk.Dec) error {
 	return nil
 }
 
+// ValidateSendCommissionRate checks the provided send commission rate is valid
+func ValidateSendCommissionRate(sendCommissionRate sdk.Dec) error {
+	if sendCommissionRate.IsNil() {
+		return nil
+	}
+
+	if !isDecPrecisionValid(sendCommissionRate, 4) {
+		return sdkerrors.Wrap(ErrInvalidInput, "send commission rate precision should not be more than 4 decimal places")
+	}
+
+	if sendCommissionRate.LT(sdk.NewDec(0)) || sendCommissionRate.GT(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(ErrInvalidInput, "send commission rate is not within acceptable range")
+	}
+
+	return nil
+}
+
+// ValidateFrozenRate checks the provided frozen rate is valid
+func ValidateFrozenRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return sdkerrors.Wrap(ErrInvalidInput, "frozen rate must be set")
+	}
+
+	if !isDecPrecisionValid(rate, 4) {
+		return sdkerrors.Wrap(ErrInvalidInput, "frozen rate precision should not be more than 4 decimal places")
+	}
+
+	if rate.LT(sdk.NewDec(0)) || rate.GT(sdk.NewDec(1)) {
+		return sdkerrors.Wrap(ErrInvalidInput, "frozen rate is not within acceptable range")
+	}
+
+	return nil
+}
+
 // checks that dec precision is limited to the provided value
 func isDecPrecisionValid(dec sdk.Dec, prec uint) bool {
 	return dec.Mul(sdk.NewDecFromInt(sdk.NewInt(int64(math.Pow10(int(prec)))))).IsInteger()
@@ -132,3 +262,111 @@ func isDecPrecisionValid(dec sdk.Dec, prec uint) bool {
 func (ftd FTDefinition) CalculateBurnRateAmount(coin sdk.Coin) sdk.Int {
 	return ftd.BurnRate.MulInt(coin.Amount).Ceil().RoundInt()
 }
+
+// IsBurnRateApplicable returns true if the burn rate must be applied to the transfer from sender to recipient.
+// The transfers sent by or to the admin of the token aren't charged, the admin is empty if it has been cleared.
+// If the recipient is nil only the sender is checked, e.g. before the burn rate is split across the outputs of the
+// multi-send.
+func (ftd FTDefinition) IsBurnRateApplicable(admin string, sender, recipient sdk.AccAddress) bool {
+	return ftd.isRateApplicable(ftd.BurnRate, admin, sender, recipient)
+}
+
+// CalculateMultiSendBurnRateAmount returns the amount burnt from the input of the multi-send. The input amount is split
+// across the outputs of the denom proportionally to their amounts and the burn rate is applied only to the share sent
+// to the outputs it applies to. The share is calculated with the precision of sdk.Dec, truncating the rest, and the
+// burnt amount is rounded up once per input, so the result doesn't depend on the number or the order of the outputs.
+func (ftd FTDefinition) CalculateMultiSendBurnRateAmount(inAmount, taxedOutAmount, totalOutAmount sdk.Int) sdk.Int {
+	return calculateMultiSendRateAmount(ftd.BurnRate, inAmount, taxedOutAmount, totalOutAmount)
+}
+
+// CalculateSendCommissionRateAmount returns the coins to be sent to the admin
+func (ftd FTDefinition) CalculateSendCommissionRateAmount(coin sdk.Coin) sdk.Int {
+	return ftd.SendCommissionRate.MulInt(coin.Amount).Ceil().RoundInt()
+}
+
+// CalculateMultiSendCommissionAmount returns the commission charged on the input of the multi-send. It is split
+// across the outputs the same way the burnt amount is, see CalculateMultiSendBurnRateAmount.
+func (ftd FTDefinition) CalculateMultiSendCommissionAmount(inAmount, taxedOutAmount, totalOutAmount sdk.Int) sdk.Int {
+	return calculateMultiSendRateAmount(ftd.SendCommissionRate, inAmount, taxedOutAmount, totalOutAmount)
+}
+
+func calculateMultiSendRateAmount(rate sdk.Dec, inAmount, taxedOutAmount, totalOutAmount sdk.Int) sdk.Int {
+	if !taxedOutAmount.IsPositive() || !totalOutAmount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return rate.MulInt(inAmount).MulInt(taxedOutAmount).QuoInt(totalOutAmount).Ceil().RoundInt()
+}
+
+// IsSendCommissionRateApplicable returns true if the send commission rate must be applied to the transfer from sender
+// to recipient. The commission is sent to the admin of the token, so it isn't charged once the admin is cleared.
+// If the recipient is nil only the sender is checked, e.g. before the commission is split across the outputs of the
+// multi-send.
+func (ftd FTDefinition) IsSendCommissionRateApplicable(admin string, sender, recipient sdk.AccAddress) bool {
+	return admin != "" && ftd.isRateApplicable(ftd.SendCommissionRate, admin, sender, recipient)
+}
+
+func (ftd FTDefinition) isRateApplicable(rate sdk.Dec, admin string, sender, recipient sdk.AccAddress) bool {
+	if rate.IsNil() || !rate.IsPositive() {
+		return false
+	}
+	if admin == "" {
+		return true
+	}
+	if admin == sender.String() {
+		return false
+	}
+	return recipient == nil || admin != recipient.String()
+}
+
+// SendOutcome describes how the transfer of the fungible token changes the balances of its participants.
+type SendOutcome struct {
+	// Sent is the amount deducted from the sender's balance, including the burnt amount and the commission.
+	Sent sdk.Int
+	// Received is the amount credited to the recipient's balance.
+	Received sdk.Int
+	// Burnt is the amount burnt from the sender's balance because of the burn rate.
+	Burnt sdk.Int
+	// Commission is the amount sent from the sender's balance to the admin because of the send commission rate.
+	Commission sdk.Int
+}
+
+// CalculateSendOutcome returns the balance changes caused by sending the amount from sender to recipient of the token
+// which admin is the account, empty if the admin has been cleared. If the recipient is nil only the sender is checked.
+func (ftd FTDefinition) CalculateSendOutcome(
+	admin string,
+	sender, recipient sdk.AccAddress,
+	amount sdk.Int,
+) SendOutcome {
+	burnt := sdk.ZeroInt()
+	if ftd.IsBurnRateApplicable(admin, sender, recipient) {
+		burnt = ftd.CalculateBurnRateAmount(sdk.NewCoin(ftd.Denom, amount))
+	}
+	commission := sdk.ZeroInt()
+	if ftd.IsSendCommissionRateApplicable(admin, sender, recipient) {
+		commission = ftd.CalculateSendCommissionRateAmount(sdk.NewCoin(ftd.Denom, amount))
+	}
+
+	return SendOutcome{
+		Sent:       amount.Add(burnt).Add(commission),
+		Received:   amount,
+		Burnt:      burnt,
+		Commission: commission,
+	}
+}
+
+// FrozenRateAmount returns the part of the balance frozen by the rate. It is rounded up, so the holder never spends
+// more than the rate allows.
+func FrozenRateAmount(balance sdk.Int, rate sdk.Dec) sdk.Int {
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return rate.MulInt(balance).Ceil().RoundInt()
+}
+
+// AvailableAmount returns the part of the balance which is not frozen.
+func AvailableAmount(balance, frozen sdk.Int) sdk.Int {
+	if frozen.GTE(balance) {
+		return sdk.ZeroInt()
+	}
+	return balance.Sub(frozen)
+}
@@ -33,10 +33,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TokenFeature int32
 
 const (
-	TokenFeature_freeze    TokenFeature = 0
-	TokenFeature_mint      TokenFeature = 1
-	TokenFeature_burn      TokenFeature = 2
-	TokenFeature_whitelist TokenFeature = 3
+	TokenFeature_freeze          TokenFeature = 0
+	TokenFeature_mint            TokenFeature = 1
+	TokenFeature_burn            TokenFeature = 2
+	TokenFeature_whitelist       TokenFeature = 3
+	TokenFeature_receive_hook    TokenFeature = 4
+	TokenFeature_ibc             TokenFeature = 5
+	TokenFeature_metadata_update TokenFeature = 6
 )
 
 var TokenFeature_name = map[int32]string{
@@ -44,13 +47,19 @@ var TokenFeature_name = map[int32]string{
 	1: "mint",
 	2: "burn",
 	3: "whitelist",
+	4: "receive_hook",
+	5: "ibc",
+	6: "metadata_update",
 }
 
 var TokenFeature_value = map[string]int32{
-	"freeze":    0,
-	"mint":      1,
-	"burn":      2,
-	"whitelist": 3,
+	"freeze":          0,
+	"mint":            1,
+	"burn":            2,
+	"whitelist":       3,
+	"receive_hook":    4,
+	"ibc":             5,
+	"metadata_update": 6,
 }
 
 func (x TokenFeature) String() string {
@@ -61,6 +70,43 @@ func (TokenFeature) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{0}
 }
 
+// TokenStatus defines the lifecycle status of the fungible token. The zero value is active, so the tokens issued before
+// the statuses were introduced are active.
+type TokenStatus int32
+
+const (
+	// active tokens might be transferred without the status restrictions.
+	TokenStatus_active TokenStatus = 0
+	// draft tokens are prepared by the issuer, only the admin of the token might send and receive them.
+	TokenStatus_draft TokenStatus = 1
+	// suspended tokens are globally frozen, so nobody might send or receive them.
+	TokenStatus_suspended TokenStatus = 2
+	// retired tokens are decommissioned for good, nobody might send or receive them and the status is never changed.
+	TokenStatus_retired TokenStatus = 3
+)
+
+var TokenStatus_name = map[int32]string{
+	0: "active",
+	1: "draft",
+	2: "suspended",
+	3: "retired",
+}
+
+var TokenStatus_value = map[string]int32{
+	"active":    0,
+	"draft":     1,
+	"suspended": 2,
+	"retired":   3,
+}
+
+func (x TokenStatus) String() string {
+	return proto.EnumName(TokenStatus_name, int32(x))
+}
+
+func (TokenStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{1}
+}
+
 // FTDefinition defines the fungible token settings to store.
 type FTDefinition struct {
 	Denom    string         `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -69,6 +115,13 @@ type FTDefinition struct {
 	// burn_rate is a number between 0 and 1 which will be multiplied by send amount to determine
 	// burn_amount. This value will be burnt on top of the send amount.
 	BurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate"`
+	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
+	// amount sent to the token issuer account.
+	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// uri is the optional URI of the document describing the token, e.g. its whitepaper or off-chain metadata.
+	URI string `protobuf:"bytes,6,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the optional hash of the document referenced by the uri.
+	URIHash string `protobuf:"bytes,7,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 }
 
 func (m *FTDefinition) Reset()         { *m = FTDefinition{} }
@@ -122,6 +175,15 @@ type FT struct {
 	// burn_rate is a number between 0 and 1 which will be multiplied by send amount to determine
 	// burn_amount. This value will be burnt on top of the send amount.
 	BurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate"`
+	// send_commission_rate is a number between 0 and 1 which will be multiplied by send amount to determine
+	// amount sent to the token issuer account.
+	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
+	// uri is the optional URI of the document describing the token, e.g. its whitepaper or off-chain metadata.
+	URI string `protobuf:"bytes,11,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is the optional hash of the document referenced by the uri.
+	URIHash string `protobuf:"bytes,12,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// status is the lifecycle status of the token, globally_frozen is true if the token is suspended.
+	Status TokenStatus `protobuf:"varint,13,opt,name=status,proto3,enum=coreum.asset.ft.v1.TokenStatus" json:"status,omitempty"`
 }
 
 func (m *FT) Reset()         { *m = FT{} }
@@ -162,46 +224,128 @@ func (m *FT) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FT proto.InternalMessageInfo
 
+// TokenAttribute is the key-value attribute attached to the fungible token by its admin, e.g. the ISIN or the
+// jurisdiction of the token.
+type TokenAttribute struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *TokenAttribute) Reset()         { *m = TokenAttribute{} }
+func (m *TokenAttribute) String() string { return proto.CompactTextString(m) }
+func (*TokenAttribute) ProtoMessage()    {}
+func (*TokenAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+}
+
+func (m *TokenAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TokenAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TokenAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAttribute.Merge(m, src)
+}
+
+func (m *TokenAttribute) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TokenAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAttribute proto.InternalMessageInfo
+
+func (m *TokenAttribute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenAttribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TokenAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.ft.v1.TokenFeature", TokenFeature_name, TokenFeature_value)
+	proto.RegisterEnum("coreum.asset.ft.v1.TokenStatus", TokenStatus_name, TokenStatus_value)
 	proto.RegisterType((*FTDefinition)(nil), "coreum.asset.ft.v1.FTDefinition")
 	proto.RegisterType((*FT)(nil), "coreum.asset.ft.v1.FT")
+	proto.RegisterType((*TokenAttribute)(nil), "coreum.asset.ft.v1.TokenAttribute")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
 
 var fileDescriptor_fe80c7a2c55589e7 = []byte{
-	// 472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x25, 0xad, 0x6b, 0x1f, 0x6d, 0x89, 0x4e, 0x55, 0x75, 0xaa, 0x90, 0x63, 0x75, 0x80,
-	0x08, 0x89, 0x3b, 0x85, 0x6e, 0x08, 0x96, 0x52, 0x79, 0x41, 0x2c, 0x56, 0x26, 0x96, 0xca, 0x76,
-	0x9e, 0xd3, 0x53, 0xed, 0xbb, 0xe8, 0xee, 0x1c, 0x48, 0x3f, 0x01, 0x23, 0x1f, 0xa1, 0x1f, 0xa7,
-	0x63, 0xd9, 0x10, 0x43, 0x85, 0x92, 0x85, 0x8f, 0x81, 0x6c, 0xa7, 0x24, 0x88, 0x09, 0xd1, 0xc9,
-	0xef, 0xf7, 0x7b, 0x7f, 0xfc, 0x7b, 0x3f, 0xdd, 0xc3, 0x41, 0xa6, 0x34, 0x54, 0x25, 0x4f, 0x8c,
-	0x01, 0xcb, 0x73, 0xcb, 0x67, 0x43, 0x6e, 0xd5, 0x25, 0x48, 0x36, 0xd5, 0xca, 0x2a, 0x42, 0xda,
-	0x3c, 0x6b, 0xf2, 0x2c, 0xb7, 0x6c, 0x36, 0x3c, 0x3a, 0x98, 0xa8, 0x89, 0x6a, 0xd2, 0xbc, 0x8e,
-	0xda, 0xca, 0xa3, 0x20, 0x53, 0xa6, 0x54, 0x86, 0xa7, 0x89, 0x01, 0x3e, 0x1b, 0xa6, 0x60, 0x93,
-	0x21, 0xcf, 0x94, 0x58, 0x4d, 0x3a, 0xfe, 0x8a, 0xf0, 0x6e, 0x34, 0x3a, 0x83, 0x5c, 0x48, 0x61,
-	0x85, 0x92, 0xe4, 0x00, 0x6f, 0x8f, 0x41, 0xaa, 0x92, 0xa2, 0x10, 0x0d, 0xfc, 0xb8, 0x05, 0xe4,
-	0x10, 0xbb, 0xc2, 0x98, 0x0a, 0x34, 0xed, 0x34, 0xf4, 0x0a, 0x91, 0xd7, 0xd8, 0xcb, 0x21, 0xb1,
-	0x95, 0x06, 0x43, 0xbb, 0x61, 0x77, 0xb0, 0xff, 0x32, 0x64, 0x7f, 0x6b, 0x63, 0xa3, 0x5a, 0x7b,
-	0xd4, 0x16, 0xc6, 0xbf, 0x3b, 0xc8, 0x3b, 0xec, 0xa7, 0x95, 0x96, 0xe7, 0x3a, 0xb1, 0x40, 0xb7,
-	0xea, 0xc1, 0xa7, 0xec, 0xe6, 0xae, 0xef, 0x7c, 0xbf, 0xeb, 0x3f, 0x9d, 0x08, 0x7b, 0x51, 0xa5,
-	0x2c, 0x53, 0x25, 0x5f, 0xad, 0xd0, 0x7e, 0x5e, 0x98, 0xf1, 0x25, 0xb7, 0xf3, 0x29, 0x18, 0x76,
-	0x06, 0x59, 0xec, 0xd5, 0x03, 0xe2, 0xc4, 0xc2, 0x2b, 0xef, 0xf3, 0x75, 0xdf, 0xf9, 0x79, 0xdd,
-	0x77, 0x8e, 0x17, 0x1d, 0xdc, 0x89, 0x46, 0xff, 0xb8, 0xc9, 0x21, 0x76, 0xcd, 0xbc, 0x4c, 0x55,
-	0x41, 0xbb, 0x2d, 0xdf, 0x22, 0x42, 0xf1, 0x8e, 0xa9, 0xd2, 0x4a, 0x0a, 0xdb, 0x2a, 0x8c, 0xef,
-	0x21, 0x79, 0x82, 0xfd, 0xa9, 0x86, 0x4c, 0x18, 0xa1, 0x24, 0xdd, 0x0e, 0xd1, 0x60, 0x2f, 0x5e,
-	0x13, 0x24, 0xc4, 0x8f, 0xc6, 0x60, 0x32, 0x2d, 0xa6, 0xb5, 0xad, 0xd4, 0x6d, 0x7a, 0x37, 0x29,
-	0xf2, 0x0c, 0x3f, 0x9e, 0x14, 0x2a, 0x4d, 0x8a, 0x62, 0x7e, 0x9e, 0x6b, 0x75, 0x05, 0x92, 0xee,
-	0x84, 0x68, 0xe0, 0xc5, 0xfb, 0xf7, 0x74, 0xd4, 0xb0, 0x7f, 0x98, 0xec, 0xfd, 0x9f, 0xc9, 0xfe,
-	0x43, 0x99, 0xfc, 0xfc, 0x0d, 0xde, 0xdd, 0xfc, 0x21, 0xc1, 0xd8, 0xcd, 0x35, 0xc0, 0x15, 0xf4,
-	0x1c, 0xe2, 0xe1, 0xad, 0x52, 0x48, 0xdb, 0x43, 0x75, 0x54, 0xf7, 0xf6, 0x3a, 0x64, 0x0f, 0xfb,
-	0x1f, 0x2f, 0x84, 0x85, 0x42, 0x18, 0xdb, 0xeb, 0x9e, 0xbe, 0xbf, 0x59, 0x04, 0xe8, 0x76, 0x11,
-	0xa0, 0x1f, 0x8b, 0x00, 0x7d, 0x59, 0x06, 0xce, 0xed, 0x32, 0x70, 0xbe, 0x2d, 0x03, 0xe7, 0xc3,
-	0xc9, 0x86, 0xa8, 0xb7, 0xcd, 0x96, 0x91, 0xaa, 0xe4, 0x38, 0xa9, 0x3d, 0xe3, 0xab, 0xbb, 0xf8,
-	0xb4, 0xbe, 0x8c, 0x46, 0x65, 0xea, 0x36, 0xaf, 0xf9, 0xe4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa4, 0x3d, 0x09, 0x5a, 0x39, 0x03, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbb, 0x6e, 0xdb, 0x4a,
+	0x10, 0x95, 0x44, 0x3d, 0x57, 0x0f, 0x13, 0x7b, 0x0d, 0x83, 0xd7, 0xb8, 0xa0, 0x04, 0x17, 0xbe,
+	0x86, 0x81, 0x90, 0x90, 0x5d, 0x04, 0x08, 0x52, 0x24, 0xb6, 0x21, 0xc4, 0x08, 0x92, 0x82, 0xb1,
+	0x9b, 0x34, 0xca, 0x92, 0x1c, 0x49, 0x0b, 0x89, 0x5c, 0x61, 0x1f, 0x4a, 0xe4, 0x2f, 0x48, 0x99,
+	0x4f, 0xf0, 0xa7, 0xa4, 0x74, 0xe9, 0x32, 0x48, 0x21, 0x04, 0x72, 0x93, 0x2f, 0x48, 0x1d, 0x2c,
+	0x49, 0xdb, 0x0a, 0x02, 0x23, 0xc8, 0xab, 0xe2, 0xcc, 0x99, 0xe1, 0x99, 0x9d, 0x99, 0x83, 0x41,
+	0x76, 0xc0, 0x38, 0xa8, 0xc8, 0x25, 0x42, 0x80, 0x74, 0x07, 0xd2, 0x9d, 0x75, 0x5d, 0xc9, 0xc6,
+	0x10, 0x3b, 0x53, 0xce, 0x24, 0xc3, 0x38, 0x8d, 0x3b, 0x49, 0xdc, 0x19, 0x48, 0x67, 0xd6, 0xdd,
+	0x5c, 0x1f, 0xb2, 0x21, 0x4b, 0xc2, 0xae, 0xb6, 0xd2, 0xcc, 0x4d, 0x3b, 0x60, 0x22, 0x62, 0xc2,
+	0xf5, 0x89, 0x00, 0x77, 0xd6, 0xf5, 0x41, 0x92, 0xae, 0x1b, 0x30, 0x9a, 0x31, 0x6d, 0x7d, 0x29,
+	0xa0, 0x46, 0xef, 0xe4, 0x08, 0x06, 0x34, 0xa6, 0x92, 0xb2, 0x18, 0xaf, 0xa3, 0x52, 0x08, 0x31,
+	0x8b, 0xac, 0x7c, 0x27, 0xbf, 0x53, 0xf3, 0x52, 0x07, 0x6f, 0xa0, 0x32, 0x15, 0x42, 0x01, 0xb7,
+	0x0a, 0x09, 0x9c, 0x79, 0xf8, 0x21, 0xaa, 0x0e, 0x80, 0x48, 0xc5, 0x41, 0x58, 0x46, 0xc7, 0xd8,
+	0x69, 0xed, 0x75, 0x9c, 0xef, 0xdf, 0xe6, 0x9c, 0xe8, 0xb7, 0xf7, 0xd2, 0x44, 0xef, 0xe6, 0x0f,
+	0xfc, 0x14, 0xd5, 0x7c, 0xc5, 0xe3, 0x3e, 0x27, 0x12, 0xac, 0xa2, 0x26, 0x3e, 0x70, 0x2e, 0x16,
+	0xed, 0xdc, 0xc7, 0x45, 0x7b, 0x7b, 0x48, 0xe5, 0x48, 0xf9, 0x4e, 0xc0, 0x22, 0x37, 0x6b, 0x21,
+	0xfd, 0xdc, 0x13, 0xe1, 0xd8, 0x95, 0xf3, 0x29, 0x08, 0xe7, 0x08, 0x02, 0xaf, 0xaa, 0x09, 0x3c,
+	0x22, 0x01, 0xbf, 0x42, 0xeb, 0x02, 0xe2, 0xb0, 0x1f, 0xb0, 0x28, 0xa2, 0x42, 0x50, 0x96, 0xf1,
+	0x96, 0x7e, 0x89, 0x17, 0x6b, 0xae, 0xc3, 0x1b, 0xaa, 0xa4, 0xc2, 0xbf, 0xc8, 0x50, 0x9c, 0x5a,
+	0xe5, 0x84, 0xb0, 0xb2, 0x5c, 0xb4, 0x8d, 0x53, 0xef, 0xd8, 0xd3, 0x18, 0xde, 0x46, 0x55, 0xc5,
+	0x69, 0x7f, 0x44, 0xc4, 0xc8, 0xaa, 0x24, 0xf1, 0xfa, 0x72, 0xd1, 0xae, 0x9c, 0x7a, 0xc7, 0x4f,
+	0x88, 0x18, 0x79, 0x15, 0xc5, 0xa9, 0x36, 0x1e, 0x54, 0xdf, 0x9e, 0xb7, 0x73, 0x9f, 0xcf, 0xdb,
+	0xb9, 0xad, 0xf7, 0x45, 0x54, 0xe8, 0x9d, 0xfc, 0xe4, 0xb8, 0x37, 0x50, 0x59, 0xcc, 0x23, 0x9f,
+	0x4d, 0x2c, 0x23, 0xc5, 0x53, 0x0f, 0x5b, 0xa8, 0x22, 0x94, 0xaf, 0x62, 0x2a, 0xd3, 0x31, 0x7a,
+	0xd7, 0x2e, 0xfe, 0x0f, 0xd5, 0xa6, 0x1c, 0x02, 0xaa, 0x9b, 0x48, 0x46, 0xd1, 0xf4, 0x6e, 0x01,
+	0xdc, 0x41, 0xf5, 0x10, 0x44, 0xc0, 0xe9, 0x54, 0xef, 0x3e, 0xed, 0xcc, 0x5b, 0x85, 0xf0, 0xff,
+	0x68, 0x6d, 0x38, 0x61, 0x3e, 0x99, 0x4c, 0xe6, 0xfd, 0x01, 0x67, 0x67, 0x10, 0x27, 0xfd, 0x55,
+	0xbd, 0xd6, 0x35, 0xdc, 0x4b, 0xd0, 0x6f, 0x94, 0x50, 0xfd, 0x3d, 0x25, 0xd4, 0xfe, 0x92, 0x12,
+	0xd0, 0x9f, 0x56, 0x42, 0xfd, 0x07, 0x4a, 0x68, 0xdc, 0xad, 0x04, 0x7c, 0x1f, 0x95, 0x85, 0x24,
+	0x52, 0x09, 0xab, 0xd9, 0xc9, 0xef, 0xb4, 0xf6, 0xda, 0x77, 0x4e, 0xeb, 0x45, 0x92, 0xe6, 0x65,
+	0xe9, 0x2b, 0x12, 0x7a, 0x8e, 0x5a, 0x49, 0xc2, 0x63, 0x29, 0x39, 0xf5, 0x95, 0x84, 0x3b, 0xd4,
+	0x64, 0x22, 0x63, 0x0c, 0xf3, 0x4c, 0x4a, 0xda, 0xd4, 0x79, 0x33, 0x32, 0x51, 0x90, 0xc9, 0x28,
+	0x75, 0x76, 0x23, 0xd4, 0x58, 0x5d, 0x0f, 0x46, 0xa8, 0x3c, 0xe0, 0x00, 0x67, 0x60, 0xe6, 0x70,
+	0x15, 0x15, 0x23, 0x1a, 0x4b, 0x33, 0xaf, 0x2d, 0x3d, 0x69, 0xb3, 0x80, 0x9b, 0xa8, 0xf6, 0x7a,
+	0x44, 0x25, 0x4c, 0xa8, 0x90, 0xa6, 0x81, 0x4d, 0xd4, 0xe0, 0x10, 0x00, 0x9d, 0x41, 0x7f, 0xc4,
+	0xd8, 0xd8, 0x2c, 0xe2, 0x0a, 0x32, 0xa8, 0x1f, 0x98, 0x25, 0xfc, 0x0f, 0x5a, 0x8b, 0x40, 0x92,
+	0x90, 0x48, 0xd2, 0x57, 0xd3, 0x90, 0x48, 0x30, 0xcb, 0xbb, 0x8f, 0x50, 0x7d, 0xa5, 0x3f, 0x5d,
+	0x8d, 0x04, 0x92, 0xce, 0x74, 0xb5, 0x1a, 0x2a, 0x85, 0x9c, 0x0c, 0x74, 0xb9, 0x26, 0xaa, 0x09,
+	0x25, 0xa6, 0x10, 0x87, 0x10, 0x9a, 0x05, 0x5c, 0x47, 0x15, 0x0e, 0x92, 0x72, 0x08, 0x4d, 0xe3,
+	0xe0, 0xd9, 0xc5, 0xd2, 0xce, 0x5f, 0x2e, 0xed, 0xfc, 0xa7, 0xa5, 0x9d, 0x7f, 0x77, 0x65, 0xe7,
+	0x2e, 0xaf, 0xec, 0xdc, 0x87, 0x2b, 0x3b, 0xf7, 0x72, 0x7f, 0x65, 0xb9, 0x87, 0xc9, 0x5c, 0x7b,
+	0x4c, 0xc5, 0x21, 0xd1, 0x9a, 0x76, 0xb3, 0xe3, 0xfa, 0xe6, 0xf6, 0xbc, 0x26, 0xdb, 0xf6, 0xcb,
+	0xc9, 0x49, 0xdc, 0xff, 0x3a, 0x00, 0x8c, 0x75, 0x97, 0xa6, 0x7e, 0x05, 0x00, 0x00,
 }
 
 func (m *FTDefinition) Marshal() (dAtA []byte, err error) {
@@ -224,6 +368,30 @@ func (m *FTDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SendCommissionRate.Size()
+		i -= size
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.BurnRate.Size()
 		i -= size
@@ -289,6 +457,35 @@ func (m *FT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.SendCommissionRate.Size()
+		i -= size
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.BurnRate.Size()
 		i -= size
@@ -370,6 +567,50 @@ func (m *FT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -405,6 +646,16 @@ func (m *FTDefinition) Size() (n int) {
 	}
 	l = m.BurnRate.Size()
 	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -449,6 +700,40 @@ func (m *FT) Size() (n int) {
 	}
 	l = m.BurnRate.Size()
 	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovToken(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TokenAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -656,6 +941,104 @@ func (m *FTDefinition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1009,6 +1392,270 @@ func (m *FT) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TokenStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TokenAttribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenAttribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
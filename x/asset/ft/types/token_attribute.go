@@ -0,0 +1,43 @@
+package types
+
+import (
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxTokenAttributes is the upper bound of the number of the attributes of the token, the limit applied by the
+	// chain is defined by the module params and can't exceed it.
+	MaxTokenAttributes = 100
+	// DefaultMaxTokenAttributes is the default number of the attributes the token might have.
+	DefaultMaxTokenAttributes = 10
+	// MaxTokenAttributeValueLength is the upper bound of the length of the attribute value, the limit applied by the
+	// chain is defined by the module params and can't exceed it.
+	MaxTokenAttributeValueLength = 256
+)
+
+// the key is limited to the characters which don't need escaping in the Tendermint event queries.
+var tokenAttributeKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.:/-]{0,63}$`)
+
+// ValidateTokenAttributeKey checks the key of the token attribute is valid.
+func ValidateTokenAttributeKey(key string) error {
+	if !tokenAttributeKeyRegex.MatchString(key) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "invalid attribute key %q, it must match %s", key, tokenAttributeKeyRegex.String(),
+		)
+	}
+
+	return nil
+}
+
+// ValidateTokenAttributeValue checks the length of the token attribute value doesn't exceed the limit.
+func ValidateTokenAttributeValue(value string, maxLength uint32) error {
+	if len(value) > int(maxLength) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "invalid attribute value %q, the length must not exceed %d", value, maxLength,
+		)
+	}
+
+	return nil
+}
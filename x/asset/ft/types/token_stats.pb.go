@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/ft/v1/token_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TokenStatsCounters are the counters of the fungible token updated on each change of the balances, so the statistics
+// of the token are available without iterating over the accounts.
+type TokenStatsCounters struct {
+	// holders is the number of the accounts holding the positive balance of the token
+	Holders uint64 `protobuf:"varint,1,opt,name=holders,proto3" json:"holders,omitempty"`
+	// frozen is the sum of the amounts frozen on the accounts
+	Frozen github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=frozen,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"frozen"`
+	// whitelisted is the sum of the amounts whitelisted for the accounts
+	Whitelisted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=whitelisted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"whitelisted"`
+}
+
+func (m *TokenStatsCounters) Reset()         { *m = TokenStatsCounters{} }
+func (m *TokenStatsCounters) String() string { return proto.CompactTextString(m) }
+func (*TokenStatsCounters) ProtoMessage()    {}
+func (*TokenStatsCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9abe649d4f1dfe, []int{0}
+}
+
+func (m *TokenStatsCounters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TokenStatsCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenStatsCounters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TokenStatsCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStatsCounters.Merge(m, src)
+}
+
+func (m *TokenStatsCounters) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TokenStatsCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStatsCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStatsCounters proto.InternalMessageInfo
+
+func (m *TokenStatsCounters) GetHolders() uint64 {
+	if m != nil {
+		return m.Holders
+	}
+	return 0
+}
+
+// TokenStats are the aggregated statistics of the supply and the holders of the fungible token.
+type TokenStats struct {
+	Supply      types.Coin `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	Holders     uint64     `protobuf:"varint,2,opt,name=holders,proto3" json:"holders,omitempty"`
+	Frozen      types.Coin `protobuf:"bytes,3,opt,name=frozen,proto3" json:"frozen"`
+	Whitelisted types.Coin `protobuf:"bytes,4,opt,name=whitelisted,proto3" json:"whitelisted"`
+}
+
+func (m *TokenStats) Reset()         { *m = TokenStats{} }
+func (m *TokenStats) String() string { return proto.CompactTextString(m) }
+func (*TokenStats) ProtoMessage()    {}
+func (*TokenStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f9abe649d4f1dfe, []int{1}
+}
+
+func (m *TokenStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TokenStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TokenStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenStats.Merge(m, src)
+}
+
+func (m *TokenStats) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TokenStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenStats proto.InternalMessageInfo
+
+func (m *TokenStats) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *TokenStats) GetHolders() uint64 {
+	if m != nil {
+		return m.Holders
+	}
+	return 0
+}
+
+func (m *TokenStats) GetFrozen() types.Coin {
+	if m != nil {
+		return m.Frozen
+	}
+	return types.Coin{}
+}
+
+func (m *TokenStats) GetWhitelisted() types.Coin {
+	if m != nil {
+		return m.Whitelisted
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*TokenStatsCounters)(nil), "coreum.asset.ft.v1.TokenStatsCounters")
+	proto.RegisterType((*TokenStats)(nil), "coreum.asset.ft.v1.TokenStats")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/ft/v1/token_stats.proto", fileDescriptor_9f9abe649d4f1dfe)
+}
+
+var fileDescriptor_9f9abe649d4f1dfe = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x4e, 0x02, 0x41,
+	0x10, 0xc6, 0x6f, 0x81, 0x60, 0x5c, 0xba, 0x8b, 0xc5, 0x49, 0xb1, 0x10, 0x62, 0x0c, 0x8d, 0xbb,
+	0x39, 0x29, 0xa8, 0x85, 0x84, 0xc4, 0xc2, 0xc4, 0xa0, 0x95, 0x8d, 0xb9, 0x3f, 0x0b, 0x5c, 0x80,
+	0x9d, 0xcb, 0xed, 0x1c, 0x8a, 0x4f, 0xe1, 0x63, 0x51, 0x52, 0x1a, 0x4d, 0x88, 0x81, 0x17, 0x31,
+	0xf7, 0x47, 0x39, 0x3a, 0x62, 0xb5, 0xbb, 0x99, 0x6f, 0xbe, 0xc9, 0xef, 0xdb, 0xa1, 0x17, 0x1e,
+	0x44, 0x32, 0x9e, 0x0b, 0x47, 0x6b, 0x89, 0x62, 0x84, 0x62, 0x61, 0x0b, 0x84, 0xa9, 0x54, 0xcf,
+	0x1a, 0x1d, 0xd4, 0x3c, 0x8c, 0x00, 0xc1, 0x34, 0x33, 0x15, 0x4f, 0x55, 0x7c, 0x84, 0x7c, 0x61,
+	0xd7, 0xcf, 0xc6, 0x30, 0x86, 0xb4, 0x2c, 0x92, 0x5b, 0xa6, 0xac, 0x33, 0x0f, 0xf4, 0x1c, 0xb4,
+	0x70, 0x1d, 0x2d, 0xc5, 0xc2, 0x76, 0x25, 0x3a, 0xb6, 0xf0, 0x20, 0x50, 0x59, 0xbd, 0xb5, 0x22,
+	0xd4, 0x7c, 0x4c, 0xfc, 0x1f, 0x12, 0xfb, 0x3e, 0xc4, 0x0a, 0x65, 0xa4, 0x4d, 0x8b, 0x9e, 0x4c,
+	0x60, 0xe6, 0xcb, 0x48, 0x5b, 0xa4, 0x49, 0xda, 0x95, 0xe1, 0xef, 0xd3, 0x1c, 0xd0, 0xea, 0x28,
+	0x82, 0x37, 0xa9, 0xac, 0x52, 0x93, 0xb4, 0x4f, 0x7b, 0x7c, 0xb5, 0x69, 0x18, 0x9f, 0x9b, 0xc6,
+	0xe5, 0x38, 0xc0, 0x49, 0xec, 0x72, 0x0f, 0xe6, 0x22, 0x9f, 0x99, 0x1d, 0x57, 0xda, 0x9f, 0x0a,
+	0x5c, 0x86, 0x52, 0xf3, 0x5b, 0x85, 0xc3, 0xbc, 0xdb, 0xbc, 0xa7, 0xb5, 0x97, 0x49, 0x80, 0x72,
+	0x16, 0x68, 0x94, 0xbe, 0x55, 0xfe, 0x97, 0x59, 0xd1, 0xa2, 0xf5, 0x45, 0x28, 0xdd, 0xa3, 0x98,
+	0x5d, 0x5a, 0xd5, 0x71, 0x18, 0xce, 0x96, 0x29, 0x41, 0xed, 0xfa, 0x9c, 0x67, 0x16, 0x3c, 0x89,
+	0x82, 0xe7, 0x51, 0xf0, 0x3e, 0x04, 0xaa, 0x57, 0x49, 0xc6, 0x0e, 0x73, 0x79, 0x91, 0xbd, 0x74,
+	0xc8, 0xde, 0xfd, 0x63, 0x2f, 0x1f, 0x69, 0x99, 0xc3, 0xde, 0x1c, 0xc2, 0x56, 0x8e, 0xeb, 0x2e,
+	0xf6, 0xf4, 0xee, 0x56, 0x5b, 0x46, 0xd6, 0x5b, 0x46, 0xbe, 0xb7, 0x8c, 0xbc, 0xef, 0x98, 0xb1,
+	0xde, 0x31, 0xe3, 0x63, 0xc7, 0x8c, 0xa7, 0x4e, 0x21, 0xac, 0x7e, 0xba, 0x17, 0x03, 0x88, 0x95,
+	0xef, 0x60, 0x00, 0x4a, 0xe4, 0xeb, 0xf4, 0xba, 0x5f, 0xa8, 0x34, 0x3d, 0xb7, 0x9a, 0x7e, 0x7f,
+	0xe7, 0x67, 0x00, 0x72, 0xdc, 0xe8, 0x28, 0x70, 0x02, 0x00, 0x00,
+}
+
+func (m *TokenStatsCounters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenStatsCounters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenStatsCounters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Whitelisted.Size()
+		i -= size
+		if _, err := m.Whitelisted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTokenStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Frozen.Size()
+		i -= size
+		if _, err := m.Frozen.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTokenStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Holders != 0 {
+		i = encodeVarintTokenStats(dAtA, i, uint64(m.Holders))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Whitelisted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTokenStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Frozen.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTokenStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Holders != 0 {
+		i = encodeVarintTokenStats(dAtA, i, uint64(m.Holders))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTokenStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TokenStatsCounters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Holders != 0 {
+		n += 1 + sovTokenStats(uint64(m.Holders))
+	}
+	l = m.Frozen.Size()
+	n += 1 + l + sovTokenStats(uint64(l))
+	l = m.Whitelisted.Size()
+	n += 1 + l + sovTokenStats(uint64(l))
+	return n
+}
+
+func (m *TokenStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovTokenStats(uint64(l))
+	if m.Holders != 0 {
+		n += 1 + sovTokenStats(uint64(m.Holders))
+	}
+	l = m.Frozen.Size()
+	n += 1 + l + sovTokenStats(uint64(l))
+	l = m.Whitelisted.Size()
+	n += 1 + l + sovTokenStats(uint64(l))
+	return n
+}
+
+func sovTokenStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTokenStats(x uint64) (n int) {
+	return sovTokenStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TokenStatsCounters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenStatsCounters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenStatsCounters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			m.Holders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Whitelisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TokenStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			m.Holders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Holders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Whitelisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipTokenStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// tokenStatusTransitions defines the statuses the token might be moved to from its current status. The retired status
+// is terminal.
+var tokenStatusTransitions = map[TokenStatus][]TokenStatus{
+	TokenStatus_draft:     {TokenStatus_active, TokenStatus_retired},    //nolint:nosnakecase
+	TokenStatus_active:    {TokenStatus_suspended, TokenStatus_retired}, //nolint:nosnakecase
+	TokenStatus_suspended: {TokenStatus_active, TokenStatus_retired},    //nolint:nosnakecase
+}
+
+// ValidateTokenStatus checks that the status is defined.
+func ValidateTokenStatus(status TokenStatus) error {
+	if _, ok := TokenStatus_name[int32(status)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown token status %d", status)
+	}
+	return nil
+}
+
+// ValidateTokenStatusTransition checks that the token might be moved from the current status to the next one.
+func ValidateTokenStatusTransition(current, next TokenStatus) error {
+	if err := ValidateTokenStatus(next); err != nil {
+		return err
+	}
+	for _, allowed := range tokenStatusTransitions[current] {
+		if allowed == next {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalidStatusTransition, "token can't be moved from %s to %s", current, next)
+}
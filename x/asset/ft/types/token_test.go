@@ -215,3 +215,267 @@ func TestValidateBurnRate(t *testing.T) {
 		})
 	}
 }
+
+func TestFTDefinition_CalculateSendOutcome(t *testing.T) {
+	issuer, err := sdk.AccAddressFromBech32("devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5")
+	require.NoError(t, err)
+	sender, err := sdk.AccAddressFromBech32("devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h")
+	require.NoError(t, err)
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	testCases := []struct {
+		name               string
+		burnRate           sdk.Dec
+		sendCommissionRate sdk.Dec
+		admin              sdk.AccAddress
+		adminCleared       bool
+		sender             sdk.AccAddress
+		recipient          sdk.AccAddress
+		amount             int64
+		expectSent         int64
+		expectBurn         int64
+		expectCommission   int64
+	}{
+		{
+			name:       "no_burn_rate",
+			burnRate:   sdk.Dec{},
+			sender:     sender,
+			recipient:  recipient,
+			amount:     100,
+			expectSent: 100,
+			expectBurn: 0,
+		},
+		{
+			name:       "burn_rate_applied",
+			burnRate:   sdk.MustNewDecFromStr("0.1"),
+			sender:     sender,
+			recipient:  recipient,
+			amount:     100,
+			expectSent: 110,
+			expectBurn: 10,
+		},
+		{
+			name:       "burn_rate_rounded_up",
+			burnRate:   sdk.MustNewDecFromStr("0.1234"),
+			sender:     sender,
+			recipient:  recipient,
+			amount:     97,
+			expectSent: 109,
+			expectBurn: 12,
+		},
+		{
+			name:       "issuer_sends",
+			burnRate:   sdk.MustNewDecFromStr("0.1"),
+			sender:     issuer,
+			recipient:  recipient,
+			amount:     100,
+			expectSent: 100,
+			expectBurn: 0,
+		},
+		{
+			name:       "issuer_receives",
+			burnRate:   sdk.MustNewDecFromStr("0.1"),
+			sender:     sender,
+			recipient:  issuer,
+			amount:     100,
+			expectSent: 100,
+			expectBurn: 0,
+		},
+		{
+			name:       "multi_send",
+			burnRate:   sdk.MustNewDecFromStr("0.1"),
+			sender:     sender,
+			recipient:  nil,
+			amount:     200,
+			expectSent: 220,
+			expectBurn: 20,
+		},
+		{
+			name:               "send_commission_rate_applied",
+			burnRate:           sdk.MustNewDecFromStr("0.1"),
+			sendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+			sender:             sender,
+			recipient:          recipient,
+			amount:             100,
+			expectSent:         130,
+			expectBurn:         10,
+			expectCommission:   20,
+		},
+		{
+			name:               "send_commission_rate_rounded_up",
+			sendCommissionRate: sdk.MustNewDecFromStr("0.1234"),
+			sender:             sender,
+			recipient:          recipient,
+			amount:             97,
+			expectSent:         109,
+			expectCommission:   12,
+		},
+		{
+			name:               "send_commission_rate_issuer_receives",
+			sendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+			sender:             sender,
+			recipient:          issuer,
+			amount:             100,
+			expectSent:         100,
+		},
+		{
+			name:               "old_issuer_sends_after_admin_transfer",
+			burnRate:           sdk.MustNewDecFromStr("0.1"),
+			sendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+			admin:              sender,
+			sender:             issuer,
+			recipient:          recipient,
+			amount:             100,
+			expectSent:         130,
+			expectBurn:         10,
+			expectCommission:   20,
+		},
+		{
+			name:               "new_admin_sends",
+			burnRate:           sdk.MustNewDecFromStr("0.1"),
+			sendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+			admin:              sender,
+			sender:             sender,
+			recipient:          recipient,
+			amount:             100,
+			expectSent:         100,
+		},
+		{
+			name:               "admin_cleared",
+			burnRate:           sdk.MustNewDecFromStr("0.1"),
+			sendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+			adminCleared:       true,
+			sender:             issuer,
+			recipient:          recipient,
+			amount:             100,
+			expectSent:         110,
+			expectBurn:         10,
+		},
+		{
+			name:               "send_commission_rate_multi_send",
+			sendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+			sender:             sender,
+			recipient:          nil,
+			amount:             200,
+			expectSent:         240,
+			expectCommission:   40,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			definition := types.FTDefinition{
+				Denom:              types.BuildDenom("abc", issuer),
+				Issuer:             issuer.String(),
+				BurnRate:           tc.burnRate,
+				SendCommissionRate: tc.sendCommissionRate,
+			}
+			admin := issuer.String()
+			if tc.admin != nil {
+				admin = tc.admin.String()
+			}
+			if tc.adminCleared {
+				admin = ""
+			}
+			outcome := definition.CalculateSendOutcome(admin, tc.sender, tc.recipient, sdk.NewInt(tc.amount))
+			requireT.Equal(sdk.NewInt(tc.expectSent).String(), outcome.Sent.String())
+			requireT.Equal(sdk.NewInt(tc.amount).String(), outcome.Received.String())
+			requireT.Equal(sdk.NewInt(tc.expectBurn).String(), outcome.Burnt.String())
+			requireT.Equal(sdk.NewInt(tc.expectCommission).String(), outcome.Commission.String())
+		})
+	}
+}
+
+func TestFTDefinition_CalculateMultiSendBurnRateAmount(t *testing.T) {
+	testCases := []struct {
+		name           string
+		burnRate       sdk.Dec
+		inAmount       int64
+		taxedOutAmount int64
+		totalOutAmount int64
+		expectBurn     int64
+	}{
+		{
+			name:           "all_outputs_taxed",
+			burnRate:       sdk.MustNewDecFromStr("0.1"),
+			inAmount:       200,
+			taxedOutAmount: 200,
+			totalOutAmount: 200,
+			expectBurn:     20,
+		},
+		{
+			name:           "half_of_outputs_taxed",
+			burnRate:       sdk.MustNewDecFromStr("0.1"),
+			inAmount:       200,
+			taxedOutAmount: 100,
+			totalOutAmount: 200,
+			expectBurn:     10,
+		},
+		{
+			name:           "no_outputs_taxed",
+			burnRate:       sdk.MustNewDecFromStr("0.1"),
+			inAmount:       200,
+			taxedOutAmount: 0,
+			totalOutAmount: 200,
+			expectBurn:     0,
+		},
+		{
+			name:           "share_of_input_rounded_up_once",
+			burnRate:       sdk.MustNewDecFromStr("0.1"),
+			inAmount:       100,
+			taxedOutAmount: 200,
+			totalOutAmount: 300,
+			expectBurn:     7,
+		},
+		{
+			name:           "input_share_of_multiple_inputs",
+			burnRate:       sdk.MustNewDecFromStr("0.25"),
+			inAmount:       30,
+			taxedOutAmount: 70,
+			totalOutAmount: 100,
+			expectBurn:     6,
+		},
+		{
+			name:           "exact_amount_not_rounded_up",
+			burnRate:       sdk.MustNewDecFromStr("0.3"),
+			inAmount:       30,
+			taxedOutAmount: 10,
+			totalOutAmount: 30,
+			expectBurn:     3,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			definition := types.FTDefinition{BurnRate: tc.burnRate}
+			burnt := definition.CalculateMultiSendBurnRateAmount(
+				sdk.NewInt(tc.inAmount), sdk.NewInt(tc.taxedOutAmount), sdk.NewInt(tc.totalOutAmount),
+			)
+			require.Equal(t, sdk.NewInt(tc.expectBurn).String(), burnt.String())
+		})
+	}
+}
+
+func TestFTDefinition_CalculateMultiSendCommissionAmount(t *testing.T) {
+	requireT := require.New(t)
+	definition := types.FTDefinition{SendCommissionRate: sdk.MustNewDecFromStr("0.25")}
+
+	// the share of the input sent to the admin isn't charged
+	commission := definition.CalculateMultiSendCommissionAmount(sdk.NewInt(200), sdk.NewInt(100), sdk.NewInt(200))
+	requireT.Equal(sdk.NewInt(25).String(), commission.String())
+	commission = definition.CalculateMultiSendCommissionAmount(sdk.NewInt(200), sdk.ZeroInt(), sdk.NewInt(200))
+	requireT.Equal(sdk.ZeroInt().String(), commission.String())
+	// the share of the input is rounded up once
+	commission = definition.CalculateMultiSendCommissionAmount(sdk.NewInt(100), sdk.NewInt(200), sdk.NewInt(300))
+	requireT.Equal(sdk.NewInt(17).String(), commission.String())
+}
+
+func TestAvailableAmount(t *testing.T) {
+	requireT := require.New(t)
+	requireT.Equal(sdk.NewInt(70).String(), types.AvailableAmount(sdk.NewInt(100), sdk.NewInt(30)).String())
+	requireT.Equal(sdk.ZeroInt().String(), types.AvailableAmount(sdk.NewInt(100), sdk.NewInt(100)).String())
+	requireT.Equal(sdk.ZeroInt().String(), types.AvailableAmount(sdk.NewInt(100), sdk.NewInt(150)).String())
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// MaxVestingPeriods is the maximum number of periods in the vesting schedule. The issuance is charged with the
+// deterministic gas, so the number of periods stored on the vesting account is limited.
+const MaxVestingPeriods = 100
+
+// ValidateVestingSchedule checks that the vesting schedule periods are valid and sum up to the initial amount.
+func ValidateVestingSchedule(schedule VestingSchedule, initialAmount sdk.Int) error {
+	if len(schedule.Periods) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "vesting schedule must contain at least one period")
+	}
+	if len(schedule.Periods) > MaxVestingPeriods {
+		return sdkerrors.Wrapf(ErrInvalidInput, "number of vesting periods must not exceed %d", MaxVestingPeriods)
+	}
+	if schedule.StartTime != nil && schedule.StartTime.Unix() <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid vesting start time %s", schedule.StartTime)
+	}
+
+	total := sdk.ZeroInt()
+	for i, period := range schedule.Periods {
+		// the periodic vesting account stores the lengths in seconds
+		if period.Length < 0 || period.Length%time.Second != 0 {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput,
+				"length of the vesting period %d must be a non-negative number of seconds",
+				i,
+			)
+		}
+		if period.Amount.IsNil() || !period.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidInput, "amount of the vesting period %d must be positive", i)
+		}
+		total = total.Add(period.Amount)
+	}
+
+	if initialAmount.IsNil() || !total.Equal(initialAmount) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"sum of the vesting period amounts %s must be equal to the initial amount %s",
+			total,
+			initialAmount,
+		)
+	}
+
+	return nil
+}
+
+// SDKPeriods returns the vesting periods of the denom in the format of the periodic vesting account.
+func (s VestingSchedule) SDKPeriods(denom string) vestingtypes.Periods {
+	periods := make(vestingtypes.Periods, 0, len(s.Periods))
+	for _, period := range s.Periods {
+		periods = append(periods, vestingtypes.Period{
+			Length: int64(period.Length.Seconds()),
+			Amount: sdk.NewCoins(sdk.NewCoin(denom, period.Amount)),
+		})
+	}
+
+	return periods
+}
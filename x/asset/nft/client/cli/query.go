@@ -0,0 +1,686 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+const (
+	issuerFlag = "issuer"
+	ownerFlag  = "owner"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryClass(),
+		CmdQueryClasses(),
+		CmdQueryNFT(),
+		CmdQueryNFTs(),
+		CmdQueryOwner(),
+		CmdQuerySupply(),
+		CmdQueryUser(),
+		CmdQueryProvenance(),
+		CmdQueryClassOwner(),
+		CmdQueryRewardPool(),
+		CmdQueryPendingReward(),
+		CmdQueryClassFrozen(),
+		CmdQueryClassWhitelistedAccounts(),
+		CmdQueryClassRoyaltyRate(),
+		CmdQueryOwnershipProof(),
+	)
+	return cmd
+}
+
+// CmdQueryParams return the QueryParams cobra command.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current asset nft parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current asset nft parameters.
+
+Example:
+$ %[1]s query asset-nft params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClass return the QueryClass cobra command.
+func CmdQueryClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the non-fungible token class together with its owner, features and royalty rate.
+
+Example:
+$ %[1]s query asset-nft class [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Class(cmd.Context(), &types.QueryClassRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Class)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClasses return the QueryClasses cobra command.
+func CmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "classes",
+		Args:  cobra.NoArgs,
+		Short: "Query the non-fungible token classes issued by the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the non-fungible token classes issued by the account ordered by the class ID.
+
+Example:
+$ %[1]s query asset-nft classes --%[2]s [issuer]
+`,
+				version.AppName, issuerFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			issuer, err := cmd.Flags().GetString(issuerFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if issuer == "" {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "the --%s flag is required", issuerFlag)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Classes(cmd.Context(), &types.QueryClassesRequest{
+				Issuer:     issuer,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+	cmd.Flags().String(issuerFlag, "", "Address of the account which issued the classes")
+
+	return cmd
+}
+
+// CmdQueryNFT return the QueryNFT cobra command.
+func CmdQueryNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the non-fungible token of the class.
+
+Example:
+$ %[1]s query asset-nft nft [class-id] [id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := nft.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NFT(cmd.Context(), &nft.QueryNFTRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryNFTs return the QueryNFTs cobra command.
+func CmdQueryNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the non-fungible tokens of the class, optionally only the ones held by the owner.
+
+Example:
+$ %[1]s query asset-nft nfts [class-id]
+$ %[1]s query asset-nft nfts [class-id] --%[2]s [owner]
+`,
+				version.AppName, ownerFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := nft.NewQueryClient(clientCtx)
+
+			owner, err := cmd.Flags().GetString(ownerFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.NFTs(cmd.Context(), &nft.QueryNFTsRequest{
+				ClassId:    args[0],
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+	cmd.Flags().String(ownerFlag, "", "Address of the account holding the tokens")
+
+	return cmd
+}
+
+// CmdQueryOwner return the QueryOwner cobra command.
+func CmdQueryOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the owner of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account holding the non-fungible token.
+
+Example:
+$ %[1]s query asset-nft owner [class-id] [id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := nft.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Owner(cmd.Context(), &nft.QueryOwnerRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySupply return the QuerySupply cobra command.
+func CmdQuerySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the number of the non-fungible tokens in the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of the non-fungible tokens in the class.
+
+Example:
+$ %[1]s query asset-nft supply [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := nft.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Supply(cmd.Context(), &nft.QuerySupplyRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryUser return the QueryUser cobra command.
+func CmdQueryUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the user of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account granted the right to use the non-fungible token and the expiration time of the right.
+
+Example:
+$ %[1]s query asset-nft user [class-id] [id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.User(cmd.Context(), &types.QueryUserRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryProvenance return the QueryProvenance cobra command.
+func CmdQueryProvenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provenance [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the provenance records of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the provenance records of the non-fungible token ordered by sequence. Each record contains the hash of the previous one.
+
+Example:
+$ %[1]s query asset-nft provenance [class-id] [id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.Provenance(cmd.Context(), &types.QueryProvenanceRequest{
+				ClassId:    args[0],
+				Id:         args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "provenance")
+
+	return cmd
+}
+
+// CmdQueryClassOwner return the QueryClassOwner cobra command.
+func CmdQueryClassOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-owner [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the owner of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the owner of the non-fungible token class and the new owner the ownership is proposed to.
+
+Example:
+$ %[1]s query asset-nft class-owner [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassOwner(cmd.Context(), &types.QueryClassOwnerRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryRewardPool return the QueryRewardPool cobra command.
+func CmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reward pool of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the configuration, the balance and the number of the locked tokens of the reward pool of the class.
+
+Example:
+$ %[1]s query asset-nft reward-pool [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPool(cmd.Context(), &types.QueryRewardPoolRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryPendingReward return the QueryPendingReward cobra command.
+func CmdQueryPendingReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-reward [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the reward earned by the locked non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lock of the non-fungible token and the reward earned by it so far.
+
+Example:
+$ %[1]s query asset-nft pending-reward [class-id] [id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingReward(cmd.Context(), &types.QueryPendingRewardRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassFrozen return the QueryClassFrozen cobra command.
+func CmdQueryClassFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the non-fungible token class is frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the freezing is enabled in the non-fungible token class and whether the class is frozen.
+
+Example:
+$ %[1]s query asset-nft class-frozen [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassFrozen(cmd.Context(), &types.QueryClassFrozenRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryClassWhitelistedAccounts return the QueryClassWhitelistedAccounts cobra command.
+func CmdQueryClassWhitelistedAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-whitelisted-accounts [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the accounts allowed to receive the tokens in the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the whitelisting is enabled in the non-fungible token class and the accounts allowed to receive its tokens.
+
+Example:
+$ %[1]s query asset-nft class-whitelisted-accounts [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.ClassWhitelistedAccounts(cmd.Context(), &types.QueryClassWhitelistedAccountsRequest{
+				ClassId:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "class whitelisted accounts")
+
+	return cmd
+}
+
+// CmdQueryClassRoyaltyRate return the QueryClassRoyaltyRate cobra command.
+func CmdQueryClassRoyaltyRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-royalty-rate [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the royalty rate of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rate of the sale price paid to the owner of the non-fungible token class.
+
+Example:
+$ %[1]s query asset-nft class-royalty-rate [class-id]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClassRoyaltyRate(cmd.Context(), &types.QueryClassRoyaltyRateRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryOwnershipProof return the QueryOwnershipProof cobra command.
+func CmdQueryOwnershipProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership-proof [class-id] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the store proof of the non-fungible token owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the owner of the non-fungible token together with the ICS-23 proof of the owner stored in the nft store.
+The proof taken at the height is verified against the app hash of the header at the next height, so it can be
+verified offline by anyone trusting the header.
+
+Example:
+$ %[1]s query asset-nft ownership-proof [class-id] [id] --height [height]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			proof, err := QueryOwnershipProof(clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(proof)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryOwnershipProof queries the owner of the non-fungible token with the proof at the height set in the client
+// context, the latest height is used if it's not set.
+func QueryOwnershipProof(clientCtx client.Context, classID, id string) (types.OwnershipProof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", nftkeeper.StoreKey),
+		Data:   nftkeeper.OwnerStoreKey(classID, id),
+		Height: clientCtx.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return types.OwnershipProof{}, err
+	}
+	if len(res.Value) == 0 {
+		return types.OwnershipProof{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %q of class %q not found", id, classID)
+	}
+
+	return types.OwnershipProof{
+		ClassID: classID,
+		ID:      id,
+		Owner:   sdk.AccAddress(res.Value).String(),
+		Height:  res.Height,
+		Proof:   res.ProofOps,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/testutil/network"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/client/cli"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+)
+
+func TestQueryClassAndNFTs(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	args := []string{symbol, "class name", "class description", "https://my-class-meta.invalid/1", "content-hash", "--freezing"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdTxIssueClass(), args)
+	requireT.NoError(err)
+
+	classID := types.BuildClassID(symbol, validator.Address)
+	args = []string{classID, "nft-1", "https://my-nft-meta.invalid/1", "9309e7e6e96150afbf181d308fe88343ab1cbec391b7717150a7fb217b4cf0a9"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdTxMint(), args)
+	requireT.NoError(err)
+
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClass(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	var class types.Class
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &class))
+	requireT.Equal(classID, class.ID)
+	requireT.Equal(validator.Address.String(), class.Owner)
+	requireT.Equal("class name", class.Name)
+	requireT.True(class.Freezing)
+	requireT.False(class.Soulbound)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClasses(), []string{
+		fmt.Sprintf("--issuer=%s", validator.Address.String()), "--output", "json",
+	})
+	requireT.NoError(err)
+	var classesRes types.QueryClassesResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &classesRes))
+	requireT.Len(classesRes.Classes, 1)
+	requireT.Equal(classID, classesRes.Classes[0].ID)
+
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryClasses(), []string{"--output", "json"})
+	requireT.Error(err)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryNFT(), []string{classID, "nft-1", "--output", "json"})
+	requireT.NoError(err)
+	var nftRes nft.QueryNFTResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &nftRes))
+	requireT.Equal("https://my-nft-meta.invalid/1", nftRes.Nft.Uri)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryNFTs(), []string{
+		classID, fmt.Sprintf("--owner=%s", validator.Address.String()), "--output", "json",
+	})
+	requireT.NoError(err)
+	var nftsRes nft.QueryNFTsResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &nftsRes))
+	requireT.Len(nftsRes.Nfts, 1)
+	requireT.Equal("nft-1", nftsRes.Nfts[0].Id)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryOwner(), []string{classID, "nft-1", "--output", "json"})
+	requireT.NoError(err)
+	var ownerRes nft.QueryOwnerResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &ownerRes))
+	requireT.Equal(validator.Address.String(), ownerRes.Owner)
+
+	buf, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQuerySupply(), []string{classID, "--output", "json"})
+	requireT.NoError(err)
+	var supplyRes nft.QuerySupplyResponse
+	requireT.NoError(ctx.Codec.UnmarshalJSON(buf.Bytes(), &supplyRes))
+	requireT.Equal(uint64(1), supplyRes.Amount)
+}
@@ -2,11 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -14,6 +18,18 @@ import (
 	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
 )
 
+// Flags defined on transactions
+const (
+	buyerFlag            = "buyer"
+	expirationHeightFlag = "expiration-height"
+	provenanceFlag       = "provenance"
+	freezingFlag         = "freezing"
+	revocableFlag        = "revocable"
+	whitelistingFlag     = "whitelisting"
+	royaltyRateFlag      = "royalty-rate"
+	soulboundFlag        = "soulbound"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +43,24 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdTxIssueClass(),
 		CmdTxMint(),
+		CmdTxReserveIDPrefix(),
+		CmdTxSignSaleOffer(),
+		CmdTxTransferWithPayment(),
+		CmdTxCancelSaleOffer(),
+		CmdTxGrantUser(),
+		CmdTxRevokeUser(),
+		CmdTxTransferClassOwnership(),
+		CmdTxAcceptClassOwnership(),
+		CmdTxCreateRewardPool(),
+		CmdTxFundRewardPool(),
+		CmdTxLockNFT(),
+		CmdTxUnlockNFT(),
+		CmdTxClassFreeze(),
+		CmdTxClassUnfreeze(),
+		CmdTxRevokeNFT(),
+		CmdTxAddToClassWhitelist(),
+		CmdTxRemoveFromClassWhitelist(),
+		CmdTxBurnNFT(),
 	)
 
 	return cmd
@@ -42,7 +76,7 @@ func CmdTxIssueClass() *cobra.Command {
 			fmt.Sprintf(`Issue new non-fungible token class.
 
 Example:
-$ %s tx asset-nft issue-class abc "ABC Name" "ABC class description." https://my-class-meta.invalid/1 e000624 --from [issuer]
+$ %s tx asset-nft issue-class abc "ABC Name" "ABC class description." https://my-class-meta.invalid/1 e000624 --provenance --freezing --royalty-rate=0.05 --from [issuer]
 `,
 				version.AppName,
 			),
@@ -59,14 +93,51 @@ $ %s tx asset-nft issue-class abc "ABC Name" "ABC class description." https://my
 			description := args[2]
 			uri := args[3]
 			uriHash := args[4]
+			provenance, err := cmd.Flags().GetBool(provenanceFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			freezing, err := cmd.Flags().GetBool(freezingFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			revocable, err := cmd.Flags().GetBool(revocableFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			whitelisting, err := cmd.Flags().GetBool(whitelistingFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			soulbound, err := cmd.Flags().GetBool(soulboundFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			royaltyRate := sdk.NewDec(0)
+			royaltyRateStr, err := cmd.Flags().GetString(royaltyRateFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(royaltyRateStr) > 0 {
+				royaltyRate, err = sdk.NewDecFromStr(royaltyRateStr)
+				if err != nil {
+					return errors.Wrapf(err, "invalid royalty-rate")
+				}
+			}
 
 			msg := &types.MsgIssueClass{
-				Issuer:      issuer.String(),
-				Symbol:      symbol,
-				Name:        name,
-				Description: description,
-				URI:         uri,
-				URIHash:     uriHash,
+				Issuer:       issuer.String(),
+				Symbol:       symbol,
+				Name:         name,
+				Description:  description,
+				URI:          uri,
+				URIHash:      uriHash,
+				Provenance:   provenance,
+				Freezing:     freezing,
+				Revocable:    revocable,
+				Whitelisting: whitelisting,
+				RoyaltyRate:  royaltyRate,
+				Soulbound:    soulbound,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -74,6 +145,12 @@ $ %s tx asset-nft issue-class abc "ABC Name" "ABC class description." https://my
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(provenanceFlag, false, "Record the provenance of the tokens in the class")
+	cmd.Flags().Bool(freezingFlag, false, "Allow the class owner to freeze the transfers of all the tokens in the class")
+	cmd.Flags().Bool(revocableFlag, false, "Allow the class owner to burn the tokens in the class held by any account")
+	cmd.Flags().Bool(whitelistingFlag, false, "Allow only the accounts whitelisted by the class owner to receive the tokens in the class")
+	cmd.Flags().String(royaltyRateFlag, "", "Rate of the sale price paid to the class owner, a number between 0 and 1")
+	cmd.Flags().Bool(soulboundFlag, false, "Make the tokens in the class non-transferable once they are delivered by the class owner")
 
 	return cmd
 }
@@ -121,3 +198,729 @@ $ %s tx asset-nft mint abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 ht
 
 	return cmd
 }
+
+// CmdTxReserveIDPrefix returns ReserveIDPrefix cobra command.
+func CmdTxReserveIDPrefix() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-id-prefix [class-id] [prefix] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Reserve non-fungible token ID prefix in the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reserve non-fungible token ID prefix in the class.
+Once the class reserves any prefix, the tokens with IDs containing the prefix (the part of the ID up to and including the first "/") might be minted only if the prefix is reserved.
+Minting rights for the reserved prefix might be granted to other accounts using the authz module and MintAuthorization.
+
+Example:
+$ %s tx asset-nft reserve-id-prefix abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 gen1/ --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			prefix := args[1]
+
+			msg := &types.MsgReserveIDPrefix{
+				Sender:  sender.String(),
+				ClassID: classID,
+				Prefix:  prefix,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSignSaleOffer returns the cobra command signing the sale offer.
+func CmdTxSignSaleOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-sale-offer [class-id] [id] [price] --from [seller]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Sign the offer to sell the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign the offer to sell the non-fungible token offline and print the signed offer to be accepted by the buyer.
+If the buyer is not set, anyone can accept the offer.
+
+Example:
+$ %s tx asset-nft sign-sale-offer abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 1000000udevcore --buyer [buyer] --expiration-height 100000 --from [seller] > offer.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid price")
+			}
+			buyer, err := cmd.Flags().GetString(buyerFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			expirationHeight, err := cmd.Flags().GetUint64(expirationHeightFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			offer := types.SaleOffer{
+				Seller:           clientCtx.GetFromAddress().String(),
+				ClassID:          args[0],
+				ID:               args[1],
+				Price:            price,
+				Buyer:            buyer,
+				ExpirationHeight: expirationHeight,
+			}
+			if err := offer.ValidateBasic(); err != nil {
+				return err
+			}
+
+			signature, pubKey, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), types.SaleOfferSignBytes(clientCtx.ChainID, offer))
+			if err != nil {
+				return errors.Wrap(err, "can't sign the offer")
+			}
+
+			return clientCtx.PrintProto(&types.SignedSaleOffer{
+				Offer:     offer,
+				PubKey:    pubKey.Bytes(),
+				Signature: signature,
+			})
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(buyerFlag, "", "The only account allowed to accept the offer")
+	cmd.Flags().Uint64(expirationHeightFlag, 0, "The last block height the offer can be accepted at, zero means the offer never expires")
+
+	return cmd
+}
+
+// CmdTxTransferWithPayment returns TransferWithPayment cobra command.
+func CmdTxTransferWithPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-with-payment [offer_file] --from [buyer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Buy the non-fungible token accepting the sale offer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy the non-fungible token accepting the sale offer signed by the seller using the sign-sale-offer command.
+The price is paid to the seller and the token is transferred to the buyer atomically.
+
+Example:
+$ %s tx asset-nft transfer-with-payment offer.json --from [buyer]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "can't read offer file %s", args[0])
+			}
+			var offer types.SignedSaleOffer
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &offer); err != nil {
+				return errors.Wrapf(err, "invalid offer file %s", args[0])
+			}
+
+			msg := &types.MsgTransferWithPayment{
+				Sender: clientCtx.GetFromAddress().String(),
+				Offer:  offer,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCancelSaleOffer returns CancelSaleOffer cobra command.
+func CmdTxCancelSaleOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-sale-offer [offer_file] --from [seller]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the sale offer of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the sale offer signed by the seller using the sign-sale-offer command, so it can't be accepted anymore.
+
+Example:
+$ %s tx asset-nft cancel-sale-offer offer.json --from [seller]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return errors.Wrapf(err, "can't read offer file %s", args[0])
+			}
+			var offer types.SignedSaleOffer
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &offer); err != nil {
+				return errors.Wrapf(err, "invalid offer file %s", args[0])
+			}
+
+			msg := &types.MsgCancelSaleOffer{
+				Sender: clientCtx.GetFromAddress().String(),
+				Offer:  offer.Offer,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGrantUser returns GrantUser cobra command.
+func CmdTxGrantUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-user [class-id] [id] [user] [expiration] --from [owner]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Grant the time-bound right to use the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the right to use the non-fungible token to the user until the expiration time without transferring the ownership.
+The expiration time is provided in the RFC3339 format.
+
+Example:
+$ %s tx asset-nft grant-user abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 [user] 2023-01-01T00:00:00Z --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid expiration")
+			}
+
+			msg := &types.MsgGrantUser{
+				Sender:     clientCtx.GetFromAddress().String(),
+				ClassID:    args[0],
+				ID:         args[1],
+				User:       args[2],
+				Expiration: expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevokeUser returns RevokeUser cobra command.
+func CmdTxRevokeUser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-user [class-id] [id] --from [user]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Give up the right to use the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Give up the right to use the non-fungible token before it expires.
+
+Example:
+$ %s tx asset-nft revoke-user abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 --from [user]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRevokeUser{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				ID:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxTransferClassOwnership returns TransferClassOwnership cobra command.
+func CmdTxTransferClassOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-class-ownership [class-id] [new-owner] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Propose the new owner of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Propose the new owner of the non-fungible token class. The ownership is transferred when the new owner accepts it.
+
+Example:
+$ %s tx asset-nft transfer-class-ownership abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgTransferClassOwnership{
+				Sender:   clientCtx.GetFromAddress().String(),
+				ClassID:  args[0],
+				NewOwner: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxAcceptClassOwnership returns AcceptClassOwnership cobra command.
+func CmdTxAcceptClassOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-class-ownership [class-id] --from [new-owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Accept the ownership of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept the ownership of the non-fungible token class proposed by its current owner.
+
+Example:
+$ %s tx asset-nft accept-class-ownership abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [new-owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgAcceptClassOwnership{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCreateRewardPool returns CreateRewardPool cobra command.
+func CmdTxCreateRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-reward-pool [class-id] [reward-per-block] [min-lock-duration] --from [owner]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create the pool rewarding the holders locking the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create the pool distributing the reward per block among the locked non-fungible tokens of the class.
+The token might be unlocked after it has been locked for the minimum duration.
+
+Example:
+$ %s tx asset-nft create-reward-pool abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 100ucore 168h --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			rewardPerBlock, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid reward per block")
+			}
+
+			minLockDuration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid min lock duration")
+			}
+
+			msg := &types.MsgCreateRewardPool{
+				Sender:          clientCtx.GetFromAddress().String(),
+				ClassID:         args[0],
+				RewardPerBlock:  rewardPerBlock,
+				MinLockDuration: minLockDuration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxFundRewardPool returns FundRewardPool cobra command.
+func CmdTxFundRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reward-pool [class-id] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Fund the reward pool of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the amount to the reward pool of the non-fungible token class.
+
+Example:
+$ %s tx asset-nft fund-reward-pool abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 100000ucore --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgFundRewardPool{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				Amount:  amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxLockNFT returns LockNFT cobra command.
+func CmdTxLockNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock [class-id] [id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Lock the non-fungible token to earn the rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock the non-fungible token to earn the rewards from the pool of its class. The locked token can't be transferred.
+
+Example:
+$ %s tx asset-nft lock abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgLockNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				ID:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUnlockNFT returns UnlockNFT cobra command.
+func CmdTxUnlockNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock [class-id] [id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unlock the non-fungible token and receive the earned rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unlock the non-fungible token locked for the minimum duration of the pool and receive the earned rewards.
+
+Example:
+$ %s tx asset-nft unlock abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUnlockNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				ID:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassFreeze returns ClassFreeze cobra command.
+func CmdTxClassFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-freeze [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Freeze the transfers of all the tokens in the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze the transfers of all the tokens in the non-fungible token class issued with the freezing feature.
+
+Example:
+$ %s tx asset-nft class-freeze abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgClassFreeze{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassUnfreeze returns ClassUnfreeze cobra command.
+func CmdTxClassUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-unfreeze [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unfreeze the transfers of the tokens in the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze the transfers of the tokens in the frozen non-fungible token class.
+
+Example:
+$ %s tx asset-nft class-unfreeze abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgClassUnfreeze{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevokeNFT returns RevokeNFT cobra command.
+func CmdTxRevokeNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [class-id] [id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Burn the non-fungible token held by any account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the non-fungible token held by any account. Only the owner of the class issued with the revocable
+or the soulbound feature can revoke the tokens.
+
+Example:
+$ %s tx asset-nft revoke abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRevokeNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				ID:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxAddToClassWhitelist returns AddToClassWhitelist cobra command.
+func CmdTxAddToClassWhitelist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-class-whitelist [class-id] [account] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Allow the account to receive the tokens in the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Allow the account to receive the tokens in the non-fungible token class issued with the whitelisting feature.
+
+Example:
+$ %s tx asset-nft add-to-class-whitelist abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgAddToClassWhitelist{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveFromClassWhitelist returns RemoveFromClassWhitelist cobra command.
+func CmdTxRemoveFromClassWhitelist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-class-whitelist [class-id] [account] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Disallow the account to receive the tokens in the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disallow the account to receive the tokens in the non-fungible token class. The tokens already held by the account are not affected.
+
+Example:
+$ %s tx asset-nft remove-from-class-whitelist abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq --from [owner]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRemoveFromClassWhitelist{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBurnNFT returns BurnNFT cobra command.
+func CmdTxBurnNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [id] --from [holder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Burn the non-fungible token of the soulbound class held by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the non-fungible token of the soulbound class held by the sender. The soulbound token can't be
+transferred, so burning is the only way for the holder to get rid of it.
+
+Example:
+$ %s tx asset-nft burn abc-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8 id1 --from [holder]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgBurnNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassID: args[0],
+				ID:      args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
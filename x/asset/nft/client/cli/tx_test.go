@@ -1,10 +1,8 @@
 package cli_test
 
 import (
-	"fmt"
 	"testing"
 
-	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
@@ -34,10 +32,5 @@ func TestCmdTxIssueClass(t *testing.T) {
 }
 
 func txValidator1Args(testNetwork *network.Network) []string {
-	return []string{
-		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
-		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(testNetwork.Config.BondDenom, sdk.NewInt(1000000))).String()),
-		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-	}
+	return network.TxArgs(testNetwork, testNetwork.Validators[0].Address)
 }
@@ -0,0 +1,23 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// InitGenesis initializes the asset nft module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Params.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the asset nft module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+}
@@ -0,0 +1,31 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestImportAndExportGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	genState := types.GenesisState{
+		Params: types.Params{
+			MaxClassDataSize: 100,
+			MaxNFTDataSize:   200,
+		},
+	}
+	nft.InitGenesis(ctx, testApp.AssetNFTKeeper, genState)
+	requireT.Equal(genState.Params, testApp.AssetNFTKeeper.GetParams(ctx))
+
+	exportedGenState := nft.ExportGenesis(ctx, testApp.AssetNFTKeeper)
+	requireT.Equal(genState, *exportedGenState)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+var issuerClassStoreVal = []byte{0x01}
+
+// GetClass returns the non-fungible token class together with its features.
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, error) {
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return types.Class{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "class %q not found", classID)
+	}
+
+	issuer, err := types.DeconstructClassID(classID)
+	if err != nil {
+		return types.Class{}, err
+	}
+	owner, err := k.GetClassOwner(ctx, classID)
+	if err != nil {
+		return types.Class{}, err
+	}
+
+	return types.Class{
+		ID:           class.Id,
+		Issuer:       issuer.String(),
+		Owner:        owner.String(),
+		Name:         class.Name,
+		Symbol:       class.Symbol,
+		Description:  class.Description,
+		URI:          class.Uri,
+		URIHash:      class.UriHash,
+		Data:         class.Data,
+		Provenance:   k.IsProvenanceEnabled(ctx, classID),
+		Freezing:     k.IsFreezingEnabled(ctx, classID),
+		Frozen:       k.IsClassFrozen(ctx, classID),
+		Revocable:    k.IsRevocable(ctx, classID),
+		Whitelisting: k.IsWhitelistingEnabled(ctx, classID),
+		Soulbound:    k.IsSoulbound(ctx, classID),
+		RoyaltyRate:  k.GetClassRoyaltyRate(ctx, classID),
+	}, nil
+}
+
+// GetIssuerClasses returns the non-fungible token classes issued by the account ordered by the class ID.
+func (k Keeper) GetIssuerClasses(
+	ctx sdk.Context,
+	issuer sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.Class, *query.PageResponse, error) {
+	classes := []types.Class{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateIssuerClassesPrefix(issuer))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		class, err := k.GetClass(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		classes = append(classes, class)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return classes, pageRes, nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_GetClass(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newOwner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	_, err := nftKeeper.GetClass(ctx, types.BuildClassID("symbol", issuer))
+	requireT.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		Name:        "name",
+		Description: "description",
+		URI:         "https://my-class-meta.invalid/1",
+		URIHash:     "content-hash",
+		Freezing:    true,
+		Soulbound:   true,
+		RoyaltyRate: sdk.MustNewDecFromStr("0.05"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.TransferClassOwnership(ctx, types.TransferClassOwnershipSettings{
+		Sender:   issuer,
+		ClassID:  classID,
+		NewOwner: newOwner,
+	}))
+	requireT.NoError(nftKeeper.AcceptClassOwnership(ctx, types.AcceptClassOwnershipSettings{
+		Sender:  newOwner,
+		ClassID: classID,
+	}))
+
+	class, err := nftKeeper.GetClass(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(classID, class.ID)
+	requireT.Equal(issuer.String(), class.Issuer)
+	requireT.Equal(newOwner.String(), class.Owner)
+	requireT.Equal("symbol", class.Symbol)
+	requireT.Equal("name", class.Name)
+	requireT.Equal("description", class.Description)
+	requireT.Equal("https://my-class-meta.invalid/1", class.URI)
+	requireT.Equal("content-hash", class.URIHash)
+	requireT.True(class.Freezing)
+	requireT.False(class.Frozen)
+	requireT.True(class.Soulbound)
+	requireT.False(class.Provenance)
+	requireT.False(class.Revocable)
+	requireT.False(class.Whitelisting)
+	requireT.Equal(sdk.MustNewDecFromStr("0.05").String(), class.RoyaltyRate.String())
+}
+
+func TestKeeper_GetIssuerClasses(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherIssuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	for _, symbol := range []string{"symbolc", "symbola", "symbolb"} {
+		_, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{Issuer: issuer, Symbol: symbol})
+		requireT.NoError(err)
+	}
+	_, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{Issuer: otherIssuer, Symbol: "symbold"})
+	requireT.NoError(err)
+
+	// the classes are ordered by the class ID and paginated
+	classes, pageRes, err := nftKeeper.GetIssuerClasses(ctx, issuer, &query.PageRequest{Limit: 2, CountTotal: true})
+	requireT.NoError(err)
+	requireT.Equal(uint64(3), pageRes.Total)
+	requireT.Len(classes, 2)
+	requireT.Equal(types.BuildClassID("symbola", issuer), classes[0].ID)
+	requireT.Equal(types.BuildClassID("symbolb", issuer), classes[1].ID)
+
+	classes, pageRes, err = nftKeeper.GetIssuerClasses(ctx, issuer, &query.PageRequest{Key: pageRes.NextKey})
+	requireT.NoError(err)
+	requireT.Nil(pageRes.NextKey)
+	requireT.Len(classes, 1)
+	requireT.Equal(types.BuildClassID("symbolc", issuer), classes[0].ID)
+
+	classes, _, err = nftKeeper.GetIssuerClasses(ctx, otherIssuer, nil)
+	requireT.NoError(err)
+	requireT.Len(classes, 1)
+	requireT.Equal(otherIssuer.String(), classes[0].Issuer)
+
+	classes, _, err = nftKeeper.GetIssuerClasses(ctx, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), nil)
+	requireT.NoError(err)
+	requireT.Empty(classes)
+}
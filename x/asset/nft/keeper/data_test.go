@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	codetypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_ParamsNotStored(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	// the params are missing in the store of the chain started before they were introduced
+	paramsStore := prefix.NewStore(ctx.KVStore(testApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{types.KeyMaxClassDataSize, types.KeyMaxNFTDataSize} {
+		requireT.True(paramsStore.Has(key))
+		paramsStore.Delete(key)
+	}
+
+	requireT.Equal(types.DefaultParams(), nftKeeper.GetParams(ctx))
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: addr,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  addr,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+}
+
+func TestKeeper_DataLimits(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	requireT.Equal(types.DefaultParams(), nftKeeper.GetParams(ctx))
+	nftKeeper.SetParams(ctx, types.Params{
+		MaxClassDataSize: 10,
+		MaxNFTDataSize:   20,
+	})
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	data := func(size int) *codetypes.Any {
+		dataValue, err := codetypes.NewAnyWithValue(&gogotypes.BytesValue{Value: make([]byte, size-2)})
+		requireT.NoError(err)
+		requireT.Len(dataValue.Value, size)
+		return dataValue
+	}
+
+	// the data of the class can't exceed the limit
+	classSettings := types.IssueClassSettings{
+		Issuer: addr,
+		Symbol: "symbol",
+		Data:   data(11),
+	}
+	_, err := nftKeeper.IssueClass(ctx, classSettings)
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	classSettings.Data = data(10)
+	classID, err := nftKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	// the data of the nft has its own limit
+	settings := types.MintSettings{
+		Sender:  addr,
+		ClassID: classID,
+		ID:      "my-id",
+		Data:    data(21),
+	}
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.Mint(ctx, settings)))
+
+	settings.Data = data(20)
+	requireT.NoError(nftKeeper.Mint(ctx, settings))
+
+	// the data envelope must be valid
+	envelope, err := codetypes.NewAnyWithValue(&types.DataEnvelope{KeyID: "key"})
+	requireT.NoError(err)
+	settings.ID = "my-id-2"
+	settings.Data = envelope
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.Mint(ctx, settings)))
+
+	envelope, err = codetypes.NewAnyWithValue(&types.DataEnvelope{
+		EncryptionScheme: "ecies-secp256k1",
+		Data:             []byte{0x01},
+	})
+	requireT.NoError(err)
+	settings.Data = envelope
+	requireT.NoError(nftKeeper.Mint(ctx, settings))
+
+	nft, found := testApp.NFTKeeper.GetNFT(ctx, classID, settings.ID)
+	requireT.True(found)
+	var storedEnvelope types.DataEnvelope
+	requireT.NoError(storedEnvelope.Unmarshal(nft.Data.Value))
+	requireT.Equal("ecies-secp256k1", storedEnvelope.EncryptionScheme)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+var (
+	freezingClassStoreVal = []byte{0x01}
+	frozenClassStoreVal   = []byte{0x01}
+)
+
+// ClassFreeze freezes the transfers of all the tokens in the class. Only the owner of the class issued with
+// the freezing feature can freeze it.
+func (k Keeper) ClassFreeze(ctx sdk.Context, settings types.ClassFreezeSettings) error {
+	owner, err := k.checkFreezingAllowed(ctx, settings)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetFrozenClassKey(settings.ClassID), frozenClassStoreVal)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, owner.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassFrozen{
+		ClassID: settings.ClassID,
+		Owner:   owner.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventClassFrozen: %s", err)
+	}
+
+	return nil
+}
+
+// ClassUnfreeze unfreezes the transfers of the tokens in the class.
+func (k Keeper) ClassUnfreeze(ctx sdk.Context, settings types.ClassFreezeSettings) error {
+	owner, err := k.checkFreezingAllowed(ctx, settings)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetFrozenClassKey(settings.ClassID))
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, owner.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassUnfrozen{
+		ClassID: settings.ClassID,
+		Owner:   owner.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventClassUnfrozen: %s", err)
+	}
+
+	return nil
+}
+
+// IsFreezingEnabled returns true if the class owner is allowed to freeze the class.
+func (k Keeper) IsFreezingEnabled(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetFreezingClassKey(classID))
+}
+
+// IsClassFrozen returns true if the transfers of the tokens in the class are frozen.
+func (k Keeper) IsClassFrozen(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetFrozenClassKey(classID))
+}
+
+func (k Keeper) enableFreezing(ctx sdk.Context, classID string) {
+	ctx.KVStore(k.storeKey).Set(types.GetFreezingClassKey(classID), freezingClassStoreVal)
+}
+
+func (k Keeper) checkFreezingAllowed(ctx sdk.Context, settings types.ClassFreezeSettings) (sdk.AccAddress, error) {
+	owner, err := k.GetClassOwner(ctx, settings.ClassID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(settings.Sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to freeze the class", settings.Sender.String())
+	}
+	if !k.IsFreezingEnabled(ctx, settings.ClassID) {
+		return nil, sdkerrors.Wrapf(types.ErrFeatureNotActive, "freezing is not enabled in class %q", settings.ClassID)
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_ClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "symbol",
+		Freezing: true,
+	})
+	requireT.NoError(err)
+	requireT.True(nftKeeper.IsFreezingEnabled(ctx, classID))
+	requireT.False(nftKeeper.IsClassFrozen(ctx, classID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "id1"}))
+
+	// the class without the freezing feature can't be frozen
+	notFreezableClassID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "notfreezable",
+	})
+	requireT.NoError(err)
+	requireT.False(nftKeeper.IsFreezingEnabled(ctx, notFreezableClassID))
+	requireT.True(types.ErrFeatureNotActive.Is(nftKeeper.ClassFreeze(ctx, types.ClassFreezeSettings{
+		Sender:  issuer,
+		ClassID: notFreezableClassID,
+	})))
+
+	// the class which doesn't exist can't be frozen
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.ClassFreeze(ctx, types.ClassFreezeSettings{
+		Sender:  issuer,
+		ClassID: types.BuildClassID("missing", issuer),
+	})))
+
+	// only the owner can freeze and unfreeze the class
+	settings := types.ClassFreezeSettings{Sender: issuer, ClassID: classID}
+	invalidSettings := types.ClassFreezeSettings{Sender: recipient, ClassID: classID}
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.ClassFreeze(ctx, invalidSettings)))
+	requireT.NoError(nftKeeper.ClassFreeze(ctx, settings))
+	requireT.True(nftKeeper.IsClassFrozen(ctx, classID))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.ClassUnfreeze(ctx, invalidSettings)))
+
+	// the tokens of the frozen class can't be transferred, even by the owner
+	requireT.True(types.ErrClassFrozen.Is(testApp.NFTKeeper.Transfer(ctx, classID, "id1", recipient)))
+
+	// the tokens are transferable once the class is unfrozen
+	requireT.NoError(nftKeeper.ClassUnfreeze(ctx, settings))
+	requireT.False(nftKeeper.IsClassFrozen(ctx, classID))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", recipient))
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/pkg/grpcerrors"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetClass(ctx sdk.Context, classID string) (types.Class, error)
+	GetIssuerClasses(ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest) ([]types.Class, *query.PageResponse, error)
+	GetUserGrant(ctx sdk.Context, classID, id string) (types.UserGrant, bool)
+	GetProvenanceRecords(ctx sdk.Context, classID, id string, pagination *query.PageRequest) ([]types.ProvenanceRecord, *query.PageResponse, error)
+	GetClassOwner(ctx sdk.Context, classID string) (sdk.AccAddress, error)
+	GetPendingClassOwner(ctx sdk.Context, classID string) (sdk.AccAddress, bool)
+	GetRewardPool(ctx sdk.Context, classID string) (types.RewardPool, bool)
+	GetPendingReward(ctx sdk.Context, classID, id string) (types.NFTLock, sdk.Coin, error)
+	IsFreezingEnabled(ctx sdk.Context, classID string) bool
+	IsClassFrozen(ctx sdk.Context, classID string) bool
+	IsWhitelistingEnabled(ctx sdk.Context, classID string) bool
+	GetClassWhitelistedAccounts(ctx sdk.Context, classID string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
+	GetClassRoyaltyRate(ctx sdk.Context, classID string) sdk.Dec
+}
+
+// QueryService serves grpc query requests for assetsnft module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params queries the parameters of x/asset/nft module.
+func (qs QueryService) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(goCtx)),
+	}, nil
+}
+
+// Class returns the non-fungible token class together with its features.
+func (qs QueryService) Class(goCtx context.Context, req *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	class, err := qs.keeper.GetClass(sdk.UnwrapSDKContext(goCtx), req.GetClassId())
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryClassResponse{
+		Class: class,
+	}, nil
+}
+
+// Classes returns the non-fungible token classes issued by the account.
+func (qs QueryService) Classes(goCtx context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	issuer, err := sdk.AccAddressFromBech32(req.GetIssuer())
+	if err != nil {
+		return nil, grpcerrors.InvalidArgument(sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid issuer address"))
+	}
+
+	classes, pageRes, err := qs.keeper.GetIssuerClasses(sdk.UnwrapSDKContext(goCtx), issuer, req.GetPagination())
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryClassesResponse{
+		Classes:    classes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// User returns the active user of the non-fungible token.
+func (qs QueryService) User(goCtx context.Context, req *types.QueryUserRequest) (*types.QueryUserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grant, found := qs.keeper.GetUserGrant(ctx, req.GetClassId(), req.GetId())
+	if !found {
+		return nil, grpcerrors.NotFound(sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %q of class %q is not used by anyone", req.GetId(), req.GetClassId()))
+	}
+
+	return &types.QueryUserResponse{
+		Grant: grant,
+	}, nil
+}
+
+// Provenance returns the provenance records of the non-fungible token.
+func (qs QueryService) Provenance(goCtx context.Context, req *types.QueryProvenanceRequest) (*types.QueryProvenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	records, pageRes, err := qs.keeper.GetProvenanceRecords(ctx, req.GetClassId(), req.GetId(), req.Pagination)
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryProvenanceResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ClassOwner returns the owner of the non-fungible token class and the address the ownership is proposed to.
+func (qs QueryService) ClassOwner(goCtx context.Context, req *types.QueryClassOwnerRequest) (*types.QueryClassOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := qs.keeper.GetClassOwner(ctx, req.GetClassId())
+	if err != nil {
+		// the only error returned by the keeper is the missing class
+		return nil, grpcerrors.NotFound(err)
+	}
+
+	res := &types.QueryClassOwnerResponse{
+		Owner: owner.String(),
+	}
+	if pendingOwner, found := qs.keeper.GetPendingClassOwner(ctx, req.GetClassId()); found {
+		res.PendingOwner = pendingOwner.String()
+	}
+
+	return res, nil
+}
+
+// RewardPool returns the reward pool of the non-fungible token class.
+func (qs QueryService) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, found := qs.keeper.GetRewardPool(ctx, req.GetClassId())
+	if !found {
+		return nil, grpcerrors.NotFound(sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward pool of class %q not found", req.GetClassId()))
+	}
+
+	return &types.QueryRewardPoolResponse{
+		Pool: pool,
+	}, nil
+}
+
+// PendingReward returns the lock of the non-fungible token and the reward earned by it so far.
+func (qs QueryService) PendingReward(goCtx context.Context, req *types.QueryPendingRewardRequest) (*types.QueryPendingRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lock, reward, err := qs.keeper.GetPendingReward(ctx, req.GetClassId(), req.GetId())
+	if err != nil {
+		return nil, queryError(err)
+	}
+
+	return &types.QueryPendingRewardResponse{
+		Lock:   lock,
+		Reward: reward,
+	}, nil
+}
+
+// ClassFrozen returns whether the freezing is enabled in the non-fungible token class and whether the class is frozen.
+func (qs QueryService) ClassFrozen(goCtx context.Context, req *types.QueryClassFrozenRequest) (*types.QueryClassFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := qs.keeper.GetClassOwner(ctx, req.GetClassId()); err != nil {
+		// the only error returned by the keeper is the missing class
+		return nil, grpcerrors.NotFound(err)
+	}
+
+	return &types.QueryClassFrozenResponse{
+		Freezing: qs.keeper.IsFreezingEnabled(ctx, req.GetClassId()),
+		Frozen:   qs.keeper.IsClassFrozen(ctx, req.GetClassId()),
+	}, nil
+}
+
+// ClassWhitelistedAccounts returns the accounts allowed to receive the non-fungible tokens of the class.
+func (qs QueryService) ClassWhitelistedAccounts(
+	goCtx context.Context,
+	req *types.QueryClassWhitelistedAccountsRequest,
+) (*types.QueryClassWhitelistedAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := qs.keeper.GetClassOwner(ctx, req.GetClassId()); err != nil {
+		// the only error returned by the keeper is the missing class
+		return nil, grpcerrors.NotFound(err)
+	}
+
+	accounts, pageRes, err := qs.keeper.GetClassWhitelistedAccounts(ctx, req.GetClassId(), req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassWhitelistedAccountsResponse{
+		Whitelisting: qs.keeper.IsWhitelistingEnabled(ctx, req.GetClassId()),
+		Accounts:     accounts,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// ClassRoyaltyRate returns the rate of the price paid to the class owner on each sale of the non-fungible tokens.
+func (qs QueryService) ClassRoyaltyRate(
+	goCtx context.Context,
+	req *types.QueryClassRoyaltyRateRequest,
+) (*types.QueryClassRoyaltyRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := qs.keeper.GetClassOwner(ctx, req.GetClassId()); err != nil {
+		// the only error returned by the keeper is the missing class
+		return nil, grpcerrors.NotFound(err)
+	}
+
+	return &types.QueryClassRoyaltyRateResponse{
+		RoyaltyRate: qs.keeper.GetClassRoyaltyRate(ctx, req.GetClassId()),
+	}, nil
+}
+
+// queryError converts the error returned by the keeper into the status error with the code the clients may branch on.
+func queryError(err error) error {
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return grpcerrors.NotFound(err)
+	case types.ErrInvalidInput.Is(err), types.ErrInvalidID.Is(err), sdkerrors.ErrInvalidRequest.Is(err):
+		return grpcerrors.InvalidArgument(err)
+	default:
+		return err
+	}
+}
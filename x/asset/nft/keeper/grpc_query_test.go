@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/pkg/grpcerrors"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/keeper"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestQueryService_ErrorCodes(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	goCtx := sdk.WrapSDKContext(testApp.BaseApp.NewContext(false, tmproto.Header{}))
+	queryService := keeper.NewQueryService(testApp.AssetNFTKeeper)
+
+	requireCode := func(code codes.Code, codespace string, err error) {
+		requireT.Equal(code, status.Code(err), err)
+		info, ok := grpcerrors.ErrorInfo(err)
+		requireT.True(ok)
+		requireT.Equal(codespace, info.Domain)
+		requireT.Equal(codespace, info.Metadata[grpcerrors.MetadataKeyCodespace])
+	}
+
+	_, err := queryService.Class(goCtx, &types.QueryClassRequest{ClassId: "class"})
+	requireCode(codes.NotFound, sdkerrors.RootCodespace, err)
+	_, err = queryService.Classes(goCtx, &types.QueryClassesRequest{Issuer: "invalid"})
+	requireCode(codes.InvalidArgument, sdkerrors.RootCodespace, err)
+	_, err = queryService.User(goCtx, &types.QueryUserRequest{ClassId: "class", Id: "id"})
+	requireCode(codes.NotFound, sdkerrors.RootCodespace, err)
+	requireT.True(sdkerrors.ErrNotFound.Is(err))
+	_, err = queryService.ClassOwner(goCtx, &types.QueryClassOwnerRequest{ClassId: "class"})
+	requireCode(codes.NotFound, types.ModuleName, err)
+	_, err = queryService.RewardPool(goCtx, &types.QueryRewardPoolRequest{ClassId: "class"})
+	requireCode(codes.NotFound, sdkerrors.RootCodespace, err)
+	_, err = queryService.PendingReward(goCtx, &types.QueryPendingRewardRequest{ClassId: "class", Id: "id"})
+	requireCode(codes.NotFound, sdkerrors.RootCodespace, err)
+	_, err = queryService.ClassFrozen(goCtx, &types.QueryClassFrozenRequest{ClassId: "class"})
+	requireCode(codes.NotFound, types.ModuleName, err)
+	_, err = queryService.ClassWhitelistedAccounts(goCtx, &types.QueryClassWhitelistedAccountsRequest{ClassId: "class"})
+	requireCode(codes.NotFound, types.ModuleName, err)
+	_, err = queryService.ClassRoyaltyRate(goCtx, &types.QueryClassRoyaltyRateRequest{ClassId: "class"})
+	requireCode(codes.NotFound, types.ModuleName, err)
+}
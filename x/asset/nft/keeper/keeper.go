@@ -2,29 +2,78 @@ package keeper
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	codetypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
 
+var (
+	idPrefixReservedStoreVal  = []byte{0x01}
+	saleOfferAcceptedStoreVal = []byte{0x01}
+	saleOfferCanceledStoreVal = []byte{0x01}
+)
+
+// ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
+type ParamSubspace interface {
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
+
 // Keeper is the asset module non-fungible token nftKeeper.
 type Keeper struct {
-	cdc       codec.BinaryCodec
-	storeKey  sdk.StoreKey
-	nftKeeper types.NFTKeeper
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	paramSubspace ParamSubspace
+	nftKeeper     types.NFTKeeper
+	bankKeeper    types.BankKeeper
+	ftKeeper      types.FTKeeper
 }
 
 // NewKeeper creates a new instance of the Keeper.
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, nftKeeper types.NFTKeeper) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSubspace ParamSubspace,
+	nftKeeper types.NFTKeeper,
+	bankKeeper types.BankKeeper,
+	ftKeeper types.FTKeeper,
+) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		nftKeeper: nftKeeper,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramSubspace,
+		nftKeeper:     nftKeeper,
+		bankKeeper:    bankKeeper,
+		ftKeeper:      ftKeeper,
 	}
 }
 
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	// the params don't exist in the store of the chain started before they were introduced,
+	// in that case the defaults are used
+	params := types.DefaultParams()
+	k.paramSubspace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// EndBlocker deletes the expired rights to use the non-fungible tokens and distributes the rewards of the block among
+// the locked tokens.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.deleteExpiredUserGrants(ctx)
+	k.distributeRewards(ctx)
+}
+
 // IssueClass issues new non-fungible token class and returns its id.
 func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (string, error) {
 	if err := types.ValidateClassSymbol(settings.Symbol); err != nil {
@@ -36,6 +85,17 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		return "", sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
 	}
 
+	if err := validateData(settings.Data, k.GetParams(ctx).MaxClassDataSize); err != nil {
+		return "", err
+	}
+
+	if err := types.ValidateRoyaltyRate(settings.RoyaltyRate); err != nil {
+		return "", err
+	}
+	if settings.RoyaltyRate.IsNil() {
+		settings.RoyaltyRate = sdk.ZeroDec()
+	}
+
 	found := k.nftKeeper.HasClass(ctx, id)
 	if found {
 		return "", sdkerrors.Wrapf(
@@ -57,15 +117,41 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't save non-fungible token: %s", err)
 	}
+	ctx.KVStore(k.storeKey).Set(types.GetIssuerClassKey(settings.Issuer, id), issuerClassStoreVal)
+	if settings.Provenance {
+		k.enableProvenance(ctx, id)
+	}
+	if settings.Freezing {
+		k.enableFreezing(ctx, id)
+	}
+	if settings.Revocable {
+		k.enableRevocation(ctx, id)
+	}
+	if settings.Whitelisting {
+		k.enableWhitelisting(ctx, id)
+	}
+	if settings.RoyaltyRate.IsPositive() {
+		k.setClassRoyaltyRate(ctx, id, settings.RoyaltyRate)
+	}
+	if settings.Soulbound {
+		k.enableSoulbound(ctx, id)
+	}
 
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(id, settings.Issuer.String()))
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassIssued{
-		ID:          id,
-		Issuer:      settings.Issuer.String(),
-		Symbol:      settings.Symbol,
-		Name:        settings.Name,
-		Description: settings.Description,
-		URI:         settings.URI,
-		URIHash:     settings.URIHash,
+		ID:           id,
+		Issuer:       settings.Issuer.String(),
+		Symbol:       settings.Symbol,
+		Name:         settings.Name,
+		Description:  settings.Description,
+		URI:          settings.URI,
+		URIHash:      settings.URIHash,
+		Provenance:   settings.Provenance,
+		Freezing:     settings.Freezing,
+		Revocable:    settings.Revocable,
+		Whitelisting: settings.Whitelisting,
+		RoyaltyRate:  settings.RoyaltyRate,
+		Soulbound:    settings.Soulbound,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventClassIssued: %s", err)
 	}
@@ -79,12 +165,16 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
 	}
 
-	if err := validateMintingAllowed(settings.Sender, settings.ClassID); err != nil {
+	if err := k.validateMintingAllowed(ctx, settings.Sender, settings.ClassID); err != nil {
+		return err
+	}
+
+	if err := validateData(settings.Data, k.GetParams(ctx).MaxNFTDataSize); err != nil {
 		return err
 	}
 
-	if !k.nftKeeper.HasClass(ctx, settings.ClassID) {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "classID %q not found", settings.ClassID)
+	if err := k.checkIDPrefix(ctx, settings.ClassID, settings.ID); err != nil {
+		return err
 	}
 
 	if nftFound := k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID); nftFound {
@@ -104,24 +194,97 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 	return nil
 }
 
-func validateMintingAllowed(sender sdk.AccAddress, classID string) error {
-	isIssuer, err := isIssuer(sender, classID)
+// ReserveIDPrefix reserves the non-fungible token ID prefix in the class.
+// Once the class reserves any prefix, only the reserved prefixes might be used in the IDs of the minted tokens.
+func (k Keeper) ReserveIDPrefix(ctx sdk.Context, settings types.ReserveIDPrefixSettings) error {
+	if err := types.ValidateIDPrefix(settings.Prefix); err != nil {
+		return err
+	}
+
+	isOwner, err := k.isClassOwner(ctx, settings.Sender, settings.ClassID)
 	if err != nil {
 		return err
 	}
+	if !isOwner {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to reserve the ID prefix", settings.Sender.String())
+	}
 
-	if !isIssuer {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to perform the mint operation", sender.String())
+	if k.IsIDPrefixReserved(ctx, settings.ClassID, settings.Prefix) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "ID prefix %q is already reserved in the class", settings.Prefix)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetIDPrefixReservationKey(settings.ClassID, settings.Prefix), idPrefixReservedStoreVal)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventIDPrefixReserved{
+		ClassID: settings.ClassID,
+		Prefix:  settings.Prefix,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventIDPrefixReserved: %s", err)
 	}
 
 	return nil
 }
 
-func isIssuer(sender sdk.AccAddress, classID string) (bool, error) {
-	issuer, err := types.DeconstructClassID(classID)
+// IsIDPrefixReserved returns true if the ID prefix is reserved in the class.
+func (k Keeper) IsIDPrefixReserved(ctx sdk.Context, classID, prefix string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetIDPrefixReservationKey(classID, prefix))
+}
+
+// HasReservedIDPrefixes returns true if any ID prefix is reserved in the class.
+func (k Keeper) HasReservedIDPrefixes(ctx sdk.Context, classID string) bool {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateIDPrefixReservationsPrefix(classID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// checkIDPrefix rejects the ID using the prefix not reserved in the class. The prefixes are opt-in, so the IDs of
+// the classes which haven't reserved any prefix aren't restricted.
+func (k Keeper) checkIDPrefix(ctx sdk.Context, classID, id string) error {
+	idPrefix, ok := types.IDPrefix(id)
+	if !ok || k.IsIDPrefixReserved(ctx, classID, idPrefix) || !k.HasReservedIDPrefixes(ctx, classID) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrIDPrefixNotReserved, "prefix %q of ID %q is not reserved in the class", idPrefix, id)
+}
+
+// GetReservedIDPrefixes returns the ID prefixes reserved in the class.
+func (k Keeper) GetReservedIDPrefixes(ctx sdk.Context, classID string) []string {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateIDPrefixReservationsPrefix(classID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var prefixes []string
+	for ; iterator.Valid(); iterator.Next() {
+		prefixes = append(prefixes, string(iterator.Key()))
+	}
+
+	return prefixes
+}
+
+func (k Keeper) validateMintingAllowed(ctx sdk.Context, sender sdk.AccAddress, classID string) error {
+	isOwner, err := k.isClassOwner(ctx, sender, classID)
 	if err != nil {
-		return false, err
+		return err
+	}
+
+	if !isOwner {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to perform the mint operation", sender.String())
+	}
+
+	return nil
+}
+
+// validateData checks the data doesn't exceed the size limit set by the governance and the data envelope, if used,
+// is valid.
+func validateData(data *codetypes.Any, maxSize uint32) error {
+	if data == nil {
+		return nil
+	}
+	if len(data.Value) > int(maxSize) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "data size %d exceeds the limit of %d bytes", len(data.Value), maxSize)
 	}
 
-	return issuer.String() == sender.String(), nil
+	return types.ValidateData(data)
 }
@@ -110,3 +110,73 @@ func TestKeeper_Mint(t *testing.T) {
 	err = nftKeeper.Mint(ctx, settings)
 	requireT.True(sdkerrors.ErrUnauthorized.Is(err))
 }
+
+func TestKeeper_ReserveIDPrefix(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: addr,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+
+	mintSettings := types.MintSettings{
+		Sender:  addr,
+		ClassID: classID,
+		ID:      "gen1/id1",
+	}
+
+	// the IDs aren't restricted until the class reserves any prefix
+	requireT.False(nftKeeper.HasReservedIDPrefixes(ctx, classID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  addr,
+		ClassID: classID,
+		ID:      "a/b",
+	}))
+	requireT.True(testApp.NFTKeeper.HasNFT(ctx, classID, "a/b"))
+
+	// try to reserve from not issuer account
+	reserveSettings := types.ReserveIDPrefixSettings{
+		Sender:  sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		ClassID: classID,
+		Prefix:  "gen1/",
+	}
+	err = nftKeeper.ReserveIDPrefix(ctx, reserveSettings)
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// reserve the prefix
+	reserveSettings.Sender = addr
+	requireT.NoError(nftKeeper.ReserveIDPrefix(ctx, reserveSettings))
+	requireT.True(nftKeeper.IsIDPrefixReserved(ctx, classID, "gen1/"))
+	requireT.False(nftKeeper.IsIDPrefixReserved(ctx, classID, "gen2/"))
+	requireT.True(nftKeeper.HasReservedIDPrefixes(ctx, classID))
+
+	// once the class reserves any prefix, the prefixes which aren't reserved can't be used
+	err = nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  addr,
+		ClassID: classID,
+		ID:      "gen2/id1",
+	})
+	requireT.ErrorIs(err, types.ErrIDPrefixNotReserved)
+
+	// try to reserve the same prefix again
+	err = nftKeeper.ReserveIDPrefix(ctx, reserveSettings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	reserveSettings.Prefix = "gen2/"
+	requireT.NoError(nftKeeper.ReserveIDPrefix(ctx, reserveSettings))
+	requireT.Equal([]string{"gen1/", "gen2/"}, nftKeeper.GetReservedIDPrefixes(ctx, classID))
+
+	// mint with the reserved prefix
+	requireT.NoError(nftKeeper.Mint(ctx, mintSettings))
+	requireT.True(testApp.NFTKeeper.HasNFT(ctx, classID, mintSettings.ID))
+
+	// try to reserve the prefix in the class which doesn't exist
+	reserveSettings.ClassID = types.BuildClassID("missing", addr)
+	err = nftKeeper.ReserveIDPrefix(ctx, reserveSettings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
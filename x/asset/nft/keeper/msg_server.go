@@ -15,6 +15,23 @@ var _ types.MsgServer = MsgServer{}
 type MsgKeeper interface {
 	IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (string, error)
 	Mint(ctx sdk.Context, settings types.MintSettings) error
+	ReserveIDPrefix(ctx sdk.Context, settings types.ReserveIDPrefixSettings) error
+	TransferWithPayment(ctx sdk.Context, settings types.TransferWithPaymentSettings) error
+	CancelSaleOffer(ctx sdk.Context, settings types.CancelSaleOfferSettings) error
+	GrantUser(ctx sdk.Context, settings types.GrantUserSettings) error
+	RevokeUser(ctx sdk.Context, settings types.RevokeUserSettings) error
+	TransferClassOwnership(ctx sdk.Context, settings types.TransferClassOwnershipSettings) error
+	AcceptClassOwnership(ctx sdk.Context, settings types.AcceptClassOwnershipSettings) error
+	CreateRewardPool(ctx sdk.Context, settings types.CreateRewardPoolSettings) error
+	FundRewardPool(ctx sdk.Context, settings types.FundRewardPoolSettings) error
+	LockNFT(ctx sdk.Context, settings types.LockNFTSettings) error
+	UnlockNFT(ctx sdk.Context, settings types.UnlockNFTSettings) error
+	ClassFreeze(ctx sdk.Context, settings types.ClassFreezeSettings) error
+	ClassUnfreeze(ctx sdk.Context, settings types.ClassFreezeSettings) error
+	RevokeNFT(ctx sdk.Context, settings types.RevokeNFTSettings) error
+	AddToClassWhitelist(ctx sdk.Context, settings types.ClassWhitelistSettings) error
+	RemoveFromClassWhitelist(ctx sdk.Context, settings types.ClassWhitelistSettings) error
+	BurnNFT(ctx sdk.Context, settings types.BurnNFTSettings) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -38,13 +55,19 @@ func (ms MsgServer) IssueClass(ctx context.Context, req *types.MsgIssueClass) (*
 	if _, err := ms.keeper.IssueClass(
 		sdk.UnwrapSDKContext(ctx),
 		types.IssueClassSettings{
-			Issuer:      issuer,
-			Name:        req.Name,
-			Symbol:      req.Symbol,
-			Description: req.Description,
-			URI:         req.URI,
-			URIHash:     req.URIHash,
-			Data:        req.Data,
+			Issuer:       issuer,
+			Name:         req.Name,
+			Symbol:       req.Symbol,
+			Description:  req.Description,
+			URI:          req.URI,
+			URIHash:      req.URIHash,
+			Data:         req.Data,
+			Provenance:   req.Provenance,
+			Freezing:     req.Freezing,
+			Revocable:    req.Revocable,
+			Whitelisting: req.Whitelisting,
+			RoyaltyRate:  req.RoyaltyRate,
+			Soulbound:    req.Soulbound,
 		},
 	); err != nil {
 		return nil, err
@@ -75,3 +98,357 @@ func (ms MsgServer) Mint(ctx context.Context, req *types.MsgMint) (*types.EmptyR
 
 	return &types.EmptyResponse{}, nil
 }
+
+// ReserveIDPrefix reserves the non-fungible token ID prefix in the class.
+func (ms MsgServer) ReserveIDPrefix(ctx context.Context, req *types.MsgReserveIDPrefix) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.ReserveIDPrefix(
+		sdk.UnwrapSDKContext(ctx),
+		types.ReserveIDPrefixSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			Prefix:  req.Prefix,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// TransferWithPayment transfers the non-fungible token to the buyer accepting the sale offer.
+func (ms MsgServer) TransferWithPayment(ctx context.Context, req *types.MsgTransferWithPayment) (*types.EmptyResponse, error) {
+	buyer, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.TransferWithPayment(
+		sdk.UnwrapSDKContext(ctx),
+		types.TransferWithPaymentSettings{
+			Buyer: buyer,
+			Offer: req.Offer,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelSaleOffer cancels the sale offer signed by the sender.
+func (ms MsgServer) CancelSaleOffer(ctx context.Context, req *types.MsgCancelSaleOffer) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.CancelSaleOffer(
+		sdk.UnwrapSDKContext(ctx),
+		types.CancelSaleOfferSettings{
+			Sender: sender,
+			Offer:  req.Offer,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// GrantUser grants the time-bound right to use the non-fungible token to the user.
+func (ms MsgServer) GrantUser(ctx context.Context, req *types.MsgGrantUser) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid user")
+	}
+	if err := ms.keeper.GrantUser(
+		sdk.UnwrapSDKContext(ctx),
+		types.GrantUserSettings{
+			Sender:     sender,
+			ClassID:    req.ClassID,
+			ID:         req.ID,
+			User:       user,
+			Expiration: req.Expiration,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeUser gives up the right to use the non-fungible token.
+func (ms MsgServer) RevokeUser(ctx context.Context, req *types.MsgRevokeUser) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.RevokeUser(
+		sdk.UnwrapSDKContext(ctx),
+		types.RevokeUserSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// TransferClassOwnership proposes the new owner of the non-fungible token class.
+func (ms MsgServer) TransferClassOwnership(ctx context.Context, req *types.MsgTransferClassOwnership) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid new owner")
+	}
+	if err := ms.keeper.TransferClassOwnership(
+		sdk.UnwrapSDKContext(ctx),
+		types.TransferClassOwnershipSettings{
+			Sender:   sender,
+			ClassID:  req.ClassID,
+			NewOwner: newOwner,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AcceptClassOwnership accepts the ownership of the non-fungible token class.
+func (ms MsgServer) AcceptClassOwnership(ctx context.Context, req *types.MsgAcceptClassOwnership) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.AcceptClassOwnership(
+		sdk.UnwrapSDKContext(ctx),
+		types.AcceptClassOwnershipSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CreateRewardPool creates the reward pool of the non-fungible token class.
+func (ms MsgServer) CreateRewardPool(ctx context.Context, req *types.MsgCreateRewardPool) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.CreateRewardPool(
+		sdk.UnwrapSDKContext(ctx),
+		types.CreateRewardPoolSettings{
+			Sender:          sender,
+			ClassID:         req.ClassID,
+			RewardPerBlock:  req.RewardPerBlock,
+			MinLockDuration: req.MinLockDuration,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// FundRewardPool funds the reward pool of the non-fungible token class.
+func (ms MsgServer) FundRewardPool(ctx context.Context, req *types.MsgFundRewardPool) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.FundRewardPool(
+		sdk.UnwrapSDKContext(ctx),
+		types.FundRewardPoolSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			Amount:  req.Amount,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// LockNFT locks the non-fungible token to earn the rewards.
+func (ms MsgServer) LockNFT(ctx context.Context, req *types.MsgLockNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.LockNFT(
+		sdk.UnwrapSDKContext(ctx),
+		types.LockNFTSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UnlockNFT unlocks the non-fungible token and pays the earned rewards.
+func (ms MsgServer) UnlockNFT(ctx context.Context, req *types.MsgUnlockNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.UnlockNFT(
+		sdk.UnwrapSDKContext(ctx),
+		types.UnlockNFTSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassFreeze freezes the transfers of all the tokens in the class.
+func (ms MsgServer) ClassFreeze(ctx context.Context, req *types.MsgClassFreeze) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.ClassFreeze(
+		sdk.UnwrapSDKContext(ctx),
+		types.ClassFreezeSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassUnfreeze unfreezes the transfers of the tokens in the class.
+func (ms MsgServer) ClassUnfreeze(ctx context.Context, req *types.MsgClassUnfreeze) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.ClassUnfreeze(
+		sdk.UnwrapSDKContext(ctx),
+		types.ClassFreezeSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeNFT burns the non-fungible token held by any account.
+func (ms MsgServer) RevokeNFT(ctx context.Context, req *types.MsgRevokeNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.RevokeNFT(
+		sdk.UnwrapSDKContext(ctx),
+		types.RevokeNFTSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AddToClassWhitelist allows the account to receive the tokens of the class.
+func (ms MsgServer) AddToClassWhitelist(ctx context.Context, req *types.MsgAddToClassWhitelist) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+	if err := ms.keeper.AddToClassWhitelist(
+		sdk.UnwrapSDKContext(ctx),
+		types.ClassWhitelistSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			Account: account,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveFromClassWhitelist disallows the account to receive the tokens of the class.
+func (ms MsgServer) RemoveFromClassWhitelist(ctx context.Context, req *types.MsgRemoveFromClassWhitelist) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid account")
+	}
+	if err := ms.keeper.RemoveFromClassWhitelist(
+		sdk.UnwrapSDKContext(ctx),
+		types.ClassWhitelistSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			Account: account,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BurnNFT burns the non-fungible token of the soulbound class held by the sender.
+func (ms MsgServer) BurnNFT(ctx context.Context, req *types.MsgBurnNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.BurnNFT(
+		sdk.UnwrapSDKContext(ctx),
+		types.BurnNFTSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
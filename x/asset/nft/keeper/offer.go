@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// TransferWithPayment accepts the sale offer signed by the owner of the non-fungible token. The price is paid by
+// the buyer to the seller and the token is transferred to the buyer atomically. If the class has the royalty rate,
+// the royalty is deducted from the price and paid to the class owner.
+func (k Keeper) TransferWithPayment(ctx sdk.Context, settings types.TransferWithPaymentSettings) error {
+	offer := settings.Offer.Offer
+	if err := settings.Offer.Verify(ctx.ChainID()); err != nil {
+		return err
+	}
+
+	if offer.Buyer != "" && offer.Buyer != settings.Buyer.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "offer can be accepted by %s only", offer.Buyer)
+	}
+
+	if offer.ExpirationHeight != 0 && uint64(ctx.BlockHeight()) > offer.ExpirationHeight {
+		return sdkerrors.Wrapf(types.ErrSaleOfferExpired, "offer expired at height %d", offer.ExpirationHeight)
+	}
+
+	offerHash := types.SaleOfferHash(ctx.ChainID(), offer)
+	if k.IsSaleOfferAccepted(ctx, offerHash) {
+		return sdkerrors.Wrapf(types.ErrSaleOfferAlreadyAccepted, "offer of %q has been accepted already", offer.ID)
+	}
+	if k.IsSaleOfferCanceled(ctx, offerHash) {
+		return sdkerrors.Wrapf(types.ErrSaleOfferCanceled, "offer of %q has been canceled by the seller", offer.ID)
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, offer.ClassID, offer.ID) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "nft with classID %q and ID %q not found", offer.ClassID, offer.ID)
+	}
+
+	seller := sdk.MustAccAddressFromBech32(offer.Seller)
+	if !k.nftKeeper.GetOwner(ctx, offer.ClassID, offer.ID).Equals(seller) {
+		return sdkerrors.Wrapf(types.ErrInvalidSaleOffer, "seller %s is not the owner of nft %q", offer.Seller, offer.ID)
+	}
+
+	// the hooks aren't called for the transfer below, so the transfer is checked here
+	if err := k.checkTransferAllowed(ctx, offer.ClassID, offer.ID, seller, settings.Buyer); err != nil {
+		return err
+	}
+
+	royalty, royaltyRecipient, err := k.payPrice(ctx, offer.ClassID, settings.Buyer, seller, offer.Price)
+	if err != nil {
+		return err
+	}
+
+	if err := k.nftKeeper.Transfer(ctx, offer.ClassID, offer.ID, settings.Buyer); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't transfer non-fungible token: %s", err)
+	}
+	// the nft keeper used by this keeper doesn't call the hooks, so the provenance is recorded here with the price
+	k.recordProvenance(ctx, offer.ClassID, offer.ID, seller, settings.Buyer, &offer.Price)
+
+	ctx.KVStore(k.storeKey).Set(types.GetAcceptedSaleOfferKey(offerHash), saleOfferAcceptedStoreVal)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(offer.ClassID, offer.Seller, settings.Buyer.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferredWithPayment{
+		ClassID:          offer.ClassID,
+		ID:               offer.ID,
+		Seller:           offer.Seller,
+		Buyer:            settings.Buyer.String(),
+		Price:            offer.Price,
+		Royalty:          royalty,
+		RoyaltyRecipient: royaltyRecipient.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTransferredWithPayment: %s", err)
+	}
+
+	return nil
+}
+
+// CancelSaleOffer cancels the sale offer of the seller, so it can't be accepted anymore. The offer doesn't need to be
+// signed, because it is canceled by the seller itself, the offers never signed might be canceled in advance too.
+func (k Keeper) CancelSaleOffer(ctx sdk.Context, settings types.CancelSaleOfferSettings) error {
+	offer := settings.Offer
+	if err := offer.ValidateBasic(); err != nil {
+		return err
+	}
+	if offer.Seller != settings.Sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "offer can be canceled by %s only", offer.Seller)
+	}
+
+	offerHash := types.SaleOfferHash(ctx.ChainID(), offer)
+	if k.IsSaleOfferAccepted(ctx, offerHash) {
+		return sdkerrors.Wrapf(types.ErrSaleOfferAlreadyAccepted, "offer of %q has been accepted already", offer.ID)
+	}
+	if k.IsSaleOfferCanceled(ctx, offerHash) {
+		return sdkerrors.Wrapf(types.ErrSaleOfferCanceled, "offer of %q has been canceled already", offer.ID)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetCanceledSaleOfferKey(offerHash), saleOfferCanceledStoreVal)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(offer.ClassID, offer.Seller))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSaleOfferCanceled{
+		ClassID: offer.ClassID,
+		ID:      offer.ID,
+		Seller:  offer.Seller,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventSaleOfferCanceled: %s", err)
+	}
+
+	return nil
+}
+
+// IsSaleOfferAccepted returns true if the sale offer with the hash has been accepted already.
+func (k Keeper) IsSaleOfferAccepted(ctx sdk.Context, offerHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAcceptedSaleOfferKey(offerHash))
+}
+
+// IsSaleOfferCanceled returns true if the sale offer with the hash has been canceled by the seller.
+func (k Keeper) IsSaleOfferCanceled(ctx sdk.Context, offerHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetCanceledSaleOfferKey(offerHash))
+}
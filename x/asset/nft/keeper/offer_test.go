@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func signSaleOffer(t *testing.T, seller *secp256k1.PrivKey, chainID string, offer types.SaleOffer) types.SignedSaleOffer {
+	signature, err := seller.Sign(types.SaleOfferSignBytes(chainID, offer))
+	require.NoError(t, err)
+	return types.SignedSaleOffer{
+		Offer:     offer,
+		PubKey:    seller.PubKey().Bytes(),
+		Signature: signature,
+	}
+}
+
+func TestKeeper_TransferWithPayment(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain", Height: 10})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	sellerKey := secp256k1.GenPrivKey()
+	seller := sdk.AccAddress(sellerKey.PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherBuyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: seller,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  seller,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+
+	price := sdk.NewInt64Coin("ucore", 100)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+
+	offer := types.SaleOffer{
+		Seller:           seller.String(),
+		ClassID:          classID,
+		ID:               "id1",
+		Price:            price,
+		Buyer:            buyer.String(),
+		ExpirationHeight: 10,
+	}
+	settings := types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), offer),
+	}
+
+	// the offer signed for other chain is rejected
+	invalidSettings := settings
+	invalidSettings.Offer = signSaleOffer(t, sellerKey, "other-chain", offer)
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, invalidSettings), types.ErrInvalidSaleOffer)
+
+	// the offer with the modified price is rejected
+	invalidSettings = settings
+	invalidSettings.Offer.Offer.Price = sdk.NewInt64Coin("ucore", 1)
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, invalidSettings), types.ErrInvalidSaleOffer)
+
+	// the offer can't be accepted by other buyer
+	invalidSettings = settings
+	invalidSettings.Buyer = otherBuyer
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, invalidSettings), sdkerrors.ErrUnauthorized)
+
+	// the offer can't be accepted after the expiration
+	requireT.ErrorIs(
+		nftKeeper.TransferWithPayment(ctx.WithBlockHeight(11), settings),
+		types.ErrSaleOfferExpired,
+	)
+
+	// the buyer without funds can't accept the offer
+	noExpirationOffer := offer
+	noExpirationOffer.Buyer = ""
+	noExpirationOffer.ExpirationHeight = 0
+	invalidSettings = types.TransferWithPaymentSettings{
+		Buyer: otherBuyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), noExpirationOffer),
+	}
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, invalidSettings), sdkerrors.ErrInsufficientFunds)
+
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, settings))
+	requireT.Equal(buyer.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id1").String())
+	requireT.Equal(price.String(), testApp.BankKeeper.GetBalance(ctx, seller, price.Denom).String())
+	requireT.True(testApp.BankKeeper.GetBalance(ctx, buyer, price.Denom).IsZero())
+
+	// the offer can't be accepted twice
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, settings), types.ErrSaleOfferAlreadyAccepted)
+
+	// the offer of the seller who doesn't own the token anymore is rejected
+	requireT.NoError(testApp.FundAccount(ctx, otherBuyer, sdk.NewCoins(price)))
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, invalidSettings), types.ErrInvalidSaleOffer)
+}
+
+// saleOfferSetup issues the class, mints the token to the seller and returns the settings of the sale offer accepted
+// by the funded buyer.
+func saleOfferSetup(
+	t *testing.T,
+	testApp *simapp.App,
+	ctx sdk.Context,
+	classSettings types.IssueClassSettings,
+) (string, types.TransferWithPaymentSettings) {
+	requireT := require.New(t)
+	nftKeeper := testApp.AssetNFTKeeper
+
+	sellerKey := secp256k1.GenPrivKey()
+	seller := sdk.AccAddress(sellerKey.PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classSettings.Issuer = seller
+	classID, err := nftKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: seller, ClassID: classID, ID: "id1"}))
+
+	price := sdk.NewInt64Coin("ucore", 100)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+
+	return classID, types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), types.SaleOffer{
+			Seller:  seller.String(),
+			ClassID: classID,
+			ID:      "id1",
+			Price:   price,
+		}),
+	}
+}
+
+func TestKeeper_TransferWithPaymentNotWhitelisted(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain"})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	classID, settings := saleOfferSetup(t, testApp, ctx, types.IssueClassSettings{
+		Symbol:       "symbol",
+		Whitelisting: true,
+	})
+
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, settings), types.ErrNotWhitelisted)
+
+	requireT.NoError(nftKeeper.AddToClassWhitelist(ctx, types.ClassWhitelistSettings{
+		Sender:  sdk.MustAccAddressFromBech32(settings.Offer.Offer.Seller),
+		ClassID: classID,
+		Account: settings.Buyer,
+	}))
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, settings))
+	requireT.Equal(settings.Buyer.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id1").String())
+}
+
+func TestKeeper_TransferWithPaymentClassFrozen(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain"})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	classID, settings := saleOfferSetup(t, testApp, ctx, types.IssueClassSettings{
+		Symbol:   "symbol",
+		Freezing: true,
+	})
+	freezeSettings := types.ClassFreezeSettings{
+		Sender:  sdk.MustAccAddressFromBech32(settings.Offer.Offer.Seller),
+		ClassID: classID,
+	}
+
+	requireT.NoError(nftKeeper.ClassFreeze(ctx, freezeSettings))
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, settings), types.ErrClassFrozen)
+
+	requireT.NoError(nftKeeper.ClassUnfreeze(ctx, freezeSettings))
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, settings))
+	requireT.Equal(settings.Buyer.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id1").String())
+}
+
+func TestKeeper_TransferWithPaymentSoulbound(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain"})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	classID, settings := saleOfferSetup(t, testApp, ctx, types.IssueClassSettings{
+		Symbol:    "symbol",
+		Soulbound: true,
+	})
+
+	// the class owner delivers the minted token by the sale
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, settings))
+	requireT.Equal(settings.Buyer.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id1").String())
+
+	// the holder of the soulbound token can't sell it
+	holderKey := secp256k1.GenPrivKey()
+	holder := sdk.AccAddress(holderKey.PubKey().Address())
+	classOwner := sdk.MustAccAddressFromBech32(settings.Offer.Offer.Seller)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: classOwner, ClassID: classID, ID: "id2"}))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id2", holder))
+	requireT.NoError(testApp.FundAccount(ctx, settings.Buyer, sdk.NewCoins(settings.Offer.Offer.Price)))
+	holderOffer := settings.Offer.Offer
+	holderOffer.Seller = holder.String()
+	holderOffer.ID = "id2"
+	holderSettings := types.TransferWithPaymentSettings{
+		Buyer: settings.Buyer,
+		Offer: signSaleOffer(t, holderKey, ctx.ChainID(), holderOffer),
+	}
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, holderSettings), types.ErrNFTSoulbound)
+	requireT.Equal(holder.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id2").String())
+}
+
+func TestKeeper_CancelSaleOffer(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain"})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	sellerKey := secp256k1.GenPrivKey()
+	seller := sdk.AccAddress(sellerKey.PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: seller,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: seller, ClassID: classID, ID: "id1"}))
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("ucore", 100))))
+
+	offer := types.SaleOffer{
+		Seller:  seller.String(),
+		ClassID: classID,
+		ID:      "id1",
+		Price:   sdk.NewInt64Coin("ucore", 100),
+	}
+	cancelSettings := types.CancelSaleOfferSettings{
+		Sender: seller,
+		Offer:  offer,
+	}
+
+	// only the seller can cancel the offer
+	invalidCancelSettings := cancelSettings
+	invalidCancelSettings.Sender = buyer
+	requireT.ErrorIs(nftKeeper.CancelSaleOffer(ctx, invalidCancelSettings), sdkerrors.ErrUnauthorized)
+
+	// the offer never expiring can't be accepted once canceled
+	requireT.NoError(nftKeeper.CancelSaleOffer(ctx, cancelSettings))
+	requireT.ErrorIs(nftKeeper.CancelSaleOffer(ctx, cancelSettings), types.ErrSaleOfferCanceled)
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), offer),
+	}), types.ErrSaleOfferCanceled)
+	requireT.Equal(seller.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id1").String())
+
+	// the other offer of the same token is not affected
+	repricedOffer := offer
+	repricedOffer.Price = sdk.NewInt64Coin("ucore", 90)
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), repricedOffer),
+	}))
+	requireT.Equal(buyer.String(), testApp.NFTKeeper.GetOwner(ctx, classID, "id1").String())
+
+	// the accepted offer can't be canceled
+	requireT.ErrorIs(nftKeeper.CancelSaleOffer(ctx, types.CancelSaleOfferSettings{
+		Sender: seller,
+		Offer:  repricedOffer,
+	}), types.ErrSaleOfferAlreadyAccepted)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// TransferClassOwnership proposes the new owner of the class. The ownership is transferred only when the new owner
+// accepts it, so the class can't be lost by transferring it to the address nobody controls. The pending proposal is
+// replaced if the owner proposes another address.
+func (k Keeper) TransferClassOwnership(ctx sdk.Context, settings types.TransferClassOwnershipSettings) error {
+	owner, err := k.GetClassOwner(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to transfer the class ownership", settings.Sender.String())
+	}
+	if owner.Equals(settings.NewOwner) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "owner can't transfer the ownership to itself")
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetPendingClassOwnerKey(settings.ClassID), settings.NewOwner)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, owner.String(), settings.NewOwner.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassOwnershipTransferProposed{
+		ClassID:  settings.ClassID,
+		Owner:    owner.String(),
+		NewOwner: settings.NewOwner.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventClassOwnershipTransferProposed: %s", err)
+	}
+
+	return nil
+}
+
+// AcceptClassOwnership transfers the ownership of the class to the sender if it has been proposed to it.
+func (k Keeper) AcceptClassOwnership(ctx sdk.Context, settings types.AcceptClassOwnershipSettings) error {
+	pendingOwner, found := k.GetPendingClassOwner(ctx, settings.ClassID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "ownership of class %q is not being transferred", settings.ClassID)
+	}
+	if !pendingOwner.Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "ownership of class %q is not proposed to %q", settings.ClassID, settings.Sender.String())
+	}
+
+	previousOwner, err := k.GetClassOwner(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingClassOwnerKey(settings.ClassID))
+	// the owner is stored only if it differs from the issuer encoded in the class ID
+	issuer, err := types.DeconstructClassID(settings.ClassID)
+	if err != nil {
+		return err
+	}
+	if issuer.Equals(settings.Sender) {
+		store.Delete(types.GetClassOwnerKey(settings.ClassID))
+	} else {
+		store.Set(types.GetClassOwnerKey(settings.ClassID), settings.Sender)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, previousOwner.String(), settings.Sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClassOwnershipTransferred{
+		ClassID:       settings.ClassID,
+		PreviousOwner: previousOwner.String(),
+		NewOwner:      settings.Sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventClassOwnershipTransferred: %s", err)
+	}
+
+	return nil
+}
+
+// GetClassOwner returns the owner of the class. The owner is the issuer of the class unless the ownership has been
+// transferred to another address.
+func (k Keeper) GetClassOwner(ctx sdk.Context, classID string) (sdk.AccAddress, error) {
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "classID %q not found", classID)
+	}
+
+	if bz := ctx.KVStore(k.storeKey).Get(types.GetClassOwnerKey(classID)); bz != nil {
+		return bz, nil
+	}
+
+	issuer, err := types.DeconstructClassID(classID)
+	if err != nil {
+		return nil, err
+	}
+
+	return issuer.Bytes(), nil
+}
+
+// GetPendingClassOwner returns the address the ownership of the class is proposed to.
+func (k Keeper) GetPendingClassOwner(ctx sdk.Context, classID string) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingClassOwnerKey(classID))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+func (k Keeper) isClassOwner(ctx sdk.Context, sender sdk.AccAddress, classID string) (bool, error) {
+	owner, err := k.GetClassOwner(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	return owner.Equals(sender), nil
+}
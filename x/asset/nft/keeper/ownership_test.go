@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_TransferClassOwnership(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newOwner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+
+	// the issuer is the owner by default
+	owner, err := nftKeeper.GetClassOwner(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(issuer, owner)
+	_, found := nftKeeper.GetPendingClassOwner(ctx, classID)
+	requireT.False(found)
+
+	// the owner of the class which doesn't exist can't be returned
+	_, err = nftKeeper.GetClassOwner(ctx, types.BuildClassID("missing", issuer))
+	requireT.True(types.ErrInvalidInput.Is(err))
+
+	settings := types.TransferClassOwnershipSettings{
+		Sender:   issuer,
+		ClassID:  classID,
+		NewOwner: otherAddr,
+	}
+
+	// only the owner can transfer the ownership
+	invalidSettings := settings
+	invalidSettings.Sender = otherAddr
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.TransferClassOwnership(ctx, invalidSettings)))
+
+	// there is nothing to accept yet
+	requireT.True(sdkerrors.ErrNotFound.Is(nftKeeper.AcceptClassOwnership(ctx, types.AcceptClassOwnershipSettings{
+		Sender:  otherAddr,
+		ClassID: classID,
+	})))
+
+	// the pending proposal is replaced by the next one
+	requireT.NoError(nftKeeper.TransferClassOwnership(ctx, settings))
+	settings.NewOwner = newOwner
+	requireT.NoError(nftKeeper.TransferClassOwnership(ctx, settings))
+	pendingOwner, found := nftKeeper.GetPendingClassOwner(ctx, classID)
+	requireT.True(found)
+	requireT.Equal(newOwner, pendingOwner)
+
+	// the ownership isn't transferred until it's accepted
+	owner, err = nftKeeper.GetClassOwner(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(issuer, owner)
+
+	// only the proposed owner can accept the ownership
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.AcceptClassOwnership(ctx, types.AcceptClassOwnershipSettings{
+		Sender:  otherAddr,
+		ClassID: classID,
+	})))
+	requireT.NoError(nftKeeper.AcceptClassOwnership(ctx, types.AcceptClassOwnershipSettings{
+		Sender:  newOwner,
+		ClassID: classID,
+	}))
+	owner, err = nftKeeper.GetClassOwner(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(newOwner, owner)
+	_, found = nftKeeper.GetPendingClassOwner(ctx, classID)
+	requireT.False(found)
+
+	// the new owner can mint and reserve the ID prefixes, while the issuer can't
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  newOwner,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "id2",
+	})))
+	requireT.NoError(nftKeeper.ReserveIDPrefix(ctx, types.ReserveIDPrefixSettings{
+		Sender:  newOwner,
+		ClassID: classID,
+		Prefix:  "prefix/",
+	}))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.ReserveIDPrefix(ctx, types.ReserveIDPrefixSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		Prefix:  "other/",
+	})))
+
+	// the ownership might be transferred back to the issuer
+	requireT.NoError(nftKeeper.TransferClassOwnership(ctx, types.TransferClassOwnershipSettings{
+		Sender:   newOwner,
+		ClassID:  classID,
+		NewOwner: issuer,
+	}))
+	requireT.NoError(nftKeeper.AcceptClassOwnership(ctx, types.AcceptClassOwnershipSettings{
+		Sender:  issuer,
+		ClassID: classID,
+	}))
+	owner, err = nftKeeper.GetClassOwner(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal(issuer, owner)
+}
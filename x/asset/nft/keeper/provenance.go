@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	"github.com/CoreumFoundation/coreum/x/nft"
+)
+
+var provenanceClassStoreVal = []byte{0x01}
+
+var _ nft.NFTHooks = Hooks{}
+
+// Hooks implements the hooks of the nft keeper.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the hooks to be registered in the nft keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k: k}
+}
+
+// AfterTransfer rejects the transfer not allowed by the class and records the provenance of the transferred token.
+func (h Hooks) AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if err := h.k.checkTransferAllowed(ctx, classID, nftID, sender, receiver); err != nil {
+		return err
+	}
+	h.k.recordProvenance(ctx, classID, nftID, sender, receiver, nil)
+	return nil
+}
+
+// checkTransferAllowed rejects the transfer of the token of the soulbound class held by the account other than the
+// class owner, the transfer of the token of the frozen class, the transfer of the locked non-fungible token or
+// the transfer to the account not whitelisted in the class. It's called by the hooks and by the transfers done without
+// them, so all the transfers are checked the same way.
+func (k Keeper) checkTransferAllowed(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	if err := k.checkSoulboundTransfer(ctx, classID, nftID, sender); err != nil {
+		return err
+	}
+	if k.IsClassFrozen(ctx, classID) {
+		return sdkerrors.Wrapf(types.ErrClassFrozen, "class %q is frozen and its tokens can't be transferred", classID)
+	}
+	if k.IsNFTLocked(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(types.ErrNFTLocked, "nft %q is locked and can't be transferred", nftID)
+	}
+	return k.checkReceivingAllowed(ctx, classID, receiver)
+}
+
+// IsProvenanceEnabled returns true if the provenance of the tokens in the class is recorded.
+func (k Keeper) IsProvenanceEnabled(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetProvenanceClassKey(classID))
+}
+
+// GetProvenanceRecords returns the provenance records of the non-fungible token ordered by sequence.
+func (k Keeper) GetProvenanceRecords(
+	ctx sdk.Context,
+	classID, id string,
+	pagination *query.PageRequest,
+) ([]types.ProvenanceRecord, *query.PageResponse, error) {
+	records := []types.ProvenanceRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateProvenanceRecordsPrefix(classID, id))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var record types.ProvenanceRecord
+		k.cdc.MustUnmarshal(value, &record)
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
+
+func (k Keeper) enableProvenance(ctx sdk.Context, classID string) {
+	ctx.KVStore(k.storeKey).Set(types.GetProvenanceClassKey(classID), provenanceClassStoreVal)
+}
+
+// recordProvenance appends the provenance record chained to the latest one of the token and prunes the records
+// exceeding the limit.
+func (k Keeper) recordProvenance(ctx sdk.Context, classID, id string, from, to sdk.AccAddress, price *sdk.Coin) {
+	if !k.IsProvenanceEnabled(ctx, classID) {
+		return
+	}
+
+	record := types.ProvenanceRecord{
+		Sequence: 1,
+		From:     from.String(),
+		To:       to.String(),
+		Price:    price,
+		Height:   ctx.BlockHeight(),
+	}
+	if latest, found := k.getLatestProvenanceRecord(ctx, classID, id); found {
+		record.Sequence = latest.Sequence + 1
+		record.PreviousHash = latest.Hash()
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProvenanceRecordKey(classID, id, record.Sequence), k.cdc.MustMarshal(&record))
+	if record.Sequence > types.MaxProvenanceRecords {
+		store.Delete(types.GetProvenanceRecordKey(classID, id, record.Sequence-types.MaxProvenanceRecords))
+	}
+}
+
+func (k Keeper) getLatestProvenanceRecord(ctx sdk.Context, classID, id string) (types.ProvenanceRecord, bool) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateProvenanceRecordsPrefix(classID, id)).
+		ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ProvenanceRecord{}, false
+	}
+
+	var record types.ProvenanceRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, true
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_Provenance(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain", Height: 10})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	sellerKey := secp256k1.GenPrivKey()
+	seller := sdk.AccAddress(sellerKey.PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:     seller,
+		Symbol:     "symbol",
+		Provenance: true,
+	})
+	requireT.NoError(err)
+	requireT.True(nftKeeper.IsProvenanceEnabled(ctx, classID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  seller,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+
+	// the transfer done by the nft module is recorded without the price
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", buyer))
+	records, _, err := nftKeeper.GetProvenanceRecords(ctx, classID, "id1", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.ProvenanceRecord{
+		{
+			Sequence: 1,
+			From:     seller.String(),
+			To:       buyer.String(),
+			Height:   10,
+		},
+	}, records)
+
+	// the transfer with payment is recorded with the price and the hash of the previous record
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", seller))
+	price := sdk.NewInt64Coin("ucore", 100)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx.WithBlockHeight(11), types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), types.SaleOffer{
+			Seller:  seller.String(),
+			ClassID: classID,
+			ID:      "id1",
+			Price:   price,
+		}),
+	}))
+	records, _, err = nftKeeper.GetProvenanceRecords(ctx, classID, "id1", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(records, 3)
+	requireT.Equal(types.ProvenanceRecord{
+		Sequence:     3,
+		From:         seller.String(),
+		To:           buyer.String(),
+		Price:        &price,
+		Height:       11,
+		PreviousHash: records[1].Hash(),
+	}, records[2])
+	requireT.Equal(records[0].Hash(), records[1].PreviousHash)
+
+	// the oldest records are pruned
+	for i := 0; i < types.MaxProvenanceRecords; i++ {
+		requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", buyer))
+	}
+	records, _, err = nftKeeper.GetProvenanceRecords(ctx, classID, "id1", &query.PageRequest{Limit: types.MaxProvenanceRecords + 1})
+	requireT.NoError(err)
+	requireT.Len(records, types.MaxProvenanceRecords)
+	requireT.EqualValues(4, records[0].Sequence)
+	requireT.EqualValues(types.MaxProvenanceRecords+3, records[len(records)-1].Sequence)
+
+	// the provenance isn't recorded for the class issued without it
+	classID, err = nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: seller,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+	requireT.False(nftKeeper.IsProvenanceEnabled(ctx, classID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  seller,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", buyer))
+	records, _, err = nftKeeper.GetProvenanceRecords(ctx, classID, "id1", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(records)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+var revocableClassStoreVal = []byte{0x01}
+
+// RevokeNFT burns the non-fungible token held by any account. Only the owner of the class issued with the revocable
+// or the soulbound feature can revoke the tokens. The right to use the token is deleted and the locked token is unlocked, paying the
+// rewards earned so far to its holder.
+func (k Keeper) RevokeNFT(ctx sdk.Context, settings types.RevokeNFTSettings) error {
+	classOwner, err := k.GetClassOwner(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+	if !classOwner.Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to revoke the nft", settings.Sender.String())
+	}
+	if !k.IsRevocable(ctx, settings.ClassID) && !k.IsSoulbound(ctx, settings.ClassID) {
+		return sdkerrors.Wrapf(types.ErrFeatureNotActive, "revocation is not enabled in class %q", settings.ClassID)
+	}
+	if !k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "nft with classID %q and ID %q not found", settings.ClassID, settings.ID)
+	}
+
+	if lock, found := k.GetNFTLock(ctx, settings.ClassID, settings.ID); found {
+		pool, found := k.GetRewardPool(ctx, settings.ClassID)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward pool of class %q not found", settings.ClassID)
+		}
+		if err := k.unlockNFT(ctx, pool, lock); err != nil {
+			return err
+		}
+	}
+	k.deleteUserGrant(ctx, settings.ClassID, settings.ID)
+
+	owner := k.nftKeeper.GetOwner(ctx, settings.ClassID, settings.ID)
+	if err := k.nftKeeper.Burn(ctx, settings.ClassID, settings.ID); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't burn non-fungible token: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, owner.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTRevoked{
+		ClassID: settings.ClassID,
+		ID:      settings.ID,
+		Owner:   owner.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventNFTRevoked: %s", err)
+	}
+
+	return nil
+}
+
+// IsRevocable returns true if the class owner is allowed to revoke the tokens in the class.
+func (k Keeper) IsRevocable(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetRevocableClassKey(classID))
+}
+
+func (k Keeper) enableRevocation(ctx sdk.Context, classID string) {
+	ctx.KVStore(k.storeKey).Set(types.GetRevocableClassKey(classID), revocableClassStoreVal)
+}
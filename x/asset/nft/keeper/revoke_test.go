@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_RevokeNFT(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:    issuer,
+		Symbol:    "symbol",
+		Freezing:  true,
+		Revocable: true,
+	})
+	requireT.NoError(err)
+	requireT.True(nftKeeper.IsRevocable(ctx, classID))
+	for _, id := range []string{"id1", "id2"} {
+		requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: id}))
+		requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, id, holder))
+	}
+
+	// the tokens of the class without the revocable feature can't be revoked
+	notRevocableClassID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "notrevocable",
+	})
+	requireT.NoError(err)
+	requireT.False(nftKeeper.IsRevocable(ctx, notRevocableClassID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: notRevocableClassID, ID: "id1"}))
+	requireT.True(types.ErrFeatureNotActive.Is(nftKeeper.RevokeNFT(ctx, types.RevokeNFTSettings{
+		Sender:  issuer,
+		ClassID: notRevocableClassID,
+		ID:      "id1",
+	})))
+
+	// only the class owner can revoke the existing token
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.RevokeNFT(ctx, types.RevokeNFTSettings{
+		Sender:  holder,
+		ClassID: classID,
+		ID:      "id1",
+	})))
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.RevokeNFT(ctx, types.RevokeNFTSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "missing",
+	})))
+
+	// the token is revoked even if the class is frozen and the right to use it is deleted
+	requireT.NoError(nftKeeper.GrantUser(ctx, types.GrantUserSettings{
+		Sender:     holder,
+		ClassID:    classID,
+		ID:         "id1",
+		User:       user,
+		Expiration: now.Add(time.Hour),
+	}))
+	requireT.NoError(nftKeeper.ClassFreeze(ctx, types.ClassFreezeSettings{Sender: issuer, ClassID: classID}))
+	requireT.NoError(nftKeeper.RevokeNFT(ctx, types.RevokeNFTSettings{Sender: issuer, ClassID: classID, ID: "id1"}))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "id1"))
+	_, found := nftKeeper.GetUserGrant(ctx, classID, "id1")
+	requireT.False(found)
+	requireT.NoError(nftKeeper.ClassUnfreeze(ctx, types.ClassFreezeSettings{Sender: issuer, ClassID: classID}))
+
+	// the locked token is unlocked and the earned reward is paid to the holder
+	requireT.NoError(nftKeeper.CreateRewardPool(ctx, types.CreateRewardPoolSettings{
+		Sender:          issuer,
+		ClassID:         classID,
+		RewardPerBlock:  sdk.NewInt64Coin("ucore", 10),
+		MinLockDuration: time.Hour,
+	}))
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(sdk.NewInt64Coin("ucore", 25))))
+	requireT.NoError(nftKeeper.FundRewardPool(ctx, types.FundRewardPoolSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		Amount:  sdk.NewInt64Coin("ucore", 25),
+	}))
+	requireT.NoError(nftKeeper.LockNFT(ctx, types.LockNFTSettings{Sender: holder, ClassID: classID, ID: "id2"}))
+	nftKeeper.EndBlocker(ctx)
+
+	requireT.NoError(nftKeeper.RevokeNFT(ctx, types.RevokeNFTSettings{Sender: issuer, ClassID: classID, ID: "id2"}))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "id2"))
+	requireT.False(nftKeeper.IsNFTLocked(ctx, classID, "id2"))
+	requireT.Equal(sdk.NewInt64Coin("ucore", 10).String(), testApp.BankKeeper.GetBalance(ctx, holder, "ucore").String())
+	pool, found := nftKeeper.GetRewardPool(ctx, classID)
+	requireT.True(found)
+	requireT.Zero(pool.LockedCount)
+}
@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// CreateRewardPool creates the pool distributing the fungible tokens among the holders locking the non-fungible
+// tokens of the class. Only the class owner might create the pool and the class might have one pool only.
+func (k Keeper) CreateRewardPool(ctx sdk.Context, settings types.CreateRewardPoolSettings) error {
+	if err := types.ValidateRewardPool(settings.RewardPerBlock, settings.MinLockDuration); err != nil {
+		return err
+	}
+
+	owner, err := k.GetClassOwner(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+	if !owner.Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to create the reward pool", settings.Sender.String())
+	}
+
+	if _, found := k.GetRewardPool(ctx, settings.ClassID); found {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "reward pool of class %q exists already", settings.ClassID)
+	}
+
+	if err := k.checkRewardDenom(ctx, settings.RewardPerBlock.Denom); err != nil {
+		return err
+	}
+
+	k.setRewardPool(ctx, types.RewardPool{
+		ClassID:         settings.ClassID,
+		RewardPerBlock:  settings.RewardPerBlock,
+		MinLockDuration: settings.MinLockDuration,
+		Balance:         sdk.ZeroInt(),
+		RewardPerToken:  sdk.ZeroDec(),
+	})
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardPoolCreated{
+		ClassID:         settings.ClassID,
+		RewardPerBlock:  settings.RewardPerBlock,
+		MinLockDuration: settings.MinLockDuration,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventRewardPoolCreated: %s", err)
+	}
+
+	return nil
+}
+
+// FundRewardPool transfers the funds from the sender to the reward pool of the class. Anyone might fund the pool.
+func (k Keeper) FundRewardPool(ctx sdk.Context, settings types.FundRewardPoolSettings) error {
+	pool, found := k.GetRewardPool(ctx, settings.ClassID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward pool of class %q not found", settings.ClassID)
+	}
+	if settings.Amount.Denom != pool.RewardPerBlock.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "reward pool of class %q is funded in %q", settings.ClassID, pool.RewardPerBlock.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, settings.Sender, types.ModuleName, sdk.NewCoins(settings.Amount)); err != nil {
+		return sdkerrors.Wrapf(err, "can't fund the reward pool with %s", settings.Amount)
+	}
+
+	pool.Balance = pool.Balance.Add(settings.Amount.Amount)
+	k.setRewardPool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, settings.Sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardPoolFunded{
+		ClassID: settings.ClassID,
+		Sender:  settings.Sender.String(),
+		Amount:  settings.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventRewardPoolFunded: %s", err)
+	}
+
+	return nil
+}
+
+// LockNFT locks the non-fungible token so it earns the rewards from the pool of its class. The locked token stays
+// owned by the sender but can't be transferred until it is unlocked.
+func (k Keeper) LockNFT(ctx sdk.Context, settings types.LockNFTSettings) error {
+	pool, found := k.GetRewardPool(ctx, settings.ClassID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward pool of class %q not found", settings.ClassID)
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "nft with classID %q and ID %q not found", settings.ClassID, settings.ID)
+	}
+	if !k.nftKeeper.GetOwner(ctx, settings.ClassID, settings.ID).Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the owner of nft %q", settings.Sender, settings.ID)
+	}
+	if k.IsNFTLocked(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrNFTLocked, "nft %q is locked already", settings.ID)
+	}
+
+	lock := types.NFTLock{
+		ClassID:        settings.ClassID,
+		ID:             settings.ID,
+		Owner:          settings.Sender.String(),
+		LockedAt:       ctx.BlockTime(),
+		RewardPerToken: pool.RewardPerToken,
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetNFTLockKey(lock.ClassID, lock.ID), k.cdc.MustMarshal(&lock))
+
+	pool.LockedCount++
+	k.setRewardPool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(lock.ClassID, lock.Owner))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTLocked{
+		ClassID: lock.ClassID,
+		ID:      lock.ID,
+		Owner:   lock.Owner,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventNFTLocked: %s", err)
+	}
+
+	return nil
+}
+
+// UnlockNFT unlocks the non-fungible token locked for the minimum duration at least and pays the rewards earned by
+// it to the owner.
+func (k Keeper) UnlockNFT(ctx sdk.Context, settings types.UnlockNFTSettings) error {
+	lock, found := k.GetNFTLock(ctx, settings.ClassID, settings.ID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %q is not locked", settings.ID)
+	}
+	if lock.Owner != settings.Sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft %q is locked by %s", settings.ID, lock.Owner)
+	}
+
+	pool, found := k.GetRewardPool(ctx, settings.ClassID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward pool of class %q not found", settings.ClassID)
+	}
+	if !lock.IsUnlockable(pool.MinLockDuration, ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrNFTLocked, "nft %q is locked until %s", settings.ID, lock.LockedAt.Add(pool.MinLockDuration))
+	}
+
+	return k.unlockNFT(ctx, pool, lock)
+}
+
+// GetRewardPool returns the reward pool of the class.
+func (k Keeper) GetRewardPool(ctx sdk.Context, classID string) (types.RewardPool, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRewardPoolKey(classID))
+	if bz == nil {
+		return types.RewardPool{}, false
+	}
+
+	var pool types.RewardPool
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool, true
+}
+
+// GetNFTLock returns the lock of the non-fungible token.
+func (k Keeper) GetNFTLock(ctx sdk.Context, classID, id string) (types.NFTLock, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetNFTLockKey(classID, id))
+	if bz == nil {
+		return types.NFTLock{}, false
+	}
+
+	var lock types.NFTLock
+	k.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// IsNFTLocked returns true if the non-fungible token is locked.
+func (k Keeper) IsNFTLocked(ctx sdk.Context, classID, id string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetNFTLockKey(classID, id))
+}
+
+// GetPendingReward returns the lock of the non-fungible token and the reward earned by it so far.
+func (k Keeper) GetPendingReward(ctx sdk.Context, classID, id string) (types.NFTLock, sdk.Coin, error) {
+	lock, found := k.GetNFTLock(ctx, classID, id)
+	if !found {
+		return types.NFTLock{}, sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %q of class %q is not locked", id, classID)
+	}
+	pool, found := k.GetRewardPool(ctx, classID)
+	if !found {
+		return types.NFTLock{}, sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "reward pool of class %q not found", classID)
+	}
+
+	return lock, pool.PendingReward(lock), nil
+}
+
+// unlockNFT deletes the lock of the non-fungible token and pays the rewards earned by it to the owner of the lock.
+func (k Keeper) unlockNFT(ctx sdk.Context, pool types.RewardPool, lock types.NFTLock) error {
+	reward := pool.PendingReward(lock)
+	ctx.KVStore(k.storeKey).Delete(types.GetNFTLockKey(lock.ClassID, lock.ID))
+	pool.LockedCount--
+	k.setRewardPool(ctx, pool)
+
+	if reward.IsPositive() {
+		owner := sdk.MustAccAddressFromBech32(lock.Owner)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(reward)); err != nil {
+			return sdkerrors.Wrapf(err, "can't pay the reward %s", reward)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(lock.ClassID, lock.Owner))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTUnlocked{
+		ClassID: lock.ClassID,
+		ID:      lock.ID,
+		Owner:   lock.Owner,
+		Reward:  reward,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventNFTUnlocked: %s", err)
+	}
+
+	return nil
+}
+
+// checkRewardDenom returns an error if the fungible token can't be used as the reward. The rewards of all the pools are
+// paid from the module account, so the burn rate and the send commission charged on the payout would be taken from
+// the funds of the other pools, and the frozen or not whitelisted payout would prevent the token from being unlocked.
+func (k Keeper) checkRewardDenom(ctx sdk.Context, denom string) error {
+	ft, err := k.ftKeeper.GetTokenDefinition(ctx, denom)
+	if assetfttypes.ErrFTNotFound.Is(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if (!ft.BurnRate.IsNil() && ft.BurnRate.IsPositive()) ||
+		(!ft.SendCommissionRate.IsNil() && ft.SendCommissionRate.IsPositive()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"denom %s with the burn rate or the send commission rate can't be used as the reward", denom,
+		)
+	}
+	for _, feature := range []assetfttypes.TokenFeature{
+		assetfttypes.TokenFeature_freeze,    //nolint:nosnakecase
+		assetfttypes.TokenFeature_whitelist, //nolint:nosnakecase
+	} {
+		if ft.IsFeatureEnabled(feature) {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"denom %s with the %s feature can't be used as the reward", denom, feature,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setRewardPool(ctx sdk.Context, pool types.RewardPool) {
+	ctx.KVStore(k.storeKey).Set(types.GetRewardPoolKey(pool.ClassID), k.cdc.MustMarshal(&pool))
+}
+
+// distributeRewards distributes the reward of the block among the locked tokens of each pool. The rewards are accrued
+// in the reward per token of the pool, so the cost doesn't depend on the number of the locked tokens.
+func (k Keeper) distributeRewards(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RewardPoolKeyPrefix)
+	defer iterator.Close()
+
+	var pools []types.RewardPool
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.RewardPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		if pool.LockedCount > 0 && pool.Balance.IsPositive() {
+			pools = append(pools, pool)
+		}
+	}
+
+	for _, pool := range pools {
+		pool.Distribute()
+		k.setRewardPool(ctx, pool)
+	}
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_RewardPool(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{Time: now})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	for id, holder := range map[string]sdk.AccAddress{"id1": holder1, "id2": holder2} {
+		requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+			Sender:  issuer,
+			ClassID: classID,
+			ID:      id,
+		}))
+		requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, id, holder))
+	}
+
+	poolSettings := types.CreateRewardPoolSettings{
+		Sender:          issuer,
+		ClassID:         classID,
+		RewardPerBlock:  sdk.NewInt64Coin("ucore", 10),
+		MinLockDuration: time.Hour,
+	}
+
+	// the token can't be locked if the class has no pool
+	lockSettings := types.LockNFTSettings{Sender: holder1, ClassID: classID, ID: "id1"}
+	requireT.True(sdkerrors.ErrNotFound.Is(nftKeeper.LockNFT(ctx, lockSettings)))
+
+	// only the class owner can create the pool
+	invalidPoolSettings := poolSettings
+	invalidPoolSettings.Sender = holder1
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.CreateRewardPool(ctx, invalidPoolSettings)))
+
+	requireT.NoError(nftKeeper.CreateRewardPool(ctx, poolSettings))
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.CreateRewardPool(ctx, poolSettings)))
+
+	// the pool is funded in its denom only
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(sdk.NewInt64Coin("ucore", 25), sdk.NewInt64Coin("uother", 10))))
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.FundRewardPool(ctx, types.FundRewardPoolSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		Amount:  sdk.NewInt64Coin("uother", 10),
+	})))
+	requireT.NoError(nftKeeper.FundRewardPool(ctx, types.FundRewardPoolSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		Amount:  sdk.NewInt64Coin("ucore", 25),
+	}))
+
+	// nothing is distributed while no tokens are locked
+	nftKeeper.EndBlocker(ctx)
+	pool, found := nftKeeper.GetRewardPool(ctx, classID)
+	requireT.True(found)
+	requireT.Equal(sdk.NewInt(25).String(), pool.Balance.String())
+
+	// only the owner can lock the token
+	invalidLockSettings := lockSettings
+	invalidLockSettings.Sender = holder2
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.LockNFT(ctx, invalidLockSettings)))
+
+	requireT.NoError(nftKeeper.LockNFT(ctx, lockSettings))
+	requireT.True(types.ErrNFTLocked.Is(nftKeeper.LockNFT(ctx, lockSettings)))
+
+	// the locked token can't be transferred
+	requireT.True(types.ErrNFTLocked.Is(testApp.NFTKeeper.Transfer(ctx, classID, "id1", holder2)))
+
+	// the first token gets the whole reward of the block
+	nftKeeper.EndBlocker(ctx)
+	_, reward, err := nftKeeper.GetPendingReward(ctx, classID, "id1")
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("ucore", 10).String(), reward.String())
+
+	// the reward of the next blocks is shared among the locked tokens, until the pool runs out of funds
+	requireT.NoError(nftKeeper.LockNFT(ctx, types.LockNFTSettings{Sender: holder2, ClassID: classID, ID: "id2"}))
+	nftKeeper.EndBlocker(ctx)
+	nftKeeper.EndBlocker(ctx)
+	nftKeeper.EndBlocker(ctx)
+	pool, found = nftKeeper.GetRewardPool(ctx, classID)
+	requireT.True(found)
+	requireT.True(pool.Balance.IsZero())
+	_, reward, err = nftKeeper.GetPendingReward(ctx, classID, "id1")
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("ucore", 17).String(), reward.String())
+	_, reward, err = nftKeeper.GetPendingReward(ctx, classID, "id2")
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("ucore", 7).String(), reward.String())
+
+	// the token can't be unlocked before the min lock duration passes
+	unlockSettings := types.UnlockNFTSettings{Sender: holder1, ClassID: classID, ID: "id1"}
+	requireT.True(types.ErrNFTLocked.Is(nftKeeper.UnlockNFT(ctx, unlockSettings)))
+
+	// only the owner can unlock the token
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	invalidUnlockSettings := unlockSettings
+	invalidUnlockSettings.Sender = holder2
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.UnlockNFT(ctx, invalidUnlockSettings)))
+
+	// the reward is paid once the token is unlocked
+	requireT.NoError(nftKeeper.UnlockNFT(ctx, unlockSettings))
+	requireT.Equal(sdk.NewInt64Coin("ucore", 17).String(), testApp.BankKeeper.GetBalance(ctx, holder1, "ucore").String())
+	requireT.False(nftKeeper.IsNFTLocked(ctx, classID, "id1"))
+	_, _, err = nftKeeper.GetPendingReward(ctx, classID, "id1")
+	requireT.True(sdkerrors.ErrNotFound.Is(err))
+	requireT.True(sdkerrors.ErrNotFound.Is(nftKeeper.UnlockNFT(ctx, unlockSettings)))
+
+	// the unlocked token can be transferred
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", holder2))
+
+	pool, found = nftKeeper.GetRewardPool(ctx, classID)
+	requireT.True(found)
+	requireT.EqualValues(1, pool.LockedCount)
+}
+
+func TestKeeper_RewardPoolFTDenom(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{Time: now})
+	nftKeeper := testApp.AssetNFTKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", holder))
+
+	issue := func(subunit string, burnRate, sendCommissionRate sdk.Dec, features ...assetfttypes.TokenFeature) string {
+		denom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+			Issuer:             issuer,
+			Symbol:             subunit,
+			Subunit:            subunit,
+			Precision:          6,
+			InitialAmount:      sdk.NewInt(1000),
+			Features:           features,
+			BurnRate:           burnRate,
+			SendCommissionRate: sendCommissionRate,
+		})
+		requireT.NoError(err)
+		return denom
+	}
+
+	// the tokens the payout of which might be charged or blocked can't be used as the reward
+	for _, denom := range []string{
+		issue("burnrate", sdk.MustNewDecFromStr("0.1"), sdk.ZeroDec()),
+		issue("commission", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1")),
+		issue("freeze", sdk.ZeroDec(), sdk.ZeroDec(), assetfttypes.TokenFeature_freeze),       //nolint:nosnakecase
+		issue("whitelist", sdk.ZeroDec(), sdk.ZeroDec(), assetfttypes.TokenFeature_whitelist), //nolint:nosnakecase
+	} {
+		requireT.True(types.ErrInvalidInput.Is(nftKeeper.CreateRewardPool(ctx, types.CreateRewardPoolSettings{
+			Sender:          issuer,
+			ClassID:         classID,
+			RewardPerBlock:  sdk.NewInt64Coin(denom, 10),
+			MinLockDuration: time.Hour,
+		})), denom)
+	}
+
+	denom := issue("reward", sdk.ZeroDec(), sdk.ZeroDec(), assetfttypes.TokenFeature_mint) //nolint:nosnakecase
+	requireT.NoError(nftKeeper.CreateRewardPool(ctx, types.CreateRewardPoolSettings{
+		Sender:          issuer,
+		ClassID:         classID,
+		RewardPerBlock:  sdk.NewInt64Coin(denom, 10),
+		MinLockDuration: time.Hour,
+	}))
+	requireT.NoError(nftKeeper.FundRewardPool(ctx, types.FundRewardPoolSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		Amount:  sdk.NewInt64Coin(denom, 25),
+	}))
+
+	// the reward is paid in full
+	requireT.NoError(nftKeeper.LockNFT(ctx, types.LockNFTSettings{Sender: holder, ClassID: classID, ID: "id1"}))
+	nftKeeper.EndBlocker(ctx)
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	requireT.NoError(nftKeeper.UnlockNFT(ctx, types.UnlockNFTSettings{Sender: holder, ClassID: classID, ID: "id1"}))
+	requireT.Equal(sdk.NewInt64Coin(denom, 10).String(), testApp.BankKeeper.GetBalance(ctx, holder, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 975).String(), testApp.BankKeeper.GetBalance(ctx, issuer, denom).String())
+}
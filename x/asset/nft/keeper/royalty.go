@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// GetClassRoyaltyRate returns the rate of the price paid to the class owner on each sale of the tokens in the class.
+func (k Keeper) GetClassRoyaltyRate(ctx sdk.Context, classID string) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClassRoyaltyRateKey(classID))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var rate sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &rate)
+	return rate.Dec
+}
+
+func (k Keeper) setClassRoyaltyRate(ctx sdk.Context, classID string, rate sdk.Dec) {
+	ctx.KVStore(k.storeKey).Set(types.GetClassRoyaltyRateKey(classID), k.cdc.MustMarshal(&sdk.DecProto{Dec: rate}))
+}
+
+// payPrice transfers the price from the buyer to the seller. The royalty is deducted from the price and paid to
+// the class owner, unless the owner is the seller. The royalty and its recipient are returned.
+func (k Keeper) payPrice(
+	ctx sdk.Context,
+	classID string,
+	buyer, seller sdk.AccAddress,
+	price sdk.Coin,
+) (sdk.Coin, sdk.AccAddress, error) {
+	royalty := sdk.NewCoin(price.Denom, sdk.ZeroInt())
+	owner, err := k.GetClassOwner(ctx, classID)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if !owner.Equals(seller) {
+		royalty.Amount = k.GetClassRoyaltyRate(ctx, classID).MulInt(price.Amount).TruncateInt()
+	}
+
+	if royalty.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, buyer, owner, sdk.NewCoins(royalty)); err != nil {
+			return sdk.Coin{}, nil, sdkerrors.Wrapf(err, "can't pay the royalty %s", royalty)
+		}
+	}
+	if err := k.bankKeeper.SendCoins(ctx, buyer, seller, sdk.NewCoins(price.Sub(royalty))); err != nil {
+		return sdk.Coin{}, nil, sdkerrors.Wrapf(err, "can't pay the price %s", price)
+	}
+
+	return royalty, owner, nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_Royalty(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain", Height: 10})
+	nftKeeper := testApp.AssetNFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuerKey := secp256k1.GenPrivKey()
+	issuer := sdk.AccAddress(issuerKey.PubKey().Address())
+	resellerKey := secp256k1.GenPrivKey()
+	reseller := sdk.AccAddress(resellerKey.PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the rate out of the range is rejected
+	_, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		RoyaltyRate: sdk.MustNewDecFromStr("1.1"),
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		RoyaltyRate: sdk.MustNewDecFromStr("0.05"),
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.05").String(), nftKeeper.GetClassRoyaltyRate(ctx, classID).String())
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "id1"}))
+
+	// no royalty is paid when the class owner sells the token
+	price := sdk.NewInt64Coin("ucore", 1000)
+	requireT.NoError(testApp.FundAccount(ctx, reseller, sdk.NewCoins(price)))
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, types.TransferWithPaymentSettings{
+		Buyer: reseller,
+		Offer: signSaleOffer(t, issuerKey, ctx.ChainID(), types.SaleOffer{
+			Seller:  issuer.String(),
+			ClassID: classID,
+			ID:      "id1",
+			Price:   price,
+		}),
+	}))
+	requireT.Equal(price.String(), bankKeeper.GetBalance(ctx, issuer, price.Denom).String())
+
+	// the royalty is deducted from the price and paid to the class owner on the resale
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, resellerKey, ctx.ChainID(), types.SaleOffer{
+			Seller:  reseller.String(),
+			ClassID: classID,
+			ID:      "id1",
+			Price:   price,
+		}),
+	}))
+	requireT.Equal("1050ucore", bankKeeper.GetBalance(ctx, issuer, price.Denom).String())
+	requireT.Equal("950ucore", bankKeeper.GetBalance(ctx, reseller, price.Denom).String())
+	requireT.True(bankKeeper.GetBalance(ctx, buyer, price.Denom).IsZero())
+	requireT.Equal(buyer, testApp.NFTKeeper.GetOwner(ctx, classID, "id1"))
+}
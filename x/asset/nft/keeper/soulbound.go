@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+var soulboundClassStoreVal = []byte{0x01}
+
+// BurnNFT burns the non-fungible token of the soulbound class held by the sender. The holder of the soulbound token
+// can't transfer it, so burning is the only way to get rid of it. The locked token must be unlocked first.
+func (k Keeper) BurnNFT(ctx sdk.Context, settings types.BurnNFTSettings) error {
+	if !k.IsSoulbound(ctx, settings.ClassID) {
+		return sdkerrors.Wrapf(types.ErrFeatureNotActive, "class %q is not soulbound", settings.ClassID)
+	}
+	if !k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "nft with classID %q and ID %q not found", settings.ClassID, settings.ID)
+	}
+	if !k.nftKeeper.GetOwner(ctx, settings.ClassID, settings.ID).Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to burn the nft", settings.Sender.String())
+	}
+	if k.IsNFTLocked(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrNFTLocked, "nft %q is locked and can't be burnt", settings.ID)
+	}
+
+	k.deleteUserGrant(ctx, settings.ClassID, settings.ID)
+	if err := k.nftKeeper.Burn(ctx, settings.ClassID, settings.ID); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't burn non-fungible token: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, settings.Sender.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTBurnt{
+		ClassID: settings.ClassID,
+		ID:      settings.ID,
+		Owner:   settings.Sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventNFTBurnt: %s", err)
+	}
+
+	return nil
+}
+
+// IsSoulbound returns true if the tokens in the class can't be transferred.
+func (k Keeper) IsSoulbound(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetSoulboundClassKey(classID))
+}
+
+// checkSoulboundTransfer rejects the transfer of the token of the soulbound class unless it's sent by the class owner,
+// which delivers the minted token to its holder.
+func (k Keeper) checkSoulboundTransfer(ctx sdk.Context, classID, nftID string, sender sdk.AccAddress) error {
+	if !k.IsSoulbound(ctx, classID) {
+		return nil
+	}
+	classOwner, err := k.GetClassOwner(ctx, classID)
+	if err != nil {
+		return err
+	}
+	if !classOwner.Equals(sender) {
+		return sdkerrors.Wrapf(types.ErrNFTSoulbound, "nft %q of the soulbound class %q can't be transferred", nftID, classID)
+	}
+	return nil
+}
+
+func (k Keeper) enableSoulbound(ctx sdk.Context, classID string) {
+	ctx.KVStore(k.storeKey).Set(types.GetSoulboundClassKey(classID), soulboundClassStoreVal)
+}
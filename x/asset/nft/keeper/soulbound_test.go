@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_Soulbound(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:    issuer,
+		Symbol:    "symbol",
+		Soulbound: true,
+	})
+	requireT.NoError(err)
+	requireT.True(nftKeeper.IsSoulbound(ctx, classID))
+	requireT.False(nftKeeper.IsRevocable(ctx, classID))
+
+	// the class owner delivers the minted tokens to the holder
+	for _, id := range []string{"id1", "id2", "id3"} {
+		requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: id}))
+		requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, id, holder))
+	}
+
+	// the holder can't transfer the token, even back to the class owner
+	cacheCtx, _ := ctx.CacheContext()
+	requireT.True(types.ErrNFTSoulbound.Is(testApp.NFTKeeper.Transfer(cacheCtx, classID, "id1", recipient)))
+	cacheCtx, _ = ctx.CacheContext()
+	requireT.True(types.ErrNFTSoulbound.Is(testApp.NFTKeeper.Transfer(cacheCtx, classID, "id1", issuer)))
+
+	// the tokens of the class which is not soulbound can't be burnt by the holder
+	notSoulboundClassID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "notsoulbound",
+	})
+	requireT.NoError(err)
+	requireT.False(nftKeeper.IsSoulbound(ctx, notSoulboundClassID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: notSoulboundClassID, ID: "id1"}))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, notSoulboundClassID, "id1", holder))
+	requireT.True(types.ErrFeatureNotActive.Is(nftKeeper.BurnNFT(ctx, types.BurnNFTSettings{
+		Sender:  holder,
+		ClassID: notSoulboundClassID,
+		ID:      "id1",
+	})))
+
+	// only the holder can burn the existing token
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.BurnNFT(ctx, types.BurnNFTSettings{
+		Sender:  recipient,
+		ClassID: classID,
+		ID:      "id1",
+	})))
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.BurnNFT(ctx, types.BurnNFTSettings{
+		Sender:  holder,
+		ClassID: classID,
+		ID:      "missing",
+	})))
+	requireT.NoError(nftKeeper.BurnNFT(ctx, types.BurnNFTSettings{Sender: holder, ClassID: classID, ID: "id1"}))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "id1"))
+
+	// the class owner can burn the soulbound token held by any account even if the class isn't revocable
+	requireT.NoError(nftKeeper.RevokeNFT(ctx, types.RevokeNFTSettings{Sender: issuer, ClassID: classID, ID: "id2"}))
+	requireT.False(testApp.NFTKeeper.HasNFT(ctx, classID, "id2"))
+	requireT.True(testApp.NFTKeeper.HasNFT(ctx, classID, "id3"))
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+// GrantUser grants the time-bound right to use the non-fungible token to the user. The owner can't grant the right to
+// another user until the active right expires or is revoked by the user, but the current user's right can be extended.
+func (k Keeper) GrantUser(ctx sdk.Context, settings types.GrantUserSettings) error {
+	if !k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "nft with classID %q and ID %q not found", settings.ClassID, settings.ID)
+	}
+
+	owner := k.nftKeeper.GetOwner(ctx, settings.ClassID, settings.ID)
+	if !owner.Equals(settings.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %s is not the owner of nft %q", settings.Sender, settings.ID)
+	}
+
+	if !settings.Expiration.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "expiration %s must be after the current block time", settings.Expiration)
+	}
+
+	if grant, found := k.GetUserGrant(ctx, settings.ClassID, settings.ID); found {
+		if grant.User != settings.User.String() {
+			return sdkerrors.Wrapf(types.ErrUserGrantActive, "nft %q is used by %s until %s", settings.ID, grant.User, grant.Expiration)
+		}
+	}
+	k.deleteUserGrant(ctx, settings.ClassID, settings.ID)
+
+	grant := types.UserGrant{
+		ClassID:    settings.ClassID,
+		ID:         settings.ID,
+		Owner:      owner.String(),
+		User:       settings.User.String(),
+		Expiration: settings.Expiration,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUserGrantKey(grant.ClassID, grant.ID), k.cdc.MustMarshal(&grant))
+	store.Set(types.GetUserGrantExpirationQueueKey(grant.ClassID, grant.ID, grant.Expiration), types.GetUserGrantKey(grant.ClassID, grant.ID))
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(grant.ClassID, grant.Owner, grant.User))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserGranted{
+		ClassID:    grant.ClassID,
+		ID:         grant.ID,
+		Owner:      grant.Owner,
+		User:       grant.User,
+		Expiration: grant.Expiration,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventUserGranted: %s", err)
+	}
+
+	return nil
+}
+
+// RevokeUser gives up the right to use the non-fungible token. Only the user can revoke the right.
+func (k Keeper) RevokeUser(ctx sdk.Context, settings types.RevokeUserSettings) error {
+	grant, found := k.GetUserGrant(ctx, settings.ClassID, settings.ID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "nft %q is not used by anyone", settings.ID)
+	}
+	if grant.User != settings.Sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "nft %q is used by %s", settings.ID, grant.User)
+	}
+
+	k.deleteUserGrant(ctx, settings.ClassID, settings.ID)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(grant.ClassID, grant.User))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUserRevoked{
+		ClassID: grant.ClassID,
+		ID:      grant.ID,
+		User:    grant.User,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventUserRevoked: %s", err)
+	}
+
+	return nil
+}
+
+// GetUserGrant returns the active right to use the non-fungible token. The right is not returned if it has expired or
+// the token has been transferred to another owner since the right was granted.
+func (k Keeper) GetUserGrant(ctx sdk.Context, classID, id string) (types.UserGrant, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetUserGrantKey(classID, id))
+	if bz == nil {
+		return types.UserGrant{}, false
+	}
+
+	var grant types.UserGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	if !grant.IsActive(ctx.BlockTime()) {
+		return types.UserGrant{}, false
+	}
+	if grant.Owner != k.nftKeeper.GetOwner(ctx, classID, id).String() {
+		return types.UserGrant{}, false
+	}
+
+	return grant, true
+}
+
+// deleteExpiredUserGrants deletes the rights which have expired by the current block time.
+func (k Keeper) deleteExpiredUserGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.UserGrantExpirationQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.CreateUserGrantExpirationQueuePrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var grants []types.UserGrant
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.UserGrant
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &grant)
+		grants = append(grants, grant)
+	}
+
+	for _, grant := range grants {
+		k.deleteUserGrant(ctx, grant.ClassID, grant.ID)
+		ctx.EventManager().EmitEvent(types.NewIndexEvent(grant.ClassID, grant.User))
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventUserRevoked{
+			ClassID: grant.ClassID,
+			ID:      grant.ID,
+			User:    grant.User,
+			Expired: true,
+		}); err != nil {
+			panic(sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventUserRevoked: %s", err))
+		}
+	}
+}
+
+// deleteUserGrant deletes the right to use the non-fungible token together with its entry in the expiration queue.
+func (k Keeper) deleteUserGrant(ctx sdk.Context, classID, id string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetUserGrantKey(classID, id)
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var grant types.UserGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	store.Delete(key)
+	store.Delete(types.GetUserGrantExpirationQueueKey(classID, id, grant.Expiration))
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_GrantUser(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{Time: now})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherUser := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: owner,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  owner,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+
+	settings := types.GrantUserSettings{
+		Sender:     owner,
+		ClassID:    classID,
+		ID:         "id1",
+		User:       user,
+		Expiration: now.Add(time.Hour),
+	}
+
+	// only the owner can grant the right
+	invalidSettings := settings
+	invalidSettings.Sender = user
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.GrantUser(ctx, invalidSettings)))
+
+	// expiration must be in the future
+	invalidSettings = settings
+	invalidSettings.Expiration = now
+	requireT.True(types.ErrInvalidInput.Is(nftKeeper.GrantUser(ctx, invalidSettings)))
+
+	requireT.NoError(nftKeeper.GrantUser(ctx, settings))
+	grant, found := nftKeeper.GetUserGrant(ctx, classID, "id1")
+	requireT.True(found)
+	requireT.Equal(types.UserGrant{
+		ClassID:    classID,
+		ID:         "id1",
+		Owner:      owner.String(),
+		User:       user.String(),
+		Expiration: settings.Expiration,
+	}, grant)
+
+	// the right can't be granted to another user while it is active
+	invalidSettings = settings
+	invalidSettings.User = otherUser
+	requireT.True(types.ErrUserGrantActive.Is(nftKeeper.GrantUser(ctx, invalidSettings)))
+
+	// the right of the current user can be extended
+	settings.Expiration = now.Add(2 * time.Hour)
+	requireT.NoError(nftKeeper.GrantUser(ctx, settings))
+
+	// the right isn't expired by the end blocker before the expiration time
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	nftKeeper.EndBlocker(ctx)
+	_, found = nftKeeper.GetUserGrant(ctx, classID, "id1")
+	requireT.True(found)
+
+	// the right is expired by the end blocker
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	_, found = nftKeeper.GetUserGrant(ctx, classID, "id1")
+	requireT.False(found)
+	nftKeeper.EndBlocker(ctx)
+	requireT.Nil(ctx.KVStore(testApp.GetKey(types.StoreKey)).Get(types.GetUserGrantKey(classID, "id1")))
+
+	// the right is granted to another user after the previous one expired
+	settings.User = otherUser
+	settings.Expiration = now.Add(3 * time.Hour)
+	requireT.NoError(nftKeeper.GrantUser(ctx, settings))
+
+	// the right is invalidated once the token is transferred
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", user))
+	_, found = nftKeeper.GetUserGrant(ctx, classID, "id1")
+	requireT.False(found)
+}
+
+func TestKeeper_RevokeUser(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{Time: now})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	user := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: owner,
+		Symbol: "symbol",
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:  owner,
+		ClassID: classID,
+		ID:      "id1",
+	}))
+
+	revokeSettings := types.RevokeUserSettings{
+		Sender:  user,
+		ClassID: classID,
+		ID:      "id1",
+	}
+	requireT.True(sdkerrors.ErrNotFound.Is(nftKeeper.RevokeUser(ctx, revokeSettings)))
+
+	requireT.NoError(nftKeeper.GrantUser(ctx, types.GrantUserSettings{
+		Sender:     owner,
+		ClassID:    classID,
+		ID:         "id1",
+		User:       user,
+		Expiration: now.Add(time.Hour),
+	}))
+
+	// the owner can't revoke the right of the user
+	invalidSettings := revokeSettings
+	invalidSettings.Sender = owner
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.RevokeUser(ctx, invalidSettings)))
+
+	requireT.NoError(nftKeeper.RevokeUser(ctx, revokeSettings))
+	_, found := nftKeeper.GetUserGrant(ctx, classID, "id1")
+	requireT.False(found)
+
+	// the expiration queue entry is removed together with the right
+	store := ctx.KVStore(testApp.GetKey(types.StoreKey))
+	requireT.Nil(store.Get(types.GetUserGrantExpirationQueueKey(classID, "id1", now.Add(time.Hour))))
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+var (
+	whitelistingClassStoreVal = []byte{0x01}
+	classWhitelistStoreVal    = []byte{0x01}
+)
+
+// AddToClassWhitelist allows the account to receive the tokens of the class. Only the owner of the class issued with
+// the whitelisting feature can change its whitelist.
+func (k Keeper) AddToClassWhitelist(ctx sdk.Context, settings types.ClassWhitelistSettings) error {
+	owner, err := k.checkWhitelistingAllowed(ctx, settings)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetClassWhitelistKey(settings.ClassID, settings.Account), classWhitelistStoreVal)
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, settings.Account.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddedToClassWhitelist{
+		ClassID: settings.ClassID,
+		Owner:   owner.String(),
+		Account: settings.Account.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventAddedToClassWhitelist: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveFromClassWhitelist disallows the account to receive the tokens of the class. The tokens already held by the
+// account are not affected.
+func (k Keeper) RemoveFromClassWhitelist(ctx sdk.Context, settings types.ClassWhitelistSettings) error {
+	owner, err := k.checkWhitelistingAllowed(ctx, settings)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetClassWhitelistKey(settings.ClassID, settings.Account))
+
+	ctx.EventManager().EmitEvent(types.NewIndexEvent(settings.ClassID, settings.Account.String()))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRemovedFromClassWhitelist{
+		ClassID: settings.ClassID,
+		Owner:   owner.String(),
+		Account: settings.Account.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventRemovedFromClassWhitelist: %s", err)
+	}
+
+	return nil
+}
+
+// IsWhitelistingEnabled returns true if the class restricts the accounts allowed to receive its tokens.
+func (k Keeper) IsWhitelistingEnabled(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetWhitelistingClassKey(classID))
+}
+
+// IsWhitelisted returns true if the account is on the whitelist of the class.
+func (k Keeper) IsWhitelisted(ctx sdk.Context, classID string, account sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetClassWhitelistKey(classID, account))
+}
+
+// GetClassWhitelistedAccounts returns the accounts on the whitelist of the class.
+func (k Keeper) GetClassWhitelistedAccounts(
+	ctx sdk.Context,
+	classID string,
+	pagination *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	accounts := []string{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateClassWhitelistPrefix(classID))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		accounts = append(accounts, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accounts, pageRes, nil
+}
+
+func (k Keeper) enableWhitelisting(ctx sdk.Context, classID string) {
+	ctx.KVStore(k.storeKey).Set(types.GetWhitelistingClassKey(classID), whitelistingClassStoreVal)
+}
+
+// checkReceivingAllowed returns an error if the class restricts the recipients of its tokens and the account is
+// neither on the whitelist nor the owner of the class.
+func (k Keeper) checkReceivingAllowed(ctx sdk.Context, classID string, account sdk.AccAddress) error {
+	if !k.IsWhitelistingEnabled(ctx, classID) || k.IsWhitelisted(ctx, classID, account) {
+		return nil
+	}
+	isOwner, err := k.isClassOwner(ctx, account, classID)
+	if err != nil {
+		return err
+	}
+	if isOwner {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrNotWhitelisted, "account %q is not allowed to receive the tokens of class %q",
+		account.String(), classID)
+}
+
+func (k Keeper) checkWhitelistingAllowed(ctx sdk.Context, settings types.ClassWhitelistSettings) (sdk.AccAddress, error) {
+	owner, err := k.GetClassOwner(ctx, settings.ClassID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(settings.Sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "address %q is unauthorized to change the class whitelist", settings.Sender.String())
+	}
+	if !k.IsWhitelistingEnabled(ctx, settings.ClassID) {
+		return nil, sdkerrors.Wrapf(types.ErrFeatureNotActive, "whitelisting is not enabled in class %q", settings.ClassID)
+	}
+
+	return owner, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestKeeper_ClassWhitelist(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:       issuer,
+		Symbol:       "symbol",
+		Whitelisting: true,
+	})
+	requireT.NoError(err)
+	requireT.True(nftKeeper.IsWhitelistingEnabled(ctx, classID))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: classID, ID: "id1"}))
+
+	// the class without the whitelisting feature doesn't restrict the recipients
+	notWhitelistingClassID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "notwhitelisting",
+	})
+	requireT.NoError(err)
+	requireT.False(nftKeeper.IsWhitelistingEnabled(ctx, notWhitelistingClassID))
+	requireT.True(types.ErrFeatureNotActive.Is(nftKeeper.AddToClassWhitelist(ctx, types.ClassWhitelistSettings{
+		Sender:  issuer,
+		ClassID: notWhitelistingClassID,
+		Account: recipient,
+	})))
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: issuer, ClassID: notWhitelistingClassID, ID: "id1"}))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, notWhitelistingClassID, "id1", recipient))
+
+	// the token can't be received by the account not whitelisted, the failed transfer is reverted by the cache context
+	cacheCtx, _ := ctx.CacheContext()
+	requireT.True(types.ErrNotWhitelisted.Is(testApp.NFTKeeper.Transfer(cacheCtx, classID, "id1", recipient)))
+
+	// only the owner can change the whitelist
+	settings := types.ClassWhitelistSettings{Sender: issuer, ClassID: classID, Account: recipient}
+	invalidSettings := types.ClassWhitelistSettings{Sender: recipient, ClassID: classID, Account: recipient}
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.AddToClassWhitelist(ctx, invalidSettings)))
+	requireT.NoError(nftKeeper.AddToClassWhitelist(ctx, settings))
+	requireT.True(nftKeeper.IsWhitelisted(ctx, classID, recipient))
+	requireT.True(sdkerrors.ErrUnauthorized.Is(nftKeeper.RemoveFromClassWhitelist(ctx, invalidSettings)))
+
+	accounts, _, err := nftKeeper.GetClassWhitelistedAccounts(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{recipient.String()}, accounts)
+
+	// the whitelisted account receives the token
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", recipient))
+
+	// the account removed from the whitelist keeps the token, and the owner of the class can always receive it back
+	requireT.NoError(nftKeeper.RemoveFromClassWhitelist(ctx, settings))
+	requireT.False(nftKeeper.IsWhitelisted(ctx, classID, recipient))
+	requireT.Equal(recipient, testApp.NFTKeeper.GetOwner(ctx, classID, "id1"))
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, "id1", issuer))
+	cacheCtx, _ = ctx.CacheContext()
+	requireT.True(types.ErrNotWhitelisted.Is(testApp.NFTKeeper.Transfer(cacheCtx, classID, "id1", recipient)))
+
+	accounts, _, err = nftKeeper.GetClassWhitelistedAccounts(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.Empty(accounts)
+}
+
+func TestKeeper_ClassWhitelist_TransferWithPayment(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{ChainID: "test-chain", Height: 10})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	sellerKey := secp256k1.GenPrivKey()
+	seller := sdk.AccAddress(sellerKey.PubKey().Address())
+	buyer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:       seller,
+		Symbol:       "symbol",
+		Whitelisting: true,
+	})
+	requireT.NoError(err)
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{Sender: seller, ClassID: classID, ID: "id1"}))
+
+	price := sdk.NewInt64Coin("ucore", 100)
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+	settings := types.TransferWithPaymentSettings{
+		Buyer: buyer,
+		Offer: signSaleOffer(t, sellerKey, ctx.ChainID(), types.SaleOffer{
+			Seller:  seller.String(),
+			ClassID: classID,
+			ID:      "id1",
+			Price:   price,
+		}),
+	}
+
+	// the offer can't be accepted by the buyer not whitelisted
+	requireT.ErrorIs(nftKeeper.TransferWithPayment(ctx, settings), types.ErrNotWhitelisted)
+
+	requireT.NoError(nftKeeper.AddToClassWhitelist(ctx, types.ClassWhitelistSettings{
+		Sender:  seller,
+		ClassID: classID,
+		Account: buyer,
+	}))
+	requireT.NoError(nftKeeper.TransferWithPayment(ctx, settings))
+	requireT.Equal(buyer, testApp.NFTKeeper.GetOwner(ctx, classID, "id1"))
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/samber/lo"
+)
+
+var _ authz.Authorization = &MintAuthorization{}
+
+// NewMintAuthorization creates a new MintAuthorization object.
+func NewMintAuthorization(classID string, idPrefixes []string) *MintAuthorization {
+	return &MintAuthorization{
+		ClassID:    classID,
+		IDPrefixes: idPrefixes,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MintAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMint{})
+}
+
+// Accept implements Authorization.Accept.
+func (a MintAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mintMsg, ok := msg.(*MsgMint)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	if mintMsg.ClassID != a.ClassID {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "minting in class %q is not authorized", mintMsg.ClassID)
+	}
+
+	prefix, ok := IDPrefix(mintMsg.ID)
+	if !ok || !lo.Contains(a.IDPrefixes, prefix) {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "minting ID %q is not authorized", mintMsg.ID)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MintAuthorization) ValidateBasic() error {
+	if _, err := DeconstructClassID(a.ClassID); err != nil {
+		return err
+	}
+
+	if len(a.IDPrefixes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one ID prefix must be authorized")
+	}
+
+	if len(lo.Uniq(a.IDPrefixes)) != len(a.IDPrefixes) {
+		return sdkerrors.Wrap(ErrInvalidInput, "ID prefixes must be unique")
+	}
+
+	for _, prefix := range a.IDPrefixes {
+		if err := ValidateIDPrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
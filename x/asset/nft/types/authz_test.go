@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestMintAuthorization(t *testing.T) {
+	requireT := require.New(t)
+
+	classID := "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	authorization := types.NewMintAuthorization(classID, []string{"gen1/", "gen2/"})
+	requireT.NoError(authorization.ValidateBasic())
+	requireT.Equal("/coreum.asset.nft.v1.MsgMint", authorization.MsgTypeURL())
+
+	ctx := sdk.Context{}
+	res, err := authorization.Accept(ctx, &types.MsgMint{ClassID: classID, ID: "gen2/id1"})
+	requireT.NoError(err)
+	requireT.True(res.Accept)
+	requireT.False(res.Delete)
+
+	// ID without prefix
+	_, err = authorization.Accept(ctx, &types.MsgMint{ClassID: classID, ID: "id1"})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// ID with prefix which is not authorized
+	_, err = authorization.Accept(ctx, &types.MsgMint{ClassID: classID, ID: "gen3/id1"})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// other class
+	_, err = authorization.Accept(ctx, &types.MsgMint{
+		ClassID: "other-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "gen1/id1",
+	})
+	requireT.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// other message
+	_, err = authorization.Accept(ctx, &types.MsgIssueClass{})
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidType)
+
+	requireT.ErrorIs(types.NewMintAuthorization("x", []string{"gen1/"}).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewMintAuthorization(classID, nil).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewMintAuthorization(classID, []string{"gen1/", "gen1/"}).ValidateBasic(), types.ErrInvalidInput)
+	requireT.ErrorIs(types.NewMintAuthorization(classID, []string{"gen1"}).ValidateBasic(), types.ErrInvalidInput)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"regexp"
+
+	codetypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+const dataEnvelopeMaxKeyIDLength = 128
+
+var (
+	dataEncryptionSchemeRegexStr = `^[a-z0-9][a-z0-9-]{0,63}$`
+	dataEncryptionSchemeRegex    = regexp.MustCompile(dataEncryptionSchemeRegexStr)
+)
+
+// ValidateData checks the data of the non-fungible token class or the non-fungible token. If the data is packed into
+// the DataEnvelope, the envelope must be valid.
+func ValidateData(data *codetypes.Any) error {
+	if data == nil || data.TypeUrl != "/"+proto.MessageName(&DataEnvelope{}) {
+		return nil
+	}
+
+	var envelope DataEnvelope
+	if err := proto.Unmarshal(data.Value, &envelope); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid data envelope: %s", err)
+	}
+
+	return envelope.Validate()
+}
+
+// Validate checks the data envelope is valid.
+func (e DataEnvelope) Validate() error {
+	if e.EncryptionScheme == "" {
+		if e.KeyID != "" {
+			return sdkerrors.Wrap(ErrInvalidInput, "key id must be empty if the data is not encrypted")
+		}
+	} else if !dataEncryptionSchemeRegex.MatchString(e.EncryptionScheme) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "encryption scheme must match regex format '%s'", dataEncryptionSchemeRegexStr)
+	}
+
+	if len(e.KeyID) > dataEnvelopeMaxKeyIDLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "key id length must be less than or equal %d", dataEnvelopeMaxKeyIDLength)
+	}
+
+	if len(e.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "data of the envelope must not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	codetypes "github.com/cosmos/cosmos-sdk/codec/types"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestDataEnvelope_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		envelope types.DataEnvelope
+		valid    bool
+	}{
+		{
+			name:     "plain",
+			envelope: types.DataEnvelope{Data: []byte("data")},
+			valid:    true,
+		},
+		{
+			name: "encrypted",
+			envelope: types.DataEnvelope{
+				EncryptionScheme: "ecies-secp256k1-aes256gcm",
+				KeyID:            "devcore1ktuwcqkrrf0ad6z5zcjvkv89ya5spvq9s38l0h",
+				Data:             []byte("data"),
+			},
+			valid: true,
+		},
+		{
+			name:     "key id of plain data",
+			envelope: types.DataEnvelope{KeyID: "key", Data: []byte("data")},
+		},
+		{
+			name:     "invalid scheme",
+			envelope: types.DataEnvelope{EncryptionScheme: "ECIES_secp256k1", Data: []byte("data")},
+		},
+		{
+			name: "too long key id",
+			envelope: types.DataEnvelope{
+				EncryptionScheme: "ecies-secp256k1-aes256gcm",
+				KeyID:            strings.Repeat("k", 129),
+				Data:             []byte("data"),
+			},
+		},
+		{
+			name:     "empty data",
+			envelope: types.DataEnvelope{EncryptionScheme: "ecies-secp256k1-aes256gcm"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.envelope.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, types.ErrInvalidInput.Is(err))
+
+			// the envelope packed into the data is validated too
+			data, err := codetypes.NewAnyWithValue(&tc.envelope)
+			require.NoError(t, err)
+			require.True(t, types.ErrInvalidInput.Is(types.ValidateData(data)))
+		})
+	}
+
+	// the data of other types is not validated
+	data, err := codetypes.NewAnyWithValue(&gogotypes.BytesValue{})
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateData(data))
+	require.NoError(t, types.ValidateData(nil))
+}
@@ -9,4 +9,28 @@ var (
 	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
 	// ErrInvalidID is returned when the provided id is not of valid format
 	ErrInvalidID = sdkerrors.Register(ModuleName, 2, "id format is not valid")
+	// ErrIDPrefixNotReserved is returned when the id uses the prefix which is not reserved in the class
+	ErrIDPrefixNotReserved = sdkerrors.Register(ModuleName, 3, "id prefix is not reserved")
+	// ErrInvalidSaleOffer is returned when the sale offer is not signed by the seller or can't be accepted
+	ErrInvalidSaleOffer = sdkerrors.Register(ModuleName, 4, "invalid sale offer")
+	// ErrSaleOfferExpired is returned when the sale offer is accepted after its expiration height
+	ErrSaleOfferExpired = sdkerrors.Register(ModuleName, 5, "sale offer expired")
+	// ErrSaleOfferAlreadyAccepted is returned when the sale offer has been accepted already
+	ErrSaleOfferAlreadyAccepted = sdkerrors.Register(ModuleName, 6, "sale offer already accepted")
+	// ErrUserGrantActive is returned when the right to use the non-fungible token is granted to another user already
+	ErrUserGrantActive = sdkerrors.Register(ModuleName, 7, "user grant is active")
+	// ErrNFTLocked is returned when the non-fungible token is locked to earn the rewards
+	ErrNFTLocked = sdkerrors.Register(ModuleName, 8, "nft is locked")
+	// ErrFeatureNotActive is returned when the feature required by the operation is not enabled in the class
+	ErrFeatureNotActive = sdkerrors.Register(ModuleName, 9, "class feature is not active")
+	// ErrClassFrozen is returned when the non-fungible token is transferred while its class is frozen
+	ErrClassFrozen = sdkerrors.Register(ModuleName, 10, "class is frozen")
+	// ErrInvalidOwnershipProof is returned when the proof of the non-fungible token ownership doesn't match the app hash
+	ErrInvalidOwnershipProof = sdkerrors.Register(ModuleName, 11, "invalid ownership proof")
+	// ErrNotWhitelisted is returned when the non-fungible token is received by the account not whitelisted in its class
+	ErrNotWhitelisted = sdkerrors.Register(ModuleName, 12, "account is not whitelisted")
+	// ErrNFTSoulbound is returned when the non-fungible token of the soulbound class is transferred
+	ErrNFTSoulbound = sdkerrors.Register(ModuleName, 13, "nft is soulbound")
+	// ErrSaleOfferCanceled is returned when the sale offer canceled by the seller is accepted
+	ErrSaleOfferCanceled = sdkerrors.Register(ModuleName, 14, "sale offer canceled")
 )
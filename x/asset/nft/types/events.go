@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The index event is emitted next to each typed event of the module. The attributes of the typed events are
+// JSON-encoded, so they can't be matched by the plain values in the Tendermint tx_search queries, while the attributes
+// of the index event can, e.g. `assetnft.class_id='abc-devcore1...'` or `assetnft.account='devcore1...'`.
+const (
+	// EventTypeIndex is the type of the event indexing the typed events of the module.
+	EventTypeIndex = "assetnft"
+
+	// AttributeKeyClassID is the attribute holding the ID of the non-fungible token class.
+	AttributeKeyClassID = "class_id"
+	// AttributeKeyIssuer is the attribute holding the address of the issuer of the class.
+	AttributeKeyIssuer = "issuer"
+	// AttributeKeyAccount is the attribute holding the address of the account affected by the event. It is repeated
+	// if the event affects more than one account.
+	AttributeKeyAccount = "account"
+)
+
+// NewIndexEvent returns the event indexing the typed event by the class ID, the issuer taken from the class ID and the
+// accounts affected by it.
+func NewIndexEvent(classID string, accounts ...string) sdk.Event {
+	attributes := make([]sdk.Attribute, 0, 2+len(accounts))
+	attributes = append(attributes, sdk.NewAttribute(AttributeKeyClassID, classID))
+	if issuer, err := DeconstructClassID(classID); err == nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyIssuer, issuer.String()))
+	}
+	seen := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if account == "" {
+			continue
+		}
+		if _, ok := seen[account]; ok {
+			continue
+		}
+		seen[account] = struct{}{}
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyAccount, account))
+	}
+
+	return sdk.NewEvent(EventTypeIndex, attributes...)
+}
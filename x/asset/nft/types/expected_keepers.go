@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreum/x/nft"
 )
 
@@ -10,7 +11,23 @@ import (
 type NFTKeeper interface {
 	SaveClass(ctx sdk.Context, class nft.Class) error
 	HasClass(ctx sdk.Context, classID string) bool
+	GetClass(ctx sdk.Context, classID string) (nft.Class, bool)
 	GetClasses(ctx sdk.Context) (classes []*nft.Class)
 	HasNFT(ctx sdk.Context, classID, id string) bool
 	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress
+	Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID, nftID string) error
+}
+
+// BankKeeper defines the expected bank interface.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FTKeeper defines the expected fungible token interface.
+type FTKeeper interface {
+	GetTokenDefinition(ctx sdk.Context, denom string) (assetfttypes.FTDefinition, error)
 }
@@ -0,0 +1,13 @@
+package types
+
+// DefaultGenesis returns the default asset nft genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (m GenesisState) Validate() error {
+	return m.Params.ValidateBasic()
+}
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "assetnft"
@@ -13,3 +21,169 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// Store key prefixes
+var (
+	// IDPrefixReservationKeyPrefix defines the key prefix for the reserved non-fungible token ID prefixes.
+	IDPrefixReservationKeyPrefix = []byte{0x01}
+	// AcceptedSaleOfferKeyPrefix defines the key prefix for the accepted sale offers.
+	AcceptedSaleOfferKeyPrefix = []byte{0x02}
+	// UserGrantKeyPrefix defines the key prefix for the rights to use the non-fungible tokens.
+	UserGrantKeyPrefix = []byte{0x03}
+	// UserGrantExpirationQueueKeyPrefix defines the key prefix for the queue of the rights ordered by expiration time.
+	UserGrantExpirationQueueKeyPrefix = []byte{0x04}
+	// ProvenanceClassKeyPrefix defines the key prefix for the classes recording the provenance of the tokens.
+	ProvenanceClassKeyPrefix = []byte{0x05}
+	// ProvenanceRecordKeyPrefix defines the key prefix for the provenance records of the non-fungible tokens.
+	ProvenanceRecordKeyPrefix = []byte{0x06}
+	// ClassOwnerKeyPrefix defines the key prefix for the owners of the classes transferred by their issuers.
+	ClassOwnerKeyPrefix = []byte{0x07}
+	// PendingClassOwnerKeyPrefix defines the key prefix for the owners the class ownership is proposed to.
+	PendingClassOwnerKeyPrefix = []byte{0x08}
+	// RewardPoolKeyPrefix defines the key prefix for the reward pools of the classes.
+	RewardPoolKeyPrefix = []byte{0x09}
+	// NFTLockKeyPrefix defines the key prefix for the locks of the non-fungible tokens earning the rewards.
+	NFTLockKeyPrefix = []byte{0x0a}
+	// FreezingClassKeyPrefix defines the key prefix for the classes allowing the owner to freeze them.
+	FreezingClassKeyPrefix = []byte{0x0b}
+	// FrozenClassKeyPrefix defines the key prefix for the frozen classes.
+	FrozenClassKeyPrefix = []byte{0x0c}
+	// RevocableClassKeyPrefix defines the key prefix for the classes allowing the owner to revoke the tokens.
+	RevocableClassKeyPrefix = []byte{0x0d}
+	// WhitelistingClassKeyPrefix defines the key prefix for the classes restricting the recipients of the tokens.
+	WhitelistingClassKeyPrefix = []byte{0x0e}
+	// ClassWhitelistKeyPrefix defines the key prefix for the accounts allowed to receive the tokens of the classes.
+	ClassWhitelistKeyPrefix = []byte{0x0f}
+	// ClassRoyaltyRateKeyPrefix defines the key prefix for the royalty rates of the classes.
+	ClassRoyaltyRateKeyPrefix = []byte{0x10}
+	// SoulboundClassKeyPrefix defines the key prefix for the classes with the non-transferable tokens.
+	SoulboundClassKeyPrefix = []byte{0x11}
+	// IssuerClassKeyPrefix defines the key prefix for the classes indexed by their issuers.
+	IssuerClassKeyPrefix = []byte{0x12}
+	// CanceledSaleOfferKeyPrefix defines the key prefix for the sale offers canceled by the sellers.
+	CanceledSaleOfferKeyPrefix = []byte{0x13}
+)
+
+// CreateIDPrefixReservationsPrefix creates the prefix for the reserved ID prefixes of the class.
+func CreateIDPrefixReservationsPrefix(classID string) []byte {
+	return store.JoinKeysWithLength(IDPrefixReservationKeyPrefix, []byte(classID))
+}
+
+// GetIDPrefixReservationKey constructs the key for the reserved ID prefix of the class.
+func GetIDPrefixReservationKey(classID, prefix string) []byte {
+	return store.JoinKeys(CreateIDPrefixReservationsPrefix(classID), []byte(prefix))
+}
+
+// GetAcceptedSaleOfferKey constructs the key for the accepted sale offer.
+func GetAcceptedSaleOfferKey(offerHash []byte) []byte {
+	return store.JoinKeys(AcceptedSaleOfferKeyPrefix, offerHash)
+}
+
+// GetCanceledSaleOfferKey constructs the key for the canceled sale offer.
+func GetCanceledSaleOfferKey(offerHash []byte) []byte {
+	return store.JoinKeys(CanceledSaleOfferKeyPrefix, offerHash)
+}
+
+// GetUserGrantKey constructs the key for the right to use the non-fungible token.
+func GetUserGrantKey(classID, id string) []byte {
+	return store.JoinKeys(UserGrantKeyPrefix, nftKey(classID, id))
+}
+
+// CreateUserGrantExpirationQueuePrefix creates the prefix for the rights expiring at the time.
+func CreateUserGrantExpirationQueuePrefix(expiration time.Time) []byte {
+	return store.JoinKeys(UserGrantExpirationQueueKeyPrefix, sdk.FormatTimeBytes(expiration))
+}
+
+// GetUserGrantExpirationQueueKey constructs the key for the right in the expiration queue.
+func GetUserGrantExpirationQueueKey(classID, id string, expiration time.Time) []byte {
+	return store.JoinKeys(CreateUserGrantExpirationQueuePrefix(expiration), nftKey(classID, id))
+}
+
+// GetProvenanceClassKey constructs the key for the class recording the provenance of the tokens.
+func GetProvenanceClassKey(classID string) []byte {
+	return store.JoinKeys(ProvenanceClassKeyPrefix, []byte(classID))
+}
+
+// CreateProvenanceRecordsPrefix creates the prefix for the provenance records of the non-fungible token.
+func CreateProvenanceRecordsPrefix(classID, id string) []byte {
+	return store.JoinKeysWithLength(ProvenanceRecordKeyPrefix, nftKey(classID, id))
+}
+
+// GetProvenanceRecordKey constructs the key for the provenance record of the non-fungible token.
+func GetProvenanceRecordKey(classID, id string, sequence uint64) []byte {
+	return store.JoinKeys(CreateProvenanceRecordsPrefix(classID, id), sdk.Uint64ToBigEndian(sequence))
+}
+
+// GetClassOwnerKey constructs the key for the owner of the class.
+func GetClassOwnerKey(classID string) []byte {
+	return store.JoinKeys(ClassOwnerKeyPrefix, []byte(classID))
+}
+
+// GetPendingClassOwnerKey constructs the key for the owner the class ownership is proposed to.
+func GetPendingClassOwnerKey(classID string) []byte {
+	return store.JoinKeys(PendingClassOwnerKeyPrefix, []byte(classID))
+}
+
+// GetRewardPoolKey constructs the key for the reward pool of the class.
+func GetRewardPoolKey(classID string) []byte {
+	return store.JoinKeys(RewardPoolKeyPrefix, []byte(classID))
+}
+
+// GetNFTLockKey constructs the key for the lock of the non-fungible token.
+func GetNFTLockKey(classID, id string) []byte {
+	return store.JoinKeys(NFTLockKeyPrefix, nftKey(classID, id))
+}
+
+// GetFreezingClassKey constructs the key for the class allowing the owner to freeze it.
+func GetFreezingClassKey(classID string) []byte {
+	return store.JoinKeys(FreezingClassKeyPrefix, []byte(classID))
+}
+
+// GetFrozenClassKey constructs the key for the frozen class.
+func GetFrozenClassKey(classID string) []byte {
+	return store.JoinKeys(FrozenClassKeyPrefix, []byte(classID))
+}
+
+// GetRevocableClassKey constructs the key for the class allowing the owner to revoke the tokens.
+func GetRevocableClassKey(classID string) []byte {
+	return store.JoinKeys(RevocableClassKeyPrefix, []byte(classID))
+}
+
+// GetWhitelistingClassKey constructs the key for the class restricting the recipients of the tokens.
+func GetWhitelistingClassKey(classID string) []byte {
+	return store.JoinKeys(WhitelistingClassKeyPrefix, []byte(classID))
+}
+
+// CreateClassWhitelistPrefix creates the prefix for the accounts allowed to receive the tokens of the class.
+func CreateClassWhitelistPrefix(classID string) []byte {
+	return store.JoinKeysWithLength(ClassWhitelistKeyPrefix, []byte(classID))
+}
+
+// GetClassWhitelistKey constructs the key for the account allowed to receive the tokens of the class.
+func GetClassWhitelistKey(classID string, account sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateClassWhitelistPrefix(classID), account)
+}
+
+// GetClassRoyaltyRateKey constructs the key for the royalty rate of the class.
+func GetClassRoyaltyRateKey(classID string) []byte {
+	return store.JoinKeys(ClassRoyaltyRateKeyPrefix, []byte(classID))
+}
+
+func nftKey(classID, id string) []byte {
+	return store.JoinKeys(store.JoinKeysWithLength(nil, []byte(classID)), []byte(id))
+}
+
+// GetSoulboundClassKey constructs the key for the class with the non-transferable tokens.
+func GetSoulboundClassKey(classID string) []byte {
+	return store.JoinKeys(SoulboundClassKeyPrefix, []byte(classID))
+}
+
+// CreateIssuerClassesPrefix creates the prefix for the classes issued by the account.
+func CreateIssuerClassesPrefix(issuer sdk.AccAddress) []byte {
+	return store.JoinKeysWithLength(IssuerClassKeyPrefix, issuer)
+}
+
+// GetIssuerClassKey constructs the key for the class issued by the account.
+func GetIssuerClassKey(issuer sdk.AccAddress, classID string) []byte {
+	return store.JoinKeys(CreateIssuerClassesPrefix(issuer), []byte(classID))
+}
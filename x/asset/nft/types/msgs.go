@@ -8,6 +8,23 @@ import (
 var (
 	_ sdk.Msg = &MsgIssueClass{}
 	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgReserveIDPrefix{}
+	_ sdk.Msg = &MsgTransferWithPayment{}
+	_ sdk.Msg = &MsgCancelSaleOffer{}
+	_ sdk.Msg = &MsgGrantUser{}
+	_ sdk.Msg = &MsgRevokeUser{}
+	_ sdk.Msg = &MsgTransferClassOwnership{}
+	_ sdk.Msg = &MsgAcceptClassOwnership{}
+	_ sdk.Msg = &MsgCreateRewardPool{}
+	_ sdk.Msg = &MsgFundRewardPool{}
+	_ sdk.Msg = &MsgLockNFT{}
+	_ sdk.Msg = &MsgUnlockNFT{}
+	_ sdk.Msg = &MsgClassFreeze{}
+	_ sdk.Msg = &MsgClassUnfreeze{}
+	_ sdk.Msg = &MsgRevokeNFT{}
+	_ sdk.Msg = &MsgAddToClassWhitelist{}
+	_ sdk.Msg = &MsgRemoveFromClassWhitelist{}
+	_ sdk.Msg = &MsgBurnNFT{}
 )
 
 const (
@@ -48,6 +65,14 @@ func (msg *MsgIssueClass) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid data, it's allowed to use %d bytes", nftMaxDataSize)
 	}
 
+	if err := ValidateData(msg.Data); err != nil {
+		return err
+	}
+
+	if err := ValidateRoyaltyRate(msg.RoyaltyRate); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -84,6 +109,10 @@ func (msg *MsgMint) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid data, it's allowed to use %d bytes", nftMaxDataSize)
 	}
 
+	if err := ValidateData(msg.Data); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -93,3 +122,382 @@ func (msg *MsgMint) GetSigners() []sdk.AccAddress {
 		sdk.MustAccAddressFromBech32(msg.Sender),
 	}
 }
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgReserveIDPrefix) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return ValidateIDPrefix(msg.Prefix)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgReserveIDPrefix) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgTransferWithPayment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if err := msg.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.Sender == msg.Offer.Offer.Seller {
+		return sdkerrors.Wrap(ErrInvalidInput, "seller can't accept its own offer")
+	}
+
+	if msg.Offer.Offer.Buyer != "" && msg.Sender != msg.Offer.Offer.Buyer {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "offer can be accepted by %s only", msg.Offer.Offer.Buyer)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgTransferWithPayment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgCancelSaleOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if err := msg.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if msg.Sender != msg.Offer.Seller {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "offer can be canceled by %s only", msg.Offer.Seller)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgCancelSaleOffer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgGrantUser) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.User); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid user account %s", msg.User)
+	}
+
+	if msg.Sender == msg.User {
+		return sdkerrors.Wrap(ErrInvalidInput, "owner can't grant the right to itself")
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenID(msg.ID); err != nil {
+		return err
+	}
+
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "expiration must be set")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgGrantUser) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgRevokeUser) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateTokenID(msg.ID)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgRevokeUser) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgTransferClassOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner account %s", msg.NewOwner)
+	}
+
+	if msg.Sender == msg.NewOwner {
+		return sdkerrors.Wrap(ErrInvalidInput, "owner can't transfer the ownership to itself")
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgTransferClassOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgAcceptClassOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgAcceptClassOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgCreateRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateRewardPool(msg.RewardPerBlock, msg.MinLockDuration)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgCreateRewardPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgFundRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "amount must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgLockNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateTokenID(msg.ID)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgLockNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgUnlockNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateTokenID(msg.ID)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgUnlockNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgClassFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	_, err := DeconstructClassID(msg.ClassID)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgClassFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgClassUnfreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	_, err := DeconstructClassID(msg.ClassID)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgClassUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgRevokeNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateTokenID(msg.ID)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgRevokeNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgAddToClassWhitelist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", msg.Account)
+	}
+
+	_, err := DeconstructClassID(msg.ClassID)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgAddToClassWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgRemoveFromClassWhitelist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s", msg.Account)
+	}
+
+	_, err := DeconstructClassID(msg.ClassID)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgRemoveFromClassWhitelist) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgBurnNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	if _, err := DeconstructClassID(msg.ClassID); err != nil {
+		return err
+	}
+
+	return ValidateTokenID(msg.ID)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
@@ -3,8 +3,11 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	codetypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
@@ -128,6 +131,15 @@ func TestMsgIssueClass_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "invalid royalty rate",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.RoyaltyRate = sdk.MustNewDecFromStr("0.00001")
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -243,3 +255,433 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgReserveIDPrefix_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgReserveIDPrefix{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Prefix:  "gen1/",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgReserveIDPrefix
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgReserveIDPrefix {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgReserveIDPrefix {
+				msg := validMessage
+				msg.Sender = "devcore172rc5sz2uc"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgReserveIDPrefix {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "prefix without separator",
+			messageFunc: func() *types.MsgReserveIDPrefix {
+				msg := validMessage
+				msg.Prefix = "gen1"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "nested prefix",
+			messageFunc: func() *types.MsgReserveIDPrefix {
+				msg := validMessage
+				msg.Prefix = "gen1/a/"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "prefix starting with digit",
+			messageFunc: func() *types.MsgReserveIDPrefix {
+				msg := validMessage
+				msg.Prefix = "1gen/"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgTransferWithPayment_ValidateBasic(t *testing.T) {
+	sellerKey := secp256k1.GenPrivKey()
+	seller := sdk.AccAddress(sellerKey.PubKey().Address()).String()
+	validMessage := types.MsgTransferWithPayment{
+		Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Offer: types.SignedSaleOffer{
+			Offer: types.SaleOffer{
+				Seller:  seller,
+				ClassID: "symbol-" + seller,
+				ID:      "id1",
+				Price:   sdk.NewInt64Coin("ucore", 100),
+			},
+			PubKey:    sellerKey.PubKey().Bytes(),
+			Signature: []byte("signature"),
+		},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgTransferWithPayment
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg with buyer",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.Offer.Buyer = msg.Sender
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Sender = "devcore172rc5sz2uc"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "sender is seller",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Sender = seller
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "sender is not buyer",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.Offer.Buyer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				return &msg
+			},
+			expectedError: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.Offer.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid ID",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.Offer.ID = "1"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero price",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.Offer.Price = sdk.NewInt64Coin("ucore", 0)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "public key of other account",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.PubKey = secp256k1.GenPrivKey().PubKey().Bytes()
+				return &msg
+			},
+			expectedError: types.ErrInvalidSaleOffer,
+		},
+		{
+			name: "empty signature",
+			messageFunc: func() *types.MsgTransferWithPayment {
+				msg := validMessage
+				msg.Offer.Signature = nil
+				return &msg
+			},
+			expectedError: types.ErrInvalidSaleOffer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgCancelSaleOffer_ValidateBasic(t *testing.T) {
+	seller := "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	validMessage := types.MsgCancelSaleOffer{
+		Sender: seller,
+		Offer: types.SaleOffer{
+			Seller:  seller,
+			ClassID: "symbol-" + seller,
+			ID:      "id1",
+			Price:   sdk.NewInt64Coin("ucore", 100),
+		},
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgCancelSaleOffer
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgCancelSaleOffer {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgCancelSaleOffer {
+				msg := validMessage
+				msg.Sender = "devcore172rc5sz2uc"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "sender is not seller",
+			messageFunc: func() *types.MsgCancelSaleOffer {
+				msg := validMessage
+				msg.Sender = "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "invalid ID",
+			messageFunc: func() *types.MsgCancelSaleOffer {
+				msg := validMessage
+				msg.Offer.ID = "1"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgGrantUser_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgGrantUser{
+		Sender:     "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID:    "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:         "id1",
+		User:       "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+		Expiration: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgGrantUser
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				msg.Sender = "devcore172rc5sz2uc"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid user",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				msg.User = "devcore1k3mke3gyf9"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "user is sender",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				msg.User = msg.Sender
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid ID",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				msg.ID = "1"
+				return &msg
+			},
+			expectedError: types.ErrInvalidID,
+		},
+		{
+			name: "missing expiration",
+			messageFunc: func() *types.MsgGrantUser {
+				msg := validMessage
+				msg.Expiration = time.Time{}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgCreateRewardPool_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgCreateRewardPool{
+		Sender:          "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID:         "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		RewardPerBlock:  sdk.NewInt64Coin("ucore", 10),
+		MinLockDuration: time.Hour,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgCreateRewardPool
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgCreateRewardPool {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgCreateRewardPool {
+				msg := validMessage
+				msg.Sender = "devcore172rc5sz2uc"
+				return &msg
+			},
+			expectedError: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgCreateRewardPool {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero reward",
+			messageFunc: func() *types.MsgCreateRewardPool {
+				msg := validMessage
+				msg.RewardPerBlock = sdk.NewInt64Coin("ucore", 0)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "negative min lock duration",
+			messageFunc: func() *types.MsgCreateRewardPool {
+				msg := validMessage
+				msg.MinLockDuration = -time.Hour
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
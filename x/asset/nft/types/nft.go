@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"regexp"
 	"strings"
 
@@ -18,18 +19,30 @@ var (
 	nftIDRegexStr = `^[a-zA-Z][a-zA-Z0-9/:-]{2,100}$`
 	nftIDRegex    = regexp.MustCompile(nftIDRegexStr)
 
-	nftClassIDSeparator = "-"
+	nftIDPrefixRegexStr = `^[a-zA-Z][a-zA-Z0-9:-]{0,40}/$`
+	nftIDPrefixRegex    = regexp.MustCompile(nftIDPrefixRegexStr)
+
+	nftClassIDSeparator  = "-"
+	nftIDPrefixSeparator = "/"
+
+	royaltyRateMaxPrecision = 4
 )
 
 // IssueClassSettings is the model which represents the params for the non-fungible token class creation.
 type IssueClassSettings struct {
-	Issuer      sdk.AccAddress
-	Name        string
-	Symbol      string
-	Description string
-	URI         string
-	URIHash     string
-	Data        *codetypes.Any
+	Issuer       sdk.AccAddress
+	Name         string
+	Symbol       string
+	Description  string
+	URI          string
+	URIHash      string
+	Data         *codetypes.Any
+	Provenance   bool
+	Freezing     bool
+	Revocable    bool
+	Whitelisting bool
+	RoyaltyRate  sdk.Dec
+	Soulbound    bool
 }
 
 // MintSettings is the model which represents the params for the non-fungible token minting.
@@ -42,6 +55,53 @@ type MintSettings struct {
 	Data    *codetypes.Any
 }
 
+// ReserveIDPrefixSettings is the model which represents the params for the non-fungible token ID prefix reservation.
+type ReserveIDPrefixSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	Prefix  string
+}
+
+// TransferClassOwnershipSettings is the model which represents the params for the class ownership transfer proposal.
+type TransferClassOwnershipSettings struct {
+	Sender   sdk.AccAddress
+	ClassID  string
+	NewOwner sdk.AccAddress
+}
+
+// AcceptClassOwnershipSettings is the model which represents the params for the class ownership acceptance.
+type AcceptClassOwnershipSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+}
+
+// ClassFreezeSettings is the model which represents the params for the class freeze and unfreeze.
+type ClassFreezeSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+}
+
+// RevokeNFTSettings is the model which represents the params for the non-fungible token revocation.
+type RevokeNFTSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+}
+
+// BurnNFTSettings is the model which represents the params for the non-fungible token burning by its holder.
+type BurnNFTSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+}
+
+// ClassWhitelistSettings is the model which represents the params for the change of the class whitelist.
+type ClassWhitelistSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	Account sdk.AccAddress
+}
+
 // BuildClassID builds the non-fungible token id string from the symbol and issuer address.
 func BuildClassID(symbol string, issuer sdk.AccAddress) string {
 	return strings.ToLower(symbol) + nftClassIDSeparator + issuer.String()
@@ -83,3 +143,44 @@ func ValidateTokenID(id string) error {
 
 	return nil
 }
+
+// ValidateIDPrefix checks the provided non-fungible token ID prefix is valid.
+func ValidateIDPrefix(prefix string) error {
+	if !nftIDPrefixRegex.MatchString(prefix) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "id prefix must match regex format '%s'", nftIDPrefixRegexStr)
+	}
+
+	return nil
+}
+
+// ValidateRoyaltyRate checks the provided royalty rate is valid. The nil rate is valid and means no royalty.
+func ValidateRoyaltyRate(rate sdk.Dec) error {
+	if rate.IsNil() {
+		return nil
+	}
+
+	if !rate.MulInt64(int64(math.Pow10(royaltyRateMaxPrecision))).IsInteger() {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"royalty rate precision should not be more than %d decimal places",
+			royaltyRateMaxPrecision,
+		)
+	}
+
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidInput, "royalty rate is not within acceptable range")
+	}
+
+	return nil
+}
+
+// IDPrefix returns the prefix of the non-fungible token ID, which is the part of the ID up to and including
+// the first "/". False is returned if the ID doesn't contain the prefix.
+func IDPrefix(id string) (string, bool) {
+	idx := strings.Index(id, nftIDPrefixSeparator)
+	if idx < 0 {
+		return "", false
+	}
+
+	return id[:idx+1], true
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferWithPaymentSettings is the model which represents the params for the non-fungible token sale.
+type TransferWithPaymentSettings struct {
+	Buyer sdk.AccAddress
+	Offer SignedSaleOffer
+}
+
+// CancelSaleOfferSettings is the model which represents the params for canceling the sale offer.
+type CancelSaleOfferSettings struct {
+	Sender sdk.AccAddress
+	Offer  SaleOffer
+}
+
+// SaleOfferSignBytes returns the bytes the seller signs to offer the non-fungible token for sale.
+// The chain ID is included to prevent the offer from being replayed on another chain.
+func SaleOfferSignBytes(chainID string, offer SaleOffer) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID          string `json:"chain_id"`
+		Seller           string `json:"seller"`
+		ClassID          string `json:"class_id"`
+		ID               string `json:"id"`
+		Price            string `json:"price"`
+		Buyer            string `json:"buyer"`
+		ExpirationHeight string `json:"expiration_height"`
+	}{
+		ChainID:          chainID,
+		Seller:           offer.Seller,
+		ClassID:          offer.ClassID,
+		ID:               offer.ID,
+		Price:            offer.Price.String(),
+		Buyer:            offer.Buyer,
+		ExpirationHeight: strconv.FormatUint(offer.ExpirationHeight, 10),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// SaleOfferHash returns the hash identifying the sale offer once it is accepted.
+func SaleOfferHash(chainID string, offer SaleOffer) []byte {
+	hash := sha256.Sum256(SaleOfferSignBytes(chainID, offer))
+	return hash[:]
+}
+
+// ValidateBasic checks that the sale offer fields are valid.
+func (o SaleOffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(o.Seller); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid seller account %s", o.Seller)
+	}
+
+	if _, err := DeconstructClassID(o.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := ValidateTokenID(o.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := o.Price.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid price: %s", err)
+	}
+	if !o.Price.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "price must be positive")
+	}
+
+	if o.Buyer != "" {
+		if _, err := sdk.AccAddressFromBech32(o.Buyer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer account %s", o.Buyer)
+		}
+		if o.Buyer == o.Seller {
+			return sdkerrors.Wrap(ErrInvalidInput, "buyer must be different from the seller")
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks that the offer is valid and the public key belongs to the seller.
+func (o SignedSaleOffer) ValidateBasic() error {
+	if err := o.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(o.PubKey) != secp256k1.PubKeySize {
+		return sdkerrors.Wrapf(ErrInvalidSaleOffer, "invalid public key of the seller %s", o.Offer.Seller)
+	}
+	if sdk.AccAddress(o.pubKey().Address()).String() != o.Offer.Seller {
+		return sdkerrors.Wrapf(ErrInvalidSaleOffer, "public key doesn't belong to the seller %s", o.Offer.Seller)
+	}
+	if len(o.Signature) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidSaleOffer, "empty signature of the seller %s", o.Offer.Seller)
+	}
+	return nil
+}
+
+// Verify checks that the offer is signed by the seller for the chain.
+func (o SignedSaleOffer) Verify(chainID string) error {
+	if err := o.ValidateBasic(); err != nil {
+		return err
+	}
+	if !o.pubKey().VerifySignature(SaleOfferSignBytes(chainID, o.Offer), o.Signature) {
+		return sdkerrors.Wrapf(ErrInvalidSaleOffer, "invalid signature of the seller %s", o.Offer.Seller)
+	}
+	return nil
+}
+
+func (o SignedSaleOffer) pubKey() *secp256k1.PubKey {
+	return &secp256k1.PubKey{Key: o.PubKey}
+}
@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/nft/v1/offer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SaleOffer is the offer of the non-fungible token owner to sell the token for the price.
+type SaleOffer struct {
+	Seller  string     `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	ClassID string     `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ID      string     `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Price   types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// buyer is the only account allowed to accept the offer, if empty anyone can accept it.
+	Buyer string `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// expiration_height is the last block height the offer can be accepted at, if zero the offer never expires. The offer
+	// might be canceled by the seller with MsgCancelSaleOffer before it is accepted.
+	ExpirationHeight uint64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *SaleOffer) Reset()         { *m = SaleOffer{} }
+func (m *SaleOffer) String() string { return proto.CompactTextString(m) }
+func (*SaleOffer) ProtoMessage()    {}
+func (*SaleOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0536ce9284bf0e84, []int{0}
+}
+
+func (m *SaleOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SaleOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SaleOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SaleOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaleOffer.Merge(m, src)
+}
+
+func (m *SaleOffer) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SaleOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaleOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaleOffer proto.InternalMessageInfo
+
+func (m *SaleOffer) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *SaleOffer) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *SaleOffer) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *SaleOffer) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *SaleOffer) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *SaleOffer) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// SignedSaleOffer is the sale offer signed offline by the seller.
+type SignedSaleOffer struct {
+	Offer     SaleOffer `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer"`
+	PubKey    []byte    `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Signature []byte    `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignedSaleOffer) Reset()         { *m = SignedSaleOffer{} }
+func (m *SignedSaleOffer) String() string { return proto.CompactTextString(m) }
+func (*SignedSaleOffer) ProtoMessage()    {}
+func (*SignedSaleOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0536ce9284bf0e84, []int{1}
+}
+
+func (m *SignedSaleOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *SignedSaleOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignedSaleOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *SignedSaleOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedSaleOffer.Merge(m, src)
+}
+
+func (m *SignedSaleOffer) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *SignedSaleOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedSaleOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedSaleOffer proto.InternalMessageInfo
+
+func (m *SignedSaleOffer) GetOffer() SaleOffer {
+	if m != nil {
+		return m.Offer
+	}
+	return SaleOffer{}
+}
+
+func (m *SignedSaleOffer) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SignedSaleOffer) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SaleOffer)(nil), "coreum.asset.nft.v1.SaleOffer")
+	proto.RegisterType((*SignedSaleOffer)(nil), "coreum.asset.nft.v1.SignedSaleOffer")
+}
+
+func init() { proto.RegisterFile("coreum/asset/nft/v1/offer.proto", fileDescriptor_0536ce9284bf0e84) }
+
+var fileDescriptor_0536ce9284bf0e84 = []byte{
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0x9b, 0xda, 0x4e, 0x6d, 0xba, 0xa0, 0xc6, 0x65, 0x1d, 0x17, 0x49, 0xcb, 0x1e, 0xa4,
+	0x20, 0x24, 0x74, 0xd5, 0x8b, 0xc7, 0x76, 0x11, 0x8b, 0xa0, 0x30, 0x7b, 0xf3, 0x52, 0xe6, 0xcf,
+	0x3b, 0xd3, 0xe0, 0x34, 0x19, 0x92, 0x4c, 0xd9, 0xb9, 0xfb, 0x01, 0xfc, 0x58, 0x7b, 0xec, 0xd1,
+	0x8b, 0x45, 0xa6, 0x5f, 0x44, 0x26, 0x53, 0xad, 0x07, 0x6f, 0x79, 0xdf, 0xe7, 0x49, 0xf8, 0xbd,
+	0x4f, 0x5e, 0x3c, 0x8e, 0x95, 0x86, 0x72, 0xc3, 0x43, 0x63, 0xc0, 0x72, 0x99, 0x5a, 0xbe, 0x9d,
+	0x71, 0x95, 0xa6, 0xa0, 0x59, 0xa1, 0x95, 0x55, 0xe4, 0x69, 0x6b, 0x60, 0xce, 0xc0, 0x64, 0x6a,
+	0xd9, 0x76, 0x76, 0x79, 0x9e, 0xa9, 0x4c, 0x39, 0x9d, 0x37, 0xa7, 0xd6, 0x7a, 0x49, 0x63, 0x65,
+	0x36, 0xca, 0xf0, 0x28, 0x34, 0xc0, 0xb7, 0xb3, 0x08, 0x6c, 0x38, 0xe3, 0xb1, 0x12, 0xb2, 0xd5,
+	0xaf, 0x7e, 0x22, 0x3c, 0xbc, 0x0d, 0x73, 0xf8, 0xdc, 0x3c, 0x4f, 0x2e, 0xb0, 0x67, 0x20, 0xcf,
+	0x41, 0xfb, 0x68, 0x82, 0xa6, 0xc3, 0xe0, 0x58, 0x91, 0x97, 0xf8, 0x61, 0x9c, 0x87, 0xc6, 0xac,
+	0x44, 0xe2, 0x77, 0x1b, 0x65, 0x3e, 0xaa, 0xf7, 0xe3, 0xc1, 0xa2, 0xe9, 0x2d, 0x6f, 0x82, 0x81,
+	0x13, 0x97, 0x09, 0xb9, 0xc0, 0x5d, 0x91, 0xf8, 0x0f, 0x9c, 0xc3, 0xab, 0xf7, 0xe3, 0xee, 0xf2,
+	0x26, 0xe8, 0x8a, 0x84, 0xbc, 0xc5, 0xfd, 0x42, 0x8b, 0x18, 0xfc, 0xde, 0x04, 0x4d, 0x47, 0xd7,
+	0xcf, 0x59, 0x4b, 0xc5, 0x1a, 0x2a, 0x76, 0xa4, 0x62, 0x0b, 0x25, 0xe4, 0xbc, 0x77, 0xbf, 0x1f,
+	0x77, 0x82, 0xd6, 0x4d, 0xce, 0x71, 0x3f, 0x2a, 0x2b, 0xd0, 0x7e, 0xdf, 0xd1, 0xb4, 0x05, 0x79,
+	0x85, 0x9f, 0xc0, 0x5d, 0x21, 0x74, 0x68, 0x85, 0x92, 0xab, 0x35, 0x88, 0x6c, 0x6d, 0x7d, 0x6f,
+	0x82, 0xa6, 0xbd, 0xe0, 0xf1, 0x49, 0xf8, 0xe0, 0xfa, 0x57, 0xdf, 0x10, 0x7e, 0x74, 0x2b, 0x32,
+	0x09, 0xc9, 0x69, 0xca, 0x77, 0xb8, 0xef, 0xd2, 0x74, 0x43, 0x8e, 0xae, 0x29, 0xfb, 0x4f, 0x9c,
+	0xec, 0xaf, 0xfd, 0x0f, 0x92, 0xbb, 0x42, 0x9e, 0xe1, 0x41, 0x51, 0x46, 0xab, 0xaf, 0x50, 0xb9,
+	0x20, 0xce, 0x02, 0xaf, 0x28, 0xa3, 0x8f, 0x50, 0x91, 0x17, 0x78, 0x68, 0x44, 0x26, 0x43, 0x5b,
+	0x6a, 0x70, 0x09, 0x9c, 0x05, 0xa7, 0xc6, 0xfc, 0xd3, 0x7d, 0x4d, 0xd1, 0xae, 0xa6, 0xe8, 0x57,
+	0x4d, 0xd1, 0xf7, 0x03, 0xed, 0xec, 0x0e, 0xb4, 0xf3, 0xe3, 0x40, 0x3b, 0x5f, 0xde, 0x64, 0xc2,
+	0xae, 0xcb, 0x88, 0xc5, 0x6a, 0xc3, 0x17, 0x8e, 0xe3, 0xbd, 0x2a, 0x65, 0xe2, 0x66, 0xe0, 0xc7,
+	0x45, 0xb8, 0xfb, 0x67, 0x15, 0x6c, 0x55, 0x80, 0x89, 0x3c, 0xf7, 0x7b, 0xaf, 0x7f, 0x0f, 0x00,
+	0x4a, 0xed, 0xd4, 0x08, 0x2b, 0x02, 0x00, 0x00,
+}
+
+func (m *SaleOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SaleOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SaleOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintOffer(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintOffer(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOffer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintOffer(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintOffer(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintOffer(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignedSaleOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignedSaleOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignedSaleOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintOffer(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintOffer(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOffer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOffer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOffer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *SaleOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovOffer(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovOffer(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovOffer(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOffer(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovOffer(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovOffer(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *SignedSaleOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Offer.Size()
+	n += 1 + l + sovOffer(uint64(l))
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovOffer(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovOffer(uint64(l))
+	}
+	return n
+}
+
+func sovOffer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozOffer(x uint64) (n int) {
+	return sovOffer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *SaleOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOffer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaleOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaleOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOffer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SignedSaleOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOffer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedSaleOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedSaleOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOffer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipOffer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOffer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOffer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOffer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOffer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOffer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOffer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOffer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOffer = fmt.Errorf("proto: unexpected end of group")
+)
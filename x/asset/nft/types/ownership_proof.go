@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+// OwnershipProof is the store proof of the non-fungible token owner. The proof taken at the height is verified against
+// the app hash of the header at the next height, so the ownership can be verified offline using the trusted header
+// only.
+type OwnershipProof struct {
+	ClassID string             `json:"class_id"`
+	ID      string             `json:"id"`
+	Owner   string             `json:"owner"`
+	Height  int64              `json:"height"`
+	Proof   *tmcrypto.ProofOps `json:"proof"`
+}
+
+// OwnershipProofKeyPath returns the merkle key path of the owner of the non-fungible token in the multistore.
+func OwnershipProofKeyPath(classID, id string) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(nftkeeper.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(nftkeeper.OwnerStoreKey(classID, id), merkle.KeyEncodingURL).
+		String()
+}
+
+// Verify verifies that the proof proves the owner of the non-fungible token against the app hash.
+func (p OwnershipProof) Verify(appHash []byte) error {
+	owner, err := sdk.AccAddressFromBech32(p.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidOwnershipProof, "invalid owner %q: %s", p.Owner, err)
+	}
+	if p.Proof == nil {
+		return sdkerrors.Wrap(ErrInvalidOwnershipProof, "proof is empty")
+	}
+
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(p.Proof, appHash, OwnershipProofKeyPath(p.ClassID, p.ID), owner); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidOwnershipProof, "nft %q of class %q is not owned by %q: %s", p.ID, p.ClassID, p.Owner, err)
+	}
+
+	return nil
+}
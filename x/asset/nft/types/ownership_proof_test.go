@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+	nftkeeper "github.com/CoreumFoundation/coreum/x/nft/keeper"
+)
+
+func TestOwnershipProof_Verify(t *testing.T) {
+	requireT := require.New(t)
+
+	classID := "class-devcore1tr3w86yesnj8f290l6ve02cqhae8x4ze0nk0a8"
+	id := "nft1"
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	anotherOwner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	storeKey := sdk.NewKVStoreKey(nftkeeper.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	requireT.NoError(ms.LoadLatestVersion())
+	ms.GetKVStore(storeKey).Set(nftkeeper.OwnerStoreKey(classID, id), owner)
+	commitID := ms.Commit()
+
+	res := ms.Query(abci.RequestQuery{
+		Path:  "/" + nftkeeper.StoreKey + "/key",
+		Data:  nftkeeper.OwnerStoreKey(classID, id),
+		Prove: true,
+	})
+	requireT.Zero(res.Code, res.Log)
+
+	proof := types.OwnershipProof{
+		ClassID: classID,
+		ID:      id,
+		Owner:   owner.String(),
+		Height:  res.Height,
+		Proof:   res.ProofOps,
+	}
+	requireT.NoError(proof.Verify(commitID.Hash))
+
+	// another owner
+	invalidProof := proof
+	invalidProof.Owner = anotherOwner.String()
+	requireT.ErrorIs(invalidProof.Verify(commitID.Hash), types.ErrInvalidOwnershipProof)
+
+	// another nft
+	invalidProof = proof
+	invalidProof.ID = "nft2"
+	requireT.ErrorIs(invalidProof.Verify(commitID.Hash), types.ErrInvalidOwnershipProof)
+
+	// another app hash
+	requireT.ErrorIs(proof.Verify([]byte("invalid")), types.ErrInvalidOwnershipProof)
+
+	// missing proof
+	invalidProof = proof
+	invalidProof.Proof = nil
+	requireT.ErrorIs(invalidProof.Verify(commitID.Hash), types.ErrInvalidOwnershipProof)
+}
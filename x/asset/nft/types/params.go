@@ -0,0 +1,60 @@
+package types
+
+import (
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+// Parameter keys
+var (
+	KeyMaxClassDataSize = []byte("MaxClassDataSize")
+	KeyMaxNFTDataSize   = []byte("MaxNFTDataSize")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		MaxClassDataSize: nftMaxDataSize,
+		MaxNFTDataSize:   nftMaxDataSize,
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of the asset nft parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxClassDataSize, &m.MaxClassDataSize, validateMaxDataSize),
+		paramtypes.NewParamSetPair(KeyMaxNFTDataSize, &m.MaxNFTDataSize, validateMaxDataSize),
+	}
+}
+
+// ValidateBasic validates the asset nft parameters.
+func (m Params) ValidateBasic() error {
+	if err := validateMaxDataSize(m.MaxClassDataSize); err != nil {
+		return errors.Wrap(err, "invalid max class data size")
+	}
+	if err := validateMaxDataSize(m.MaxNFTDataSize); err != nil {
+		return errors.Wrap(err, "invalid max nft data size")
+	}
+	return nil
+}
+
+// validateMaxDataSize validates the max data size. The size can't exceed the limit checked by the messages, because
+// the bigger data would be rejected before reaching the keeper anyway.
+func validateMaxDataSize(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > nftMaxDataSize {
+		return errors.Errorf("max data size must not exceed %d bytes, got %d", nftMaxDataSize, v)
+	}
+
+	return nil
+}
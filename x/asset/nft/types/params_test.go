@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/asset/nft/types"
+)
+
+func TestParams_ValidateBasic(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.ValidateBasic())
+	require.NoError(t, types.DefaultGenesis().Validate())
+
+	// the data might be disabled completely
+	params.MaxNFTDataSize = 0
+	require.NoError(t, params.ValidateBasic())
+
+	// the limit can't exceed the one checked by the messages
+	params.MaxClassDataSize = 5001
+	require.Error(t, params.ValidateBasic())
+}
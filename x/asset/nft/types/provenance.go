@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// MaxProvenanceRecords is the maximum number of the provenance records stored for the non-fungible token.
+// The oldest records are pruned, but the remaining ones are still chained by the hashes.
+const MaxProvenanceRecords = 100
+
+// Hash returns the hash of the provenance record referenced by the next record of the token.
+func (r ProvenanceRecord) Hash() []byte {
+	bz, err := r.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
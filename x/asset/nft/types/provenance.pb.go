@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/nft/v1/provenance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProvenanceRecord is the record of the transfer of the non-fungible token. The records of the token are chained by
+// the hashes, so the history might be verified starting from any known record.
+type ProvenanceRecord struct {
+	// sequence is the number of the transfer of the token, starting from 1.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// from is the previous owner of the token.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the new owner of the token.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// price is the price paid for the token if it was sold by accepting the sale offer.
+	Price *types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	// height is the block height the token was transferred at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// previous_hash is the hash of the previous record of the token, empty for the first one.
+	PreviousHash []byte `protobuf:"bytes,6,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+}
+
+func (m *ProvenanceRecord) Reset()         { *m = ProvenanceRecord{} }
+func (m *ProvenanceRecord) String() string { return proto.CompactTextString(m) }
+func (*ProvenanceRecord) ProtoMessage()    {}
+func (*ProvenanceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a3b333c29ec08de, []int{0}
+}
+
+func (m *ProvenanceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ProvenanceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvenanceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ProvenanceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvenanceRecord.Merge(m, src)
+}
+
+func (m *ProvenanceRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ProvenanceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvenanceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvenanceRecord proto.InternalMessageInfo
+
+func (m *ProvenanceRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ProvenanceRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ProvenanceRecord) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ProvenanceRecord) GetPrice() *types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ProvenanceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProvenanceRecord) GetPreviousHash() []byte {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProvenanceRecord)(nil), "coreum.asset.nft.v1.ProvenanceRecord")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/nft/v1/provenance.proto", fileDescriptor_5a3b333c29ec08de)
+}
+
+var fileDescriptor_5a3b333c29ec08de = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0xeb, 0xfe, 0x13, 0x98, 0x82, 0x90, 0x41, 0x28, 0x74, 0x88, 0x22, 0x60, 0xc8, 0x64,
+	0x2b, 0xc0, 0x13, 0x50, 0x09, 0x31, 0x21, 0x94, 0x91, 0x05, 0x39, 0xee, 0x35, 0xf1, 0x10, 0x5f,
+	0xb0, 0x9d, 0x08, 0xde, 0x82, 0x47, 0x62, 0x64, 0xec, 0xc8, 0x88, 0xda, 0x17, 0x41, 0x4d, 0x4a,
+	0xc5, 0x76, 0x77, 0xdf, 0x4f, 0xa7, 0x4f, 0x3f, 0x7a, 0xa5, 0xd0, 0x42, 0x5d, 0x0a, 0xe9, 0x1c,
+	0x78, 0x61, 0x16, 0x5e, 0x34, 0x89, 0xa8, 0x2c, 0x36, 0x60, 0xa4, 0x51, 0xc0, 0x2b, 0x8b, 0x1e,
+	0xd9, 0x49, 0x47, 0xf1, 0x96, 0xe2, 0x66, 0xe1, 0x79, 0x93, 0x4c, 0x4f, 0x73, 0xcc, 0xb1, 0xcd,
+	0xc5, 0x66, 0xea, 0xd0, 0x69, 0xa8, 0xd0, 0x95, 0xe8, 0x44, 0x26, 0x1d, 0x88, 0x26, 0xc9, 0xc0,
+	0xcb, 0x44, 0x28, 0xd4, 0xa6, 0xcb, 0x2f, 0x3e, 0x09, 0x3d, 0x7e, 0xda, 0xfd, 0x4f, 0x41, 0xa1,
+	0x9d, 0xb3, 0x29, 0xdd, 0x73, 0xf0, 0x5a, 0x83, 0x51, 0x10, 0x90, 0x88, 0xc4, 0xc3, 0x74, 0xb7,
+	0x33, 0x46, 0x87, 0x0b, 0x8b, 0x65, 0xd0, 0x8f, 0x48, 0xbc, 0x9f, 0xb6, 0x33, 0x3b, 0xa2, 0x7d,
+	0x8f, 0xc1, 0xa0, 0xbd, 0xf4, 0x3d, 0x32, 0x41, 0x47, 0x95, 0xd5, 0x0a, 0x82, 0x61, 0x44, 0xe2,
+	0x83, 0xeb, 0x73, 0xde, 0x95, 0xe0, 0x9b, 0x12, 0x7c, 0x5b, 0x82, 0xcf, 0x50, 0x9b, 0xb4, 0xe3,
+	0xd8, 0x19, 0x1d, 0x17, 0xa0, 0xf3, 0xc2, 0x07, 0xa3, 0x88, 0xc4, 0x83, 0x74, 0xbb, 0xb1, 0x4b,
+	0x7a, 0x58, 0x59, 0x68, 0x34, 0xd6, 0xee, 0xa5, 0x90, 0xae, 0x08, 0xc6, 0x11, 0x89, 0x27, 0xe9,
+	0xe4, 0xef, 0xf8, 0x20, 0x5d, 0x71, 0xf7, 0xf8, 0xb5, 0x0a, 0xc9, 0x72, 0x15, 0x92, 0x9f, 0x55,
+	0x48, 0x3e, 0xd6, 0x61, 0x6f, 0xb9, 0x0e, 0x7b, 0xdf, 0xeb, 0xb0, 0xf7, 0x7c, 0x9b, 0x6b, 0x5f,
+	0xd4, 0x19, 0x57, 0x58, 0x8a, 0x59, 0xab, 0xec, 0x1e, 0x6b, 0x33, 0x97, 0x5e, 0xa3, 0x11, 0x5b,
+	0xd3, 0x6f, 0xff, 0x5c, 0xfb, 0xf7, 0x0a, 0x5c, 0x36, 0x6e, 0xcd, 0xdc, 0xfc, 0x0e, 0x00, 0x0d,
+	0xed, 0xaf, 0x0e, 0x8c, 0x01, 0x00, 0x00,
+}
+
+func (m *ProvenanceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvenanceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvenanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousHash) > 0 {
+		i -= len(m.PreviousHash)
+		copy(dAtA[i:], m.PreviousHash)
+		i = encodeVarintProvenance(dAtA, i, uint64(len(m.PreviousHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintProvenance(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvenance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintProvenance(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintProvenance(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProvenance(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvenance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvenance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *ProvenanceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovProvenance(uint64(m.Sequence))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvenance(uint64(m.Height))
+	}
+	l = len(m.PreviousHash)
+	if l > 0 {
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	return n
+}
+
+func sovProvenance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozProvenance(x uint64) (n int) {
+	return sovProvenance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ProvenanceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvenance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvenanceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvenanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &types.Coin{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousHash = append(m.PreviousHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousHash == nil {
+				m.PreviousHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvenance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipProvenance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProvenance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProvenance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProvenance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProvenance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProvenance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProvenance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProvenance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateRewardPoolSettings is the model which represents the params for creating the reward pool of the class.
+type CreateRewardPoolSettings struct {
+	Sender          sdk.AccAddress
+	ClassID         string
+	RewardPerBlock  sdk.Coin
+	MinLockDuration time.Duration
+}
+
+// FundRewardPoolSettings is the model which represents the params for funding the reward pool of the class.
+type FundRewardPoolSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	Amount  sdk.Coin
+}
+
+// LockNFTSettings is the model which represents the params for locking the non-fungible token.
+type LockNFTSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+}
+
+// UnlockNFTSettings is the model which represents the params for unlocking the non-fungible token.
+type UnlockNFTSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+}
+
+// ValidateRewardPool checks the configuration of the reward pool is valid.
+func ValidateRewardPool(rewardPerBlock sdk.Coin, minLockDuration time.Duration) error {
+	if err := rewardPerBlock.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+	if !rewardPerBlock.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "reward per block must be positive")
+	}
+	if minLockDuration < 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "min lock duration must not be negative")
+	}
+
+	return nil
+}
+
+// Distribute distributes the reward of the block among the locked tokens. Nothing is distributed if no tokens are
+// locked, so the funds stay in the pool.
+func (p *RewardPool) Distribute() {
+	if p.LockedCount == 0 || !p.Balance.IsPositive() {
+		return
+	}
+
+	amount := sdk.MinInt(p.RewardPerBlock.Amount, p.Balance)
+	p.Balance = p.Balance.Sub(amount)
+	p.RewardPerToken = p.RewardPerToken.Add(amount.ToDec().QuoInt64(int64(p.LockedCount)))
+}
+
+// PendingReward returns the reward earned by the locked token so far. The fraction of the reward not representable
+// in the integer amount is never paid out.
+func (p RewardPool) PendingReward(lock NFTLock) sdk.Coin {
+	return sdk.NewCoin(p.RewardPerBlock.Denom, p.RewardPerToken.Sub(lock.RewardPerToken).TruncateInt())
+}
+
+// IsUnlockable returns true if the token has been locked for the minimum duration at the time.
+func (l NFTLock) IsUnlockable(minLockDuration time.Duration, now time.Time) bool {
+	return !now.Before(l.LockedAt.Add(minLockDuration))
+}
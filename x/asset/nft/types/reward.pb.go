@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/nft/v1/reward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardPool is the pool of the fungible tokens distributed among the holders locking the non-fungible tokens of
+// the class.
+type RewardPool struct {
+	ClassID string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// reward_per_block is the amount distributed each block among all the locked tokens of the class.
+	RewardPerBlock types.Coin `protobuf:"bytes,2,opt,name=reward_per_block,json=rewardPerBlock,proto3" json:"reward_per_block"`
+	// min_lock_duration is the minimum duration the token must stay locked before it might be unlocked.
+	MinLockDuration time.Duration `protobuf:"bytes,3,opt,name=min_lock_duration,json=minLockDuration,proto3,stdduration" json:"min_lock_duration"`
+	// balance is the amount funded to the pool and not distributed yet.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// reward_per_token is the amount distributed to each locked token since the pool was created.
+	RewardPerToken github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_per_token,json=rewardPerToken,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_token"`
+	// locked_count is the number of the tokens of the class locked currently.
+	LockedCount uint64 `protobuf:"varint,6,opt,name=locked_count,json=lockedCount,proto3" json:"locked_count,omitempty"`
+}
+
+func (m *RewardPool) Reset()         { *m = RewardPool{} }
+func (m *RewardPool) String() string { return proto.CompactTextString(m) }
+func (*RewardPool) ProtoMessage()    {}
+func (*RewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2314bc0bc64061cd, []int{0}
+}
+
+func (m *RewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPool.Merge(m, src)
+}
+
+func (m *RewardPool) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPool proto.InternalMessageInfo
+
+func (m *RewardPool) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *RewardPool) GetRewardPerBlock() types.Coin {
+	if m != nil {
+		return m.RewardPerBlock
+	}
+	return types.Coin{}
+}
+
+func (m *RewardPool) GetMinLockDuration() time.Duration {
+	if m != nil {
+		return m.MinLockDuration
+	}
+	return 0
+}
+
+func (m *RewardPool) GetLockedCount() uint64 {
+	if m != nil {
+		return m.LockedCount
+	}
+	return 0
+}
+
+// NFTLock is the lock of the non-fungible token earning the rewards from the pool of its class.
+type NFTLock struct {
+	ClassID string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	ID      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Owner   string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// locked_at is the block time the token was locked at.
+	LockedAt time.Time `protobuf:"bytes,4,opt,name=locked_at,json=lockedAt,proto3,stdtime" json:"locked_at"`
+	// reward_per_token is the reward per token of the pool at the time the token was locked.
+	RewardPerToken github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_per_token,json=rewardPerToken,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_token"`
+}
+
+func (m *NFTLock) Reset()         { *m = NFTLock{} }
+func (m *NFTLock) String() string { return proto.CompactTextString(m) }
+func (*NFTLock) ProtoMessage()    {}
+func (*NFTLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2314bc0bc64061cd, []int{1}
+}
+
+func (m *NFTLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *NFTLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *NFTLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTLock.Merge(m, src)
+}
+
+func (m *NFTLock) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *NFTLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTLock proto.InternalMessageInfo
+
+func (m *NFTLock) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *NFTLock) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *NFTLock) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NFTLock) GetLockedAt() time.Time {
+	if m != nil {
+		return m.LockedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*RewardPool)(nil), "coreum.asset.nft.v1.RewardPool")
+	proto.RegisterType((*NFTLock)(nil), "coreum.asset.nft.v1.NFTLock")
+}
+
+func init() { proto.RegisterFile("coreum/asset/nft/v1/reward.proto", fileDescriptor_2314bc0bc64061cd) }
+
+var fileDescriptor_2314bc0bc64061cd = []byte{
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xd3, 0x36, 0x3f, 0x1b, 0xc4, 0x8f, 0xa9, 0x90, 0xc9, 0xc1, 0x0e, 0x3d, 0x54, 0xb9,
+	0xb0, 0xab, 0x14, 0x5e, 0xa0, 0x4e, 0x54, 0x11, 0x09, 0x95, 0xca, 0xca, 0x01, 0x71, 0xb1, 0xfc,
+	0xb3, 0x09, 0xab, 0xc4, 0x3b, 0x91, 0x77, 0x9d, 0xc2, 0x5b, 0xf4, 0x06, 0x8f, 0xd4, 0x63, 0x8f,
+	0x88, 0x43, 0x40, 0xce, 0x6b, 0x70, 0x40, 0xbb, 0x6b, 0x4b, 0x01, 0x2e, 0x70, 0xe0, 0xe4, 0xdd,
+	0x99, 0x6f, 0xbe, 0xd9, 0xef, 0x1b, 0x0f, 0x1a, 0x24, 0x90, 0xd3, 0x22, 0x23, 0x91, 0x10, 0x54,
+	0x12, 0x3e, 0x97, 0x64, 0x33, 0x22, 0x39, 0xbd, 0x8e, 0xf2, 0x14, 0xaf, 0x73, 0x90, 0x60, 0x3f,
+	0x36, 0x08, 0xac, 0x11, 0x98, 0xcf, 0x25, 0xde, 0x8c, 0xfa, 0xc7, 0x0b, 0x58, 0x80, 0xce, 0x13,
+	0x75, 0x32, 0xd0, 0xbe, 0xbb, 0x00, 0x58, 0xac, 0x28, 0xd1, 0xb7, 0xb8, 0x98, 0x93, 0xb4, 0xc8,
+	0x23, 0xc9, 0x80, 0x57, 0x79, 0xef, 0xf7, 0xbc, 0x64, 0x19, 0x15, 0x32, 0xca, 0xd6, 0x35, 0x41,
+	0x02, 0x22, 0x03, 0x41, 0xe2, 0x48, 0x50, 0xb2, 0x19, 0xc5, 0x54, 0x46, 0x23, 0x92, 0x00, 0xab,
+	0x08, 0x4e, 0x3e, 0x1d, 0x20, 0x14, 0xe8, 0xc7, 0x5d, 0x01, 0xac, 0xec, 0x53, 0xd4, 0x49, 0x56,
+	0x91, 0x10, 0x21, 0x4b, 0x1d, 0x6b, 0x60, 0x0d, 0xbb, 0x7e, 0xaf, 0xdc, 0x7a, 0xed, 0xb1, 0x8a,
+	0x4d, 0x27, 0x41, 0x5b, 0x27, 0xa7, 0xa9, 0x3d, 0x45, 0x0f, 0x8d, 0xa4, 0x70, 0x4d, 0xf3, 0x30,
+	0x5e, 0x41, 0xb2, 0x74, 0x9a, 0x03, 0x6b, 0xd8, 0x3b, 0x7b, 0x8a, 0x4d, 0x47, 0xac, 0x3a, 0xe2,
+	0xaa, 0x23, 0x1e, 0x03, 0xe3, 0xfe, 0xe1, 0xed, 0xd6, 0x6b, 0x04, 0xf7, 0x4d, 0xe1, 0x15, 0xcd,
+	0x7d, 0x55, 0x66, 0xbf, 0x41, 0x8f, 0x32, 0xc6, 0x43, 0x75, 0x0e, 0x6b, 0x75, 0xce, 0x41, 0xc5,
+	0x65, 0xe4, 0xe1, 0x5a, 0x1e, 0x9e, 0x54, 0x00, 0xbf, 0xa3, 0xb8, 0x3e, 0x7f, 0xf3, 0xac, 0xe0,
+	0x41, 0xc6, 0xf8, 0x6b, 0x48, 0x96, 0x75, 0xca, 0x7e, 0x85, 0xda, 0x71, 0xb4, 0x8a, 0x78, 0x42,
+	0x9d, 0x43, 0x2d, 0x01, 0x2b, 0xec, 0xd7, 0xad, 0x77, 0xba, 0x60, 0xf2, 0x7d, 0x11, 0xe3, 0x04,
+	0x32, 0x52, 0xd9, 0x62, 0x3e, 0xcf, 0x45, 0xba, 0x24, 0xf2, 0xe3, 0x9a, 0x0a, 0x3c, 0xe5, 0x32,
+	0xa8, 0xcb, 0xed, 0xb7, 0xbf, 0xa8, 0x94, 0xb0, 0xa4, 0xdc, 0x39, 0xfa, 0x67, 0xca, 0x09, 0x4d,
+	0xf6, 0x44, 0xcf, 0x14, 0x8b, 0xfd, 0x0c, 0xdd, 0x53, 0x82, 0x69, 0x1a, 0x26, 0x50, 0x70, 0xe9,
+	0xb4, 0x06, 0xd6, 0xf0, 0x30, 0xe8, 0x99, 0xd8, 0x58, 0x85, 0x4e, 0x7e, 0x58, 0xa8, 0x7d, 0x79,
+	0x31, 0x53, 0xd2, 0xfe, 0x7a, 0x2c, 0x4f, 0x50, 0x93, 0xa5, 0x7a, 0x10, 0x5d, 0xbf, 0x55, 0x6e,
+	0xbd, 0xe6, 0x74, 0x12, 0x34, 0x59, 0x6a, 0x1f, 0xa3, 0x23, 0xb8, 0xe6, 0x34, 0xd7, 0xbe, 0x76,
+	0x03, 0x73, 0xb1, 0xcf, 0x51, 0xb7, 0x7a, 0x44, 0x24, 0xb5, 0x55, 0xbd, 0xb3, 0xfe, 0x1f, 0x8e,
+	0xcf, 0xea, 0x1f, 0xca, 0x58, 0x7e, 0xa3, 0x2c, 0xef, 0x98, 0xb2, 0x73, 0xf9, 0xff, 0x1c, 0xf2,
+	0x2f, 0x6f, 0x4b, 0xd7, 0xba, 0x2b, 0x5d, 0xeb, 0x7b, 0xe9, 0x5a, 0x37, 0x3b, 0xb7, 0x71, 0xb7,
+	0x73, 0x1b, 0x5f, 0x76, 0x6e, 0xe3, 0xdd, 0xcb, 0x3d, 0xc6, 0xb1, 0xde, 0xa4, 0x0b, 0x28, 0x78,
+	0xaa, 0x87, 0x4f, 0xaa, 0xe5, 0xfb, 0xb0, 0xb7, 0x7e, 0xba, 0x47, 0xdc, 0xd2, 0x8a, 0x5e, 0xfc,
+	0x1c, 0x00, 0xae, 0x1d, 0xb3, 0xed, 0x9f, 0x03, 0x00, 0x00,
+}
+
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockedCount != 0 {
+		i = encodeVarintReward(dAtA, i, uint64(m.LockedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.RewardPerToken.Size()
+		i -= size
+		if _, err := m.RewardPerToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinLockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinLockDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReward(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RewardPerBlock.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardPerToken.Size()
+		i -= size
+		if _, err := m.RewardPerToken.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LockedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintReward(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintReward(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *RewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = m.RewardPerBlock.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinLockDuration)
+	n += 1 + l + sovReward(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovReward(uint64(l))
+	l = m.RewardPerToken.Size()
+	n += 1 + l + sovReward(uint64(l))
+	if m.LockedCount != 0 {
+		n += 1 + sovReward(uint64(m.LockedCount))
+	}
+	return n
+}
+
+func (m *NFTLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovReward(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LockedAt)
+	n += 1 + l + sovReward(uint64(l))
+	l = m.RewardPerToken.Size()
+	n += 1 + l + sovReward(uint64(l))
+	return n
+}
+
+func sovReward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozReward(x uint64) (n int) {
+	return sovReward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinLockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedCount", wireType)
+			}
+			m.LockedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *NFTLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LockedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipReward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReward = fmt.Errorf("proto: unexpected end of group")
+)
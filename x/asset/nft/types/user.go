@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantUserSettings is the model which represents the params for granting the right to use the non-fungible token.
+type GrantUserSettings struct {
+	Sender     sdk.AccAddress
+	ClassID    string
+	ID         string
+	User       sdk.AccAddress
+	Expiration time.Time
+}
+
+// RevokeUserSettings is the model which represents the params for giving up the right to use the non-fungible token.
+type RevokeUserSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+}
+
+// IsActive returns true if the right hasn't expired at the time.
+func (g UserGrant) IsActive(now time.Time) bool {
+	return now.Before(g.Expiration)
+}
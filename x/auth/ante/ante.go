@@ -14,6 +14,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/pkg/config"
 	"github.com/CoreumFoundation/coreum/x/auth/keeper"
+	customparamsante "github.com/CoreumFoundation/coreum/x/customparams/ante"
 	deterministicgasante "github.com/CoreumFoundation/coreum/x/deterministicgas/ante"
 	feemodelante "github.com/CoreumFoundation/coreum/x/feemodel/ante"
 )
@@ -25,6 +26,7 @@ type HandlerOptions struct {
 	BankKeeper                   authtypes.BankKeeper
 	FeegrantKeeper               authante.FeegrantKeeper
 	FeeModelKeeper               feemodelante.Keeper
+	CustomParamsKeeper           customparamsante.Keeper
 	SignModeHandler              authsigning.SignModeHandler
 	SigGasConsumer               func(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	WasmTXCounterStoreKey        sdk.StoreKey
@@ -46,6 +48,10 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "fee model keeper is required for ante builder")
 	}
 
+	if options.CustomParamsKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "custom params keeper is required for ante builder")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
@@ -95,11 +101,18 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		deterministicgasante.NewSetInfiniteGasMeterDecorator(options.DeterministicGasRequirements),
 		authante.NewRejectExtensionOptionsDecorator(),
 		authante.NewValidateBasicDecorator(),
+		customparamsante.NewMaxMsgsDecorator(options.CustomParamsKeeper),
 		authante.NewTxTimeoutHeightDecorator(),
 		wasmkeeper.NewCountTXDecorator(options.WasmTXCounterStoreKey),
 		authante.NewValidateMemoDecorator(options.AccountKeeper),
 		feemodelante.NewFeeDecorator(options.FeeModelKeeper),
 		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		customparamsante.NewNewAccountFeeDecorator(
+			options.CustomParamsKeeper,
+			options.AccountKeeper,
+			options.BankKeeper,
+			options.FeegrantKeeper,
+		),
 		authante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
 		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
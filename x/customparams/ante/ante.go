@@ -0,0 +1,37 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+// Keeper interface exposes methods required by ante handler decorator of custom params.
+type Keeper interface {
+	GetTxParams(ctx sdk.Context) types.TxParams
+}
+
+// MaxMsgsDecorator rejects the transactions containing more messages than allowed by the governance.
+// The deterministic gas of the message doesn't depend on the number of the messages in the transaction, so the
+// transactions with many cheap messages are limited to protect the blocks from the pathological transactions.
+type MaxMsgsDecorator struct {
+	keeper Keeper
+}
+
+// NewMaxMsgsDecorator creates ante decorator refusing transactions containing too many messages.
+func NewMaxMsgsDecorator(keeper Keeper) MaxMsgsDecorator {
+	return MaxMsgsDecorator{
+		keeper: keeper,
+	}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (md MaxMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	maxMsgs := md.keeper.GetTxParams(ctx).MaxMsgs
+	if msgsNum := len(tx.GetMsgs()); msgsNum > int(maxMsgs) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "transaction contains %d messages, max allowed is %d", msgsNum, maxMsgs)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,53 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/customparams/ante"
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+type keeperMock struct {
+	params types.TxParams
+}
+
+func (k keeperMock) GetTxParams(ctx sdk.Context) types.TxParams {
+	return k.params
+}
+
+type txMock struct {
+	msgs []sdk.Msg
+}
+
+func (tx txMock) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (tx txMock) ValidateBasic() error {
+	return nil
+}
+
+func TestMaxMsgsDecorator(t *testing.T) {
+	requireT := require.New(t)
+
+	decorator := ante.NewMaxMsgsDecorator(keeperMock{params: types.TxParams{MaxMsgs: 2}})
+	var nextCalled bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	_, err := decorator.AnteHandle(sdk.Context{}, txMock{msgs: []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}}}, false, next)
+	requireT.NoError(err)
+	requireT.True(nextCalled)
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(sdk.Context{}, txMock{msgs: []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}, &banktypes.MsgSend{}}}, false, next)
+	requireT.True(sdkerrors.ErrInvalidRequest.Is(err))
+	requireT.False(nextCalled)
+}
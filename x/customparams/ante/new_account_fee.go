@@ -0,0 +1,156 @@
+package ante
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+// AccountKeeper interface exposes methods required by the new account fee decorator.
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper interface exposes methods required by the new account fee decorator.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// FeegrantKeeper interface exposes methods required by the new account fee decorator.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// NewAccountFeeDecorator charges the fee payer for the accounts created by the bank transfers of the transaction.
+// Creating the account costs the same deterministic gas as sending to the existing one, while the account stays in
+// the state forever, so the transactions airdropping the dust to many new accounts are charged on top of the gas.
+// The first accounts created by the transaction are free, so the regular transfers aren't affected.
+// The fee is paid the same way as the transaction fee, by the fee granter from its allowance if the granter is set.
+type NewAccountFeeDecorator struct {
+	keeper         Keeper
+	accountKeeper  AccountKeeper
+	bankKeeper     BankKeeper
+	feegrantKeeper FeegrantKeeper
+}
+
+// NewNewAccountFeeDecorator creates ante decorator charging the fee for the new accounts.
+func NewNewAccountFeeDecorator(
+	keeper Keeper,
+	accountKeeper AccountKeeper,
+	bankKeeper BankKeeper,
+	feegrantKeeper FeegrantKeeper,
+) NewAccountFeeDecorator {
+	return NewAccountFeeDecorator{
+		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		feegrantKeeper: feegrantKeeper,
+	}
+}
+
+// AnteHandle handles transaction in ante decorator.
+func (nd NewAccountFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newAccounts := nd.countNewAccounts(ctx, tx.GetMsgs())
+	if newAccounts == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := nd.keeper.GetTxParams(ctx)
+	if newAccounts > uint64(params.FreeNewAccounts) && !params.NewAccountFee.IsZero() {
+		charged := newAccounts - uint64(params.FreeNewAccounts)
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+		}
+
+		fee := sdk.NewCoins()
+		for _, coin := range params.NewAccountFee {
+			fee = fee.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(charged))))
+		}
+		payer, err := nd.feePayer(ctx, feeTx, fee)
+		if err != nil {
+			return ctx, err
+		}
+		if err := nd.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fee); err != nil {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"can't pay the fee %s for %d new accounts above the %d free ones: %s",
+				fee, charged, params.FreeNewAccounts, err,
+			)
+		}
+	}
+
+	if !ctx.IsCheckTx() && !simulate {
+		telemetry.IncrCounter(float32(newAccounts), types.ModuleName, "new_accounts")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// feePayer returns the account paying the fee. The fee granter pays the fee if it is set, the fee is deducted from
+// the allowance granted to the fee payer then, the same way the transaction fee is.
+func (nd NewAccountFeeDecorator) feePayer(ctx sdk.Context, feeTx sdk.FeeTx, fee sdk.Coins) (sdk.AccAddress, error) {
+	payer := feeTx.FeePayer()
+	granter := feeTx.FeeGranter()
+	if granter.Empty() {
+		return payer, nil
+	}
+
+	if nd.feegrantKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+	}
+	if !granter.Equals(payer) {
+		if err := nd.feegrantKeeper.UseGrantedFees(ctx, granter, payer, fee, feeTx.GetMsgs()); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s not allowed to pay the new account fee from %s", granter, payer)
+		}
+	}
+	return granter, nil
+}
+
+// countNewAccounts returns the number of distinct recipients of the bank transfers not having the account yet.
+// The transfers executed on behalf of other accounts (e.g. by authz) are counted too.
+func (nd NewAccountFeeDecorator) countNewAccounts(ctx sdk.Context, msgs []sdk.Msg) uint64 {
+	recipients := map[string]struct{}{}
+	var count uint64
+	countRecipient := func(address string) {
+		if _, ok := recipients[address]; ok {
+			return
+		}
+		recipients[address] = struct{}{}
+
+		// the addresses are checked by the validate basic decorator already
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return
+		}
+		if nd.accountKeeper.GetAccount(ctx, addr) == nil {
+			count++
+		}
+	}
+
+	var countMsgs func(msgs []sdk.Msg)
+	countMsgs = func(msgs []sdk.Msg) {
+		for _, msg := range msgs {
+			switch msg := msg.(type) {
+			case *banktypes.MsgSend:
+				countRecipient(msg.ToAddress)
+			case *banktypes.MsgMultiSend:
+				for _, output := range msg.Outputs {
+					countRecipient(output.Address)
+				}
+			case interface{ GetMessages() ([]sdk.Msg, error) }:
+				// the messages which can't be unpacked are rejected by the message handler anyway
+				if nestedMsgs, err := msg.GetMessages(); err == nil {
+					countMsgs(nestedMsgs)
+				}
+			}
+		}
+	}
+	countMsgs(msgs)
+
+	return count
+}
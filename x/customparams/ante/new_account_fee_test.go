@@ -0,0 +1,216 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/customparams/ante"
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+type accountKeeperMock struct {
+	accounts map[string]struct{}
+}
+
+func (k accountKeeperMock) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI {
+	if _, ok := k.accounts[addr.String()]; !ok {
+		return nil
+	}
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+type bankKeeperMock struct {
+	balance sdk.Coins
+	paid    sdk.Coins
+}
+
+func (k *bankKeeperMock) SendCoinsFromAccountToModule(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	if !k.balance.IsAllGTE(amt) {
+		return errors.New("insufficient funds")
+	}
+	k.balance = k.balance.Sub(amt)
+	k.paid = k.paid.Add(amt...)
+	return nil
+}
+
+type feegrantKeeperMock struct {
+	allowances map[string]sdk.Coins
+}
+
+func (k feegrantKeeperMock) UseGrantedFees(
+	ctx sdk.Context,
+	granter, grantee sdk.AccAddress,
+	fee sdk.Coins,
+	msgs []sdk.Msg,
+) error {
+	key := granter.String() + grantee.String()
+	if !k.allowances[key].IsAllGTE(fee) {
+		return errors.New("fee limit exceeded")
+	}
+	k.allowances[key] = k.allowances[key].Sub(fee)
+	return nil
+}
+
+type feeTxMock struct {
+	txMock
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (tx feeTxMock) GetGas() uint64 {
+	return 0
+}
+
+func (tx feeTxMock) GetFee() sdk.Coins {
+	return nil
+}
+
+func (tx feeTxMock) FeePayer() sdk.AccAddress {
+	return tx.payer
+}
+
+func (tx feeTxMock) FeeGranter() sdk.AccAddress {
+	return tx.granter
+}
+
+func TestNewAccountFeeDecorator(t *testing.T) {
+	requireT := require.New(t)
+
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	existing := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newAccounts := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	accountKeeper := accountKeeperMock{accounts: map[string]struct{}{
+		payer.String():    {},
+		existing.String(): {},
+	}}
+	params := types.TxParams{
+		MaxMsgs:         10,
+		NewAccountFee:   sdk.NewCoins(sdk.NewInt64Coin("ucore", 100)),
+		FreeNewAccounts: 1,
+	}
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	ctx := sdk.Context{}.WithIsCheckTx(true)
+
+	// the account created twice and the existing account are counted once and not at all
+	tx := feeTxMock{
+		payer: payer,
+		txMock: txMock{msgs: []sdk.Msg{
+			&banktypes.MsgSend{ToAddress: newAccounts[0].String()},
+			&banktypes.MsgSend{ToAddress: newAccounts[0].String()},
+			&banktypes.MsgSend{ToAddress: existing.String()},
+			&banktypes.MsgMultiSend{Outputs: []banktypes.Output{
+				{Address: newAccounts[1].String()},
+				{Address: newAccounts[2].String()},
+			}},
+		}},
+	}
+	bankKeeper := &bankKeeperMock{balance: sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))}
+	decorator := ante.NewNewAccountFeeDecorator(keeperMock{params: params}, accountKeeper, bankKeeper, nil)
+	_, err := decorator.AnteHandle(ctx, tx, false, next)
+	requireT.NoError(err)
+	requireT.Equal("200ucore", bankKeeper.paid.String())
+
+	// the fee is not charged if the new accounts are free
+	params.FreeNewAccounts = 3
+	bankKeeper = &bankKeeperMock{balance: sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))}
+	decorator = ante.NewNewAccountFeeDecorator(keeperMock{params: params}, accountKeeper, bankKeeper, nil)
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	requireT.NoError(err)
+	requireT.True(bankKeeper.paid.IsZero())
+
+	// the fee is not charged if it is not set
+	params.FreeNewAccounts = 0
+	params.NewAccountFee = sdk.NewCoins()
+	decorator = ante.NewNewAccountFeeDecorator(keeperMock{params: params}, accountKeeper, bankKeeper, nil)
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	requireT.NoError(err)
+	requireT.True(bankKeeper.paid.IsZero())
+
+	// the transaction is rejected if the payer can't pay the fee
+	params.NewAccountFee = sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))
+	decorator = ante.NewNewAccountFeeDecorator(keeperMock{params: params}, accountKeeper, bankKeeper, nil)
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	requireT.True(sdkerrors.ErrInsufficientFee.Is(err))
+}
+
+func TestNewAccountFeeDecorator_AuthzAndFeeGrant(t *testing.T) {
+	requireT := require.New(t)
+
+	payer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	granter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	newAccounts := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	accountKeeper := accountKeeperMock{accounts: map[string]struct{}{
+		payer.String():   {},
+		granter.String(): {},
+	}}
+	params := types.TxParams{
+		MaxMsgs:         10,
+		NewAccountFee:   sdk.NewCoins(sdk.NewInt64Coin("ucore", 100)),
+		FreeNewAccounts: 0,
+	}
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	ctx := sdk.Context{}.WithIsCheckTx(true)
+
+	// the transfers executed by authz are counted
+	execMsg := authz.NewMsgExec(payer, []sdk.Msg{
+		&banktypes.MsgSend{ToAddress: newAccounts[0].String()},
+		&banktypes.MsgMultiSend{Outputs: []banktypes.Output{
+			{Address: newAccounts[0].String()},
+			{Address: newAccounts[1].String()},
+		}},
+	})
+	tx := feeTxMock{
+		payer:  payer,
+		txMock: txMock{msgs: []sdk.Msg{&execMsg}},
+	}
+	bankKeeper := &bankKeeperMock{balance: sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))}
+	decorator := ante.NewNewAccountFeeDecorator(keeperMock{params: params}, accountKeeper, bankKeeper, nil)
+	_, err := decorator.AnteHandle(ctx, tx, false, next)
+	requireT.NoError(err)
+	requireT.Equal("200ucore", bankKeeper.paid.String())
+
+	// the fee granter can't pay if the fee grants are not enabled
+	tx.granter = granter
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	requireT.True(sdkerrors.ErrInvalidRequest.Is(err))
+
+	// the fee granter pays from the allowance
+	feegrantKeeper := feegrantKeeperMock{allowances: map[string]sdk.Coins{
+		granter.String() + payer.String(): sdk.NewCoins(sdk.NewInt64Coin("ucore", 300)),
+	}}
+	bankKeeper = &bankKeeperMock{balance: sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))}
+	decorator = ante.NewNewAccountFeeDecorator(keeperMock{params: params}, accountKeeper, bankKeeper, feegrantKeeper)
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	requireT.NoError(err)
+	requireT.Equal("200ucore", bankKeeper.paid.String())
+	requireT.Equal("100ucore", feegrantKeeper.allowances[granter.String()+payer.String()].String())
+
+	// the transaction is rejected if the allowance doesn't cover the fee
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	requireT.Error(err)
+	requireT.Equal("200ucore", bankKeeper.paid.String())
+}
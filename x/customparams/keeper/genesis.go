@@ -9,11 +9,15 @@ import (
 // InitGenesis initializes the customparams module's state with the provided genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.SetStakingParams(ctx, genState.StakingParams)
+	k.SetWasmParams(ctx, genState.WasmParams)
+	k.SetTxParams(ctx, genState.TxParams)
 }
 
 // ExportGenesis returns the customparams module's exported genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
 		StakingParams: k.GetStakingParams(ctx),
+		WasmParams:    k.GetWasmParams(ctx),
+		TxParams:      k.GetTxParams(ctx),
 	}
 }
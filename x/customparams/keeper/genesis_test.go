@@ -20,11 +20,19 @@ func TestKeeper_InitAndExportGenesis(t *testing.T) {
 		StakingParams: types.StakingParams{
 			MinSelfDelegation: sdk.OneInt(),
 		},
+		WasmParams: types.WasmParams{
+			PinnedInstanceCostDiscount: sdk.NewDecWithPrec(5, 1),
+		},
+		TxParams: types.TxParams{
+			MaxMsgs: 10,
+		},
 	}
 	keeper.InitGenesis(ctx, genState)
 
 	requireT := require.New(t)
 	requireT.Equal(sdk.OneInt().String(), keeper.GetStakingParams(ctx).MinSelfDelegation.String())
+	requireT.Equal(sdk.NewDecWithPrec(5, 1).String(), keeper.GetWasmParams(ctx).PinnedInstanceCostDiscount.String())
+	requireT.EqualValues(10, keeper.GetTxParams(ctx).MaxMsgs)
 
 	exportedGetState := keeper.ExportGenesis(ctx)
 	requireT.Equal(genState, *exportedGetState)
@@ -13,6 +13,8 @@ import (
 // QueryKeeper defines subscope of keeper methods required by query service.
 type QueryKeeper interface {
 	GetStakingParams(ctx sdk.Context) types.StakingParams
+	GetWasmParams(ctx sdk.Context) types.WasmParams
+	GetTxParams(ctx sdk.Context) types.TxParams
 }
 
 // NewQueryService creates query service.
@@ -37,3 +39,25 @@ func (qs QueryService) StakingParams(ctx context.Context, req *types.QueryStakin
 		Params: qs.keeper.GetStakingParams(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// WasmParams returns wasm params of the model.
+func (qs QueryService) WasmParams(ctx context.Context, req *types.QueryWasmParamsRequest) (*types.QueryWasmParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryWasmParamsResponse{
+		Params: qs.keeper.GetWasmParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// TxParams returns transaction params of the model.
+func (qs QueryService) TxParams(ctx context.Context, req *types.QueryTxParamsRequest) (*types.QueryTxParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryTxParamsResponse{
+		Params: qs.keeper.GetTxParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
@@ -10,17 +10,27 @@ import (
 // Keeper is customparams module Keeper.
 type Keeper struct {
 	stakingParamSpace paramtypes.Subspace
+	wasmParamSpace    paramtypes.Subspace
+	txParamSpace      paramtypes.Subspace
 }
 
 // NewKeeper returns a new Keeper instance.
-func NewKeeper(stakingParamSpace paramtypes.Subspace) Keeper {
+func NewKeeper(stakingParamSpace, wasmParamSpace, txParamSpace paramtypes.Subspace) Keeper {
 	// set KeyTable if it has not already been set
 	if !stakingParamSpace.HasKeyTable() {
 		stakingParamSpace = stakingParamSpace.WithKeyTable(types.StakingParamKeyTable())
 	}
+	if !wasmParamSpace.HasKeyTable() {
+		wasmParamSpace = wasmParamSpace.WithKeyTable(types.WasmParamKeyTable())
+	}
+	if !txParamSpace.HasKeyTable() {
+		txParamSpace = txParamSpace.WithKeyTable(types.TxParamKeyTable())
+	}
 
 	return Keeper{
 		stakingParamSpace: stakingParamSpace,
+		wasmParamSpace:    wasmParamSpace,
+		txParamSpace:      txParamSpace,
 	}
 }
 
@@ -35,3 +45,27 @@ func (k Keeper) GetStakingParams(ctx sdk.Context) types.StakingParams {
 func (k Keeper) SetStakingParams(ctx sdk.Context, params types.StakingParams) {
 	k.stakingParamSpace.SetParamSet(ctx, &params)
 }
+
+// GetWasmParams returns the set of wasm parameters.
+func (k Keeper) GetWasmParams(ctx sdk.Context) types.WasmParams {
+	var wasmParams types.WasmParams
+	k.wasmParamSpace.GetParamSet(ctx, &wasmParams)
+	return wasmParams
+}
+
+// SetWasmParams sets the module wasm parameters to the param space.
+func (k Keeper) SetWasmParams(ctx sdk.Context, params types.WasmParams) {
+	k.wasmParamSpace.SetParamSet(ctx, &params)
+}
+
+// GetTxParams returns the set of transaction parameters.
+func (k Keeper) GetTxParams(ctx sdk.Context) types.TxParams {
+	var txParams types.TxParams
+	k.txParamSpace.GetParamSet(ctx, &txParams)
+	return txParams
+}
+
+// SetTxParams sets the module transaction parameters to the param space.
+func (k Keeper) SetTxParams(ctx sdk.Context, params types.TxParams) {
+	k.txParamSpace.SetParamSet(ctx, &params)
+}
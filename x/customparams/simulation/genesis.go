@@ -0,0 +1,78 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+const (
+	// MinSelfDelegation is the key used to store the min self delegation in the simulation app params.
+	MinSelfDelegation = "min_self_delegation"
+	// PinnedInstanceCostDiscount is the key used to store the pinned instance cost discount in the simulation app params.
+	PinnedInstanceCostDiscount = "pinned_instance_cost_discount"
+	// MaxMsgs is the key used to store the max number of messages in the transaction in the simulation app params.
+	MaxMsgs = "max_msgs"
+)
+
+const (
+	// maxMinSelfDelegation is the upper bound (exclusive) of the randomized min self delegation.
+	maxMinSelfDelegation = 1000
+	// maxMaxMsgs is the upper bound (inclusive) of the randomized max number of messages in the transaction.
+	maxMaxMsgs = 500
+)
+
+// genMinSelfDelegation returns a randomized min self delegation.
+func genMinSelfDelegation(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(r.Int63n(maxMinSelfDelegation) + 1)
+}
+
+// genPinnedInstanceCostDiscount returns a randomized pinned instance cost discount between 0 and 1.
+func genPinnedInstanceCostDiscount(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(r.Int63n(101), 2)
+}
+
+// genMaxMsgs returns a randomized max number of messages in the transaction.
+func genMaxMsgs(r *rand.Rand) uint32 {
+	return uint32(r.Int63n(maxMaxMsgs) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for customparams.
+func RandomizedGenState(simState *module.SimulationState) {
+	var minSelfDelegation sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinSelfDelegation, &minSelfDelegation, simState.Rand,
+		func(r *rand.Rand) { minSelfDelegation = genMinSelfDelegation(r) },
+	)
+
+	var pinnedInstanceCostDiscount sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PinnedInstanceCostDiscount, &pinnedInstanceCostDiscount, simState.Rand,
+		func(r *rand.Rand) { pinnedInstanceCostDiscount = genPinnedInstanceCostDiscount(r) },
+	)
+
+	var maxMsgs uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxMsgs, &maxMsgs, simState.Rand,
+		func(r *rand.Rand) { maxMsgs = genMaxMsgs(r) },
+	)
+
+	customParamsGenesis := types.GenesisState{
+		StakingParams: types.StakingParams{
+			MinSelfDelegation: minSelfDelegation,
+		},
+		WasmParams: types.WasmParams{
+			PinnedInstanceCostDiscount: pinnedInstanceCostDiscount,
+		},
+		// the new accounts are created for free, so the simulated transfers don't need to pay for them
+		TxParams: types.TxParams{
+			MaxMsgs:         maxMsgs,
+			NewAccountFee:   sdk.NewCoins(),
+			FreeNewAccounts: types.DefaultTxParams().FreeNewAccounts,
+		},
+	}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&customParamsGenesis)
+}
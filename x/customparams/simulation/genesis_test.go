@@ -0,0 +1,76 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/customparams/simulation"
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.New()
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var customParamsGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &customParamsGenesis)
+
+	require.NoError(t, customParamsGenesis.Validate())
+	require.True(t, customParamsGenesis.StakingParams.MinSelfDelegation.IsPositive())
+	require.False(t, customParamsGenesis.WasmParams.PinnedInstanceCostDiscount.IsNegative())
+	require.Positive(t, customParamsGenesis.TxParams.MaxMsgs)
+}
+
+func TestParamChanges(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	paramChanges := simulation.ParamChanges(r)
+	require.Len(t, paramChanges, 3)
+
+	paramChange := paramChanges[0]
+	require.Equal(t, types.CustomParamsStaking, paramChange.Subspace())
+	require.Equal(t, string(types.ParamStoreKeyMinSelfDelegation), paramChange.Key())
+	require.Equal(t, "customparamsstaking/minselfdelegation", paramChange.ComposedKey())
+
+	var minSelfDelegation sdk.Int
+	require.NoError(t, json.Unmarshal([]byte(paramChange.SimValue()(r)), &minSelfDelegation))
+	require.True(t, minSelfDelegation.IsPositive())
+
+	paramChange = paramChanges[1]
+	require.Equal(t, types.CustomParamsWasm, paramChange.Subspace())
+	require.Equal(t, string(types.ParamStoreKeyPinnedInstanceCostDiscount), paramChange.Key())
+	require.Equal(t, "customparamswasm/pinnedinstancecostdiscount", paramChange.ComposedKey())
+
+	var pinnedInstanceCostDiscount sdk.Dec
+	require.NoError(t, json.Unmarshal([]byte(paramChange.SimValue()(r)), &pinnedInstanceCostDiscount))
+	require.True(t, pinnedInstanceCostDiscount.LTE(sdk.OneDec()))
+
+	paramChange = paramChanges[2]
+	require.Equal(t, types.CustomParamsTx, paramChange.Subspace())
+	require.Equal(t, string(types.ParamStoreKeyMaxMsgs), paramChange.Key())
+	require.Equal(t, "customparamstx/maxmsgs", paramChange.ComposedKey())
+
+	var maxMsgs uint32
+	require.NoError(t, json.Unmarshal([]byte(paramChange.SimValue()(r)), &maxMsgs))
+	require.Positive(t, maxMsgs)
+}
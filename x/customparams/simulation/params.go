@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/CoreumFoundation/coreum/x/customparams/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation.
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.CustomParamsStaking, string(types.ParamStoreKeyMinSelfDelegation),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genMinSelfDelegation(r))
+			},
+		),
+		simulation.NewSimParamChange(types.CustomParamsWasm, string(types.ParamStoreKeyPinnedInstanceCostDiscount),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", genPinnedInstanceCostDiscount(r))
+			},
+		),
+		simulation.NewSimParamChange(types.CustomParamsTx, string(types.ParamStoreKeyMaxMsgs),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", genMaxMsgs(r))
+			},
+		),
+	}
+}
@@ -4,10 +4,18 @@ package types
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		StakingParams: DefaultStakingParams(),
+		WasmParams:    DefaultWasmParams(),
+		TxParams:      DefaultTxParams(),
 	}
 }
 
 // Validate validates genesis parameters
 func (m *GenesisState) Validate() error {
-	return m.StakingParams.ValidateBasic()
+	if err := m.StakingParams.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := m.WasmParams.ValidateBasic(); err != nil {
+		return err
+	}
+	return m.TxParams.ValidateBasic()
 }
@@ -32,6 +32,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// staking_params defines staking parameters of the module.
 	StakingParams StakingParams `protobuf:"bytes,1,opt,name=staking_params,json=stakingParams,proto3" json:"staking_params"`
+	// wasm_params defines wasm parameters of the module.
+	WasmParams WasmParams `protobuf:"bytes,2,opt,name=wasm_params,json=wasmParams,proto3" json:"wasm_params"`
+	// tx_params defines transaction parameters of the module.
+	TxParams TxParams `protobuf:"bytes,3,opt,name=tx_params,json=txParams,proto3" json:"tx_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,20 @@ func (m *GenesisState) GetStakingParams() StakingParams {
 	return StakingParams{}
 }
 
+func (m *GenesisState) GetWasmParams() WasmParams {
+	if m != nil {
+		return m.WasmParams
+	}
+	return WasmParams{}
+}
+
+func (m *GenesisState) GetTxParams() TxParams {
+	if m != nil {
+		return m.TxParams
+	}
+	return TxParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.customparams.v1.GenesisState")
 }
@@ -88,23 +106,26 @@ func init() {
 }
 
 var fileDescriptor_fe3d5fb69a1f14ca = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2e, 0x2d, 0x2e, 0xc9, 0xcf, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xa8, 0xd2, 0x43, 0x56, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa2, 0x0f, 0x62, 0x41, 0x54, 0x4b, 0xc9, 0x25, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb,
-	0x27, 0x25, 0x16, 0xa7, 0xea, 0x97, 0x19, 0x26, 0xa5, 0x96, 0x24, 0x1a, 0xea, 0x27, 0xe7, 0x67,
-	0xe6, 0x41, 0xe5, 0x95, 0x71, 0xd8, 0x09, 0x35, 0x17, 0xac, 0x48, 0x29, 0x89, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x86, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0xa1, 0x20, 0x2e, 0xbe, 0xe2, 0x92, 0xc4, 0xec, 0xcc,
-	0xbc, 0xf4, 0x78, 0x88, 0x3a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x55, 0x3d, 0xec, 0x6e,
-	0xd3, 0x0b, 0x86, 0xa8, 0x0e, 0x00, 0x0b, 0x38, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0xc4, 0x5b,
-	0x8c, 0x22, 0x18, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xe6, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce, 0x60, 0xf3, 0xdd, 0xf2, 0x4b,
-	0xf3, 0x52, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0xf4, 0xa1, 0xce, 0xaf, 0x40, 0xf5, 0x40, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xf5, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x71,
-	0xed, 0x8e, 0x58, 0x01, 0x00, 0x00,
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xc1, 0x4a, 0xec, 0x30,
+	0x18, 0x85, 0x9b, 0x7b, 0x45, 0x34, 0xa3, 0x2e, 0x8a, 0x88, 0xcc, 0x22, 0x0e, 0xa3, 0x82, 0xab,
+	0x84, 0xea, 0xc2, 0xbd, 0x03, 0x8a, 0x3b, 0x9d, 0x11, 0x04, 0x37, 0x92, 0xd6, 0x50, 0x8b, 0xa4,
+	0x7f, 0xe9, 0x9f, 0x76, 0xea, 0x5b, 0xf8, 0x58, 0xb3, 0x9c, 0xa5, 0x2b, 0x91, 0xf6, 0x0d, 0x7c,
+	0x02, 0x31, 0x6d, 0x65, 0x0a, 0x76, 0x97, 0x9c, 0x7c, 0x7c, 0x39, 0x1c, 0x7a, 0x14, 0x40, 0xaa,
+	0x32, 0x2d, 0x82, 0x0c, 0x0d, 0xe8, 0x44, 0xa6, 0x52, 0xa3, 0xc8, 0x3d, 0x11, 0xaa, 0x58, 0x61,
+	0x84, 0x3c, 0x49, 0xc1, 0x80, 0xbb, 0x57, 0x53, 0x7c, 0x95, 0xe2, 0xb9, 0x37, 0xdc, 0x0d, 0x21,
+	0x04, 0x8b, 0x88, 0x9f, 0x53, 0x4d, 0x0f, 0x59, 0x00, 0xa8, 0x01, 0x85, 0x2f, 0x51, 0x89, 0xdc,
+	0xf3, 0x95, 0x91, 0x9e, 0x08, 0x20, 0x8a, 0x9b, 0xf7, 0xc3, 0x9e, 0x3f, 0x1b, 0xaf, 0x85, 0xc6,
+	0x5f, 0x84, 0x6e, 0x5d, 0xd5, 0x25, 0x66, 0x46, 0x1a, 0xe5, 0x4e, 0xe9, 0x0e, 0x1a, 0xf9, 0x12,
+	0xc5, 0xe1, 0x63, 0x0d, 0xee, 0x93, 0x11, 0x39, 0x19, 0x9c, 0x1e, 0xf3, 0xbf, 0xcb, 0xf1, 0x59,
+	0x4d, 0xdf, 0xd8, 0xe0, 0x62, 0x6d, 0xf1, 0x71, 0xe0, 0x4c, 0xb7, 0x71, 0x35, 0x74, 0xaf, 0xe9,
+	0x60, 0x2e, 0x51, 0xb7, 0xc2, 0x7f, 0x56, 0x38, 0xee, 0x13, 0xde, 0x4b, 0xd4, 0x1d, 0x1b, 0x9d,
+	0xff, 0x26, 0xee, 0x84, 0x6e, 0x9a, 0xa2, 0x15, 0xfd, 0xb7, 0xa2, 0x51, 0x9f, 0xe8, 0xae, 0xe8,
+	0x68, 0x36, 0x4c, 0x7b, 0xbf, 0x5d, 0x94, 0x8c, 0x2c, 0x4b, 0x46, 0x3e, 0x4b, 0x46, 0xde, 0x2a,
+	0xe6, 0x2c, 0x2b, 0xe6, 0xbc, 0x57, 0xcc, 0x79, 0x38, 0x0f, 0x23, 0xf3, 0x9c, 0xf9, 0x3c, 0x00,
+	0x2d, 0x26, 0xd6, 0x7a, 0x09, 0x59, 0xfc, 0x24, 0x4d, 0x04, 0xb1, 0x68, 0xf6, 0x2c, 0xba, 0x8b,
+	0x9a, 0xd7, 0x44, 0xa1, 0xbf, 0x6e, 0xe7, 0x3c, 0xfb, 0x1e, 0x00, 0x92, 0xb4, 0x64, 0x2f, 0xe9,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +148,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TxParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.WasmParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.StakingParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -160,6 +201,10 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.StakingParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.WasmParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TxParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -233,6 +278,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WasmParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
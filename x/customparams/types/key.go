@@ -12,4 +12,10 @@ const (
 
 	// CustomParamsStaking defines the params space key to store the staking custom params.
 	CustomParamsStaking = "customparamsstaking"
+
+	// CustomParamsWasm defines the params space key to store the wasm custom params.
+	CustomParamsWasm = "customparamswasm"
+
+	// CustomParamsTx defines the params space key to store the transaction custom params.
+	CustomParamsTx = "customparamstx"
 )
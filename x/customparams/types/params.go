@@ -6,8 +6,18 @@ import (
 	"github.com/pkg/errors"
 )
 
-// ParamStoreKeyMinSelfDelegation defines the param key for the min_self_delegation param.
-var ParamStoreKeyMinSelfDelegation = []byte("minselfdelegation")
+var (
+	// ParamStoreKeyMinSelfDelegation defines the param key for the min_self_delegation param.
+	ParamStoreKeyMinSelfDelegation = []byte("minselfdelegation")
+	// ParamStoreKeyPinnedInstanceCostDiscount defines the param key for the pinned_instance_cost_discount param.
+	ParamStoreKeyPinnedInstanceCostDiscount = []byte("pinnedinstancecostdiscount")
+	// ParamStoreKeyMaxMsgs defines the param key for the max_msgs param.
+	ParamStoreKeyMaxMsgs = []byte("maxmsgs")
+	// ParamStoreKeyNewAccountFee defines the param key for the new_account_fee param.
+	ParamStoreKeyNewAccountFee = []byte("newaccountfee")
+	// ParamStoreKeyFreeNewAccounts defines the param key for the free_new_accounts param.
+	ParamStoreKeyFreeNewAccounts = []byte("freenewaccounts")
+)
 
 // StakingParamKeyTable returns the parameter key table.
 func StakingParamKeyTable() paramtypes.KeyTable {
@@ -48,3 +58,111 @@ func validateMinSelfDelegation(i interface{}) error {
 
 	return nil
 }
+
+// WasmParamKeyTable returns the wasm parameter key table.
+func WasmParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&WasmParams{})
+}
+
+// DefaultWasmParams returns default wasm parameters. The pinned contracts don't pay the instance cost by default.
+func DefaultWasmParams() WasmParams {
+	return WasmParams{
+		PinnedInstanceCostDiscount: sdk.OneDec(),
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *WasmParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyPinnedInstanceCostDiscount, &p.PinnedInstanceCostDiscount, validatePinnedInstanceCostDiscount),
+	}
+}
+
+// ValidateBasic performs basic validation on wasm parameters.
+func (p WasmParams) ValidateBasic() error {
+	return validatePinnedInstanceCostDiscount(p.PinnedInstanceCostDiscount)
+}
+
+func validatePinnedInstanceCostDiscount(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("param pinned_instance_cost_discount must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return errors.Errorf("param pinned_instance_cost_discount must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+// TxParamKeyTable returns the transaction parameter key table.
+func TxParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&TxParams{})
+}
+
+// DefaultTxParams returns default transaction parameters. The new accounts are created for free by default.
+func DefaultTxParams() TxParams {
+	return TxParams{
+		MaxMsgs:         200,
+		NewAccountFee:   sdk.NewCoins(),
+		FreeNewAccounts: 10,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *TxParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxMsgs, &p.MaxMsgs, validateMaxMsgs),
+		paramtypes.NewParamSetPair(ParamStoreKeyNewAccountFee, &p.NewAccountFee, validateNewAccountFee),
+		paramtypes.NewParamSetPair(ParamStoreKeyFreeNewAccounts, &p.FreeNewAccounts, validateFreeNewAccounts),
+	}
+}
+
+// ValidateBasic performs basic validation on transaction parameters.
+func (p TxParams) ValidateBasic() error {
+	if err := validateMaxMsgs(p.MaxMsgs); err != nil {
+		return err
+	}
+	if err := validateNewAccountFee(p.NewAccountFee); err != nil {
+		return err
+	}
+	return validateFreeNewAccounts(p.FreeNewAccounts)
+}
+
+func validateMaxMsgs(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("param max_msgs must be positive")
+	}
+
+	return nil
+}
+
+func validateNewAccountFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return errors.Wrap(err, "param new_account_fee is invalid")
+	}
+
+	return nil
+}
+
+func validateFreeNewAccounts(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
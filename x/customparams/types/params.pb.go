@@ -10,6 +10,7 @@ import (
 	math_bits "math/bits"
 
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -73,8 +74,125 @@ func (m *StakingParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StakingParams proto.InternalMessageInfo
 
+// WasmParams defines the set of additional wasm params used by the wasm gas register.
+type WasmParams struct {
+	// pinned_instance_cost_discount is the part of the instance cost the pinned contracts are not charged for.
+	// It must be between 0 and 1, where 1 means the pinned contracts don't pay the instance cost at all.
+	PinnedInstanceCostDiscount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=pinned_instance_cost_discount,json=pinnedInstanceCostDiscount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pinned_instance_cost_discount" yaml:"pinned_instance_cost_discount"`
+}
+
+func (m *WasmParams) Reset()         { *m = WasmParams{} }
+func (m *WasmParams) String() string { return proto.CompactTextString(m) }
+func (*WasmParams) ProtoMessage()    {}
+func (*WasmParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{1}
+}
+
+func (m *WasmParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WasmParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WasmParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *WasmParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmParams.Merge(m, src)
+}
+
+func (m *WasmParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WasmParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmParams proto.InternalMessageInfo
+
+// TxParams defines the set of additional params limiting the transactions.
+type TxParams struct {
+	// max_msgs is the maximum number of messages a single transaction might contain.
+	MaxMsgs uint32 `protobuf:"varint,1,opt,name=max_msgs,json=maxMsgs,proto3" json:"max_msgs,omitempty" yaml:"max_msgs"`
+	// new_account_fee is the fee charged from the fee payer for each account created by the bank transfers of
+	// the transaction above the free_new_accounts. If empty, the accounts are created for free.
+	NewAccountFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=new_account_fee,json=newAccountFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"new_account_fee" yaml:"new_account_fee"`
+	// free_new_accounts is the number of accounts a single transaction might create without paying the new_account_fee.
+	FreeNewAccounts uint32 `protobuf:"varint,3,opt,name=free_new_accounts,json=freeNewAccounts,proto3" json:"free_new_accounts,omitempty" yaml:"free_new_accounts"`
+}
+
+func (m *TxParams) Reset()         { *m = TxParams{} }
+func (m *TxParams) String() string { return proto.CompactTextString(m) }
+func (*TxParams) ProtoMessage()    {}
+func (*TxParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_957be068a77b113f, []int{2}
+}
+
+func (m *TxParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TxParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TxParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxParams.Merge(m, src)
+}
+
+func (m *TxParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TxParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxParams proto.InternalMessageInfo
+
+func (m *TxParams) GetMaxMsgs() uint32 {
+	if m != nil {
+		return m.MaxMsgs
+	}
+	return 0
+}
+
+func (m *TxParams) GetNewAccountFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NewAccountFee
+	}
+	return nil
+}
+
+func (m *TxParams) GetFreeNewAccounts() uint32 {
+	if m != nil {
+		return m.FreeNewAccounts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StakingParams)(nil), "coreum.customparams.v1.StakingParams")
+	proto.RegisterType((*WasmParams)(nil), "coreum.customparams.v1.WasmParams")
+	proto.RegisterType((*TxParams)(nil), "coreum.customparams.v1.TxParams")
 }
 
 func init() {
@@ -82,24 +200,38 @@ func init() {
 }
 
 var fileDescriptor_957be068a77b113f = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2e, 0x2d, 0x2e, 0xc9, 0xcf, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x87, 0xb0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0x20, 0x8a, 0xf4,
-	0x90, 0x15, 0xe9, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95, 0xe8, 0x83, 0x58,
-	0x10, 0xd5, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0xd4, 0xcb, 0xc8, 0xc5, 0x1b, 0x5c, 0x92, 0x98, 0x9d, 0x99, 0x97, 0x1e, 0x00, 0x36, 0x45,
-	0xa8, 0x86, 0x4b, 0x38, 0x37, 0x33, 0x2f, 0xbe, 0x38, 0x35, 0x27, 0x2d, 0x3e, 0x25, 0x35, 0x27,
-	0x35, 0x3d, 0xb1, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xe7, 0xc4,
-	0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xd5, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3,
-	0x73, 0xa1, 0xe6, 0x41, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d,
-	0xcf, 0xbc, 0x92, 0x4f, 0xf7, 0xe4, 0xa5, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0xb0, 0x18, 0xa9,
-	0x14, 0x24, 0x98, 0x9b, 0x99, 0x17, 0x9c, 0x9a, 0x93, 0xe6, 0x02, 0x17, 0x73, 0x0a, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x73, 0x24, 0x2b, 0x9d, 0xc1, 0xbe, 0x77,
-	0xcb, 0x2f, 0xcd, 0x4b, 0x01, 0x6b, 0xd3, 0x87, 0x86, 0x59, 0x05, 0x6a, 0xa8, 0x81, 0xdd, 0x91,
-	0xc4, 0x06, 0xf6, 0xa9, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x69, 0x1c, 0xd6, 0x59, 0x01,
-	0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xce, 0xb6, 0xa0, 0x75, 0x24, 0x84, 0xa6, 0x52, 0xd2, 0xa0, 0xbb, 0x65, 0x15, 0xc9, 0xc5,
+	0x5d, 0xa2, 0x07, 0xc1, 0x9b, 0x49, 0x28, 0x56, 0x54, 0x34, 0x15, 0x05, 0x2f, 0xc3, 0x64, 0xf2,
+	0x66, 0x1d, 0x9a, 0x99, 0x09, 0x79, 0x67, 0xd3, 0x14, 0xfc, 0x0b, 0x05, 0x0f, 0x5e, 0xfc, 0x07,
+	0xe2, 0x2f, 0xe9, 0xb1, 0x47, 0xf1, 0xb0, 0x4a, 0xf2, 0x0f, 0xf6, 0x17, 0xc8, 0xee, 0x8c, 0x1a,
+	0x3f, 0x10, 0x7b, 0x9a, 0x8f, 0xe7, 0x99, 0xe7, 0x7d, 0x5e, 0x9e, 0x79, 0xc9, 0x75, 0xae, 0xa7,
+	0x90, 0xca, 0x98, 0xa7, 0x68, 0xb4, 0x9c, 0xb0, 0x29, 0x93, 0x18, 0xcf, 0xda, 0xb1, 0xdd, 0x45,
+	0x93, 0xa9, 0x36, 0xba, 0xbe, 0x6d, 0x49, 0xd1, 0x2a, 0x29, 0x9a, 0xb5, 0x9b, 0x57, 0x12, 0x9d,
+	0xe8, 0x92, 0x12, 0x17, 0x3b, 0xcb, 0x6e, 0xee, 0x70, 0x8d, 0x52, 0x23, 0xb5, 0x80, 0x3d, 0x38,
+	0xc8, 0xb7, 0xa7, 0x78, 0xc0, 0x10, 0xe2, 0x59, 0x7b, 0x00, 0x86, 0xb5, 0x63, 0xae, 0x85, 0xb2,
+	0x78, 0x78, 0xe2, 0x91, 0xea, 0x81, 0x61, 0x87, 0x42, 0x25, 0x4f, 0xcb, 0x2a, 0xf5, 0x37, 0x64,
+	0x4b, 0x0a, 0x45, 0x11, 0xc6, 0x23, 0x3a, 0x84, 0x31, 0x24, 0xcc, 0x08, 0xad, 0x1a, 0xde, 0xae,
+	0xd7, 0xba, 0xd4, 0x79, 0x74, 0x9a, 0x05, 0x95, 0xcf, 0x59, 0x70, 0x33, 0x11, 0xe6, 0x75, 0x3a,
+	0x88, 0xb8, 0x96, 0xae, 0x9e, 0x5b, 0x6e, 0xe1, 0xf0, 0x30, 0x36, 0xc7, 0x13, 0xc0, 0x68, 0x5f,
+	0x99, 0x3c, 0x0b, 0x9a, 0xc7, 0x4c, 0x8e, 0xef, 0x85, 0x7f, 0x91, 0x0c, 0xfb, 0x9b, 0x52, 0xa8,
+	0x03, 0x18, 0x8f, 0x7a, 0x3f, 0xef, 0x3e, 0x78, 0x84, 0xbc, 0x64, 0x28, 0x9d, 0x99, 0xf7, 0x1e,
+	0xb9, 0x36, 0x11, 0x4a, 0xc1, 0x90, 0x0a, 0x85, 0x86, 0x29, 0x0e, 0x94, 0x6b, 0x34, 0x74, 0x28,
+	0x90, 0xeb, 0x54, 0x19, 0xe7, 0xeb, 0xc5, 0x39, 0x7c, 0xf5, 0x80, 0xe7, 0x59, 0x70, 0xc3, 0xfa,
+	0xfa, 0xa7, 0x78, 0xd8, 0x6f, 0x5a, 0x7c, 0xdf, 0xc1, 0x5d, 0x8d, 0xa6, 0xf7, 0x1d, 0x7c, 0xb7,
+	0x46, 0x36, 0x9e, 0xcf, 0x9d, 0xd1, 0x88, 0x6c, 0x48, 0x36, 0xa7, 0x12, 0x13, 0x2c, 0x2d, 0x55,
+	0x3b, 0x5b, 0x79, 0x16, 0xd4, 0x5c, 0xf3, 0x0e, 0x09, 0xfb, 0x17, 0x25, 0x9b, 0x3f, 0xc6, 0x04,
+	0xeb, 0x27, 0x1e, 0xa9, 0x29, 0x38, 0xa2, 0x8c, 0x97, 0x62, 0x74, 0x04, 0xd0, 0x58, 0xdb, 0x5d,
+	0x6f, 0x5d, 0xbe, 0xbd, 0x13, 0xb9, 0x00, 0x8b, 0xc8, 0x22, 0x17, 0x59, 0xd4, 0xd5, 0x42, 0x75,
+	0x1e, 0x16, 0x5d, 0xe6, 0x59, 0xb0, 0x6d, 0x65, 0x7f, 0x7b, 0x1f, 0x7e, 0xfc, 0x12, 0xb4, 0xfe,
+	0xa3, 0xff, 0x42, 0x0a, 0xfb, 0x55, 0x05, 0x47, 0xf7, 0xed, 0xe3, 0x3d, 0x80, 0xfa, 0x03, 0xb2,
+	0x39, 0x9a, 0x02, 0xd0, 0x15, 0x4d, 0x6c, 0xac, 0x97, 0x8d, 0x5c, 0xcd, 0xb3, 0xa0, 0x61, 0x2b,
+	0xfe, 0x41, 0x09, 0xfb, 0xb5, 0xe2, 0xee, 0xc9, 0x0f, 0x2d, 0xec, 0x3c, 0x3b, 0x5d, 0xf8, 0xde,
+	0xd9, 0xc2, 0xf7, 0xbe, 0x2e, 0x7c, 0xef, 0xed, 0xd2, 0xaf, 0x9c, 0x2d, 0xfd, 0xca, 0xa7, 0xa5,
+	0x5f, 0x79, 0x75, 0x77, 0xc5, 0x5c, 0xb7, 0xfc, 0xdf, 0x7b, 0x3a, 0x55, 0xc3, 0x32, 0xf8, 0xd8,
+	0x4d, 0xc5, 0xfc, 0xd7, 0xb9, 0x28, 0x1d, 0x0f, 0x2e, 0x94, 0x7f, 0xf5, 0xce, 0xb7, 0x01, 0x00,
+	0xc8, 0x33, 0x2b, 0x72, 0x3b, 0x03, 0x00, 0x00,
 }
 
 func (m *StakingParams) Marshal() (dAtA []byte, err error) {
@@ -135,6 +267,86 @@ func (m *StakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WasmParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PinnedInstanceCostDiscount.Size()
+		i -= size
+		if _, err := m.PinnedInstanceCostDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TxParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FreeNewAccounts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FreeNewAccounts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewAccountFee) > 0 {
+		for iNdEx := len(m.NewAccountFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewAccountFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxMsgs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMsgs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -158,6 +370,38 @@ func (m *StakingParams) Size() (n int) {
 	return n
 }
 
+func (m *WasmParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PinnedInstanceCostDiscount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TxParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMsgs != 0 {
+		n += 1 + sovParams(uint64(m.MaxMsgs))
+	}
+	if len(m.NewAccountFee) > 0 {
+		for _, e := range m.NewAccountFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FreeNewAccounts != 0 {
+		n += 1 + sovParams(uint64(m.FreeNewAccounts))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -251,6 +495,214 @@ func (m *StakingParams) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *WasmParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedInstanceCostDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PinnedInstanceCostDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *TxParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgs", wireType)
+			}
+			m.MaxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAccountFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAccountFee = append(m.NewAccountFee, types.Coin{})
+			if err := m.NewAccountFee[len(m.NewAccountFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeNewAccounts", wireType)
+			}
+			m.FreeNewAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeNewAccounts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
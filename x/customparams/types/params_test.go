@@ -14,3 +14,36 @@ func TestStakingParams_ValidateBasic(t *testing.T) {
 	p.MinSelfDelegation = sdk.NewInt(-1)
 	require.Error(t, p.ValidateBasic())
 }
+
+func TestWasmParams_ValidateBasic(t *testing.T) {
+	p := DefaultWasmParams()
+	require.NoError(t, p.ValidateBasic())
+
+	p.PinnedInstanceCostDiscount = sdk.ZeroDec()
+	require.NoError(t, p.ValidateBasic())
+
+	p.PinnedInstanceCostDiscount = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, p.ValidateBasic())
+
+	p.PinnedInstanceCostDiscount = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestTxParams_ValidateBasic(t *testing.T) {
+	p := DefaultTxParams()
+	require.NoError(t, p.ValidateBasic())
+
+	p.MaxMsgs = 1
+	require.NoError(t, p.ValidateBasic())
+
+	p.MaxMsgs = 0
+	require.Error(t, p.ValidateBasic())
+
+	p = DefaultTxParams()
+	p.NewAccountFee = sdk.NewCoins(sdk.NewInt64Coin("ucore", 1000))
+	p.FreeNewAccounts = 0
+	require.NoError(t, p.ValidateBasic())
+
+	p.NewAccountFee = sdk.Coins{sdk.Coin{Denom: "ucore", Amount: sdk.ZeroInt()}}
+	require.Error(t, p.ValidateBasic())
+}
@@ -125,9 +125,195 @@ func (m *QueryStakingParamsResponse) GetParams() StakingParams {
 	return StakingParams{}
 }
 
+// QueryWasmParamsRequest defines the request type for querying x/customparams wasm parameters.
+type QueryWasmParamsRequest struct{}
+
+func (m *QueryWasmParamsRequest) Reset()         { *m = QueryWasmParamsRequest{} }
+func (m *QueryWasmParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmParamsRequest) ProtoMessage()    {}
+func (*QueryWasmParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{2}
+}
+
+func (m *QueryWasmParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryWasmParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryWasmParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmParamsRequest.Merge(m, src)
+}
+
+func (m *QueryWasmParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryWasmParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmParamsRequest proto.InternalMessageInfo
+
+// QueryWasmParamsResponse defines the response type for querying x/customparams wasm parameters.
+type QueryWasmParamsResponse struct {
+	Params WasmParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryWasmParamsResponse) Reset()         { *m = QueryWasmParamsResponse{} }
+func (m *QueryWasmParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWasmParamsResponse) ProtoMessage()    {}
+func (*QueryWasmParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{3}
+}
+
+func (m *QueryWasmParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryWasmParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWasmParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryWasmParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWasmParamsResponse.Merge(m, src)
+}
+
+func (m *QueryWasmParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryWasmParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWasmParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWasmParamsResponse proto.InternalMessageInfo
+
+func (m *QueryWasmParamsResponse) GetParams() WasmParams {
+	if m != nil {
+		return m.Params
+	}
+	return WasmParams{}
+}
+
+// QueryTxParamsRequest defines the request type for querying x/customparams transaction parameters.
+type QueryTxParamsRequest struct{}
+
+func (m *QueryTxParamsRequest) Reset()         { *m = QueryTxParamsRequest{} }
+func (m *QueryTxParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxParamsRequest) ProtoMessage()    {}
+func (*QueryTxParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{4}
+}
+
+func (m *QueryTxParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTxParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTxParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxParamsRequest.Merge(m, src)
+}
+
+func (m *QueryTxParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTxParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxParamsRequest proto.InternalMessageInfo
+
+// QueryTxParamsResponse defines the response type for querying x/customparams transaction parameters.
+type QueryTxParamsResponse struct {
+	Params TxParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryTxParamsResponse) Reset()         { *m = QueryTxParamsResponse{} }
+func (m *QueryTxParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxParamsResponse) ProtoMessage()    {}
+func (*QueryTxParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da080998585ae5b1, []int{5}
+}
+
+func (m *QueryTxParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTxParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTxParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxParamsResponse.Merge(m, src)
+}
+
+func (m *QueryTxParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTxParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxParamsResponse proto.InternalMessageInfo
+
+func (m *QueryTxParamsResponse) GetParams() TxParams {
+	if m != nil {
+		return m.Params
+	}
+	return TxParams{}
+}
+
 func init() {
 	proto.RegisterType((*QueryStakingParamsRequest)(nil), "coreum.customparams.v1.QueryStakingParamsRequest")
 	proto.RegisterType((*QueryStakingParamsResponse)(nil), "coreum.customparams.v1.QueryStakingParamsResponse")
+	proto.RegisterType((*QueryWasmParamsRequest)(nil), "coreum.customparams.v1.QueryWasmParamsRequest")
+	proto.RegisterType((*QueryWasmParamsResponse)(nil), "coreum.customparams.v1.QueryWasmParamsResponse")
+	proto.RegisterType((*QueryTxParamsRequest)(nil), "coreum.customparams.v1.QueryTxParamsRequest")
+	proto.RegisterType((*QueryTxParamsResponse)(nil), "coreum.customparams.v1.QueryTxParamsResponse")
 }
 
 func init() {
@@ -135,27 +321,35 @@ func init() {
 }
 
 var fileDescriptor_da080998585ae5b1 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x45, 0x63, 0x04, 0x1d, 0x8c, 0x58, 0x22, 0x84, 0x20, 0x20, 0x83, 0x82, 0x2a, 0x58, 0xb0,
-	0x95, 0x32, 0xb0, 0xb7, 0x12, 0x33, 0x2d, 0x1b, 0x9b, 0x13, 0x2c, 0x13, 0x41, 0xfc, 0xd2, 0xd8,
-	0xae, 0xe8, 0xca, 0x17, 0x20, 0xf1, 0x0d, 0xcc, 0xfc, 0x46, 0xc7, 0x4a, 0x2c, 0x4c, 0x08, 0xb5,
-	0x7c, 0x08, 0xaa, 0x1d, 0x24, 0x2a, 0x35, 0x03, 0x5b, 0x94, 0x7b, 0xee, 0x7d, 0xf7, 0xf9, 0xe1,
-	0x38, 0x83, 0x4a, 0xd8, 0x82, 0x65, 0x56, 0x1b, 0x28, 0x4a, 0x5e, 0xf1, 0x42, 0xb3, 0x51, 0xc2,
-	0x86, 0x56, 0x54, 0x63, 0x5a, 0x56, 0x60, 0x20, 0xdc, 0xf1, 0x0c, 0xfd, 0xcb, 0xd0, 0x51, 0x12,
-	0x6d, 0x4b, 0x90, 0xe0, 0x10, 0xb6, 0xf8, 0xf2, 0x74, 0x74, 0x20, 0x01, 0xe4, 0x83, 0x60, 0xbc,
-	0xcc, 0x19, 0x57, 0x0a, 0x0c, 0x37, 0x39, 0x28, 0x5d, 0xab, 0x24, 0x03, 0x5d, 0x80, 0x66, 0x29,
-	0xd7, 0x82, 0x8d, 0x92, 0x54, 0x18, 0x9e, 0xb0, 0x0c, 0x72, 0x55, 0xeb, 0xc7, 0x0d, 0x7d, 0xea,
-	0xa9, 0x0e, 0x8a, 0xf7, 0xf1, 0x5e, 0x7f, 0xd1, 0xef, 0xda, 0xf0, 0xfb, 0x5c, 0xc9, 0x2b, 0xa7,
-	0x0d, 0xc4, 0xd0, 0x0a, 0x6d, 0x62, 0x8e, 0xa3, 0x55, 0xa2, 0x2e, 0x41, 0x69, 0x11, 0xf6, 0x70,
-	0xcb, 0x47, 0xed, 0xa2, 0x23, 0x74, 0xba, 0xd9, 0x69, 0xd3, 0xd5, 0xcb, 0xd1, 0x25, 0x7b, 0x77,
-	0x7d, 0xf2, 0x79, 0x18, 0x0c, 0x6a, 0x6b, 0xe7, 0x0d, 0xe1, 0x0d, 0x37, 0x23, 0x7c, 0x45, 0x78,
-	0x6b, 0x89, 0x0c, 0x93, 0xa6, 0xc0, 0xc6, 0xc6, 0x51, 0xe7, 0x3f, 0x16, 0xbf, 0x47, 0x7c, 0xf6,
-	0xf4, 0xfe, 0xfd, 0xb2, 0x76, 0x12, 0xb6, 0x59, 0xc3, 0x83, 0x69, 0x6f, 0xf3, 0x3f, 0xba, 0xfd,
-	0xc9, 0x8c, 0xa0, 0xe9, 0x8c, 0xa0, 0xaf, 0x19, 0x41, 0xcf, 0x73, 0x12, 0x4c, 0xe7, 0x24, 0xf8,
-	0x98, 0x93, 0xe0, 0xe6, 0x42, 0xe6, 0xe6, 0xce, 0xa6, 0x34, 0x83, 0x82, 0xf5, 0x5c, 0xd4, 0x25,
-	0x58, 0x75, 0xeb, 0x8e, 0xf6, 0x9b, 0xfd, 0xb8, 0x9c, 0x6e, 0xc6, 0xa5, 0xd0, 0x69, 0xcb, 0xdd,
-	0xe2, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x9e, 0x2a, 0xe8, 0x42, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
+	0x14, 0x85, 0x63, 0x7e, 0x46, 0xc8, 0x88, 0x8d, 0x35, 0x94, 0x21, 0xa0, 0x50, 0x19, 0x46, 0x8c,
+	0x10, 0x13, 0x2b, 0x65, 0xc1, 0x0e, 0xa1, 0x19, 0x89, 0x35, 0x33, 0x54, 0xaa, 0x04, 0x2b, 0x27,
+	0x58, 0x21, 0x82, 0xe4, 0xa6, 0xb1, 0x53, 0xd2, 0x2d, 0x4f, 0x00, 0x62, 0xc5, 0x03, 0xf0, 0x2e,
+	0x5d, 0x56, 0x62, 0xc3, 0x0a, 0x50, 0xcb, 0x83, 0xa0, 0x3a, 0xae, 0x4a, 0xd2, 0xba, 0xea, 0xec,
+	0x1c, 0xdf, 0x73, 0xcf, 0x77, 0xec, 0x1b, 0x63, 0x1a, 0x41, 0x21, 0xca, 0x94, 0x45, 0xa5, 0x54,
+	0x90, 0xe6, 0xbc, 0xe0, 0xa9, 0x64, 0xa3, 0x80, 0x0d, 0x4b, 0x51, 0x8c, 0xfd, 0xbc, 0x00, 0x05,
+	0xa4, 0x53, 0x6b, 0xfc, 0xff, 0x35, 0xfe, 0x28, 0x70, 0xf7, 0x63, 0x88, 0x41, 0x4b, 0xd8, 0x62,
+	0x55, 0xab, 0xdd, 0xbb, 0x31, 0x40, 0xfc, 0x41, 0x30, 0x9e, 0x27, 0x8c, 0x67, 0x19, 0x28, 0xae,
+	0x12, 0xc8, 0xa4, 0xa9, 0x7a, 0x11, 0xc8, 0x14, 0x24, 0x0b, 0xb9, 0x14, 0x6c, 0x14, 0x84, 0x42,
+	0xf1, 0x80, 0x45, 0x90, 0x64, 0xa6, 0x7e, 0xdf, 0x92, 0xc7, 0x50, 0xb5, 0x88, 0xde, 0xc1, 0xb7,
+	0xcf, 0x16, 0xf9, 0x5e, 0x29, 0xfe, 0x3e, 0xc9, 0xe2, 0x97, 0xba, 0x76, 0x2e, 0x86, 0xa5, 0x90,
+	0x8a, 0x72, 0xec, 0x6e, 0x2a, 0xca, 0x1c, 0x32, 0x29, 0xc8, 0x29, 0xde, 0xab, 0xad, 0x0e, 0x50,
+	0x17, 0x1d, 0x5d, 0xef, 0x1d, 0xfa, 0x9b, 0x0f, 0xe7, 0x37, 0xda, 0x4f, 0xae, 0x4c, 0x7e, 0xdd,
+	0x73, 0xce, 0x4d, 0x2b, 0x3d, 0xc0, 0x1d, 0x8d, 0x18, 0x70, 0x99, 0x36, 0xe1, 0x6f, 0xf0, 0xad,
+	0xb5, 0x8a, 0x21, 0x3f, 0x6f, 0x91, 0xa9, 0x8d, 0xbc, 0xea, 0x6d, 0x61, 0x3b, 0x78, 0x5f, 0x9b,
+	0xf7, 0xab, 0x26, 0x74, 0x80, 0x6f, 0xb6, 0xf6, 0x0d, 0xf2, 0x59, 0x0b, 0xd9, 0xb5, 0x21, 0xfb,
+	0xd5, 0x26, 0x60, 0xef, 0xf7, 0x65, 0x7c, 0x55, 0x3b, 0x93, 0xef, 0x08, 0xdf, 0x68, 0xdc, 0x08,
+	0x09, 0x6c, 0x5e, 0xd6, 0xc9, 0xb8, 0xbd, 0x8b, 0xb4, 0xd4, 0x47, 0xa0, 0xc7, 0x9f, 0x7e, 0xfc,
+	0xfd, 0x7a, 0xe9, 0x21, 0x39, 0x64, 0x96, 0x1f, 0x43, 0xd6, 0x6d, 0xf5, 0x06, 0xf9, 0x86, 0x30,
+	0x5e, 0xdd, 0x1f, 0xf1, 0xb7, 0x12, 0xd7, 0xc6, 0xe7, 0xb2, 0x9d, 0xf5, 0x26, 0xde, 0x23, 0x1d,
+	0xef, 0x01, 0xa1, 0xb6, 0x78, 0x1f, 0xb9, 0x34, 0x5f, 0xe4, 0x0b, 0xc2, 0xd7, 0x96, 0x17, 0x4d,
+	0x1e, 0x6f, 0x25, 0xb5, 0x26, 0xec, 0x1e, 0xef, 0xa8, 0x36, 0xa9, 0x8e, 0x74, 0x2a, 0x4a, 0xba,
+	0xb6, 0x54, 0xaa, 0xaa, 0xd7, 0x27, 0x67, 0x93, 0x99, 0x87, 0xa6, 0x33, 0x0f, 0xfd, 0x99, 0x79,
+	0xe8, 0xf3, 0xdc, 0x73, 0xa6, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c, 0xd7, 0x4f, 0xe3, 0x44, 0xbd,
+	0x2b, 0x43, 0x3f, 0x82, 0x94, 0x9d, 0x6a, 0x97, 0x17, 0x50, 0x66, 0x6f, 0xf5, 0x63, 0x5e, 0xda,
+	0x56, 0x4d, 0x63, 0x35, 0xce, 0x85, 0x0c, 0xf7, 0xf4, 0x1b, 0x7d, 0xf2, 0x6f, 0x00, 0x62, 0x7a,
+	0xe5, 0x01, 0x5a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +366,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// StakingParams queries the staking parameters of the module.
 	StakingParams(ctx context.Context, in *QueryStakingParamsRequest, opts ...grpc.CallOption) (*QueryStakingParamsResponse, error)
+	// WasmParams queries the wasm parameters of the module.
+	WasmParams(ctx context.Context, in *QueryWasmParamsRequest, opts ...grpc.CallOption) (*QueryWasmParamsResponse, error)
+	// TxParams queries the transaction parameters of the module.
+	TxParams(ctx context.Context, in *QueryTxParamsRequest, opts ...grpc.CallOption) (*QueryTxParamsResponse, error)
 }
 
 type queryClient struct {
@@ -191,10 +389,32 @@ func (c *queryClient) StakingParams(ctx context.Context, in *QueryStakingParamsR
 	return out, nil
 }
 
+func (c *queryClient) WasmParams(ctx context.Context, in *QueryWasmParamsRequest, opts ...grpc.CallOption) (*QueryWasmParamsResponse, error) {
+	out := new(QueryWasmParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/WasmParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxParams(ctx context.Context, in *QueryTxParamsRequest, opts ...grpc.CallOption) (*QueryTxParamsResponse, error) {
+	out := new(QueryTxParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.customparams.v1.Query/TxParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// StakingParams queries the staking parameters of the module.
 	StakingParams(context.Context, *QueryStakingParamsRequest) (*QueryStakingParamsResponse, error)
+	// WasmParams queries the wasm parameters of the module.
+	WasmParams(context.Context, *QueryWasmParamsRequest) (*QueryWasmParamsResponse, error)
+	// TxParams queries the transaction parameters of the module.
+	TxParams(context.Context, *QueryTxParamsRequest) (*QueryTxParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -204,6 +424,14 @@ func (*UnimplementedQueryServer) StakingParams(ctx context.Context, req *QuerySt
 	return nil, status.Errorf(codes.Unimplemented, "method StakingParams not implemented")
 }
 
+func (*UnimplementedQueryServer) WasmParams(ctx context.Context, req *QueryWasmParamsRequest) (*QueryWasmParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmParams not implemented")
+}
+
+func (*UnimplementedQueryServer) TxParams(ctx context.Context, req *QueryTxParamsRequest) (*QueryTxParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxParams not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
@@ -226,6 +454,42 @@ func _Query_StakingParams_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WasmParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWasmParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/WasmParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmParams(ctx, req.(*QueryWasmParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.customparams.v1.Query/TxParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxParams(ctx, req.(*QueryTxParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "coreum.customparams.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -234,6 +498,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StakingParams",
 			Handler:    _Query_StakingParams_Handler,
 		},
+		{
+			MethodName: "WasmParams",
+			Handler:    _Query_WasmParams_Handler,
+		},
+		{
+			MethodName: "TxParams",
+			Handler:    _Query_TxParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/customparams/v1/query.proto",
@@ -295,55 +567,207 @@ func (m *QueryStakingParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryWasmParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *QueryStakingParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWasmParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWasmParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWasmParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWasmParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWasmParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStakingParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTxParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTxParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryTxParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStakingParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+func (m *QueryTxParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryStakingParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWasmParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWasmParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTxParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTxParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryStakingParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -470,6 +894,276 @@ func (m *QueryStakingParamsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *QueryWasmParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWasmParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWasmParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryWasmParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWasmParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWasmParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTxParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTxParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTxParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTxParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+// GetQueryCmd returns the cli query commands for the deterministic gas.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "deterministic-gas",
+		Short:                      "Querying commands for the deterministic gas",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryConfig(),
+	)
+	return cmd
+}
+
+// CmdQueryConfig return the QueryConfig cobra command.
+func CmdQueryConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Args:  cobra.NoArgs,
+		Short: "Query the deterministic gas of the messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deterministic gas of the message types and the gas charged on each transaction, including
+the number of the transaction bytes and signatures it covers.
+
+Example:
+$ %[1]s query deterministic-gas config
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Config(cmd.Context(), &types.QueryConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -102,13 +102,30 @@ func (s *deterministicMsgServer) RegisterService(sd *googlegrpc.ServiceDesc, han
 
 func ctxForDeterministicGas(ctx sdk.Context, msg sdk.Msg, deterministicGasRequirements config.DeterministicGasRequirements) sdk.Context {
 	gasRequired, exists := deterministicGasRequirements.GasRequiredByMessage(msg)
-	if exists {
-		// Fixed gas is consumed on original gas meter to require and report deterministic gas amount
-		ctx.GasMeter().ConsumeGas(gasRequired, fmt.Sprintf("DeterministicGas (gas required: %d, message type: %T)", gasRequired, msg))
+	if !exists {
+		// The meter stored by the enclosing deterministic message must not leak to the nested nondeterministic one.
+		return ctx.WithValue(originalGasMeterKey{}, nil)
+	}
+
+	// Fixed gas is consumed on original gas meter to require and report deterministic gas amount
+	ctx.GasMeter().ConsumeGas(gasRequired, fmt.Sprintf("DeterministicGas (gas required: %d, message type: %T)", gasRequired, msg))
+
+	// We pass much higher amount of gas to handler to be sure that it succeeds.
+	// We want to avoid passing infinite gas meter to always have a limit in case of mistake.
+	return ctx.WithValue(originalGasMeterKey{}, ctx.GasMeter()).
+		WithGasMeter(sdk.NewGasMeter(gasMultiplier * gasRequired))
+}
+
+type originalGasMeterKey struct{}
 
-		// We pass much higher amount of gas to handler to be sure that it succeeds.
-		// We want to avoid passing infinite gas meter to always have a limit in case of mistake.
-		ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasMultiplier * gasRequired))
+// WithOriginalGasMeter returns the context using the gas meter the deterministic gas of the message has been charged on.
+// It is used to charge the real gas of the nondeterministic actions triggered by the deterministic message, like the
+// contract calls, on top of the deterministic gas. The context is returned unchanged if the message being handled
+// doesn't use the deterministic gas.
+func WithOriginalGasMeter(ctx sdk.Context) sdk.Context {
+	gasMeter, ok := ctx.Value(originalGasMeterKey{}).(sdk.GasMeter)
+	if !ok {
+		return ctx
 	}
-	return ctx
+	return ctx.WithGasMeter(gasMeter)
 }
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"context"
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+)
+
+var _ QueryServer = QueryService{}
+
+// QueryService serves grpc query requests for the deterministic gas.
+type QueryService struct {
+	response QueryConfigResponse
+}
+
+// NewQueryService initiates the new instance of query service reporting the deterministic gas of the messages
+// registered in the interface registry.
+func NewQueryService(
+	interfaceRegistry codectypes.InterfaceRegistry,
+	deterministicGasRequirements config.DeterministicGasRequirements,
+) QueryService {
+	// The messages are resolved to their zero values, so the messages containing the list of entries report the gas
+	// of a single entry.
+	messages := []MessageGas{}
+	for _, msgType := range interfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		msg, err := interfaceRegistry.Resolve(msgType)
+		if err != nil {
+			panic(err)
+		}
+		sdkMsg, ok := msg.(sdk.Msg)
+		if !ok {
+			continue
+		}
+		gas, exists := deterministicGasRequirements.GasRequiredByMessage(sdkMsg)
+		if !exists {
+			continue
+		}
+		messages = append(messages, MessageGas{
+			MsgType: msgType,
+			Gas:     gas,
+		})
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].MsgType < messages[j].MsgType
+	})
+
+	features := []FeatureGas{}
+	for feature, gas := range deterministicGasRequirements.AssetFTSendFeatureGas() {
+		features = append(features, FeatureGas{
+			Feature: feature.String(),
+			Gas:     gas,
+		})
+	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Feature < features[j].Feature
+	})
+
+	return QueryService{
+		response: QueryConfigResponse{
+			FixedGas:            deterministicGasRequirements.FixedGas,
+			FreeBytes:           deterministicGasRequirements.FreeBytes,
+			FreeSignatures:      deterministicGasRequirements.FreeSignatures,
+			Messages:            messages,
+			AssetFtSendFeatures: features,
+		},
+	}
+}
+
+// Config returns the deterministic gas of the messages and the gas charged on each transaction.
+func (qs QueryService) Config(ctx context.Context, req *QueryConfigRequest) (*QueryConfigResponse, error) {
+	response := qs.response
+	return &response, nil
+}
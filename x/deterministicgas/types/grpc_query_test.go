@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/pkg/config"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/x/deterministicgas/types"
+)
+
+func TestQueryService_Config(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	deterministicGas := config.DefaultDeterministicGasRequirements()
+	queryService := types.NewQueryService(testApp.InterfaceRegistry(), deterministicGas)
+
+	res, err := queryService.Config(context.Background(), &types.QueryConfigRequest{})
+	requireT.NoError(err)
+	requireT.Equal(deterministicGas.FixedGas, res.FixedGas)
+	requireT.Equal(deterministicGas.FreeBytes, res.FreeBytes)
+	requireT.Equal(deterministicGas.FreeSignatures, res.FreeSignatures)
+
+	messages := map[string]uint64{}
+	for i, msg := range res.Messages {
+		if i > 0 {
+			requireT.Less(res.Messages[i-1].MsgType, msg.MsgType)
+		}
+		messages[msg.MsgType] = msg.Gas
+	}
+	requireT.Equal(deterministicGas.AssetFTIssue, messages[sdk.MsgTypeURL(&assetfttypes.MsgIssue{})])
+	requireT.Equal(deterministicGas.BankSendPerEntry, messages[sdk.MsgTypeURL(&banktypes.MsgSend{})])
+	requireT.Equal(deterministicGas.AssetFTBatchFreezePerEntry, messages[sdk.MsgTypeURL(&assetfttypes.MsgBatchFreeze{})])
+	// the messages without the deterministic gas are not reported
+	requireT.NotContains(messages, sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}))
+
+	features := map[string]uint64{}
+	for _, feature := range res.AssetFtSendFeatures {
+		features[feature.Feature] = feature.Gas
+	}
+	requireT.Equal(map[string]uint64{
+		assetfttypes.TokenFeature_freeze.String():       deterministicGas.AssetFTSendFreezeFeature,      //nolint:nosnakecase
+		assetfttypes.TokenFeature_whitelist.String():    deterministicGas.AssetFTSendWhitelistFeature,   //nolint:nosnakecase
+		assetfttypes.TokenFeature_receive_hook.String(): deterministicGas.AssetFTSendReceiveHookFeature, //nolint:nosnakecase
+	}, features)
+}
@@ -0,0 +1,28 @@
+package expedited
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/expedited/keeper"
+	"github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+// InitGenesis initializes the expedited module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Params.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+
+	for _, proposalID := range genState.ProposalIDs {
+		k.SetExpedited(ctx, proposalID)
+	}
+}
+
+// ExportGenesis returns the expedited module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:      k.GetParams(ctx),
+		ProposalIDs: k.GetExpeditedProposals(ctx),
+	}
+}
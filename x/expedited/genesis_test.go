@@ -0,0 +1,40 @@
+package expedited_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/expedited"
+	"github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+func TestImportAndExportGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := types.DefaultParams()
+	params.VotingPeriod = time.Hour
+	params.ProposalTypes = []string{"/cosmos.gov.v1beta1.TextProposal"}
+	genState := types.GenesisState{
+		Params:      params,
+		ProposalIDs: []uint64{3, 10},
+	}
+	requireT.NoError(genState.Validate())
+
+	expedited.InitGenesis(ctx, testApp.ExpeditedKeeper, genState)
+	requireT.True(testApp.ExpeditedKeeper.IsExpedited(ctx, 3))
+	requireT.False(testApp.ExpeditedKeeper.IsExpedited(ctx, 4))
+
+	exportedGenState := expedited.ExportGenesis(ctx, testApp.ExpeditedKeeper)
+	requireT.Equal(genState, *exportedGenState)
+
+	// the same proposal can't be listed twice
+	genState.ProposalIDs = []uint64{3, 3}
+	requireT.Error(genState.Validate())
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetExpeditedProposals(ctx sdk.Context) []uint64
+}
+
+// QueryService serves grpc query requests for the expedited module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params queries the parameters of the expedited module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// Proposals queries the ids of the proposals being voted on the expedited track.
+func (qs QueryService) Proposals(ctx context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryProposalsResponse{
+		ProposalIDs: qs.keeper.GetExpeditedProposals(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
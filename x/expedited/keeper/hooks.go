@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks implements the hooks of the gov keeper.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the hooks to be registered in the gov keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k: k}
+}
+
+// AfterProposalSubmission implements the GovHooks interface.
+func (h Hooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {}
+
+// AfterProposalDeposit expedites the proposal if the deposit has activated its voting period.
+func (h Hooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+	h.k.expedite(ctx, proposalID)
+}
+
+// AfterProposalVote implements the GovHooks interface.
+func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {}
+
+// AfterProposalFailedMinDeposit implements the GovHooks interface.
+func (h Hooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {}
+
+// AfterProposalVotingPeriodEnded implements the GovHooks interface.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+var expeditedProposalStoreVal = []byte{0x01}
+
+// ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
+type ParamSubspace interface {
+	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
+
+// Keeper is the expedited module keeper.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	paramSubspace ParamSubspace
+	govKeeper     types.GovKeeper
+	stakingKeeper types.StakingKeeper
+}
+
+// NewKeeper creates a new instance of the Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSubspace ParamSubspace,
+	govKeeper types.GovKeeper,
+	stakingKeeper types.StakingKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramSubspace,
+		govKeeper:     govKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// IsExpedited returns true if the proposal is being voted on the expedited track.
+func (k Keeper) IsExpedited(ctx sdk.Context, proposalID uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetProposalKey(proposalID))
+}
+
+// SetExpedited marks the proposal as being voted on the expedited track.
+func (k Keeper) SetExpedited(ctx sdk.Context, proposalID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetProposalKey(proposalID), expeditedProposalStoreVal)
+}
+
+// GetExpeditedProposals returns the ids of the proposals being voted on the expedited track.
+func (k Keeper) GetExpeditedProposals(ctx sdk.Context) []uint64 {
+	proposalIDs := []uint64{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return proposalIDs
+}
+
+// EndBlocker tallies the expedited proposals whose voting period ends in the block. It must be executed before
+// the end blocker of the gov module. The proposal reaching the expedited threshold is left to the gov module
+// to be executed, the one not reaching it is converted to the regular proposal and its voting continues until
+// the end of the regular voting period.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	var proposals []govtypes.Proposal
+	k.govKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal govtypes.Proposal) bool {
+		if k.IsExpedited(ctx, proposal.ProposalId) {
+			proposals = append(proposals, proposal)
+		}
+		return false
+	})
+
+	params := k.GetParams(ctx)
+	for _, proposal := range proposals {
+		k.deleteExpedited(ctx, proposal.ProposalId)
+		if k.tally(ctx, proposal, params.Threshold) {
+			continue
+		}
+
+		votingEndTime := proposal.VotingStartTime.Add(k.govKeeper.GetVotingParams(ctx).VotingPeriod)
+		// the regular voting period might be shortened by the governance in the meantime
+		if !votingEndTime.After(ctx.BlockHeader().Time) {
+			continue
+		}
+		k.setVotingEndTime(ctx, proposal, votingEndTime)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventProposalConverted{
+			ProposalID:    proposal.ProposalId,
+			VotingEndTime: votingEndTime,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// expedite shortens the voting period of the proposal which has just entered the voting period if its content
+// is of the expedited type.
+func (k Keeper) expedite(ctx sdk.Context, proposalID uint64) {
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found || proposal.Status != govtypes.StatusVotingPeriod ||
+		!proposal.VotingStartTime.Equal(ctx.BlockHeader().Time) || k.IsExpedited(ctx, proposalID) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if proposal.Content == nil || !params.IsExpedited(proposal.Content.TypeUrl) {
+		return
+	}
+
+	votingEndTime := proposal.VotingStartTime.Add(params.VotingPeriod)
+	if !votingEndTime.Before(proposal.VotingEndTime) {
+		return
+	}
+	k.setVotingEndTime(ctx, proposal, votingEndTime)
+	k.SetExpedited(ctx, proposalID)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventProposalExpedited{
+		ProposalID:    proposalID,
+		VotingEndTime: votingEndTime,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) setVotingEndTime(ctx sdk.Context, proposal govtypes.Proposal, votingEndTime time.Time) {
+	k.govKeeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	proposal.VotingEndTime = votingEndTime
+	k.govKeeper.SetProposal(ctx, proposal)
+	k.govKeeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, votingEndTime)
+}
+
+func (k Keeper) deleteExpedited(ctx sdk.Context, proposalID uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetProposalKey(proposalID))
+}
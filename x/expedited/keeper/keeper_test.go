@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/event"
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/expedited/keeper"
+	"github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+type stakingKeeperMock struct {
+	validators []stakingtypes.Validator
+}
+
+func (m stakingKeeperMock) IterateBondedValidatorsByPower(
+	_ sdk.Context,
+	fn func(index int64, validator stakingtypes.ValidatorI) (stop bool),
+) {
+	for i, validator := range m.validators {
+		if fn(int64(i), validator) {
+			return
+		}
+	}
+}
+
+func (m stakingKeeperMock) IterateDelegations(
+	_ sdk.Context,
+	_ sdk.AccAddress,
+	_ func(index int64, delegation stakingtypes.DelegationI) (stop bool),
+) {
+}
+
+func (m stakingKeeperMock) TotalBondedTokens(_ sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, validator := range m.validators {
+		total = total.Add(validator.Tokens)
+	}
+	return total
+}
+
+func newValidator(t *testing.T, tokens int64) stakingtypes.Validator {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewInt(tokens)
+	validator.DelegatorShares = sdk.NewDec(tokens)
+	return validator
+}
+
+func TestKeeper_ExpeditedProposals(t *testing.T) {
+	const expeditedVotingPeriod = time.Hour
+
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+
+	validator1 := newValidator(t, 60)
+	validator2 := newValidator(t, 40)
+	expeditedKeeper := keeper.NewKeeper(
+		testApp.AppCodec(),
+		testApp.GetKey(types.StoreKey),
+		testApp.GetSubspace(types.ModuleName),
+		&testApp.GovKeeper,
+		stakingKeeperMock{validators: []stakingtypes.Validator{validator1, validator2}},
+	)
+
+	params := types.DefaultParams()
+	params.VotingPeriod = expeditedVotingPeriod
+	params.ProposalTypes = []string{"/" + proto.MessageName(&govtypes.TextProposal{})}
+	expeditedKeeper.SetParams(ctx, params)
+
+	regularVotingPeriod := testApp.GovKeeper.GetVotingParams(ctx).VotingPeriod
+	requireT.Greater(regularVotingPeriod, expeditedVotingPeriod)
+
+	minDeposit := testApp.GovKeeper.GetDepositParams(ctx).MinDeposit
+	proposer, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, proposer, minDeposit.Add(minDeposit...).Add(minDeposit...)))
+
+	submit := func(ctx sdk.Context) govtypes.Proposal {
+		proposal, err := testApp.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+		requireT.NoError(err)
+		activated, err := testApp.GovKeeper.AddDeposit(ctx, proposal.ProposalId, proposer, minDeposit)
+		requireT.NoError(err)
+		requireT.True(activated)
+		proposal, found := testApp.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+		requireT.True(found)
+		return proposal
+	}
+	vote := func(ctx sdk.Context, proposalID uint64, validator stakingtypes.Validator, option govtypes.VoteOption) {
+		voter := sdk.AccAddress(validator.GetOperator())
+		requireT.NoError(testApp.GovKeeper.AddVote(ctx, proposalID, voter, govtypes.NewNonSplitVoteOption(option)))
+	}
+
+	// the proposal of the expedited type is expedited once the deposit activates its voting period
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	passingProposal := submit(ctx)
+	requireT.Equal(startTime.Add(expeditedVotingPeriod), passingProposal.VotingEndTime)
+	requireT.True(expeditedKeeper.IsExpedited(ctx, passingProposal.ProposalId))
+	expeditedEvents, err := event.FindTypedEvents[*types.EventProposalExpedited](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventProposalExpedited{{
+		ProposalID:    passingProposal.ProposalId,
+		VotingEndTime: startTime.Add(expeditedVotingPeriod),
+	}}, expeditedEvents)
+
+	convertedProposal := submit(ctx)
+	requireT.True(expeditedKeeper.IsExpedited(ctx, convertedProposal.ProposalId))
+
+	// the proposals of other types are voted on the regular track
+	params.ProposalTypes = nil
+	expeditedKeeper.SetParams(ctx, params)
+	regularProposal := submit(ctx)
+	requireT.Equal(startTime.Add(regularVotingPeriod), regularProposal.VotingEndTime)
+	requireT.False(expeditedKeeper.IsExpedited(ctx, regularProposal.ProposalId))
+	requireT.Equal(
+		[]uint64{passingProposal.ProposalId, convertedProposal.ProposalId},
+		expeditedKeeper.GetExpeditedProposals(ctx),
+	)
+
+	// the first proposal reaches the expedited threshold, the second one doesn't
+	vote(ctx, passingProposal.ProposalId, validator1, govtypes.OptionYes)
+	vote(ctx, passingProposal.ProposalId, validator2, govtypes.OptionYes)
+	vote(ctx, convertedProposal.ProposalId, validator1, govtypes.OptionYes)
+	vote(ctx, convertedProposal.ProposalId, validator2, govtypes.OptionNo)
+
+	// nothing happens before the end of the expedited voting period
+	expeditedKeeper.EndBlocker(ctx.WithBlockTime(startTime.Add(expeditedVotingPeriod - time.Second)))
+	requireT.Len(expeditedKeeper.GetExpeditedProposals(ctx), 2)
+
+	ctx = ctx.WithBlockTime(startTime.Add(expeditedVotingPeriod)).WithEventManager(sdk.NewEventManager())
+	expeditedKeeper.EndBlocker(ctx)
+	requireT.Empty(expeditedKeeper.GetExpeditedProposals(ctx))
+
+	// the passing proposal is left to be executed by the gov module
+	proposal, found := testApp.GovKeeper.GetProposal(ctx, passingProposal.ProposalId)
+	requireT.True(found)
+	requireT.Equal(startTime.Add(expeditedVotingPeriod), proposal.VotingEndTime)
+
+	// the other one is converted to the regular proposal keeping its votes
+	proposal, found = testApp.GovKeeper.GetProposal(ctx, convertedProposal.ProposalId)
+	requireT.True(found)
+	requireT.Equal(govtypes.StatusVotingPeriod, proposal.Status)
+	requireT.Equal(startTime.Add(regularVotingPeriod), proposal.VotingEndTime)
+	requireT.Len(testApp.GovKeeper.GetVotes(ctx, convertedProposal.ProposalId), 2)
+	convertedEvents, err := event.FindTypedEvents[*types.EventProposalConverted](ctx.EventManager().ABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventProposalConverted{{
+		ProposalID:    convertedProposal.ProposalId,
+		VotingEndTime: startTime.Add(regularVotingPeriod),
+	}}, convertedEvents)
+
+	var endingProposals []uint64
+	testApp.GovKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockTime(), func(proposal govtypes.Proposal) bool {
+		endingProposals = append(endingProposals, proposal.ProposalId)
+		return false
+	})
+	requireT.Equal([]uint64{passingProposal.ProposalId}, endingProposals)
+}
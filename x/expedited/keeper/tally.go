@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// tally returns true if the proposal passes with the provided threshold. The logic mirrors the tally of the gov module,
+// but the votes are not deleted, so the voting might continue if the proposal is converted to the regular one.
+func (k Keeper) tally(ctx sdk.Context, proposal govtypes.Proposal, threshold sdk.Dec) bool {
+	results := map[govtypes.VoteOption]sdk.Dec{
+		govtypes.OptionYes:        sdk.ZeroDec(),
+		govtypes.OptionAbstain:    sdk.ZeroDec(),
+		govtypes.OptionNo:         sdk.ZeroDec(),
+		govtypes.OptionNoWithVeto: sdk.ZeroDec(),
+	}
+
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := make(map[string]govtypes.ValidatorGovInfo)
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		currValidators[validator.GetOperator().String()] = govtypes.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			govtypes.WeightedVoteOptions{},
+		)
+		return false
+	})
+
+	k.govKeeper.IterateVotes(ctx, proposal.ProposalId, func(vote govtypes.Vote) bool {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		valAddrStr := sdk.ValAddress(voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		k.stakingKeeper.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) bool {
+			valAddrStr := delegation.GetValidatorAddr().String()
+			val, ok := currValidators[valAddrStr]
+			if !ok {
+				return false
+			}
+
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			currValidators[valAddrStr] = val
+
+			votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			for _, option := range vote.Options {
+				results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+
+			return false
+		})
+
+		return false
+	})
+
+	for _, val := range currValidators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		votingPower := val.DelegatorShares.Sub(val.DelegatorDeductions).MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	totalBondedTokens := k.stakingKeeper.TotalBondedTokens(ctx)
+	if totalBondedTokens.IsZero() {
+		return false
+	}
+
+	tallyParams := k.govKeeper.GetTallyParams(ctx)
+	if totalVotingPower.Quo(totalBondedTokens.ToDec()).LT(tallyParams.Quorum) {
+		return false
+	}
+
+	nonAbstainingVotingPower := totalVotingPower.Sub(results[govtypes.OptionAbstain])
+	if nonAbstainingVotingPower.IsZero() {
+		return false
+	}
+
+	if results[govtypes.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
+		return false
+	}
+
+	return results[govtypes.OptionYes].Quo(nonAbstainingVotingPower).GT(threshold)
+}
@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/expedited/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventProposalExpedited is emitted when the voting period of the proposal is shortened to the expedited one.
+type EventProposalExpedited struct {
+	ProposalID    uint64    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	VotingEndTime time.Time `protobuf:"bytes,2,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+}
+
+func (m *EventProposalExpedited) Reset()         { *m = EventProposalExpedited{} }
+func (m *EventProposalExpedited) String() string { return proto.CompactTextString(m) }
+func (*EventProposalExpedited) ProtoMessage()    {}
+func (*EventProposalExpedited) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bfb0d1d6841620, []int{0}
+}
+
+func (m *EventProposalExpedited) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventProposalExpedited) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalExpedited.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventProposalExpedited) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalExpedited.Merge(m, src)
+}
+
+func (m *EventProposalExpedited) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventProposalExpedited) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalExpedited.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalExpedited proto.InternalMessageInfo
+
+func (m *EventProposalExpedited) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+func (m *EventProposalExpedited) GetVotingEndTime() time.Time {
+	if m != nil {
+		return m.VotingEndTime
+	}
+	return time.Time{}
+}
+
+// EventProposalConverted is emitted when the expedited proposal doesn't reach the expedited threshold and is converted
+// to the regular one.
+type EventProposalConverted struct {
+	ProposalID    uint64    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	VotingEndTime time.Time `protobuf:"bytes,2,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+}
+
+func (m *EventProposalConverted) Reset()         { *m = EventProposalConverted{} }
+func (m *EventProposalConverted) String() string { return proto.CompactTextString(m) }
+func (*EventProposalConverted) ProtoMessage()    {}
+func (*EventProposalConverted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bfb0d1d6841620, []int{1}
+}
+
+func (m *EventProposalConverted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventProposalConverted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalConverted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventProposalConverted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalConverted.Merge(m, src)
+}
+
+func (m *EventProposalConverted) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventProposalConverted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalConverted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalConverted proto.InternalMessageInfo
+
+func (m *EventProposalConverted) GetProposalID() uint64 {
+	if m != nil {
+		return m.ProposalID
+	}
+	return 0
+}
+
+func (m *EventProposalConverted) GetVotingEndTime() time.Time {
+	if m != nil {
+		return m.VotingEndTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*EventProposalExpedited)(nil), "coreum.expedited.v1.EventProposalExpedited")
+	proto.RegisterType((*EventProposalConverted)(nil), "coreum.expedited.v1.EventProposalConverted")
+}
+
+func init() { proto.RegisterFile("coreum/expedited/v1/event.proto", fileDescriptor_a5bfb0d1d6841620) }
+
+var fileDescriptor_a5bfb0d1d6841620 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x91, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0x9b, 0x3f, 0x7f, 0x44, 0x32, 0x54, 0xa8, 0x22, 0xa3, 0x87, 0x74, 0x78, 0xda, 0x29,
+	0x61, 0xea, 0x13, 0x74, 0x56, 0x18, 0x88, 0xc8, 0xf0, 0xe4, 0xa5, 0xb4, 0x4b, 0x8c, 0x81, 0x35,
+	0xbf, 0xd0, 0xa6, 0x65, 0xbe, 0xc5, 0x6e, 0xbe, 0xd2, 0x8e, 0x3b, 0x7a, 0x9a, 0xd2, 0xbe, 0x88,
+	0xb4, 0x5d, 0x45, 0xf0, 0x01, 0xbc, 0x25, 0xf9, 0x7d, 0xf2, 0xfb, 0x7e, 0xe0, 0x8b, 0xfd, 0x05,
+	0x64, 0xa2, 0x48, 0x99, 0x58, 0x19, 0xc1, 0x95, 0x15, 0x9c, 0x95, 0x13, 0x26, 0x4a, 0xa1, 0x2d,
+	0x35, 0x19, 0x58, 0x70, 0x4f, 0x3b, 0x80, 0x7e, 0x03, 0xb4, 0x9c, 0x78, 0x67, 0x12, 0x24, 0xb4,
+	0x73, 0xd6, 0x9c, 0x3a, 0xd4, 0xf3, 0x25, 0x80, 0x5c, 0x0a, 0xd6, 0xde, 0x92, 0xe2, 0x99, 0x59,
+	0x95, 0x8a, 0xdc, 0xc6, 0xa9, 0xe9, 0x80, 0x8b, 0x37, 0x84, 0xcf, 0xc3, 0x66, 0xf7, 0x43, 0x06,
+	0x06, 0xf2, 0x78, 0x19, 0xf6, 0x4b, 0x5d, 0x86, 0x07, 0x66, 0xff, 0x18, 0x29, 0x3e, 0x44, 0x23,
+	0x34, 0xfe, 0x1f, 0x1c, 0x57, 0x3b, 0x1f, 0xf7, 0xec, 0xec, 0x66, 0x8e, 0x7b, 0x64, 0xc6, 0xdd,
+	0x3b, 0x7c, 0x52, 0x82, 0x55, 0x5a, 0x46, 0x42, 0xf3, 0xa8, 0x49, 0x1a, 0xfe, 0x1b, 0xa1, 0xf1,
+	0xe0, 0xd2, 0xa3, 0x9d, 0x06, 0xed, 0x35, 0xe8, 0x63, 0xaf, 0x11, 0x1c, 0x6e, 0x76, 0xbe, 0xb3,
+	0xfe, 0xf0, 0xd1, 0xfc, 0xa8, 0xfb, 0x1c, 0x6a, 0xde, 0x4c, 0x7f, 0x9b, 0x4d, 0x41, 0x97, 0x22,
+	0xfb, 0x7b, 0xb3, 0xe0, 0x7e, 0x53, 0x11, 0xb4, 0xad, 0x08, 0xfa, 0xac, 0x08, 0x5a, 0xd7, 0xc4,
+	0xd9, 0xd6, 0xc4, 0x79, 0xaf, 0x89, 0xf3, 0x74, 0x2d, 0x95, 0x7d, 0x29, 0x12, 0xba, 0x80, 0x94,
+	0x4d, 0xdb, 0x92, 0x6e, 0xa1, 0xd0, 0x3c, 0xb6, 0x0a, 0x34, 0xdb, 0xd7, 0xba, 0xfa, 0x51, 0xac,
+	0x7d, 0x35, 0x22, 0x4f, 0x0e, 0xda, 0xf0, 0xab, 0xaf, 0x01, 0x00, 0x19, 0x56, 0x9e, 0x8c, 0xf9,
+	0x01, 0x00, 0x00,
+}
+
+func (m *EventProposalExpedited) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalExpedited) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalExpedited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvent(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposalConverted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalConverted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalConverted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvent(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalID != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *EventProposalExpedited) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventProposalConverted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *EventProposalExpedited) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalExpedited: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalExpedited: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventProposalConverted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalConverted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalConverted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
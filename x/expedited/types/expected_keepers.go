@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GovKeeper defines the expected gov keeper interface.
+type GovKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (govtypes.Proposal, bool)
+	SetProposal(ctx sdk.Context, proposal govtypes.Proposal)
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+	GetTallyParams(ctx sdk.Context) govtypes.TallyParams
+	IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vote govtypes.Vote) (stop bool))
+	IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govtypes.Proposal) (stop bool))
+	InsertActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time)
+	RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time)
+}
+
+// StakingKeeper defines the expected staking keeper interface.
+type StakingKeeper interface {
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool))
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+}
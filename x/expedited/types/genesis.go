@@ -0,0 +1,27 @@
+package types
+
+import "github.com/pkg/errors"
+
+// DefaultGenesis returns the default expedited genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (m GenesisState) Validate() error {
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	proposals := make(map[uint64]struct{}, len(m.ProposalIDs))
+	for _, proposalID := range m.ProposalIDs {
+		if _, ok := proposals[proposalID]; ok {
+			return errors.Errorf("duplicated expedited proposal %d", proposalID)
+		}
+		proposals[proposalID] = struct{}{}
+	}
+
+	return nil
+}
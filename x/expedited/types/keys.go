@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "expedited"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Store key prefixes
+var (
+	// ProposalKeyPrefix defines the key prefix for the proposals being voted on the expedited track.
+	ProposalKeyPrefix = []byte{0x01}
+)
+
+// GetProposalKey constructs the key for the expedited proposal.
+func GetProposalKey(proposalID uint64) []byte {
+	return store.JoinKeys(ProposalKeyPrefix, sdk.Uint64ToBigEndian(proposalID))
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+// Parameter keys
+var (
+	KeyVotingPeriod  = []byte("VotingPeriod")
+	KeyThreshold     = []byte("Threshold")
+	KeyProposalTypes = []byte("ProposalTypes")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns params with default values.
+// Only the cancellation of the software upgrade is expedited by default, the emergency actions of other modules are
+// added by the governance.
+func DefaultParams() Params {
+	return Params{
+		VotingPeriod: 24 * time.Hour,
+		Threshold:    sdk.MustNewDecFromStr("0.667"),
+		ProposalTypes: []string{
+			"/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal",
+		},
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of the expedited parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVotingPeriod, &m.VotingPeriod, validateVotingPeriod),
+		paramtypes.NewParamSetPair(KeyThreshold, &m.Threshold, validateThreshold),
+		paramtypes.NewParamSetPair(KeyProposalTypes, &m.ProposalTypes, validateProposalTypes),
+	}
+}
+
+// ValidateBasic validates the expedited parameters.
+func (m Params) ValidateBasic() error {
+	if err := validateVotingPeriod(m.VotingPeriod); err != nil {
+		return errors.Wrap(err, "invalid voting period")
+	}
+	if err := validateThreshold(m.Threshold); err != nil {
+		return errors.Wrap(err, "invalid threshold")
+	}
+	if err := validateProposalTypes(m.ProposalTypes); err != nil {
+		return errors.Wrap(err, "invalid proposal types")
+	}
+	return nil
+}
+
+// IsExpedited returns true if the proposals having the content of the type are expedited.
+func (m Params) IsExpedited(typeURL string) bool {
+	for _, t := range m.ProposalTypes {
+		if t == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+func validateVotingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return errors.New("voting period must be positive")
+	}
+	return nil
+}
+
+func validateThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return errors.New("threshold must be set")
+	}
+	if v.LT(sdk.MustNewDecFromStr("0.5")) || v.GT(sdk.OneDec()) {
+		return errors.Errorf("threshold must be between 0.5 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateProposalTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	present := make(map[string]struct{}, len(v))
+	for _, typeURL := range v {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return errors.Errorf("invalid proposal type URL %q", typeURL)
+		}
+		if _, ok := present[typeURL]; ok {
+			return errors.Errorf("duplicated proposal type %q", typeURL)
+		}
+		present[typeURL] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/expedited/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params store gov manageable parameters of the expedited proposals.
+type Params struct {
+	// voting_period is the duration of the voting period of the expedited proposal.
+	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period" yaml:"voting_period"`
+	// threshold is the minimum fraction of the yes votes of the non-abstaining voting power required for the expedited
+	// proposal to pass. The expedited proposal not reaching the threshold is converted to the regular one.
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold" yaml:"threshold"`
+	// proposal_types is the list of the type URLs of the proposal contents which are expedited.
+	ProposalTypes []string `protobuf:"bytes,3,rep,name=proposal_types,json=proposalTypes,proto3" json:"proposal_types,omitempty" yaml:"proposal_types"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_919bde3e8d62fa2d, []int{0}
+}
+
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVotingPeriod() time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetProposalTypes() []string {
+	if m != nil {
+		return m.ProposalTypes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "coreum.expedited.v1.Params")
+}
+
+func init() { proto.RegisterFile("coreum/expedited/v1/params.proto", fileDescriptor_919bde3e8d62fa2d) }
+
+var fileDescriptor_919bde3e8d62fa2d = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x13, 0x90, 0x90, 0xc8, 0xbd, 0x5c, 0x5d, 0xe5, 0x72, 0x25, 0x60, 0x48, 0xa2, 0x0c,
+	0x15, 0x4b, 0x6d, 0xd1, 0x76, 0xea, 0x54, 0xa5, 0xa8, 0x63, 0x85, 0x50, 0xa7, 0x2e, 0x10, 0x62,
+	0x37, 0x44, 0x4d, 0x38, 0x96, 0xed, 0x20, 0x78, 0x89, 0xaa, 0x63, 0x1f, 0x89, 0x91, 0xb1, 0xea,
+	0x90, 0x56, 0xf0, 0x06, 0x3c, 0x41, 0x85, 0x0d, 0x14, 0x26, 0xfb, 0x9c, 0xf3, 0xff, 0x9f, 0xad,
+	0xf3, 0x5b, 0x5e, 0x04, 0x9c, 0xe6, 0x19, 0xa6, 0x33, 0x46, 0x49, 0x22, 0x29, 0xc1, 0xd3, 0x0e,
+	0x66, 0x21, 0x0f, 0x33, 0x81, 0x18, 0x07, 0x09, 0xf6, 0x3f, 0xad, 0x40, 0x07, 0x05, 0x9a, 0x76,
+	0x5a, 0xf5, 0x18, 0x62, 0x50, 0x73, 0xbc, 0xbd, 0x69, 0x69, 0xcb, 0x89, 0x01, 0xe2, 0x94, 0x62,
+	0x55, 0x8d, 0xf2, 0x27, 0x4c, 0x72, 0x1e, 0xca, 0x04, 0x26, 0x7a, 0xee, 0xbf, 0x94, 0xac, 0x4a,
+	0x4f, 0xb1, 0xed, 0xa1, 0x55, 0x9b, 0x82, 0x4c, 0x26, 0xf1, 0x80, 0x51, 0x9e, 0x00, 0x69, 0x98,
+	0x9e, 0xd9, 0xfe, 0x75, 0xd1, 0x44, 0x1a, 0x81, 0xf6, 0x08, 0xd4, 0xdd, 0x21, 0x02, 0x6f, 0x51,
+	0xb8, 0xc6, 0xa6, 0x70, 0xeb, 0xf3, 0x30, 0x4b, 0xaf, 0xfd, 0x13, 0xb7, 0xff, 0xf6, 0xe9, 0x9a,
+	0xfd, 0xdf, 0xba, 0xd7, 0x53, 0x2d, 0x7b, 0x68, 0x55, 0xe5, 0x98, 0x53, 0x31, 0x86, 0x94, 0x34,
+	0x4a, 0x9e, 0xd9, 0xae, 0x06, 0xc1, 0x16, 0xf1, 0x51, 0xb8, 0x67, 0x71, 0x22, 0xc7, 0xf9, 0x08,
+	0x45, 0x90, 0xe1, 0x08, 0x44, 0x06, 0x62, 0x77, 0x9c, 0x0b, 0xf2, 0x8c, 0xe5, 0x9c, 0x51, 0x81,
+	0xba, 0x34, 0xda, 0x14, 0xee, 0x5f, 0xfd, 0xd8, 0x01, 0xe4, 0xf7, 0x7f, 0xa0, 0xf6, 0x8d, 0xf5,
+	0x87, 0x71, 0x60, 0x20, 0xc2, 0x74, 0xa0, 0x4c, 0x8d, 0xb2, 0x57, 0x6e, 0x57, 0x83, 0xe6, 0xa6,
+	0x70, 0xff, 0x6b, 0xe3, 0xe9, 0xdc, 0xef, 0xd7, 0xf6, 0x8d, 0x87, 0x6d, 0x1d, 0xdc, 0x2f, 0x56,
+	0x8e, 0xb9, 0x5c, 0x39, 0xe6, 0xd7, 0xca, 0x31, 0x5f, 0xd7, 0x8e, 0xb1, 0x5c, 0x3b, 0xc6, 0xfb,
+	0xda, 0x31, 0x1e, 0xaf, 0x8e, 0xbe, 0x78, 0xab, 0x02, 0xb8, 0x83, 0x7c, 0x42, 0xd4, 0x2a, 0xf0,
+	0x2e, 0xb3, 0xd9, 0x51, 0x6a, 0x8a, 0x3f, 0xaa, 0xa8, 0xb5, 0x5d, 0x7e, 0x0f, 0x00, 0x1c, 0x6d,
+	0xba, 0x8a, 0xd6, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalTypes) > 0 {
+		for iNdEx := len(m.ProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProposalTypes[iNdEx])
+			copy(dAtA[i:], m.ProposalTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ProposalTypes) > 0 {
+		for _, s := range m.ProposalTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypes = append(m.ProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
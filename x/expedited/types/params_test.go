@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/x/expedited/types"
+)
+
+func TestParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modifier func(params *types.Params)
+		valid    bool
+	}{
+		{
+			name:     "default",
+			modifier: func(params *types.Params) {},
+			valid:    true,
+		},
+		{
+			name: "no_proposal_types",
+			modifier: func(params *types.Params) {
+				params.ProposalTypes = nil
+			},
+			valid: true,
+		},
+		{
+			name: "zero_voting_period",
+			modifier: func(params *types.Params) {
+				params.VotingPeriod = 0
+			},
+		},
+		{
+			name: "low_threshold",
+			modifier: func(params *types.Params) {
+				params.Threshold = sdk.MustNewDecFromStr("0.4")
+			},
+		},
+		{
+			name: "high_threshold",
+			modifier: func(params *types.Params) {
+				params.Threshold = sdk.MustNewDecFromStr("1.1")
+			},
+		},
+		{
+			name: "invalid_proposal_type",
+			modifier: func(params *types.Params) {
+				params.ProposalTypes = []string{"cosmos.gov.v1beta1.TextProposal"}
+			},
+		},
+		{
+			name: "duplicated_proposal_type",
+			modifier: func(params *types.Params) {
+				params.ProposalTypes = []string{"/cosmos.gov.v1beta1.TextProposal", "/cosmos.gov.v1beta1.TextProposal"}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modifier(&params)
+			err := params.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -9,10 +9,13 @@ import (
 type Keeper interface {
 	TrackGas(ctx sdk.Context, gas int64)
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	CalculateSurcharge(ctx sdk.Context, msgs []sdk.Msg) sdk.Int
+	SetTxGasFee(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Int, gasLimit uint64)
 }
 
 // FeeDecorator will check if the gas price offered by transaction's fee is at least as large
 // as the current minimum gas price required by the network and computd by our fee model.
+// The surcharges of the heavy messages configured by the governance are required on top of it.
 // CONTRACT: Tx must implement FeeTx to use FeeDecorator
 type FeeDecorator struct {
 	keeper Keeper
@@ -37,35 +40,56 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	if err := fd.actOnFeeModelOutput(ctx, feeTx); err != nil {
+	surcharge, err := fd.actOnFeeModelOutput(ctx, feeTx)
+	if err != nil {
 		return ctx, err
 	}
 
 	fd.collectFeeModelInput(ctx, feeTx)
 
+	if !simulate && !ctx.IsCheckTx() {
+		fd.recordTxGasFee(ctx, feeTx, surcharge)
+	}
+
 	return next(ctx, tx, simulate)
 }
 
-func (fd FeeDecorator) actOnFeeModelOutput(ctx sdk.Context, feeTx sdk.FeeTx) error {
+func (fd FeeDecorator) actOnFeeModelOutput(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Int, error) {
 	fees := feeTx.GetFee()
 	minGasPrice := fd.keeper.GetMinGasPrice(ctx)
 	if len(fees) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "no fee declared for transaction")
+		return sdk.Int{}, sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "no fee declared for transaction")
 	}
 	if fees[0].Denom != minGasPrice.Denom {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in '%s' coin only", minGasPrice.Denom)
+		return sdk.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee must be paid in '%s' coin only", minGasPrice.Denom)
 	}
 
 	gasDeclared := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
 	feeOffered := sdk.NewDecCoin(minGasPrice.Denom, fees.AmountOf(minGasPrice.Denom))
-	feeRequired := sdk.NewDecCoinFromDec(minGasPrice.Denom, gasDeclared.Mul(minGasPrice.Amount))
+	surcharge := fd.keeper.CalculateSurcharge(ctx, feeTx.GetMsgs())
+	feeRequired := sdk.NewDecCoinFromDec(minGasPrice.Denom, gasDeclared.Mul(minGasPrice.Amount).Add(sdk.NewDecFromInt(surcharge)))
 
 	if feeOffered.IsLT(feeRequired) {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeOffered, feeRequired)
+		if surcharge.IsPositive() {
+			return sdk.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s (including surcharge: %s%s)",
+				feeOffered, feeRequired, surcharge, minGasPrice.Denom)
+		}
+		return sdk.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeOffered, feeRequired)
 	}
-	return nil
+	return surcharge, nil
 }
 
 func (fd FeeDecorator) collectFeeModelInput(ctx sdk.Context, feeTx sdk.FeeTx) {
 	fd.keeper.TrackGas(ctx, int64(feeTx.GetGas()))
 }
+
+// recordTxGasFee records the fee paid for the declared gas, so the fee of the unused gas might be refunded
+// if the transaction fails. The fee is deducted from the fee granter if it is set.
+func (fd FeeDecorator) recordTxGasFee(ctx sdk.Context, feeTx sdk.FeeTx, surcharge sdk.Int) {
+	payer := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); !granter.Empty() {
+		payer = granter
+	}
+	fees := feeTx.GetFee()
+	fd.keeper.SetTxGasFee(ctx, payer, fees.AmountOf(fees[0].Denom).Sub(surcharge), feeTx.GetGas())
+}
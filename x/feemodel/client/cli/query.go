@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
@@ -22,6 +25,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetMinGasPriceCmd(),
+		GetParamsCmd(),
+		GetAverageMinGasPriceCmd(),
+		GetRecommendedGasPriceCmd(),
+		GetGasPriceHistoryCmd(),
+		GetModelStateCmd(),
 	)
 
 	return cmd
@@ -54,3 +62,164 @@ func GetMinGasPriceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetParamsCmd returns command for getting the params of the fee model.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current params of the fee model",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAverageMinGasPriceCmd returns command for getting the average minimum gas price required in the recent blocks.
+func GetAverageMinGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "average-min-gas-price [blocks]",
+		Short: "Query for the average minimum gas price required by the network in the recent blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid number of blocks")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.AverageMinGasPrice(ctx, &types.QueryAverageMinGasPriceRequest{
+				Blocks: blocks,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRecommendedGasPriceCmd returns command for getting the gas prices recommended for the next blocks.
+func GetRecommendedGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recommended-gas-price [after-blocks]",
+		Short: "Query for the low, medium and high gas prices projected for the next blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			afterBlocks, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return errors.Wrap(err, "invalid number of blocks")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.RecommendedGasPrice(ctx, &types.QueryRecommendedGasPriceRequest{
+				AfterBlocks: uint32(afterBlocks),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetGasPriceHistoryCmd returns command for getting the minimum gas prices and the gas used in the recent blocks.
+func GetGasPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-history [blocks]",
+		Short: "Query for the minimum gas prices required by the network and the gas used in the recent blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return errors.Wrap(err, "invalid number of blocks")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.GasPriceHistory(ctx, &types.QueryGasPriceHistoryRequest{
+				Blocks: uint32(blocks),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetModelStateCmd returns command for getting the internal state of the fee model.
+func GetModelStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "model-state",
+		Short: "Query the internal state of the fee model, informational only, it might change between the versions",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			res, err := queryClient.ModelState(ctx, &types.QueryModelStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
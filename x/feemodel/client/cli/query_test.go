@@ -11,6 +11,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/testutil/network"
 	"github.com/CoreumFoundation/coreum/x/feemodel/client/cli"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
 )
 
 func TestMinGasPrice(t *testing.T) {
@@ -24,6 +25,92 @@ func TestMinGasPrice(t *testing.T) {
 	var resp sdk.DecCoin
 	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
 
-	assert.Equal(t, "ducore", resp.Denom)
+	assert.Equal(t, testNetwork.Config.BondDenom, resp.Denom)
 	assert.True(t, resp.Amount.GT(sdk.ZeroDec()))
 }
+
+func TestParams(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"params", "--output", "json"})
+	require.NoError(t, err)
+
+	var params types.Params
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &params))
+	assert.NoError(t, params.ValidateBasic())
+}
+
+func TestAverageMinGasPrice(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"average-min-gas-price", "10", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryAverageMinGasPriceResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	assert.Equal(t, testNetwork.Config.BondDenom, resp.AverageMinGasPrice.Denom)
+	assert.True(t, resp.AverageMinGasPrice.Amount.GT(sdk.ZeroDec()))
+	assert.Greater(t, resp.Blocks, uint64(0))
+	assert.LessOrEqual(t, resp.Blocks, uint64(10))
+}
+
+func TestRecommendedGasPrice(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"recommended-gas-price", "10", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryRecommendedGasPriceResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	assert.Equal(t, testNetwork.Config.BondDenom, resp.Medium.Denom)
+	assert.True(t, resp.Low.Amount.GT(sdk.ZeroDec()))
+	assert.True(t, resp.Low.Amount.LTE(resp.Medium.Amount))
+	assert.True(t, resp.Medium.Amount.LTE(resp.High.Amount))
+}
+
+func TestGasPriceHistory(t *testing.T) {
+	testNetwork := network.New(t)
+	require.NoError(t, testNetwork.WaitForNextBlock())
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"gas-price-history", "10", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryGasPriceHistoryResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	require.NotEmpty(t, resp.Records)
+	assert.LessOrEqual(t, len(resp.Records), 10)
+	for i, record := range resp.Records {
+		assert.Equal(t, testNetwork.Config.BondDenom, record.MinGasPrice.Denom)
+		assert.True(t, record.MinGasPrice.Amount.GT(sdk.ZeroDec()))
+		if i > 0 {
+			assert.Greater(t, record.Height, resp.Records[i-1].Height)
+		}
+	}
+}
+
+func TestModelState(t *testing.T) {
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	cmd := cli.GetQueryCmd()
+	buf, err := clitestutil.ExecTestCLICmd(ctx, cmd, []string{"model-state", "--output", "json"})
+	require.NoError(t, err)
+
+	var resp types.QueryModelStateResponse
+	require.NoError(t, ctx.Codec.UnmarshalJSON(buf.Bytes(), &resp))
+
+	assert.GreaterOrEqual(t, resp.ShortEmaGas, int64(0))
+	assert.GreaterOrEqual(t, resp.LongEmaGas, int64(0))
+	assert.GreaterOrEqual(t, resp.LastBlockGas, int64(0))
+}
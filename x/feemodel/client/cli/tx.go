@@ -0,0 +1,324 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+const (
+	// FileFlag is the flag pointing to the file containing the proposed params.
+	FileFlag = "file"
+	// TemplateFlag is the flag printing the params file template instead of submitting the proposal.
+	TemplateFlag = "template"
+
+	// InitialGasPriceFlag is the flag setting the initial gas price of the model.
+	InitialGasPriceFlag = "initial-gas-price"
+	// MaxGasPriceMultiplierFlag is the flag setting the max gas price multiplier of the model.
+	MaxGasPriceMultiplierFlag = "max-gas-price-multiplier"
+	// MaxDiscountFlag is the flag setting the max discount of the model.
+	MaxDiscountFlag = "max-discount"
+	// EscalationStartFractionFlag is the flag setting the escalation start fraction of the model.
+	EscalationStartFractionFlag = "escalation-start-fraction"
+	// MaxBlockGasFlag is the flag setting the max block gas of the model.
+	MaxBlockGasFlag = "max-block-gas"
+	// ShortEMABlockLengthFlag is the flag setting the short EMA block length of the model.
+	ShortEMABlockLengthFlag = "short-ema-block-length"
+	// LongEMABlockLengthFlag is the flag setting the long EMA block length of the model.
+	LongEMABlockLengthFlag = "long-ema-block-length"
+)
+
+// GetTxCmd returns the transaction commands for the fee model module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxUpdateParams(),
+	)
+
+	return cmd
+}
+
+// CmdTxUpdateParams returns the command drafting and submitting the governance proposal updating the params of the
+// fee model. The params not set by the flags are taken from the current params of the chain.
+func CmdTxUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params --max-discount [max-discount] --title [title] --description [description] --deposit [deposit] --from [proposer]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal updating the params of the fee model",
+		Long: fmt.Sprintf(`Submit a proposal updating the params of the fee model.
+The params not set by the flags are taken from the current params of the chain, so only the changed ones need to be
+provided. The params are validated against the constraints of the model before the proposal is submitted.
+The proposal might be drafted without broadcasting it by the --%s flag.
+
+Example:
+$ %s tx %s update-params --%s 0.4 --%s 100 --title "Tune the fee model" --description "..." --deposit 10000000ucore --from [proposer]
+`,
+			flags.FlagGenerateOnly, version.AppName, types.ModuleName, MaxDiscountFlag, ShortEMABlockLengthFlag,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			params := res.Params
+			params.Model, err = ApplyModelParamsFlags(cmd.Flags(), params.Model)
+			if err != nil {
+				return err
+			}
+			if err := params.ValidateBasic(); err != nil {
+				return errors.Wrap(err, "invalid params of the fee model")
+			}
+
+			return submitParamsUpdateProposal(cmd, clientCtx, params)
+		},
+	}
+
+	cmd.Flags().String(InitialGasPriceFlag, "", "Initial gas price of the model")
+	cmd.Flags().String(MaxGasPriceMultiplierFlag, "", "Multiplier of the initial gas price giving the max gas price")
+	cmd.Flags().String(MaxDiscountFlag, "", "Max discount offered on top of the initial gas price")
+	cmd.Flags().String(EscalationStartFractionFlag, "", "Fraction of the max block gas the gas price escalation starts at")
+	cmd.Flags().Int64(MaxBlockGasFlag, 0, "Max block gas of the model")
+	cmd.Flags().Uint32(ShortEMABlockLengthFlag, 0, "Number of blocks the short average block gas is computed over")
+	cmd.Flags().Uint32(LongEMABlockLengthFlag, 0, "Number of blocks the long average block gas is computed over")
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ApplyModelParamsFlags returns the model params with the values set by the flags replacing the current ones.
+func ApplyModelParamsFlags(flagSet *pflag.FlagSet, model types.ModelParams) (types.ModelParams, error) {
+	decFlags := []struct {
+		name  string
+		value *sdk.Dec
+	}{
+		{name: InitialGasPriceFlag, value: &model.InitialGasPrice},
+		{name: MaxGasPriceMultiplierFlag, value: &model.MaxGasPriceMultiplier},
+		{name: MaxDiscountFlag, value: &model.MaxDiscount},
+		{name: EscalationStartFractionFlag, value: &model.EscalationStartFraction},
+	}
+	for _, decFlag := range decFlags {
+		if !flagSet.Changed(decFlag.name) {
+			continue
+		}
+		str, err := flagSet.GetString(decFlag.name)
+		if err != nil {
+			return types.ModelParams{}, errors.WithStack(err)
+		}
+		value, err := sdk.NewDecFromStr(str)
+		if err != nil {
+			return types.ModelParams{}, errors.Wrapf(err, "invalid --%s", decFlag.name)
+		}
+		*decFlag.value = value
+	}
+
+	if flagSet.Changed(MaxBlockGasFlag) {
+		maxBlockGas, err := flagSet.GetInt64(MaxBlockGasFlag)
+		if err != nil {
+			return types.ModelParams{}, errors.WithStack(err)
+		}
+		model.MaxBlockGas = maxBlockGas
+	}
+	if flagSet.Changed(ShortEMABlockLengthFlag) {
+		length, err := flagSet.GetUint32(ShortEMABlockLengthFlag)
+		if err != nil {
+			return types.ModelParams{}, errors.WithStack(err)
+		}
+		model.ShortEmaBlockLength = length
+	}
+	if flagSet.Changed(LongEMABlockLengthFlag) {
+		length, err := flagSet.GetUint32(LongEMABlockLengthFlag)
+		if err != nil {
+			return types.ModelParams{}, errors.WithStack(err)
+		}
+		model.LongEmaBlockLength = length
+	}
+
+	return model, nil
+}
+
+// CmdSubmitParamsUpdateProposal returns the command submitting the governance proposal updating the params
+// of the fee model. The tx flags are added by the gov module.
+func CmdSubmitParamsUpdateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feemodel-update --file [params-file] --title [title] --description [description] --deposit [deposit] --from [proposer]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal updating the params of the fee model",
+		Long: fmt.Sprintf(`Submit a proposal updating the params of the fee model.
+The params file contains the complete set of the params of the fee model. The params are validated against
+the constraints of the model before the proposal is submitted. The template of the file, prefilled with the
+current params, is printed by the --%s flag.
+
+Example:
+$ %s tx gov submit-proposal feemodel-update --%s > params.json
+$ %s tx gov submit-proposal feemodel-update --%s params.json --title "Increase initial gas price" --description "..." --deposit 10000000ucore --from [proposer]
+`,
+			TemplateFlag, version.AppName, TemplateFlag, version.AppName, FileFlag,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			template, err := cmd.Flags().GetBool(TemplateFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if template {
+				return printParamsTemplate(cmd)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := cmd.Flags().GetString(FileFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if file == "" {
+				return errors.Errorf("the params file must be provided by the --%s flag", FileFlag)
+			}
+			params, err := ReadParamsFile(clientCtx.Codec, file)
+			if err != nil {
+				return err
+			}
+
+			return submitParamsUpdateProposal(cmd, clientCtx, params)
+		},
+	}
+
+	cmd.Flags().String(FileFlag, "", "JSON file containing the proposed params of the fee model")
+	cmd.Flags().Bool(TemplateFlag, false, "Print the template of the params file prefilled with the current params and exit")
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of the proposal")
+
+	return cmd
+}
+
+// ReadParamsFile reads the params of the fee model from the JSON file and validates them.
+func ReadParamsFile(cdc codec.JSONCodec, file string) (types.Params, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return types.Params{}, errors.Wrapf(err, "can't read the params file %s", file)
+	}
+
+	var params types.Params
+	if err := cdc.UnmarshalJSON(bz, &params); err != nil {
+		return types.Params{}, errors.Wrapf(err, "can't decode the params file %s", file)
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return types.Params{}, errors.Wrap(err, "invalid params of the fee model")
+	}
+
+	return params, nil
+}
+
+// NewParamsUpdateProposal returns the param change proposal setting the params of the fee model.
+// The values are encoded by the legacy amino codec, the same way the params subspace decodes them.
+func NewParamsUpdateProposal(
+	cdc *codec.LegacyAmino,
+	title, description string,
+	params types.Params,
+) (*paramproposal.ParameterChangeProposal, error) {
+	modelValue, err := cdc.MarshalJSON(params.Model)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	oracleValue, err := cdc.MarshalJSON(params.Oracle)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	surchargesValue, err := cdc.MarshalJSON(params.Surcharges)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	feeBurnRateValue, err := cdc.MarshalJSON(params.FeeBurnRate)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	content := paramproposal.NewParameterChangeProposal(title, description, []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyModel), string(modelValue)),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyOracle), string(oracleValue)),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeySurcharges), string(surchargesValue)),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyFeeBurnRate), string(feeBurnRateValue)),
+	})
+	if err := content.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func submitParamsUpdateProposal(cmd *cobra.Command, clientCtx client.Context, params types.Params) error {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return errors.Wrapf(err, "invalid deposit %q", depositStr)
+	}
+
+	content, err := NewParamsUpdateProposal(clientCtx.LegacyAmino, title, description, params)
+	if err != nil {
+		return err
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func printParamsTemplate(cmd *cobra.Command) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.WithOutputFormat("json").PrintProto(&res.Params)
+}
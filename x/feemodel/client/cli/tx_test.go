@@ -0,0 +1,91 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/feemodel/client/cli"
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+func TestParamsUpdateProposal(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	newParams := types.DefaultParams()
+	newParams.Model.InitialGasPrice = sdk.MustNewDecFromStr("0.1")
+	newParams.Model.MaxBlockGas = 1000000
+	newParams.Oracle.Enabled = true
+	newParams.Oracle.MinGasPriceUSD = sdk.MustNewDecFromStr("0.0002")
+	newParams.Surcharges = []types.MsgSurcharge{
+		{MsgTypeURL: "/coreum.asset.nft.v1.MsgIssueClass", Amount: sdk.NewInt(1000000)},
+	}
+	newParams.FeeBurnRate = sdk.MustNewDecFromStr("0.25")
+
+	file := filepath.Join(t.TempDir(), "params.json")
+	bz, err := testApp.AppCodec().MarshalJSON(&newParams)
+	requireT.NoError(err)
+	requireT.NoError(os.WriteFile(file, bz, 0o600))
+
+	fileParams, err := cli.ReadParamsFile(testApp.AppCodec(), file)
+	requireT.NoError(err)
+	requireT.Equal(newParams, fileParams)
+
+	content, err := cli.NewParamsUpdateProposal(testApp.LegacyAmino(), "title", "description", fileParams)
+	requireT.NoError(err)
+	requireT.NoError(params.NewParamChangeProposalHandler(testApp.ParamsKeeper)(ctx, content))
+	requireT.Equal(newParams, testApp.FeeModelKeeper.GetParams(ctx))
+}
+
+func TestReadParamsFileInvalid(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+
+	invalidParams := types.DefaultParams()
+	invalidParams.Model.MaxGasPriceMultiplier = sdk.OneDec()
+
+	file := filepath.Join(t.TempDir(), "params.json")
+	bz, err := testApp.AppCodec().MarshalJSON(&invalidParams)
+	requireT.NoError(err)
+	requireT.NoError(os.WriteFile(file, bz, 0o600))
+
+	_, err = cli.ReadParamsFile(testApp.AppCodec(), file)
+	requireT.ErrorContains(err, "max gas price multiplier must be greater than one")
+
+	_, err = cli.ReadParamsFile(testApp.AppCodec(), filepath.Join(t.TempDir(), "missing.json"))
+	requireT.Error(err)
+}
+
+func TestApplyModelParamsFlags(t *testing.T) {
+	requireT := require.New(t)
+
+	cmd := cli.CmdTxUpdateParams()
+	requireT.NoError(cmd.Flags().Parse([]string{
+		"--" + cli.MaxDiscountFlag, "0.4",
+		"--" + cli.ShortEMABlockLengthFlag, "100",
+		"--" + cli.MaxBlockGasFlag, "5000000",
+	}))
+
+	model := types.DefaultParams().Model
+	expectedModel := model
+	expectedModel.MaxDiscount = sdk.MustNewDecFromStr("0.4")
+	expectedModel.ShortEmaBlockLength = 100
+	expectedModel.MaxBlockGas = 5000000
+
+	newModel, err := cli.ApplyModelParamsFlags(cmd.Flags(), model)
+	requireT.NoError(err)
+	requireT.Equal(expectedModel, newModel)
+
+	cmd = cli.CmdTxUpdateParams()
+	requireT.NoError(cmd.Flags().Parse([]string{"--" + cli.InitialGasPriceFlag, "invalid"}))
+	_, err = cli.ApplyModelParamsFlags(cmd.Flags(), model)
+	requireT.ErrorContains(err, "invalid --"+cli.InitialGasPriceFlag)
+}
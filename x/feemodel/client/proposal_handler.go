@@ -0,0 +1,26 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/client/cli"
+)
+
+// ProposalHandler is the gov proposal handler submitting the update of the fee model params.
+var ProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitParamsUpdateProposal, restProposalHandler)
+
+// restProposalHandler rejects the requests, the legacy REST endpoint is not supported, the generic
+// param_change endpoint might be used instead.
+func restProposalHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "feemodel_update",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST route is not supported, use param_change instead")
+		},
+	}
+}
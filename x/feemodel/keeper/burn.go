@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// BurnFees burns the share of the fees collected in the block defined by the fee burn rate. The fees are collected
+// in the denom of the minimum gas price, the rest of them stays in the fee collector and is distributed to
+// the validators and the delegators by the distribution module in the next block.
+func (k Keeper) BurnFees(ctx sdk.Context) error {
+	denom := k.GetMinGasPrice(ctx).Denom
+	collectedFee := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), denom)
+	burntFee := sdk.NewCoin(denom, k.GetParams(ctx).CalculateBurntFee(collectedFee.Amount))
+	if !burntFee.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(burntFee)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrapf(err, "can't send the fees to be burnt to the %s module", types.ModuleName)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrapf(err, "can't burn the fees")
+	}
+
+	if burntFee.Amount.IsInt64() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "burnt_fees"},
+			float32(burntFee.Amount.Int64()),
+			[]metrics.Label{telemetry.NewLabel("denom", denom)},
+		)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFeesBurned{
+		Amount: burntFee,
+	}); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't emit event EventFeesBurned: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+type bankKeeperMock struct {
+	balances map[string]sdk.Coins
+	burnt    sdk.Coins
+}
+
+func (bkm *bankKeeperMock) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, bkm.balances[addr.String()].AmountOf(denom))
+}
+
+func (bkm *bankKeeperMock) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	sender := authtypes.NewModuleAddress(senderModule).String()
+	recipient := authtypes.NewModuleAddress(recipientModule).String()
+	bkm.balances[sender] = bkm.balances[sender].Sub(amt)
+	bkm.balances[recipient] = bkm.balances[recipient].Add(amt...)
+	return nil
+}
+
+func (bkm *bankKeeperMock) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	sender := authtypes.NewModuleAddress(senderModule).String()
+	bkm.balances[sender] = bkm.balances[sender].Sub(amt)
+	bkm.balances[recipientAddr.String()] = bkm.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (bkm *bankKeeperMock) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	module := authtypes.NewModuleAddress(moduleName).String()
+	bkm.balances[module] = bkm.balances[module].Sub(amt)
+	bkm.burnt = bkm.burnt.Add(amt...)
+	return nil
+}
+
+func TestBurnFees(t *testing.T) {
+	requireT := require.New(t)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	bankKeeper := &bankKeeperMock{
+		balances: map[string]sdk.Coins{
+			feeCollector: sdk.NewCoins(sdk.NewInt64Coin("coin", 1001), sdk.NewInt64Coin("other", 1000)),
+		},
+	}
+	ctx, feeKeeper := setupWithBankKeeper(bankKeeper)
+	feeKeeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(10)))
+
+	// nothing is burnt by default
+	feeKeeper.SetParams(ctx, types.DefaultParams())
+	requireT.NoError(feeKeeper.BurnFees(ctx))
+	assert.True(t, bankKeeper.burnt.IsZero())
+
+	// the share of the fees collected in the denom of the min gas price is burnt
+	params := types.DefaultParams()
+	params.FeeBurnRate = sdk.MustNewDecFromStr("0.3")
+	feeKeeper.SetParams(ctx, params)
+	requireT.NoError(feeKeeper.BurnFees(ctx))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("coin", 300)).String(), bankKeeper.burnt.String())
+	assert.Equal(
+		t,
+		sdk.NewCoins(sdk.NewInt64Coin("coin", 701), sdk.NewInt64Coin("other", 1000)).String(),
+		bankKeeper.balances[feeCollector].String(),
+	)
+
+	events := ctx.EventManager().Events()
+	requireT.Len(events, 1)
+	assert.Equal(t, "coreum.feemodel.v1.EventFeesBurned", events[0].Type)
+}
@@ -14,6 +14,12 @@ import (
 type QueryKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	GetAverageMinGasPrice(ctx sdk.Context, blocks uint64) (sdk.DecCoin, uint64, error)
+	GetRecommendedGasPrice(ctx sdk.Context, afterBlocks uint32) (low, medium, high sdk.DecCoin, err error)
+	GetGasPriceHistory(ctx sdk.Context, blocks uint32) ([]types.GasPriceHistoryRecord, error)
+	GetShortEMAGas(ctx sdk.Context) int64
+	GetLongEMAGas(ctx sdk.Context) int64
+	GetLastBlockGas(ctx sdk.Context) int64
 }
 
 // NewQueryService creates query service
@@ -49,3 +55,68 @@ func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest
 		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// AverageMinGasPrice returns the average of the minimum gas prices required by the network in the recent blocks
+func (qs QueryService) AverageMinGasPrice(ctx context.Context, req *types.QueryAverageMinGasPriceRequest) (*types.QueryAverageMinGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	averageMinGasPrice, blocks, err := qs.keeper.GetAverageMinGasPrice(sdk.UnwrapSDKContext(ctx), req.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAverageMinGasPriceResponse{
+		AverageMinGasPrice: averageMinGasPrice,
+		Blocks:             blocks,
+	}, nil
+}
+
+// RecommendedGasPrice returns the gas prices expected to be accepted by the network in the next blocks
+func (qs QueryService) RecommendedGasPrice(ctx context.Context, req *types.QueryRecommendedGasPriceRequest) (*types.QueryRecommendedGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	low, medium, high, err := qs.keeper.GetRecommendedGasPrice(sdk.UnwrapSDKContext(ctx), req.AfterBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecommendedGasPriceResponse{
+		Low:    low,
+		Medium: medium,
+		High:   high,
+	}, nil
+}
+
+// GasPriceHistory returns the minimum gas prices required by the network and the gas used in the recent blocks
+func (qs QueryService) GasPriceHistory(ctx context.Context, req *types.QueryGasPriceHistoryRequest) (*types.QueryGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	records, err := qs.keeper.GetGasPriceHistory(sdk.UnwrapSDKContext(ctx), req.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGasPriceHistoryResponse{
+		Records: records,
+	}, nil
+}
+
+// ModelState returns the internal state of the fee model, for informational purposes only
+func (qs QueryService) ModelState(ctx context.Context, req *types.QueryModelStateRequest) (*types.QueryModelStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryModelStateResponse{
+		ShortEmaGas:  qs.keeper.GetShortEMAGas(sdkCtx),
+		LongEmaGas:   qs.keeper.GetLongEMAGas(sdkCtx),
+		LastBlockGas: qs.keeper.GetLastBlockGas(sdkCtx),
+	}, nil
+}
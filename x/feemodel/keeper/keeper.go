@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/CoreumFoundation/coreum/x/feemodel/types"
@@ -9,7 +10,7 @@ import (
 
 // ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters
 type ParamSubspace interface {
-	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
 
@@ -18,6 +19,8 @@ type Keeper struct {
 	paramSubspace     ParamSubspace
 	storeKey          sdk.StoreKey
 	transientStoreKey sdk.StoreKey
+	bankKeeper        types.BankKeeper
+	priceOracle       types.PriceOracle
 }
 
 // NewKeeper returns a new keeper object providing storage options required by fee model.
@@ -25,14 +28,25 @@ func NewKeeper(
 	paramSubspace ParamSubspace,
 	storeKey sdk.StoreKey,
 	transientStoreKey sdk.StoreKey,
+	bankKeeper types.BankKeeper,
 ) Keeper {
 	return Keeper{
 		paramSubspace:     paramSubspace,
 		storeKey:          storeKey,
 		transientStoreKey: transientStoreKey,
+		bankKeeper:        bankKeeper,
 	}
 }
 
+// SetPriceOracle sets the price oracle consulted to compute the gas price floor.
+func (k *Keeper) SetPriceOracle(priceOracle types.PriceOracle) *Keeper {
+	if k.priceOracle != nil {
+		panic("cannot set fee model price oracle twice")
+	}
+	k.priceOracle = priceOracle
+	return k
+}
+
 // TrackedGas returns gas limits declared by transactions executed so far in current block
 func (k Keeper) TrackedGas(ctx sdk.Context) int64 {
 	tStore := ctx.TransientStore(k.transientStoreKey)
@@ -67,7 +81,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 // GetParams gets the parameters of the model
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
-	k.paramSubspace.GetParamSet(ctx, &params)
+	// oracle params don't exist in the store of the chain started before they were introduced,
+	// in that case the gas price floor stays disabled
+	k.paramSubspace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -127,6 +143,34 @@ func (k Keeper) SetLongEMAGas(ctx sdk.Context, emaGas int64) {
 	store.Set(longEMAGasKey, bz)
 }
 
+// GetLastBlockGas retrieves gas tracked in the last block, it is stored for informational purposes only
+func (k Keeper) GetLastBlockGas(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(lastBlockGasKey)
+
+	if bz == nil {
+		return 0
+	}
+
+	gas := sdk.NewInt(0)
+	if err := gas.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return gas.Int64()
+}
+
+// SetLastBlockGas sets gas tracked in the last block, the model doesn't depend on it
+func (k Keeper) SetLastBlockGas(ctx sdk.Context, gas int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := sdk.NewInt(gas).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(lastBlockGasKey, bz)
+}
+
 // GetMinGasPrice returns current minimum gas price required by the network
 func (k Keeper) GetMinGasPrice(ctx sdk.Context) sdk.DecCoin {
 	store := ctx.KVStore(k.storeKey)
@@ -151,3 +195,228 @@ func (k Keeper) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	}
 	store.Set(gasPriceKey, bz)
 }
+
+// TrackMinGasPrice adds the minimum gas price required in the current block to the history the average minimum gas
+// price is computed from. The history stores the cumulative sum of the prices for each height, so the average over any
+// number of the recent blocks is computed from two records.
+func (k Keeper) TrackMinGasPrice(ctx sdk.Context, minGasPrice sdk.Dec) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+
+	cumulative, found := k.getCumulativeMinGasPrice(ctx, height-1)
+	if !found {
+		// the history starts in this block, or it restarts if the previous block hasn't been tracked
+		store.Set(minGasPriceHistoryStartKey, sdk.Uint64ToBigEndian(uint64(height)))
+		cumulative = sdk.ZeroDec()
+	}
+
+	bz, err := cumulative.Add(minGasPrice).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(cumulativeMinGasPriceKey(height), bz)
+
+	// the record preceding the window is required to compute the average over the whole window
+	if pruneHeight := height - types.MaxAverageMinGasPriceBlocks - 1; pruneHeight > 0 {
+		store.Delete(cumulativeMinGasPriceKey(pruneHeight))
+	}
+}
+
+// GetAverageMinGasPrice returns the average of the minimum gas prices required in the recent blocks and the number
+// of blocks the average is computed over. The number is lower than requested if the history is shorter.
+func (k Keeper) GetAverageMinGasPrice(ctx sdk.Context, blocks uint64) (sdk.DecCoin, uint64, error) {
+	if blocks == 0 || blocks > types.MaxAverageMinGasPriceBlocks {
+		return sdk.DecCoin{}, 0, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"number of blocks must be between 1 and %d",
+			types.MaxAverageMinGasPriceBlocks,
+		)
+	}
+
+	height := ctx.BlockHeight()
+	latest, found := k.getCumulativeMinGasPrice(ctx, height)
+	if !found {
+		return sdk.DecCoin{}, 0, sdkerrors.Wrap(sdkerrors.ErrNotFound, "history of the minimum gas prices is empty")
+	}
+
+	startHeight := int64(sdk.BigEndianToUint64(ctx.KVStore(k.storeKey).Get(minGasPriceHistoryStartKey)))
+	if available := uint64(height - startHeight + 1); blocks > available {
+		blocks = available
+	}
+
+	previous, found := k.getCumulativeMinGasPrice(ctx, height-int64(blocks))
+	if !found {
+		previous = sdk.ZeroDec()
+	}
+
+	return sdk.NewDecCoinFromDec(
+		k.GetMinGasPrice(ctx).Denom,
+		latest.Sub(previous).QuoInt64(int64(blocks)),
+	), blocks, nil
+}
+
+// TrackGasPriceHistory stores the minimum gas price required in the current block and the gas tracked in it. The
+// records older than the number of blocks set by the params are pruned.
+func (k Keeper) TrackGasPriceHistory(ctx sdk.Context, minGasPrice sdk.DecCoin, blockGas int64) {
+	height := ctx.BlockHeight()
+	retention := int64(k.GetParams(ctx).GasPriceHistoryBlocks)
+	store := ctx.KVStore(k.storeKey)
+
+	if retention > 0 {
+		record := types.GasPriceHistoryRecord{
+			Height:      height,
+			MinGasPrice: minGasPrice,
+			BlockGas:    blockGas,
+		}
+		bz, err := record.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(gasPriceHistoryKey(height), bz)
+	}
+
+	// the retention might be decreased by the governance, so all the records out of the window are pruned
+	pruneEndHeight := height - retention + 1
+	if pruneEndHeight <= 0 {
+		return
+	}
+	iterator := store.Iterator(gasPriceHistoryKeyPrefix, gasPriceHistoryKey(pruneEndHeight))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		panic(err)
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetGasPriceHistory returns the records of the gas price history of the recent blocks ordered by the height.
+// There are fewer records than requested if the history is shorter.
+func (k Keeper) GetGasPriceHistory(ctx sdk.Context, blocks uint32) ([]types.GasPriceHistoryRecord, error) {
+	if blocks == 0 || blocks > types.MaxGasPriceHistoryBlocks {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"number of blocks must be between 1 and %d",
+			types.MaxGasPriceHistoryBlocks,
+		)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(gasPriceHistoryKeyPrefix, gasPriceHistoryKey(ctx.BlockHeight()+1))
+	defer iterator.Close()
+
+	records := make([]types.GasPriceHistoryRecord, 0)
+	for ; iterator.Valid() && len(records) < int(blocks); iterator.Next() {
+		var record types.GasPriceHistoryRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+
+	return records, nil
+}
+
+// GetRecommendedGasPrice returns the low, medium and high gas prices expected to be accepted by the network in the
+// next blocks. The prices are projected from the current state of the model for the empty blocks, the blocks using the
+// gas of the short EMA and the full blocks. The highest price of the projected blocks is taken for each of them.
+func (k Keeper) GetRecommendedGasPrice(ctx sdk.Context, afterBlocks uint32) (low, medium, high sdk.DecCoin, err error) {
+	if afterBlocks == 0 || afterBlocks > types.MaxRecommendedGasPriceAfterBlocks {
+		return sdk.DecCoin{}, sdk.DecCoin{}, sdk.DecCoin{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"number of blocks must be between 1 and %d",
+			types.MaxRecommendedGasPriceAfterBlocks,
+		)
+	}
+
+	params := k.GetParams(ctx)
+	minGasPrice := k.GetMinGasPrice(ctx)
+	shortEMA := k.GetShortEMAGas(ctx)
+
+	prices := make([]sdk.Dec, 0, 3)
+	for _, blockGas := range []int64{0, shortEMA, params.Model.MaxBlockGas} {
+		prices = append(prices, k.projectMinGasPrice(ctx, params, minGasPrice, blockGas, afterBlocks))
+	}
+	medium = sdk.NewDecCoinFromDec(minGasPrice.Denom, prices[1])
+	low, high = medium, medium
+	for _, price := range prices {
+		if price.LT(low.Amount) {
+			low = sdk.NewDecCoinFromDec(minGasPrice.Denom, price)
+		}
+		if price.GT(high.Amount) {
+			high = sdk.NewDecCoinFromDec(minGasPrice.Denom, price)
+		}
+	}
+
+	return low, medium, high, nil
+}
+
+// projectMinGasPrice returns the highest minimum gas price required in the next blocks if each of them uses the block
+// gas. The price of the first block is the current one, the prices of the following ones are computed the same way
+// the end blocker does.
+func (k Keeper) projectMinGasPrice(
+	ctx sdk.Context,
+	params types.Params,
+	minGasPrice sdk.DecCoin,
+	blockGas int64,
+	afterBlocks uint32,
+) sdk.Dec {
+	model := types.NewModel(params.Model)
+	gasPriceFloor, hasFloor := k.GetGasPriceFloor(ctx, minGasPrice.Denom)
+	shortEMA := k.GetShortEMAGas(ctx)
+	longEMA := k.GetLongEMAGas(ctx)
+
+	maxPrice := minGasPrice.Amount
+	for i := uint32(1); i < afterBlocks; i++ {
+		shortEMA = types.CalculateEMA(shortEMA, blockGas, params.Model.ShortEmaBlockLength)
+		longEMA = types.CalculateEMA(longEMA, blockGas, params.Model.LongEmaBlockLength)
+		price := model.CalculateNextGasPrice(shortEMA, longEMA)
+		if hasFloor {
+			price = model.ApplyGasPriceFloor(price, gasPriceFloor)
+		}
+		maxPrice = sdk.MaxDec(maxPrice, price)
+	}
+
+	return maxPrice
+}
+
+func (k Keeper) getCumulativeMinGasPrice(ctx sdk.Context, height int64) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(cumulativeMinGasPriceKey(height))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+
+	var cumulative sdk.Dec
+	if err := cumulative.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return cumulative, true
+}
+
+// CalculateSurcharge returns the surcharge of the messages denominated in the denom of the minimum gas price.
+func (k Keeper) CalculateSurcharge(ctx sdk.Context, msgs []sdk.Msg) sdk.Int {
+	return k.GetParams(ctx).CalculateSurcharge(msgs)
+}
+
+// GetGasPriceFloor returns the gas price floor in the denom computed using the price oracle.
+// False is returned if the floor is disabled or the price of the denom is not available.
+func (k Keeper) GetGasPriceFloor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	oracleParams := k.GetParams(ctx).Oracle
+	if !oracleParams.Enabled || k.priceOracle == nil {
+		return sdk.Dec{}, false
+	}
+
+	priceUSD, ok := k.priceOracle.GetPriceUSD(ctx, denom)
+	if !ok || priceUSD.IsNil() || !priceUSD.IsPositive() {
+		return sdk.Dec{}, false
+	}
+
+	return oracleParams.CalculateGasPriceFloor(priceUSD), true
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/log"
@@ -27,9 +28,11 @@ type paramSubspaceMock struct {
 	params map[string][]byte
 }
 
-func (psm *paramSubspaceMock) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+func (psm *paramSubspaceMock) GetParamSetIfExists(ctx sdk.Context, ps paramtypes.ParamSet) {
 	for _, pair := range ps.ParamSetPairs() {
-		must.OK(json.Unmarshal(psm.params[string(pair.Key)], pair.Value))
+		if bz, ok := psm.params[string(pair.Key)]; ok {
+			must.OK(json.Unmarshal(bz, pair.Value))
+		}
 	}
 }
 
@@ -39,7 +42,20 @@ func (psm *paramSubspaceMock) SetParamSet(ctx sdk.Context, ps paramtypes.ParamSe
 	}
 }
 
+type priceOracleMock struct {
+	prices map[string]sdk.Dec
+}
+
+func (pom priceOracleMock) GetPriceUSD(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	price, ok := pom.prices[denom]
+	return price, ok
+}
+
 func setup() (sdk.Context, keeper.Keeper) {
+	return setupWithBankKeeper(nil)
+}
+
+func setupWithBankKeeper(bankKeeper types.BankKeeper) (sdk.Context, keeper.Keeper) {
 	key := sdk.NewKVStoreKey(types.StoreKey)
 	tKey := sdk.NewTransientStoreKey(types.TransientStoreKey)
 
@@ -50,7 +66,7 @@ func setup() (sdk.Context, keeper.Keeper) {
 	must.OK(cms.LoadLatestVersion())
 	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
 
-	return ctx, keeper.NewKeeper(newParamSubspaceMock(), key, tKey)
+	return ctx, keeper.NewKeeper(newParamSubspaceMock(), key, tKey, bankKeeper)
 }
 
 func TestTrackGas(t *testing.T) {
@@ -83,6 +99,15 @@ func TestLongEMAGas(t *testing.T) {
 	assert.EqualValues(t, 10, keeper.GetLongEMAGas(ctx))
 }
 
+func TestLastBlockGas(t *testing.T) {
+	ctx, keeper := setup()
+
+	assert.EqualValues(t, 0, keeper.GetLastBlockGas(ctx))
+
+	keeper.SetLastBlockGas(ctx, 10)
+	assert.EqualValues(t, 10, keeper.GetLastBlockGas(ctx))
+}
+
 func TestMinGasPrice(t *testing.T) {
 	ctx, keeper := setup()
 
@@ -111,4 +136,236 @@ func TestParams(t *testing.T) {
 	assert.Equal(t, defParams.Model.MaxBlockGas, params.Model.MaxBlockGas)
 	assert.Equal(t, defParams.Model.ShortEmaBlockLength, params.Model.ShortEmaBlockLength)
 	assert.Equal(t, defParams.Model.LongEmaBlockLength, params.Model.LongEmaBlockLength)
+	assert.Equal(t, defParams.Oracle.Enabled, params.Oracle.Enabled)
+	assert.Equal(t, defParams.Oracle.MinGasPriceUSD.String(), params.Oracle.MinGasPriceUSD.String())
+}
+
+func TestParamsWithoutOracle(t *testing.T) {
+	ctx, _ := setup()
+
+	// simulate the store of the chain started before the oracle params were introduced
+	paramSubspace := newParamSubspaceMock()
+	paramSubspace.params[string(types.KeyModel)] = must.Bytes(json.Marshal(types.DefaultParams().Model))
+
+	params := keeper.NewKeeper(paramSubspace, nil, nil, nil).GetParams(ctx)
+	assert.Equal(t, types.DefaultParams().Model.InitialGasPrice.String(), params.Model.InitialGasPrice.String())
+	assert.False(t, params.Oracle.Enabled)
+}
+
+func TestGasPriceFloor(t *testing.T) {
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+
+	// price oracle is not set
+	_, ok := keeper.GetGasPriceFloor(ctx, "coin")
+	assert.False(t, ok)
+
+	keeper.SetPriceOracle(priceOracleMock{
+		prices: map[string]sdk.Dec{
+			"coin":  sdk.MustNewDecFromStr("0.5"),
+			"coin2": sdk.ZeroDec(),
+		},
+	})
+
+	// gas price floor is disabled
+	_, ok = keeper.GetGasPriceFloor(ctx, "coin")
+	assert.False(t, ok)
+
+	params.Oracle = types.OracleParams{
+		Enabled:        true,
+		MinGasPriceUSD: sdk.MustNewDecFromStr("0.01"),
+	}
+	keeper.SetParams(ctx, params)
+
+	gasPriceFloor, ok := keeper.GetGasPriceFloor(ctx, "coin")
+	assert.True(t, ok)
+	assert.Equal(t, "0.020000000000000000", gasPriceFloor.String())
+
+	// invalid price
+	_, ok = keeper.GetGasPriceFloor(ctx, "coin2")
+	assert.False(t, ok)
+
+	// price is not available
+	_, ok = keeper.GetGasPriceFloor(ctx, "coin3")
+	assert.False(t, ok)
+}
+
+func TestAverageMinGasPrice(t *testing.T) {
+	ctx, keeper := setup()
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(1)))
+
+	// history is empty
+	_, _, err := keeper.GetAverageMinGasPrice(ctx, 1)
+	assert.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	for height, price := range []int64{10, 20, 30, 40} {
+		ctx = ctx.WithBlockHeight(int64(height) + 5)
+		keeper.TrackMinGasPrice(ctx, sdk.NewDec(price))
+	}
+
+	averageMinGasPrice, blocks, err := keeper.GetAverageMinGasPrice(ctx, 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, blocks)
+	assert.Equal(t, sdk.NewDecCoin("coin", sdk.NewInt(40)), averageMinGasPrice)
+
+	averageMinGasPrice, blocks, err = keeper.GetAverageMinGasPrice(ctx, 3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, blocks)
+	assert.Equal(t, sdk.NewDecCoin("coin", sdk.NewInt(30)), averageMinGasPrice)
+
+	// the average is computed over the whole history if it's shorter than requested
+	averageMinGasPrice, blocks, err = keeper.GetAverageMinGasPrice(ctx, 100)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, blocks)
+	assert.Equal(t, sdk.NewDecCoin("coin", sdk.NewInt(25)), averageMinGasPrice)
+
+	// the history restarts if the previous block hasn't been tracked
+	ctx = ctx.WithBlockHeight(20)
+	keeper.TrackMinGasPrice(ctx, sdk.NewDec(5))
+	averageMinGasPrice, blocks, err = keeper.GetAverageMinGasPrice(ctx, 100)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, blocks)
+	assert.Equal(t, sdk.NewDecCoin("coin", sdk.NewInt(5)), averageMinGasPrice)
+
+	// invalid number of blocks
+	_, _, err = keeper.GetAverageMinGasPrice(ctx, 0)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, _, err = keeper.GetAverageMinGasPrice(ctx, types.MaxAverageMinGasPriceBlocks+1)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestAverageMinGasPricePruning(t *testing.T) {
+	ctx, keeper := setup()
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(1)))
+
+	for height := int64(1); height <= types.MaxAverageMinGasPriceBlocks+2; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.TrackMinGasPrice(ctx, sdk.NewDec(height))
+	}
+
+	// the average over the whole window is still available after the oldest records are pruned
+	averageMinGasPrice, blocks, err := keeper.GetAverageMinGasPrice(ctx, types.MaxAverageMinGasPriceBlocks)
+	assert.NoError(t, err)
+	assert.EqualValues(t, types.MaxAverageMinGasPriceBlocks, blocks)
+	// average of the heights from 3 to MaxAverageMinGasPriceBlocks+2
+	expectedAverage := sdk.NewDec(types.MaxAverageMinGasPriceBlocks + 5).QuoInt64(2)
+	assert.Equal(t, sdk.NewDecCoinFromDec("coin", expectedAverage), averageMinGasPrice)
+}
+
+func TestGasPriceHistory(t *testing.T) {
+	ctx, keeper := setup()
+	params := types.DefaultParams()
+	params.GasPriceHistoryBlocks = 3
+	keeper.SetParams(ctx, params)
+
+	// history is empty
+	records, err := keeper.GetGasPriceHistory(ctx, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	for height := int64(1); height <= 4; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.TrackGasPriceHistory(ctx, sdk.NewDecCoin("coin", sdk.NewInt(height*10)), height*100)
+	}
+
+	record := func(height int64) types.GasPriceHistoryRecord {
+		return types.GasPriceHistoryRecord{
+			Height:      height,
+			MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(height*10)),
+			BlockGas:    height * 100,
+		}
+	}
+
+	records, err = keeper.GetGasPriceHistory(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.GasPriceHistoryRecord{record(3), record(4)}, records)
+
+	// the records older than the retention are pruned
+	records, err = keeper.GetGasPriceHistory(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.GasPriceHistoryRecord{record(2), record(3), record(4)}, records)
+
+	// the decreased retention prunes all the records out of the window
+	params.GasPriceHistoryBlocks = 1
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(5)
+	keeper.TrackGasPriceHistory(ctx, sdk.NewDecCoin("coin", sdk.NewInt(50)), 500)
+	records, err = keeper.GetGasPriceHistory(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.GasPriceHistoryRecord{record(5)}, records)
+
+	// the history is not kept if the retention is zero
+	params.GasPriceHistoryBlocks = 0
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(6)
+	keeper.TrackGasPriceHistory(ctx, sdk.NewDecCoin("coin", sdk.NewInt(60)), 600)
+	records, err = keeper.GetGasPriceHistory(ctx, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	// invalid number of blocks
+	_, err = keeper.GetGasPriceHistory(ctx, 0)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = keeper.GetGasPriceHistory(ctx, types.MaxGasPriceHistoryBlocks+1)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestRecommendedGasPrice(t *testing.T) {
+	ctx, keeper := setup()
+
+	params := types.DefaultParams()
+	keeper.SetParams(ctx, params)
+	model := types.NewModel(params.Model)
+
+	// the load of the recent blocks is stable, so the max discount is applied
+	gasPriceWithMaxDiscount := model.CalculateGasPriceWithMaxDiscount()
+	keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec("coin", gasPriceWithMaxDiscount))
+	keeper.SetShortEMAGas(ctx, params.Model.MaxBlockGas/10)
+	keeper.SetLongEMAGas(ctx, params.Model.MaxBlockGas/10)
+
+	// the current price is required in the next block
+	low, medium, high, err := keeper.GetRecommendedGasPrice(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, gasPriceWithMaxDiscount.String(), low.Amount.String())
+	assert.Equal(t, gasPriceWithMaxDiscount.String(), medium.Amount.String())
+	assert.Equal(t, gasPriceWithMaxDiscount.String(), high.Amount.String())
+
+	// the price doesn't change if the load stays the same, the full blocks trigger the escalation and the empty blocks
+	// reduce the discount
+	low, medium, high, err = keeper.GetRecommendedGasPrice(ctx, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, "coin", medium.Denom)
+	assert.Equal(t, gasPriceWithMaxDiscount.String(), low.Amount.String())
+	assert.Equal(t, gasPriceWithMaxDiscount.String(), medium.Amount.String())
+	assert.True(t, high.Amount.GT(params.Model.InitialGasPrice))
+	assert.True(t, high.Amount.LTE(model.CalculateMaxGasPrice()))
+
+	// the state is not changed by the projection
+	assert.Equal(t, params.Model.MaxBlockGas/10, keeper.GetShortEMAGas(ctx))
+	assert.Equal(t, params.Model.MaxBlockGas/10, keeper.GetLongEMAGas(ctx))
+
+	// the gas price floor is applied to the projected blocks
+	keeper.SetPriceOracle(priceOracleMock{
+		prices: map[string]sdk.Dec{
+			"coin": sdk.MustNewDecFromStr("0.000001"),
+		},
+	})
+	params.Oracle = types.OracleParams{
+		Enabled:        true,
+		MinGasPriceUSD: sdk.MustNewDecFromStr("0.00001"),
+	}
+	keeper.SetParams(ctx, params)
+	gasPriceFloor, ok := keeper.GetGasPriceFloor(ctx, "coin")
+	assert.True(t, ok)
+	low, _, _, err = keeper.GetRecommendedGasPrice(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, model.ApplyGasPriceFloor(gasPriceWithMaxDiscount, gasPriceFloor).String(), low.Amount.String())
+
+	// invalid number of blocks
+	_, _, _, err = keeper.GetRecommendedGasPrice(ctx, 0)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, _, _, err = keeper.GetRecommendedGasPrice(ctx, types.MaxRecommendedGasPriceAfterBlocks+1)
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
 }
@@ -1,8 +1,27 @@
 package keeper
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 var (
 	gasTrackingKey = []byte{0x00}
 	gasPriceKey    = []byte{0x01}
 	shortEMAGasKey = []byte{0x02}
 	longEMAGasKey  = []byte{0x03}
+
+	minGasPriceHistoryStartKey     = []byte{0x04}
+	cumulativeMinGasPriceKeyPrefix = []byte{0x05}
+	lastBlockGasKey                = []byte{0x06}
+
+	// txGasFeeKey is stored in the transient store.
+	txGasFeeKey = []byte{0x07}
+
+	gasPriceHistoryKeyPrefix = []byte{0x08}
 )
+
+func cumulativeMinGasPriceKey(height int64) []byte {
+	return append(append([]byte{}, cumulativeMinGasPriceKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+func gasPriceHistoryKey(height int64) []byte {
+	return append(append([]byte{}, gasPriceHistoryKeyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
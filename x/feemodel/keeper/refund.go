@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+// SetTxGasFee records the fee paid for the gas declared by the transaction being executed, so the fee of the gas not
+// used might be refunded if the transaction fails. Nothing is recorded if the refund is disabled.
+func (k Keeper) SetTxGasFee(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Int, gasLimit uint64) {
+	if !k.GetParams(ctx).RefundFailedTxGas {
+		return
+	}
+
+	bz, err := (&types.TxGasFee{
+		Payer:    payer.String(),
+		Amount:   amount,
+		GasLimit: gasLimit,
+	}).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.TransientStore(k.transientStoreKey).Set(txGasFeeKey, bz)
+}
+
+// RefundTxGas is called once the transaction is executed. If the transaction failed, the fee of the gas it hasn't used
+// is sent back from the fee collector to the account which paid it. The record of the fee is removed in any case,
+// even if the refund fails, so it is never applied to the next transaction.
+func (k Keeper) RefundTxGas(ctx sdk.Context, txFailed bool, gasUsed uint64) error {
+	tStore := ctx.TransientStore(k.transientStoreKey)
+	bz := tStore.Get(txGasFeeKey)
+	if bz == nil {
+		return nil
+	}
+	tStore.Delete(txGasFeeKey)
+
+	if !txFailed {
+		return nil
+	}
+
+	var gasFee types.TxGasFee
+	if err := gasFee.Unmarshal(bz); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "can't unmarshal the gas fee of the transaction: %s", err)
+	}
+
+	refund := k.GetParams(ctx).CalculateGasRefund(gasFee.Amount, gasFee.GasLimit, gasUsed)
+	if !refund.IsPositive() {
+		return nil
+	}
+
+	payer, err := sdk.AccAddressFromBech32(gasFee.Payer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer of the gas fee: %s", err)
+	}
+
+	// the refund is applied as a whole or not at all
+	cacheCtx, writeCache := ctx.CacheContext()
+	refundCoin := sdk.NewCoin(k.GetMinGasPrice(ctx).Denom, refund)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, authtypes.FeeCollectorName, payer, sdk.NewCoins(refundCoin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't refund the gas fee to %s", payer)
+	}
+
+	if err := cacheCtx.EventManager().EmitTypedEvent(&types.EventFailedTxGasRefunded{
+		Payer:     gasFee.Payer,
+		Amount:    refundCoin,
+		GasWanted: gasFee.GasLimit,
+		GasUsed:   gasUsed,
+	}); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't emit event EventFailedTxGasRefunded: %s", err)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	if refund.IsInt64() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "refunded_gas_fees"},
+			float32(refund.Int64()),
+			[]metrics.Label{telemetry.NewLabel("denom", refundCoin.Denom)},
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/feemodel/types"
+)
+
+func TestRefundTxGas(t *testing.T) {
+	requireT := require.New(t)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	payer := sdk.AccAddress("payer")
+	bankKeeper := &bankKeeperMock{
+		balances: map[string]sdk.Coins{
+			feeCollector: sdk.NewCoins(sdk.NewInt64Coin("coin", 10000)),
+		},
+	}
+	ctx, feeKeeper := setupWithBankKeeper(bankKeeper)
+	feeKeeper.SetMinGasPrice(ctx, sdk.NewDecCoin("coin", sdk.NewInt(10)))
+
+	// nothing is recorded and refunded if the refund is disabled
+	feeKeeper.SetParams(ctx, types.DefaultParams())
+	feeKeeper.SetTxGasFee(ctx, payer, sdk.NewInt(1000), 100)
+	requireT.NoError(feeKeeper.RefundTxGas(ctx, true, 40))
+	assert.True(t, bankKeeper.balances[payer.String()].IsZero())
+
+	params := types.DefaultParams()
+	params.RefundFailedTxGas = true
+	feeKeeper.SetParams(ctx, params)
+
+	// nothing is refunded to the successful transaction and the record is removed
+	feeKeeper.SetTxGasFee(ctx, payer, sdk.NewInt(1000), 100)
+	requireT.NoError(feeKeeper.RefundTxGas(ctx, false, 40))
+	requireT.NoError(feeKeeper.RefundTxGas(ctx, true, 40))
+	assert.True(t, bankKeeper.balances[payer.String()].IsZero())
+
+	// the fee of the unused gas is refunded to the failed transaction once
+	feeKeeper.SetTxGasFee(ctx, payer, sdk.NewInt(1000), 100)
+	requireT.NoError(feeKeeper.RefundTxGas(ctx, true, 40))
+	requireT.NoError(feeKeeper.RefundTxGas(ctx, true, 40))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("coin", 600)).String(), bankKeeper.balances[payer.String()].String())
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("coin", 9400)).String(), bankKeeper.balances[feeCollector].String())
+
+	events := ctx.EventManager().Events()
+	requireT.Len(events, 1)
+	assert.Equal(t, "coreum.feemodel.v1.EventFailedTxGasRefunded", events[0].Type)
+}
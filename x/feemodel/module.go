@@ -37,8 +37,17 @@ type Keeper interface {
 	SetShortEMAGas(ctx sdk.Context, emaGas int64)
 	GetLongEMAGas(ctx sdk.Context) int64
 	SetLongEMAGas(ctx sdk.Context, emaGas int64)
+	GetLastBlockGas(ctx sdk.Context) int64
+	SetLastBlockGas(ctx sdk.Context, gas int64)
 	GetMinGasPrice(ctx sdk.Context) sdk.DecCoin
+	TrackMinGasPrice(ctx sdk.Context, minGasPrice sdk.Dec)
+	GetAverageMinGasPrice(ctx sdk.Context, blocks uint64) (sdk.DecCoin, uint64, error)
+	GetRecommendedGasPrice(ctx sdk.Context, afterBlocks uint32) (low, medium, high sdk.DecCoin, err error)
+	TrackGasPriceHistory(ctx sdk.Context, minGasPrice sdk.DecCoin, blockGas int64)
+	GetGasPriceHistory(ctx sdk.Context, blocks uint32) ([]types.GasPriceHistoryRecord, error)
 	SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin)
+	GetGasPriceFloor(ctx sdk.Context, denom string) (sdk.Dec, bool)
+	BurnFees(ctx sdk.Context) error
 }
 
 // AppModuleBasic defines the basic application module used by the fee module.
@@ -76,7 +85,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd returns the root tx command for the fee module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns no root query command for the fee module.
@@ -153,6 +162,11 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// TODO (wojtek): add simulation tests
+	// the fees are burnt before the min gas price is replaced, so they are burnt in the denom they were collected in
+	if err := am.keeper.BurnFees(ctx); err != nil {
+		panic(err)
+	}
+
 	currentGasUsage := am.keeper.TrackedGas(ctx)
 	params := am.keeper.GetParams(ctx)
 	model := types.NewModel(params.Model)
@@ -164,9 +178,16 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 		params.Model.LongEmaBlockLength)
 
 	newMinGasPrice := model.CalculateNextGasPrice(newShortEMA, newLongEMA)
+	if gasPriceFloor, ok := am.keeper.GetGasPriceFloor(ctx, previousMinGasPrice.Denom); ok {
+		newMinGasPrice = model.ApplyGasPriceFloor(newMinGasPrice, gasPriceFloor)
+	}
 
+	// the price required in the current block is tracked before it's replaced by the price for the next one
+	am.keeper.TrackMinGasPrice(ctx, previousMinGasPrice.Amount)
+	am.keeper.TrackGasPriceHistory(ctx, previousMinGasPrice, currentGasUsage)
 	am.keeper.SetShortEMAGas(ctx, newShortEMA)
 	am.keeper.SetLongEMAGas(ctx, newLongEMA)
+	am.keeper.SetLastBlockGas(ctx, currentGasUsage)
 	am.keeper.SetMinGasPrice(ctx, sdk.NewDecCoinFromDec(previousMinGasPrice.Denom, newMinGasPrice))
 
 	return []abci.ValidatorUpdate{}
@@ -22,7 +22,12 @@ func newKeeperMock(genesisState types.GenesisState) *keeperMock {
 }
 
 type keeperMock struct {
-	state types.GenesisState
+	state              types.GenesisState
+	gasPriceFloor      sdk.Dec
+	trackedMinGasPrice []sdk.Dec
+	gasPriceHistory    []types.GasPriceHistoryRecord
+	lastBlockGas       int64
+	burntFeesDenoms    []string
 }
 
 func (k *keeperMock) TrackedGas(ctx sdk.Context) int64 {
@@ -49,6 +54,14 @@ func (k *keeperMock) GetLongEMAGas(ctx sdk.Context) int64 {
 
 func (k *keeperMock) SetLongEMAGas(ctx sdk.Context, emaGas int64) {}
 
+func (k *keeperMock) GetLastBlockGas(ctx sdk.Context) int64 {
+	return k.lastBlockGas
+}
+
+func (k *keeperMock) SetLastBlockGas(ctx sdk.Context, gas int64) {
+	k.lastBlockGas = gas
+}
+
 func (k *keeperMock) GetMinGasPrice(ctx sdk.Context) sdk.DecCoin {
 	return k.state.MinGasPrice
 }
@@ -57,7 +70,43 @@ func (k *keeperMock) SetMinGasPrice(ctx sdk.Context, minGasPrice sdk.DecCoin) {
 	k.state.MinGasPrice = minGasPrice
 }
 
-func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Codec) {
+func (k *keeperMock) TrackMinGasPrice(ctx sdk.Context, minGasPrice sdk.Dec) {
+	k.trackedMinGasPrice = append(k.trackedMinGasPrice, minGasPrice)
+}
+
+func (k *keeperMock) GetAverageMinGasPrice(ctx sdk.Context, blocks uint64) (sdk.DecCoin, uint64, error) {
+	return k.state.MinGasPrice, blocks, nil
+}
+
+func (k *keeperMock) GetRecommendedGasPrice(ctx sdk.Context, afterBlocks uint32) (sdk.DecCoin, sdk.DecCoin, sdk.DecCoin, error) {
+	return k.state.MinGasPrice, k.state.MinGasPrice, k.state.MinGasPrice, nil
+}
+
+func (k *keeperMock) TrackGasPriceHistory(ctx sdk.Context, minGasPrice sdk.DecCoin, blockGas int64) {
+	k.gasPriceHistory = append(k.gasPriceHistory, types.GasPriceHistoryRecord{
+		Height:      ctx.BlockHeight(),
+		MinGasPrice: minGasPrice,
+		BlockGas:    blockGas,
+	})
+}
+
+func (k *keeperMock) GetGasPriceHistory(ctx sdk.Context, blocks uint32) ([]types.GasPriceHistoryRecord, error) {
+	return k.gasPriceHistory, nil
+}
+
+func (k *keeperMock) GetGasPriceFloor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if k.gasPriceFloor.IsNil() {
+		return sdk.Dec{}, false
+	}
+	return k.gasPriceFloor, true
+}
+
+func (k *keeperMock) BurnFees(ctx sdk.Context) error {
+	k.burntFeesDenoms = append(k.burntFeesDenoms, k.state.MinGasPrice.Denom)
+	return nil
+}
+
+func setup() (feemodel.AppModule, *keeperMock, types.GenesisState, codec.Codec) {
 	genesisState := types.GenesisState{
 		Params: types.Params{
 			Model: types.ModelParams{
@@ -69,6 +118,13 @@ func setup() (feemodel.AppModule, feemodel.Keeper, types.GenesisState, codec.Cod
 				ShortEmaBlockLength:     1,
 				LongEmaBlockLength:      3,
 			},
+			Oracle: types.OracleParams{
+				MinGasPriceUSD: sdk.ZeroDec(),
+			},
+			Surcharges: []types.MsgSurcharge{
+				{MsgTypeURL: "/coreum.asset.nft.v1.MsgIssueClass", Amount: sdk.NewInt(1000)},
+			},
+			FeeBurnRate: sdk.MustNewDecFromStr("0.5"),
 		},
 		MinGasPrice: sdk.NewDecCoin("coin", sdk.NewInt(155)),
 	}
@@ -126,4 +182,40 @@ func TestEndBlock(t *testing.T) {
 	minGasPrice := keeper.GetMinGasPrice(sdk.Context{})
 	assert.True(t, minGasPrice.Amount.Equal(model.CalculateGasPriceWithMaxDiscount()))
 	assert.Equal(t, minGasPrice.Denom, state.MinGasPrice.Denom)
+
+	// the price required in the block is tracked, not the one computed for the next block
+	require.Len(t, keeper.trackedMinGasPrice, 1)
+	assert.True(t, keeper.trackedMinGasPrice[0].Equal(state.MinGasPrice.Amount))
+
+	// the price required in the block and the gas tracked in it are added to the history
+	assert.Equal(t, []types.GasPriceHistoryRecord{{
+		MinGasPrice: state.MinGasPrice,
+		BlockGas:    1,
+	}}, keeper.gasPriceHistory)
+
+	// the fees are burnt once per block
+	assert.Equal(t, []string{state.MinGasPrice.Denom}, keeper.burntFeesDenoms)
+
+	// the gas tracked in the block is stored
+	assert.EqualValues(t, 1, keeper.lastBlockGas)
+}
+
+func TestEndBlockWithGasPriceFloor(t *testing.T) {
+	module, keeper, state, _ := setup()
+	model := types.NewModel(state.Params.Model)
+
+	// gas price floor below the price computed by the model
+	keeper.gasPriceFloor = sdk.NewDec(1)
+	module.EndBlock(sdk.Context{}, abci.RequestEndBlock{})
+	assert.True(t, keeper.GetMinGasPrice(sdk.Context{}).Amount.Equal(model.CalculateGasPriceWithMaxDiscount()))
+
+	// gas price floor above the price computed by the model
+	keeper.gasPriceFloor = sdk.NewDec(100)
+	module.EndBlock(sdk.Context{}, abci.RequestEndBlock{})
+	assert.True(t, keeper.GetMinGasPrice(sdk.Context{}).Amount.Equal(sdk.NewDec(100)))
+
+	// gas price floor above the max gas price
+	keeper.gasPriceFloor = model.CalculateMaxGasPrice().Add(sdk.OneDec())
+	module.EndBlock(sdk.Context{}, abci.RequestEndBlock{})
+	assert.True(t, keeper.GetMinGasPrice(sdk.Context{}).Amount.Equal(model.CalculateMaxGasPrice()))
 }
@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feemodel/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventFeesBurned is emitted in the end block when the share of the collected fees is burnt.
+type EventFeesBurned struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventFeesBurned) Reset()         { *m = EventFeesBurned{} }
+func (m *EventFeesBurned) String() string { return proto.CompactTextString(m) }
+func (*EventFeesBurned) ProtoMessage()    {}
+func (*EventFeesBurned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea6e19e4e6fcbeaf, []int{0}
+}
+
+func (m *EventFeesBurned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventFeesBurned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeesBurned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventFeesBurned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeesBurned.Merge(m, src)
+}
+
+func (m *EventFeesBurned) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventFeesBurned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeesBurned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeesBurned proto.InternalMessageInfo
+
+func (m *EventFeesBurned) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventFailedTxGasRefunded is emitted when the fee of the gas not used by the failed transaction is refunded.
+type EventFailedTxGasRefunded struct {
+	Payer     string     `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	GasWanted uint64     `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed   uint64     `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *EventFailedTxGasRefunded) Reset()         { *m = EventFailedTxGasRefunded{} }
+func (m *EventFailedTxGasRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventFailedTxGasRefunded) ProtoMessage()    {}
+func (*EventFailedTxGasRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea6e19e4e6fcbeaf, []int{1}
+}
+
+func (m *EventFailedTxGasRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventFailedTxGasRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFailedTxGasRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventFailedTxGasRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFailedTxGasRefunded.Merge(m, src)
+}
+
+func (m *EventFailedTxGasRefunded) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventFailedTxGasRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFailedTxGasRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFailedTxGasRefunded proto.InternalMessageInfo
+
+func (m *EventFailedTxGasRefunded) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *EventFailedTxGasRefunded) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventFailedTxGasRefunded) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *EventFailedTxGasRefunded) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventFeesBurned)(nil), "coreum.feemodel.v1.EventFeesBurned")
+	proto.RegisterType((*EventFailedTxGasRefunded)(nil), "coreum.feemodel.v1.EventFailedTxGasRefunded")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/event.proto", fileDescriptor_ea6e19e4e6fcbeaf) }
+
+var fileDescriptor_ea6e19e4e6fcbeaf = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0xf7, 0xf6, 0xf6, 0xda, 0x71, 0x21, 0x84, 0x2e, 0xd2, 0x82, 0x63, 0xe9, 0xaa,
+	0xab, 0x19, 0x62, 0x17, 0xee, 0x5b, 0xac, 0x20, 0xb8, 0x09, 0x8a, 0xe0, 0x46, 0x26, 0x99, 0xd3,
+	0x18, 0x68, 0xe6, 0x94, 0xcc, 0x4c, 0x6c, 0xdf, 0xc2, 0x77, 0xf0, 0x65, 0xba, 0xec, 0xd2, 0x95,
+	0x48, 0xfb, 0x22, 0x92, 0xa4, 0xa2, 0x5b, 0x77, 0x33, 0xe7, 0x3b, 0x7c, 0x07, 0xfe, 0x9f, 0xb2,
+	0x04, 0x0b, 0x70, 0xb9, 0x98, 0x03, 0xe4, 0xa8, 0x60, 0x21, 0xca, 0x50, 0x40, 0x09, 0xda, 0xf2,
+	0x65, 0x81, 0x16, 0x7d, 0xbf, 0xe1, 0xfc, 0x8b, 0xf3, 0x32, 0xec, 0x77, 0x53, 0x4c, 0xb1, 0xc6,
+	0xa2, 0x7a, 0x35, 0x9b, 0x7d, 0x96, 0xa0, 0xc9, 0xd1, 0x88, 0x58, 0x1a, 0x10, 0x65, 0x18, 0x83,
+	0x95, 0xa1, 0x48, 0x30, 0xd3, 0x0d, 0x1f, 0x5e, 0xd3, 0x93, 0xcb, 0x4a, 0x3c, 0x03, 0x30, 0x13,
+	0x57, 0x68, 0x50, 0xfe, 0x05, 0x6d, 0xcb, 0x1c, 0x9d, 0xb6, 0x01, 0x19, 0x90, 0xd1, 0xf1, 0x79,
+	0x8f, 0x37, 0x0e, 0x5e, 0x39, 0xf8, 0xc1, 0xc1, 0xa7, 0x98, 0xe9, 0x49, 0x6b, 0xf3, 0x7e, 0xe6,
+	0x45, 0x87, 0xf5, 0xe1, 0x2b, 0xa1, 0x41, 0x23, 0x93, 0xd9, 0x02, 0xd4, 0xed, 0xea, 0x4a, 0x9a,
+	0x08, 0xe6, 0x4e, 0x2b, 0x50, 0x7e, 0x97, 0xfe, 0x5b, 0xca, 0x35, 0x14, 0xb5, 0xb4, 0x13, 0x35,
+	0x9f, 0x1f, 0xb7, 0xfe, 0xfc, 0xea, 0x96, 0x7f, 0x4a, 0x69, 0x2a, 0xcd, 0xe3, 0xb3, 0xd4, 0x16,
+	0x54, 0xf0, 0x77, 0x40, 0x46, 0xad, 0xa8, 0x93, 0x4a, 0x73, 0x5f, 0x0f, 0xfc, 0x1e, 0x3d, 0xaa,
+	0xb0, 0x33, 0xa0, 0x82, 0x56, 0x0d, 0xff, 0xa7, 0xd2, 0xdc, 0x19, 0x50, 0x93, 0x9b, 0xcd, 0x8e,
+	0x91, 0xed, 0x8e, 0x91, 0x8f, 0x1d, 0x23, 0x2f, 0x7b, 0xe6, 0x6d, 0xf7, 0xcc, 0x7b, 0xdb, 0x33,
+	0xef, 0x61, 0x9c, 0x66, 0xf6, 0xc9, 0xc5, 0x3c, 0xc1, 0x5c, 0x4c, 0xeb, 0x80, 0x67, 0xe8, 0xb4,
+	0x92, 0x36, 0x43, 0x2d, 0x0e, 0x8d, 0xac, 0xbe, 0x3b, 0xb1, 0xeb, 0x25, 0x98, 0xb8, 0x5d, 0xe7,
+	0x38, 0xfe, 0x1c, 0x00, 0xf7, 0x3b, 0x7b, 0x8e, 0xb3, 0x01, 0x00, 0x00,
+}
+
+func (m *EventFeesBurned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeesBurned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeesBurned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFailedTxGasRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFailedTxGasRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFailedTxGasRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *EventFeesBurned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventFailedTxGasRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovEvent(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvent(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *EventFeesBurned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeesBurned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeesBurned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventFailedTxGasRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFailedTxGasRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFailedTxGasRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
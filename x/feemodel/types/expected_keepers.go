@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceOracle defines the expected interface of the oracle providing the prices of the tokens in USD.
+type PriceOracle interface {
+	// GetPriceUSD returns the price of one unit of the denom in USD. False is returned if the price is not available.
+	GetPriceUSD(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
+
+// BankKeeper defines the expected interface of the bank keeper used to burn the share of the collected fees
+// and to refund the fees of the failed transactions.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/feemodel/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasPriceHistoryRecord is the minimum gas price required by the network and the gas tracked by the fee model in
+// the block. The records of the recent blocks are kept in the store for the number of blocks set by the params.
+type GasPriceHistoryRecord struct {
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// min_gas_price is the minimum gas price required by the network in the block.
+	MinGasPrice types.DecCoin `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// block_gas is the gas tracked by the fee model in the block, it is the sum of the gas limits declared by the
+	// transactions included in the block.
+	BlockGas int64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+}
+
+func (m *GasPriceHistoryRecord) Reset()         { *m = GasPriceHistoryRecord{} }
+func (m *GasPriceHistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryRecord) ProtoMessage()    {}
+func (*GasPriceHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff14b79ad4bf116c, []int{0}
+}
+
+func (m *GasPriceHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GasPriceHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GasPriceHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryRecord.Merge(m, src)
+}
+
+func (m *GasPriceHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GasPriceHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryRecord proto.InternalMessageInfo
+
+func (m *GasPriceHistoryRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryRecord) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *GasPriceHistoryRecord) GetBlockGas() int64 {
+	if m != nil {
+		return m.BlockGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasPriceHistoryRecord)(nil), "coreum.feemodel.v1.GasPriceHistoryRecord")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/history.proto", fileDescriptor_ff14b79ad4bf116c) }
+
+var fileDescriptor_ff14b79ad4bf116c = []byte{
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x63, 0x8a, 0x2a, 0x48, 0xc5, 0x12, 0x01, 0x8a, 0x0a, 0x32, 0x11, 0x53, 0x27, 0x5b,
+	0xa1, 0x6f, 0xd0, 0xa2, 0x96, 0x05, 0x09, 0x65, 0x64, 0xa9, 0x1c, 0xc7, 0x24, 0x16, 0xb5, 0x2f,
+	0x8a, 0x9d, 0x88, 0xbe, 0x05, 0x03, 0x0f, 0xd5, 0xb1, 0x23, 0x13, 0x42, 0xc9, 0x8b, 0xa0, 0xfc,
+	0xa9, 0xd8, 0xee, 0xee, 0xfb, 0xee, 0x7e, 0xba, 0xcf, 0x0d, 0x38, 0x14, 0xa2, 0x54, 0xf4, 0x4d,
+	0x08, 0x05, 0x89, 0xd8, 0xd2, 0x2a, 0xa4, 0x99, 0x34, 0x16, 0x8a, 0x1d, 0xc9, 0x0b, 0xb0, 0xe0,
+	0x79, 0xbd, 0x83, 0x1c, 0x1d, 0xa4, 0x0a, 0xa7, 0x97, 0x29, 0xa4, 0xd0, 0xc9, 0xb4, 0xad, 0x7a,
+	0xe7, 0x14, 0x73, 0x30, 0x0a, 0x0c, 0x8d, 0x99, 0x11, 0xb4, 0x0a, 0x63, 0x61, 0x59, 0x48, 0x39,
+	0x48, 0xdd, 0xeb, 0xf7, 0x5f, 0xc8, 0xbd, 0x5a, 0x33, 0xf3, 0x52, 0x48, 0x2e, 0x9e, 0x7a, 0x46,
+	0x24, 0x38, 0x14, 0x89, 0x77, 0xed, 0x8e, 0x33, 0x21, 0xd3, 0xcc, 0xfa, 0x28, 0x40, 0xb3, 0x51,
+	0x34, 0x74, 0xde, 0xca, 0xbd, 0x50, 0x52, 0x6f, 0x52, 0x66, 0x36, 0x79, 0xbb, 0xe5, 0x9f, 0x04,
+	0x68, 0x36, 0x79, 0xb8, 0x25, 0x3d, 0x89, 0xb4, 0x24, 0x32, 0x90, 0xc8, 0xa3, 0xe0, 0x4b, 0x90,
+	0x7a, 0x71, 0xba, 0xff, 0xb9, 0x73, 0xa2, 0x89, 0x92, 0xfa, 0x08, 0xf3, 0x6e, 0xdc, 0xf3, 0x78,
+	0x0b, 0xfc, 0xbd, 0xbd, 0xe4, 0x8f, 0x3a, 0xc4, 0x59, 0x37, 0x58, 0x33, 0xb3, 0x78, 0xde, 0xd7,
+	0x18, 0x1d, 0x6a, 0x8c, 0x7e, 0x6b, 0x8c, 0x3e, 0x1b, 0xec, 0x1c, 0x1a, 0xec, 0x7c, 0x37, 0xd8,
+	0x79, 0x9d, 0xa7, 0xd2, 0x66, 0x65, 0x4c, 0x38, 0x28, 0xba, 0xec, 0x52, 0x58, 0x41, 0xa9, 0x13,
+	0x66, 0x25, 0x68, 0x3a, 0x04, 0xf7, 0xf1, 0x1f, 0x9d, 0xdd, 0xe5, 0xc2, 0xc4, 0xe3, 0xee, 0xd9,
+	0xf9, 0xdf, 0x00, 0x2a, 0x29, 0x9a, 0xfe, 0x5a, 0x01, 0x00, 0x00,
+}
+
+func (m *GasPriceHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGas != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.BlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *GasPriceHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.BlockGas != 0 {
+		n += 1 + sovHistory(uint64(m.BlockGas))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *GasPriceHistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGas", wireType)
+			}
+			m.BlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
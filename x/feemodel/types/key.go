@@ -13,3 +13,15 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// MaxAverageMinGasPriceBlocks is the maximum number of the recent blocks the average minimum gas price might be
+// computed over. The history of the minimum gas prices older than that is pruned.
+const MaxAverageMinGasPriceBlocks = 100_000
+
+// MaxRecommendedGasPriceAfterBlocks is the maximum number of the next blocks the recommended gas price might be
+// projected for.
+const MaxRecommendedGasPriceAfterBlocks = 1000
+
+// MaxGasPriceHistoryBlocks is the maximum number of the recent blocks the history of the minimum gas prices and
+// the block gas might be kept for.
+const MaxGasPriceHistoryBlocks = 100_000
@@ -45,6 +45,14 @@ func (m Model) CalculateNextGasPrice(shortEMA, longEMA int64) sdk.Dec {
 	}
 }
 
+// ApplyGasPriceFloor raises the gas price to the floor. The floor never raises the price above the maximum gas price.
+func (m Model) ApplyGasPriceFloor(gasPrice, floor sdk.Dec) sdk.Dec {
+	if gasPrice.GTE(floor) {
+		return gasPrice
+	}
+	return sdk.MinDec(floor, m.CalculateMaxGasPrice())
+}
+
 // CalculateGasPriceWithMaxDiscount calculates gas price with maximum discount applied
 func (m Model) CalculateGasPriceWithMaxDiscount() sdk.Dec {
 	return m.params.InitialGasPrice.Mul(sdk.OneDec().Sub(m.params.MaxDiscount))
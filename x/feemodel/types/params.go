@@ -1,19 +1,40 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/pkg/errors"
 )
 
-// KeyModel represents the Model param key with which the ModelParams will be stored.
-var KeyModel = []byte("Model")
+var (
+	// KeyModel represents the Model param key with which the ModelParams will be stored.
+	KeyModel = []byte("Model")
+	// KeyOracle represents the Oracle param key with which the OracleParams will be stored.
+	KeyOracle = []byte("Oracle")
+	// KeySurcharges represents the Surcharges param key with which the message surcharges will be stored.
+	KeySurcharges = []byte("Surcharges")
+	// KeyFeeBurnRate represents the FeeBurnRate param key with which the fraction of the burnt fees will be stored.
+	KeyFeeBurnRate = []byte("FeeBurnRate")
+	// KeyRefundFailedTxGas represents the RefundFailedTxGas param key with which the gas refund policy of the failed
+	// transactions will be stored.
+	KeyRefundFailedTxGas = []byte("RefundFailedTxGas")
+	// KeyGasPriceHistoryBlocks represents the GasPriceHistoryBlocks param key with which the retention of the gas price
+	// history will be stored.
+	KeyGasPriceHistoryBlocks = []byte("GasPriceHistoryBlocks")
+)
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // of model's parameters.
 func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyModel, &m.Model, validateModelParams),
+		paramtypes.NewParamSetPair(KeyOracle, &m.Oracle, validateOracleParams),
+		paramtypes.NewParamSetPair(KeySurcharges, &m.Surcharges, validateSurcharges),
+		paramtypes.NewParamSetPair(KeyFeeBurnRate, &m.FeeBurnRate, validateFeeBurnRate),
+		paramtypes.NewParamSetPair(KeyRefundFailedTxGas, &m.RefundFailedTxGas, validateRefundFailedTxGas),
+		paramtypes.NewParamSetPair(KeyGasPriceHistoryBlocks, &m.GasPriceHistoryBlocks, validateGasPriceHistoryBlocks),
 	}
 }
 
@@ -31,12 +52,83 @@ func DefaultParams() Params {
 			ShortEmaBlockLength: 50,
 			LongEmaBlockLength:  1000,
 		},
+		Oracle: OracleParams{
+			Enabled:        false,
+			MinGasPriceUSD: sdk.ZeroDec(),
+		},
+		FeeBurnRate:           sdk.ZeroDec(),
+		GasPriceHistoryBlocks: 1000,
 	}
 }
 
 // ValidateBasic validates parameters of the model.
 func (m Params) ValidateBasic() error {
-	return validateModelParams(m.Model)
+	if err := validateModelParams(m.Model); err != nil {
+		return err
+	}
+	if err := validateOracleParams(m.Oracle); err != nil {
+		return err
+	}
+	if err := validateSurcharges(m.Surcharges); err != nil {
+		return err
+	}
+	if err := validateFeeBurnRate(m.FeeBurnRate); err != nil {
+		return err
+	}
+	if err := validateRefundFailedTxGas(m.RefundFailedTxGas); err != nil {
+		return err
+	}
+	return validateGasPriceHistoryBlocks(m.GasPriceHistoryBlocks)
+}
+
+// CalculateBurntFee returns the part of the collected fee which is burnt. Nothing is burnt if the rate is not set,
+// which is the case on the chain started before the rate was introduced.
+func (m Params) CalculateBurntFee(collectedFee sdk.Int) sdk.Int {
+	if m.FeeBurnRate.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return m.FeeBurnRate.MulInt(collectedFee).TruncateInt()
+}
+
+// CalculateGasRefund returns the part of the fee paid for the declared gas which is refunded because the gas
+// hasn't been used by the failed transaction.
+func (m Params) CalculateGasRefund(gasFee sdk.Int, gasLimit, gasUsed uint64) sdk.Int {
+	if !m.RefundFailedTxGas || gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.ZeroInt()
+	}
+	return gasFee.Mul(sdk.NewIntFromUint64(gasLimit - gasUsed)).Quo(sdk.NewIntFromUint64(gasLimit))
+}
+
+// CalculateSurcharge returns the sum of the surcharges of the messages. The messages executed on behalf of
+// other accounts (e.g. by authz) are charged too.
+func (m Params) CalculateSurcharge(msgs []sdk.Msg) sdk.Int {
+	surcharge := sdk.ZeroInt()
+	if len(m.Surcharges) == 0 {
+		return surcharge
+	}
+
+	amounts := make(map[string]sdk.Int, len(m.Surcharges))
+	for _, s := range m.Surcharges {
+		amounts[s.MsgTypeURL] = s.Amount
+	}
+
+	var calculate func(msgs []sdk.Msg)
+	calculate = func(msgs []sdk.Msg) {
+		for _, msg := range msgs {
+			if amount, ok := amounts[sdk.MsgTypeURL(msg)]; ok {
+				surcharge = surcharge.Add(amount)
+			}
+			if nested, ok := msg.(interface{ GetMessages() ([]sdk.Msg, error) }); ok {
+				// the messages which can't be unpacked are rejected by the message handler anyway
+				if nestedMsgs, err := nested.GetMessages(); err == nil {
+					calculate(nestedMsgs)
+				}
+			}
+		}
+	}
+	calculate(msgs)
+
+	return surcharge
 }
 
 // ValidateBasic validates parameters of the model params.
@@ -91,3 +183,97 @@ func validateModelParams(i interface{}) error {
 
 	return nil
 }
+
+// ValidateBasic validates parameters of the gas price floor.
+func (m OracleParams) ValidateBasic() error {
+	return validateOracleParams(m)
+}
+
+// CalculateGasPriceFloor converts the gas price floor denominated in USD into the denom using the price of
+// the denom in USD.
+func (m OracleParams) CalculateGasPriceFloor(priceUSD sdk.Dec) sdk.Dec {
+	return m.MinGasPriceUSD.Quo(priceUSD)
+}
+
+func validateOracleParams(i interface{}) error {
+	m, ok := i.(OracleParams)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if m.MinGasPriceUSD.IsNil() {
+		if m.Enabled {
+			return errors.New("min gas price in USD is not set")
+		}
+		return nil
+	}
+	if m.MinGasPriceUSD.IsNegative() {
+		return errors.New("min gas price in USD must not be negative")
+	}
+	if m.Enabled && !m.MinGasPriceUSD.IsPositive() {
+		return errors.New("min gas price in USD must be positive if the gas price floor is enabled")
+	}
+
+	return nil
+}
+
+func validateSurcharges(i interface{}) error {
+	surcharges, ok := i.([]MsgSurcharge)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	msgTypeURLs := make(map[string]struct{}, len(surcharges))
+	for _, s := range surcharges {
+		if !strings.HasPrefix(s.MsgTypeURL, "/") || len(s.MsgTypeURL) == 1 {
+			return errors.Errorf("invalid message type URL %q of the surcharge", s.MsgTypeURL)
+		}
+		if _, exists := msgTypeURLs[s.MsgTypeURL]; exists {
+			return errors.Errorf("duplicated surcharge of the message %s", s.MsgTypeURL)
+		}
+		msgTypeURLs[s.MsgTypeURL] = struct{}{}
+
+		if s.Amount.IsNil() || !s.Amount.IsPositive() {
+			return errors.Errorf("surcharge of the message %s must be positive", s.MsgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+func validateFeeBurnRate(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the rate is not set in the genesis of the chain started before it was introduced, nothing is burnt then
+	if rate.IsNil() {
+		return nil
+	}
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return errors.New("fee burn rate must be between 0 and 1")
+	}
+
+	return nil
+}
+
+func validateRefundFailedTxGas(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateGasPriceHistoryBlocks(i interface{}) error {
+	blocks, ok := i.(uint32)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	if blocks > MaxGasPriceHistoryBlocks {
+		return errors.Errorf("gas price history blocks must not be greater than %d", MaxGasPriceHistoryBlocks)
+	}
+
+	return nil
+}
@@ -116,17 +116,137 @@ func (m *ModelParams) GetLongEmaBlockLength() uint32 {
 	return 0
 }
 
+// OracleParams define the params of the gas price floor denominated in USD.
+// If enabled, the price oracle is consulted on each block to convert the floor into the native denom and the minimum gas price
+// computed by the fee model is never set below it. It keeps the minimum fee roughly stable in fiat terms when the price of the native token goes down.
+type OracleParams struct {
+	// enabled defines whether the gas price floor is applied. It is disabled by default and might be enabled by the governance once the price oracle is available.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// min_gas_price_usd is the gas price floor denominated in USD.
+	MinGasPriceUSD github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_gas_price_usd,json=minGasPriceUsd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price_usd" yaml:"min_gas_price_usd"`
+}
+
+func (m *OracleParams) Reset()         { *m = OracleParams{} }
+func (m *OracleParams) String() string { return proto.CompactTextString(m) }
+func (*OracleParams) ProtoMessage()    {}
+func (*OracleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3500559e6fedefd6, []int{1}
+}
+
+func (m *OracleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *OracleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *OracleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleParams.Merge(m, src)
+}
+
+func (m *OracleParams) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *OracleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleParams proto.InternalMessageInfo
+
+func (m *OracleParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSurcharge defines the flat fee charged on top of the gas price for each occurrence of the message in the transaction.
+type MsgSurcharge struct {
+	// msg_type_url is the type URL of the message, e.g. "/coreum.asset.nft.v1.MsgIssueClass".
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// amount is the surcharge denominated in the denom of the minimum gas price.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgSurcharge) Reset()         { *m = MsgSurcharge{} }
+func (m *MsgSurcharge) String() string { return proto.CompactTextString(m) }
+func (*MsgSurcharge) ProtoMessage()    {}
+func (*MsgSurcharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3500559e6fedefd6, []int{2}
+}
+
+func (m *MsgSurcharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MsgSurcharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSurcharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MsgSurcharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSurcharge.Merge(m, src)
+}
+
+func (m *MsgSurcharge) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MsgSurcharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSurcharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSurcharge proto.InternalMessageInfo
+
+func (m *MsgSurcharge) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
 // Params store gov manageable feemodel parameters.
 type Params struct {
 	// model is a fee model params.
 	Model ModelParams `protobuf:"bytes,1,opt,name=model,proto3" json:"model" yaml:"model"`
+	// oracle is a gas price floor params.
+	Oracle OracleParams `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle" yaml:"oracle"`
+	// surcharges is the table of the flat fees charged for the heavy messages on top of the gas price.
+	Surcharges []MsgSurcharge `protobuf:"bytes,3,rep,name=surcharges,proto3" json:"surcharges" yaml:"surcharges"`
+	// fee_burn_rate is the fraction of the transaction fees collected in the block which is burnt, the rest is distributed to the validators and the delegators. Nothing is burnt if it is zero.
+	FeeBurnRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_burn_rate,json=feeBurnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_burn_rate" yaml:"fee_burn_rate"`
+	// refund_failed_tx_gas defines whether the fee of the gas declared but not used by the failed transaction is refunded to the account paying the fee.
+	// If it is false the failed transaction pays for the full declared gas, like the successful one.
+	RefundFailedTxGas bool `protobuf:"varint,5,opt,name=refund_failed_tx_gas,json=refundFailedTxGas,proto3" json:"refund_failed_tx_gas,omitempty" yaml:"refund_failed_tx_gas"`
+	// gas_price_history_blocks is the number of the recent blocks the history of the minimum gas prices and the block gas is kept for.
+	// The history is not kept if it is zero.
+	GasPriceHistoryBlocks uint32 `protobuf:"varint,6,opt,name=gas_price_history_blocks,json=gasPriceHistoryBlocks,proto3" json:"gas_price_history_blocks,omitempty" yaml:"gas_price_history_blocks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3500559e6fedefd6, []int{1}
+	return fileDescriptor_3500559e6fedefd6, []int{3}
 }
 
 func (m *Params) XXX_Unmarshal(b []byte) error {
@@ -167,48 +287,98 @@ func (m *Params) GetModel() ModelParams {
 	return ModelParams{}
 }
 
+func (m *Params) GetOracle() OracleParams {
+	if m != nil {
+		return m.Oracle
+	}
+	return OracleParams{}
+}
+
+func (m *Params) GetSurcharges() []MsgSurcharge {
+	if m != nil {
+		return m.Surcharges
+	}
+	return nil
+}
+
+func (m *Params) GetRefundFailedTxGas() bool {
+	if m != nil {
+		return m.RefundFailedTxGas
+	}
+	return false
+}
+
+func (m *Params) GetGasPriceHistoryBlocks() uint32 {
+	if m != nil {
+		return m.GasPriceHistoryBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ModelParams)(nil), "coreum.feemodel.v1.ModelParams")
+	proto.RegisterType((*OracleParams)(nil), "coreum.feemodel.v1.OracleParams")
+	proto.RegisterType((*MsgSurcharge)(nil), "coreum.feemodel.v1.MsgSurcharge")
 	proto.RegisterType((*Params)(nil), "coreum.feemodel.v1.Params")
 }
 
 func init() { proto.RegisterFile("coreum/feemodel/v1/params.proto", fileDescriptor_3500559e6fedefd6) }
 
 var fileDescriptor_3500559e6fedefd6 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc7, 0x9b, 0xdf, 0x7e, 0x2d, 0xc2, 0xdd, 0x84, 0xf0, 0x3a, 0xc8, 0x26, 0x88, 0x8b, 0x0f,
-	0xa8, 0x17, 0x12, 0x8d, 0xdd, 0x10, 0xa7, 0xb0, 0x3f, 0x12, 0x50, 0x69, 0x64, 0x82, 0x03, 0x97,
-	0xc8, 0x4d, 0xbd, 0x34, 0x5a, 0x1c, 0x57, 0xb1, 0x5b, 0x75, 0x6f, 0x81, 0x03, 0xe2, 0x65, 0xed,
-	0xb8, 0x23, 0xe2, 0x10, 0xa1, 0xf6, 0x1d, 0xe4, 0xc4, 0x11, 0xd9, 0x4e, 0x97, 0xa1, 0x6e, 0x87,
-	0x9e, 0x92, 0xe7, 0x79, 0xbe, 0xfe, 0x7c, 0x6d, 0x3f, 0x7e, 0x00, 0x8a, 0x78, 0x4e, 0x27, 0xcc,
-	0x3b, 0xa7, 0x94, 0xf1, 0x21, 0x4d, 0xbd, 0xe9, 0xbe, 0x37, 0x26, 0x39, 0x61, 0xc2, 0x1d, 0xe7,
-	0x5c, 0x72, 0x08, 0x8d, 0xc0, 0x5d, 0x0a, 0xdc, 0xe9, 0xfe, 0xde, 0x6e, 0xc4, 0x05, 0xe3, 0x22,
-	0xd4, 0x0a, 0xcf, 0x04, 0x46, 0xbe, 0xd7, 0x89, 0x79, 0xcc, 0x4d, 0x5e, 0xfd, 0x99, 0x2c, 0xfe,
-	0xd3, 0x04, 0xed, 0xbe, 0x5a, 0x7d, 0xaa, 0xd1, 0x70, 0x0a, 0x1e, 0x27, 0x59, 0x22, 0x13, 0x92,
-	0x86, 0x31, 0x51, 0x9c, 0x24, 0xa2, 0xb6, 0xd5, 0xb5, 0x7a, 0x0f, 0xfd, 0xf7, 0x57, 0x05, 0x6a,
-	0xfc, 0x2a, 0xd0, 0xcb, 0x38, 0x91, 0xa3, 0xc9, 0xc0, 0x8d, 0x38, 0xab, 0x1c, 0xaa, 0xcf, 0x2b,
-	0x31, 0xbc, 0xf0, 0xe4, 0xe5, 0x98, 0x0a, 0xf7, 0x90, 0x46, 0x65, 0x81, 0xec, 0x4b, 0xc2, 0xd2,
-	0x37, 0x78, 0x05, 0x88, 0x83, 0x47, 0x55, 0xee, 0x84, 0x88, 0x53, 0x95, 0x81, 0xdf, 0x2c, 0x60,
-	0x33, 0x32, 0xab, 0x35, 0x21, 0x9b, 0xa4, 0x32, 0x19, 0xa7, 0x09, 0xcd, 0xed, 0xff, 0xb4, 0xff,
-	0xa7, 0xb5, 0xfd, 0x91, 0xf1, 0xbf, 0x8f, 0x8b, 0x83, 0x1d, 0x46, 0x66, 0xcb, 0x2d, 0xf4, 0x6f,
-	0xf2, 0x70, 0x04, 0x36, 0xd5, 0x9a, 0x61, 0x22, 0x22, 0x3e, 0xc9, 0xa4, 0xbd, 0xa1, 0xfd, 0x8f,
-	0xd6, 0xf6, 0xdf, 0xae, 0xfd, 0x97, 0x2c, 0x1c, 0xb4, 0x19, 0x99, 0x1d, 0x56, 0x11, 0xfc, 0x6e,
-	0x81, 0x5d, 0x2a, 0x22, 0x92, 0x12, 0x99, 0xf0, 0x2c, 0x14, 0x92, 0xe4, 0x32, 0x3c, 0xcf, 0x49,
-	0xa4, 0x42, 0xfb, 0x7f, 0xed, 0x1b, 0xac, 0xed, 0xdb, 0x35, 0xbe, 0xf7, 0x82, 0x71, 0xf0, 0xb4,
-	0xae, 0x9d, 0xa9, 0xd2, 0x71, 0x55, 0x81, 0x6f, 0xc1, 0x96, 0xda, 0xee, 0x20, 0xe5, 0xd1, 0x85,
-	0xba, 0x34, 0xbb, 0xd9, 0xb5, 0x7a, 0x1b, 0xbe, 0x5d, 0x16, 0xa8, 0x53, 0x9f, 0xe6, 0xa6, 0x6c,
-	0x8e, 0xe3, 0xab, 0xf0, 0x84, 0x08, 0xf8, 0x05, 0x3c, 0x11, 0x23, 0x9e, 0xcb, 0x90, 0x32, 0x52,
-	0x89, 0x52, 0x9a, 0xc5, 0x72, 0x64, 0xb7, 0xba, 0x56, 0x6f, 0xcb, 0x7f, 0x51, 0x16, 0xe8, 0xb9,
-	0xc1, 0xdc, 0xad, 0xc3, 0xc1, 0xb6, 0x2e, 0x1c, 0x31, 0xa2, 0xa1, 0x1f, 0x75, 0x16, 0x9e, 0x81,
-	0x9d, 0x94, 0x67, 0xf1, 0x2a, 0xf6, 0x81, 0xc6, 0x76, 0xcb, 0x02, 0x3d, 0x33, 0xd8, 0x3b, 0x65,
-	0x38, 0x80, 0x2a, 0xff, 0x2f, 0x14, 0x7f, 0x06, 0xad, 0xea, 0xd1, 0x7f, 0x00, 0x4d, 0x3d, 0x41,
-	0xfa, 0xa1, 0xb7, 0x5f, 0x23, 0x77, 0x75, 0xb2, 0xdc, 0x5b, 0x43, 0xe2, 0x77, 0x54, 0x47, 0xca,
-	0x02, 0x6d, 0x56, 0x37, 0xa2, 0x4a, 0x38, 0x30, 0x0c, 0xbf, 0x7f, 0x35, 0x77, 0xac, 0xeb, 0xb9,
-	0x63, 0xfd, 0x9e, 0x3b, 0xd6, 0x8f, 0x85, 0xd3, 0xb8, 0x5e, 0x38, 0x8d, 0x9f, 0x0b, 0xa7, 0xf1,
-	0xf5, 0xe0, 0x56, 0x03, 0xdf, 0x69, 0x87, 0x63, 0x3e, 0xc9, 0x86, 0xba, 0x0b, 0x5e, 0x35, 0xed,
-	0xb3, 0x7a, 0xde, 0x75, 0x47, 0x07, 0x2d, 0x3d, 0xa7, 0x07, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0xde, 0x9b, 0xa2, 0x0f, 0x04, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0xc7, 0x3b, 0x74, 0x9b, 0x5d, 0x9c, 0xb4, 0xa8, 0xde, 0x16, 0xa6, 0x0b, 0xc4, 0xc1, 0x08,
+	0xd4, 0x0b, 0x48, 0xb4, 0xbb, 0x77, 0x08, 0x09, 0x69, 0xe8, 0xb6, 0x7c, 0x6c, 0xb4, 0xc5, 0xdd,
+	0x05, 0x09, 0x56, 0x1a, 0x39, 0x33, 0xce, 0x64, 0xd8, 0xf1, 0x38, 0xb2, 0x3d, 0x55, 0xfa, 0x04,
+	0x48, 0x5c, 0x20, 0xde, 0x80, 0x57, 0xe0, 0x29, 0xd0, 0x5e, 0xee, 0x25, 0xe2, 0x62, 0x84, 0xd2,
+	0x37, 0xc8, 0x15, 0x97, 0x68, 0x6c, 0xa7, 0x33, 0xab, 0x36, 0x17, 0xb9, 0x4a, 0x7c, 0xce, 0x7f,
+	0x7e, 0xe7, 0xd8, 0x3e, 0xe7, 0x18, 0xa0, 0x48, 0x48, 0x56, 0xf0, 0xc1, 0x98, 0x31, 0x2e, 0x62,
+	0x96, 0x0d, 0xce, 0xef, 0x0f, 0xa6, 0x54, 0x52, 0xae, 0xfa, 0x53, 0x29, 0xb4, 0x80, 0xd0, 0x0a,
+	0xfa, 0x4b, 0x41, 0xff, 0xfc, 0xfe, 0xbd, 0x83, 0x48, 0x28, 0x2e, 0x54, 0x68, 0x14, 0x03, 0xbb,
+	0xb0, 0xf2, 0x7b, 0x7b, 0x89, 0x48, 0x84, 0xb5, 0x57, 0xff, 0xac, 0x15, 0xff, 0xb7, 0x05, 0xda,
+	0xc3, 0xea, 0xeb, 0x53, 0x83, 0x86, 0xe7, 0x60, 0x37, 0xcd, 0x53, 0x9d, 0xd2, 0x2c, 0x4c, 0x68,
+	0xc5, 0x49, 0x23, 0xe6, 0x7b, 0x3d, 0xef, 0xf0, 0xcd, 0xe0, 0x9b, 0x97, 0x25, 0xda, 0xf8, 0xa7,
+	0x44, 0x1f, 0x27, 0xa9, 0x9e, 0x14, 0xa3, 0x7e, 0x24, 0xb8, 0x8b, 0xe0, 0x7e, 0x3e, 0x55, 0xf1,
+	0x8b, 0x81, 0xbe, 0x98, 0x32, 0xd5, 0x3f, 0x62, 0xd1, 0xa2, 0x44, 0xfe, 0x05, 0xe5, 0xd9, 0x67,
+	0xf8, 0x1a, 0x10, 0x93, 0xb7, 0x9c, 0xed, 0x84, 0xaa, 0xd3, 0xca, 0x02, 0x7f, 0xf5, 0x80, 0xcf,
+	0xe9, 0xac, 0xd6, 0x84, 0xbc, 0xc8, 0x74, 0x3a, 0xcd, 0x52, 0x26, 0xfd, 0x37, 0x4c, 0xfc, 0xef,
+	0xd6, 0x8e, 0x8f, 0x6c, 0xfc, 0x55, 0x5c, 0x4c, 0xf6, 0x39, 0x9d, 0x2d, 0x53, 0x18, 0x5e, 0xd9,
+	0xe1, 0x04, 0x74, 0xaa, 0x6f, 0xe2, 0x54, 0x45, 0xa2, 0xc8, 0xb5, 0xbf, 0x69, 0xe2, 0x3f, 0x5a,
+	0x3b, 0xfe, 0xdd, 0x3a, 0xfe, 0x92, 0x85, 0x49, 0x9b, 0xd3, 0xd9, 0x91, 0x5b, 0xc1, 0xdf, 0x3c,
+	0x70, 0xc0, 0x54, 0x44, 0x33, 0xaa, 0x53, 0x91, 0x87, 0x4a, 0x53, 0xa9, 0xc3, 0xb1, 0xa4, 0x51,
+	0xb5, 0xf4, 0x6f, 0x99, 0xb8, 0x64, 0xed, 0xb8, 0x3d, 0x1b, 0x77, 0x25, 0x18, 0x93, 0x77, 0x6a,
+	0xdf, 0x59, 0xe5, 0x3a, 0x76, 0x1e, 0xf8, 0x39, 0xd8, 0xae, 0xd2, 0x1d, 0x65, 0x22, 0x7a, 0x51,
+	0x1d, 0x9a, 0xbf, 0xd5, 0xf3, 0x0e, 0x37, 0x03, 0x7f, 0x51, 0xa2, 0xbd, 0x7a, 0x37, 0x57, 0x6e,
+	0xbb, 0x9d, 0xa0, 0x5a, 0x9e, 0x50, 0x05, 0xbf, 0x07, 0x6f, 0xab, 0x89, 0x90, 0x3a, 0x64, 0x9c,
+	0x3a, 0x51, 0xc6, 0xf2, 0x44, 0x4f, 0xfc, 0x56, 0xcf, 0x3b, 0xdc, 0x0e, 0x3e, 0x58, 0x94, 0xe8,
+	0x7d, 0x8b, 0xb9, 0x59, 0x87, 0xc9, 0x5d, 0xe3, 0x78, 0xc4, 0xa9, 0x81, 0x3e, 0x36, 0x56, 0x78,
+	0x06, 0xf6, 0x33, 0x91, 0x27, 0xd7, 0xb1, 0xb7, 0x0d, 0xb6, 0xb7, 0x28, 0xd1, 0x7b, 0x16, 0x7b,
+	0xa3, 0x0c, 0x13, 0x58, 0xd9, 0x5f, 0x87, 0xe2, 0xbf, 0x3c, 0xd0, 0x79, 0x22, 0x69, 0x94, 0x31,
+	0x57, 0xfb, 0x9f, 0x80, 0xdb, 0x2c, 0xa7, 0xa3, 0x8c, 0xc5, 0xa6, 0xe2, 0xef, 0x04, 0x70, 0x51,
+	0xa2, 0x1d, 0x77, 0x96, 0xd6, 0x81, 0xc9, 0x52, 0x02, 0x7f, 0xf1, 0xc0, 0x2e, 0x4f, 0xf3, 0x46,
+	0x65, 0x15, 0x2a, 0x76, 0xa5, 0xfa, 0x7c, 0xbd, 0x2b, 0x9b, 0x97, 0x68, 0x67, 0x98, 0xe6, 0xcb,
+	0x4a, 0x7c, 0x76, 0x76, 0x54, 0x37, 0xcf, 0xb5, 0x10, 0x98, 0xec, 0xf0, 0x86, 0x56, 0xc5, 0xf8,
+	0x4f, 0x0f, 0x74, 0x86, 0x2a, 0x39, 0x2b, 0x64, 0x34, 0xa1, 0x32, 0x61, 0xf0, 0x04, 0x74, 0xb8,
+	0x4a, 0xc2, 0x8a, 0x1f, 0x16, 0x32, 0x73, 0xfd, 0xfb, 0xd1, 0xbc, 0x44, 0x60, 0xa8, 0x92, 0xa7,
+	0x17, 0x53, 0xf6, 0x8c, 0x3c, 0x6e, 0xd4, 0x67, 0x43, 0x8b, 0x09, 0xe0, 0x4e, 0x22, 0x33, 0xf8,
+	0x03, 0x68, 0x51, 0x6e, 0x5a, 0xc0, 0xee, 0xeb, 0x8b, 0x35, 0xf6, 0xf5, 0x75, 0xae, 0x17, 0x25,
+	0xda, 0xb6, 0x21, 0x2c, 0x05, 0x13, 0x87, 0xc3, 0x7f, 0xdc, 0x02, 0x2d, 0x77, 0xea, 0xdf, 0x82,
+	0x2d, 0x33, 0xbe, 0x4c, 0x96, 0xed, 0x07, 0xa8, 0x7f, 0x7d, 0xac, 0xf5, 0x1b, 0x13, 0x2a, 0xd8,
+	0xab, 0x72, 0x58, 0x94, 0xa8, 0xe3, 0x92, 0xaf, 0x5c, 0x98, 0x58, 0x06, 0x7c, 0x02, 0x5a, 0xc2,
+	0x5c, 0xa9, 0x49, 0xb8, 0xfd, 0xa0, 0x77, 0x13, 0xad, 0x79, 0xe9, 0xc1, 0xbe, 0xc3, 0xb9, 0x44,
+	0xed, 0xd7, 0x98, 0x38, 0x0c, 0xfc, 0x09, 0x00, 0xb5, 0x3c, 0x57, 0xe5, 0x6f, 0xf6, 0x36, 0x57,
+	0x41, 0x9b, 0x17, 0x10, 0x1c, 0x38, 0xe8, 0xae, 0xab, 0xf5, 0x2b, 0x02, 0x26, 0x0d, 0x1c, 0xfc,
+	0x19, 0x6c, 0x8f, 0x19, 0x0b, 0x47, 0x85, 0xcc, 0x43, 0x49, 0x35, 0x73, 0x0d, 0x7f, 0xbc, 0x76,
+	0xc3, 0xbb, 0xd6, 0x7c, 0x0d, 0x86, 0x49, 0x7b, 0xcc, 0x58, 0x50, 0xc8, 0x9c, 0x50, 0xcd, 0xe0,
+	0x29, 0xd8, 0x93, 0x6c, 0x5c, 0xe4, 0x71, 0x38, 0xa6, 0x69, 0xc6, 0xe2, 0x50, 0xcf, 0xae, 0xfa,
+	0xfb, 0x4e, 0x80, 0x16, 0x25, 0x7a, 0xd7, 0x42, 0x6e, 0x52, 0x61, 0xb2, 0x6b, 0xcd, 0xc7, 0xc6,
+	0xfa, 0xb4, 0x1a, 0x9a, 0xf0, 0x39, 0xf0, 0xeb, 0xc2, 0x9c, 0xa4, 0x4a, 0x0b, 0x79, 0x61, 0xdb,
+	0x4e, 0xb9, 0x76, 0xff, 0xb0, 0x9e, 0xc1, 0xab, 0x94, 0x98, 0xec, 0x27, 0xae, 0x94, 0xbf, 0xb2,
+	0x0e, 0xd3, 0xa2, 0x2a, 0x18, 0xbe, 0x9c, 0x77, 0xbd, 0x57, 0xf3, 0xae, 0xf7, 0xef, 0xbc, 0xeb,
+	0xfd, 0x7e, 0xd9, 0xdd, 0x78, 0x75, 0xd9, 0xdd, 0xf8, 0xfb, 0xb2, 0xbb, 0xf1, 0xe3, 0xc3, 0xc6,
+	0xb1, 0x7c, 0x69, 0x2e, 0xe2, 0x58, 0x14, 0x79, 0x6c, 0x86, 0xd9, 0xc0, 0x3d, 0x9a, 0xb3, 0xfa,
+	0xd9, 0x34, 0xe7, 0x34, 0x6a, 0x99, 0xe7, 0xee, 0xe1, 0xff, 0x03, 0x00, 0xc7, 0x6e, 0x13, 0x80,
+	0x56, 0x07, 0x00, 0x00,
 }
 
 func (m *ModelParams) Marshal() (dAtA []byte, err error) {
@@ -289,6 +459,89 @@ func (m *ModelParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPriceUSD.Size()
+		i -= size
+		if _, err := m.MinGasPriceUSD.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSurcharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSurcharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSurcharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -309,6 +562,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasPriceHistoryBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPriceHistoryBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RefundFailedTxGas {
+		i--
+		if m.RefundFailedTxGas {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FeeBurnRate.Size()
+		i -= size
+		if _, err := m.FeeBurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Surcharges) > 0 {
+		for iNdEx := len(m.Surcharges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surcharges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Oracle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Model.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -360,6 +662,35 @@ func (m *ModelParams) Size() (n int) {
 	return n
 }
 
+func (m *OracleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinGasPriceUSD.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MsgSurcharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -368,6 +699,22 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.Model.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Oracle.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Surcharges) > 0 {
+		for _, e := range m.Surcharges {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeBurnRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RefundFailedTxGas {
+		n += 2
+	}
+	if m.GasPriceHistoryBlocks != 0 {
+		n += 1 + sovParams(uint64(m.GasPriceHistoryBlocks))
+	}
 	return n
 }
 
@@ -623,7 +970,7 @@ func (m *ModelParams) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *OracleParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -646,17 +993,37 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceUSD", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -666,22 +1033,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Model.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinGasPriceUSD.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -707,6 +1075,347 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *MsgSurcharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSurcharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSurcharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Model.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Oracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surcharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Surcharges = append(m.Surcharges, MsgSurcharge{})
+			if err := m.Surcharges[len(m.Surcharges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailedTxGas", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailedTxGas = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceHistoryBlocks", wireType)
+			}
+			m.GasPriceHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceHistoryBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
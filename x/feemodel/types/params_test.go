@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +19,10 @@ var params = Params{
 		ShortEmaBlockLength:     10,
 		LongEmaBlockLength:      1000,
 	},
+	Oracle: OracleParams{
+		Enabled:        true,
+		MinGasPriceUSD: sdk.MustNewDecFromStr("0.001"),
+	},
 }
 
 func TestParamsValidation(t *testing.T) {
@@ -53,4 +59,124 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.Model.EscalationStartFraction = sdk.OneDec()
 	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.Oracle.MinGasPriceUSD = sdk.ZeroDec()
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.Oracle.MinGasPriceUSD = sdk.Dec{}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.Oracle.MinGasPriceUSD = sdk.NewDec(-1)
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.Oracle = OracleParams{}
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.Oracle = OracleParams{MinGasPriceUSD: sdk.ZeroDec()}
+	assert.NoError(t, testParams.ValidateBasic())
+}
+
+func TestSurchargesValidation(t *testing.T) {
+	testParams := params
+	testParams.Surcharges = []MsgSurcharge{
+		{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Amount: sdk.NewInt(10)},
+		{MsgTypeURL: "/cosmos.bank.v1beta1.MsgMultiSend", Amount: sdk.NewInt(20)},
+	}
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams.Surcharges = []MsgSurcharge{{MsgTypeURL: "cosmos.bank.v1beta1.MsgSend", Amount: sdk.NewInt(10)}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams.Surcharges = []MsgSurcharge{{MsgTypeURL: "/", Amount: sdk.NewInt(10)}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams.Surcharges = []MsgSurcharge{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Amount: sdk.ZeroInt()}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams.Surcharges = []MsgSurcharge{{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend"}}
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams.Surcharges = []MsgSurcharge{
+		{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Amount: sdk.NewInt(10)},
+		{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Amount: sdk.NewInt(20)},
+	}
+	assert.Error(t, testParams.ValidateBasic())
+}
+
+func TestCalculateSurcharge(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address"))
+	sendMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("ucore", 1)))
+	multiSendMsg := banktypes.NewMsgMultiSend(nil, nil)
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{sendMsg, sendMsg})
+
+	testParams := params
+	assert.Equal(t, sdk.ZeroInt().String(), testParams.CalculateSurcharge([]sdk.Msg{sendMsg}).String())
+
+	testParams.Surcharges = []MsgSurcharge{
+		{MsgTypeURL: sdk.MsgTypeURL(sendMsg), Amount: sdk.NewInt(10)},
+		{MsgTypeURL: sdk.MsgTypeURL(&execMsg), Amount: sdk.NewInt(100)},
+	}
+	assert.Equal(t, sdk.ZeroInt().String(), testParams.CalculateSurcharge([]sdk.Msg{multiSendMsg}).String())
+	assert.Equal(t, sdk.NewInt(20).String(), testParams.CalculateSurcharge([]sdk.Msg{sendMsg, multiSendMsg, sendMsg}).String())
+	// the nested messages are charged too
+	assert.Equal(t, sdk.NewInt(130).String(), testParams.CalculateSurcharge([]sdk.Msg{&execMsg, sendMsg}).String())
+}
+
+func TestFeeBurnRateValidation(t *testing.T) {
+	testParams := params
+	testParams.FeeBurnRate = sdk.MustNewDecFromStr("0.3")
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams.FeeBurnRate = sdk.OneDec()
+	assert.NoError(t, testParams.ValidateBasic())
+
+	// the rate is not set on the chain started before it was introduced
+	testParams.FeeBurnRate = sdk.Dec{}
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams.FeeBurnRate = sdk.MustNewDecFromStr("1.1")
+	assert.Error(t, testParams.ValidateBasic())
+
+	testParams.FeeBurnRate = sdk.MustNewDecFromStr("-0.1")
+	assert.Error(t, testParams.ValidateBasic())
+}
+
+func TestGasPriceHistoryBlocksValidation(t *testing.T) {
+	testParams := params
+	testParams.GasPriceHistoryBlocks = 0
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams.GasPriceHistoryBlocks = MaxGasPriceHistoryBlocks
+	assert.NoError(t, testParams.ValidateBasic())
+
+	testParams.GasPriceHistoryBlocks = MaxGasPriceHistoryBlocks + 1
+	assert.Error(t, testParams.ValidateBasic())
+}
+
+func TestCalculateBurntFee(t *testing.T) {
+	testParams := params
+	assert.Equal(t, sdk.ZeroInt().String(), testParams.CalculateBurntFee(sdk.NewInt(1000)).String())
+
+	testParams.FeeBurnRate = sdk.MustNewDecFromStr("0.3")
+	assert.Equal(t, sdk.NewInt(300).String(), testParams.CalculateBurntFee(sdk.NewInt(1000)).String())
+	// the fraction is never burnt
+	assert.Equal(t, sdk.NewInt(2).String(), testParams.CalculateBurntFee(sdk.NewInt(9)).String())
+}
+
+func TestCalculateGasRefund(t *testing.T) {
+	testParams := params
+	// nothing is refunded if the refund is disabled
+	assert.Equal(t, sdk.ZeroInt().String(), testParams.CalculateGasRefund(sdk.NewInt(1000), 100, 40).String())
+
+	testParams.RefundFailedTxGas = true
+	assert.Equal(t, sdk.NewInt(600).String(), testParams.CalculateGasRefund(sdk.NewInt(1000), 100, 40).String())
+	// the fraction is never refunded
+	assert.Equal(t, sdk.NewInt(6).String(), testParams.CalculateGasRefund(sdk.NewInt(10), 3, 1).String())
+	assert.Equal(t, sdk.ZeroInt().String(), testParams.CalculateGasRefund(sdk.NewInt(1000), 100, 100).String())
+	assert.Equal(t, sdk.ZeroInt().String(), testParams.CalculateGasRefund(sdk.NewInt(1000), 100, 120).String())
 }
@@ -217,337 +217,1803 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryMinGasPriceRequest")
-	proto.RegisterType((*QueryMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.feemodel.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.feemodel.v1.QueryParamsResponse")
+// QueryAverageMinGasPriceRequest is the request type for the Query/AverageMinGasPrice RPC method.
+type QueryAverageMinGasPriceRequest struct {
+	// blocks is the number of the recent blocks the average is computed over.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func init() { proto.RegisterFile("coreum/feemodel/v1/query.proto", fileDescriptor_d2036651e57006ae) }
-
-var fileDescriptor_d2036651e57006ae = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xce, 0xd2, 0x40,
-	0x14, 0xc5, 0x5b, 0xa2, 0x2c, 0x86, 0xb8, 0x19, 0x49, 0xc4, 0x86, 0x0c, 0x5a, 0x13, 0xff, 0x44,
-	0x33, 0x93, 0xc2, 0xc6, 0x35, 0x18, 0x5c, 0x11, 0x91, 0xa5, 0x1b, 0x32, 0x2d, 0x63, 0x9d, 0x84,
-	0xce, 0x2d, 0x9d, 0x96, 0xc8, 0xc2, 0x8d, 0x4f, 0x60, 0xc2, 0xd6, 0x07, 0x62, 0x49, 0xe2, 0xc6,
-	0x95, 0x31, 0xe0, 0x83, 0x98, 0x4e, 0x87, 0xf0, 0x11, 0x20, 0xdf, 0xb7, 0x6b, 0x7a, 0xee, 0x3d,
-	0xe7, 0xd7, 0xd3, 0x8b, 0x48, 0x04, 0x99, 0x28, 0x12, 0xf6, 0x59, 0x88, 0x04, 0x66, 0x62, 0xce,
-	0x96, 0x01, 0x5b, 0x14, 0x22, 0x5b, 0xd1, 0x34, 0x83, 0x1c, 0x30, 0xae, 0x74, 0x7a, 0xd0, 0xe9,
-	0x32, 0xf0, 0x9a, 0x31, 0xc4, 0x60, 0x64, 0x56, 0x3e, 0x55, 0x93, 0x5e, 0x3b, 0x06, 0x88, 0xe7,
-	0x82, 0xf1, 0x54, 0x32, 0xae, 0x14, 0xe4, 0x3c, 0x97, 0xa0, 0xb4, 0x55, 0x49, 0x04, 0x3a, 0x01,
-	0xcd, 0x42, 0xae, 0x05, 0x5b, 0x06, 0xa1, 0xc8, 0x79, 0xc0, 0x22, 0x90, 0xca, 0xea, 0x9d, 0x0b,
-	0x1c, 0x29, 0xcf, 0x78, 0x62, 0x0d, 0xfc, 0xc7, 0xe8, 0xd1, 0xc7, 0x92, 0x6b, 0x24, 0xd5, 0x7b,
-	0xae, 0xc7, 0x99, 0x8c, 0xc4, 0x44, 0x2c, 0x0a, 0xa1, 0x73, 0x3f, 0x44, 0xad, 0x73, 0x49, 0xa7,
-	0xa0, 0xb4, 0xc0, 0x43, 0xf4, 0x20, 0x91, 0x6a, 0x1a, 0x73, 0x3d, 0x4d, 0x4b, 0xa1, 0xe5, 0x3e,
-	0x71, 0x5f, 0x36, 0xba, 0x6d, 0x5a, 0xf1, 0xd0, 0x92, 0x87, 0x5a, 0x1e, 0xfa, 0x4e, 0x44, 0x03,
-	0x90, 0xaa, 0x7f, 0x6f, 0xf3, 0xa7, 0xe3, 0x4c, 0x1a, 0xc9, 0xd1, 0xcf, 0x6f, 0x22, 0x6c, 0x32,
-	0xc6, 0x86, 0xe9, 0x90, 0xfc, 0x01, 0x3d, 0x3c, 0x79, 0x6b, 0x43, 0xdf, 0xa2, 0x7a, 0xc5, 0x6e,
-	0xd3, 0x3c, 0x7a, 0xde, 0x22, 0xad, 0x76, 0x6c, 0x96, 0x9d, 0xef, 0xfe, 0xac, 0xa1, 0xfb, 0xc6,
-	0x11, 0xaf, 0x5d, 0xd4, 0xb8, 0xf1, 0x41, 0xf8, 0xf5, 0x25, 0x8f, 0x2b, 0x8d, 0x78, 0x6f, 0xee,
-	0x36, 0x5c, 0xe1, 0xfa, 0xaf, 0xbe, 0xff, 0xfa, 0xb7, 0xae, 0x3d, 0xc3, 0x4f, 0xd9, 0x85, 0x9f,
-	0x70, 0xd2, 0x1e, 0xfe, 0x86, 0xea, 0x15, 0x37, 0x7e, 0x7e, 0x35, 0xe2, 0xa4, 0x22, 0xef, 0xc5,
-	0xad, 0x73, 0x96, 0xc2, 0x37, 0x14, 0x6d, 0xec, 0xb1, 0xab, 0xa7, 0xd0, 0x1f, 0x6d, 0x76, 0xc4,
-	0xdd, 0xee, 0x88, 0xfb, 0x77, 0x47, 0xdc, 0x1f, 0x7b, 0xe2, 0x6c, 0xf7, 0xc4, 0xf9, 0xbd, 0x27,
-	0xce, 0xa7, 0x5e, 0x2c, 0xf3, 0x2f, 0x45, 0x48, 0x23, 0x48, 0xd8, 0xc0, 0xec, 0x0f, 0xa1, 0x50,
-	0x33, 0x73, 0x83, 0x07, 0xc3, 0xaf, 0x47, 0xcb, 0x7c, 0x95, 0x0a, 0x1d, 0xd6, 0xcd, 0x69, 0xf5,
-	0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x76, 0xcd, 0xe0, 0x05, 0x03, 0x00, 0x00,
+func (m *QueryAverageMinGasPriceRequest) Reset()         { *m = QueryAverageMinGasPriceRequest{} }
+func (m *QueryAverageMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAverageMinGasPriceRequest) ProtoMessage()    {}
+func (*QueryAverageMinGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{4}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryAverageMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// MinGasPrice queries the current minimum gas price required by the network.
-	MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error)
-	// Params queries the parameters of x/feemodel module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryAverageMinGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAverageMinGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAverageMinGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAverageMinGasPriceRequest.Merge(m, src)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAverageMinGasPriceRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (c *queryClient) MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error) {
-	out := new(QueryMinGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/MinGasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAverageMinGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAverageMinGasPriceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAverageMinGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryAverageMinGasPriceRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// MinGasPrice queries the current minimum gas price required by the network.
-	MinGasPrice(context.Context, *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error)
-	// Params queries the parameters of x/feemodel module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryAverageMinGasPriceResponse is the response type for the Query/AverageMinGasPrice RPC method.
+type QueryAverageMinGasPriceResponse struct {
+	// average_min_gas_price is the average of the minimum gas prices required by the network in the recent blocks.
+	AverageMinGasPrice types.DecCoin `protobuf:"bytes,1,opt,name=average_min_gas_price,json=averageMinGasPrice,proto3" json:"average_min_gas_price"`
+	// blocks is the number of blocks the average is computed over. It is lower than requested if the history
+	// of the minimum gas prices is shorter.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct{}
-
-func (*UnimplementedQueryServer) MinGasPrice(ctx context.Context, req *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MinGasPrice not implemented")
+func (m *QueryAverageMinGasPriceResponse) Reset()         { *m = QueryAverageMinGasPriceResponse{} }
+func (m *QueryAverageMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAverageMinGasPriceResponse) ProtoMessage()    {}
+func (*QueryAverageMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{5}
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAverageMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAverageMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAverageMinGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
 
-func _Query_MinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMinGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MinGasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.feemodel.v1.Query/MinGasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MinGasPrice(ctx, req.(*QueryMinGasPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAverageMinGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAverageMinGasPriceResponse.Merge(m, src)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.feemodel.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAverageMinGasPriceResponse) XXX_Size() int {
+	return m.Size()
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.feemodel.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MinGasPrice",
-			Handler:    _Query_MinGasPrice_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "coreum/feemodel/v1/query.proto",
+func (m *QueryAverageMinGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAverageMinGasPriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAverageMinGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryAverageMinGasPriceResponse) GetAverageMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.AverageMinGasPrice
 	}
-	return dAtA[:n], nil
+	return types.DecCoin{}
 }
 
-func (m *QueryMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAverageMinGasPriceResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
 }
 
-func (m *QueryMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryRecommendedGasPriceRequest is the request type for the Query/RecommendedGasPrice RPC method.
+type QueryRecommendedGasPriceRequest struct {
+	// after_blocks is the number of the next blocks the transaction is expected to be included in.
+	AfterBlocks uint32 `protobuf:"varint,1,opt,name=after_blocks,json=afterBlocks,proto3" json:"after_blocks,omitempty"`
 }
 
-func (m *QueryMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRecommendedGasPriceRequest) Reset()         { *m = QueryRecommendedGasPriceRequest{} }
+func (m *QueryRecommendedGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedGasPriceRequest) ProtoMessage()    {}
+func (*QueryRecommendedGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{6}
 }
 
-func (m *QueryMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecommendedGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *QueryMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRecommendedGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRecommendedGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedGasPriceRequest.Merge(m, src)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecommendedGasPriceRequest) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRecommendedGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedGasPriceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecommendedGasPriceRequest proto.InternalMessageInfo
+
+func (m *QueryRecommendedGasPriceRequest) GetAfterBlocks() uint32 {
+	if m != nil {
+		return m.AfterBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRecommendedGasPriceResponse is the response type for the Query/RecommendedGasPrice RPC method. Each price is
+// the highest minimum gas price projected for the next blocks, so the transaction paying it is accepted in any of them
+// if the load of the network follows the projection.
+type QueryRecommendedGasPriceResponse struct {
+	// low is the lowest of the prices projected for the empty blocks, the blocks using the current gas and the full blocks.
+	Low types.DecCoin `protobuf:"bytes,1,opt,name=low,proto3" json:"low"`
+	// medium is the price projected for the blocks using the gas of the short exponential moving average.
+	Medium types.DecCoin `protobuf:"bytes,2,opt,name=medium,proto3" json:"medium"`
+	// high is the highest of the prices projected for the empty blocks, the blocks using the current gas and the full
+	// blocks.
+	High types.DecCoin `protobuf:"bytes,3,opt,name=high,proto3" json:"high"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRecommendedGasPriceResponse) Reset()         { *m = QueryRecommendedGasPriceResponse{} }
+func (m *QueryRecommendedGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedGasPriceResponse) ProtoMessage()    {}
+func (*QueryRecommendedGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{7}
+}
+
+func (m *QueryRecommendedGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryRecommendedGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryRecommendedGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedGasPriceResponse.Merge(m, src)
 }
 
-func (m *QueryMinGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryRecommendedGasPriceResponse) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *QueryMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRecommendedGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedGasPriceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRecommendedGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryRecommendedGasPriceResponse) GetLow() types.DecCoin {
+	if m != nil {
+		return m.Low
 	}
-	var l int
-	_ = l
-	return n
+	return types.DecCoin{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecommendedGasPriceResponse) GetMedium() types.DecCoin {
+	if m != nil {
+		return m.Medium
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return types.DecCoin{}
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRecommendedGasPriceResponse) GetHigh() types.DecCoin {
+	if m != nil {
+		return m.High
+	}
+	return types.DecCoin{}
+}
+
+// QueryGasPriceHistoryRequest is the request type for the Query/GasPriceHistory RPC method.
+type QueryGasPriceHistoryRequest struct {
+	// blocks is the number of the recent blocks the history is returned for.
+	Blocks uint32 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *QueryGasPriceHistoryRequest) Reset()         { *m = QueryGasPriceHistoryRequest{} }
+func (m *QueryGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{8}
+}
+
+func (m *QueryGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceHistoryRequest.Merge(m, src)
+}
+
+func (m *QueryGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryGasPriceHistoryRequest) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// QueryGasPriceHistoryResponse is the response type for the Query/GasPriceHistory RPC method.
+type QueryGasPriceHistoryResponse struct {
+	// records are the records of the recent blocks ordered by the height. There are fewer records than requested if
+	// the history kept by the network is shorter.
+	Records []GasPriceHistoryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryGasPriceHistoryResponse) Reset()         { *m = QueryGasPriceHistoryResponse{} }
+func (m *QueryGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{9}
+}
+
+func (m *QueryGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceHistoryResponse.Merge(m, src)
+}
+
+func (m *QueryGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGasPriceHistoryResponse) GetRecords() []GasPriceHistoryRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// QueryModelStateRequest is the request type for the Query/ModelState RPC method.
+type QueryModelStateRequest struct{}
+
+func (m *QueryModelStateRequest) Reset()         { *m = QueryModelStateRequest{} }
+func (m *QueryModelStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModelStateRequest) ProtoMessage()    {}
+func (*QueryModelStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{10}
+}
+
+func (m *QueryModelStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryModelStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModelStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryModelStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModelStateRequest.Merge(m, src)
+}
+
+func (m *QueryModelStateRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryModelStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModelStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModelStateRequest proto.InternalMessageInfo
+
+// QueryModelStateResponse is the response type for the Query/ModelState RPC method. The values are informational, the
+// minimum gas price is the only output of the model the clients should rely on.
+type QueryModelStateResponse struct {
+	// short_ema_gas is the short exponential moving average of the gas used by the recent blocks.
+	ShortEmaGas int64 `protobuf:"varint,1,opt,name=short_ema_gas,json=shortEmaGas,proto3" json:"short_ema_gas,omitempty"`
+	// long_ema_gas is the long exponential moving average of the gas used by the recent blocks.
+	LongEmaGas int64 `protobuf:"varint,2,opt,name=long_ema_gas,json=longEmaGas,proto3" json:"long_ema_gas,omitempty"`
+	// last_block_gas is the gas tracked by the model in the last block, it is the sum of the gas limits declared by the
+	// transactions included in the block.
+	LastBlockGas int64 `protobuf:"varint,3,opt,name=last_block_gas,json=lastBlockGas,proto3" json:"last_block_gas,omitempty"`
+}
+
+func (m *QueryModelStateResponse) Reset()         { *m = QueryModelStateResponse{} }
+func (m *QueryModelStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModelStateResponse) ProtoMessage()    {}
+func (*QueryModelStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2036651e57006ae, []int{11}
+}
+
+func (m *QueryModelStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryModelStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModelStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryModelStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModelStateResponse.Merge(m, src)
+}
+
+func (m *QueryModelStateResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryModelStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModelStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModelStateResponse proto.InternalMessageInfo
+
+func (m *QueryModelStateResponse) GetShortEmaGas() int64 {
+	if m != nil {
+		return m.ShortEmaGas
+	}
+	return 0
+}
+
+func (m *QueryModelStateResponse) GetLongEmaGas() int64 {
+	if m != nil {
+		return m.LongEmaGas
+	}
+	return 0
+}
+
+func (m *QueryModelStateResponse) GetLastBlockGas() int64 {
+	if m != nil {
+		return m.LastBlockGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryMinGasPriceRequest")
+	proto.RegisterType((*QueryMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryMinGasPriceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.feemodel.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.feemodel.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAverageMinGasPriceRequest)(nil), "coreum.feemodel.v1.QueryAverageMinGasPriceRequest")
+	proto.RegisterType((*QueryAverageMinGasPriceResponse)(nil), "coreum.feemodel.v1.QueryAverageMinGasPriceResponse")
+	proto.RegisterType((*QueryRecommendedGasPriceRequest)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceRequest")
+	proto.RegisterType((*QueryRecommendedGasPriceResponse)(nil), "coreum.feemodel.v1.QueryRecommendedGasPriceResponse")
+	proto.RegisterType((*QueryGasPriceHistoryRequest)(nil), "coreum.feemodel.v1.QueryGasPriceHistoryRequest")
+	proto.RegisterType((*QueryGasPriceHistoryResponse)(nil), "coreum.feemodel.v1.QueryGasPriceHistoryResponse")
+	proto.RegisterType((*QueryModelStateRequest)(nil), "coreum.feemodel.v1.QueryModelStateRequest")
+	proto.RegisterType((*QueryModelStateResponse)(nil), "coreum.feemodel.v1.QueryModelStateResponse")
+}
+
+func init() { proto.RegisterFile("coreum/feemodel/v1/query.proto", fileDescriptor_d2036651e57006ae) }
+
+var fileDescriptor_d2036651e57006ae = []byte{
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0xa4, 0xa4, 0xd2, 0xa4, 0x01, 0x69, 0x5b, 0xda, 0x60, 0x22, 0x27, 0x35, 0x1f,
+	0x6d, 0x29, 0xd8, 0x24, 0x2d, 0xa8, 0xe2, 0x46, 0x5b, 0x5a, 0x38, 0x54, 0x94, 0x20, 0x2e, 0x5c,
+	0xac, 0x8d, 0xb3, 0x75, 0x2c, 0x62, 0x6f, 0xea, 0x75, 0x02, 0x3d, 0x70, 0x41, 0xe2, 0x8a, 0x2a,
+	0xf5, 0x11, 0x78, 0x01, 0x24, 0xae, 0x3c, 0x40, 0x0f, 0x1c, 0x2a, 0x71, 0xe1, 0x84, 0x50, 0xcb,
+	0x83, 0x20, 0xaf, 0x37, 0x5f, 0xb5, 0x2d, 0x52, 0x6e, 0xe9, 0xce, 0xff, 0x3f, 0xf3, 0x9b, 0xed,
+	0xce, 0x24, 0xa0, 0x98, 0xd4, 0x23, 0x1d, 0x47, 0xdf, 0x23, 0xc4, 0xa1, 0x0d, 0xd2, 0xd2, 0xbb,
+	0x15, 0x7d, 0xbf, 0x43, 0xbc, 0x03, 0xad, 0xed, 0x51, 0x9f, 0x22, 0x14, 0xc6, 0xb5, 0x5e, 0x5c,
+	0xeb, 0x56, 0xe4, 0x19, 0x8b, 0x5a, 0x94, 0x87, 0xf5, 0xe0, 0x53, 0xa8, 0x94, 0x8b, 0x16, 0xa5,
+	0x56, 0x8b, 0xe8, 0xb8, 0x6d, 0xeb, 0xd8, 0x75, 0xa9, 0x8f, 0x7d, 0x9b, 0xba, 0x4c, 0x44, 0x15,
+	0x93, 0x32, 0x87, 0x32, 0xbd, 0x8e, 0x19, 0xd1, 0xbb, 0x95, 0x3a, 0xf1, 0x71, 0x45, 0x37, 0xa9,
+	0xed, 0x8a, 0x78, 0x39, 0x86, 0xa3, 0x69, 0x33, 0x9f, 0xf6, 0x48, 0xe4, 0x52, 0x8c, 0xa2, 0x8d,
+	0x3d, 0xec, 0x88, 0x12, 0xea, 0x35, 0x98, 0x7b, 0x11, 0x90, 0xef, 0xd8, 0xee, 0x36, 0x66, 0xbb,
+	0x9e, 0x6d, 0x92, 0x1a, 0xd9, 0xef, 0x10, 0xe6, 0xab, 0x75, 0x28, 0x44, 0x43, 0xac, 0x4d, 0x5d,
+	0x46, 0xd0, 0x16, 0xe4, 0x1d, 0xdb, 0x35, 0x2c, 0xcc, 0x8c, 0x76, 0x10, 0x28, 0x48, 0x65, 0x69,
+	0x31, 0x57, 0x2d, 0x6a, 0x21, 0xb1, 0x16, 0x10, 0x6b, 0x82, 0x58, 0xdb, 0x24, 0xe6, 0x06, 0xb5,
+	0xdd, 0xf5, 0x89, 0xe3, 0x5f, 0xa5, 0x54, 0x2d, 0xe7, 0x0c, 0xf2, 0xa9, 0x33, 0x80, 0x78, 0x8d,
+	0x5d, 0xce, 0xd4, 0xab, 0xfc, 0x1c, 0xa6, 0x47, 0x4e, 0x45, 0xd1, 0x35, 0xc8, 0x86, 0xec, 0xa2,
+	0x9a, 0xac, 0x45, 0xef, 0x59, 0x0b, 0x3d, 0xa2, 0x96, 0xd0, 0xab, 0x6b, 0xa0, 0xf0, 0x84, 0x8f,
+	0xbb, 0xc4, 0xc3, 0x16, 0x89, 0x36, 0x8b, 0x66, 0x21, 0x5b, 0x6f, 0x51, 0xf3, 0x4d, 0x98, 0x7b,
+	0xa2, 0x26, 0xfe, 0x52, 0x0f, 0x25, 0x28, 0x25, 0x5a, 0x05, 0xd7, 0x2b, 0xb8, 0x8a, 0xc3, 0xa8,
+	0xf1, 0xbf, 0x97, 0x82, 0x70, 0x24, 0xfd, 0x10, 0x52, 0x7a, 0x04, 0x69, 0x53, 0x10, 0xd5, 0x88,
+	0x49, 0x1d, 0x87, 0xb8, 0x0d, 0xd2, 0x38, 0xdf, 0xcd, 0x3c, 0x4c, 0xe1, 0x3d, 0x9f, 0x78, 0xc6,
+	0x50, 0x4f, 0xf9, 0x5a, 0x8e, 0x9f, 0xad, 0x87, 0x59, 0xbe, 0x4b, 0x50, 0x4e, 0x4e, 0x23, 0x3a,
+	0x5b, 0x85, 0x4c, 0x8b, 0xbe, 0xbd, 0x40, 0x1f, 0x81, 0x1c, 0x3d, 0x82, 0xac, 0x43, 0x1a, 0x76,
+	0xc7, 0x29, 0xa4, 0xc7, 0x36, 0x0a, 0x07, 0x7a, 0x08, 0x13, 0x4d, 0xdb, 0x6a, 0x16, 0x32, 0x63,
+	0x3b, 0xb9, 0x5e, 0x7d, 0x00, 0xd7, 0x79, 0x37, 0xbd, 0x16, 0x9e, 0x86, 0x63, 0x10, 0xff, 0xef,
+	0xcd, 0xf7, 0xef, 0xd2, 0x86, 0x62, 0xbc, 0x4d, 0x5c, 0xc0, 0x33, 0x98, 0xf4, 0x88, 0x49, 0xbd,
+	0x46, 0x60, 0xcc, 0x2c, 0xe6, 0xaa, 0x4b, 0x71, 0x6f, 0x2e, 0xe2, 0x0e, 0x1c, 0x02, 0xaf, 0xe7,
+	0x57, 0x0b, 0x30, 0x1b, 0x8e, 0x53, 0xe0, 0x7a, 0xe9, 0x63, 0xbf, 0x3f, 0x68, 0x1f, 0x25, 0x98,
+	0x8b, 0x84, 0x04, 0x80, 0x0a, 0x79, 0xd6, 0xa4, 0x9e, 0x6f, 0x10, 0x07, 0x07, 0x2f, 0x8b, 0xf3,
+	0x67, 0x6a, 0x39, 0x7e, 0xf8, 0xc4, 0xc1, 0xdb, 0x98, 0xa1, 0x32, 0x4c, 0xb5, 0xa8, 0x6b, 0xf5,
+	0x25, 0x69, 0x2e, 0x81, 0xe0, 0x4c, 0x28, 0x6e, 0xc2, 0xe5, 0x16, 0x66, 0x7e, 0xf8, 0x1c, 0xb8,
+	0x26, 0xc3, 0x35, 0x53, 0xc1, 0x29, 0x7f, 0x10, 0xdb, 0x98, 0x55, 0xbf, 0x4d, 0xc2, 0x25, 0xce,
+	0x81, 0x8e, 0x24, 0xc8, 0x0d, 0x3f, 0xc5, 0xe5, 0xb8, 0xae, 0x13, 0xf6, 0x86, 0x7c, 0x77, 0x3c,
+	0x71, 0xd8, 0xa0, 0xba, 0xf4, 0xe1, 0xc7, 0x9f, 0xa3, 0xf4, 0x0d, 0x34, 0xaf, 0xc7, 0xac, 0xaa,
+	0x91, 0x71, 0x42, 0xef, 0x21, 0x1b, 0x4e, 0x37, 0xba, 0x9d, 0x58, 0x62, 0x64, 0x91, 0xc8, 0x0b,
+	0xff, 0xd4, 0x09, 0x0a, 0x95, 0x53, 0x14, 0x91, 0xac, 0x27, 0x2e, 0x4c, 0xf4, 0x45, 0x02, 0x14,
+	0xdd, 0x02, 0xa8, 0x9a, 0x58, 0x23, 0x71, 0xdb, 0xc8, 0x2b, 0x17, 0xf2, 0x08, 0xc6, 0x0a, 0x67,
+	0x5c, 0x46, 0x4b, 0x71, 0x8c, 0xb1, 0x0b, 0x08, 0x7d, 0x95, 0x60, 0x3a, 0x66, 0xbe, 0x51, 0x72,
+	0xfd, 0xe4, 0xa5, 0x22, 0xaf, 0x5e, 0xcc, 0x34, 0x0e, 0xb5, 0x37, 0x30, 0x0e, 0x51, 0x7f, 0x96,
+	0xe0, 0xca, 0xb9, 0x91, 0x42, 0x7a, 0x62, 0xf1, 0xf8, 0x89, 0x97, 0xef, 0x8f, 0x6f, 0x10, 0xa4,
+	0xf7, 0x38, 0xe9, 0x02, 0xba, 0x15, 0x47, 0xda, 0xa7, 0x33, 0xc4, 0x17, 0x2c, 0xfa, 0x24, 0x01,
+	0x0c, 0x06, 0x16, 0xdd, 0x49, 0x7e, 0xf5, 0xe7, 0x07, 0x5e, 0x5e, 0x1e, 0x4b, 0x2b, 0xb0, 0x16,
+	0x38, 0xd6, 0x3c, 0x2a, 0xc5, 0x0e, 0x48, 0xf0, 0xc1, 0x60, 0x81, 0x61, 0x7d, 0xe7, 0xf8, 0x54,
+	0x91, 0x4e, 0x4e, 0x15, 0xe9, 0xf7, 0xa9, 0x22, 0x1d, 0x9e, 0x29, 0xa9, 0x93, 0x33, 0x25, 0xf5,
+	0xf3, 0x4c, 0x49, 0xbd, 0x5e, 0xb1, 0x6c, 0xbf, 0xd9, 0xa9, 0x6b, 0x26, 0x75, 0xf4, 0x0d, 0x9e,
+	0x64, 0x8b, 0x76, 0xdc, 0x06, 0xff, 0xad, 0xd1, 0xcb, 0xfa, 0x6e, 0x90, 0xd7, 0x3f, 0x68, 0x13,
+	0x56, 0xcf, 0xf2, 0x1f, 0x08, 0x2b, 0x7f, 0x07, 0x00, 0x80, 0x80, 0x38, 0x48, 0xed, 0x08, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MinGasPrice queries the current minimum gas price required by the network.
+	MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error)
+	// Params queries the parameters of x/feemodel module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AverageMinGasPrice queries the average of the minimum gas prices required by the network in the recent blocks.
+	AverageMinGasPrice(ctx context.Context, in *QueryAverageMinGasPriceRequest, opts ...grpc.CallOption) (*QueryAverageMinGasPriceResponse, error)
+	// RecommendedGasPrice queries the gas prices expected to be accepted by the network in the next blocks, projected
+	// from the current state of the fee model.
+	RecommendedGasPrice(ctx context.Context, in *QueryRecommendedGasPriceRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPriceResponse, error)
+	// GasPriceHistory queries the minimum gas prices required by the network and the gas used in the recent blocks.
+	GasPriceHistory(ctx context.Context, in *QueryGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryGasPriceHistoryResponse, error)
+	// ModelState queries the internal state of the fee model. The state is informational, it is exposed for debugging
+	// and research, and it is not a part of the stable API, so its content might change between the versions.
+	ModelState(ctx context.Context, in *QueryModelStateRequest, opts ...grpc.CallOption) (*QueryModelStateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MinGasPrice(ctx context.Context, in *QueryMinGasPriceRequest, opts ...grpc.CallOption) (*QueryMinGasPriceResponse, error) {
+	out := new(QueryMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/MinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AverageMinGasPrice(ctx context.Context, in *QueryAverageMinGasPriceRequest, opts ...grpc.CallOption) (*QueryAverageMinGasPriceResponse, error) {
+	out := new(QueryAverageMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/AverageMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecommendedGasPrice(ctx context.Context, in *QueryRecommendedGasPriceRequest, opts ...grpc.CallOption) (*QueryRecommendedGasPriceResponse, error) {
+	out := new(QueryRecommendedGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/RecommendedGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPriceHistory(ctx context.Context, in *QueryGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryGasPriceHistoryResponse, error) {
+	out := new(QueryGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/GasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModelState(ctx context.Context, in *QueryModelStateRequest, opts ...grpc.CallOption) (*QueryModelStateResponse, error) {
+	out := new(QueryModelStateResponse)
+	err := c.cc.Invoke(ctx, "/coreum.feemodel.v1.Query/ModelState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MinGasPrice queries the current minimum gas price required by the network.
+	MinGasPrice(context.Context, *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error)
+	// Params queries the parameters of x/feemodel module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AverageMinGasPrice queries the average of the minimum gas prices required by the network in the recent blocks.
+	AverageMinGasPrice(context.Context, *QueryAverageMinGasPriceRequest) (*QueryAverageMinGasPriceResponse, error)
+	// RecommendedGasPrice queries the gas prices expected to be accepted by the network in the next blocks, projected
+	// from the current state of the fee model.
+	RecommendedGasPrice(context.Context, *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error)
+	// GasPriceHistory queries the minimum gas prices required by the network and the gas used in the recent blocks.
+	GasPriceHistory(context.Context, *QueryGasPriceHistoryRequest) (*QueryGasPriceHistoryResponse, error)
+	// ModelState queries the internal state of the fee model. The state is informational, it is exposed for debugging
+	// and research, and it is not a part of the stable API, so its content might change between the versions.
+	ModelState(context.Context, *QueryModelStateRequest) (*QueryModelStateResponse, error)
 }
 
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) MinGasPrice(ctx context.Context, req *QueryMinGasPriceRequest) (*QueryMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPrice not implemented")
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func (*UnimplementedQueryServer) AverageMinGasPrice(ctx context.Context, req *QueryAverageMinGasPriceRequest) (*QueryAverageMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AverageMinGasPrice not implemented")
+}
+
+func (*UnimplementedQueryServer) RecommendedGasPrice(ctx context.Context, req *QueryRecommendedGasPriceRequest) (*QueryRecommendedGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendedGasPrice not implemented")
+}
+
+func (*UnimplementedQueryServer) GasPriceHistory(ctx context.Context, req *QueryGasPriceHistoryRequest) (*QueryGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
+
+func (*UnimplementedQueryServer) ModelState(ctx context.Context, req *QueryModelStateRequest) (*QueryModelStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModelState not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/MinGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPrice(ctx, req.(*QueryMinGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AverageMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAverageMinGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AverageMinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/AverageMinGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AverageMinGasPrice(ctx, req.(*QueryAverageMinGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecommendedGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommendedGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecommendedGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/RecommendedGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecommendedGasPrice(ctx, req.(*QueryRecommendedGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/GasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceHistory(ctx, req.(*QueryGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModelState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModelStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModelState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.feemodel.v1.Query/ModelState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModelState(ctx, req.(*QueryModelStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.feemodel.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MinGasPrice",
+			Handler:    _Query_MinGasPrice_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AverageMinGasPrice",
+			Handler:    _Query_AverageMinGasPrice_Handler,
+		},
+		{
+			MethodName: "RecommendedGasPrice",
+			Handler:    _Query_RecommendedGasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _Query_GasPriceHistory_Handler,
+		},
+		{
+			MethodName: "ModelState",
+			Handler:    _Query_ModelState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/feemodel/v1/query.proto",
+}
+
+func (m *QueryMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAverageMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAverageMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAverageMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAverageMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAverageMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAverageMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.AverageMinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.High.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Medium.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Low.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModelStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModelStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModelStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModelStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModelStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModelStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBlockGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LongEmaGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LongEmaGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShortEmaGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShortEmaGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAverageMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryAverageMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AverageMinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AfterBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.AfterBlocks))
+	}
+	return n
+}
+
+func (m *QueryRecommendedGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Low.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Medium.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *QueryGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModelStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModelStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShortEmaGas != 0 {
+		n += 1 + sovQuery(uint64(m.ShortEmaGas))
+	}
+	if m.LongEmaGas != 0 {
+		n += 1 + sovQuery(uint64(m.LongEmaGas))
+	}
+	if m.LastBlockGas != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlockGas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryAverageMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAverageMinGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAverageMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryAverageMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAverageMinGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAverageMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageMinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 
-func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecommendedGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -570,12 +2036,31 @@ func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecommendedGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecommendedGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterBlocks", wireType)
+			}
+			m.AfterBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -598,7 +2083,7 @@ func (m *QueryMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecommendedGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,15 +2106,15 @@ func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMinGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecommendedGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecommendedGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -656,7 +2141,73 @@ func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Medium", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Medium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -682,7 +2233,7 @@ func (m *QueryMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -705,12 +2256,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -733,7 +2303,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -756,15 +2326,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -791,7 +2361,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, GasPriceHistoryRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -817,6 +2388,165 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *QueryModelStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModelStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModelStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryModelStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModelStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModelStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortEmaGas", wireType)
+			}
+			m.ShortEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongEmaGas", wireType)
+			}
+			m.LongEmaGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongEmaGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockGas", wireType)
+			}
+			m.LastBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,8 +16,9 @@ import (
 
 // Flag names and values
 const (
-	FlagOwner   = "owner"
-	FlagClassID = "class-id"
+	FlagOwner    = "owner"
+	FlagClassID  = "class-id"
+	FlagClassIDs = "class-ids"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -36,6 +37,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClasses(),
 		GetCmdQueryNFT(),
 		GetCmdQueryNFTs(),
+		GetCmdQueryOwnerNFTs(),
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
@@ -195,6 +197,62 @@ $ %s query %s nfts <class-id> --owner=<owner>
 	return cmd
 }
 
+// GetCmdQueryOwnerNFTs implements the query owner-nfts command.
+func GetCmdQueryOwnerNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-nfts [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query all NFTs of an owner across the classes.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all NFTs of an owner across the classes. If class-ids
+is set, only the nfts of those classes are returned.
+Examples:
+$ %s query %s owner-nfts <owner> --class-ids=<class-id1>,<class-id2>
+`,
+				version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			classIDs, err := cmd.Flags().GetStringSlice(FlagClassIDs)
+			if err != nil {
+				return err
+			}
+			for _, classID := range classIDs {
+				if err := nft.ValidateClassID(classID); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.OwnerNFTs(cmd.Context(), &nft.QueryOwnerNFTsRequest{
+				Owner:      args[0],
+				ClassIds:   classIDs,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner-nfts")
+	cmd.Flags().StringSlice(FlagClassIDs, nil, "The class-ids the nfts are filtered by")
+	return cmd
+}
+
 // GetCmdQueryOwner implements the query owner command.
 func GetCmdQueryOwner() *cobra.Command {
 	cmd := &cobra.Command{
@@ -214,6 +214,66 @@ func (s *IntegrationTestSuite) TestQueryNFTs() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryOwnerNFTs() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name         string
+		owner        string
+		classIDs     []string
+		expectErr    bool
+		expectResult []*nft.NFT
+	}{
+		{
+			name:      "invalid owner",
+			owner:     "owner",
+			expectErr: true,
+		},
+		{
+			name:      "invalid class id",
+			owner:     val.Address.String(),
+			classIDs:  []string{"1class"},
+			expectErr: true,
+		},
+		{
+			name:         "class id does not exist",
+			owner:        val.Address.String(),
+			classIDs:     []string{"class"},
+			expectResult: []*nft.NFT{},
+		},
+		{
+			name:         "owner does not exist",
+			owner:        s.owner,
+			expectResult: []*nft.NFT{},
+		},
+		{
+			name:         "nft exist",
+			owner:        val.Address.String(),
+			expectResult: []*nft.NFT{&ExpNFT},
+		},
+		{
+			name:         "nft exist in filtered classes",
+			owner:        val.Address.String(),
+			classIDs:     []string{"class", testClassID},
+			expectResult: []*nft.NFT{&ExpNFT},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			resp, err := ExecQueryOwnerNFTs(val, tc.owner, tc.classIDs...)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var result nft.QueryOwnerNFTsResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(resp.Bytes(), &result)
+				s.Require().NoError(err)
+				s.Require().EqualValues(tc.expectResult, result.Nfts)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestQueryOwner() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -49,6 +50,17 @@ func ExecQueryNFTs(val *sdknetwork.Validator, classID, owner string) (testutil.B
 	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
 }
 
+func ExecQueryOwnerNFTs(val *sdknetwork.Validator, owner string, classIDs ...string) (testutil.BufferWriter, error) { //nolint:revive // test helper
+	cmd := cli.GetCmdQueryOwnerNFTs()
+	var args []string
+	args = append(args, owner)
+	if len(classIDs) > 0 {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.FlagClassIDs, strings.Join(classIDs, ",")))
+	}
+	args = append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
+
 func ExecQueryOwner(val *sdknetwork.Validator, classID, nftID string) (testutil.BufferWriter, error) { //nolint:revive // test helper
 	cmd := cli.GetCmdQueryOwner()
 	var args []string
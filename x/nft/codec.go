@@ -11,5 +11,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 	)
+	registry.RegisterInterface("coreum.nft.v1beta1.Data", (*Data)(nil),
+		&BasicMetadata{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc) //nolint:nosnakecase // generated code
 }
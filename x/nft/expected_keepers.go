@@ -16,3 +16,8 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
+
+// NFTHooks defines the hooks called by the nft keeper.
+type NFTHooks interface {
+	AfterTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
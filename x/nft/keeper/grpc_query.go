@@ -133,6 +133,65 @@ func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.Query
 	}, nil
 }
 
+// OwnerNFTs returns the NFTs of all the classes held by the owner, optionally filtered by the classes.
+func (k Keeper) OwnerNFTs(goCtx context.Context, r *nft.QueryOwnerNFTsRequest) (*nft.QueryOwnerNFTsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	classIDs := make(map[string]struct{}, len(r.ClassIds))
+	for _, classID := range r.ClassIds {
+		if err := nft.ValidateClassID(classID); err != nil {
+			return nil, err
+		}
+		classIDs[classID] = struct{}{}
+	}
+
+	var nfts []*nft.NFT
+	var pageRes *query.PageResponse
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(classIDs) == 1 {
+		// the keys of the single class are iterated directly
+		classID := r.ClassIds[0]
+		pageRes, err = query.Paginate(k.getClassStoreByOwner(ctx, owner, classID), r.Pagination, func(key, _ []byte) error {
+			if n, has := k.GetNFT(ctx, classID, string(key)); has {
+				nfts = append(nfts, &n)
+			}
+			return nil
+		})
+	} else {
+		pageRes, err = query.FilteredPaginate(k.prefixStoreNftOfClassByOwner(ctx, owner), r.Pagination,
+			func(key, _ []byte, accumulate bool) (bool, error) {
+				classID, nftID := parseNftOfClassByOwnerStoreKey(key)
+				if len(classIDs) > 0 {
+					if _, ok := classIDs[classID]; !ok {
+						return false, nil
+					}
+				}
+				if accumulate {
+					if n, has := k.GetNFT(ctx, classID, nftID); has {
+						nfts = append(nfts, &n)
+					}
+				}
+				return true, nil
+			})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &nft.QueryOwnerNFTsResponse{
+		Nfts:       nfts,
+		Pagination: pageRes,
+	}, nil
+}
+
 // NFT return an NFT based on its class and id.
 func (k Keeper) NFT(goCtx context.Context, r *nft.QueryNFTRequest) (*nft.QueryNFTResponse, error) {
 	if r == nil {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -385,6 +386,62 @@ func (s *TestSuite) TestNFTs() { //nolint:funlen // the default sdk test
 	}
 }
 
+func (s *TestSuite) TestOwnerNFTs() {
+	require := s.Require()
+
+	owner := s.addrs[1]
+	var expNFTs []*nft.NFT
+	for _, classID := range []string{"OwnerKittyA", "OwnerKittyB", "OwnerKittyC"} {
+		require.NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: classID}))
+		for i := 0; i < 2; i++ {
+			n := nft.NFT{
+				ClassId: classID,
+				Id:      fmt.Sprintf("cat%d", i),
+			}
+			require.NoError(s.app.NFTKeeper.Mint(s.ctx, n, owner))
+			expNFTs = append(expNFTs, &n)
+		}
+	}
+	// the nft of another owner must not be returned
+	require.NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: "OwnerKittyA", Id: "cat9"}, s.addrs[2]))
+
+	// fail on invalid owner
+	_, err := s.queryClient.OwnerNFTs(gocontext.Background(), &nft.QueryOwnerNFTsRequest{Owner: "invalid"})
+	require.Error(err)
+
+	// all the classes
+	res, err := s.queryClient.OwnerNFTs(gocontext.Background(), &nft.QueryOwnerNFTsRequest{Owner: owner.String()})
+	require.NoError(err)
+	require.Equal(expNFTs, res.Nfts)
+
+	// single class
+	res, err = s.queryClient.OwnerNFTs(gocontext.Background(), &nft.QueryOwnerNFTsRequest{
+		Owner:    owner.String(),
+		ClassIds: []string{"OwnerKittyB"},
+	})
+	require.NoError(err)
+	require.Equal(expNFTs[2:4], res.Nfts)
+
+	// several classes with pagination
+	res, err = s.queryClient.OwnerNFTs(gocontext.Background(), &nft.QueryOwnerNFTsRequest{
+		Owner:      owner.String(),
+		ClassIds:   []string{"OwnerKittyA", "OwnerKittyC"},
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(expNFTs[0:2], res.Nfts[0:2])
+	require.Equal(expNFTs[4:5], res.Nfts[2:])
+	require.EqualValues(4, res.Pagination.Total)
+
+	res, err = s.queryClient.OwnerNFTs(gocontext.Background(), &nft.QueryOwnerNFTsRequest{
+		Owner:      owner.String(),
+		ClassIds:   []string{"OwnerKittyA", "OwnerKittyC"},
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal(expNFTs[5:6], res.Nfts)
+}
+
 func (s *TestSuite) TestNFT() {
 	var (
 		req    *nft.QueryNFTRequest
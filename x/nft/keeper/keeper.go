@@ -12,6 +12,9 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	bk       nft.BankKeeper
+	hooks    nft.NFTHooks
+	// updaters holds the names of the modules scoped to update the nfts.
+	updaters map[string]struct{}
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -27,5 +30,15 @@ func NewKeeper(key storetypes.StoreKey,
 		cdc:      cdc,
 		storeKey: key,
 		bk:       bk,
+		updaters: map[string]struct{}{},
 	}
 }
+
+// SetHooks sets the hooks called by the keeper. The keeper copies created before the call don't call the hooks.
+func (k *Keeper) SetHooks(hooks nft.NFTHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
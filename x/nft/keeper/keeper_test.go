@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -249,13 +251,43 @@ func (s *TestSuite) TestUpdate() {
 		Uri:     "updated",
 	}
 
-	err = s.app.NFTKeeper.Update(s.ctx, expNFT)
+	updater := s.app.NFTKeeper.ScopeUpdater("updater")
+	err = updater.Update(s.ctx, expNFT)
 	s.Require().NoError(err)
 
 	// test GetNFT
 	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+
+	// the module can't be scoped twice
+	s.Require().Panics(func() { s.app.NFTKeeper.ScopeUpdater("updater") })
+
+	// not existing nft
+	err = updater.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: "kitty2", Uri: testURI})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	// invalid nft id
+	err = updater.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: "k", Uri: testURI})
+	s.Require().ErrorIs(err, nft.ErrInvalidID)
+
+	// uri hash without the uri
+	err = updater.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, UriHash: "hash"})
+	s.Require().ErrorIs(err, nft.ErrInvalidNFT)
+
+	expNFT.UriHash = "hash"
+	s.Require().NoError(updater.Update(s.ctx, expNFT))
+
+	// uri changed without the uri hash
+	err = updater.Update(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: "updated2", UriHash: "hash"})
+	s.Require().ErrorIs(err, nft.ErrInvalidNFT)
+
+	expNFT.Uri = "updated2"
+	expNFT.UriHash = "hash2"
+	s.Require().NoError(updater.Update(s.ctx, expNFT))
+	actNFT, has = s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(expNFT, actNFT)
 }
 
 func (s *TestSuite) TestTransfer() {
@@ -360,3 +392,63 @@ func (s *TestSuite) TestInitGenesis() {
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
 }
+
+func (s *TestSuite) TestBasicMetadata() {
+	classMetadata := &nft.BasicMetadata{
+		Name:        testClassName,
+		Description: testClassDescription,
+		Image:       "https://example.com/kitty.png",
+	}
+	classData, err := nft.PackData(classMetadata)
+	s.Require().NoError(err)
+
+	class := nft.Class{
+		Id:     testClassID,
+		Symbol: testClassSymbol,
+		Data:   classData,
+	}
+	err = s.app.NFTKeeper.SaveClass(s.ctx, class)
+	s.Require().NoError(err)
+
+	nftMetadata := &nft.BasicMetadata{
+		Name:        "Kitty #1",
+		ExternalUrl: "https://example.com/kitty/1",
+		Attributes: []nft.Attribute{
+			{TraitType: "color", Value: "black"},
+			{TraitType: "eyes", Value: "green"},
+		},
+	}
+	nftData, err := nft.PackData(nftMetadata)
+	s.Require().NoError(err)
+
+	err = s.app.NFTKeeper.Mint(s.ctx, nft.NFT{
+		ClassId: testClassID,
+		Id:      testID,
+		Data:    nftData,
+	}, s.addrs[0])
+	s.Require().NoError(err)
+
+	storedClass, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	actualClassMetadata, err := nft.UnpackBasicMetadata(s.app.AppCodec(), storedClass.Data)
+	s.Require().NoError(err)
+	s.Require().Equal(classMetadata, actualClassMetadata)
+
+	storedNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	actualNFTMetadata, err := nft.UnpackBasicMetadata(s.app.AppCodec(), storedNFT.Data)
+	s.Require().NoError(err)
+	s.Require().Equal(nftMetadata, actualNFTMetadata)
+
+	// the payload must be registered in the interface registry to be unpacked once it is loaded from the store
+	unknownData, err := codectypes.NewAnyWithValue(&gogotypes.BytesValue{Value: []byte{0x01}})
+	s.Require().NoError(err)
+	_, err = nft.UnpackData(s.app.AppCodec(), &codectypes.Any{
+		TypeUrl: unknownData.TypeUrl,
+		Value:   unknownData.Value,
+	})
+	s.Require().ErrorIs(err, nft.ErrInvalidData)
+
+	_, err = nft.UnpackBasicMetadata(s.app.AppCodec(), nil)
+	s.Require().ErrorIs(err, nft.ErrInvalidData)
+}
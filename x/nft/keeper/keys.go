@@ -97,10 +97,11 @@ func parseNftOfClassByOwnerStoreKey(key []byte) (classID, nftID string) {
 	return classID, nftID
 }
 
-// ownerStoreKey returns the byte representation of the nft owner
+// OwnerStoreKey returns the byte representation of the nft owner. It is exported so the proofs of the ownership can be
+// requested and verified against the store.
 // Items are stored with the following key: values
 // 0x04<classID><Delimiter(1 Byte)><nftID>
-func ownerStoreKey(classID, nftID string) []byte {
+func OwnerStoreKey(classID, nftID string) []byte {
 	// key is of format:
 	classIDBz := store.UnsafeStrToBytes(classID)
 	nftIDBz := store.UnsafeStrToBytes(nftID)
@@ -64,20 +64,6 @@ func (k Keeper) Burn(ctx sdk.Context, classID, nftID string) error {
 	return nil
 }
 
-// Update defines a method for updating an exist nft
-// Note: When the upper module uses this method, it needs to authenticate nft
-func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
-	if !k.HasClass(ctx, token.ClassId) {
-		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
-	}
-
-	if !k.HasNFT(ctx, token.ClassId, token.Id) {
-		return sdkerrors.Wrap(nft.ErrNFTNotExists, token.Id)
-	}
-	k.setNFT(ctx, token)
-	return nil
-}
-
 // Transfer defines a method for sending a nft from one account to another account.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx sdk.Context,
@@ -96,6 +82,10 @@ func (k Keeper) Transfer(ctx sdk.Context,
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
+
+	if k.hooks != nil {
+		return k.hooks.AfterTransfer(ctx, classID, nftID, owner, receiver)
+	}
 	return nil
 }
 
@@ -141,7 +131,7 @@ func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []nft.NFT)
 // GetOwner returns the owner information of the specified nft
 func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(ownerStoreKey(classID, nftID))
+	bz := store.Get(OwnerStoreKey(classID, nftID))
 	return sdk.AccAddress(bz)
 }
 
@@ -172,7 +162,7 @@ func (k Keeper) setNFT(ctx sdk.Context, token nft.NFT) {
 
 func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(ownerStoreKey(classID, nftID), owner.Bytes())
+	store.Set(OwnerStoreKey(classID, nftID), owner.Bytes())
 
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
 	ownerStore.Set([]byte(nftID), Placeholder)
@@ -180,7 +170,7 @@ func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAd
 
 func (k Keeper) deleteOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(ownerStoreKey(classID, nftID))
+	store.Delete(OwnerStoreKey(classID, nftID))
 
 	ownerStore := k.getClassStoreByOwner(ctx, owner, classID)
 	ownerStore.Delete([]byte(nftID))
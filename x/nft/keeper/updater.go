@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/nft"
+)
+
+// Updater updates the stored nfts on behalf of the module. It is the capability granted to the module at the app
+// wiring, so the nfts can't be updated by the modules the updater isn't passed to.
+type Updater struct {
+	keeper     Keeper
+	moduleName string
+}
+
+// ScopeUpdater returns the updater of the nfts used by the module. It must be called at the app wiring only, once per
+// module.
+func (k Keeper) ScopeUpdater(moduleName string) Updater {
+	if moduleName == "" || moduleName == nft.ModuleName {
+		panic(sdkerrors.Wrapf(nft.ErrInvalidNFT, "module %q can't be scoped", moduleName))
+	}
+	if _, exists := k.updaters[moduleName]; exists {
+		panic(sdkerrors.Wrapf(nft.ErrInvalidNFT, "module %s is scoped already", moduleName))
+	}
+	k.updaters[moduleName] = struct{}{}
+
+	return Updater{
+		keeper:     k,
+		moduleName: moduleName,
+	}
+}
+
+// Update replaces the uri, uri hash and data of the existing nft.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (u Updater) Update(ctx sdk.Context, token nft.NFT) error {
+	return u.keeper.update(ctx, u.moduleName, token)
+}
+
+func (k Keeper) update(ctx sdk.Context, moduleName string, token nft.NFT) error {
+	if err := nft.ValidateClassID(token.ClassId); err != nil {
+		return err
+	}
+	if err := nft.ValidateNFTID(token.Id); err != nil {
+		return err
+	}
+	if !k.HasClass(ctx, token.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+
+	stored, found := k.GetNFT(ctx, token.ClassId, token.Id)
+	if !found {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, token.Id)
+	}
+	if err := validateURIUpdate(stored, token); err != nil {
+		return err
+	}
+
+	k.setNFT(ctx, token)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: token.ClassId,
+		Id:      token.Id,
+		Uri:     token.Uri,
+		UriHash: token.UriHash,
+		Module:  moduleName,
+	})
+}
+
+// validateURIUpdate checks that the uri hash still describes the uri after the update. The hash can't be set without
+// the uri, and the uri can't be changed while keeping the hash of the previous one.
+func validateURIUpdate(stored, updated nft.NFT) error {
+	if updated.UriHash != "" && updated.Uri == "" {
+		return sdkerrors.Wrap(nft.ErrInvalidNFT, "uri hash can't be set without the uri")
+	}
+	if updated.Uri != stored.Uri && updated.UriHash != "" && updated.UriHash == stored.UriHash {
+		return sdkerrors.Wrap(nft.ErrInvalidNFT, "uri hash must be changed together with the uri")
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package nft
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Data is implemented by the typed payloads which might be stored in the data field of Class and NFT.
+type Data interface {
+	proto.Message
+}
+
+var _ Data = &BasicMetadata{}
+
+// PackData packs the typed payload into the Any which might be stored in the data field of Class and NFT.
+func PackData(data Data) (*codectypes.Any, error) {
+	if data == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidData, "data must not be nil")
+	}
+	any, err := codectypes.NewAnyWithValue(data)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidData, err.Error())
+	}
+	return any, nil
+}
+
+// UnpackData unpacks the data field of Class or NFT into the typed payload registered in the interface registry.
+func UnpackData(unpacker codectypes.AnyUnpacker, any *codectypes.Any) (Data, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidData, "data is empty")
+	}
+	var data Data
+	if err := unpacker.UnpackAny(any, &data); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidData, err.Error())
+	}
+	return data, nil
+}
+
+// UnpackBasicMetadata unpacks the data field of Class or NFT into BasicMetadata.
+func UnpackBasicMetadata(unpacker codectypes.AnyUnpacker, any *codectypes.Any) (*BasicMetadata, error) {
+	data, err := UnpackData(unpacker, any)
+	if err != nil {
+		return nil, err
+	}
+	metadata, ok := data.(*BasicMetadata)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidData, "data is of type %s, expected %s", any.TypeUrl, "/"+proto.MessageName(&BasicMetadata{}))
+	}
+	return metadata, nil
+}
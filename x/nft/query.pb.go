@@ -473,6 +473,131 @@ func (m *QueryNFTsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOwnerNFTsRequest is the request type for the Query/OwnerNFTs RPC method
+type QueryOwnerNFTsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// class_ids filters the NFTs by the classes, if empty the NFTs of all the classes are returned
+	ClassIds   []string           `protobuf:"bytes,2,rep,name=class_ids,json=classIds,proto3" json:"class_ids,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOwnerNFTsRequest) Reset()         { *m = QueryOwnerNFTsRequest{} }
+func (m *QueryOwnerNFTsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerNFTsRequest) ProtoMessage()    {}
+func (*QueryOwnerNFTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_531d9ac0c4020f3e, []int{8}
+}
+
+func (m *QueryOwnerNFTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryOwnerNFTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerNFTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryOwnerNFTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerNFTsRequest.Merge(m, src)
+}
+
+func (m *QueryOwnerNFTsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryOwnerNFTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerNFTsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerNFTsRequest proto.InternalMessageInfo
+
+func (m *QueryOwnerNFTsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryOwnerNFTsRequest) GetClassIds() []string {
+	if m != nil {
+		return m.ClassIds
+	}
+	return nil
+}
+
+func (m *QueryOwnerNFTsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOwnerNFTsResponse is the response type for the Query/OwnerNFTs RPC method
+type QueryOwnerNFTsResponse struct {
+	Nfts       []*NFT              `protobuf:"bytes,1,rep,name=nfts,proto3" json:"nfts,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOwnerNFTsResponse) Reset()         { *m = QueryOwnerNFTsResponse{} }
+func (m *QueryOwnerNFTsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerNFTsResponse) ProtoMessage()    {}
+func (*QueryOwnerNFTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_531d9ac0c4020f3e, []int{9}
+}
+
+func (m *QueryOwnerNFTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryOwnerNFTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerNFTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryOwnerNFTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerNFTsResponse.Merge(m, src)
+}
+
+func (m *QueryOwnerNFTsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryOwnerNFTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerNFTsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerNFTsResponse proto.InternalMessageInfo
+
+func (m *QueryOwnerNFTsResponse) GetNfts() []*NFT {
+	if m != nil {
+		return m.Nfts
+	}
+	return nil
+}
+
+func (m *QueryOwnerNFTsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryNFTRequest is the request type for the Query/NFT RPC method
 type QueryNFTRequest struct {
 	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
@@ -483,7 +608,7 @@ func (m *QueryNFTRequest) Reset()         { *m = QueryNFTRequest{} }
 func (m *QueryNFTRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTRequest) ProtoMessage()    {}
 func (*QueryNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_531d9ac0c4020f3e, []int{8}
+	return fileDescriptor_531d9ac0c4020f3e, []int{10}
 }
 
 func (m *QueryNFTRequest) XXX_Unmarshal(b []byte) error {
@@ -540,7 +665,7 @@ func (m *QueryNFTResponse) Reset()         { *m = QueryNFTResponse{} }
 func (m *QueryNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTResponse) ProtoMessage()    {}
 func (*QueryNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_531d9ac0c4020f3e, []int{9}
+	return fileDescriptor_531d9ac0c4020f3e, []int{11}
 }
 
 func (m *QueryNFTResponse) XXX_Unmarshal(b []byte) error {
@@ -590,7 +715,7 @@ func (m *QueryClassRequest) Reset()         { *m = QueryClassRequest{} }
 func (m *QueryClassRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClassRequest) ProtoMessage()    {}
 func (*QueryClassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_531d9ac0c4020f3e, []int{10}
+	return fileDescriptor_531d9ac0c4020f3e, []int{12}
 }
 
 func (m *QueryClassRequest) XXX_Unmarshal(b []byte) error {
@@ -640,7 +765,7 @@ func (m *QueryClassResponse) Reset()         { *m = QueryClassResponse{} }
 func (m *QueryClassResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClassResponse) ProtoMessage()    {}
 func (*QueryClassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_531d9ac0c4020f3e, []int{11}
+	return fileDescriptor_531d9ac0c4020f3e, []int{13}
 }
 
 func (m *QueryClassResponse) XXX_Unmarshal(b []byte) error {
@@ -691,7 +816,7 @@ func (m *QueryClassesRequest) Reset()         { *m = QueryClassesRequest{} }
 func (m *QueryClassesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClassesRequest) ProtoMessage()    {}
 func (*QueryClassesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_531d9ac0c4020f3e, []int{12}
+	return fileDescriptor_531d9ac0c4020f3e, []int{14}
 }
 
 func (m *QueryClassesRequest) XXX_Unmarshal(b []byte) error {
@@ -742,7 +867,7 @@ func (m *QueryClassesResponse) Reset()         { *m = QueryClassesResponse{} }
 func (m *QueryClassesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClassesResponse) ProtoMessage()    {}
 func (*QueryClassesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_531d9ac0c4020f3e, []int{13}
+	return fileDescriptor_531d9ac0c4020f3e, []int{15}
 }
 
 func (m *QueryClassesResponse) XXX_Unmarshal(b []byte) error {
@@ -799,6 +924,8 @@ func init() {
 	proto.RegisterType((*QuerySupplyResponse)(nil), "coreum.nft.v1beta1.QuerySupplyResponse")
 	proto.RegisterType((*QueryNFTsRequest)(nil), "coreum.nft.v1beta1.QueryNFTsRequest")
 	proto.RegisterType((*QueryNFTsResponse)(nil), "coreum.nft.v1beta1.QueryNFTsResponse")
+	proto.RegisterType((*QueryOwnerNFTsRequest)(nil), "coreum.nft.v1beta1.QueryOwnerNFTsRequest")
+	proto.RegisterType((*QueryOwnerNFTsResponse)(nil), "coreum.nft.v1beta1.QueryOwnerNFTsResponse")
 	proto.RegisterType((*QueryNFTRequest)(nil), "coreum.nft.v1beta1.QueryNFTRequest")
 	proto.RegisterType((*QueryNFTResponse)(nil), "coreum.nft.v1beta1.QueryNFTResponse")
 	proto.RegisterType((*QueryClassRequest)(nil), "coreum.nft.v1beta1.QueryClassRequest")
@@ -810,53 +937,57 @@ func init() {
 func init() { proto.RegisterFile("coreum/nft/v1beta1/query.proto", fileDescriptor_531d9ac0c4020f3e) }
 
 var fileDescriptor_531d9ac0c4020f3e = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcd, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0x99, 0x96, 0xd2, 0xdf, 0x6f, 0x48, 0x7c, 0x19, 0x89, 0x96, 0xaa, 0x9b, 0x66, 0x79,
-	0xe9, 0x02, 0x61, 0x86, 0x97, 0xc4, 0x93, 0x7a, 0x80, 0x58, 0xe3, 0x05, 0xb5, 0x72, 0x32, 0x31,
-	0x66, 0xdb, 0x6e, 0xeb, 0x26, 0xed, 0x4c, 0xe9, 0xec, 0xaa, 0x84, 0x70, 0x90, 0x83, 0x91, 0x78,
-	0x31, 0x91, 0xbf, 0xc7, 0xb3, 0x47, 0x12, 0x2f, 0x1e, 0x0d, 0xf8, 0x87, 0x98, 0x7d, 0x66, 0xb6,
-	0xec, 0xca, 0x76, 0x97, 0x10, 0x8f, 0xdb, 0xf9, 0x3e, 0xcf, 0xf7, 0x33, 0xf3, 0xbc, 0x00, 0x36,
-	0x9a, 0x62, 0xe0, 0xf8, 0x3d, 0xc6, 0xdb, 0x1e, 0x7b, 0xbb, 0xda, 0x70, 0x3c, 0x7b, 0x95, 0xed,
-	0xf8, 0xce, 0x60, 0x97, 0xf6, 0x07, 0xc2, 0x13, 0x84, 0xa8, 0x73, 0xca, 0xdb, 0x1e, 0xd5, 0xe7,
-	0xe5, 0xc5, 0xa6, 0x90, 0x3d, 0x21, 0x59, 0xc3, 0x96, 0x8e, 0x12, 0x0f, 0x43, 0xfb, 0x76, 0xc7,
-	0xe5, 0xb6, 0xe7, 0x0a, 0xae, 0xe2, 0xcb, 0x77, 0x3a, 0x42, 0x74, 0xba, 0x0e, 0xb3, 0xfb, 0x2e,
-	0xb3, 0x39, 0x17, 0x1e, 0x1c, 0xca, 0xf0, 0x34, 0xc1, 0x3d, 0x70, 0x82, 0x53, 0xb3, 0x86, 0x6f,
-	0x3c, 0x0f, 0xb2, 0x6f, 0xd8, 0x5d, 0x9b, 0x37, 0x9d, 0xba, 0xb3, 0xe3, 0x3b, 0xd2, 0x23, 0xd3,
-	0xf8, 0xbf, 0x66, 0xd7, 0x96, 0xf2, 0xb5, 0xdb, 0x2a, 0xa1, 0x0a, 0xb2, 0xfe, 0xaf, 0x17, 0xe1,
-	0xfb, 0x49, 0x8b, 0x4c, 0xe1, 0x82, 0x78, 0xc7, 0x9d, 0x41, 0x29, 0x07, 0xbf, 0xab, 0x0f, 0x93,
-	0xe2, 0xa9, 0x78, 0x1e, 0xd9, 0x17, 0x5c, 0x3a, 0xe4, 0x26, 0x9e, 0xb0, 0x7b, 0xc2, 0xe7, 0x1e,
-	0xa4, 0x19, 0xaf, 0xeb, 0x2f, 0xf3, 0x21, 0xbe, 0x0e, 0xfa, 0xa7, 0x41, 0xf4, 0x05, 0x5c, 0xaf,
-	0xe0, 0x9c, 0xdb, 0xd2, 0x96, 0x39, 0xb7, 0x65, 0x2e, 0x62, 0x12, 0x8d, 0xd7, 0x6e, 0x43, 0x36,
-	0x14, 0x65, 0x63, 0x5a, 0xfb, 0xc2, 0xef, 0xf7, 0xbb, 0xbb, 0xd9, 0x66, 0xe6, 0xb2, 0x7e, 0x94,
-	0x30, 0x20, 0xe3, 0x2e, 0x9f, 0x11, 0xbe, 0x06, 0xfa, 0xad, 0xda, 0xb6, 0xbc, 0xec, 0x0b, 0x92,
-	0x1a, 0xc6, 0x67, 0x95, 0x2d, 0xe5, 0x2b, 0xc8, 0x9a, 0x5c, 0x9b, 0xa7, 0xaa, 0x0d, 0x68, 0xd0,
-	0x06, 0x54, 0xf5, 0x8c, 0xae, 0x21, 0x7d, 0x66, 0x77, 0xc2, 0x72, 0xd5, 0x23, 0x91, 0xe6, 0x21,
-	0xd2, 0x4f, 0xab, 0x68, 0x34, 0xfb, 0x12, 0x1e, 0xe7, 0x6d, 0x4f, 0x96, 0x50, 0x25, 0x6f, 0x4d,
-	0xae, 0xdd, 0xa2, 0xe7, 0x5b, 0x8e, 0x6e, 0xd5, 0xb6, 0xeb, 0x20, 0x22, 0x8f, 0x63, 0x28, 0x39,
-	0x40, 0xa9, 0x66, 0xa2, 0x28, 0xa7, 0x18, 0xcb, 0x7d, 0x7c, 0x35, 0x44, 0xb9, 0x44, 0x8d, 0x1f,
-	0x9c, 0x3d, 0xeb, 0xf0, 0x1e, 0x0b, 0x38, 0xcf, 0xdb, 0xaa, 0x00, 0x29, 0xd7, 0x08, 0x34, 0x26,
-	0xd5, 0xef, 0xb0, 0x19, 0xa4, 0xbf, 0x40, 0xd5, 0x1f, 0xe9, 0x36, 0xd1, 0x7a, 0x6d, 0xc8, 0x70,
-	0x01, 0x04, 0xda, 0x72, 0x3a, 0xc9, 0x52, 0x45, 0x28, 0x9d, 0xf9, 0x4a, 0x37, 0x0f, 0xfc, 0xe8,
-	0x0c, 0x8d, 0xe3, 0xe5, 0x45, 0x97, 0x2e, 0xef, 0x11, 0xd2, 0x93, 0x36, 0xcc, 0xaf, 0x41, 0xd7,
-	0xb1, 0xba, 0x89, 0x13, 0x16, 0x39, 0x05, 0x35, 0x54, 0xfe, 0xb3, 0x4a, 0xaf, 0x7d, 0x2b, 0xe2,
-	0x02, 0x60, 0x91, 0x23, 0x84, 0x8b, 0x7a, 0x0b, 0x90, 0x6a, 0x12, 0x42, 0xc2, 0xbe, 0x29, 0x5b,
-	0xd9, 0x42, 0x65, 0x6a, 0xde, 0x3b, 0xf8, 0xf1, 0xfb, 0x6b, 0x6e, 0x85, 0x50, 0x96, 0xb0, 0xd7,
-	0x1a, 0x4a, 0xcc, 0xf6, 0x60, 0xa4, 0xf6, 0xd9, 0x5e, 0x58, 0xeb, 0x7d, 0x72, 0x88, 0x70, 0x01,
-	0x96, 0x05, 0x99, 0x1b, 0xe9, 0x15, 0x5d, 0x46, 0xe5, 0xf9, 0x2c, 0x99, 0x06, 0x5a, 0x05, 0xa0,
-	0x25, 0xb2, 0x90, 0x04, 0x04, 0x1c, 0x11, 0x0c, 0xb6, 0x17, 0xb0, 0x7c, 0x42, 0x78, 0x42, 0xed,
-	0x16, 0x32, 0xda, 0x25, 0xb6, 0xad, 0xca, 0xd5, 0x4c, 0x9d, 0xc6, 0x59, 0x06, 0x9c, 0x2a, 0x99,
-	0x4b, 0xc2, 0x91, 0xa0, 0x8d, 0x3e, 0x8b, 0x8f, 0xc7, 0x83, 0x3d, 0x41, 0x66, 0x47, 0xe6, 0x8f,
-	0x2c, 0xb5, 0xf2, 0x5c, 0x86, 0x4a, 0x33, 0x54, 0x80, 0xa1, 0x4c, 0x4a, 0x2c, 0xf9, 0x6f, 0x8f,
-	0x24, 0x07, 0x08, 0xe7, 0xb7, 0x6a, 0xdb, 0x64, 0x26, 0x2d, 0x61, 0xe8, 0x3a, 0x9b, 0x2e, 0xd2,
-	0xa6, 0x2b, 0x60, 0xba, 0x48, 0xac, 0x51, 0xa6, 0xe7, 0xca, 0xf0, 0x11, 0xe1, 0x02, 0xcc, 0x43,
-	0x4a, 0x4b, 0x44, 0x97, 0x47, 0x4a, 0x4b, 0xc4, 0x76, 0x86, 0x49, 0x01, 0xc5, 0x22, 0xf3, 0x49,
-	0x28, 0x7a, 0xf4, 0xa2, 0x45, 0xf8, 0x80, 0x70, 0x51, 0x8f, 0x73, 0xca, 0xc8, 0xc4, 0x17, 0x4a,
-	0xca, 0xc8, 0xfc, 0xb5, 0x19, 0xcc, 0x19, 0xc0, 0xb9, 0x4b, 0x6e, 0xa7, 0xe0, 0x6c, 0x6c, 0x7c,
-	0x3f, 0x31, 0xd0, 0xf1, 0x89, 0x81, 0x7e, 0x9d, 0x18, 0xe8, 0xcb, 0xa9, 0x31, 0x76, 0x7c, 0x6a,
-	0x8c, 0xfd, 0x3c, 0x35, 0xc6, 0x5e, 0x5a, 0x1d, 0xd7, 0x7b, 0xe3, 0x37, 0x68, 0x53, 0xf4, 0xd8,
-	0x26, 0x24, 0xa8, 0x09, 0x9f, 0xb7, 0x60, 0xee, 0xc3, 0x8c, 0xef, 0x83, 0x9c, 0x8d, 0x09, 0xf8,
-	0x9f, 0x62, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xee, 0xea, 0xe7, 0xf1, 0x08, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcf, 0x4f, 0x13, 0x5b,
+	0x14, 0xc7, 0xb9, 0x2d, 0x05, 0x7a, 0x48, 0xde, 0x7b, 0x5c, 0x78, 0xbc, 0x32, 0x3c, 0x9b, 0x66,
+	0x80, 0x76, 0x5a, 0xc2, 0x5c, 0x7e, 0x24, 0xae, 0xd4, 0x05, 0xc4, 0x1a, 0x37, 0xa8, 0x95, 0x95,
+	0x89, 0x31, 0xd3, 0x76, 0x5a, 0x27, 0x69, 0x67, 0x4a, 0xef, 0x8c, 0x4a, 0x08, 0x0b, 0x59, 0x18,
+	0x89, 0x89, 0x31, 0xc2, 0x1f, 0xe5, 0x92, 0x44, 0x17, 0x2e, 0x0d, 0xf8, 0x87, 0x98, 0x39, 0x73,
+	0xa7, 0xcc, 0xc8, 0x74, 0x86, 0x10, 0x12, 0x97, 0xd3, 0xfb, 0xbd, 0xe7, 0x7c, 0xee, 0xf9, 0xf1,
+	0x05, 0xc8, 0x37, 0xac, 0xbe, 0xee, 0x74, 0x99, 0xd9, 0xb2, 0xd9, 0xab, 0xb5, 0xba, 0x6e, 0x6b,
+	0x6b, 0x6c, 0xd7, 0xd1, 0xfb, 0x7b, 0x6a, 0xaf, 0x6f, 0xd9, 0x16, 0xa5, 0xde, 0xb9, 0x6a, 0xb6,
+	0x6c, 0x55, 0x9c, 0x4b, 0x95, 0x86, 0xc5, 0xbb, 0x16, 0x67, 0x75, 0x8d, 0xeb, 0x9e, 0x78, 0x70,
+	0xb5, 0xa7, 0xb5, 0x0d, 0x53, 0xb3, 0x0d, 0xcb, 0xf4, 0xee, 0x4b, 0xff, 0xb7, 0x2d, 0xab, 0xdd,
+	0xd1, 0x99, 0xd6, 0x33, 0x98, 0x66, 0x9a, 0x96, 0x8d, 0x87, 0xdc, 0x3f, 0x8d, 0xc8, 0xee, 0x66,
+	0xc2, 0x53, 0xb9, 0x0a, 0xd3, 0x4f, 0xdc, 0xe8, 0x9b, 0x5a, 0x47, 0x33, 0x1b, 0x7a, 0x4d, 0xdf,
+	0x75, 0x74, 0x6e, 0xd3, 0x39, 0x98, 0x68, 0x74, 0x34, 0xce, 0x5f, 0x18, 0xcd, 0x1c, 0x29, 0x10,
+	0x25, 0x5b, 0x1b, 0xc7, 0xef, 0x87, 0x4d, 0x3a, 0x03, 0x19, 0xeb, 0xb5, 0xa9, 0xf7, 0x73, 0x29,
+	0xfc, 0xdd, 0xfb, 0x90, 0x55, 0x98, 0x09, 0xc7, 0xe1, 0x3d, 0xcb, 0xe4, 0x3a, 0x9d, 0x85, 0x31,
+	0xad, 0x6b, 0x39, 0xa6, 0x8d, 0x61, 0x46, 0x6b, 0xe2, 0x4b, 0xbe, 0x07, 0x53, 0xa8, 0x7f, 0xe4,
+	0xde, 0xbe, 0x42, 0xd6, 0xbf, 0x20, 0x65, 0x34, 0x45, 0xca, 0x94, 0xd1, 0x94, 0x2b, 0x40, 0x83,
+	0xf7, 0x45, 0xb6, 0x01, 0x1b, 0x09, 0xb2, 0x31, 0xa1, 0x7d, 0xea, 0xf4, 0x7a, 0x9d, 0xbd, 0xe4,
+	0x64, 0xf2, 0x0a, 0x4c, 0x87, 0x2e, 0x24, 0xbc, 0xe5, 0x03, 0x81, 0x7f, 0x50, 0xbf, 0x5d, 0xdd,
+	0xe1, 0xd7, 0xad, 0x20, 0xad, 0x02, 0x5c, 0x74, 0x36, 0x97, 0x2e, 0x10, 0x65, 0x72, 0xbd, 0xa8,
+	0x7a, 0x63, 0xa0, 0xba, 0x63, 0xa0, 0x7a, 0x33, 0x23, 0x7a, 0xa8, 0x3e, 0xd6, 0xda, 0x7e, 0xbb,
+	0x6a, 0x81, 0x9b, 0xf2, 0x11, 0x81, 0xa9, 0x00, 0x8d, 0x60, 0x5f, 0x86, 0x51, 0xb3, 0x65, 0xf3,
+	0x1c, 0x29, 0xa4, 0x95, 0xc9, 0xf5, 0xff, 0xd4, 0xcb, 0x23, 0xa7, 0x6e, 0x57, 0x77, 0x6a, 0x28,
+	0xa2, 0x0f, 0x42, 0x28, 0x29, 0x44, 0x29, 0x25, 0xa2, 0x78, 0x99, 0x42, 0x2c, 0x9f, 0x09, 0xfc,
+	0x7b, 0xd1, 0xa6, 0x60, 0x79, 0x22, 0x3b, 0x45, 0xe7, 0x21, 0xeb, 0x17, 0x8d, 0xe7, 0x52, 0x85,
+	0xb4, 0x92, 0xad, 0x4d, 0x88, 0xaa, 0xf1, 0x1b, 0x2b, 0xd0, 0x47, 0x02, 0xb3, 0xbf, 0x43, 0xfd,
+	0xd1, 0x2a, 0xdd, 0x81, 0xbf, 0xfd, 0x86, 0x5d, 0x63, 0x13, 0xee, 0x5e, 0x0c, 0xdf, 0xe0, 0x1d,
+	0x65, 0x48, 0x9b, 0x2d, 0x6f, 0x4c, 0x63, 0x9e, 0xe1, 0x6a, 0x64, 0x55, 0x4c, 0xcb, 0x96, 0x1b,
+	0xfe, 0x0a, 0xbb, 0x71, 0x1f, 0x68, 0x50, 0x2f, 0x12, 0x32, 0xc8, 0xa0, 0x40, 0xa4, 0x9c, 0x8b,
+	0x4a, 0xe9, 0xdd, 0xf0, 0x74, 0xf2, 0x73, 0xb1, 0x62, 0xf8, 0xa3, 0x3e, 0x48, 0x1c, 0xee, 0x31,
+	0xb9, 0x76, 0x8f, 0x4f, 0x08, 0xcc, 0x84, 0xe3, 0x0b, 0xd0, 0x0d, 0xf0, 0x5e, 0xa2, 0xfb, 0x4d,
+	0x8e, 0x41, 0xf5, 0x95, 0x37, 0xd6, 0xe9, 0xf5, 0x6f, 0x13, 0x90, 0x41, 0x2c, 0x7a, 0x42, 0x60,
+	0x5c, 0x78, 0x25, 0x2d, 0x45, 0x21, 0x44, 0xb8, 0xb2, 0xa4, 0x24, 0x0b, 0xbd, 0xa4, 0xf2, 0xed,
+	0xc3, 0xaf, 0x3f, 0x8f, 0x53, 0xab, 0x54, 0x65, 0x11, 0xee, 0x5f, 0xf7, 0xc4, 0x6c, 0x1f, 0x97,
+	0xee, 0x80, 0xed, 0xfb, 0xbd, 0x3e, 0xa0, 0x47, 0x04, 0x32, 0xb8, 0x16, 0x74, 0x69, 0x68, 0xae,
+	0xa0, 0x65, 0x4b, 0xc5, 0x24, 0x99, 0x00, 0x5a, 0x43, 0xa0, 0x65, 0x5a, 0x8e, 0x02, 0x42, 0x8e,
+	0x00, 0x06, 0xdb, 0x77, 0x59, 0xde, 0x13, 0x18, 0xf3, 0x1c, 0x98, 0x0e, 0xcf, 0x12, 0xf2, 0x74,
+	0xa9, 0x94, 0xa8, 0x13, 0x38, 0x2b, 0x88, 0x53, 0xa2, 0x4b, 0x51, 0x38, 0x1c, 0xb5, 0xc1, 0xb2,
+	0x38, 0x30, 0xea, 0xfa, 0x04, 0x5d, 0x1c, 0x1a, 0x3f, 0xe0, 0x6d, 0xd2, 0x52, 0x82, 0x4a, 0x30,
+	0x14, 0x90, 0x41, 0xa2, 0x39, 0x16, 0xfd, 0x17, 0x9a, 0xd3, 0x63, 0x02, 0xd9, 0x81, 0x49, 0xd1,
+	0x72, 0x7c, 0xa9, 0x83, 0x04, 0x95, 0xab, 0x48, 0x05, 0x06, 0x43, 0x8c, 0x32, 0x2d, 0x0d, 0xed,
+	0x0c, 0x1f, 0x4c, 0x0a, 0x52, 0x1d, 0x12, 0x48, 0x6f, 0x57, 0x77, 0xe8, 0x42, 0xdc, 0x33, 0x7d,
+	0x92, 0xc5, 0x78, 0x91, 0x60, 0x58, 0x45, 0x86, 0x0a, 0x55, 0x86, 0x95, 0xe2, 0xd2, 0x70, 0xbc,
+	0x23, 0x90, 0xc1, 0x2d, 0x8d, 0x19, 0xd4, 0xa0, 0xa5, 0x49, 0xc5, 0x24, 0x99, 0x40, 0x51, 0x11,
+	0x45, 0xa1, 0xc5, 0x28, 0x14, 0x61, 0x08, 0xc1, 0xd1, 0x78, 0x4b, 0x60, 0x5c, 0x98, 0x4c, 0xcc,
+	0x22, 0x87, 0x6d, 0x4e, 0x52, 0x92, 0x85, 0x02, 0x67, 0x01, 0x71, 0x6e, 0xd1, 0xf9, 0x18, 0x9c,
+	0xcd, 0xcd, 0x2f, 0x67, 0x79, 0x72, 0x7a, 0x96, 0x27, 0x3f, 0xce, 0xf2, 0xe4, 0xd3, 0x79, 0x7e,
+	0xe4, 0xf4, 0x3c, 0x3f, 0xf2, 0xfd, 0x3c, 0x3f, 0xf2, 0x4c, 0x69, 0x1b, 0xf6, 0x4b, 0xa7, 0xae,
+	0x36, 0xac, 0x2e, 0xdb, 0xc2, 0x00, 0x55, 0xcb, 0x31, 0x9b, 0xe8, 0x46, 0x7e, 0xc4, 0x37, 0x6e,
+	0xcc, 0xfa, 0x18, 0xfe, 0x3f, 0xb8, 0xf1, 0x6b, 0x00, 0xd8, 0xc2, 0xb6, 0xab, 0xad, 0x0a, 0x00,
 	0x00,
 }
 
@@ -881,6 +1012,8 @@ type QueryClient interface {
 	// NFTs queries all NFTs of a given class or owner,choose at least one of the two, similar to tokenByIndex in
 	// ERC721Enumerable
 	NFTs(ctx context.Context, in *QueryNFTsRequest, opts ...grpc.CallOption) (*QueryNFTsResponse, error)
+	// OwnerNFTs queries the NFTs of all the classes held by the owner, optionally filtered by the classes.
+	OwnerNFTs(ctx context.Context, in *QueryOwnerNFTsRequest, opts ...grpc.CallOption) (*QueryOwnerNFTsResponse, error)
 	// NFT queries an NFT based on its class and id.
 	NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error)
 	// Class queries an NFT class based on its id
@@ -933,6 +1066,15 @@ func (c *queryClient) NFTs(ctx context.Context, in *QueryNFTsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) OwnerNFTs(ctx context.Context, in *QueryOwnerNFTsRequest, opts ...grpc.CallOption) (*QueryOwnerNFTsResponse, error) {
+	out := new(QueryOwnerNFTsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.nft.v1beta1.Query/OwnerNFTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NFT(ctx context.Context, in *QueryNFTRequest, opts ...grpc.CallOption) (*QueryNFTResponse, error) {
 	out := new(QueryNFTResponse)
 	err := c.cc.Invoke(ctx, "/coreum.nft.v1beta1.Query/NFT", in, out, opts...)
@@ -971,6 +1113,8 @@ type QueryServer interface {
 	// NFTs queries all NFTs of a given class or owner,choose at least one of the two, similar to tokenByIndex in
 	// ERC721Enumerable
 	NFTs(context.Context, *QueryNFTsRequest) (*QueryNFTsResponse, error)
+	// OwnerNFTs queries the NFTs of all the classes held by the owner, optionally filtered by the classes.
+	OwnerNFTs(context.Context, *QueryOwnerNFTsRequest) (*QueryOwnerNFTsResponse, error)
 	// NFT queries an NFT based on its class and id.
 	NFT(context.Context, *QueryNFTRequest) (*QueryNFTResponse, error)
 	// Class queries an NFT class based on its id
@@ -998,6 +1142,10 @@ func (*UnimplementedQueryServer) NFTs(ctx context.Context, req *QueryNFTsRequest
 	return nil, status.Errorf(codes.Unimplemented, "method NFTs not implemented")
 }
 
+func (*UnimplementedQueryServer) OwnerNFTs(ctx context.Context, req *QueryOwnerNFTsRequest) (*QueryOwnerNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerNFTs not implemented")
+}
+
 func (*UnimplementedQueryServer) NFT(ctx context.Context, req *QueryNFTRequest) (*QueryNFTResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NFT not implemented")
 }
@@ -1086,6 +1234,24 @@ func _Query_NFTs_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnerNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.nft.v1beta1.Query/OwnerNFTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerNFTs(ctx, req.(*QueryOwnerNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_NFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNFTRequest)
 	if err := dec(in); err != nil {
@@ -1160,6 +1326,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NFTs",
 			Handler:    _Query_NFTs_Handler,
 		},
+		{
+			MethodName: "OwnerNFTs",
+			Handler:    _Query_OwnerNFTs_Handler,
+		},
 		{
 			MethodName: "NFT",
 			Handler:    _Query_NFT_Handler,
@@ -1465,6 +1635,106 @@ func (m *QueryNFTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOwnerNFTsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerNFTsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerNFTsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassIds) > 0 {
+		for iNdEx := len(m.ClassIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClassIds[iNdEx])
+			copy(dAtA[i:], m.ClassIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnerNFTsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnerNFTsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnerNFTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryNFTRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1822,6 +2092,48 @@ func (m *QueryNFTsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOwnerNFTsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ClassIds) > 0 {
+		for _, s := range m.ClassIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerNFTsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryNFTRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2726,6 +3038,278 @@ func (m *QueryNFTsResponse) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
+func (m *QueryOwnerNFTsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerNFTsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerNFTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassIds = append(m.ClassIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryOwnerNFTsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerNFTsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerNFTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nfts = append(m.Nfts, &NFT{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *QueryNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
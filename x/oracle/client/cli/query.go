@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryExchangeRate())
+	cmd.AddCommand(CmdQueryExchangeRates())
+	cmd.AddCommand(CmdQueryVote())
+	cmd.AddCommand(CmdQueryMissCounter())
+	return cmd
+}
+
+// CmdQueryParams return the QueryParams cobra command.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current oracle parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current oracle parameters.
+
+Example:
+$ %[1]s query oracle params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryExchangeRate return the QueryExchangeRate cobra command.
+func CmdQueryExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exchange rate of the denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the USD exchange rate of the denom agreed by the validators.
+
+Example:
+$ %[1]s query oracle exchange-rate [denom]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRate(cmd.Context(), &types.QueryExchangeRateRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryExchangeRates return the QueryExchangeRates cobra command.
+func CmdQueryExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rates",
+		Args:  cobra.NoArgs,
+		Short: "Query the exchange rates of all the denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the USD exchange rates of all the denoms agreed by the validators.
+
+Example:
+$ %[1]s query oracle exchange-rates
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRates(cmd.Context(), &types.QueryExchangeRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryVote return the QueryVote cobra command.
+func CmdQueryVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exchange rate vote of the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the exchange rate vote submitted by the validator in the current voting epoch.
+
+Example:
+$ %[1]s query oracle vote [validator]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Vote(cmd.Context(), &types.QueryVoteRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryMissCounter return the QueryMissCounter cobra command.
+func CmdQueryMissCounter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "miss-counter [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the miss counter of the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of voting epochs missed by the validator in the current slash window.
+
+Example:
+$ %[1]s query oracle miss-counter [validator]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MissCounter(cmd.Context(), &types.QueryMissCounterRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
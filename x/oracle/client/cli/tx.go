@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxSubmitExchangeRateVote(),
+	)
+
+	return cmd
+}
+
+// CmdTxSubmitExchangeRateVote returns SubmitExchangeRateVote cobra command.
+func CmdTxSubmitExchangeRateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-exchange-rate-vote [exchange_rates] --from [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the exchange rate vote of the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submits the USD exchange rates of the whitelisted denoms observed by the validator.
+The vote is valid for the current voting epoch only.
+
+Example:
+$ %s tx oracle submit-exchange-rate-vote 0.085ucore,1.0uusdc --from [validator]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			exchangeRates, err := sdk.ParseDecCoins(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid exchange rates")
+			}
+
+			msg := &types.MsgSubmitExchangeRateVote{
+				Validator:     sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				ExchangeRates: exchangeRates,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
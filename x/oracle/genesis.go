@@ -0,0 +1,42 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/keeper"
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+// InitGenesis initializes the oracle module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Params.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+
+	for _, exchangeRate := range genState.ExchangeRates {
+		k.SetExchangeRate(ctx, exchangeRate)
+	}
+
+	for _, vote := range genState.Votes {
+		k.SetVote(ctx, vote)
+	}
+
+	for _, missCounter := range genState.MissCounters {
+		valAddr, err := sdk.ValAddressFromBech32(missCounter.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.SetMissCounter(ctx, valAddr, missCounter.Count)
+	}
+}
+
+// ExportGenesis returns the oracle module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:        k.GetParams(ctx),
+		ExchangeRates: k.GetExchangeRates(ctx),
+		Votes:         k.GetVotes(ctx),
+		MissCounters:  k.GetMissCounters(ctx),
+	}
+}
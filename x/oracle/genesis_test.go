@@ -0,0 +1,66 @@
+package oracle_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/oracle"
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+func TestImportAndExportGenesis(t *testing.T) {
+	assertT := assert.New(t)
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	oracleKeeper := testApp.OracleKeeper
+
+	valAddr1 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr2 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	params := types.DefaultParams()
+	params.Whitelist = []string{"ucore", "uusdc"}
+
+	genState := types.GenesisState{
+		Params: params,
+		ExchangeRates: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.085")),
+			sdk.NewDecCoinFromDec("uusdc", sdk.OneDec()),
+		),
+		Votes: []types.ExchangeRateVote{
+			{
+				Validator:     valAddr1.String(),
+				ExchangeRates: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.09"))),
+			},
+		},
+		MissCounters: []types.MissCounter{
+			{Validator: valAddr1.String(), Count: 1},
+			{Validator: valAddr2.String(), Count: 5},
+		},
+	}
+	requireT.NoError(genState.Validate())
+
+	oracle.InitGenesis(ctx, oracleKeeper, genState)
+
+	assertT.Equal(params, oracleKeeper.GetParams(ctx))
+	exchangeRate, err := oracleKeeper.GetExchangeRate(ctx, "ucore")
+	requireT.NoError(err)
+	assertT.Equal(sdk.MustNewDecFromStr("0.085").String(), exchangeRate.Amount.String())
+	assertT.EqualValues(5, oracleKeeper.GetMissCounter(ctx, valAddr2))
+
+	// check that export is equal import
+	exportedGenState := oracle.ExportGenesis(ctx, oracleKeeper)
+
+	assertT.Equal(genState.Params, exportedGenState.Params)
+	assertT.Equal(genState.ExchangeRates.String(), exportedGenState.ExchangeRates.String())
+	assertT.ElementsMatch(genState.Votes, exportedGenState.Votes)
+	assertT.ElementsMatch(genState.MissCounters, exportedGenState.MissCounters)
+}
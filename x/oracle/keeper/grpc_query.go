@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetExchangeRate(ctx sdk.Context, denom string) (sdk.DecCoin, error)
+	GetExchangeRates(ctx sdk.Context) sdk.DecCoins
+	GetVote(ctx sdk.Context, valAddr sdk.ValAddress) (types.ExchangeRateVote, bool)
+	GetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress) uint64
+}
+
+// QueryService serves grpc query requests for the oracle module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params queries the parameters of the oracle module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// ExchangeRate queries the exchange rate of the denom.
+func (qs QueryService) ExchangeRate(ctx context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	exchangeRate, err := qs.keeper.GetExchangeRate(sdk.UnwrapSDKContext(ctx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: exchangeRate,
+	}, nil
+}
+
+// ExchangeRates queries the exchange rates of all the denoms.
+func (qs QueryService) ExchangeRates(ctx context.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryExchangeRatesResponse{
+		ExchangeRates: qs.keeper.GetExchangeRates(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// Vote queries the exchange rate vote submitted by the validator in the current voting epoch.
+func (qs QueryService) Vote(ctx context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	vote, found := qs.keeper.GetVote(sdk.UnwrapSDKContext(ctx), valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vote of the validator %s not found", req.Validator)
+	}
+
+	return &types.QueryVoteResponse{
+		Vote: vote,
+	}, nil
+}
+
+// MissCounter queries the number of voting epochs missed by the validator in the current slash window.
+func (qs QueryService) MissCounter(ctx context.Context, req *types.QueryMissCounterRequest) (*types.QueryMissCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid validator address")
+	}
+
+	return &types.QueryMissCounterResponse{
+		Count: qs.keeper.GetMissCounter(sdk.UnwrapSDKContext(ctx), valAddr),
+	}, nil
+}
@@ -0,0 +1,334 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+// ParamSubspace represents a subscope of methods exposed by param module to store and retrieve parameters.
+type ParamSubspace interface {
+	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+}
+
+// Keeper is the oracle module keeper.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	paramSubspace ParamSubspace
+	stakingKeeper types.StakingKeeper
+}
+
+// NewKeeper creates a new instance of the Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	paramSubspace ParamSubspace,
+	stakingKeeper types.StakingKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramSubspace,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSubspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// SubmitVote stores the exchange rate vote of the bonded validator for the current voting epoch.
+// The vote submitted earlier in the same epoch is replaced.
+func (k Keeper) SubmitVote(ctx sdk.Context, valAddr sdk.ValAddress, exchangeRates sdk.DecCoins) error {
+	if err := types.ValidateExchangeRateVote(valAddr.String(), exchangeRates); err != nil {
+		return err
+	}
+
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil || !validator.IsBonded() {
+		return sdkerrors.Wrapf(types.ErrValidatorNotBonded, "validator %s is not bonded", valAddr)
+	}
+
+	params := k.GetParams(ctx)
+	for _, exchangeRate := range exchangeRates {
+		if !params.IsWhitelisted(exchangeRate.Denom) {
+			return sdkerrors.Wrapf(types.ErrDenomNotWhitelisted, "denom %s is not whitelisted", exchangeRate.Denom)
+		}
+	}
+
+	k.SetVote(ctx, types.ExchangeRateVote{
+		Validator:     valAddr.String(),
+		ExchangeRates: exchangeRates,
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventExchangeRateVoted{
+		Validator:     valAddr.String(),
+		ExchangeRates: exchangeRates,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventExchangeRateVoted: %s", err)
+	}
+
+	return nil
+}
+
+// GetExchangeRate returns the exchange rate of the denom.
+func (k Keeper) GetExchangeRate(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetExchangeRateKey(denom))
+	if bz == nil {
+		return sdk.DecCoin{}, sdkerrors.Wrapf(types.ErrExchangeRateNotFound, "exchange rate of the denom %s not found", denom)
+	}
+
+	var rate sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &rate)
+	return sdk.NewDecCoinFromDec(denom, rate.Dec), nil
+}
+
+// GetExchangeRates returns the exchange rates of all the denoms.
+func (k Keeper) GetExchangeRates(ctx sdk.Context) sdk.DecCoins {
+	exchangeRates := sdk.NewDecCoins()
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExchangeRateKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rate sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &rate)
+		exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(string(iterator.Key()), rate.Dec))
+	}
+
+	return exchangeRates
+}
+
+// SetExchangeRate sets the exchange rate of the denom.
+func (k Keeper) SetExchangeRate(ctx sdk.Context, exchangeRate sdk.DecCoin) {
+	ctx.KVStore(k.storeKey).Set(
+		types.GetExchangeRateKey(exchangeRate.Denom),
+		k.cdc.MustMarshal(&sdk.DecProto{Dec: exchangeRate.Amount}),
+	)
+}
+
+// DeleteExchangeRate removes the exchange rate of the denom.
+func (k Keeper) DeleteExchangeRate(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetExchangeRateKey(denom))
+}
+
+// GetPriceUSD returns the exchange rate of the denom, it is used by the fee model to compute the gas price floor.
+func (k Keeper) GetPriceUSD(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	exchangeRate, err := k.GetExchangeRate(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, false
+	}
+	return exchangeRate.Amount, true
+}
+
+// GetVote returns the exchange rate vote submitted by the validator in the current voting epoch.
+func (k Keeper) GetVote(ctx sdk.Context, valAddr sdk.ValAddress) (types.ExchangeRateVote, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(valAddr))
+	if bz == nil {
+		return types.ExchangeRateVote{}, false
+	}
+
+	var vote types.ExchangeRateVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// GetVotes returns the exchange rate votes submitted in the current voting epoch.
+func (k Keeper) GetVotes(ctx sdk.Context) []types.ExchangeRateVote {
+	votes := []types.ExchangeRateVote{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.ExchangeRateVote
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		votes = append(votes, vote)
+	}
+
+	return votes
+}
+
+// SetVote stores the exchange rate vote of the validator.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.ExchangeRateVote) {
+	valAddr := mustValAddressFromBech32(vote.Validator)
+	ctx.KVStore(k.storeKey).Set(types.GetVoteKey(valAddr), k.cdc.MustMarshal(&vote))
+}
+
+// ClearVotes removes all the exchange rate votes.
+func (k Keeper) ClearVotes(ctx sdk.Context) {
+	clearPrefix(ctx.KVStore(k.storeKey), types.VoteKeyPrefix)
+}
+
+// GetMissCounter returns the number of voting epochs missed by the validator in the current slash window.
+func (k Keeper) GetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMissCounterKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetMissCounters returns the miss counters of all the validators.
+func (k Keeper) GetMissCounters(ctx sdk.Context) []types.MissCounter {
+	missCounters := []types.MissCounter{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.MissCounterKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the length prefixed validator address
+		valAddr := sdk.ValAddress(iterator.Key()[1:])
+		missCounters = append(missCounters, types.MissCounter{
+			Validator: valAddr.String(),
+			Count:     sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return missCounters
+}
+
+// SetMissCounter sets the miss counter of the validator.
+func (k Keeper) SetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetMissCounterKey(valAddr), sdk.Uint64ToBigEndian(count))
+}
+
+// ClearMissCounters removes the miss counters of all the validators.
+func (k Keeper) ClearMissCounters(ctx sdk.Context) {
+	clearPrefix(ctx.KVStore(k.storeKey), types.MissCounterKeyPrefix)
+}
+
+// EndBlocker tallies the votes at the end of the voting epoch and penalizes the validators
+// missing too many votes at the end of the slash window.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.IsVotePeriodLastBlock(ctx.BlockHeight()) {
+		k.tally(ctx, params)
+	}
+	if params.IsSlashWindowLastBlock(ctx.BlockHeight()) {
+		k.penalizeMissingValidators(ctx, params)
+	}
+}
+
+func (k Keeper) tally(ctx sdk.Context, params types.Params) {
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	votes := make(map[string]types.ExchangeRateVote)
+	for _, vote := range k.GetVotes(ctx) {
+		votes[vote.Validator] = vote
+	}
+
+	var totalPower int64
+	ballots := make(map[string]types.Ballot, len(params.Whitelist))
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		power := validator.GetConsensusPower(powerReduction)
+		totalPower += power
+
+		valAddr := validator.GetOperator()
+		vote, ok := votes[valAddr.String()]
+		if !ok {
+			k.SetMissCounter(ctx, valAddr, k.GetMissCounter(ctx, valAddr)+1)
+			return false
+		}
+		for _, exchangeRate := range vote.ExchangeRates {
+			// the whitelist might be changed by the governance after the vote was submitted
+			if !params.IsWhitelisted(exchangeRate.Denom) {
+				continue
+			}
+			ballots[exchangeRate.Denom] = append(ballots[exchangeRate.Denom], types.BallotVote{
+				ExchangeRate: exchangeRate.Amount,
+				Power:        power,
+			})
+		}
+		return false
+	})
+
+	thresholdPower := params.VoteThreshold.MulInt64(totalPower)
+	for _, denom := range params.Whitelist {
+		ballot := ballots[denom]
+		if totalPower == 0 || sdk.NewDec(ballot.Power()).LT(thresholdPower) {
+			// the stale exchange rate is removed to not be used when the validators are unable to agree on the new one
+			k.DeleteExchangeRate(ctx, denom)
+			continue
+		}
+
+		exchangeRate := sdk.NewDecCoinFromDec(denom, ballot.WeightedMedian())
+		k.SetExchangeRate(ctx, exchangeRate)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventExchangeRateUpdated{
+			ExchangeRate: exchangeRate,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	k.ClearVotes(ctx)
+}
+
+func (k Keeper) penalizeMissingValidators(ctx sdk.Context, params types.Params) {
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	votePeriodsPerWindow := sdk.NewDec(int64(params.SlashWindow))
+	// the infraction took place in the past, so the height is taken in the same way the slashing module does it
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	for _, missCounter := range k.GetMissCounters(ctx) {
+		validPerWindow := votePeriodsPerWindow.Sub(sdk.NewDec(int64(missCounter.Count))).Quo(votePeriodsPerWindow)
+		if !validPerWindow.LT(params.MinValidPerWindow) {
+			continue
+		}
+
+		valAddr := mustValAddressFromBech32(missCounter.Validator)
+		validator := k.stakingKeeper.Validator(ctx, valAddr)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			continue
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+
+		k.stakingKeeper.Slash(ctx, consAddr, distributionHeight, validator.GetConsensusPower(powerReduction), params.SlashFraction)
+		k.stakingKeeper.Jail(ctx, consAddr)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventValidatorPenalized{
+			Validator: missCounter.Validator,
+			MissCount: missCounter.Count,
+		}); err != nil {
+			panic(err)
+		}
+	}
+
+	k.ClearMissCounters(ctx)
+}
+
+func clearPrefix(store sdk.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
+func mustValAddressFromBech32(address string) sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
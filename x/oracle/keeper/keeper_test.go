@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/oracle/keeper"
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+type stakingKeeperMock struct {
+	validators []stakingtypes.Validator
+	slashed    []sdk.ConsAddress
+	jailed     []sdk.ConsAddress
+}
+
+func (m *stakingKeeperMock) Validator(_ sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI {
+	for _, validator := range m.validators {
+		if validator.GetOperator().Equals(address) {
+			return validator
+		}
+	}
+	return nil
+}
+
+func (m *stakingKeeperMock) IterateBondedValidatorsByPower(
+	_ sdk.Context,
+	fn func(index int64, validator stakingtypes.ValidatorI) (stop bool),
+) {
+	for i, validator := range m.validators {
+		if !validator.IsBonded() {
+			continue
+		}
+		if fn(int64(i), validator) {
+			return
+		}
+	}
+}
+
+func (m *stakingKeeperMock) PowerReduction(_ sdk.Context) sdk.Int {
+	return sdk.DefaultPowerReduction
+}
+
+func (m *stakingKeeperMock) Slash(_ sdk.Context, consAddr sdk.ConsAddress, _, _ int64, _ sdk.Dec) {
+	m.slashed = append(m.slashed, consAddr)
+}
+
+func (m *stakingKeeperMock) Jail(_ sdk.Context, consAddr sdk.ConsAddress) {
+	m.jailed = append(m.jailed, consAddr)
+}
+
+func newValidator(t *testing.T, power int64, status stakingtypes.BondStatus) stakingtypes.Validator {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = status
+	validator.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	return validator
+}
+
+func setup(t *testing.T, validators ...stakingtypes.Validator) (sdk.Context, keeper.Keeper, *stakingKeeperMock) {
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	stakingKeeper := &stakingKeeperMock{validators: validators}
+	oracleKeeper := keeper.NewKeeper(
+		testApp.AppCodec(),
+		testApp.GetKey(types.StoreKey),
+		testApp.GetSubspace(types.ModuleName),
+		stakingKeeper,
+	)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 5
+	params.SlashWindow = 2
+	params.MinValidPerWindow = sdk.MustNewDecFromStr("0.5")
+	params.Whitelist = []string{"ucore", "uusdc"}
+	oracleKeeper.SetParams(ctx, params)
+
+	return ctx, oracleKeeper, stakingKeeper
+}
+
+func TestKeeper_SubmitVote(t *testing.T) {
+	requireT := require.New(t)
+
+	bonded := newValidator(t, 10, stakingtypes.Bonded)
+	unbonded := newValidator(t, 10, stakingtypes.Unbonded)
+	ctx, oracleKeeper, _ := setup(t, bonded, unbonded)
+
+	exchangeRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.085")))
+
+	err := oracleKeeper.SubmitVote(ctx, unbonded.GetOperator(), exchangeRates)
+	requireT.ErrorIs(err, types.ErrValidatorNotBonded)
+
+	err = oracleKeeper.SubmitVote(ctx, bonded.GetOperator(), sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.OneDec())))
+	requireT.ErrorIs(err, types.ErrDenomNotWhitelisted)
+
+	requireT.NoError(oracleKeeper.SubmitVote(ctx, bonded.GetOperator(), exchangeRates))
+	vote, found := oracleKeeper.GetVote(ctx, bonded.GetOperator())
+	requireT.True(found)
+	requireT.Equal(exchangeRates.String(), vote.ExchangeRates.String())
+
+	_, found = oracleKeeper.GetVote(ctx, unbonded.GetOperator())
+	requireT.False(found)
+}
+
+func TestKeeper_Tally(t *testing.T) {
+	requireT := require.New(t)
+
+	validator1 := newValidator(t, 10, stakingtypes.Bonded)
+	validator2 := newValidator(t, 20, stakingtypes.Bonded)
+	validator3 := newValidator(t, 30, stakingtypes.Bonded)
+	ctx, oracleKeeper, _ := setup(t, validator1, validator2, validator3)
+
+	requireT.NoError(oracleKeeper.SubmitVote(ctx, validator1.GetOperator(), sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.1")),
+		sdk.NewDecCoinFromDec("uusdc", sdk.MustNewDecFromStr("1")),
+	)))
+	requireT.NoError(oracleKeeper.SubmitVote(ctx, validator3.GetOperator(), sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.2")),
+	)))
+
+	// the votes are not tallied before the end of the epoch
+	oracleKeeper.EndBlocker(ctx.WithBlockHeight(3))
+	_, err := oracleKeeper.GetExchangeRate(ctx, "ucore")
+	requireT.ErrorIs(err, types.ErrExchangeRateNotFound)
+
+	oracleKeeper.EndBlocker(ctx.WithBlockHeight(4))
+
+	// 40 of 60 voted for ucore, the weighted median is the rate voted by validator3
+	exchangeRate, err := oracleKeeper.GetExchangeRate(ctx, "ucore")
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.2").String(), exchangeRate.Amount.String())
+
+	priceUSD, found := oracleKeeper.GetPriceUSD(ctx, "ucore")
+	requireT.True(found)
+	requireT.Equal(exchangeRate.Amount.String(), priceUSD.String())
+
+	// 10 of 60 voted for uusdc, it's below the threshold
+	_, err = oracleKeeper.GetExchangeRate(ctx, "uusdc")
+	requireT.ErrorIs(err, types.ErrExchangeRateNotFound)
+
+	requireT.Empty(oracleKeeper.GetVotes(ctx))
+	requireT.EqualValues(0, oracleKeeper.GetMissCounter(ctx, validator1.GetOperator()))
+	requireT.EqualValues(1, oracleKeeper.GetMissCounter(ctx, validator2.GetOperator()))
+	requireT.EqualValues(0, oracleKeeper.GetMissCounter(ctx, validator3.GetOperator()))
+}
+
+func TestKeeper_PenalizeMissingValidators(t *testing.T) {
+	requireT := require.New(t)
+
+	validator1 := newValidator(t, 10, stakingtypes.Bonded)
+	validator2 := newValidator(t, 20, stakingtypes.Bonded)
+	ctx, oracleKeeper, stakingKeeper := setup(t, validator1, validator2)
+
+	exchangeRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.1")))
+
+	// validator1 misses both epochs of the slash window, validator2 misses one
+	requireT.NoError(oracleKeeper.SubmitVote(ctx, validator2.GetOperator(), exchangeRates))
+	oracleKeeper.EndBlocker(ctx.WithBlockHeight(4))
+	requireT.Empty(stakingKeeper.slashed)
+
+	oracleKeeper.EndBlocker(ctx.WithBlockHeight(9))
+
+	consAddr1, err := validator1.GetConsAddr()
+	requireT.NoError(err)
+	requireT.Equal([]sdk.ConsAddress{consAddr1}, stakingKeeper.slashed)
+	requireT.Equal([]sdk.ConsAddress{consAddr1}, stakingKeeper.jailed)
+	requireT.Empty(oracleKeeper.GetMissCounters(ctx))
+}
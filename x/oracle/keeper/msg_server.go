@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	SubmitVote(ctx sdk.Context, valAddr sdk.ValAddress, exchangeRates sdk.DecCoins) error
+}
+
+// MsgServer serves grpc tx requests for the oracle module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// SubmitExchangeRateVote submits the exchange rate vote of the validator.
+func (ms MsgServer) SubmitExchangeRateVote(ctx context.Context, req *types.MsgSubmitExchangeRateVote) (*types.EmptyResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid validator in MsgSubmitExchangeRateVote")
+	}
+	if err := ms.keeper.SubmitVote(sdk.UnwrapSDKContext(ctx), valAddr, req.ExchangeRates); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BallotVote is the exchange rate voted by the validator having the voting power.
+type BallotVote struct {
+	ExchangeRate sdk.Dec
+	Power        int64
+}
+
+// Ballot is the set of the exchange rates voted for the denom in the voting epoch.
+type Ballot []BallotVote
+
+// Power returns the total voting power of the ballot.
+func (b Ballot) Power() int64 {
+	var power int64
+	for _, vote := range b {
+		power += vote.Power
+	}
+	return power
+}
+
+// WeightedMedian returns the exchange rate voted by the validators holding the median of the voting power.
+// Zero is returned for the empty ballot.
+func (b Ballot) WeightedMedian() sdk.Dec {
+	totalPower := b.Power()
+	if totalPower == 0 {
+		return sdk.ZeroDec()
+	}
+
+	sorted := make(Ballot, len(b))
+	copy(sorted, b)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ExchangeRate.LT(sorted[j].ExchangeRate)
+	})
+
+	var cumulativePower int64
+	for _, vote := range sorted {
+		cumulativePower += vote.Power
+		if cumulativePower*2 >= totalPower {
+			return vote.ExchangeRate
+		}
+	}
+
+	return sorted[len(sorted)-1].ExchangeRate
+}
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+func TestBallotWeightedMedian(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ballot   types.Ballot
+		expected sdk.Dec
+	}{
+		{
+			name:     "empty",
+			ballot:   types.Ballot{},
+			expected: sdk.ZeroDec(),
+		},
+		{
+			name: "single",
+			ballot: types.Ballot{
+				{ExchangeRate: sdk.MustNewDecFromStr("1.5"), Power: 10},
+			},
+			expected: sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name: "equal_power",
+			ballot: types.Ballot{
+				{ExchangeRate: sdk.MustNewDecFromStr("3"), Power: 10},
+				{ExchangeRate: sdk.MustNewDecFromStr("1"), Power: 10},
+				{ExchangeRate: sdk.MustNewDecFromStr("2"), Power: 10},
+			},
+			expected: sdk.MustNewDecFromStr("2"),
+		},
+		{
+			name: "dominant_power",
+			ballot: types.Ballot{
+				{ExchangeRate: sdk.MustNewDecFromStr("1"), Power: 10},
+				{ExchangeRate: sdk.MustNewDecFromStr("2"), Power: 10},
+				{ExchangeRate: sdk.MustNewDecFromStr("10"), Power: 100},
+			},
+			expected: sdk.MustNewDecFromStr("10"),
+		},
+		{
+			name: "half_power",
+			ballot: types.Ballot{
+				{ExchangeRate: sdk.MustNewDecFromStr("2"), Power: 10},
+				{ExchangeRate: sdk.MustNewDecFromStr("1"), Power: 10},
+			},
+			expected: sdk.MustNewDecFromStr("1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected.String(), tc.ballot.WeightedMedian().String())
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrInvalidInput defines the common error for the invalid input.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrValidatorNotBonded is returned when the vote is submitted by the validator which is not bonded.
+	ErrValidatorNotBonded = sdkerrors.Register(ModuleName, 2, "validator is not bonded")
+	// ErrDenomNotWhitelisted is returned when the vote contains the exchange rate of the denom which is not whitelisted.
+	ErrDenomNotWhitelisted = sdkerrors.Register(ModuleName, 3, "denom is not whitelisted")
+	// ErrExchangeRateNotFound is returned when the exchange rate of the denom is not available.
+	ErrExchangeRateNotFound = sdkerrors.Register(ModuleName, 4, "exchange rate not found")
+)
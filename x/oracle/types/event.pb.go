@@ -0,0 +1,797 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/oracle/v1/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventExchangeRateVoted is emitted on MsgSubmitExchangeRateVote.
+type EventExchangeRateVoted struct {
+	Validator     string                                      `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+}
+
+func (m *EventExchangeRateVoted) Reset()         { *m = EventExchangeRateVoted{} }
+func (m *EventExchangeRateVoted) String() string { return proto.CompactTextString(m) }
+func (*EventExchangeRateVoted) ProtoMessage()    {}
+func (*EventExchangeRateVoted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed07ec8efb0e5820, []int{0}
+}
+
+func (m *EventExchangeRateVoted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventExchangeRateVoted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExchangeRateVoted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventExchangeRateVoted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExchangeRateVoted.Merge(m, src)
+}
+
+func (m *EventExchangeRateVoted) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventExchangeRateVoted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExchangeRateVoted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExchangeRateVoted proto.InternalMessageInfo
+
+func (m *EventExchangeRateVoted) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventExchangeRateVoted) GetExchangeRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+// EventExchangeRateUpdated is emitted when the exchange rate of the denom is updated at the end of the voting epoch.
+type EventExchangeRateUpdated struct {
+	ExchangeRate types.DecCoin `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate"`
+}
+
+func (m *EventExchangeRateUpdated) Reset()         { *m = EventExchangeRateUpdated{} }
+func (m *EventExchangeRateUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventExchangeRateUpdated) ProtoMessage()    {}
+func (*EventExchangeRateUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed07ec8efb0e5820, []int{1}
+}
+
+func (m *EventExchangeRateUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventExchangeRateUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExchangeRateUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventExchangeRateUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExchangeRateUpdated.Merge(m, src)
+}
+
+func (m *EventExchangeRateUpdated) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventExchangeRateUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExchangeRateUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExchangeRateUpdated proto.InternalMessageInfo
+
+func (m *EventExchangeRateUpdated) GetExchangeRate() types.DecCoin {
+	if m != nil {
+		return m.ExchangeRate
+	}
+	return types.DecCoin{}
+}
+
+// EventValidatorPenalized is emitted when the validator is slashed and jailed for missing too many votes.
+type EventValidatorPenalized struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	MissCount uint64 `protobuf:"varint,2,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+}
+
+func (m *EventValidatorPenalized) Reset()         { *m = EventValidatorPenalized{} }
+func (m *EventValidatorPenalized) String() string { return proto.CompactTextString(m) }
+func (*EventValidatorPenalized) ProtoMessage()    {}
+func (*EventValidatorPenalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed07ec8efb0e5820, []int{2}
+}
+
+func (m *EventValidatorPenalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *EventValidatorPenalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventValidatorPenalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *EventValidatorPenalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventValidatorPenalized.Merge(m, src)
+}
+
+func (m *EventValidatorPenalized) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *EventValidatorPenalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventValidatorPenalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventValidatorPenalized proto.InternalMessageInfo
+
+func (m *EventValidatorPenalized) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventValidatorPenalized) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventExchangeRateVoted)(nil), "coreum.oracle.v1.EventExchangeRateVoted")
+	proto.RegisterType((*EventExchangeRateUpdated)(nil), "coreum.oracle.v1.EventExchangeRateUpdated")
+	proto.RegisterType((*EventValidatorPenalized)(nil), "coreum.oracle.v1.EventValidatorPenalized")
+}
+
+func init() { proto.RegisterFile("coreum/oracle/v1/event.proto", fileDescriptor_ed07ec8efb0e5820) }
+
+var fileDescriptor_ed07ec8efb0e5820 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
+	0x10, 0xc7, 0x5b, 0x3e, 0xf2, 0x25, 0xec, 0xf7, 0x61, 0x0c, 0x31, 0xda, 0x10, 0x2c, 0x84, 0x13,
+	0x89, 0x71, 0x37, 0x95, 0x37, 0x00, 0xd1, 0x83, 0x17, 0xd3, 0x44, 0x0e, 0x5e, 0xc8, 0x76, 0xbb,
+	0x29, 0x1b, 0xe9, 0x0e, 0xe9, 0x6e, 0x1b, 0xf4, 0x29, 0x7c, 0x0e, 0x4f, 0x3e, 0x06, 0x47, 0x8e,
+	0x9e, 0xd4, 0xc0, 0x8b, 0x98, 0x6d, 0x4b, 0xc4, 0x78, 0xe0, 0xb4, 0x9b, 0xf9, 0xef, 0xcc, 0xff,
+	0xb7, 0x33, 0x83, 0x5a, 0x0c, 0x12, 0x9e, 0xc6, 0x04, 0x12, 0xca, 0x66, 0x9c, 0x64, 0x1e, 0xe1,
+	0x19, 0x97, 0x1a, 0xcf, 0x13, 0xd0, 0xd0, 0x38, 0x2c, 0x54, 0x5c, 0xa8, 0x38, 0xf3, 0x9a, 0x47,
+	0x11, 0x44, 0x90, 0x8b, 0xc4, 0xdc, 0x8a, 0x77, 0x4d, 0x97, 0x81, 0x8a, 0x41, 0x91, 0x80, 0x2a,
+	0x53, 0x23, 0xe0, 0x9a, 0x7a, 0x84, 0x81, 0x90, 0x85, 0xde, 0x7d, 0xb5, 0xd1, 0xf1, 0xc8, 0xd4,
+	0x1d, 0x2d, 0xd8, 0x94, 0xca, 0x88, 0xfb, 0x54, 0xf3, 0x31, 0x68, 0x1e, 0x36, 0x5a, 0xa8, 0x96,
+	0xd1, 0x99, 0x08, 0xa9, 0x86, 0xc4, 0xb1, 0x3b, 0x76, 0xaf, 0xe6, 0x7f, 0x07, 0x1a, 0x0b, 0x74,
+	0xc0, 0xcb, 0x94, 0x49, 0x42, 0x35, 0x57, 0x4e, 0xa5, 0xf3, 0xa7, 0xf7, 0xef, 0xa2, 0x85, 0x0b,
+	0x47, 0x6c, 0x1c, 0x71, 0xe9, 0x88, 0x2f, 0x39, 0x1b, 0x82, 0x90, 0x83, 0xfe, 0xf2, 0xbd, 0x6d,
+	0xbd, 0x7c, 0xb4, 0xcf, 0x22, 0xa1, 0xa7, 0x69, 0x80, 0x19, 0xc4, 0xa4, 0x24, 0x2c, 0x8e, 0x73,
+	0x15, 0x3e, 0x10, 0xfd, 0x38, 0xe7, 0x6a, 0x9b, 0xa3, 0xfc, 0x3a, 0xdf, 0x61, 0x53, 0x5d, 0x86,
+	0x9c, 0x5f, 0xc4, 0x77, 0xf3, 0x90, 0x1a, 0xe6, 0x6b, 0x54, 0xff, 0x41, 0x95, 0x73, 0xef, 0x83,
+	0xaa, 0x1a, 0x28, 0xff, 0xff, 0xae, 0x4b, 0x77, 0x8c, 0x4e, 0x72, 0x93, 0xf1, 0xf6, 0xc3, 0xb7,
+	0x5c, 0xd2, 0x99, 0x78, 0xda, 0xdb, 0x97, 0x53, 0x84, 0x62, 0xa1, 0xd4, 0x84, 0x41, 0x2a, 0xb5,
+	0x53, 0xe9, 0xd8, 0xbd, 0xaa, 0x5f, 0x33, 0x91, 0xa1, 0x09, 0x0c, 0x6e, 0x96, 0x6b, 0xd7, 0x5e,
+	0xad, 0x5d, 0xfb, 0x73, 0xed, 0xda, 0xcf, 0x1b, 0xd7, 0x5a, 0x6d, 0x5c, 0xeb, 0x6d, 0xe3, 0x5a,
+	0xf7, 0xde, 0x4e, 0x4b, 0x86, 0xf9, 0x70, 0xaf, 0x20, 0x95, 0x21, 0xd5, 0x02, 0x24, 0x29, 0x77,
+	0x61, 0xb1, 0xdd, 0x86, 0xbc, 0x43, 0xc1, 0xdf, 0x7c, 0x86, 0xfd, 0xaf, 0x01, 0x00, 0xa0, 0x8a,
+	0x68, 0xa8, 0x2b, 0x02, 0x00, 0x00,
+}
+
+func (m *EventExchangeRateVoted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExchangeRateVoted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExchangeRateVoted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExchangeRateUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExchangeRateUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExchangeRateUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValidatorPenalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValidatorPenalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValidatorPenalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissCount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *EventExchangeRateVoted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventExchangeRateUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventValidatorPenalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovEvent(uint64(m.MissCount))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *EventExchangeRateVoted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExchangeRateVoted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExchangeRateVoted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventExchangeRateUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExchangeRateUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExchangeRateUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EventValidatorPenalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorPenalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorPenalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+// DefaultGenesis returns the default oracle genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (m GenesisState) Validate() error {
+	if err := m.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := m.ExchangeRates.Validate(); err != nil {
+		return errors.Wrap(err, "invalid exchange rates")
+	}
+
+	validators := make(map[string]struct{}, len(m.Votes))
+	for _, vote := range m.Votes {
+		if err := ValidateExchangeRateVote(vote.Validator, vote.ExchangeRates); err != nil {
+			return err
+		}
+		if _, ok := validators[vote.Validator]; ok {
+			return errors.Errorf("duplicated vote of the validator %s", vote.Validator)
+		}
+		validators[vote.Validator] = struct{}{}
+	}
+
+	validators = make(map[string]struct{}, len(m.MissCounters))
+	for _, missCounter := range m.MissCounters {
+		if _, err := sdk.ValAddressFromBech32(missCounter.Validator); err != nil {
+			return errors.Wrapf(err, "invalid validator address %s", missCounter.Validator)
+		}
+		if _, ok := validators[missCounter.Validator]; ok {
+			return errors.Errorf("duplicated miss counter of the validator %s", missCounter.Validator)
+		}
+		validators[missCounter.Validator] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+func TestGenesisValidation(t *testing.T) {
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	exchangeRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.085")))
+
+	testCases := []struct {
+		name     string
+		modifier func(genState *types.GenesisState)
+		valid    bool
+	}{
+		{
+			name:     "default",
+			modifier: func(genState *types.GenesisState) {},
+			valid:    true,
+		},
+		{
+			name: "full",
+			modifier: func(genState *types.GenesisState) {
+				genState.ExchangeRates = exchangeRates
+				genState.Votes = []types.ExchangeRateVote{{Validator: valAddr.String(), ExchangeRates: exchangeRates}}
+				genState.MissCounters = []types.MissCounter{{Validator: valAddr.String(), Count: 2}}
+			},
+			valid: true,
+		},
+		{
+			name: "invalid_params",
+			modifier: func(genState *types.GenesisState) {
+				genState.Params.VotePeriod = 0
+			},
+		},
+		{
+			name: "duplicated_vote",
+			modifier: func(genState *types.GenesisState) {
+				vote := types.ExchangeRateVote{Validator: valAddr.String(), ExchangeRates: exchangeRates}
+				genState.Votes = []types.ExchangeRateVote{vote, vote}
+			},
+		},
+		{
+			name: "invalid_miss_counter_validator",
+			modifier: func(genState *types.GenesisState) {
+				genState.MissCounters = []types.MissCounter{{Validator: "invalid", Count: 2}}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesis()
+			tc.modifier(genState)
+			err := genState.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/CoreumFoundation/coreum/pkg/store"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Store key prefixes
+var (
+	// ExchangeRateKeyPrefix defines the key prefix for the exchange rates.
+	ExchangeRateKeyPrefix = []byte{0x01}
+	// VoteKeyPrefix defines the key prefix for the exchange rate votes of the current voting epoch.
+	VoteKeyPrefix = []byte{0x02}
+	// MissCounterKeyPrefix defines the key prefix for the miss counters of the validators.
+	MissCounterKeyPrefix = []byte{0x03}
+)
+
+// GetExchangeRateKey constructs the key for the exchange rate of the denom.
+func GetExchangeRateKey(denom string) []byte {
+	return store.JoinKeys(ExchangeRateKeyPrefix, []byte(denom))
+}
+
+// GetVoteKey constructs the key for the exchange rate vote of the validator.
+func GetVoteKey(valAddr []byte) []byte {
+	return store.JoinKeys(VoteKeyPrefix, address.MustLengthPrefix(valAddr))
+}
+
+// GetMissCounterKey constructs the key for the miss counter of the validator.
+func GetMissCounterKey(valAddr []byte) []byte {
+	return store.JoinKeys(MissCounterKeyPrefix, address.MustLengthPrefix(valAddr))
+}
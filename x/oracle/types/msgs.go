@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitExchangeRateVote{}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgSubmitExchangeRateVote) ValidateBasic() error {
+	return ValidateExchangeRateVote(msg.Validator, msg.ExchangeRates)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgSubmitExchangeRateVote) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{
+		sdk.AccAddress(valAddr),
+	}
+}
+
+// ValidateExchangeRateVote checks that the validator address and the exchange rates of the vote are valid.
+func ValidateExchangeRateVote(validator string, exchangeRates sdk.DecCoins) error {
+	if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s", validator)
+	}
+
+	if len(exchangeRates) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "exchange rates must not be empty")
+	}
+
+	// the validation requires the exchange rates to be sorted, unique and positive
+	if err := exchangeRates.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid exchange rates: %s", err)
+	}
+
+	return nil
+}
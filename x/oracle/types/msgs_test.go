@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+func TestMsgSubmitExchangeRateVote_ValidateBasic(t *testing.T) {
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name        string
+		message     types.MsgSubmitExchangeRateVote
+		expectedErr bool
+	}{
+		{
+			name: "valid",
+			message: types.MsgSubmitExchangeRateVote{
+				Validator: valAddr.String(),
+				ExchangeRates: sdk.NewDecCoins(
+					sdk.NewDecCoinFromDec("ucore", sdk.MustNewDecFromStr("0.085")),
+					sdk.NewDecCoinFromDec("uusdc", sdk.OneDec()),
+				),
+			},
+		},
+		{
+			name: "invalid_validator",
+			message: types.MsgSubmitExchangeRateVote{
+				Validator:     sdk.AccAddress(valAddr).String(),
+				ExchangeRates: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ucore", sdk.OneDec())),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty_exchange_rates",
+			message: types.MsgSubmitExchangeRateVote{
+				Validator: valAddr.String(),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "unsorted_exchange_rates",
+			message: types.MsgSubmitExchangeRateVote{
+				Validator: valAddr.String(),
+				ExchangeRates: sdk.DecCoins{
+					sdk.NewDecCoinFromDec("uusdc", sdk.OneDec()),
+					sdk.NewDecCoinFromDec("ucore", sdk.OneDec()),
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "zero_exchange_rate",
+			message: types.MsgSubmitExchangeRateVote{
+				Validator: valAddr.String(),
+				ExchangeRates: sdk.DecCoins{
+					{Denom: "ucore", Amount: sdk.ZeroDec()},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.message.ValidateBasic()
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+// Parameter keys
+var (
+	KeyVotePeriod        = []byte("VotePeriod")
+	KeyVoteThreshold     = []byte("VoteThreshold")
+	KeyWhitelist         = []byte("Whitelist")
+	KeySlashWindow       = []byte("SlashWindow")
+	KeyMinValidPerWindow = []byte("MinValidPerWindow")
+	KeySlashFraction     = []byte("SlashFraction")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns params with default values.
+// The whitelist is empty by default, so the voting is disabled until the denoms are added by the governance.
+func DefaultParams() Params {
+	return Params{
+		VotePeriod:        30,
+		VoteThreshold:     sdk.MustNewDecFromStr("0.5"),
+		Whitelist:         []string{},
+		SlashWindow:       3360,
+		MinValidPerWindow: sdk.MustNewDecFromStr("0.05"),
+		SlashFraction:     sdk.MustNewDecFromStr("0.0001"),
+	}
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// of the oracle parameters.
+func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVotePeriod, &m.VotePeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyVoteThreshold, &m.VoteThreshold, validateVoteThreshold),
+		paramtypes.NewParamSetPair(KeyWhitelist, &m.Whitelist, validateWhitelist),
+		paramtypes.NewParamSetPair(KeySlashWindow, &m.SlashWindow, validatePeriod),
+		paramtypes.NewParamSetPair(KeyMinValidPerWindow, &m.MinValidPerWindow, validateFraction),
+		paramtypes.NewParamSetPair(KeySlashFraction, &m.SlashFraction, validateFraction),
+	}
+}
+
+// ValidateBasic validates the oracle parameters.
+func (m Params) ValidateBasic() error {
+	if err := validatePeriod(m.VotePeriod); err != nil {
+		return errors.Wrap(err, "invalid vote period")
+	}
+	if err := validateVoteThreshold(m.VoteThreshold); err != nil {
+		return errors.Wrap(err, "invalid vote threshold")
+	}
+	if err := validateWhitelist(m.Whitelist); err != nil {
+		return errors.Wrap(err, "invalid whitelist")
+	}
+	if err := validatePeriod(m.SlashWindow); err != nil {
+		return errors.Wrap(err, "invalid slash window")
+	}
+	if err := validateFraction(m.MinValidPerWindow); err != nil {
+		return errors.Wrap(err, "invalid min valid per window")
+	}
+	if err := validateFraction(m.SlashFraction); err != nil {
+		return errors.Wrap(err, "invalid slash fraction")
+	}
+	return nil
+}
+
+// IsWhitelisted returns true if the denom is whitelisted.
+func (m Params) IsWhitelisted(denom string) bool {
+	for _, d := range m.Whitelist {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsVotePeriodLastBlock returns true if the block at the height is the last one of the voting epoch.
+func (m Params) IsVotePeriodLastBlock(height int64) bool {
+	return (uint64(height)+1)%m.VotePeriod == 0
+}
+
+// IsSlashWindowLastBlock returns true if the block at the height is the last one of the slash window.
+func (m Params) IsSlashWindowLastBlock(height int64) bool {
+	return (uint64(height)+1)%(m.VotePeriod*m.SlashWindow) == 0
+}
+
+func validatePeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return errors.New("period must be positive")
+	}
+	return nil
+}
+
+func validateVoteThreshold(i interface{}) error {
+	if err := validateFraction(i); err != nil {
+		return err
+	}
+	if v := i.(sdk.Dec); v.LT(sdk.MustNewDecFromStr("0.5")) {
+		return errors.Errorf("vote threshold must be greater than or equal to 0.5: %s", v)
+	}
+	return nil
+}
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return errors.New("fraction must be set")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return errors.Errorf("fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateWhitelist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return errors.Errorf("invalid parameter type: %T", i)
+	}
+
+	present := make(map[string]struct{}, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errors.Wrapf(err, "invalid whitelisted denom %q", denom)
+		}
+		if _, ok := present[denom]; ok {
+			return errors.Errorf("duplicated whitelisted denom %q", denom)
+		}
+		present[denom] = struct{}{}
+	}
+	return nil
+}
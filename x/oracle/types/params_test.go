@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CoreumFoundation/coreum/x/oracle/types"
+)
+
+func TestParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modifier func(params *types.Params)
+		valid    bool
+	}{
+		{
+			name:     "default",
+			modifier: func(params *types.Params) {},
+			valid:    true,
+		},
+		{
+			name: "whitelist",
+			modifier: func(params *types.Params) {
+				params.Whitelist = []string{"ucore", "uusdc"}
+			},
+			valid: true,
+		},
+		{
+			name: "zero_vote_period",
+			modifier: func(params *types.Params) {
+				params.VotePeriod = 0
+			},
+		},
+		{
+			name: "low_vote_threshold",
+			modifier: func(params *types.Params) {
+				params.VoteThreshold = sdk.MustNewDecFromStr("0.4")
+			},
+		},
+		{
+			name: "high_vote_threshold",
+			modifier: func(params *types.Params) {
+				params.VoteThreshold = sdk.MustNewDecFromStr("1.1")
+			},
+		},
+		{
+			name: "invalid_whitelisted_denom",
+			modifier: func(params *types.Params) {
+				params.Whitelist = []string{"1core"}
+			},
+		},
+		{
+			name: "duplicated_whitelisted_denom",
+			modifier: func(params *types.Params) {
+				params.Whitelist = []string{"ucore", "ucore"}
+			},
+		},
+		{
+			name: "zero_slash_window",
+			modifier: func(params *types.Params) {
+				params.SlashWindow = 0
+			},
+		},
+		{
+			name: "negative_min_valid_per_window",
+			modifier: func(params *types.Params) {
+				params.MinValidPerWindow = sdk.MustNewDecFromStr("-0.1")
+			},
+		},
+		{
+			name: "high_slash_fraction",
+			modifier: func(params *types.Params) {
+				params.SlashFraction = sdk.MustNewDecFromStr("1.1")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modifier(&params)
+			err := params.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsPeriods(t *testing.T) {
+	params := types.DefaultParams()
+	params.VotePeriod = 5
+	params.SlashWindow = 2
+
+	assert.False(t, params.IsVotePeriodLastBlock(3))
+	assert.True(t, params.IsVotePeriodLastBlock(4))
+	assert.True(t, params.IsVotePeriodLastBlock(9))
+	assert.False(t, params.IsSlashWindowLastBlock(4))
+	assert.True(t, params.IsSlashWindowLastBlock(9))
+}
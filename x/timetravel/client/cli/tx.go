@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxShiftTime(),
+	)
+
+	return cmd
+}
+
+// CmdTxShiftTime returns ShiftTime cobra command.
+func CmdTxShiftTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shift-time [duration] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Move the time of the chain forward",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Moves the time of the chain forward by the duration, starting from the next block.
+Works only on the local chains running the binary built with the time travel enabled.
+
+Example:
+$ %s tx timetravel shift-time 24h --from [sender]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			duration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid duration")
+			}
+
+			msg := &types.MsgShiftTime{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Duration: duration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,23 @@
+package timetravel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/x/timetravel/keeper"
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+// InitGenesis initializes the timetravel module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	k.SetOffset(ctx, genState.Offset)
+}
+
+// ExportGenesis returns the timetravel module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Offset: k.GetOffset(ctx),
+	}
+}
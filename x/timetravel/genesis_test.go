@@ -0,0 +1,31 @@
+package timetravel_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/timetravel"
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+func TestImportAndExportGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	requireT.Error(types.GenesisState{Offset: -time.Second}.Validate())
+
+	genState := types.GenesisState{Offset: 36 * time.Hour}
+	requireT.NoError(genState.Validate())
+
+	timetravel.InitGenesis(ctx, testApp.TimeTravelKeeper, genState)
+	requireT.Equal(genState.Offset, testApp.TimeTravelKeeper.GetOffset(ctx))
+
+	// check that export is equal import
+	requireT.Equal(genState, *timetravel.ExportGenesis(ctx, testApp.TimeTravelKeeper))
+}
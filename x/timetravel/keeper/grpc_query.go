@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	IsEnabled() bool
+	GetOffset(ctx sdk.Context) time.Duration
+}
+
+// QueryService serves grpc query requests for the timetravel module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Time queries the block time seen by the chain together with the offset added to the time of the consensus.
+func (qs QueryService) Time(ctx context.Context, req *types.QueryTimeRequest) (*types.QueryTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryTimeResponse{
+		Enabled:   qs.keeper.IsEnabled(),
+		BlockTime: sdkCtx.BlockTime(),
+		Offset:    qs.keeper.GetOffset(sdkCtx),
+	}, nil
+}
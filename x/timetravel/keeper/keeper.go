@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+// Keeper is the timetravel module keeper.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+	enabled  bool
+}
+
+// NewKeeper creates a new instance of the Keeper. The time might be shifted only if enabled is true, which must never
+// be the case for the binaries running the public networks.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	enabled bool,
+) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		enabled:  enabled,
+	}
+}
+
+// IsEnabled returns true if the time travel is enabled.
+func (k Keeper) IsEnabled() bool {
+	return k.enabled
+}
+
+// GetOffset returns the duration added to the block time of the consensus.
+func (k Keeper) GetOffset(ctx sdk.Context) time.Duration {
+	bz := ctx.KVStore(k.storeKey).Get(types.OffsetKey)
+	if bz == nil {
+		return 0
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz))
+}
+
+// SetOffset sets the duration added to the block time of the consensus.
+func (k Keeper) SetOffset(ctx sdk.Context, offset time.Duration) {
+	ctx.KVStore(k.storeKey).Set(types.OffsetKey, sdk.Uint64ToBigEndian(uint64(offset)))
+}
+
+// ShiftTime moves the time of the chain forward by the duration. The shift takes effect starting from the next block.
+func (k Keeper) ShiftTime(ctx sdk.Context, sender sdk.AccAddress, duration time.Duration) error {
+	if !k.enabled {
+		return sdkerrors.Wrap(types.ErrDisabled, "the binary is built without the time travel")
+	}
+	if err := types.ValidateShift(duration); err != nil {
+		return err
+	}
+
+	offset := k.GetOffset(ctx) + duration
+	k.SetOffset(ctx, offset)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTimeShifted{
+		Sender:   sender.String(),
+		Duration: duration,
+		Offset:   offset,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't emit event EventTimeShifted: %s", err)
+	}
+
+	return nil
+}
+
+// ShiftedTime returns the block time of the consensus shifted by the offset. The block time stays untouched if the
+// time travel is disabled.
+func (k Keeper) ShiftedTime(ctx sdk.Context, blockTime time.Time) time.Time {
+	if !k.enabled {
+		return blockTime
+	}
+	return blockTime.Add(k.GetOffset(ctx))
+}
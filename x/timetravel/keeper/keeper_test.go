@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CoreumFoundation/coreum/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/x/timetravel/keeper"
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+func TestKeeper_ShiftTime(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{Time: now})
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// the time travel is disabled by default
+	requireT.False(testApp.TimeTravelKeeper.IsEnabled())
+	requireT.True(types.ErrDisabled.Is(testApp.TimeTravelKeeper.ShiftTime(ctx, sender, time.Hour)))
+	requireT.Equal(now, testApp.TimeTravelKeeper.ShiftedTime(ctx, now))
+
+	timeTravelKeeper := keeper.NewKeeper(testApp.AppCodec(), testApp.GetKey(types.StoreKey), true)
+	requireT.True(timeTravelKeeper.IsEnabled())
+
+	// the time can't be moved backwards or too far
+	requireT.True(types.ErrInvalidInput.Is(timeTravelKeeper.ShiftTime(ctx, sender, 0)))
+	requireT.True(types.ErrInvalidInput.Is(timeTravelKeeper.ShiftTime(ctx, sender, -time.Hour)))
+	requireT.True(types.ErrInvalidInput.Is(timeTravelKeeper.ShiftTime(ctx, sender, types.MaxShift+1)))
+
+	// the shifts are accumulated
+	requireT.NoError(timeTravelKeeper.ShiftTime(ctx, sender, time.Hour))
+	requireT.NoError(timeTravelKeeper.ShiftTime(ctx, sender, 24*time.Hour))
+	requireT.Equal(25*time.Hour, timeTravelKeeper.GetOffset(ctx))
+	requireT.Equal(now.Add(25*time.Hour), timeTravelKeeper.ShiftedTime(ctx, now))
+
+	// the offset is ignored if the time travel is disabled
+	requireT.Equal(now, testApp.TimeTravelKeeper.ShiftedTime(ctx, now))
+}
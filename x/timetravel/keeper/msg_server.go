@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/x/timetravel/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	ShiftTime(ctx sdk.Context, sender sdk.AccAddress, duration time.Duration) error
+}
+
+// MsgServer serves grpc tx requests for the timetravel module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// ShiftTime moves the time of the chain forward.
+func (ms MsgServer) ShiftTime(ctx context.Context, req *types.MsgShiftTime) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+	if err := ms.keeper.ShiftTime(sdk.UnwrapSDKContext(ctx), sender, req.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
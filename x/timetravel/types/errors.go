@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrInvalidInput defines the common error for the invalid input.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrDisabled is returned when the time is shifted on the chain built without the time travel enabled.
+	ErrDisabled = sdkerrors.Register(ModuleName, 2, "time travel is disabled")
+)
@@ -0,0 +1,17 @@
+package types
+
+import "github.com/pkg/errors"
+
+// DefaultGenesis returns the default timetravel genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (m GenesisState) Validate() error {
+	if m.Offset < 0 {
+		return errors.New("offset must not be negative")
+	}
+
+	return nil
+}
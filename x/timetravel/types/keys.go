@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "timetravel"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Store keys
+var (
+	// OffsetKey defines the key for the duration added to the block time of the consensus.
+	OffsetKey = []byte{0x01}
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxShift is the maximum duration the time might be shifted by in a single message.
+const MaxShift = 365 * 24 * time.Hour
+
+var _ sdk.Msg = &MsgShiftTime{}
+
+// ValidateBasic checks that message fields are valid.
+func (msg *MsgShiftTime) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender account %s", msg.Sender)
+	}
+
+	return ValidateShift(msg.Duration)
+}
+
+// GetSigners returns the required signers of this message type.
+func (msg *MsgShiftTime) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	}
+}
+
+// ValidateShift checks the time is moved forward and not too far, so the block time stays monotonic.
+func ValidateShift(duration time.Duration) error {
+	if duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "duration must be positive")
+	}
+	if duration > MaxShift {
+		return sdkerrors.Wrapf(ErrInvalidInput, "duration must not exceed %s", MaxShift)
+	}
+
+	return nil
+}
@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/timetravel/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+
+var (
+	_ = fmt.Errorf
+	_ = math.Inf
+	_ = time.Kitchen
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryTimeRequest struct{}
+
+func (m *QueryTimeRequest) Reset()         { *m = QueryTimeRequest{} }
+func (m *QueryTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeRequest) ProtoMessage()    {}
+func (*QueryTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e10fd9f3791f2a8, []int{0}
+}
+
+func (m *QueryTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeRequest.Merge(m, src)
+}
+
+func (m *QueryTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeRequest proto.InternalMessageInfo
+
+type QueryTimeResponse struct {
+	// enabled tells if the time travel is enabled on the chain.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// block_time is the time of the latest block seen by the modules.
+	BlockTime time.Time `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// offset is the duration added to the block time of the consensus.
+	Offset time.Duration `protobuf:"bytes,3,opt,name=offset,proto3,stdduration" json:"offset"`
+}
+
+func (m *QueryTimeResponse) Reset()         { *m = QueryTimeResponse{} }
+func (m *QueryTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeResponse) ProtoMessage()    {}
+func (*QueryTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e10fd9f3791f2a8, []int{1}
+}
+
+func (m *QueryTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeResponse.Merge(m, src)
+}
+
+func (m *QueryTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeResponse proto.InternalMessageInfo
+
+func (m *QueryTimeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryTimeResponse) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTimeResponse) GetOffset() time.Duration {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryTimeRequest)(nil), "coreum.timetravel.v1.QueryTimeRequest")
+	proto.RegisterType((*QueryTimeResponse)(nil), "coreum.timetravel.v1.QueryTimeResponse")
+}
+
+func init() { proto.RegisterFile("coreum/timetravel/v1/query.proto", fileDescriptor_0e10fd9f3791f2a8) }
+
+var fileDescriptor_0e10fd9f3791f2a8 = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcf, 0x4e, 0xe2, 0x40,
+	0x18, 0xef, 0xb0, 0xbb, 0x2c, 0x3b, 0x7b, 0xd1, 0x86, 0x43, 0x6d, 0x48, 0x21, 0x3d, 0x28, 0xa7,
+	0x99, 0x80, 0xf1, 0xe4, 0x0d, 0x8c, 0x57, 0x23, 0xe1, 0xe4, 0xc5, 0xb4, 0x30, 0xd4, 0xc6, 0xb6,
+	0x5f, 0x69, 0x67, 0x88, 0xc4, 0x9b, 0xf1, 0x01, 0x48, 0xbc, 0xf8, 0x16, 0xbe, 0x06, 0x47, 0x12,
+	0x2f, 0x9e, 0xd4, 0x80, 0x0f, 0x62, 0x66, 0xda, 0x46, 0x82, 0x24, 0xde, 0xe6, 0x9b, 0xdf, 0xbf,
+	0xef, 0x37, 0x83, 0x1b, 0x03, 0x48, 0x98, 0x08, 0x29, 0xf7, 0x43, 0xc6, 0x13, 0x67, 0xc2, 0x02,
+	0x3a, 0x69, 0xd1, 0xb1, 0x60, 0xc9, 0x94, 0xc4, 0x09, 0x70, 0xd0, 0xab, 0x19, 0x83, 0x7c, 0x31,
+	0xc8, 0xa4, 0x65, 0x56, 0x3d, 0xf0, 0x40, 0x11, 0xa8, 0x3c, 0x65, 0x5c, 0xb3, 0xe6, 0x01, 0x78,
+	0x01, 0xa3, 0x4e, 0xec, 0x53, 0x27, 0x8a, 0x80, 0x3b, 0xdc, 0x87, 0x28, 0xcd, 0x51, 0x2b, 0x47,
+	0xd5, 0xe4, 0x8a, 0x11, 0x1d, 0x8a, 0x44, 0x11, 0x72, 0xbc, 0xbe, 0x89, 0xcb, 0xc8, 0x94, 0x3b,
+	0x61, 0x9c, 0x11, 0x6c, 0x1d, 0xef, 0x9c, 0xcb, 0xcd, 0xfa, 0x7e, 0xc8, 0x7a, 0x6c, 0x2c, 0x58,
+	0xca, 0xed, 0x27, 0x84, 0x77, 0xd7, 0x2e, 0xd3, 0x18, 0xa2, 0x94, 0xe9, 0x06, 0xfe, 0xcb, 0x22,
+	0xc7, 0x0d, 0xd8, 0xd0, 0x40, 0x0d, 0xd4, 0xac, 0xf4, 0x8a, 0x51, 0xef, 0x62, 0xec, 0x06, 0x30,
+	0xb8, 0xbe, 0x94, 0xe6, 0x46, 0xa9, 0x81, 0x9a, 0xff, 0xdb, 0x26, 0xc9, 0x92, 0x49, 0x91, 0x4c,
+	0xfa, 0x45, 0x72, 0xa7, 0x32, 0x7f, 0xad, 0x6b, 0xb3, 0xb7, 0x3a, 0xea, 0xfd, 0x53, 0x3a, 0x89,
+	0xe8, 0xc7, 0xb8, 0x0c, 0xa3, 0x51, 0xca, 0xb8, 0xf1, 0x4b, 0x19, 0xec, 0x7d, 0x33, 0x38, 0xc9,
+	0xab, 0x65, 0xfa, 0x47, 0xa9, 0xcf, 0x25, 0xed, 0x7b, 0x84, 0xff, 0xa8, 0x8d, 0xf5, 0x5b, 0xfc,
+	0x5b, 0xd9, 0xed, 0x93, 0x6d, 0x6f, 0x4c, 0x36, 0xbb, 0x9a, 0x07, 0x3f, 0xf2, 0xb2, 0xfa, 0xb6,
+	0x7d, 0xf7, 0xfc, 0xf1, 0x50, 0xaa, 0xe9, 0x26, 0xdd, 0xfa, 0xbd, 0x72, 0xea, 0x9c, 0xcd, 0x97,
+	0x16, 0x5a, 0x2c, 0x2d, 0xf4, 0xbe, 0xb4, 0xd0, 0x6c, 0x65, 0x69, 0x8b, 0x95, 0xa5, 0xbd, 0xac,
+	0x2c, 0xed, 0xe2, 0xc8, 0xf3, 0xf9, 0x95, 0x70, 0xc9, 0x00, 0x42, 0xda, 0x55, 0xfa, 0x53, 0x10,
+	0xd1, 0x50, 0xf5, 0x29, 0x0c, 0x6f, 0xd6, 0x2d, 0xf9, 0x34, 0x66, 0xa9, 0x5b, 0x56, 0xe5, 0x0f,
+	0x3f, 0x07, 0x00, 0x51, 0xe7, 0x0f, 0xe5, 0x53, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Time returns the block time seen by the modules and the offset added to the block time of the consensus.
+	Time(ctx context.Context, in *QueryTimeRequest, opts ...grpc.CallOption) (*QueryTimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Time(ctx context.Context, in *QueryTimeRequest, opts ...grpc.CallOption) (*QueryTimeResponse, error) {
+	out := new(QueryTimeResponse)
+	err := c.cc.Invoke(ctx, "/coreum.timetravel.v1.Query/Time", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Time returns the block time seen by the modules and the offset added to the block time of the consensus.
+	Time(context.Context, *QueryTimeRequest) (*QueryTimeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) Time(ctx context.Context, req *QueryTimeRequest) (*QueryTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Time not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Time_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Time(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.timetravel.v1.Query/Time",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Time(ctx, req.(*QueryTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.timetravel.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Time",
+			Handler:    _Query_Time_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/timetravel/v1/query.proto",
+}
+
+func (m *QueryTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Offset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Offset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Offset)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Offset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"sync/atomic"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ wasmkeeper.GasRegister = GasRegister{}
+
+// GasRegister is the wasm gas register charging the pinned contracts only the part of the instance cost which is not
+// discounted. The pinned contracts are kept in the memory cache of the VM, so loading them is much cheaper than loading
+// the contracts from the disk.
+//
+// The gas register has no access to the state, so the discount, controlled by the governance, must be set before the
+// transactions of the block are executed.
+type GasRegister struct {
+	wasmkeeper.WasmGasRegister
+	instanceCost       sdk.Gas
+	pinnedInstanceCost *uint64
+}
+
+// NewGasRegister returns a new instance of the GasRegister. The pinned contracts are not charged for the instance
+// until the discount is set.
+func NewGasRegister(config wasmkeeper.WasmGasRegisterConfig) GasRegister {
+	return GasRegister{
+		WasmGasRegister:    wasmkeeper.NewWasmGasRegister(config),
+		instanceCost:       config.InstanceCost,
+		pinnedInstanceCost: new(uint64),
+	}
+}
+
+// SetPinnedInstanceCostDiscount sets the part of the instance cost the pinned contracts are not charged for.
+func (g GasRegister) SetPinnedInstanceCostDiscount(discount sdk.Dec) {
+	cost := sdk.OneDec().Sub(discount).MulInt64(int64(g.instanceCost)).TruncateInt().Uint64()
+	atomic.StoreUint64(g.pinnedInstanceCost, cost)
+}
+
+// PinnedInstanceCost returns the instance cost charged to the pinned contracts.
+func (g GasRegister) PinnedInstanceCost() sdk.Gas {
+	return atomic.LoadUint64(g.pinnedInstanceCost)
+}
+
+// NewContractInstanceCosts costs to create a new contract instance from code.
+func (g GasRegister) NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas {
+	return g.InstantiateContractCosts(pinned, msgLen)
+}
+
+// InstantiateContractCosts costs when interacting with a wasm contract.
+func (g GasRegister) InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
+	// the wrapped register doesn't charge the instance cost for the pinned contracts
+	return g.WasmGasRegister.InstantiateContractCosts(true, msgLen) + g.instanceCosts(pinned)
+}
+
+// ReplyCosts costs to handle a message reply.
+func (g GasRegister) ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas {
+	return g.WasmGasRegister.ReplyCosts(true, reply) + g.instanceCosts(pinned)
+}
+
+func (g GasRegister) instanceCosts(pinned bool) sdk.Gas {
+	if pinned {
+		return g.PinnedInstanceCost()
+	}
+	return g.instanceCost
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/x/wasm/types"
+)
+
+func TestGasRegister_PinnedInstanceCostDiscount(t *testing.T) {
+	requireT := require.New(t)
+
+	config := wasmkeeper.DefaultGasRegisterConfig()
+	config.ContractMessageDataCost = 1
+	wasmRegister := wasmkeeper.NewWasmGasRegister(config)
+	gasRegister := types.NewGasRegister(config)
+
+	reply := wasmvmtypes.Reply{
+		Result: wasmvmtypes.SubMsgResult{
+			Ok: &wasmvmtypes.SubMsgResponse{
+				Data: []byte("data"),
+				Events: []wasmvmtypes.Event{
+					{Type: "event", Attributes: []wasmvmtypes.EventAttribute{{Key: "key", Value: "value"}}},
+				},
+			},
+		},
+	}
+
+	// by default the pinned contracts aren't charged for the instance, the same way as in the wrapped register
+	requireT.Zero(gasRegister.PinnedInstanceCost())
+	for _, pinned := range []bool{true, false} {
+		requireT.Equal(wasmRegister.InstantiateContractCosts(pinned, 10), gasRegister.InstantiateContractCosts(pinned, 10))
+		requireT.Equal(wasmRegister.NewContractInstanceCosts(pinned, 10), gasRegister.NewContractInstanceCosts(pinned, 10))
+		requireT.Equal(wasmRegister.ReplyCosts(pinned, reply), gasRegister.ReplyCosts(pinned, reply))
+	}
+
+	// the pinned contracts are charged for the part of the instance cost which is not discounted
+	gasRegister.SetPinnedInstanceCostDiscount(sdk.NewDecWithPrec(75, 2))
+	requireT.Equal(config.InstanceCost/4, gasRegister.PinnedInstanceCost())
+	requireT.Equal(wasmRegister.InstantiateContractCosts(true, 10)+config.InstanceCost/4, gasRegister.InstantiateContractCosts(true, 10))
+	requireT.Equal(wasmRegister.ReplyCosts(true, reply)+config.InstanceCost/4, gasRegister.ReplyCosts(true, reply))
+	requireT.Equal(wasmRegister.InstantiateContractCosts(false, 10), gasRegister.InstantiateContractCosts(false, 10))
+
+	// without the discount the pinned contracts are charged the same as the others
+	gasRegister.SetPinnedInstanceCostDiscount(sdk.ZeroDec())
+	requireT.Equal(gasRegister.InstantiateContractCosts(false, 10), gasRegister.InstantiateContractCosts(true, 10))
+	requireT.Equal(gasRegister.ReplyCosts(false, reply), gasRegister.ReplyCosts(true, reply))
+}
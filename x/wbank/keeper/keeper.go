@@ -87,7 +87,9 @@ func (k BaseKeeperWrapper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAd
 		return err
 	}
 
-	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	return k.ftProvider.TrackHolders(ctx, []sdk.AccAddress{fromAddr, toAddr}, amt, func() error {
+		return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
 }
 
 // InputOutputCoins is a BaseKeeper InputOutputCoins wrapped method.
@@ -96,5 +98,25 @@ func (k BaseKeeperWrapper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.
 		return err
 	}
 
-	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	addrs := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
+	coins := sdk.NewCoins()
+	for _, input := range inputs {
+		addr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+		coins = coins.Add(input.Coins...)
+	}
+	for _, output := range outputs {
+		addr, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return k.ftProvider.TrackHolders(ctx, addrs, coins, func() error {
+		return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	})
 }
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type msgServer struct {
+	banktypes.MsgServer
+	keeper BaseKeeperWrapper
+}
+
+// NewMsgServerImpl returns the bank message server calling the receive hooks of the fungible tokens sent to the
+// contracts. The hooks are called by the messages only, so the contracts aren't notified about the funds sent to them
+// by the wasm module on the execution, which are passed to the contract call anyway.
+func NewMsgServerImpl(keeper BaseKeeperWrapper) banktypes.MsgServer {
+	return msgServer{
+		MsgServer: bankkeeper.NewMsgServerImpl(keeper),
+		keeper:    keeper,
+	}
+}
+
+// Send is a bank Send wrapped method.
+func (s msgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	res, err := s.MsgServer.Send(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	toAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.ftProvider.CallReceiveHooks(sdk.UnwrapSDKContext(goCtx), fromAddress, toAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MultiSend is a bank MultiSend wrapped method.
+func (s msgServer) MultiSend(goCtx context.Context, msg *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	res, err := s.MsgServer.MultiSend(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keeper.ftProvider.CallInputOutputReceiveHooks(
+		sdk.UnwrapSDKContext(goCtx), msg.Inputs, msg.Outputs,
+	); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -9,4 +9,7 @@ import (
 type FungibleTokenProvider interface {
 	BeforeSendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) error
 	BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
+	CallReceiveHooks(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) error
+	CallInputOutputReceiveHooks(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
+	TrackHolders(ctx sdk.Context, addrs []sdk.AccAddress, coins sdk.Coins, change func() error) error
 }